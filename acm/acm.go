@@ -0,0 +1,321 @@
+// Package acm provides types and functions to interact with AWS Certificate
+// Manager.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/Welcome.html
+package acm
+
+import (
+	"net/http"
+
+	"errors"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type ACM struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *ACM {
+	return &ACM{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with ACM.
+type Error = aws.JSONError
+
+// query calls the ACM action with req encoded as JSON and decodes the
+// response into resp.
+func (a *ACM) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         a.Auth,
+		Region:       a.Region,
+		HTTPClient:   a.HTTPClient,
+		Endpoint:     a.Region.ACMEndpoint,
+		TargetPrefix: "CertificateManager",
+		SigningName:  "acm",
+	}
+	return client.Call(action, req, resp)
+}
+
+const (
+	ValidationMethodDNS   = "DNS"
+	ValidationMethodEmail = "EMAIL"
+)
+
+// Statuses of a certificate.
+const (
+	CertificateStatusPendingValidation  = "PENDING_VALIDATION"
+	CertificateStatusIssued             = "ISSUED"
+	CertificateStatusInactive           = "INACTIVE"
+	CertificateStatusExpired            = "EXPIRED"
+	CertificateStatusValidationTimedOut = "VALIDATION_TIMED_OUT"
+	CertificateStatusRevoked            = "REVOKED"
+	CertificateStatusFailed             = "FAILED"
+)
+
+type Tag struct {
+	Key   string
+	Value string `json:",omitempty"`
+}
+
+// DomainValidationOption sets the domain that validation emails for
+// DomainName are sent to, such as its parent domain.
+type DomainValidationOption struct {
+	DomainName       string
+	ValidationDomain string
+}
+
+// RequestCertificateRequest holds the parameters of RequestCertificate.
+// Only DomainName is required; ValidationMethod defaults to EMAIL, but
+// DNS is recommended as it allows ACM to renew the certificate unattended.
+// IdempotencyToken makes requests made within an hour with the same token
+// return the same certificate.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_RequestCertificate.html
+type RequestCertificateRequest struct {
+	DomainName              string
+	ValidationMethod        string                   `json:",omitempty"`
+	SubjectAlternativeNames []string                 `json:",omitempty"`
+	IdempotencyToken        string                   `json:",omitempty"`
+	DomainValidationOptions []DomainValidationOption `json:",omitempty"`
+	KeyAlgorithm            string                   `json:",omitempty"`
+	CertificateAuthorityArn string                   `json:",omitempty"`
+	Tags                    []Tag                    `json:",omitempty"`
+}
+
+// RequestCertificate requests a public certificate, or a private one if
+// CertificateAuthorityArn is set, and returns its ARN. Certificates used
+// by CloudFront must be requested in us-east-1.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_RequestCertificate.html
+func (a *ACM) RequestCertificate(req *RequestCertificateRequest) (certificateArn string, err error) {
+	var resp struct {
+		CertificateArn string
+	}
+	if err := a.query("RequestCertificate", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.CertificateArn, nil
+}
+
+// ResourceRecord is a DNS record to create to validate a domain.
+type ResourceRecord struct {
+	Name  string
+	Type  string
+	Value string
+}
+
+// DomainValidation describes the validation of a domain of a certificate.
+// ValidationStatus is PENDING_VALIDATION, SUCCESS or FAILED.
+type DomainValidation struct {
+	DomainName       string
+	ValidationDomain string
+	ValidationMethod string
+	ValidationStatus string
+	ValidationEmails []string
+	ResourceRecord   *ResourceRecord
+}
+
+// CertificateDetail describes a certificate. Dates are in seconds since
+// the epoch. InUseBy holds the ARNs of the resources using the
+// certificate, which must be empty for the certificate to be deleted.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_CertificateDetail.html
+type CertificateDetail struct {
+	CertificateArn          string
+	DomainName              string
+	SubjectAlternativeNames []string
+	DomainValidationOptions []DomainValidation
+	Serial                  string
+	Subject                 string
+	Issuer                  string
+	CreatedAt               float64
+	IssuedAt                float64
+	ImportedAt              float64
+	NotBefore               float64
+	NotAfter                float64
+	Status                  string
+	FailureReason           string
+	RevokedAt               float64
+	RevocationReason        string
+	KeyAlgorithm            string
+	SignatureAlgorithm      string
+	InUseBy                 []string
+	Type                    string
+	RenewalEligibility      string
+}
+
+// DescribeCertificate describes a certificate.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_DescribeCertificate.html
+func (a *ACM) DescribeCertificate(certificateArn string) (*CertificateDetail, error) {
+	req := map[string]string{"CertificateArn": certificateArn}
+	var resp struct {
+		Certificate *CertificateDetail
+	}
+	if err := a.query("DescribeCertificate", req, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Certificate, nil
+}
+
+var errValidationRecordsPending = errors.New("acm: DNS validation records are not available yet")
+
+// DNSValidationRecords returns the DNS records that validate the domains
+// of a certificate requested with DNS validation. Domains sharing a record,
+// such as example.com and *.example.com, yield it once.
+//
+// ACM generates the records shortly after RequestCertificate returns; an
+// error is returned until all of them are available, so callers should
+// retry after a few seconds.
+func (a *ACM) DNSValidationRecords(certificateArn string) ([]ResourceRecord, error) {
+	cert, err := a.DescribeCertificate(certificateArn)
+	if err != nil {
+		return nil, err
+	}
+	var records []ResourceRecord
+	seen := make(map[ResourceRecord]bool)
+	for _, v := range cert.DomainValidationOptions {
+		if v.ValidationMethod != ValidationMethodDNS {
+			continue
+		}
+		if v.ResourceRecord == nil {
+			return nil, errValidationRecordsPending
+		}
+		if !seen[*v.ResourceRecord] {
+			seen[*v.ResourceRecord] = true
+			records = append(records, *v.ResourceRecord)
+		}
+	}
+	return records, nil
+}
+
+// ListCertificatesRequest selects the certificates to list. All fields are
+// optional; by default only RSA_1024 and RSA_2048 certificates are listed.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_ListCertificates.html
+type ListCertificatesRequest struct {
+	CertificateStatuses []string `json:",omitempty"`
+	Includes            *Filters `json:",omitempty"`
+	MaxItems            int      `json:",omitempty"`
+	NextToken           string   `json:",omitempty"`
+}
+
+// Filters restricts ListCertificates to certificates with the given key
+// types, such as EC_prime256v1, and key usages.
+type Filters struct {
+	KeyTypes         []string `json:"keyTypes,omitempty"`
+	KeyUsage         []string `json:"keyUsage,omitempty"`
+	ExtendedKeyUsage []string `json:"extendedKeyUsage,omitempty"`
+}
+
+type CertificateSummary struct {
+	CertificateArn string
+	DomainName     string
+	Status         string
+	Type           string
+	KeyAlgorithm   string
+	InUse          bool
+	NotAfter       float64
+}
+
+type ListCertificatesResponse struct {
+	CertificateSummaryList []CertificateSummary
+	NextToken              string
+}
+
+// ListCertificates fetches a page of certificates. If the response has a
+// NextToken, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_ListCertificates.html
+func (a *ACM) ListCertificates(req *ListCertificatesRequest) (resp *ListCertificatesResponse, err error) {
+	resp = new(ListCertificatesResponse)
+	if err := a.query("ListCertificates", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ImportCertificateRequest holds the parameters of ImportCertificate. The
+// certificate, its chain and its unencrypted private key are PEM encoded;
+// the chain is optional. Setting CertificateArn reimports a certificate
+// previously imported, keeping its ARN and the resources using it.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_ImportCertificate.html
+type ImportCertificateRequest struct {
+	CertificateArn   string `json:",omitempty"`
+	Certificate      []byte
+	CertificateChain []byte `json:",omitempty"`
+	PrivateKey       []byte
+	Tags             []Tag `json:",omitempty"`
+}
+
+// ImportCertificate imports a certificate issued outside ACM, and returns
+// its ARN. ACM does not renew imported certificates.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_ImportCertificate.html
+func (a *ACM) ImportCertificate(req *ImportCertificateRequest) (certificateArn string, err error) {
+	var resp struct {
+		CertificateArn string
+	}
+	if err := a.query("ImportCertificate", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.CertificateArn, nil
+}
+
+// allKeyTypes lists every key type, as ListCertificates only lists RSA
+// certificates by default.
+var allKeyTypes = []string{
+	"RSA_1024", "RSA_2048", "RSA_3072", "RSA_4096",
+	"EC_prime256v1", "EC_secp384r1", "EC_secp521r1",
+}
+
+// ListExpiring returns the issued or expired certificates, of any key type,
+// that expire within the given duration from now, including those already
+// expired. The summaries listed are filtered on their expiry date when
+// they have one, and the remaining certificates described.
+func (a *ACM) ListExpiring(within time.Duration) ([]CertificateDetail, error) {
+	deadline := float64(time.Now().Add(within).Unix())
+	req := &ListCertificatesRequest{
+		CertificateStatuses: []string{CertificateStatusIssued, CertificateStatusExpired},
+		Includes:            &Filters{KeyTypes: allKeyTypes},
+	}
+	var certs []CertificateDetail
+	for {
+		resp, err := a.ListCertificates(req)
+		if err != nil {
+			return nil, err
+		}
+		for _, summary := range resp.CertificateSummaryList {
+			if summary.NotAfter > deadline {
+				continue
+			}
+			cert, err := a.DescribeCertificate(summary.CertificateArn)
+			if err != nil {
+				return nil, err
+			}
+			if cert.NotAfter != 0 && cert.NotAfter <= deadline {
+				certs = append(certs, *cert)
+			}
+		}
+		if resp.NextToken == "" {
+			return certs, nil
+		}
+		req.NextToken = resp.NextToken
+	}
+}
+
+// DeleteCertificate deletes a certificate, which must not be in use.
+//
+// See http://docs.aws.amazon.com/acm/latest/APIReference/API_DeleteCertificate.html
+func (a *ACM) DeleteCertificate(certificateArn string) error {
+	req := map[string]string{"CertificateArn": certificateArn}
+	return a.query("DeleteCertificate", req, nil)
+}
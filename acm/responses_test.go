@@ -0,0 +1,178 @@
+package acm_test
+
+// http://docs.aws.amazon.com/acm/latest/APIReference/API_RequestCertificate.html
+var RequestCertificateResponse = `
+{
+  "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8"
+}
+`
+
+// http://docs.aws.amazon.com/acm/latest/APIReference/API_DescribeCertificate.html
+var DescribeCertificateResponse = `
+{
+  "Certificate": {
+    "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8",
+    "DomainName": "example.com",
+    "SubjectAlternativeNames": ["example.com", "*.example.com", "www.example.org"],
+    "DomainValidationOptions": [
+      {
+        "DomainName": "example.com",
+        "ValidationDomain": "example.com",
+        "ValidationMethod": "DNS",
+        "ValidationStatus": "PENDING_VALIDATION",
+        "ResourceRecord": {
+          "Name": "_a79865eb4cd1a6ab990a45779b4e0b96.example.com.",
+          "Type": "CNAME",
+          "Value": "_424c7224e9b0146f9a8808af955727d0.hkmpvcwbzw.acm-validations.aws."
+        }
+      },
+      {
+        "DomainName": "*.example.com",
+        "ValidationDomain": "example.com",
+        "ValidationMethod": "DNS",
+        "ValidationStatus": "PENDING_VALIDATION",
+        "ResourceRecord": {
+          "Name": "_a79865eb4cd1a6ab990a45779b4e0b96.example.com.",
+          "Type": "CNAME",
+          "Value": "_424c7224e9b0146f9a8808af955727d0.hkmpvcwbzw.acm-validations.aws."
+        }
+      },
+      {
+        "DomainName": "www.example.org",
+        "ValidationDomain": "www.example.org",
+        "ValidationMethod": "DNS",
+        "ValidationStatus": "SUCCESS",
+        "ResourceRecord": {
+          "Name": "_3e1cbf5b7d3c4dbd8e2c1a9f0b6d7e8f.www.example.org.",
+          "Type": "CNAME",
+          "Value": "_9d8c7b6a5f4e3d2c1b0a9f8e7d6c5b4a.hkmpvcwbzw.acm-validations.aws."
+        }
+      }
+    ],
+    "Subject": "CN=example.com",
+    "CreatedAt": 1697322371.234,
+    "Status": "PENDING_VALIDATION",
+    "KeyAlgorithm": "RSA-2048",
+    "InUseBy": [],
+    "Type": "AMAZON_ISSUED",
+    "RenewalEligibility": "INELIGIBLE"
+  }
+}
+`
+
+// DescribeCertificatePendingResponse is the description of a certificate
+// whose validation records are not generated yet.
+var DescribeCertificatePendingResponse = `
+{
+  "Certificate": {
+    "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8",
+    "DomainName": "example.com",
+    "DomainValidationOptions": [
+      {
+        "DomainName": "example.com",
+        "ValidationDomain": "example.com",
+        "ValidationMethod": "DNS",
+        "ValidationStatus": "PENDING_VALIDATION"
+      }
+    ],
+    "Status": "PENDING_VALIDATION",
+    "Type": "AMAZON_ISSUED"
+  }
+}
+`
+
+// http://docs.aws.amazon.com/acm/latest/APIReference/API_ListCertificates.html
+var ListCertificatesResponse = `
+{
+  "CertificateSummaryList": [
+    {
+      "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8",
+      "DomainName": "example.com",
+      "Status": "ISSUED",
+      "Type": "AMAZON_ISSUED",
+      "KeyAlgorithm": "EC-prime256v1",
+      "InUse": true,
+      "NotAfter": 1731450371.0
+    }
+  ],
+  "NextToken": "AAAAAQAAAAEAAAAA"
+}
+`
+
+var ErrorResponse = `
+{
+  "__type": "ResourceInUseException",
+  "message": "Certificate arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8 in use."
+}
+`
+
+// http://docs.aws.amazon.com/acm/latest/APIReference/API_ImportCertificate.html
+var ImportCertificateResponse = `
+{
+  "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/0a1b2c3d-4e5f-6071-8293-a4b5c6d7e8f9"
+}
+`
+
+// ListExpiringFirstPage lists a certificate that expired in 2024 and one
+// that expires in 2100.
+var ListExpiringFirstPage = `
+{
+  "CertificateSummaryList": [
+    {
+      "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8",
+      "DomainName": "example.com",
+      "Status": "EXPIRED",
+      "Type": "IMPORTED",
+      "NotAfter": 1731450371.0
+    },
+    {
+      "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/11111111-2222-3333-4444-555555555555",
+      "DomainName": "example.net",
+      "Status": "ISSUED",
+      "Type": "AMAZON_ISSUED",
+      "NotAfter": 4102444800.0
+    }
+  ],
+  "NextToken": "AAAAAQAAAAEAAAAA"
+}
+`
+
+// ListExpiringSecondPage lists a certificate without its expiry date.
+var ListExpiringSecondPage = `
+{
+  "CertificateSummaryList": [
+    {
+      "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/66666666-7777-8888-9999-000000000000",
+      "DomainName": "example.org",
+      "Status": "ISSUED",
+      "Type": "AMAZON_ISSUED"
+    }
+  ]
+}
+`
+
+var DescribeExpiredCertificateResponse = `
+{
+  "Certificate": {
+    "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/fe1c2a3b-4d5e-6f70-8192-a3b4c5d6e7f8",
+    "DomainName": "example.com",
+    "NotBefore": 1699914371.0,
+    "NotAfter": 1731450371.0,
+    "Status": "EXPIRED",
+    "InUseBy": ["arn:aws:elasticloadbalancing:us-east-1:111122223333:loadbalancer/app/web/1234567890abcdef"],
+    "Type": "IMPORTED"
+  }
+}
+`
+
+var DescribeValidCertificateResponse = `
+{
+  "Certificate": {
+    "CertificateArn": "arn:aws:acm:us-east-1:111122223333:certificate/66666666-7777-8888-9999-000000000000",
+    "DomainName": "example.org",
+    "NotAfter": 4102444800.0,
+    "Status": "ISSUED",
+    "Type": "AMAZON_ISSUED"
+  }
+}
+`
@@ -169,3 +169,151 @@ var DeleteScheduledActionResponse = `
   </ResponseMetadata>
 </DeleteScheduledActionResponse>
 `
+
+var AttachLoadBalancersResponse = `
+<AttachLoadBalancersResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <AttachLoadBalancersResult/>
+  <ResponseMetadata>
+    <RequestId>3a5d8f0c-2c41-11e6-b4d8-0b5d3c3e6b11</RequestId>
+  </ResponseMetadata>
+</AttachLoadBalancersResponse>
+`
+
+var AttachLoadBalancerTargetGroupsResponse = `
+<AttachLoadBalancerTargetGroupsResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <AttachLoadBalancerTargetGroupsResult/>
+  <ResponseMetadata>
+    <RequestId>3b1e9a27-2c41-11e6-9b8c-e3f0a1d5c7a2</RequestId>
+  </ResponseMetadata>
+</AttachLoadBalancerTargetGroupsResponse>
+`
+
+var DetachLoadBalancerTargetGroupsResponse = `
+<DetachLoadBalancerTargetGroupsResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <DetachLoadBalancerTargetGroupsResult/>
+  <ResponseMetadata>
+    <RequestId>3c4f6b81-2c41-11e6-a0d2-7f1c9e2b4d53</RequestId>
+  </ResponseMetadata>
+</DetachLoadBalancerTargetGroupsResponse>
+`
+
+var PutScalingPolicyResponse = `
+<PutScalingPolicyResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <PutScalingPolicyResult>
+    <PolicyARN>arn:aws:autoscaling:us-west-2:123456789012:scalingPolicy:0ab3e4f9-7f2a-4c1b-9d5e-8a6b3c2d1e0f:autoScalingGroupName/ASGTest1:policyName/cpu40-predictive</PolicyARN>
+    <Alarms/>
+  </PutScalingPolicyResult>
+  <ResponseMetadata>
+    <RequestId>5d2c7e91-2c41-11e6-8f3a-1b9d4e6c2a70</RequestId>
+  </ResponseMetadata>
+</PutScalingPolicyResponse>
+`
+
+var GetPredictiveScalingForecastResponse = `
+<GetPredictiveScalingForecastResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <GetPredictiveScalingForecastResult>
+    <LoadForecast>
+      <member>
+        <Timestamps>
+          <member>2021-05-19T17:00:00Z</member>
+          <member>2021-05-19T18:00:00Z</member>
+        </Timestamps>
+        <Values>
+          <member>172.0</member>
+          <member>176.5</member>
+        </Values>
+        <MetricSpecification>
+          <TargetValue>40.0</TargetValue>
+          <PredefinedMetricPairSpecification>
+            <PredefinedMetricType>ASGCPUUtilization</PredefinedMetricType>
+          </PredefinedMetricPairSpecification>
+        </MetricSpecification>
+      </member>
+    </LoadForecast>
+    <CapacityForecast>
+      <Timestamps>
+        <member>2021-05-19T17:00:00Z</member>
+        <member>2021-05-19T18:00:00Z</member>
+      </Timestamps>
+      <Values>
+        <member>5.0</member>
+        <member>6.0</member>
+      </Values>
+    </CapacityForecast>
+    <UpdateTime>2021-05-19T17:30:00Z</UpdateTime>
+  </GetPredictiveScalingForecastResult>
+  <ResponseMetadata>
+    <RequestId>6e3d8fa2-2c41-11e6-9a4b-2c0e5f7d3b81</RequestId>
+  </ResponseMetadata>
+</GetPredictiveScalingForecastResponse>
+`
+
+var PutLifecycleHookResponse = `
+<PutLifecycleHookResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <PutLifecycleHookResult/>
+  <ResponseMetadata>
+    <RequestId>7a1e3c52-2c41-11e6-9b0d-4f7e2a8c1d93</RequestId>
+  </ResponseMetadata>
+</PutLifecycleHookResponse>
+`
+
+var CompleteLifecycleActionResponse = `
+<CompleteLifecycleActionResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <CompleteLifecycleActionResult/>
+  <ResponseMetadata>
+    <RequestId>7b8f4d63-2c41-11e6-a1c2-5e8f3b9d2ea4</RequestId>
+  </ResponseMetadata>
+</CompleteLifecycleActionResponse>
+`
+
+var StartInstanceRefreshResponse = `
+<StartInstanceRefreshResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <StartInstanceRefreshResult>
+    <InstanceRefreshId>08b91cf7-8fa6-48af-b6a6-d227f40f1b9b</InstanceRefreshId>
+  </StartInstanceRefreshResult>
+  <ResponseMetadata>
+    <RequestId>8c9a5e74-2c41-11e6-b2d3-6f9a4cae3fb5</RequestId>
+  </ResponseMetadata>
+</StartInstanceRefreshResponse>
+`
+
+var DescribeInstanceRefreshesResponse = `
+<DescribeInstanceRefreshesResponse xmlns="http://autoscaling.amazonaws.com/doc/2011-01-01/">
+  <DescribeInstanceRefreshesResult>
+    <InstanceRefreshes>
+      <member>
+        <InstanceRefreshId>08b91cf7-8fa6-48af-b6a6-d227f40f1b9b</InstanceRefreshId>
+        <AutoScalingGroupName>ASGTest1</AutoScalingGroupName>
+        <Status>InProgress</Status>
+        <StatusReason>Waiting for instances to warm up before continuing. For example: i-0645704820a8e83ff is warming up.</StatusReason>
+        <StartTime>2020-06-02T18:11:27Z</StartTime>
+        <PercentageComplete>50</PercentageComplete>
+        <InstancesToUpdate>2</InstancesToUpdate>
+        <Preferences>
+          <MinHealthyPercentage>90</MinHealthyPercentage>
+          <InstanceWarmup>60</InstanceWarmup>
+          <CheckpointPercentages>
+            <member>50</member>
+            <member>100</member>
+          </CheckpointPercentages>
+          <CheckpointDelay>300</CheckpointDelay>
+          <SkipMatching>true</SkipMatching>
+        </Preferences>
+      </member>
+      <member>
+        <InstanceRefreshId>dd7728d0-5bc4-4575-96a3-1b2c52bf8bb1</InstanceRefreshId>
+        <AutoScalingGroupName>ASGTest1</AutoScalingGroupName>
+        <Status>Successful</Status>
+        <StartTime>2020-06-02T16:43:19Z</StartTime>
+        <EndTime>2020-06-02T16:53:37Z</EndTime>
+        <PercentageComplete>100</PercentageComplete>
+        <InstancesToUpdate>0</InstancesToUpdate>
+      </member>
+    </InstanceRefreshes>
+    <NextToken>bmV4dA==</NextToken>
+  </DescribeInstanceRefreshesResult>
+  <ResponseMetadata>
+    <RequestId>9dab6f85-2c41-11e6-c3e4-7fab5dbf40c6</RequestId>
+  </ResponseMetadata>
+</DescribeInstanceRefreshesResponse>
+`
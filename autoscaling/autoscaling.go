@@ -46,6 +46,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
 // New creates a new AutoScaling
 func New(auth aws.Auth, region aws.Region) *AutoScaling {
 	return &AutoScaling{auth, region}
@@ -133,6 +137,7 @@ type AutoScalingGroup struct {
 	LoadBalancerNames       []string   `xml:"LoadBalancerNames>member"`
 	MaxSize                 int64      `xml:"MaxSize"`
 	MinSize                 int64      `xml:"MinSize"`
+	TargetGroupARNs         []string   `xml:"TargetGroupARNs>member"`
 	TerminationPolicies     []string   `xml:"TerminationPolicies>member"`
 	VPCZoneIdentifier       string     `xml:"VPCZoneIdentifier"`
 	Tags                    []Tag      `xml:"Tags>member"`
@@ -230,6 +235,9 @@ func (as *AutoScaling) CreateAutoScalingGroup(ag AutoScalingGroup) (
 	if len(ag.LoadBalancerNames) > 0 {
 		addParamsList(params, "LoadBalancerNames.member", ag.LoadBalancerNames)
 	}
+	if len(ag.TargetGroupARNs) > 0 {
+		addParamsList(params, "TargetGroupARNs.member", ag.TargetGroupARNs)
+	}
 	if ag.DefaultCooldown > 0 {
 		params["DefaultCooldown"] = strconv.FormatInt(ag.DefaultCooldown, 10)
 	}
@@ -403,6 +411,48 @@ func (as *AutoScaling) SetDesiredCapacity(rp SetDesiredCapacityRequestParams) (r
 	return resp, nil
 }
 
+// AttachLoadBalancers attaches one or more classic load balancers to the
+// autoscaling group.
+func (as *AutoScaling) AttachLoadBalancers(asgName string, loadBalancerNames []string) (
+	resp *SimpleResp, err error) {
+	return as.loadBalancersAction("AttachLoadBalancers", asgName, "LoadBalancerNames.member", loadBalancerNames)
+}
+
+// DetachLoadBalancers detaches one or more classic load balancers from the
+// autoscaling group. Instances in the group are deregistered from the load
+// balancers but remain running.
+func (as *AutoScaling) DetachLoadBalancers(asgName string, loadBalancerNames []string) (
+	resp *SimpleResp, err error) {
+	return as.loadBalancersAction("DetachLoadBalancers", asgName, "LoadBalancerNames.member", loadBalancerNames)
+}
+
+// AttachLoadBalancerTargetGroups attaches one or more load balancer target
+// groups, identified by ARN, to the autoscaling group.
+func (as *AutoScaling) AttachLoadBalancerTargetGroups(asgName string, targetGroupARNs []string) (
+	resp *SimpleResp, err error) {
+	return as.loadBalancersAction("AttachLoadBalancerTargetGroups", asgName, "TargetGroupARNs.member", targetGroupARNs)
+}
+
+// DetachLoadBalancerTargetGroups detaches one or more load balancer target
+// groups from the autoscaling group.
+func (as *AutoScaling) DetachLoadBalancerTargetGroups(asgName string, targetGroupARNs []string) (
+	resp *SimpleResp, err error) {
+	return as.loadBalancersAction("DetachLoadBalancerTargetGroups", asgName, "TargetGroupARNs.member", targetGroupARNs)
+}
+
+func (as *AutoScaling) loadBalancersAction(action, asgName, label string, ids []string) (
+	resp *SimpleResp, err error) {
+	resp = &SimpleResp{}
+	params := makeParams(action)
+	params["AutoScalingGroupName"] = asgName
+	addParamsList(params, label, ids)
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // ----------------------------------------------------------------------------
 // Autoscaling scheduled actions types and methods
 
@@ -418,12 +468,13 @@ type ScheduledUpdateGroupAction struct {
 	ScheduledActionARN   string `xml:"ScheduledActionARN"`
 	ScheduledActionName  string `xml:"ScheduledActionName"`
 	StartTime            string `xml:"StartTime"`
+	TimeZone             string `xml:"TimeZone"`
 }
 
 // DescribeScheduledActionsResult contains the response from a DescribeScheduledActions.
 type DescribeScheduledActionsResult struct {
-	NextToken                   string                       `xml:"NextToken"`
-	ScheduledUpdateGroupActions []ScheduledUpdateGroupAction `xml:"DescribeScheduledActions>ScheduledUpdateGroups>member"`
+	NextToken                   string                       `xml:"DescribeScheduledActionsResult>NextToken"`
+	ScheduledUpdateGroupActions []ScheduledUpdateGroupAction `xml:"DescribeScheduledActionsResult>ScheduledUpdateGroupActions>member"`
 }
 
 // ScheduledActionsRequestParams contains the items that can be specified when making
@@ -437,6 +488,7 @@ type ScheduledActionsRequestParams struct {
 }
 
 // PutScheduledActionRequestParams contains the details of the ScheduledAction to be added.
+// TimeZone is the IANA time zone Recurrence is evaluated in, UTC if empty.
 type PutScheduledActionRequestParams struct {
 	AutoScalingGroupName string
 	DesiredCapacity      int64
@@ -446,6 +498,7 @@ type PutScheduledActionRequestParams struct {
 	Recurrence           string
 	ScheduledActionName  string
 	StartTime            string
+	TimeZone             string
 }
 
 // DeleteScheduledActionRequestParams contains the details of the scheduled action to delete.
@@ -507,9 +560,15 @@ func (as *AutoScaling) PutScheduledUpdateGroupAction(rp PutScheduledActionReques
 	if rp.MinSize > 0 {
 		params["MinSize"] = strconv.FormatInt(rp.MinSize, 10)
 	}
+	if rp.DesiredCapacity > 0 {
+		params["DesiredCapacity"] = strconv.FormatInt(rp.DesiredCapacity, 10)
+	}
 	if len(rp.Recurrence) > 0 {
 		params["Recurrence"] = rp.Recurrence
 	}
+	if len(rp.TimeZone) > 0 {
+		params["TimeZone"] = rp.TimeZone
+	}
 	err = as.query(params, resp)
 	if err != nil {
 		return nil, err
@@ -530,3 +589,450 @@ func (as *AutoScaling) DeleteScheduledAction(rp DeleteScheduledActionRequestPara
 	}
 	return resp, nil
 }
+
+// ----------------------------------------------------------------------------
+// Autoscaling predictive scaling types and methods
+
+// Policy types of PutScalingPolicy.
+const (
+	PolicyTypeSimpleScaling     = "SimpleScaling"
+	PolicyTypeStepScaling       = "StepScaling"
+	PolicyTypeTargetTracking    = "TargetTrackingScaling"
+	PolicyTypePredictiveScaling = "PredictiveScaling"
+)
+
+// Modes of a predictive scaling policy. ForecastOnly lets forecasts be
+// evaluated before the policy is allowed to scale the group.
+const (
+	PredictiveScalingModeForecastOnly     = "ForecastOnly"
+	PredictiveScalingModeForecastAndScale = "ForecastAndScale"
+)
+
+// What a predictive scaling policy does when the forecast capacity exceeds
+// the maximum size of the group.
+const (
+	MaxCapacityBreachHonor    = "HonorMaxCapacity"
+	MaxCapacityBreachIncrease = "IncreaseMaxCapacity"
+)
+
+// PredefinedMetricSpecification selects a predefined metric of a
+// predictive scaling policy, such as "ASGCPUUtilization" for a metric pair
+// or scaling metric, or "ASGTotalCPUUtilization" for a load metric.
+// ResourceLabel identifies the target group of the "ALBRequestCount"
+// metrics.
+type PredefinedMetricSpecification struct {
+	PredefinedMetricType string `xml:"PredefinedMetricType"`
+	ResourceLabel        string `xml:"ResourceLabel"`
+}
+
+// PredictiveScalingMetricSpecification gives the metrics a predictive
+// scaling policy forecasts and the value of the scaling metric to keep the
+// group at. Either the metric pair, or both the scaling and load metrics,
+// must be set.
+type PredictiveScalingMetricSpecification struct {
+	TargetValue                          float64                        `xml:"TargetValue"`
+	PredefinedMetricPairSpecification    *PredefinedMetricSpecification `xml:"PredefinedMetricPairSpecification"`
+	PredefinedScalingMetricSpecification *PredefinedMetricSpecification `xml:"PredefinedScalingMetricSpecification"`
+	PredefinedLoadMetricSpecification    *PredefinedMetricSpecification `xml:"PredefinedLoadMetricSpecification"`
+}
+
+// PredictiveScalingConfiguration contains the settings of a predictive
+// scaling policy. SchedulingBufferTime is the number of seconds instances
+// are launched ahead of the forecast need. MaxCapacityBuffer is the
+// percentage the forecast capacity may exceed the maximum size of the group
+// by, with MaxCapacityBreachIncrease.
+type PredictiveScalingConfiguration struct {
+	MetricSpecifications      []PredictiveScalingMetricSpecification `xml:"MetricSpecifications>member"`
+	Mode                      string                                 `xml:"Mode"`
+	SchedulingBufferTime      int64                                  `xml:"SchedulingBufferTime"`
+	MaxCapacityBreachBehavior string                                 `xml:"MaxCapacityBreachBehavior"`
+	MaxCapacityBuffer         int64                                  `xml:"MaxCapacityBuffer"`
+}
+
+// PutScalingPolicyRequestParams contains the details of the scaling policy
+// to create or update. AdjustmentType, ScalingAdjustment and Cooldown only
+// apply to simple scaling policies, and PredictiveScalingConfiguration to
+// predictive scaling policies.
+type PutScalingPolicyRequestParams struct {
+	AutoScalingGroupName           string
+	PolicyName                     string
+	PolicyType                     string
+	AdjustmentType                 string
+	ScalingAdjustment              int64
+	Cooldown                       int64
+	PredictiveScalingConfiguration *PredictiveScalingConfiguration
+}
+
+// Alarm is a CloudWatch alarm of a scaling policy.
+type Alarm struct {
+	AlarmARN  string `xml:"AlarmARN"`
+	AlarmName string `xml:"AlarmName"`
+}
+
+// PutScalingPolicyResp is returned from the PutScalingPolicy request.
+type PutScalingPolicyResp struct {
+	PolicyARN string  `xml:"PutScalingPolicyResult>PolicyARN"`
+	Alarms    []Alarm `xml:"PutScalingPolicyResult>Alarms>member"`
+	RequestId string  `xml:"ResponseMetadata>RequestId"`
+}
+
+// PutScalingPolicy creates or updates a scaling policy of an AutoScaling
+// group.
+func (as *AutoScaling) PutScalingPolicy(rp PutScalingPolicyRequestParams) (
+	resp *PutScalingPolicyResp, err error) {
+	resp = &PutScalingPolicyResp{}
+	params := makeParams("PutScalingPolicy")
+	params["AutoScalingGroupName"] = rp.AutoScalingGroupName
+	params["PolicyName"] = rp.PolicyName
+	if rp.PolicyType != "" {
+		params["PolicyType"] = rp.PolicyType
+	}
+	if rp.AdjustmentType != "" {
+		params["AdjustmentType"] = rp.AdjustmentType
+		params["ScalingAdjustment"] = strconv.FormatInt(rp.ScalingAdjustment, 10)
+	}
+	if rp.Cooldown > 0 {
+		params["Cooldown"] = strconv.FormatInt(rp.Cooldown, 10)
+	}
+	if c := rp.PredictiveScalingConfiguration; c != nil {
+		prefix := "PredictiveScalingConfiguration."
+		for i, spec := range c.MetricSpecifications {
+			specPrefix := prefix + "MetricSpecifications.member." + strconv.Itoa(i+1) + "."
+			params[specPrefix+"TargetValue"] = strconv.FormatFloat(spec.TargetValue, 'f', -1, 64)
+			addPredefinedMetricParams(params, specPrefix+"PredefinedMetricPairSpecification.", spec.PredefinedMetricPairSpecification)
+			addPredefinedMetricParams(params, specPrefix+"PredefinedScalingMetricSpecification.", spec.PredefinedScalingMetricSpecification)
+			addPredefinedMetricParams(params, specPrefix+"PredefinedLoadMetricSpecification.", spec.PredefinedLoadMetricSpecification)
+		}
+		if c.Mode != "" {
+			params[prefix+"Mode"] = c.Mode
+		}
+		if c.SchedulingBufferTime > 0 {
+			params[prefix+"SchedulingBufferTime"] = strconv.FormatInt(c.SchedulingBufferTime, 10)
+		}
+		if c.MaxCapacityBreachBehavior != "" {
+			params[prefix+"MaxCapacityBreachBehavior"] = c.MaxCapacityBreachBehavior
+		}
+		if c.MaxCapacityBuffer > 0 {
+			params[prefix+"MaxCapacityBuffer"] = strconv.FormatInt(c.MaxCapacityBuffer, 10)
+		}
+	}
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func addPredefinedMetricParams(params map[string]string, prefix string, spec *PredefinedMetricSpecification) {
+	if spec == nil {
+		return
+	}
+	params[prefix+"PredefinedMetricType"] = spec.PredefinedMetricType
+	if spec.ResourceLabel != "" {
+		params[prefix+"ResourceLabel"] = spec.ResourceLabel
+	}
+}
+
+// LoadForecast is the forecast of a load metric of a predictive scaling
+// policy.
+type LoadForecast struct {
+	Timestamps          []time.Time                          `xml:"Timestamps>member"`
+	Values              []float64                            `xml:"Values>member"`
+	MetricSpecification PredictiveScalingMetricSpecification `xml:"MetricSpecification"`
+}
+
+// CapacityForecast is the forecast capacity of an AutoScaling group.
+type CapacityForecast struct {
+	Timestamps []time.Time `xml:"Timestamps>member"`
+	Values     []float64   `xml:"Values>member"`
+}
+
+// GetPredictiveScalingForecastResp is returned from the
+// GetPredictiveScalingForecast request.
+type GetPredictiveScalingForecastResp struct {
+	LoadForecast     []LoadForecast   `xml:"GetPredictiveScalingForecastResult>LoadForecast>member"`
+	CapacityForecast CapacityForecast `xml:"GetPredictiveScalingForecastResult>CapacityForecast"`
+	UpdateTime       time.Time        `xml:"GetPredictiveScalingForecastResult>UpdateTime"`
+	RequestId        string           `xml:"ResponseMetadata>RequestId"`
+}
+
+// GetPredictiveScalingForecast returns the load and capacity forecasts of a
+// predictive scaling policy between startTime and endTime. Forecasts are
+// hourly, and available for up to 56 days in the past and 2 days in the
+// future.
+func (as *AutoScaling) GetPredictiveScalingForecast(asgName, policyName string, startTime, endTime time.Time) (
+	resp *GetPredictiveScalingForecastResp, err error) {
+	resp = &GetPredictiveScalingForecastResp{}
+	params := makeParams("GetPredictiveScalingForecast")
+	params["AutoScalingGroupName"] = asgName
+	params["PolicyName"] = policyName
+	params["StartTime"] = startTime.In(time.UTC).Format(time.RFC3339)
+	params["EndTime"] = endTime.In(time.UTC).Format(time.RFC3339)
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ----------------------------------------------------------------------------
+// Autoscaling lifecycle hook types and methods
+
+// Transitions a lifecycle hook can pause instances at.
+const (
+	LifecycleTransitionLaunching   = "autoscaling:EC2_INSTANCE_LAUNCHING"
+	LifecycleTransitionTerminating = "autoscaling:EC2_INSTANCE_TERMINATING"
+)
+
+// Results of a lifecycle action. A lifecycle hook takes its DefaultResult
+// when its HeartbeatTimeout elapses before the action is completed.
+const (
+	LifecycleActionResultContinue = "CONTINUE"
+	LifecycleActionResultAbandon  = "ABANDON"
+)
+
+// PutLifecycleHookRequestParams contains the details of the lifecycle hook
+// to create or update. HeartbeatTimeout is in seconds. Notifications are
+// sent to EventBridge unless NotificationTargetARN, with the RoleARN
+// allowing to publish to it, is set.
+type PutLifecycleHookRequestParams struct {
+	AutoScalingGroupName  string
+	LifecycleHookName     string
+	LifecycleTransition   string
+	DefaultResult         string
+	HeartbeatTimeout      int64
+	NotificationMetadata  string
+	NotificationTargetARN string
+	RoleARN               string
+}
+
+// PutLifecycleHook creates or updates a lifecycle hook of an AutoScaling
+// group, which keeps the instances launched or terminated by the group in
+// a wait state until their lifecycle action is completed.
+func (as *AutoScaling) PutLifecycleHook(rp PutLifecycleHookRequestParams) (
+	resp *SimpleResp, err error) {
+	resp = &SimpleResp{}
+	params := makeParams("PutLifecycleHook")
+	params["AutoScalingGroupName"] = rp.AutoScalingGroupName
+	params["LifecycleHookName"] = rp.LifecycleHookName
+	if rp.LifecycleTransition != "" {
+		params["LifecycleTransition"] = rp.LifecycleTransition
+	}
+	if rp.DefaultResult != "" {
+		params["DefaultResult"] = rp.DefaultResult
+	}
+	if rp.HeartbeatTimeout > 0 {
+		params["HeartbeatTimeout"] = strconv.FormatInt(rp.HeartbeatTimeout, 10)
+	}
+	if rp.NotificationMetadata != "" {
+		params["NotificationMetadata"] = rp.NotificationMetadata
+	}
+	if rp.NotificationTargetARN != "" {
+		params["NotificationTargetARN"] = rp.NotificationTargetARN
+	}
+	if rp.RoleARN != "" {
+		params["RoleARN"] = rp.RoleARN
+	}
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CompleteLifecycleActionRequestParams identifies the lifecycle action to
+// complete, either by the LifecycleActionToken of its notification or by
+// its InstanceId.
+type CompleteLifecycleActionRequestParams struct {
+	AutoScalingGroupName  string
+	LifecycleHookName     string
+	LifecycleActionResult string
+	LifecycleActionToken  string
+	InstanceId            string
+}
+
+// CompleteLifecycleAction lets an instance waiting on a lifecycle hook
+// proceed with its launch or termination, or, with
+// LifecycleActionResultAbandon, have a launching instance terminated.
+func (as *AutoScaling) CompleteLifecycleAction(rp CompleteLifecycleActionRequestParams) (
+	resp *SimpleResp, err error) {
+	resp = &SimpleResp{}
+	params := makeParams("CompleteLifecycleAction")
+	params["AutoScalingGroupName"] = rp.AutoScalingGroupName
+	params["LifecycleHookName"] = rp.LifecycleHookName
+	params["LifecycleActionResult"] = rp.LifecycleActionResult
+	if rp.LifecycleActionToken != "" {
+		params["LifecycleActionToken"] = rp.LifecycleActionToken
+	}
+	if rp.InstanceId != "" {
+		params["InstanceId"] = rp.InstanceId
+	}
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ----------------------------------------------------------------------------
+// Autoscaling instance refresh types and methods
+
+// Statuses of an instance refresh.
+const (
+	InstanceRefreshStatusPending            = "Pending"
+	InstanceRefreshStatusInProgress         = "InProgress"
+	InstanceRefreshStatusSuccessful         = "Successful"
+	InstanceRefreshStatusFailed             = "Failed"
+	InstanceRefreshStatusCancelling         = "Cancelling"
+	InstanceRefreshStatusCancelled          = "Cancelled"
+	InstanceRefreshStatusBaking             = "Baking"
+	InstanceRefreshStatusRollbackInProgress = "RollbackInProgress"
+	InstanceRefreshStatusRollbackFailed     = "RollbackFailed"
+	InstanceRefreshStatusRollbackSuccessful = "RollbackSuccessful"
+)
+
+// LaunchTemplateSpecification identifies a launch template by ID or name.
+// Version is a version number, "$Latest" or "$Default".
+type LaunchTemplateSpecification struct {
+	LaunchTemplateId   string `xml:"LaunchTemplateId"`
+	LaunchTemplateName string `xml:"LaunchTemplateName"`
+	Version            string `xml:"Version"`
+}
+
+// DesiredConfiguration is the configuration the instances of a group are
+// replaced with by an instance refresh. The configuration of the group is
+// updated to it once the refresh succeeds.
+type DesiredConfiguration struct {
+	LaunchTemplate *LaunchTemplateSpecification `xml:"LaunchTemplate"`
+}
+
+// RefreshPreferences controls how an instance refresh replaces instances.
+// MinHealthyPercentage and MaxHealthyPercentage bound the capacity kept in
+// service, and default to 90 and 100. InstanceWarmup and CheckpointDelay
+// are in seconds. The refresh pauses for CheckpointDelay each time the
+// share of replaced instances reaches one of CheckpointPercentages. With
+// SkipMatching, instances already matching the desired configuration are
+// kept, and with AutoRollback a failed refresh restores the previous
+// configuration.
+type RefreshPreferences struct {
+	MinHealthyPercentage  int64   `xml:"MinHealthyPercentage"`
+	MaxHealthyPercentage  int64   `xml:"MaxHealthyPercentage"`
+	InstanceWarmup        int64   `xml:"InstanceWarmup"`
+	CheckpointPercentages []int64 `xml:"CheckpointPercentages>member"`
+	CheckpointDelay       int64   `xml:"CheckpointDelay"`
+	SkipMatching          bool    `xml:"SkipMatching"`
+	AutoRollback          bool    `xml:"AutoRollback"`
+}
+
+// StartInstanceRefreshRequestParams contains the details of the instance
+// refresh to start. Strategy is "Rolling", the only strategy, if empty.
+// Without DesiredConfiguration, instances are replaced with the current
+// configuration of the group.
+type StartInstanceRefreshRequestParams struct {
+	AutoScalingGroupName string
+	Strategy             string
+	DesiredConfiguration *DesiredConfiguration
+	Preferences          *RefreshPreferences
+}
+
+// StartInstanceRefreshResp is returned from the StartInstanceRefresh request.
+type StartInstanceRefreshResp struct {
+	InstanceRefreshId string `xml:"StartInstanceRefreshResult>InstanceRefreshId"`
+	RequestId         string `xml:"ResponseMetadata>RequestId"`
+}
+
+// StartInstanceRefresh starts replacing the instances of an AutoScaling
+// group, for example to roll out a new AMI. Only one instance refresh of a
+// group can be in progress at a time.
+func (as *AutoScaling) StartInstanceRefresh(rp StartInstanceRefreshRequestParams) (
+	resp *StartInstanceRefreshResp, err error) {
+	resp = &StartInstanceRefreshResp{}
+	params := makeParams("StartInstanceRefresh")
+	params["AutoScalingGroupName"] = rp.AutoScalingGroupName
+	if rp.Strategy != "" {
+		params["Strategy"] = rp.Strategy
+	}
+	if c := rp.DesiredConfiguration; c != nil && c.LaunchTemplate != nil {
+		prefix := "DesiredConfiguration.LaunchTemplate."
+		if c.LaunchTemplate.LaunchTemplateId != "" {
+			params[prefix+"LaunchTemplateId"] = c.LaunchTemplate.LaunchTemplateId
+		}
+		if c.LaunchTemplate.LaunchTemplateName != "" {
+			params[prefix+"LaunchTemplateName"] = c.LaunchTemplate.LaunchTemplateName
+		}
+		if c.LaunchTemplate.Version != "" {
+			params[prefix+"Version"] = c.LaunchTemplate.Version
+		}
+	}
+	if p := rp.Preferences; p != nil {
+		prefix := "Preferences."
+		if p.MinHealthyPercentage > 0 {
+			params[prefix+"MinHealthyPercentage"] = strconv.FormatInt(p.MinHealthyPercentage, 10)
+		}
+		if p.MaxHealthyPercentage > 0 {
+			params[prefix+"MaxHealthyPercentage"] = strconv.FormatInt(p.MaxHealthyPercentage, 10)
+		}
+		if p.InstanceWarmup > 0 {
+			params[prefix+"InstanceWarmup"] = strconv.FormatInt(p.InstanceWarmup, 10)
+		}
+		for i, percentage := range p.CheckpointPercentages {
+			params[prefix+"CheckpointPercentages.member."+strconv.Itoa(i+1)] = strconv.FormatInt(percentage, 10)
+		}
+		if p.CheckpointDelay > 0 {
+			params[prefix+"CheckpointDelay"] = strconv.FormatInt(p.CheckpointDelay, 10)
+		}
+		if p.SkipMatching {
+			params[prefix+"SkipMatching"] = "true"
+		}
+		if p.AutoRollback {
+			params[prefix+"AutoRollback"] = "true"
+		}
+	}
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// InstanceRefresh describes an instance refresh of an AutoScaling group.
+// EndTime is zero while the refresh is in progress.
+type InstanceRefresh struct {
+	InstanceRefreshId    string               `xml:"InstanceRefreshId"`
+	AutoScalingGroupName string               `xml:"AutoScalingGroupName"`
+	Status               string               `xml:"Status"`
+	StatusReason         string               `xml:"StatusReason"`
+	StartTime            time.Time            `xml:"StartTime"`
+	EndTime              time.Time            `xml:"EndTime"`
+	PercentageComplete   int64                `xml:"PercentageComplete"`
+	InstancesToUpdate    int64                `xml:"InstancesToUpdate"`
+	Preferences          RefreshPreferences   `xml:"Preferences"`
+	DesiredConfiguration DesiredConfiguration `xml:"DesiredConfiguration"`
+}
+
+// DescribeInstanceRefreshesResp is returned from the
+// DescribeInstanceRefreshes request.
+type DescribeInstanceRefreshesResp struct {
+	InstanceRefreshes []InstanceRefresh `xml:"DescribeInstanceRefreshesResult>InstanceRefreshes>member"`
+	NextToken         string            `xml:"DescribeInstanceRefreshesResult>NextToken"`
+	RequestId         string            `xml:"ResponseMetadata>RequestId"`
+}
+
+// DescribeInstanceRefreshes returns the instance refreshes of an
+// AutoScaling group, most recent first, or only those of the given IDs.
+// nextToken is the NextToken of the previous page, if any.
+func (as *AutoScaling) DescribeInstanceRefreshes(asgName string, instanceRefreshIds []string, nextToken string) (
+	resp *DescribeInstanceRefreshesResp, err error) {
+	resp = &DescribeInstanceRefreshesResp{}
+	params := makeParams("DescribeInstanceRefreshes")
+	params["AutoScalingGroupName"] = asgName
+	addParamsList(params, "InstanceRefreshIds.member", instanceRefreshIds)
+	if nextToken != "" {
+		params["NextToken"] = nextToken
+	}
+	err = as.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
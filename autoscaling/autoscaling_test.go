@@ -4,6 +4,7 @@ import (
 	"github.com/zackbloom/goamz/autoscaling/astest"
 	"github.com/zackbloom/goamz/aws"
 	"testing"
+	"time"
 )
 
 var testServer = astest.NewHTTPServer()
@@ -200,3 +201,349 @@ func TestAutoScalingGroup(t *testing.T) {
 	}
 	testServer.Flush()
 }
+
+func TestLoadBalancerAttachment(t *testing.T) {
+	if _, err := aws.EnvAuth(); err == nil {
+		t.Skip("load balancer attachment is only tested against the mock server")
+	}
+	testServer.Start()
+	defer testServer.Flush()
+	as := New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{AutoScalingEndpoint: testServer.URL})
+
+	testServer.Response(200, nil, astest.AttachLoadBalancersResponse)
+	resp, err := as.AttachLoadBalancers("ASGTest1", []string{"lb-1", "lb-2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := testServer.WaitRequest()
+	if got := req.Form.Get("Action"); got != "AttachLoadBalancers" {
+		t.Errorf("Action = %q", got)
+	}
+	if got := req.Form.Get("AutoScalingGroupName"); got != "ASGTest1" {
+		t.Errorf("AutoScalingGroupName = %q", got)
+	}
+	if got := req.Form.Get("LoadBalancerNames.member.1"); got != "lb-1" {
+		t.Errorf("LoadBalancerNames.member.1 = %q", got)
+	}
+	if got := req.Form.Get("LoadBalancerNames.member.2"); got != "lb-2" {
+		t.Errorf("LoadBalancerNames.member.2 = %q", got)
+	}
+	if resp.RequestId != "3a5d8f0c-2c41-11e6-b4d8-0b5d3c3e6b11" {
+		t.Errorf("RequestId = %q", resp.RequestId)
+	}
+
+	arn := "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067"
+	testServer.Response(200, nil, astest.AttachLoadBalancerTargetGroupsResponse)
+	_, err = as.AttachLoadBalancerTargetGroups("ASGTest1", []string{arn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = testServer.WaitRequest()
+	if got := req.Form.Get("Action"); got != "AttachLoadBalancerTargetGroups" {
+		t.Errorf("Action = %q", got)
+	}
+	if got := req.Form.Get("TargetGroupARNs.member.1"); got != arn {
+		t.Errorf("TargetGroupARNs.member.1 = %q", got)
+	}
+
+	testServer.Response(200, nil, astest.DetachLoadBalancerTargetGroupsResponse)
+	_, err = as.DetachLoadBalancerTargetGroups("ASGTest1", []string{arn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = testServer.WaitRequest()
+	if got := req.Form.Get("Action"); got != "DetachLoadBalancerTargetGroups" {
+		t.Errorf("Action = %q", got)
+	}
+	if got := req.Form.Get("TargetGroupARNs.member.1"); got != arn {
+		t.Errorf("TargetGroupARNs.member.1 = %q", got)
+	}
+}
+
+func TestPredictiveScaling(t *testing.T) {
+	if _, err := aws.EnvAuth(); err == nil {
+		t.Skip("predictive scaling is only tested against the mock server")
+	}
+	testServer.Start()
+	defer testServer.Flush()
+	as := New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{AutoScalingEndpoint: testServer.URL})
+
+	testServer.Response(200, nil, astest.PutScalingPolicyResponse)
+	resp, err := as.PutScalingPolicy(PutScalingPolicyRequestParams{
+		AutoScalingGroupName: "ASGTest1",
+		PolicyName:           "cpu40-predictive",
+		PolicyType:           PolicyTypePredictiveScaling,
+		PredictiveScalingConfiguration: &PredictiveScalingConfiguration{
+			MetricSpecifications: []PredictiveScalingMetricSpecification{{
+				TargetValue:                       40,
+				PredefinedMetricPairSpecification: &PredefinedMetricSpecification{PredefinedMetricType: "ASGCPUUtilization"},
+			}},
+			Mode:                      PredictiveScalingModeForecastAndScale,
+			SchedulingBufferTime:      600,
+			MaxCapacityBreachBehavior: MaxCapacityBreachIncrease,
+			MaxCapacityBuffer:         10,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := testServer.WaitRequest()
+	expected := map[string]string{
+		"Action":               "PutScalingPolicy",
+		"AutoScalingGroupName": "ASGTest1",
+		"PolicyName":           "cpu40-predictive",
+		"PolicyType":           "PredictiveScaling",
+		"PredictiveScalingConfiguration.MetricSpecifications.member.1.TargetValue":                                            "40",
+		"PredictiveScalingConfiguration.MetricSpecifications.member.1.PredefinedMetricPairSpecification.PredefinedMetricType": "ASGCPUUtilization",
+		"PredictiveScalingConfiguration.Mode":                      "ForecastAndScale",
+		"PredictiveScalingConfiguration.SchedulingBufferTime":      "600",
+		"PredictiveScalingConfiguration.MaxCapacityBreachBehavior": "IncreaseMaxCapacity",
+		"PredictiveScalingConfiguration.MaxCapacityBuffer":         "10",
+	}
+	for k, v := range expected {
+		if got := req.Form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	for _, k := range []string{"AdjustmentType", "ScalingAdjustment", "Cooldown",
+		"PredictiveScalingConfiguration.MetricSpecifications.member.1.PredefinedMetricPairSpecification.ResourceLabel",
+		"PredictiveScalingConfiguration.MetricSpecifications.member.1.PredefinedLoadMetricSpecification.PredefinedMetricType"} {
+		if _, ok := req.Form[k]; ok {
+			t.Errorf("%s should not be set", k)
+		}
+	}
+	if resp.PolicyARN != "arn:aws:autoscaling:us-west-2:123456789012:scalingPolicy:0ab3e4f9-7f2a-4c1b-9d5e-8a6b3c2d1e0f:autoScalingGroupName/ASGTest1:policyName/cpu40-predictive" {
+		t.Errorf("PolicyARN = %q", resp.PolicyARN)
+	}
+
+	testServer.Response(200, nil, astest.GetPredictiveScalingForecastResponse)
+	start := time.Date(2021, 5, 19, 17, 0, 0, 0, time.UTC)
+	forecast, err := as.GetPredictiveScalingForecast("ASGTest1", "cpu40-predictive", start, start.Add(2*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = testServer.WaitRequest()
+	if got := req.Form.Get("Action"); got != "GetPredictiveScalingForecast" {
+		t.Errorf("Action = %q", got)
+	}
+	if got := req.Form.Get("StartTime"); got != "2021-05-19T17:00:00Z" {
+		t.Errorf("StartTime = %q", got)
+	}
+	if got := req.Form.Get("EndTime"); got != "2021-05-19T19:00:00Z" {
+		t.Errorf("EndTime = %q", got)
+	}
+	if len(forecast.LoadForecast) != 1 {
+		t.Fatalf("LoadForecast = %v", forecast.LoadForecast)
+	}
+	load := forecast.LoadForecast[0]
+	if len(load.Values) != 2 || load.Values[1] != 176.5 || !load.Timestamps[0].Equal(start) {
+		t.Errorf("LoadForecast[0] = %+v", load)
+	}
+	if load.MetricSpecification.PredefinedMetricPairSpecification.PredefinedMetricType != "ASGCPUUtilization" {
+		t.Errorf("MetricSpecification = %+v", load.MetricSpecification)
+	}
+	if len(forecast.CapacityForecast.Values) != 2 || forecast.CapacityForecast.Values[1] != 6 {
+		t.Errorf("CapacityForecast = %+v", forecast.CapacityForecast)
+	}
+	if !forecast.UpdateTime.Equal(start.Add(30 * time.Minute)) {
+		t.Errorf("UpdateTime = %v", forecast.UpdateTime)
+	}
+}
+
+func TestScheduledActions(t *testing.T) {
+	if _, err := aws.EnvAuth(); err == nil {
+		t.Skip("scheduled actions are only tested against the mock server")
+	}
+	testServer.Start()
+	defer testServer.Flush()
+	as := New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{AutoScalingEndpoint: testServer.URL})
+
+	testServer.Response(200, nil, astest.PutScheduledUpdateGroupActionResponse)
+	_, err := as.PutScheduledUpdateGroupAction(PutScheduledActionRequestParams{
+		AutoScalingGroupName: "ASGTest1",
+		ScheduledActionName:  "business-hours",
+		DesiredCapacity:      6,
+		Recurrence:           "0 8 * * 1-5",
+		TimeZone:             "Europe/Paris",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := testServer.WaitRequest()
+	expected := map[string]string{
+		"Action":          "PutScheduledUpdateGroupAction",
+		"DesiredCapacity": "6",
+		"Recurrence":      "0 8 * * 1-5",
+		"TimeZone":        "Europe/Paris",
+	}
+	for k, v := range expected {
+		if got := req.Form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+
+	testServer.Response(200, nil, astest.DescribeScheduledActionsResponse)
+	resp, err := as.DescribeScheduledActions(ScheduledActionsRequestParams{AutoScalingGroupName: "ASGTest1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testServer.WaitRequest()
+	if len(resp.ScheduledUpdateGroupActions) != 1 {
+		t.Fatalf("ScheduledUpdateGroupActions = %v", resp.ScheduledUpdateGroupActions)
+	}
+	action := resp.ScheduledUpdateGroupActions[0]
+	if action.ScheduledActionName != "SATest1" || action.Recurrence != "30 0 1 1,6,12 *" || action.MaxSize != 4 {
+		t.Errorf("ScheduledUpdateGroupActions[0] = %+v", action)
+	}
+}
+
+func TestLifecycleHooks(t *testing.T) {
+	if _, err := aws.EnvAuth(); err == nil {
+		t.Skip("lifecycle hooks are only tested against the mock server")
+	}
+	testServer.Start()
+	defer testServer.Flush()
+	as := New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{AutoScalingEndpoint: testServer.URL})
+
+	testServer.Response(200, nil, astest.PutLifecycleHookResponse)
+	_, err := as.PutLifecycleHook(PutLifecycleHookRequestParams{
+		AutoScalingGroupName: "ASGTest1",
+		LifecycleHookName:    "drain",
+		LifecycleTransition:  LifecycleTransitionTerminating,
+		DefaultResult:        LifecycleActionResultContinue,
+		HeartbeatTimeout:     300,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := testServer.WaitRequest()
+	expected := map[string]string{
+		"Action":               "PutLifecycleHook",
+		"AutoScalingGroupName": "ASGTest1",
+		"LifecycleHookName":    "drain",
+		"LifecycleTransition":  "autoscaling:EC2_INSTANCE_TERMINATING",
+		"DefaultResult":        "CONTINUE",
+		"HeartbeatTimeout":     "300",
+	}
+	for k, v := range expected {
+		if got := req.Form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	for _, k := range []string{"NotificationMetadata", "NotificationTargetARN", "RoleARN"} {
+		if _, ok := req.Form[k]; ok {
+			t.Errorf("%s should not be set", k)
+		}
+	}
+
+	testServer.Response(200, nil, astest.CompleteLifecycleActionResponse)
+	resp, err := as.CompleteLifecycleAction(CompleteLifecycleActionRequestParams{
+		AutoScalingGroupName:  "ASGTest1",
+		LifecycleHookName:     "drain",
+		LifecycleActionResult: LifecycleActionResultContinue,
+		InstanceId:            "i-0645704820a8e83ff",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = testServer.WaitRequest()
+	expected = map[string]string{
+		"Action":                "CompleteLifecycleAction",
+		"LifecycleActionResult": "CONTINUE",
+		"InstanceId":            "i-0645704820a8e83ff",
+	}
+	for k, v := range expected {
+		if got := req.Form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	if _, ok := req.Form["LifecycleActionToken"]; ok {
+		t.Errorf("LifecycleActionToken should not be set")
+	}
+	if resp.RequestId != "7b8f4d63-2c41-11e6-a1c2-5e8f3b9d2ea4" {
+		t.Errorf("RequestId = %q", resp.RequestId)
+	}
+}
+
+func TestInstanceRefresh(t *testing.T) {
+	if _, err := aws.EnvAuth(); err == nil {
+		t.Skip("instance refresh is only tested against the mock server")
+	}
+	testServer.Start()
+	defer testServer.Flush()
+	as := New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{AutoScalingEndpoint: testServer.URL})
+
+	testServer.Response(200, nil, astest.StartInstanceRefreshResponse)
+	resp, err := as.StartInstanceRefresh(StartInstanceRefreshRequestParams{
+		AutoScalingGroupName: "ASGTest1",
+		DesiredConfiguration: &DesiredConfiguration{
+			LaunchTemplate: &LaunchTemplateSpecification{LaunchTemplateName: "web", Version: "$Latest"},
+		},
+		Preferences: &RefreshPreferences{
+			MinHealthyPercentage:  90,
+			InstanceWarmup:        60,
+			CheckpointPercentages: []int64{50, 100},
+			CheckpointDelay:       300,
+			SkipMatching:          true,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := testServer.WaitRequest()
+	expected := map[string]string{
+		"Action":               "StartInstanceRefresh",
+		"AutoScalingGroupName": "ASGTest1",
+		"DesiredConfiguration.LaunchTemplate.LaunchTemplateName": "web",
+		"DesiredConfiguration.LaunchTemplate.Version":            "$Latest",
+		"Preferences.MinHealthyPercentage":                       "90",
+		"Preferences.InstanceWarmup":                             "60",
+		"Preferences.CheckpointPercentages.member.1":             "50",
+		"Preferences.CheckpointPercentages.member.2":             "100",
+		"Preferences.CheckpointDelay":                            "300",
+		"Preferences.SkipMatching":                               "true",
+	}
+	for k, v := range expected {
+		if got := req.Form.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	for _, k := range []string{"Strategy", "DesiredConfiguration.LaunchTemplate.LaunchTemplateId",
+		"Preferences.MaxHealthyPercentage", "Preferences.AutoRollback"} {
+		if _, ok := req.Form[k]; ok {
+			t.Errorf("%s should not be set", k)
+		}
+	}
+	if resp.InstanceRefreshId != "08b91cf7-8fa6-48af-b6a6-d227f40f1b9b" {
+		t.Errorf("InstanceRefreshId = %q", resp.InstanceRefreshId)
+	}
+
+	testServer.Response(200, nil, astest.DescribeInstanceRefreshesResponse)
+	refreshes, err := as.DescribeInstanceRefreshes("ASGTest1", []string{resp.InstanceRefreshId}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = testServer.WaitRequest()
+	if got := req.Form.Get("InstanceRefreshIds.member.1"); got != resp.InstanceRefreshId {
+		t.Errorf("InstanceRefreshIds.member.1 = %q", got)
+	}
+	if _, ok := req.Form["NextToken"]; ok {
+		t.Errorf("NextToken should not be set")
+	}
+	if len(refreshes.InstanceRefreshes) != 2 || refreshes.NextToken != "bmV4dA==" {
+		t.Fatalf("DescribeInstanceRefreshes = %+v", refreshes)
+	}
+	current := refreshes.InstanceRefreshes[0]
+	if current.Status != InstanceRefreshStatusInProgress || current.PercentageComplete != 50 || !current.EndTime.IsZero() {
+		t.Errorf("InstanceRefreshes[0] = %+v", current)
+	}
+	if !current.StartTime.Equal(time.Date(2020, 6, 2, 18, 11, 27, 0, time.UTC)) {
+		t.Errorf("StartTime = %v", current.StartTime)
+	}
+	if len(current.Preferences.CheckpointPercentages) != 2 || !current.Preferences.SkipMatching {
+		t.Errorf("Preferences = %+v", current.Preferences)
+	}
+	if previous := refreshes.InstanceRefreshes[1]; previous.Status != InstanceRefreshStatusSuccessful || previous.EndTime.IsZero() {
+		t.Errorf("InstanceRefreshes[1] = %+v", previous)
+	}
+}
@@ -0,0 +1,85 @@
+package aws
+
+import (
+	"encoding/xml"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+var accountIds = struct {
+	sync.Mutex
+	m map[string]string // By access key.
+}{m: map[string]string{}}
+
+// AccountID returns the ID of the AWS account that auth belongs to, as
+// needed to build ARNs. It is looked up with the STS GetCallerIdentity
+// action the first time it is asked for a given access key and cached
+// afterwards.
+//
+// AccountID uses the global STS endpoint, which only knows the accounts of
+// the "aws" partition; use AccountIDForRegion with a China or GovCloud
+// region for the others.
+//
+// See http://docs.aws.amazon.com/STS/latest/APIReference/API_GetCallerIdentity.html
+func AccountID(auth Auth) (string, error) {
+	return AccountIDForRegion(auth, USEast)
+}
+
+// AccountIDForRegion is like AccountID but asks the STS endpoint of region.
+func AccountIDForRegion(auth Auth, region Region) (string, error) {
+	accountIds.Lock()
+	id, ok := accountIds.m[auth.AccessKey]
+	accountIds.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	id, err := getCallerAccount(auth, region)
+	if err != nil {
+		return "", err
+	}
+
+	accountIds.Lock()
+	accountIds.m[auth.AccessKey] = id
+	accountIds.Unlock()
+	return id, nil
+}
+
+func getCallerAccount(auth Auth, region Region) (string, error) {
+	params := url.Values{"Action": {"GetCallerIdentity"}, "Version": {"2011-06-15"}}
+	hreq, err := http.NewRequest("POST", region.STSEndpoint+"/", strings.NewReader(params.Encode()))
+	if err != nil {
+		return "", err
+	}
+	hreq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(ISO8601BasicFormat))
+
+	token := auth.Token()
+	if token != "" {
+		hreq.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	signer := NewV4Signer(auth, "sts", region)
+	signer.Sign(hreq)
+
+	resp, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", buildError(resp)
+	}
+
+	var result struct {
+		Account string `xml:"GetCallerIdentityResult>Account"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	return result.Account, nil
+}
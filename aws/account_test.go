@@ -0,0 +1,88 @@
+package aws_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+var getCallerIdentityResponse = `
+<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/Alice</Arn>
+    <UserId>AKIAI44QH8DHBEXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>
+`
+
+var invalidClientTokenResponse = `
+<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>InvalidClientTokenId</Code>
+    <Message>The security token included in the request is invalid.</Message>
+  </Error>
+  <RequestId>fedcba98-7654-3210-fedc-ba9876543210</RequestId>
+</ErrorResponse>
+`
+
+func (s *S) TestAccountID(c *check.C) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		requests = append(requests, r)
+		fmt.Fprint(w, getCallerIdentityResponse)
+	}))
+	defer server.Close()
+	region := aws.Region{Name: "us-east-1", STSEndpoint: server.URL}
+
+	auth := aws.Auth{AccessKey: "account-test-key", SecretKey: "secret"}
+	id, err := aws.AccountIDForRegion(auth, region)
+	c.Assert(err, check.IsNil)
+	c.Assert(id, check.Equals, "123456789012")
+	c.Assert(requests, check.HasLen, 1)
+	c.Assert(requests[0].Form.Get("Action"), check.Equals, "GetCallerIdentity")
+	c.Assert(requests[0].Form.Get("Version"), check.Equals, "2011-06-15")
+	c.Assert(requests[0].Header.Get("Authorization"), check.Matches, "AWS4-HMAC-SHA256 Credential=account-test-key/[0-9]{8}/us-east-1/sts/aws4_request, .*")
+
+	// Cached by access key.
+	id, err = aws.AccountIDForRegion(auth, region)
+	c.Assert(err, check.IsNil)
+	c.Assert(id, check.Equals, "123456789012")
+	c.Assert(requests, check.HasLen, 1)
+
+	_, err = aws.AccountIDForRegion(aws.Auth{AccessKey: "account-test-other-key", SecretKey: "secret"}, region)
+	c.Assert(err, check.IsNil)
+	c.Assert(requests, check.HasLen, 2)
+}
+
+func (s *S) TestAccountIDError(c *check.C) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(403)
+		fmt.Fprint(w, invalidClientTokenResponse)
+	}))
+	defer server.Close()
+	region := aws.Region{Name: "us-east-1", STSEndpoint: server.URL}
+
+	auth := aws.Auth{AccessKey: "account-test-bad-key", SecretKey: "secret"}
+	_, err := aws.AccountIDForRegion(auth, region)
+	c.Assert(err, check.FitsTypeOf, &aws.Error{})
+	e := err.(*aws.Error)
+	c.Assert(e.StatusCode, check.Equals, 403)
+	c.Assert(e.Code, check.Equals, "InvalidClientTokenId")
+	c.Assert(e.RequestId, check.Equals, "fedcba98-7654-3210-fedc-ba9876543210")
+
+	// Failures are not cached.
+	_, err = aws.AccountIDForRegion(auth, region)
+	c.Assert(err, check.NotNil)
+	c.Assert(requests, check.Equals, 2)
+}
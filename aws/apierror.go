@@ -0,0 +1,77 @@
+package aws
+
+// APIError is the error of a request refused by AWS. The error types of
+// the service packages, such as *Error, *s3.Error or *kms.Error, can all
+// be matched as an APIError with errors.As:
+//
+//	var apiErr aws.APIError
+//	if errors.As(err, &apiErr) && apiErr.Code() == "ThrottlingException" {
+//		...
+//	}
+//
+// Unwrap returns the error of the service package, for its other fields.
+type APIError struct {
+	err        error
+	statusCode int
+	code       string
+	message    string
+	requestID  string
+}
+
+// NewAPIError returns the APIError of err, the error of a request refused
+// with the given HTTP status, error code, message and request ID.
+func NewAPIError(err error, statusCode int, code, message, requestID string) APIError {
+	return APIError{err, statusCode, code, message, requestID}
+}
+
+func (e APIError) Error() string {
+	if e.err == nil {
+		return e.code + ": " + e.message
+	}
+	return e.err.Error()
+}
+
+// Unwrap returns the error of the service package.
+func (e APIError) Unwrap() error {
+	return e.err
+}
+
+// Code returns the error code, such as "AccessDenied".
+func (e APIError) Code() string {
+	return e.code
+}
+
+// Message returns the error message.
+func (e APIError) Message() string {
+	return e.message
+}
+
+// RequestID returns the ID of the request, if the service returned it.
+func (e APIError) RequestID() string {
+	return e.requestID
+}
+
+// HTTPStatus returns the HTTP status code of the response.
+func (e APIError) HTTPStatus() int {
+	return e.statusCode
+}
+
+// AsAPIError implements the As method of the error types of the service
+// packages: it sets target to the APIError of err if it is an *APIError,
+// and reports whether it did.
+//
+// Every error type a service package returns for a refused request has
+// an As method calling AsAPIError with its fields, so that errors.As
+// matches it, or any error wrapping it, as an APIError. The error types
+// added to the service packages must do the same.
+func AsAPIError(target interface{}, err error, statusCode int, code, message, requestID string) bool {
+	t, ok := target.(*APIError)
+	if ok {
+		*t = NewAPIError(err, statusCode, code, message, requestID)
+	}
+	return ok
+}
+
+func (err *Error) As(target interface{}) bool {
+	return AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
@@ -0,0 +1,32 @@
+package aws_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+func TestAPIError(t *testing.T) {
+	awsErr := &aws.Error{StatusCode: 403, Code: "AccessDenied", Message: "Access denied.", RequestId: "b0be6d8a"}
+	err := fmt.Errorf("listing distributions: %w", awsErr)
+
+	var apiErr aws.APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatal("expected an APIError")
+	}
+	if apiErr.Code() != "AccessDenied" || apiErr.Message() != "Access denied." || apiErr.RequestID() != "b0be6d8a" || apiErr.HTTPStatus() != 403 {
+		t.Errorf("unexpected APIError: %#v", apiErr)
+	}
+	if apiErr.Error() != awsErr.Error() {
+		t.Errorf("unexpected message: %q", apiErr.Error())
+	}
+	if !errors.Is(apiErr, awsErr) {
+		t.Error("expected the APIError to unwrap to the *aws.Error")
+	}
+
+	if errors.As(errors.New("not an API error"), &apiErr) {
+		t.Error("unexpected APIError")
+	}
+}
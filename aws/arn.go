@@ -0,0 +1,96 @@
+package aws
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ARN is an Amazon Resource Name, of the form
+// arn:partition:service:region:account-id:resource.
+//
+// Region and AccountId are empty for resources that are not regional or
+// not owned by an account, such as IAM roles or S3 buckets. The format of
+// Resource depends on the service; see ResourceType and ResourceId.
+//
+// See http://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html
+type ARN struct {
+	Partition string
+	Service   string
+	Region    string
+	AccountId string
+	Resource  string
+}
+
+// ParseARN splits an ARN into its components.
+func ParseARN(s string) (ARN, error) {
+	parts := strings.SplitN(s, ":", 6)
+	if len(parts) != 6 || parts[0] != "arn" {
+		return ARN{}, fmt.Errorf("aws: invalid ARN %q", s)
+	}
+	arn := ARN{
+		Partition: parts[1],
+		Service:   parts[2],
+		Region:    parts[3],
+		AccountId: parts[4],
+		Resource:  parts[5],
+	}
+	if arn.Partition == "" || arn.Service == "" || arn.Resource == "" {
+		return ARN{}, fmt.Errorf("aws: invalid ARN %q", s)
+	}
+	return arn, nil
+}
+
+// NewARN builds the ARN of a resource in region. The partition is derived
+// from the region name; region may be the zero Region for global
+// resources, which are in the "aws" partition.
+func NewARN(region Region, service, accountId, resource string) ARN {
+	return ARN{
+		Partition: Partition(region.Name),
+		Service:   service,
+		Region:    region.Name,
+		AccountId: accountId,
+		Resource:  resource,
+	}
+}
+
+// Partition returns the partition of the named region: "aws-cn" for the
+// China regions, "aws-us-gov" for GovCloud and "aws" for the others.
+func Partition(regionName string) string {
+	switch {
+	case strings.HasPrefix(regionName, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(regionName, "us-gov-"):
+		return "aws-us-gov"
+	}
+	return "aws"
+}
+
+func (arn ARN) String() string {
+	return strings.Join([]string{"arn", arn.Partition, arn.Service, arn.Region, arn.AccountId, arn.Resource}, ":")
+}
+
+// splitResource splits the resource at the first "/" or ":", which
+// services use to separate the resource type from its ID, as in
+// "instance/i-1234" or "function:name".
+func (arn ARN) splitResource() (string, string) {
+	i := strings.IndexAny(arn.Resource, "/:")
+	if i < 0 {
+		return "", arn.Resource
+	}
+	return arn.Resource[:i], arn.Resource[i+1:]
+}
+
+// ResourceType returns the part of the resource before the first "/" or
+// ":", or "" if there is none, as for S3 buckets and SNS topics.
+func (arn ARN) ResourceType() string {
+	t, _ := arn.splitResource()
+	return t
+}
+
+// ResourceId returns the part of the resource after its type. It may still
+// hold separators, as in the "function:name:alias" resource of a Lambda
+// alias or the "path/name" of an IAM role with a path.
+func (arn ARN) ResourceId() string {
+	_, id := arn.splitResource()
+	return id
+}
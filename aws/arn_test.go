@@ -0,0 +1,78 @@
+package aws_test
+
+import (
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestParseARN(c *check.C) {
+	for _, t := range []struct {
+		arn          string
+		expected     aws.ARN
+		resourceType string
+		resourceId   string
+	}{
+		{
+			"arn:aws:ec2:us-east-1:123456789012:instance/i-1234567890abcdef0",
+			aws.ARN{"aws", "ec2", "us-east-1", "123456789012", "instance/i-1234567890abcdef0"},
+			"instance", "i-1234567890abcdef0",
+		},
+		{
+			"arn:aws:iam::123456789012:role/service/deployer",
+			aws.ARN{"aws", "iam", "", "123456789012", "role/service/deployer"},
+			"role", "service/deployer",
+		},
+		{
+			"arn:aws:s3:::my-bucket",
+			aws.ARN{"aws", "s3", "", "", "my-bucket"},
+			"", "my-bucket",
+		},
+		{
+			"arn:aws:lambda:us-west-2:123456789012:function:resize:live",
+			aws.ARN{"aws", "lambda", "us-west-2", "123456789012", "function:resize:live"},
+			"function", "resize:live",
+		},
+		{
+			"arn:aws-cn:sns:cn-north-1:123456789012:alerts",
+			aws.ARN{"aws-cn", "sns", "cn-north-1", "123456789012", "alerts"},
+			"", "alerts",
+		},
+	} {
+		arn, err := aws.ParseARN(t.arn)
+		c.Assert(err, check.IsNil)
+		c.Check(arn, check.Equals, t.expected)
+		c.Check(arn.ResourceType(), check.Equals, t.resourceType)
+		c.Check(arn.ResourceId(), check.Equals, t.resourceId)
+		c.Check(arn.String(), check.Equals, t.arn)
+	}
+}
+
+func (s *S) TestParseARNInvalid(c *check.C) {
+	for _, arn := range []string{
+		"",
+		"arn:aws:s3",
+		"urn:aws:s3:::bucket",
+		"arn::s3:::bucket",
+		"arn:aws:s3:::",
+	} {
+		_, err := aws.ParseARN(arn)
+		c.Check(err, check.ErrorMatches, `aws: invalid ARN ".*"`)
+	}
+}
+
+func (s *S) TestNewARN(c *check.C) {
+	arn := aws.NewARN(aws.USWest2, "dynamodb", "123456789012", "table/orders")
+	c.Check(arn.String(), check.Equals, "arn:aws:dynamodb:us-west-2:123456789012:table/orders")
+
+	arn = aws.NewARN(aws.CNNorth1, "s3", "", "assets/logo.png")
+	c.Check(arn.String(), check.Equals, "arn:aws-cn:s3:cn-north-1::assets/logo.png")
+
+	arn = aws.NewARN(aws.Region{}, "iam", "123456789012", "user/alice")
+	c.Check(arn.String(), check.Equals, "arn:aws:iam::123456789012:user/alice")
+}
+
+func (s *S) TestPartition(c *check.C) {
+	c.Check(aws.Partition("eu-west-1"), check.Equals, "aws")
+	c.Check(aws.Partition("cn-northwest-1"), check.Equals, "aws-cn")
+	c.Check(aws.Partition("us-gov-west-1"), check.Equals, "aws-us-gov")
+}
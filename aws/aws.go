@@ -10,6 +10,7 @@
 package aws
 
 import (
+	"bytes"
 	"encoding/json"
 	"encoding/xml"
 	"errors"
@@ -50,26 +51,37 @@ type ServiceInfo struct {
 //
 // See http://goo.gl/d8BP1 for more details.
 type Region struct {
-	Name                   string // the canonical name of this region.
-	EC2Endpoint            string
-	S3Endpoint             string
-	S3BucketEndpoint       string // Not needed by AWS S3. Use ${bucket} for bucket name.
-	S3LocationConstraint   bool   // true if this region requires a LocationConstraint declaration.
-	S3LowercaseBucket      bool   // true if the region requires bucket names to be lower case.
-	SDBEndpoint            string
-	SNSEndpoint            string
-	SQSEndpoint            string
-	SESEndpoint            string
-	IAMEndpoint            string
-	ELBEndpoint            string
-	DynamoDBEndpoint       string
-	CloudWatchServicepoint ServiceInfo
-	AutoScalingEndpoint    string
-	RDSEndpoint            ServiceInfo
-	KinesisEndpoint        string
-	STSEndpoint            string
-	CloudFormationEndpoint string
-	ElastiCacheEndpoint    string
+	Name                    string // the canonical name of this region.
+	EC2Endpoint             string
+	S3Endpoint              string
+	S3BucketEndpoint        string // Not needed by AWS S3. Use ${bucket} for bucket name.
+	S3LocationConstraint    bool   // true if this region requires a LocationConstraint declaration.
+	S3LowercaseBucket       bool   // true if the region requires bucket names to be lower case.
+	SDBEndpoint             string
+	SNSEndpoint             string
+	SQSEndpoint             string
+	SESEndpoint             string
+	IAMEndpoint             string
+	ELBEndpoint             string
+	DynamoDBEndpoint        string
+	CloudWatchServicepoint  ServiceInfo
+	AutoScalingEndpoint     string
+	RDSEndpoint             ServiceInfo
+	KinesisEndpoint         string
+	STSEndpoint             string
+	CloudFormationEndpoint  string
+	ElastiCacheEndpoint     string
+	KMSEndpoint             string
+	LambdaEndpoint          string
+	ECSEndpoint             string
+	DynamoDBStreamsEndpoint string
+	CloudWatchLogsEndpoint  string
+	ACMEndpoint             string
+	WAFV2Endpoint           string
+	ECREndpoint             string
+	GlacierEndpoint         string
+	SecretsManagerEndpoint  string
+	SSMEndpoint             string
 }
 
 var Regions = map[string]Region{
@@ -113,6 +125,13 @@ type AWSService interface {
 type Service struct {
 	service ServiceInfo
 	signer  Signer
+
+	// RetryPolicy, if set, retries the queries that fail with a network
+	// error or an error response. By default queries are not retried.
+	RetryPolicy RetryPolicy
+
+	// HTTPClient sends the queries, or http.DefaultClient if nil.
+	HTTPClient *http.Client
 }
 
 // Create a base set of params for an action
@@ -140,7 +159,42 @@ func NewService(auth Auth, service ServiceInfo) (s *Service, err error) {
 	return
 }
 
+// Query signs and sends a request. The response is returned whatever its
+// status; use BuildError to turn an error response into an error.
 func (s *Service) Query(method, path string, params map[string]string) (resp *http.Response, err error) {
+	if s.RetryPolicy == nil {
+		return s.query(method, path, params)
+	}
+	for numRetries := 0; ; numRetries++ {
+		// Signing adds to the params, so every attempt signs a fresh copy.
+		attempt := make(map[string]string, len(params))
+		for k, v := range params {
+			attempt[k] = v
+		}
+		resp, err = s.query(method, path, attempt)
+		qerr := err
+		if err == nil && resp.StatusCode >= 400 {
+			body, rerr := ioutil.ReadAll(resp.Body)
+			resp.Body.Close()
+			if rerr != nil {
+				return nil, rerr
+			}
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+			qerr = buildError(resp)
+			resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		}
+		if qerr == nil {
+			RecordSuccess(s.RetryPolicy, path, numRetries)
+			return
+		}
+		if !s.RetryPolicy.ShouldRetry(path, resp, qerr, numRetries) {
+			return
+		}
+		time.Sleep(s.RetryPolicy.Delay(path, resp, qerr, numRetries))
+	}
+}
+
+func (s *Service) query(method, path string, params map[string]string) (resp *http.Response, err error) {
 	params["Timestamp"] = time.Now().UTC().Format(time.RFC3339)
 	u, err := url.Parse(s.service.Endpoint)
 	if err != nil {
@@ -151,15 +205,19 @@ func (s *Service) Query(method, path string, params map[string]string) (resp *ht
 	s.signer.Sign(method, path, params)
 	if method == "GET" {
 		u.RawQuery = multimap(params).Encode()
-		resp, err = http.Get(u.String())
+		resp, err = httpClient(s.HTTPClient).Get(u.String())
 	} else if method == "POST" {
-		resp, err = http.PostForm(u.String(), multimap(params))
+		resp, err = httpClient(s.HTTPClient).PostForm(u.String(), multimap(params))
 	}
 
 	return
 }
 
 func (s *Service) BuildError(r *http.Response) error {
+	return buildError(r)
+}
+
+func buildError(r *http.Response) error {
 	errors := ErrorResponse{}
 	xml.NewDecoder(r.Body).Decode(&errors)
 	var err Error
@@ -335,11 +393,15 @@ func GetInstanceCredentials() (cred credentials, err error) {
 
 // GetAuth creates an Auth based on either passed in credentials,
 // environment information or instance based role credentials.
+//
+// If no provider has credentials, the error returned reports why each of
+// them failed.
 func GetAuth(accessKey string, secretKey, token string, expiration time.Time) (auth Auth, err error) {
 	// First try passed in credentials
 	if accessKey != "" && secretKey != "" {
 		return Auth{accessKey, secretKey, token, expiration}, nil
 	}
+	var failures []string
 
 	// Next try to get auth from the environment
 	auth, err = EnvAuth()
@@ -347,6 +409,7 @@ func GetAuth(accessKey string, secretKey, token string, expiration time.Time) (a
 		// Found auth, return
 		return
 	}
+	failures = append(failures, "environment: "+err.Error())
 
 	// Next try getting auth from the instance role
 	cred, err := GetInstanceCredentials()
@@ -362,16 +425,31 @@ func GetAuth(accessKey string, secretKey, token string, expiration time.Time) (a
 		auth.expiration = exptdate
 		return auth, err
 	}
+	failures = append(failures, "instance role: "+err.Error())
 
 	// Next try getting auth from the credentials file
 	auth, err = CredentialFileAuth("", "", time.Minute*5)
 	if err == nil {
 		return
 	}
+	failures = append(failures, "credentials file: "+err.Error())
+
+	// Next try the credential_process of the shared config file
+	auth, err = CredentialProcessAuth("", "", time.Minute*5)
+	if err == nil {
+		return
+	}
+	failures = append(failures, "credential_process: "+err.Error())
+
+	// Next try the SSO profile of the shared config file
+	auth, err = SSOAuth("", "")
+	if err == nil {
+		return
+	}
+	failures = append(failures, "SSO: "+err.Error())
 
-	//err = errors.New("No valid AWS authentication found")
-	err = fmt.Errorf("No valid AWS authentication found: %s", err)
-	return auth, err
+	err = fmt.Errorf("No valid AWS authentication found: %s", strings.Join(failures, "; "))
+	return Auth{}, err
 }
 
 // EnvAuth creates an Auth based on environment information.
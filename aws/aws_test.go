@@ -4,7 +4,10 @@ import (
 	"github.com/zackbloom/goamz/aws"
 	"gopkg.in/check.v1"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -82,6 +85,14 @@ func (s *S) TestGetAuthEnv(c *check.C) {
 	c.Assert(auth, check.Equals, aws.Auth{SecretKey: "secret", AccessKey: "access"})
 }
 
+func (s *S) TestGetAuthReportsEveryProvider(c *check.C) {
+	os.Clearenv()
+	os.Setenv("AWS_CONFIG_FILE", filepath.Join(c.MkDir(), "config"))
+	_, err := aws.GetAuth("", "", "", time.Time{})
+	c.Assert(err, check.ErrorMatches, "(?s)No valid AWS authentication found: environment: .*; instance role: .*; "+
+		"credentials file: .*; credential_process: .*; SSO: .*")
+}
+
 func (s *S) TestEncode(c *check.C) {
 	c.Assert(aws.Encode("foo"), check.Equals, "foo")
 	c.Assert(aws.Encode("/"), check.Equals, "%2F")
@@ -138,3 +149,98 @@ aws_secret_access_key=key1
 	c.Assert(profile2.SecretKey, check.Equals, "key2")
 	c.Assert(profile2.Token(), check.Equals, "token1")
 }
+
+func (s *S) TestCredentialProcessAuth(c *check.C) {
+	file, err := ioutil.TempFile("", "config")
+	c.Assert(err, check.IsNil)
+	defer os.Remove(file.Name())
+
+	iniFile := `
+[default]
+credential_process = echo '{"Version": 1, "AccessKeyId": "keyid1", "SecretAccessKey": "key1"}'
+
+[profile okta]
+region = us-west-2
+credential_process = echo '{"Version": 1, "AccessKeyId": "keyid2", "SecretAccessKey": "key2", "SessionToken": "token2", "Expiration": "2100-01-01T00:00:00Z"}'
+
+[profile failing]
+credential_process = echo denied >&2 && exit 1
+
+[profile static]
+aws_access_key_id = keyid3
+`
+	_, err = file.WriteString(iniFile)
+	c.Assert(err, check.IsNil)
+	c.Assert(file.Close(), check.IsNil)
+
+	auth, err := aws.CredentialProcessAuth(file.Name(), "", 30*time.Minute)
+	c.Assert(err, check.IsNil)
+	c.Assert(auth.AccessKey, check.Equals, "keyid1")
+	c.Assert(auth.SecretKey, check.Equals, "key1")
+	c.Assert(auth.Token(), check.Equals, "")
+
+	os.Setenv("AWS_PROFILE", "okta")
+	auth, err = aws.CredentialProcessAuth(file.Name(), "", 30*time.Minute)
+	c.Assert(err, check.IsNil)
+	c.Assert(auth.AccessKey, check.Equals, "keyid2")
+	c.Assert(auth.Token(), check.Equals, "token2")
+	c.Assert(auth.Expiration(), check.Equals, time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	_, err = aws.CredentialProcessAuth(file.Name(), "failing", 30*time.Minute)
+	c.Assert(err, check.ErrorMatches, "credential_process: exit status 1: denied")
+	_, err = aws.CredentialProcessAuth(file.Name(), "static", 30*time.Minute)
+	c.Assert(err, check.ErrorMatches, "The profile static has no credential_process")
+	_, err = aws.CredentialProcessAuth(file.Name(), "missing", 30*time.Minute)
+	c.Assert(err, check.ErrorMatches, "The config file did not contain the profile missing")
+}
+
+func (s *S) TestServiceQueryRetryPolicy(c *check.C) {
+	var signatures []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		signatures = append(signatures, r.Form.Get("Signature"))
+		if len(signatures) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("<OK/>"))
+	}))
+	defer server.Close()
+
+	auth := aws.Auth{AccessKey: "access", SecretKey: "secret"}
+	service, err := aws.NewService(auth, aws.ServiceInfo{Endpoint: server.URL, Signer: aws.V2Signature})
+	c.Assert(err, check.IsNil)
+
+	resp, err := service.Query("GET", "/", map[string]string{"Action": "Describe"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.StatusCode, check.Equals, http.StatusServiceUnavailable)
+	c.Assert(signatures, check.HasLen, 1)
+
+	signatures = nil
+	service.RetryPolicy = aws.DynamoDBRetryPolicy{}
+	resp, err = service.Query("GET", "/", map[string]string{"Action": "Describe"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.StatusCode, check.Equals, http.StatusOK)
+	c.Assert(signatures, check.HasLen, 3)
+	c.Assert(signatures[0], check.Not(check.Equals), "")
+}
+
+func (s *S) TestServiceQueryRetryPolicyKeepsErrorBody(c *check.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`<Response><Errors><Error><Code>InvalidParameterValue</Code><Message>bad</Message></Error></Errors></Response>`))
+	}))
+	defer server.Close()
+
+	auth := aws.Auth{AccessKey: "access", SecretKey: "secret"}
+	service, err := aws.NewService(auth, aws.ServiceInfo{Endpoint: server.URL, Signer: aws.V2Signature})
+	c.Assert(err, check.IsNil)
+	service.RetryPolicy = aws.DynamoDBRetryPolicy{}
+
+	resp, err := service.Query("POST", "/", map[string]string{"Action": "Describe"})
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.StatusCode, check.Equals, http.StatusBadRequest)
+	body, err := ioutil.ReadAll(resp.Body)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(body), check.Matches, ".*InvalidParameterValue.*")
+}
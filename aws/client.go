@@ -1,9 +1,14 @@
 package aws
 
 import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io/ioutil"
 	"math"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -29,10 +34,21 @@ type ResilientTransport struct {
 	Deadline    DeadlineFunc
 	ShouldRetry RetryableFunc
 	Wait        WaitFunc
-	transport   *http.Transport
+
+	// Metrics, if set, is called back around every request. The service
+	// it gets is Service, or the first label of the host of the request if
+	// Service is empty. The operation is the action named by the
+	// X-Amz-Target header or the Action parameter of the request, or else
+	// its method. The responses with a 4xx or 5xx status are reported as
+	// an APIError.
+	Metrics Metrics
+	Service string
+
+	transport *http.Transport
 }
 
-// Convenience method for creating an http client
+// Convenience method for creating an http client. The Metrics of rt, if
+// any, is called back around every request the client makes.
 func NewClient(rt *ResilientTransport) *http.Client {
 	rt.transport = &http.Transport{
 		Dial: func(netw, addr string) (net.Conn, error) {
@@ -74,8 +90,20 @@ func (t *ResilientTransport) RoundTrip(req *http.Request) (*http.Response, error
 // If a wait function is specified, wait that amount of time
 // In between requests.
 func (t *ResilientTransport) tries(req *http.Request) (res *http.Response, err error) {
+	metrics := t.Metrics
+	if metrics == nil {
+		metrics = NopMetrics{}
+	}
+	service, operation := t.service(req), requestOperation(req)
+	start := time.Now()
+	metrics.RequestStart(service, operation)
+
+	var failure error
 	for try := 0; try < t.MaxTries; try += 1 {
 		res, err = t.transport.RoundTrip(req)
+		if failure = responseError(res, err); failure != nil {
+			metrics.RequestError(service, operation, failure)
+		}
 
 		if !t.ShouldRetry(req, res, err) {
 			break
@@ -83,14 +111,83 @@ func (t *ResilientTransport) tries(req *http.Request) (res *http.Response, err e
 		if res != nil {
 			res.Body.Close()
 		}
+		if try+1 < t.MaxTries {
+			metrics.RequestRetry(service, operation, try+1, failure)
+		}
 		if t.Wait != nil {
 			t.Wait(try)
 		}
 	}
 
+	metrics.RequestFinish(service, operation, time.Since(start), failure)
 	return
 }
 
+func (t *ResilientTransport) service(req *http.Request) string {
+	if t.Service != "" {
+		return t.Service
+	}
+	return strings.SplitN(req.URL.Hostname(), ".", 2)[0]
+}
+
+// requestOperation returns the action req calls, for Metrics.
+func requestOperation(req *http.Request) string {
+	if target := req.Header.Get("X-Amz-Target"); target != "" {
+		return target[strings.LastIndex(target, ".")+1:]
+	}
+	if action := req.URL.Query().Get("Action"); action != "" {
+		return action
+	}
+	return req.Method
+}
+
+// responseError returns the error of an attempt, for Metrics: err, or an
+// APIError for a response with a 4xx or 5xx status. The body of the
+// response is read for the error code, and replaced.
+func responseError(res *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	if res.StatusCode < 400 {
+		return nil
+	}
+	data, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	return NewAPIError(nil, res.StatusCode, errorCode(data), res.Status, res.Header.Get("X-Amzn-RequestId"))
+}
+
+// errorCode returns the code of an error response of any protocol: the
+// __type of a JSON error, or the first Code element of an XML one.
+func errorCode(data []byte) string {
+	var jsonErr struct {
+		Type string `json:"__type"`
+		Code string `json:"code"`
+	}
+	if json.Unmarshal(data, &jsonErr) == nil {
+		code := jsonErr.Type
+		if code == "" {
+			code = jsonErr.Code
+		}
+		return code[strings.LastIndex(code, "#")+1:]
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if start, ok := tok.(xml.StartElement); ok && start.Name.Local == "Code" {
+			var code string
+			d.DecodeElement(&code, &start)
+			return code
+		}
+	}
+}
+
 func ExpBackoff(try int) {
 	time.Sleep(100 * time.Millisecond *
 		time.Duration(math.Exp2(float64(try))))
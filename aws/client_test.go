@@ -0,0 +1,173 @@
+package aws_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+// recordMetrics records the callbacks it gets as strings.
+type recordMetrics struct {
+	calls []string
+}
+
+func (m *recordMetrics) RequestStart(service, operation string) {
+	m.calls = append(m.calls, "start "+service+" "+operation)
+}
+
+func (m *recordMetrics) RequestFinish(service, operation string, latency time.Duration, err error) {
+	m.calls = append(m.calls, fmt.Sprintf("finish %s %s %v", service, operation, err))
+}
+
+func (m *recordMetrics) RequestRetry(service, operation string, retry int, err error) {
+	m.calls = append(m.calls, fmt.Sprintf("retry %s %s %d", service, operation, retry))
+}
+
+func (m *recordMetrics) RequestError(service, operation string, err error) {
+	m.calls = append(m.calls, fmt.Sprintf("error %s %s %v throttle=%t", service, operation, err, aws.IsThrottle(err)))
+}
+
+func (s *S) TestResilientTransportMetrics(c *check.C) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`<ErrorResponse><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error></ErrorResponse>`))
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	metrics := &recordMetrics{}
+	client := aws.NewClient(&aws.ResilientTransport{
+		Deadline:    func() time.Time { return time.Now().Add(5 * time.Second) },
+		DialTimeout: time.Second,
+		MaxTries:    3,
+		ShouldRetry: func(req *http.Request, res *http.Response, err error) bool {
+			return res != nil && res.StatusCode >= 500
+		},
+		Metrics: metrics,
+		Service: "ec2",
+	})
+	resp, err := client.Get(srv.URL + "/?Action=DescribeInstances")
+	c.Assert(err, check.IsNil)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	c.Assert(string(body), check.Equals, "ok")
+
+	c.Assert(metrics.calls, check.DeepEquals, []string{
+		"start ec2 DescribeInstances",
+		"error ec2 DescribeInstances Throttling: 503 Service Unavailable throttle=true",
+		"retry ec2 DescribeInstances 1",
+		"finish ec2 DescribeInstances <nil>",
+	})
+}
+
+func (s *S) TestResilientTransportMetricsJSONError(c *check.C) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type": "com.amazonaws.kms#NotFoundException", "message": "no such key"}`))
+	}))
+	defer srv.Close()
+
+	metrics := &recordMetrics{}
+	client := aws.NewClient(&aws.ResilientTransport{
+		Deadline:    func() time.Time { return time.Now().Add(5 * time.Second) },
+		DialTimeout: time.Second,
+		MaxTries:    3,
+		ShouldRetry: func(*http.Request, *http.Response, error) bool { return false },
+		Metrics:     metrics,
+	})
+	req, _ := http.NewRequest("POST", strings.Replace(srv.URL, "127.0.0.1", "localhost", 1), nil)
+	req.Header.Set("X-Amz-Target", "TrentService.DescribeKey")
+	resp, err := client.Do(req)
+	c.Assert(err, check.IsNil)
+	body, _ := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	c.Assert(string(body), check.Matches, ".*no such key.*")
+
+	c.Assert(metrics.calls, check.DeepEquals, []string{
+		"start localhost DescribeKey",
+		"error localhost DescribeKey NotFoundException: 400 Bad Request throttle=false",
+		"finish localhost DescribeKey NotFoundException: 400 Bad Request",
+	})
+}
+
+// recordSink records the metrics it gets as strings.
+type recordSink []string
+
+func (r *recordSink) PutMetric(name string, value float64, unit string, dimensions map[string]string) {
+	*r = append(*r, fmt.Sprintf("%s %v %s %s/%s", name, value, unit, dimensions["Service"], dimensions["Operation"]))
+}
+
+func (s *S) TestSinkMetrics(c *check.C) {
+	var sink recordSink
+	var metrics aws.Metrics = aws.SinkMetrics{Sink: &sink}
+	throttled := &aws.Error{StatusCode: 400, Code: "Throttling"}
+
+	metrics.RequestStart("sqs", "SendMessage")
+	metrics.RequestError("sqs", "SendMessage", throttled)
+	metrics.RequestRetry("sqs", "SendMessage", 1, throttled)
+	metrics.RequestError("sqs", "SendMessage", &aws.Error{StatusCode: 400, Code: "InvalidParameterValue"})
+	metrics.RequestFinish("sqs", "SendMessage", 1500*time.Microsecond, throttled)
+
+	c.Assert([]string(sink), check.DeepEquals, []string{
+		"Requests 1 Count sqs/SendMessage",
+		"Throttles 1 Count sqs/SendMessage",
+		"Retries 1 Count sqs/SendMessage",
+		"Latency 1.5 Milliseconds sqs/SendMessage",
+		"Errors 1 Count sqs/SendMessage",
+	})
+}
+
+func (s *S) TestJSONClientSinkMetrics(c *check.C) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type": "ThrottlingException", "message": "Rate exceeded"}`))
+			return
+		}
+		w.Write([]byte(`{"KeyMetadata": {"KeyId": "1234abcd"}}`))
+	}))
+	defer srv.Close()
+
+	var sink recordSink
+	client := aws.JSONClient{
+		Auth:         aws.Auth{AccessKey: "abc", SecretKey: "123"},
+		Region:       aws.USEast,
+		Endpoint:     srv.URL,
+		TargetPrefix: "TrentService",
+		SigningName:  "kms",
+		HTTPClient: aws.NewClient(&aws.ResilientTransport{
+			Deadline:    func() time.Time { return time.Now().Add(5 * time.Second) },
+			DialTimeout: time.Second,
+			MaxTries:    3,
+			ShouldRetry: func(req *http.Request, res *http.Response, err error) bool {
+				return res != nil && res.StatusCode == http.StatusBadRequest
+			},
+			Metrics: aws.SinkMetrics{Sink: &sink},
+			Service: "kms",
+		}),
+	}
+	var resp struct{ KeyMetadata struct{ KeyId string } }
+	c.Assert(client.Call("DescribeKey", map[string]string{"KeyId": "alias/app"}, &resp), check.IsNil)
+	c.Assert(resp.KeyMetadata.KeyId, check.Equals, "1234abcd")
+
+	c.Assert(sink, check.HasLen, 4)
+	c.Assert([]string(sink[:3]), check.DeepEquals, []string{
+		"Requests 1 Count kms/DescribeKey",
+		"Throttles 1 Count kms/DescribeKey",
+		"Retries 1 Count kms/DescribeKey",
+	})
+	c.Assert(sink[3], check.Matches, "Latency [0-9.e+-]+ Milliseconds kms/DescribeKey")
+}
@@ -0,0 +1,93 @@
+package aws
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultMinCompressSize is the size from which the AWS SDKs compress the
+// requests of the actions that accept compressed payloads.
+const DefaultMinCompressSize = 10240
+
+// CompressRequestBody gzips the body of req and sets its Content-Encoding
+// if the body is at least minSize bytes long. Only some actions accept
+// compressed requests, such as CloudWatch PutMetricData and CloudWatch Logs
+// PutLogEvents.
+//
+// Requests signed with a V4Signer must be compressed before they are
+// signed, so that the signature covers the payload that is sent.
+func CompressRequestBody(req *http.Request, minSize int) error {
+	if req.Body == nil {
+		return nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return err
+	}
+	if len(body) < minSize {
+		setRequestBody(req, body)
+		return nil
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	setRequestBody(req, buf.Bytes())
+	if encoding := req.Header.Get("Content-Encoding"); encoding != "" {
+		req.Header.Set("Content-Encoding", encoding+", gzip")
+	} else {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
+	return nil
+}
+
+func setRequestBody(req *http.Request, body []byte) {
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+}
+
+// A CompressingService is an AWSService that can gzip the bodies of POST
+// requests, as done by *Service.
+type CompressingService interface {
+	AWSService
+	// QueryCompressed is like Query but sends params gzipped when they
+	// encode to at least minSize bytes.
+	QueryCompressed(method, path string, params map[string]string, minSize int) (*http.Response, error)
+}
+
+// QueryCompressed implements the CompressingService QueryCompressed method.
+// GET requests have no body and are sent as with Query.
+func (s *Service) QueryCompressed(method, path string, params map[string]string, minSize int) (*http.Response, error) {
+	if method != "POST" {
+		return s.Query(method, path, params)
+	}
+	params["Timestamp"] = time.Now().UTC().Format(time.RFC3339)
+	u, err := url.Parse(s.service.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path
+
+	s.signer.Sign(method, path, params)
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(multimap(params).Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if err := CompressRequestBody(req, minSize); err != nil {
+		return nil, err
+	}
+	return httpClient(s.HTTPClient).Do(req)
+}
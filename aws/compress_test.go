@@ -0,0 +1,49 @@
+package aws_test
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCompressRequestBody(c *check.C) {
+	payload := strings.Repeat(`{"timestamp":1500000000000,"message":"GET /health 200"}`, 200)
+	req, _ := http.NewRequest("POST", "https://logs.us-east-1.amazonaws.com/", strings.NewReader(payload))
+
+	err := aws.CompressRequestBody(req, aws.DefaultMinCompressSize)
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Header.Get("Content-Encoding"), check.Equals, "gzip")
+
+	compressed, _ := ioutil.ReadAll(req.Body)
+	c.Assert(req.ContentLength, check.Equals, int64(len(compressed)))
+	c.Assert(len(compressed) < len(payload), check.Equals, true)
+
+	zr, err := gzip.NewReader(strings.NewReader(string(compressed)))
+	c.Assert(err, check.IsNil)
+	body, _ := ioutil.ReadAll(zr)
+	c.Assert(string(body), check.Equals, payload)
+}
+
+func (s *S) TestCompressRequestBodySmall(c *check.C) {
+	req, _ := http.NewRequest("POST", "https://logs.us-east-1.amazonaws.com/", strings.NewReader("{}"))
+
+	err := aws.CompressRequestBody(req, aws.DefaultMinCompressSize)
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Header.Get("Content-Encoding"), check.Equals, "")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(string(body), check.Equals, "{}")
+	c.Assert(req.ContentLength, check.Equals, int64(2))
+}
+
+func (s *S) TestCompressRequestBodyEncodingAppended(c *check.C) {
+	req, _ := http.NewRequest("PUT", "https://example.s3.amazonaws.com/key", strings.NewReader("data"))
+	req.Header.Set("Content-Encoding", "aws-chunked")
+
+	err := aws.CompressRequestBody(req, 0)
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Header.Get("Content-Encoding"), check.Equals, "aws-chunked, gzip")
+}
@@ -0,0 +1,145 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/user"
+	"path"
+	"runtime"
+	"time"
+)
+
+// credentialProcessOutput is the output of a credential_process command.
+//
+// See http://docs.aws.amazon.com/cli/latest/userguide/cli-configure-sourcing-external.html
+type credentialProcessOutput struct {
+	Version         int
+	AccessKeyId     string
+	SecretAccessKey string
+	SessionToken    string
+	Expiration      string
+}
+
+// CredentialProcessAuth creates an Auth from the output of the
+// credential_process command of a profile of the shared config file,
+// ~/.aws/config by default, or the file named by AWS_CONFIG_FILE.
+//
+// The profile defaults to the one named by AWS_PROFILE, or "default". The
+// command is run by the shell, and must print credentials as JSON. If they
+// have no Expiration, they expire after the given duration.
+func CredentialProcessAuth(filePath string, profile string, expiration time.Duration) (auth Auth, err error) {
+	profileData, profile, err := sharedConfigProfile(filePath, profile)
+	if err != nil {
+		return
+	}
+
+	command, ok := profileData["credential_process"]
+	if !ok {
+		err = fmt.Errorf("The profile %s has no credential_process", profile)
+		return
+	}
+
+	out, err := runCredentialProcess(command)
+	if err != nil {
+		return
+	}
+
+	auth.AccessKey = out.AccessKeyId
+	auth.SecretKey = out.SecretAccessKey
+	auth.token = out.SessionToken
+	if out.Expiration == "" {
+		auth.expiration = time.Now().Add(expiration)
+	} else if auth.expiration, err = time.Parse(time.RFC3339, out.Expiration); err != nil {
+		err = fmt.Errorf("credential_process: invalid Expiration: %s", err)
+	}
+	return
+}
+
+// sharedConfigProfile returns the settings of a profile of the shared
+// config file at filePath, or the default one, as well as the name of the
+// profile, which defaults to AWS_PROFILE or "default".
+func sharedConfigProfile(filePath, profile string) (settings map[string]string, name string, err error) {
+	profiles, name, err := sharedConfig(filePath, profile)
+	if err != nil {
+		return nil, "", err
+	}
+	settings, err = lookupProfile(profiles, name)
+	return settings, name, err
+}
+
+// lookupProfile returns the settings of a profile of a parsed shared config
+// file, where profiles other than the default one are in sections named
+// "profile <name>".
+func lookupProfile(profiles map[string]map[string]string, name string) (map[string]string, error) {
+	settings, ok := profiles["profile "+name]
+	if !ok {
+		settings, ok = profiles[name]
+	}
+	if !ok {
+		return nil, fmt.Errorf("The config file did not contain the profile %s", name)
+	}
+	return settings, nil
+}
+
+// sharedConfig parses the shared config file at filePath, or the default
+// one, and returns the name of the profile to use.
+func sharedConfig(filePath, profile string) (profiles map[string]map[string]string, name string, err error) {
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	if profile == "" {
+		profile = "default"
+	}
+
+	if filePath == "" {
+		filePath = os.Getenv("AWS_CONFIG_FILE")
+	}
+	if filePath == "" {
+		u, err := user.Current()
+		if err != nil {
+			return nil, "", err
+		}
+
+		filePath = path.Join(u.HomeDir, ".aws", "config")
+	}
+
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, "", err
+	}
+	return parseINI(string(contents)), profile, nil
+}
+
+// runCredentialProcess runs a credential_process command and parses its
+// output.
+func runCredentialProcess(command string) (*credentialProcessOutput, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd.exe", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	cmd.Stdin = os.Stdin
+	data, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("credential_process: %s: %s", err, bytes.TrimSpace(stderr.Bytes()))
+	}
+
+	out := new(credentialProcessOutput)
+	if err := json.Unmarshal(data, out); err != nil {
+		return nil, fmt.Errorf("credential_process: invalid output: %s", err)
+	}
+	if out.Version != 1 {
+		return nil, fmt.Errorf("credential_process: unsupported Version %d", out.Version)
+	}
+	if out.AccessKeyId == "" || out.SecretAccessKey == "" {
+		return nil, fmt.Errorf("credential_process: output has no AccessKeyId or SecretAccessKey")
+	}
+	return out, nil
+}
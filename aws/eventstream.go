@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// EventStreamMessage is a message of a response in the AWS event stream
+// encoding, such as the events of S3 Select or CloudWatch Logs Live Tail.
+// Headers holds the string and byte array headers, such as
+// ":message-type" and ":event-type".
+type EventStreamMessage struct {
+	Headers map[string]string
+	Payload []byte
+}
+
+const eventStreamPreludeLen = 12
+
+// ErrEventStreamChecksum is returned by ReadEventStreamMessage when the
+// CRC of the prelude or of the message does not match its content.
+var ErrEventStreamChecksum = errors.New("aws: event stream checksum mismatch")
+
+// ReadEventStreamMessage reads a single message in the AWS event stream
+// encoding: a prelude holding the total and header lengths and their CRC,
+// the headers, the payload and a CRC of the whole message.
+//
+// Only string header values are decoded; other header types are skipped.
+// At the end of the stream it returns io.EOF.
+func ReadEventStreamMessage(r io.Reader) (*EventStreamMessage, error) {
+	prelude := make([]byte, eventStreamPreludeLen)
+	if _, err := io.ReadFull(r, prelude); err != nil {
+		return nil, err
+	}
+	totalLen := binary.BigEndian.Uint32(prelude[0:4])
+	headersLen := binary.BigEndian.Uint32(prelude[4:8])
+	if crc32.ChecksumIEEE(prelude[0:8]) != binary.BigEndian.Uint32(prelude[8:12]) {
+		return nil, ErrEventStreamChecksum
+	}
+	if totalLen < eventStreamPreludeLen+4+headersLen {
+		return nil, fmt.Errorf("aws: invalid event stream message length %d", totalLen)
+	}
+
+	rest := make([]byte, totalLen-eventStreamPreludeLen)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	crc := crc32.NewIEEE()
+	crc.Write(prelude)
+	crc.Write(rest[:len(rest)-4])
+	if crc.Sum32() != binary.BigEndian.Uint32(rest[len(rest)-4:]) {
+		return nil, ErrEventStreamChecksum
+	}
+
+	headers, err := decodeEventStreamHeaders(rest[:headersLen])
+	if err != nil {
+		return nil, err
+	}
+	return &EventStreamMessage{
+		Headers: headers,
+		Payload: rest[headersLen : len(rest)-4],
+	}, nil
+}
+
+// Sizes of the non-string header value types, indexed by type.
+var eventStreamHeaderSizes = map[byte]int{
+	0: 0,  // bool true
+	1: 0,  // bool false
+	2: 1,  // byte
+	3: 2,  // short
+	4: 4,  // int
+	5: 8,  // long
+	8: 8,  // timestamp
+	9: 16, // uuid
+}
+
+func decodeEventStreamHeaders(b []byte) (map[string]string, error) {
+	headers := make(map[string]string)
+	buf := bytes.NewBuffer(b)
+	for buf.Len() > 0 {
+		nameLen, err := buf.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if buf.Len() < int(nameLen) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		name := buf.Next(int(nameLen))
+		typ, err := buf.ReadByte()
+		if err != nil {
+			return nil, io.ErrUnexpectedEOF
+		}
+		switch typ {
+		case 6, 7: // byte array, string
+			if buf.Len() < 2 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			valueLen := binary.BigEndian.Uint16(buf.Next(2))
+			if buf.Len() < int(valueLen) {
+				return nil, io.ErrUnexpectedEOF
+			}
+			headers[string(name)] = string(buf.Next(int(valueLen)))
+		default:
+			size, ok := eventStreamHeaderSizes[typ]
+			if !ok || buf.Len() < size {
+				return nil, fmt.Errorf("aws: invalid event stream header %q", name)
+			}
+			buf.Next(size)
+		}
+	}
+	return headers, nil
+}
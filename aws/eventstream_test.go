@@ -0,0 +1,88 @@
+package aws_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestReadEventStreamMessage(c *check.C) {
+	stream := bytes.NewReader(append(
+		testutil.EventStreamMessage([][2]string{{":message-type", "event"}, {":event-type", "Records"}}, "a,b\n"),
+		testutil.EventStreamMessage([][2]string{{":message-type", "event"}, {":event-type", "End"}}, "")...))
+
+	msg, err := aws.ReadEventStreamMessage(stream)
+	c.Assert(err, check.IsNil)
+	c.Assert(msg.Headers, check.DeepEquals, map[string]string{":message-type": "event", ":event-type": "Records"})
+	c.Assert(string(msg.Payload), check.Equals, "a,b\n")
+
+	msg, err = aws.ReadEventStreamMessage(stream)
+	c.Assert(err, check.IsNil)
+	c.Assert(msg.Headers[":event-type"], check.Equals, "End")
+	c.Assert(msg.Payload, check.HasLen, 0)
+
+	_, err = aws.ReadEventStreamMessage(stream)
+	c.Assert(err, check.Equals, io.EOF)
+}
+
+func (s *S) TestReadEventStreamMessageSkipsOtherHeaders(c *check.C) {
+	// A bool, an int and a timestamp header, then a string one.
+	var h bytes.Buffer
+	h.Write([]byte{4, 'f', 'l', 'a', 'g', 0})
+	h.Write([]byte{5, 'c', 'o', 'u', 'n', 't', 4, 0, 0, 0, 7})
+	h.Write([]byte{4, 't', 'i', 'm', 'e', 8, 0, 0, 0, 0, 0, 0, 0, 1})
+	h.Write([]byte{4, 'n', 'a', 'm', 'e', 7, 0, 2, 'o', 'k'})
+	msg, err := aws.ReadEventStreamMessage(bytes.NewReader(encodeRawEventStreamMessage(h.Bytes(), "{}")))
+	c.Assert(err, check.IsNil)
+	c.Assert(msg.Headers, check.DeepEquals, map[string]string{"name": "ok"})
+	c.Assert(string(msg.Payload), check.Equals, "{}")
+
+	_, err = aws.ReadEventStreamMessage(bytes.NewReader(encodeRawEventStreamMessage([]byte{1, 'x', 42}, "")))
+	c.Assert(err, check.ErrorMatches, `aws: invalid event stream header "x"`)
+}
+
+func (s *S) TestReadEventStreamMessageInvalid(c *check.C) {
+	valid := testutil.EventStreamMessage([][2]string{{":message-type", "event"}}, "payload")
+
+	corruptPrelude := append([]byte(nil), valid...)
+	corruptPrelude[3]++
+	_, err := aws.ReadEventStreamMessage(bytes.NewReader(corruptPrelude))
+	c.Assert(err, check.Equals, aws.ErrEventStreamChecksum)
+
+	corruptPayload := append([]byte(nil), valid...)
+	corruptPayload[len(corruptPayload)-5]++
+	_, err = aws.ReadEventStreamMessage(bytes.NewReader(corruptPayload))
+	c.Assert(err, check.Equals, aws.ErrEventStreamChecksum)
+
+	_, err = aws.ReadEventStreamMessage(bytes.NewReader(valid[:len(valid)-1]))
+	c.Assert(err, check.Equals, io.ErrUnexpectedEOF)
+
+	_, err = aws.ReadEventStreamMessage(bytes.NewReader(valid[:5]))
+	c.Assert(err, check.Equals, io.ErrUnexpectedEOF)
+
+	// A total length too short to hold the headers.
+	var short bytes.Buffer
+	binary.Write(&short, binary.BigEndian, uint32(16))
+	binary.Write(&short, binary.BigEndian, uint32(8))
+	binary.Write(&short, binary.BigEndian, crc32.ChecksumIEEE(short.Bytes()))
+	_, err = aws.ReadEventStreamMessage(&short)
+	c.Assert(err, check.ErrorMatches, "aws: invalid event stream message length 16")
+}
+
+// encodeRawEventStreamMessage encodes a message with already encoded
+// headers.
+func encodeRawEventStreamMessage(headers []byte, payload string) []byte {
+	var m bytes.Buffer
+	binary.Write(&m, binary.BigEndian, uint32(12+len(headers)+len(payload)+4))
+	binary.Write(&m, binary.BigEndian, uint32(len(headers)))
+	binary.Write(&m, binary.BigEndian, crc32.ChecksumIEEE(m.Bytes()))
+	m.Write(headers)
+	m.WriteString(payload)
+	binary.Write(&m, binary.BigEndian, crc32.ChecksumIEEE(m.Bytes()))
+	return m.Bytes()
+}
@@ -27,3 +27,11 @@ func (s *V4Signer) Signature(t time.Time, sts string) string {
 func (s *V4Signer) Authorization(header http.Header, t time.Time, signature string) string {
 	return s.authorization(header, t, signature)
 }
+
+// SSOAuth:
+// Overriding the portal endpoint and token cache for testing
+
+func SetSSO(endpoint, cacheDir string) {
+	ssoPortalEndpoint = endpoint
+	ssoCacheDir = cacheDir
+}
@@ -0,0 +1,69 @@
+package aws
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Some operations are sent to a subdomain of the endpoint of their
+// service, given by the hostPrefix trait of the operation in the service
+// model, such as "streaming-" for CloudWatch Logs StartLiveTail or
+// "{AccountId}." for the S3 Control operations. Their clients call
+// ApplyHostPrefix on the request before signing it, as JSONClient does for
+// its HostPrefixes.
+
+// ExpandHostPrefix returns prefix with its "{Name}" labels replaced by the
+// values of labels, which must be valid host labels.
+func ExpandHostPrefix(prefix string, labels map[string]string) (string, error) {
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(prefix, '{')
+		if i < 0 {
+			b.WriteString(prefix)
+			break
+		}
+		j := strings.IndexByte(prefix[i:], '}')
+		if j < 0 {
+			return "", fmt.Errorf("aws: invalid host prefix %q", prefix)
+		}
+		name := prefix[i+1 : i+j]
+		value, ok := labels[name]
+		if !ok {
+			return "", fmt.Errorf("aws: no value for host prefix label %s", name)
+		}
+		if !validHostLabel(value) {
+			return "", fmt.Errorf("aws: invalid value %q for host prefix label %s", value, name)
+		}
+		b.WriteString(prefix[:i])
+		b.WriteString(value)
+		prefix = prefix[i+j+1:]
+	}
+	return b.String(), nil
+}
+
+// ApplyHostPrefix sends req to the host of its URL prefixed with prefix,
+// expanded with labels as by ExpandHostPrefix. It must be called before
+// the request is signed.
+func ApplyHostPrefix(req *http.Request, prefix string, labels map[string]string) error {
+	expanded, err := ExpandHostPrefix(prefix, labels)
+	if err != nil {
+		return err
+	}
+	req.URL.Host = expanded + req.URL.Host
+	req.Host = req.URL.Host
+	return nil
+}
+
+// validHostLabel reports whether s is a valid DNS label.
+func validHostLabel(s string) bool {
+	if len(s) == 0 || len(s) > 63 || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,46 @@
+package aws_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+func TestExpandHostPrefix(t *testing.T) {
+	tests := []struct {
+		prefix string
+		labels map[string]string
+		want   string
+		err    string
+	}{
+		{prefix: "data-", want: "data-"},
+		{prefix: "{AccountId}.", labels: map[string]string{"AccountId": "123456789012"}, want: "123456789012."},
+		{prefix: "{Bucket}-{AccountId}.", labels: map[string]string{"Bucket": "logs", "AccountId": "123456789012"}, want: "logs-123456789012."},
+		{prefix: "{AccountId}.", err: "aws: no value for host prefix label AccountId"},
+		{prefix: "{AccountId}.", labels: map[string]string{"AccountId": "a.b"}, err: `aws: invalid value "a.b" for host prefix label AccountId`},
+		{prefix: "{AccountId.", err: `aws: invalid host prefix "{AccountId."`},
+	}
+	for _, test := range tests {
+		got, err := aws.ExpandHostPrefix(test.prefix, test.labels)
+		if test.err != "" {
+			if err == nil || err.Error() != test.err {
+				t.Errorf("%s: expected error %q, got %v", test.prefix, test.err, err)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Errorf("%s: expected %q, got %q, %v", test.prefix, test.want, got, err)
+		}
+	}
+}
+
+func TestApplyHostPrefix(t *testing.T) {
+	req, _ := http.NewRequest("POST", "https://servicediscovery.us-east-1.amazonaws.com/", nil)
+	if err := aws.ApplyHostPrefix(req, "data-", nil); err != nil {
+		t.Fatal(err)
+	}
+	if req.URL.Host != "data-servicediscovery.us-east-1.amazonaws.com" || req.Host != req.URL.Host {
+		t.Errorf("unexpected host: %s, %s", req.URL.Host, req.Host)
+	}
+}
@@ -0,0 +1,134 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// JSONClient calls the actions of a service speaking the AWS JSON 1.1
+// protocol, such as KMS or ECS: an action is posted to the endpoint of the
+// service, named by the X-Amz-Target header, with its parameters and its
+// result encoded as JSON.
+type JSONClient struct {
+	Auth   Auth
+	Region Region
+
+	Endpoint     string // URL of the service, such as Region.KMSEndpoint
+	TargetPrefix string // prefix of the action names, such as "TrentService"
+	SigningName  string // name of the service in signatures, such as "kms"
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil, such as
+	// a client made by NewClient to retry them and report Metrics.
+	HTTPClient *http.Client
+
+	// HostPrefixes holds, by action, the host prefix of the actions sent
+	// to a subdomain of Endpoint, as applied by ApplyHostPrefix.
+	HostPrefixes map[string]string
+
+	// DecodeError, if set, returns the error of an error response, given
+	// the JSONError decoded from it and its body, for the services whose
+	// errors have more fields. By default the *JSONError is returned.
+	DecodeError func(err *JSONError, body []byte) error
+}
+
+// JSONError represents an error in an action of a JSON service.
+type JSONError struct {
+	Service    string // signing name of the service ("kms", ...)
+	StatusCode int    // HTTP status code (200, 403, ...)
+	Code       string `json:"__type"`
+	Message    string `json:"message"`
+}
+
+func (e *JSONError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.Service, e.Code, e.Message)
+}
+
+func (e *JSONError) As(target interface{}) bool {
+	return AsAPIError(target, e, e.StatusCode, e.Code, e.Message, "")
+}
+
+// Call calls action with req encoded as JSON and decodes the result into
+// resp, unless it is nil.
+func (c *JSONClient) Call(action string, req, resp interface{}) error {
+	hresp, err := c.Send(action, req)
+	if err != nil {
+		return err
+	}
+	defer hresp.Body.Close()
+
+	data, err := ioutil.ReadAll(hresp.Body)
+	if err != nil {
+		return err
+	}
+	if resp == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, resp)
+}
+
+// Send calls action with req encoded as JSON. The body of the response
+// must be closed by the caller unless an error is returned.
+func (c *JSONClient) Send(action string, req interface{}) (*http.Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	hreq, err := http.NewRequest("POST", c.Endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if prefix, ok := c.HostPrefixes[action]; ok {
+		if err := ApplyHostPrefix(hreq, prefix, nil); err != nil {
+			return nil, err
+		}
+	}
+	hreq.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(ISO8601BasicFormat))
+	hreq.Header.Set("X-Amz-Target", c.TargetPrefix+"."+action)
+	if c.Auth.Token() != "" {
+		hreq.Header.Set("X-Amz-Security-Token", c.Auth.Token())
+	}
+
+	signer := NewV4Signer(c.Auth, c.SigningName, c.Region)
+	signer.Sign(hreq)
+
+	hresp, err := httpClient(c.HTTPClient).Do(hreq)
+	if err != nil {
+		return nil, err
+	}
+	if hresp.StatusCode != http.StatusOK {
+		defer hresp.Body.Close()
+		return nil, c.buildError(hresp)
+	}
+	return hresp, nil
+}
+
+// httpClient returns client, or http.DefaultClient if it is nil.
+func httpClient(client *http.Client) *http.Client {
+	if client == nil {
+		return http.DefaultClient
+	}
+	return client
+}
+
+func (c *JSONClient) buildError(r *http.Response) error {
+	jsonErr := &JSONError{Service: c.SigningName, StatusCode: r.StatusCode}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil || json.Unmarshal(data, jsonErr) != nil {
+		jsonErr.Message = r.Status
+	}
+	// The type may be qualified with a namespace, as in
+	// "com.amazonaws.ecs#ClientException".
+	if i := strings.LastIndex(jsonErr.Code, "#"); i >= 0 {
+		jsonErr.Code = jsonErr.Code[i+1:]
+	}
+	if c.DecodeError != nil {
+		return c.DecodeError(jsonErr, data)
+	}
+	return jsonErr
+}
@@ -0,0 +1,86 @@
+package aws_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestJSONClientCall(c *check.C) {
+	var target string
+	var params map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target = r.Header.Get("X-Amz-Target")
+		data, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(data, &params)
+		w.Write([]byte(`{"KeyId": "1234abcd"}`))
+	}))
+	defer server.Close()
+
+	client := aws.JSONClient{
+		Auth:         aws.Auth{AccessKey: "access", SecretKey: "secret"},
+		Region:       aws.USEast,
+		Endpoint:     server.URL,
+		TargetPrefix: "TrentService",
+		SigningName:  "kms",
+	}
+	var resp struct{ KeyId string }
+	err := client.Call("DescribeKey", map[string]string{"KeyId": "alias/key"}, &resp)
+	c.Assert(err, check.IsNil)
+	c.Assert(target, check.Equals, "TrentService.DescribeKey")
+	c.Assert(params, check.DeepEquals, map[string]string{"KeyId": "alias/key"})
+	c.Assert(resp.KeyId, check.Equals, "1234abcd")
+}
+
+func (s *S) TestJSONClientError(c *check.C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"__type": "com.amazonaws.ecs#ClientException", "message": "bad request", "reason": "MISSING"}`))
+	}))
+	defer server.Close()
+
+	client := aws.JSONClient{Endpoint: server.URL, TargetPrefix: "AmazonEC2ContainerServiceV20141113", SigningName: "ecs"}
+	err := client.Call("ListClusters", struct{}{}, nil)
+	c.Assert(err, check.DeepEquals, &aws.JSONError{Service: "ecs", StatusCode: 400, Code: "ClientException", Message: "bad request"})
+	c.Assert(err, check.ErrorMatches, "ecs: ClientException: bad request")
+	var apiErr aws.APIError
+	c.Assert(errors.As(err, &apiErr), check.Equals, true)
+	c.Assert(apiErr.HTTPStatus(), check.Equals, 400)
+
+	// DecodeError gets the fields that only some services send.
+	var reason string
+	client.DecodeError = func(err *aws.JSONError, body []byte) error {
+		var extra struct{ Reason string }
+		json.Unmarshal(body, &extra)
+		reason = extra.Reason
+		return err
+	}
+	err = client.Call("ListClusters", struct{}{}, nil)
+	c.Assert(err, check.ErrorMatches, "ecs: ClientException: bad request")
+	c.Assert(reason, check.Equals, "MISSING")
+}
+
+func (s *S) TestJSONClientHostPrefix(c *check.C) {
+	var hosts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+	}))
+	defer server.Close()
+	defer testutil.DialAll(server.Listener.Addr().String())()
+
+	client := aws.JSONClient{
+		Endpoint:     "http://logs.us-east-1.amazonaws.com",
+		TargetPrefix: "Logs_20140328",
+		SigningName:  "logs",
+		HostPrefixes: map[string]string{"StartLiveTail": "streaming-"},
+	}
+	c.Assert(client.Call("StartLiveTail", struct{}{}, nil), check.IsNil)
+	c.Assert(client.Call("DescribeLogGroups", struct{}{}, nil), check.IsNil)
+	c.Assert(hosts, check.DeepEquals, []string{"streaming-logs.us-east-1.amazonaws.com", "logs.us-east-1.amazonaws.com"})
+}
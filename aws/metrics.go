@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"time"
+)
+
+// Clients report to two interfaces: Metrics is called back around their
+// API calls, while MetricsSink receives measured values, such as the
+// iterator age of Kinesis shards, to be stored by a monitoring system.
+// SinkMetrics turns the callbacks of a Metrics into values of a
+// MetricsSink, to store call metrics alongside the others.
+
+// MetricsSink receives the measurements that clients export for
+// monitoring, such as the iterator age of Kinesis shards. Dimensions tell
+// what a value is about, e.g. {"ShardId": "shardId-000000000000"}, and unit
+// is a CloudWatch unit such as "Milliseconds" or "Count".
+//
+// cloudwatch.Publisher is a MetricsSink; other monitoring systems can be
+// plugged in by implementing PutMetric, which must not block.
+type MetricsSink interface {
+	PutMetric(name string, value float64, unit string, dimensions map[string]string)
+}
+
+// Metrics is called back by a client around its API calls, to count and
+// time them without wrapping every method. Service is the signing name of
+// the service, such as "cloudfront", and operation the API action, such as
+// "CreateInvalidation".
+//
+// RequestStart is called before the first attempt of a call, and
+// RequestFinish once the call is done, with its latency over all the
+// attempts and its error, if any. RequestError is called for every failed
+// attempt, and RequestRetry before retrying one, with the number of the
+// retry. Callbacks must not block. Embed NopMetrics to implement only some
+// of them.
+//
+// The CloudFront client takes a Metrics with cloudfront.WithMetrics, and
+// the other clients through the Metrics of a ResilientTransport.
+type Metrics interface {
+	RequestStart(service, operation string)
+	RequestFinish(service, operation string, latency time.Duration, err error)
+	RequestRetry(service, operation string, retry int, err error)
+	RequestError(service, operation string, err error)
+}
+
+// NopMetrics is a Metrics that ignores every callback.
+type NopMetrics struct{}
+
+func (NopMetrics) RequestStart(service, operation string)                                    {}
+func (NopMetrics) RequestFinish(service, operation string, latency time.Duration, err error) {}
+func (NopMetrics) RequestRetry(service, operation string, retry int, err error)              {}
+func (NopMetrics) RequestError(service, operation string, err error)                         {}
+
+// IsThrottle reports whether err is a throttling error of a service, so
+// that Metrics can count the throttled requests apart.
+func IsThrottle(err error) bool {
+	return isThrottlingException(err)
+}
+
+// SinkMetrics is a Metrics that puts to Sink, with the dimensions Service
+// and Operation, the metrics:
+//
+//	Requests   the calls made (Count)
+//	Latency    the latency of the calls (Milliseconds)
+//	Errors     the calls that failed (Count)
+//	Retries    the attempts retried (Count)
+//	Throttles  the attempts throttled (Count)
+type SinkMetrics struct {
+	Sink MetricsSink
+}
+
+func (m SinkMetrics) put(name string, value float64, unit, service, operation string) {
+	m.Sink.PutMetric(name, value, unit, map[string]string{"Service": service, "Operation": operation})
+}
+
+func (m SinkMetrics) RequestStart(service, operation string) {
+	m.put("Requests", 1, "Count", service, operation)
+}
+
+func (m SinkMetrics) RequestFinish(service, operation string, latency time.Duration, err error) {
+	m.put("Latency", float64(latency)/float64(time.Millisecond), "Milliseconds", service, operation)
+	if err != nil {
+		m.put("Errors", 1, "Count", service, operation)
+	}
+}
+
+func (m SinkMetrics) RequestRetry(service, operation string, retry int, err error) {
+	m.put("Retries", 1, "Count", service, operation)
+}
+
+func (m SinkMetrics) RequestError(service, operation string, err error) {
+	if IsThrottle(err) {
+		m.put("Throttles", 1, "Count", service, operation)
+	}
+}
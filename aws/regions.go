@@ -21,6 +21,17 @@ var USGovWest = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.us-gov-west-1.amazonaws.com",
 	"",
+	"https://kms.us-gov-west-1.amazonaws.com",
+	"https://lambda.us-gov-west-1.amazonaws.com",
+	"https://ecs.us-gov-west-1.amazonaws.com",
+	"https://streams.dynamodb.us-gov-west-1.amazonaws.com",
+	"https://logs.us-gov-west-1.amazonaws.com",
+	"https://acm.us-gov-west-1.amazonaws.com",
+	"https://wafv2.us-gov-west-1.amazonaws.com",
+	"https://api.ecr.us-gov-west-1.amazonaws.com",
+	"https://glacier.us-gov-west-1.amazonaws.com",
+	"https://secretsmanager.us-gov-west-1.amazonaws.com",
+	"https://ssm.us-gov-west-1.amazonaws.com",
 }
 
 var USEast = Region{
@@ -44,6 +55,17 @@ var USEast = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.us-east-1.amazonaws.com",
 	"https://elasticache.us-east-1.amazonaws.com",
+	"https://kms.us-east-1.amazonaws.com",
+	"https://lambda.us-east-1.amazonaws.com",
+	"https://ecs.us-east-1.amazonaws.com",
+	"https://streams.dynamodb.us-east-1.amazonaws.com",
+	"https://logs.us-east-1.amazonaws.com",
+	"https://acm.us-east-1.amazonaws.com",
+	"https://wafv2.us-east-1.amazonaws.com",
+	"https://api.ecr.us-east-1.amazonaws.com",
+	"https://glacier.us-east-1.amazonaws.com",
+	"https://secretsmanager.us-east-1.amazonaws.com",
+	"https://ssm.us-east-1.amazonaws.com",
 }
 
 var USWest = Region{
@@ -67,6 +89,17 @@ var USWest = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.us-west-1.amazonaws.com",
 	"https://elasticache.us-west-1.amazonaws.com",
+	"https://kms.us-west-1.amazonaws.com",
+	"https://lambda.us-west-1.amazonaws.com",
+	"https://ecs.us-west-1.amazonaws.com",
+	"https://streams.dynamodb.us-west-1.amazonaws.com",
+	"https://logs.us-west-1.amazonaws.com",
+	"https://acm.us-west-1.amazonaws.com",
+	"https://wafv2.us-west-1.amazonaws.com",
+	"https://api.ecr.us-west-1.amazonaws.com",
+	"https://glacier.us-west-1.amazonaws.com",
+	"https://secretsmanager.us-west-1.amazonaws.com",
+	"https://ssm.us-west-1.amazonaws.com",
 }
 
 var USWest2 = Region{
@@ -90,6 +123,17 @@ var USWest2 = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.us-west-2.amazonaws.com",
 	"https://elasticache.us-west-2.amazonaws.com",
+	"https://kms.us-west-2.amazonaws.com",
+	"https://lambda.us-west-2.amazonaws.com",
+	"https://ecs.us-west-2.amazonaws.com",
+	"https://streams.dynamodb.us-west-2.amazonaws.com",
+	"https://logs.us-west-2.amazonaws.com",
+	"https://acm.us-west-2.amazonaws.com",
+	"https://wafv2.us-west-2.amazonaws.com",
+	"https://api.ecr.us-west-2.amazonaws.com",
+	"https://glacier.us-west-2.amazonaws.com",
+	"https://secretsmanager.us-west-2.amazonaws.com",
+	"https://ssm.us-west-2.amazonaws.com",
 }
 
 var EUWest = Region{
@@ -113,6 +157,17 @@ var EUWest = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.eu-west-1.amazonaws.com",
 	"https://elasticache.eu-west-1.amazonaws.com",
+	"https://kms.eu-west-1.amazonaws.com",
+	"https://lambda.eu-west-1.amazonaws.com",
+	"https://ecs.eu-west-1.amazonaws.com",
+	"https://streams.dynamodb.eu-west-1.amazonaws.com",
+	"https://logs.eu-west-1.amazonaws.com",
+	"https://acm.eu-west-1.amazonaws.com",
+	"https://wafv2.eu-west-1.amazonaws.com",
+	"https://api.ecr.eu-west-1.amazonaws.com",
+	"https://glacier.eu-west-1.amazonaws.com",
+	"https://secretsmanager.eu-west-1.amazonaws.com",
+	"https://ssm.eu-west-1.amazonaws.com",
 }
 
 var EUCentral = Region{
@@ -136,6 +191,17 @@ var EUCentral = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.eu-central-1.amazonaws.com",
 	"",
+	"https://kms.eu-central-1.amazonaws.com",
+	"https://lambda.eu-central-1.amazonaws.com",
+	"https://ecs.eu-central-1.amazonaws.com",
+	"https://streams.dynamodb.eu-central-1.amazonaws.com",
+	"https://logs.eu-central-1.amazonaws.com",
+	"https://acm.eu-central-1.amazonaws.com",
+	"https://wafv2.eu-central-1.amazonaws.com",
+	"https://api.ecr.eu-central-1.amazonaws.com",
+	"https://glacier.eu-central-1.amazonaws.com",
+	"https://secretsmanager.eu-central-1.amazonaws.com",
+	"https://ssm.eu-central-1.amazonaws.com",
 }
 
 var APSoutheast = Region{
@@ -159,6 +225,17 @@ var APSoutheast = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.ap-southeast-1.amazonaws.com",
 	"https://elasticache.ap-southeast-1.amazonaws.com",
+	"https://kms.ap-southeast-1.amazonaws.com",
+	"https://lambda.ap-southeast-1.amazonaws.com",
+	"https://ecs.ap-southeast-1.amazonaws.com",
+	"https://streams.dynamodb.ap-southeast-1.amazonaws.com",
+	"https://logs.ap-southeast-1.amazonaws.com",
+	"https://acm.ap-southeast-1.amazonaws.com",
+	"https://wafv2.ap-southeast-1.amazonaws.com",
+	"https://api.ecr.ap-southeast-1.amazonaws.com",
+	"https://glacier.ap-southeast-1.amazonaws.com",
+	"https://secretsmanager.ap-southeast-1.amazonaws.com",
+	"https://ssm.ap-southeast-1.amazonaws.com",
 }
 
 var APSoutheast2 = Region{
@@ -182,6 +259,17 @@ var APSoutheast2 = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.ap-southeast-2.amazonaws.com",
 	"https://elasticache.ap-southeast-2.amazonaws.com",
+	"https://kms.ap-southeast-2.amazonaws.com",
+	"https://lambda.ap-southeast-2.amazonaws.com",
+	"https://ecs.ap-southeast-2.amazonaws.com",
+	"https://streams.dynamodb.ap-southeast-2.amazonaws.com",
+	"https://logs.ap-southeast-2.amazonaws.com",
+	"https://acm.ap-southeast-2.amazonaws.com",
+	"https://wafv2.ap-southeast-2.amazonaws.com",
+	"https://api.ecr.ap-southeast-2.amazonaws.com",
+	"https://glacier.ap-southeast-2.amazonaws.com",
+	"https://secretsmanager.ap-southeast-2.amazonaws.com",
+	"https://ssm.ap-southeast-2.amazonaws.com",
 }
 
 var APSouth = Region{
@@ -205,6 +293,17 @@ var APSouth = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.ap-south-1.amazonaws.com",
 	"https://elasticache.ap-south-1.amazonaws.com",
+	"https://kms.ap-south-1.amazonaws.com",
+	"https://lambda.ap-south-1.amazonaws.com",
+	"https://ecs.ap-south-1.amazonaws.com",
+	"https://streams.dynamodb.ap-south-1.amazonaws.com",
+	"https://logs.ap-south-1.amazonaws.com",
+	"https://acm.ap-south-1.amazonaws.com",
+	"https://wafv2.ap-south-1.amazonaws.com",
+	"https://api.ecr.ap-south-1.amazonaws.com",
+	"https://glacier.ap-south-1.amazonaws.com",
+	"https://secretsmanager.ap-south-1.amazonaws.com",
+	"https://ssm.ap-south-1.amazonaws.com",
 }
 
 var APNortheast = Region{
@@ -228,6 +327,17 @@ var APNortheast = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.ap-northeast-1.amazonaws.com",
 	"https://elasticache.ap-northeast-1.amazonaws.com",
+	"https://kms.ap-northeast-1.amazonaws.com",
+	"https://lambda.ap-northeast-1.amazonaws.com",
+	"https://ecs.ap-northeast-1.amazonaws.com",
+	"https://streams.dynamodb.ap-northeast-1.amazonaws.com",
+	"https://logs.ap-northeast-1.amazonaws.com",
+	"https://acm.ap-northeast-1.amazonaws.com",
+	"https://wafv2.ap-northeast-1.amazonaws.com",
+	"https://api.ecr.ap-northeast-1.amazonaws.com",
+	"https://glacier.ap-northeast-1.amazonaws.com",
+	"https://secretsmanager.ap-northeast-1.amazonaws.com",
+	"https://ssm.ap-northeast-1.amazonaws.com",
 }
 
 var APNortheast2 = Region{
@@ -251,6 +361,17 @@ var APNortheast2 = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.ap-northeast-2.amazonaws.com",
 	"https://elasticache.ap-northeast-2.amazonaws.com",
+	"https://kms.ap-northeast-2.amazonaws.com",
+	"https://lambda.ap-northeast-2.amazonaws.com",
+	"https://ecs.ap-northeast-2.amazonaws.com",
+	"https://streams.dynamodb.ap-northeast-2.amazonaws.com",
+	"https://logs.ap-northeast-2.amazonaws.com",
+	"https://acm.ap-northeast-2.amazonaws.com",
+	"https://wafv2.ap-northeast-2.amazonaws.com",
+	"https://api.ecr.ap-northeast-2.amazonaws.com",
+	"https://glacier.ap-northeast-2.amazonaws.com",
+	"https://secretsmanager.ap-northeast-2.amazonaws.com",
+	"https://ssm.ap-northeast-2.amazonaws.com",
 }
 
 var SAEast = Region{
@@ -274,6 +395,17 @@ var SAEast = Region{
 	"https://sts.amazonaws.com",
 	"https://cloudformation.sa-east-1.amazonaws.com",
 	"https://elasticache.sa-east-1.amazonaws.com",
+	"https://kms.sa-east-1.amazonaws.com",
+	"https://lambda.sa-east-1.amazonaws.com",
+	"https://ecs.sa-east-1.amazonaws.com",
+	"https://streams.dynamodb.sa-east-1.amazonaws.com",
+	"https://logs.sa-east-1.amazonaws.com",
+	"https://acm.sa-east-1.amazonaws.com",
+	"https://wafv2.sa-east-1.amazonaws.com",
+	"https://api.ecr.sa-east-1.amazonaws.com",
+	"https://glacier.sa-east-1.amazonaws.com",
+	"https://secretsmanager.sa-east-1.amazonaws.com",
+	"https://ssm.sa-east-1.amazonaws.com",
 }
 
 var CNNorth1 = Region{
@@ -297,4 +429,15 @@ var CNNorth1 = Region{
 	"https://sts.cn-north-1.amazonaws.com.cn",
 	"",
 	"",
+	"https://kms.cn-north-1.amazonaws.com.cn",
+	"https://lambda.cn-north-1.amazonaws.com.cn",
+	"https://ecs.cn-north-1.amazonaws.com.cn",
+	"https://streams.dynamodb.cn-north-1.amazonaws.com.cn",
+	"https://logs.cn-north-1.amazonaws.com.cn",
+	"https://acm.cn-north-1.amazonaws.com.cn",
+	"https://wafv2.cn-north-1.amazonaws.com.cn",
+	"https://api.ecr.cn-north-1.amazonaws.com.cn",
+	"https://glacier.cn-north-1.amazonaws.com.cn",
+	"https://secretsmanager.cn-north-1.amazonaws.com.cn",
+	"https://ssm.cn-north-1.amazonaws.com.cn",
 }
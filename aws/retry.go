@@ -1,9 +1,11 @@
 package aws
 
 import (
+	"errors"
 	"math/rand"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -15,6 +17,12 @@ const (
 	defaultMaxRetries    = 3
 	dynamoDBScale        = 25 * time.Millisecond
 	dynamoDBMaxRetries   = 10
+
+	// The retry quota of AdaptiveRetryPolicy, as in the AWS SDKs.
+	retryQuotaCapacity = 500
+	retryCost          = 5
+	timeoutRetryCost   = 10
+	noRetryIncrement   = 1
 )
 
 // A RetryPolicy encapsulates a strategy for implementing client retries.
@@ -46,7 +54,7 @@ func (policy DefaultRetryPolicy) ShouldRetry(target string, r *http.Response, er
 // Delay implements the RetryPolicy Delay method.
 func (policy DefaultRetryPolicy) Delay(target string, r *http.Response, err error, numRetries int) time.Duration {
 	scale := defaultScale
-	if err, ok := err.(*Error); ok && isThrottlingException(err) {
+	if isThrottlingException(err) {
 		scale = throttlingScale + time.Duration(rand.Int63n(int64(throttlingScaleRange)))
 	}
 	return exponentialBackoff(numRetries, scale)
@@ -85,6 +93,142 @@ func (policy NeverRetryPolicy) Delay(target string, r *http.Response, err error,
 	return time.Duration(0)
 }
 
+// A SuccessRecorder is a RetryPolicy that needs to know about the requests
+// that succeeded, such as AdaptiveRetryPolicy. Clients report successes
+// with RecordSuccess.
+type SuccessRecorder interface {
+	// RecordSuccess is called when a request succeeds after numRetries
+	// retries.
+	RecordSuccess(target string, numRetries int)
+}
+
+// RecordSuccess reports a successful request to policy if it is a
+// SuccessRecorder.
+func RecordSuccess(policy RetryPolicy, target string, numRetries int) {
+	if recorder, ok := policy.(SuccessRecorder); ok {
+		recorder.RecordSuccess(target, numRetries)
+	}
+}
+
+// AdaptiveRetryPolicy limits the retries of another policy with a token
+// bucket, so that a throttling event or an outage doesn't turn into a
+// retry storm.
+//
+// Every retry takes 5 tokens from the bucket, or 10 when retrying a
+// timeout, and is not made if the bucket is short of them. A request that
+// succeeds puts a token back, or 5 if it was retried, up to the 500 the
+// bucket starts with. The bucket is shared by the copies of the policy, and
+// so by all the clients it is given to. NewAdaptiveRetryPolicy creates a
+// new bucket; a policy built as a struct literal shares a package-wide one,
+// and retries with DefaultRetryPolicy if Policy is nil.
+//
+// See https://docs.aws.amazon.com/sdkref/latest/guide/feature-retry-behavior.html
+type AdaptiveRetryPolicy struct {
+	// Policy decides which failures are retried and how long to wait.
+	Policy RetryPolicy
+
+	// Deadline, if set, returns the time by which the request must be
+	// done. No retry is made once it has passed, and delays are cut
+	// short at it.
+	Deadline DeadlineFunc
+
+	quota *retryQuota
+}
+
+type retryQuota struct {
+	sync.Mutex
+	tokens int
+}
+
+// defaultRetryQuota is the bucket of the policies that were not created
+// with NewAdaptiveRetryPolicy.
+var defaultRetryQuota = &retryQuota{tokens: retryQuotaCapacity}
+
+func (policy AdaptiveRetryPolicy) retryQuota() *retryQuota {
+	if policy.quota == nil {
+		return defaultRetryQuota
+	}
+	return policy.quota
+}
+
+func (policy AdaptiveRetryPolicy) policy() RetryPolicy {
+	if policy.Policy == nil {
+		return DefaultRetryPolicy{}
+	}
+	return policy.Policy
+}
+
+// NewAdaptiveRetryPolicy returns an AdaptiveRetryPolicy limiting the
+// retries of policy, with a full token bucket.
+func NewAdaptiveRetryPolicy(policy RetryPolicy) AdaptiveRetryPolicy {
+	return AdaptiveRetryPolicy{
+		Policy: policy,
+		quota:  &retryQuota{tokens: retryQuotaCapacity},
+	}
+}
+
+// WithDeadline returns a copy of the policy sharing its token bucket that
+// gives up on retrying once deadline is reached.
+func (policy AdaptiveRetryPolicy) WithDeadline(deadline time.Time) AdaptiveRetryPolicy {
+	policy.Deadline = func() time.Time { return deadline }
+	return policy
+}
+
+// ShouldRetry implements the RetryPolicy ShouldRetry method.
+func (policy AdaptiveRetryPolicy) ShouldRetry(target string, r *http.Response, err error, numRetries int) bool {
+	if !policy.policy().ShouldRetry(target, r, err, numRetries) {
+		return false
+	}
+	if policy.Deadline != nil && !time.Now().Before(policy.Deadline()) {
+		return false
+	}
+
+	cost := retryCost
+	if err, ok := err.(net.Error); ok && err.Timeout() {
+		cost = timeoutRetryCost
+	}
+
+	quota := policy.retryQuota()
+	quota.Lock()
+	defer quota.Unlock()
+	if quota.tokens < cost {
+		return false
+	}
+	quota.tokens -= cost
+	return true
+}
+
+// Delay implements the RetryPolicy Delay method. The delay of the
+// underlying policy is cut short at the deadline.
+func (policy AdaptiveRetryPolicy) Delay(target string, r *http.Response, err error, numRetries int) time.Duration {
+	delay := policy.policy().Delay(target, r, err, numRetries)
+	if policy.Deadline != nil {
+		if remaining := policy.Deadline().Sub(time.Now()); remaining < delay {
+			delay = remaining
+		}
+		if delay < 0 {
+			delay = 0
+		}
+	}
+	return delay
+}
+
+// RecordSuccess implements the SuccessRecorder RecordSuccess method.
+func (policy AdaptiveRetryPolicy) RecordSuccess(target string, numRetries int) {
+	refund := noRetryIncrement
+	if numRetries > 0 {
+		refund = retryCost
+	}
+
+	quota := policy.retryQuota()
+	quota.Lock()
+	defer quota.Unlock()
+	quota.tokens += refund
+	if quota.tokens > retryQuotaCapacity {
+		quota.tokens = retryQuotaCapacity
+	}
+}
+
 // shouldRetry determines if we should retry the request.
 //
 // See http://docs.aws.amazon.com/general/latest/gr/api-retries.html.
@@ -99,13 +243,18 @@ func shouldRetry(r *http.Response, err error, numRetries int, maxRetries int) bo
 		return true
 	}
 
-	// Always retry 5xx responses.
+	// Always retry 5xx responses, whether the client hands over the
+	// response or only the error it was turned into.
 	if r != nil && r.StatusCode >= 500 {
 		return true
 	}
+	var apiErr APIError
+	if errors.As(err, &apiErr) && apiErr.HTTPStatus() >= 500 {
+		return true
+	}
 
 	// Always retry throttling exceptions.
-	if err, ok := err.(ServiceError); ok && isThrottlingException(err) {
+	if isThrottlingException(err) {
 		return true
 	}
 
@@ -126,9 +275,20 @@ func exponentialBackoff(numRetries int, scale time.Duration) time.Duration {
 	return delay
 }
 
-func isThrottlingException(err ServiceError) bool {
-	switch err.ErrorCode() {
-	case "Throttling", "ThrottlingException", "ProvisionedThroughputExceededException":
+func isThrottlingException(err error) bool {
+	var code string
+	var serr ServiceError
+	var apiErr APIError
+	switch {
+	case errors.As(err, &serr):
+		code = serr.ErrorCode()
+	case errors.As(err, &apiErr):
+		code = apiErr.Code()
+	}
+	switch code {
+	case "Throttling", "ThrottlingException", "ProvisionedThroughputExceededException",
+		"RequestLimitExceeded", "TooManyRequestsException", "RequestThrottled", "SlowDown",
+		"PriorRequestNotComplete":
 		return true
 	default:
 		return false
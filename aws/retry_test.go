@@ -1,6 +1,7 @@
 package aws
 
 import (
+	"fmt"
 	"math/rand"
 	"net"
 	"net/http"
@@ -301,3 +302,158 @@ func TestNeverRetryPolicy(t *testing.T) {
 		}
 	}
 }
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestAdaptiveRetryPolicy(t *testing.T) {
+	policy := NewAdaptiveRetryPolicy(DynamoDBRetryPolicy{})
+	throttled := &Error{Code: "ProvisionedThroughputExceededException"}
+
+	// Failures the underlying policy doesn't retry cost nothing.
+	if policy.ShouldRetry("", nil, &Error{Code: "ValidationException"}, 0) {
+		t.Errorf("ShouldRetry returned true for a ValidationException")
+	}
+
+	// The bucket of 500 tokens allows 100 retries.
+	for i := 0; i < 100; i++ {
+		if !policy.ShouldRetry("", nil, throttled, 0) {
+			t.Fatalf("ShouldRetry returned false after %d retries", i)
+		}
+	}
+	if policy.ShouldRetry("", nil, throttled, 0) {
+		t.Fatalf("ShouldRetry returned true with an empty bucket")
+	}
+
+	// Successes refill the bucket shared by the copies of the policy.
+	shared := policy
+	for i := 0; i < 4; i++ {
+		shared.RecordSuccess("", 0)
+	}
+	if policy.ShouldRetry("", nil, throttled, 0) {
+		t.Errorf("ShouldRetry returned true with 4 tokens")
+	}
+	RecordSuccess(shared, "", 0)
+	if !policy.ShouldRetry("", nil, throttled, 0) {
+		t.Errorf("ShouldRetry returned false with 5 tokens")
+	}
+
+	// Retried successes refund a retry; timeouts cost two.
+	RecordSuccess(policy, "", 1)
+	if policy.ShouldRetry("", nil, timeoutError{}, 0) {
+		t.Errorf("ShouldRetry returned true for a timeout with 5 tokens")
+	}
+	RecordSuccess(policy, "", 1)
+	if !policy.ShouldRetry("", nil, timeoutError{}, 0) {
+		t.Errorf("ShouldRetry returned false for a timeout with 10 tokens")
+	}
+	if policy.quota.tokens != 0 {
+		t.Errorf("bucket has %d tokens, expected 0", policy.quota.tokens)
+	}
+
+	// The bucket never holds more than it starts with.
+	full := NewAdaptiveRetryPolicy(DefaultRetryPolicy{})
+	full.RecordSuccess("", 3)
+	if full.quota.tokens != retryQuotaCapacity {
+		t.Errorf("bucket has %d tokens, expected %d", full.quota.tokens, retryQuotaCapacity)
+	}
+}
+
+func TestAdaptiveRetryPolicyDeadline(t *testing.T) {
+	policy := NewAdaptiveRetryPolicy(DynamoDBRetryPolicy{})
+	throttled := &Error{Code: "ProvisionedThroughputExceededException"}
+
+	if policy.WithDeadline(time.Now().Add(-time.Second)).ShouldRetry("", nil, throttled, 0) {
+		t.Errorf("ShouldRetry returned true after the deadline")
+	}
+	if policy.quota.tokens != retryQuotaCapacity {
+		t.Errorf("a retry past the deadline took tokens")
+	}
+
+	// 800ms is cut short at the deadline.
+	soon := policy.WithDeadline(time.Now().Add(50 * time.Millisecond))
+	if !soon.ShouldRetry("", nil, throttled, 5) {
+		t.Errorf("ShouldRetry returned false before the deadline")
+	}
+	if delay := soon.Delay("", nil, throttled, 5); delay > 50*time.Millisecond {
+		t.Errorf("Delay returned %v, expected at most 50ms", delay)
+	}
+	if delay := policy.Delay("", nil, throttled, 5); delay != 800*time.Millisecond {
+		t.Errorf("Delay returned %v, expected 800ms", delay)
+	}
+}
+
+func TestIsThrottle(t *testing.T) {
+	if !IsThrottle(&Error{Code: "Throttling"}) {
+		t.Error("expected Throttling to be a throttling error")
+	}
+	if !IsThrottle(fmt.Errorf("listing: %w", &Error{Code: "ThrottlingException"})) {
+		t.Error("expected a wrapped ThrottlingException to be a throttling error")
+	}
+	if IsThrottle(&Error{Code: "AccessDenied"}) {
+		t.Error("expected AccessDenied not to be a throttling error")
+	}
+	if IsThrottle(nil) {
+		t.Error("expected nil not to be a throttling error")
+	}
+}
+
+func TestAdaptiveRetryPolicyZeroValue(t *testing.T) {
+	defer func() { defaultRetryQuota.tokens = retryQuotaCapacity }()
+
+	// A struct literal retries with DefaultRetryPolicy, from the
+	// package-wide bucket.
+	var policy AdaptiveRetryPolicy
+	throttled := &Error{Code: "Throttling"}
+	if !policy.ShouldRetry("", nil, throttled, 0) {
+		t.Errorf("ShouldRetry returned false for a throttling error")
+	}
+	if policy.ShouldRetry("", nil, throttled, defaultMaxRetries) {
+		t.Errorf("ShouldRetry returned true past the retries of DefaultRetryPolicy")
+	}
+	if delay := policy.Delay("", nil, nil, 1); delay != 600*time.Millisecond {
+		t.Errorf("Delay returned %v, expected 600ms", delay)
+	}
+	if defaultRetryQuota.tokens != retryQuotaCapacity-retryCost {
+		t.Errorf("bucket has %d tokens, expected %d", defaultRetryQuota.tokens, retryQuotaCapacity-retryCost)
+	}
+	AdaptiveRetryPolicy{Policy: DynamoDBRetryPolicy{}}.RecordSuccess("", 1)
+	if defaultRetryQuota.tokens != retryQuotaCapacity {
+		t.Errorf("bucket has %d tokens, expected %d", defaultRetryQuota.tokens, retryQuotaCapacity)
+	}
+}
+
+// apiError is an error of a service package, known to the retry policies
+// only as an APIError.
+type apiError struct {
+	status int
+	code   string
+}
+
+func (e *apiError) Error() string { return e.code }
+
+func (e *apiError) As(target interface{}) bool {
+	return AsAPIError(target, e, e.status, e.code, "", "")
+}
+
+func TestShouldRetryAPIError(t *testing.T) {
+	policy := DefaultRetryPolicy{}
+	if !policy.ShouldRetry("", nil, &apiError{503, "ServiceUnavailable"}, 0) {
+		t.Errorf("ShouldRetry returned false for a 503 error")
+	}
+	if !policy.ShouldRetry("", nil, fmt.Errorf("put: %w", &apiError{503, "SlowDown"}), 0) {
+		t.Errorf("ShouldRetry returned false for a wrapped SlowDown error")
+	}
+	if !policy.ShouldRetry("", nil, &apiError{400, "RequestLimitExceeded"}, 0) {
+		t.Errorf("ShouldRetry returned false for a RequestLimitExceeded error")
+	}
+	if policy.ShouldRetry("", nil, &apiError{403, "AccessDenied"}, 0) {
+		t.Errorf("ShouldRetry returned true for an AccessDenied error")
+	}
+	if !IsThrottle(&apiError{429, "TooManyRequestsException"}) {
+		t.Errorf("expected TooManyRequestsException to be a throttling error")
+	}
+}
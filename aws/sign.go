@@ -269,53 +269,78 @@ func (s *V4Signer) canonicalURI(u *url.URL) string {
 	return canonicalPath
 }
 
+// canonicalQueryString sorts the query parameters by name, and then by
+// value, after URI-encoding them. Parameters without a value, such as
+// "?acl", are given an empty one.
 func (s *V4Signer) canonicalQueryString(u *url.URL) string {
-	var a []string
+	type param struct{ k, v string }
+	var params []param
 	for k, vs := range u.Query() {
-		k = url.QueryEscape(k)
+		k = uriEncode(k)
 		for _, v := range vs {
-			if v == "" {
-				a = append(a, k+"=")
-			} else {
-				v = url.QueryEscape(v)
-				a = append(a, k+"="+v)
-			}
+			params = append(params, param{k, uriEncode(v)})
 		}
 	}
-	sort.Strings(a)
+	sort.Slice(params, func(i, j int) bool {
+		if params[i].k != params[j].k {
+			return params[i].k < params[j].k
+		}
+		return params[i].v < params[j].v
+	})
+	a := make([]string, len(params))
+	for i, p := range params {
+		a[i] = p.k + "=" + p.v
+	}
 	return strings.Join(a, "&")
 }
 
-func (s *V4Signer) canonicalHeaders(h http.Header) string {
-	i, a, lowerCase := 0, make([]string, len(h)), make(map[string][]string)
+// uriEncode escapes all but the unreserved characters of RFC 3986, with
+// spaces as %20 rather than the + of url.QueryEscape.
+func uriEncode(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
 
-	for k, v := range h {
-		lowerCase[strings.ToLower(k)] = v
+// canonicalHeaders lists the headers by lowercase name, with the values
+// of the headers of the same name joined in the order they were added.
+// Names differing only in case, which a header map set directly can hold,
+// are merged in a fixed order. The values are trimmed, and their runs of
+// spaces collapsed; h itself is left unchanged.
+func (s *V4Signer) canonicalHeaders(h http.Header) string {
+	names := make([]string, 0, len(h))
+	for k := range h {
+		names = append(names, k)
 	}
+	sort.Strings(names)
 
+	values := make(map[string][]string)
 	var keys []string
-	for k := range lowerCase {
-		keys = append(keys, k)
+	for _, k := range names {
+		lk := strings.ToLower(k)
+		if _, ok := values[lk]; !ok {
+			keys = append(keys, lk)
+		}
+		for _, v := range h[k] {
+			values[lk] = append(values[lk], strings.Join(strings.Fields(v), " "))
+		}
 	}
 	sort.Strings(keys)
 
-	for _, k := range keys {
-		v := lowerCase[k]
-		for j, w := range v {
-			v[j] = strings.Trim(w, " ")
-		}
-		sort.Strings(v)
-		a[i] = strings.ToLower(k) + ":" + strings.Join(v, ",")
-		i++
+	a := make([]string, len(keys))
+	for i, k := range keys {
+		a[i] = k + ":" + strings.Join(values[k], ",")
 	}
 	return strings.Join(a, "\n")
 }
 
 func (s *V4Signer) signedHeaders(h http.Header) string {
-	i, a := 0, make([]string, len(h))
-	for k, _ := range h {
-		a[i] = strings.ToLower(k)
-		i++
+	seen := make(map[string]bool)
+	var a []string
+	for k := range h {
+		k = strings.ToLower(k)
+		if !seen[k] {
+			seen[k] = true
+			a = append(a, k)
+		}
 	}
 	sort.Strings(a)
 	return strings.Join(a, ";")
@@ -15,6 +15,8 @@ type V4SignerSuite struct {
 	auth   aws.Auth
 	region aws.Region
 	cases  []V4SignerSuiteCase
+	// cases2015 are signed for the service "service" rather than "host".
+	cases2015 []V4SignerSuiteCase
 }
 
 type V4SignerSuiteCase struct {
@@ -41,7 +43,9 @@ func (s *V4SignerSuite) SetUpSuite(c *check.C) {
 	// Test cases from the Signature Version 4 Test Suite (http://goo.gl/nguvs0)
 	s.cases = append(s.cases,
 
-		// get-header-key-duplicate
+		// get-header-key-duplicate, with the values in the order they were
+		// added, as the current suite and the services expect, instead of
+		// sorted.
 		V4SignerSuiteCase{
 			label: "get-header-key-duplicate",
 			request: V4SignerSuiteCaseRequest{
@@ -50,13 +54,14 @@ func (s *V4SignerSuite) SetUpSuite(c *check.C) {
 				url:     "/",
 				headers: []string{"DATE:Mon, 09 Sep 2011 23:36:00 GMT", "ZOO:zoobar", "zoo:foobar", "zoo:zoobar"},
 			},
-			canonicalRequest: "POST\n/\n\ndate:Mon, 09 Sep 2011 23:36:00 GMT\nhost:host.foo.com\nzoo:foobar,zoobar,zoobar\n\ndate;host;zoo\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
-			stringToSign:     "AWS4-HMAC-SHA256\n20110909T233600Z\n20110909/us-east-1/host/aws4_request\n3c52f0eaae2b61329c0a332e3fa15842a37bc5812cf4d80eb64784308850e313",
-			signature:        "54afcaaf45b331f81cd2edb974f7b824ff4dd594cbbaa945ed636b48477368ed",
-			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20110909/us-east-1/host/aws4_request, SignedHeaders=date;host;zoo, Signature=54afcaaf45b331f81cd2edb974f7b824ff4dd594cbbaa945ed636b48477368ed",
+			canonicalRequest: "POST\n/\n\ndate:Mon, 09 Sep 2011 23:36:00 GMT\nhost:host.foo.com\nzoo:zoobar,foobar,zoobar\n\ndate;host;zoo\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20110909T233600Z\n20110909/us-east-1/host/aws4_request\n27e5a0aba5b78c3d4937ba61a36badc9919a9b9773a6442b486781bd9872169e",
+			signature:        "e466e59a8f69db46393c688fc3b4fdca8de56046bdab1d963ea3d9c27f5781f0",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20110909/us-east-1/host/aws4_request, SignedHeaders=date;host;zoo, Signature=e466e59a8f69db46393c688fc3b4fdca8de56046bdab1d963ea3d9c27f5781f0",
 		},
 
-		// get-header-value-order
+		// get-header-value-order, with the values in the order they were
+		// added.
 		V4SignerSuiteCase{
 			label: "get-header-value-order",
 			request: V4SignerSuiteCaseRequest{
@@ -65,10 +70,10 @@ func (s *V4SignerSuite) SetUpSuite(c *check.C) {
 				url:     "/",
 				headers: []string{"DATE:Mon, 09 Sep 2011 23:36:00 GMT", "p:z", "p:a", "p:p", "p:a"},
 			},
-			canonicalRequest: "POST\n/\n\ndate:Mon, 09 Sep 2011 23:36:00 GMT\nhost:host.foo.com\np:a,a,p,z\n\ndate;host;p\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
-			stringToSign:     "AWS4-HMAC-SHA256\n20110909T233600Z\n20110909/us-east-1/host/aws4_request\n94c0389fefe0988cbbedc8606f0ca0b485b48da010d09fc844b45b697c8924fe",
-			signature:        "d2973954263943b11624a11d1c963ca81fb274169c7868b2858c04f083199e3d",
-			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20110909/us-east-1/host/aws4_request, SignedHeaders=date;host;p, Signature=d2973954263943b11624a11d1c963ca81fb274169c7868b2858c04f083199e3d",
+			canonicalRequest: "POST\n/\n\ndate:Mon, 09 Sep 2011 23:36:00 GMT\nhost:host.foo.com\np:z,a,p,a\n\ndate;host;p\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20110909T233600Z\n20110909/us-east-1/host/aws4_request\ne455f0635c9f5336ee1fad0d35179621427861240fbb106a16d0b93ed7f6bf16",
+			signature:        "bb7956f64dfab89c02bcf8ce99819d83b830048c6fad8a24e1fbbc49ee200e8a",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20110909/us-east-1/host/aws4_request, SignedHeaders=date;host;p, Signature=bb7956f64dfab89c02bcf8ce99819d83b830048c6fad8a24e1fbbc49ee200e8a",
 		},
 
 		// get-header-value-trim
@@ -508,12 +513,206 @@ func (s *V4SignerSuite) SetUpSuite(c *check.C) {
 			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20110909/us-east-1/host/aws4_request, SignedHeaders=content-type;date;host, Signature=5a15b22cf462f047318703b92e6f4f38884e4a7ab7b1d6426ca46a8bd1c26cbc",
 		},
 	)
+
+	// Test cases from the current Signature Version 4 Test Suite
+	// (https://docs.aws.amazon.com/general/latest/gr/signature-v4-test-suite.html),
+	// followed by cases for the query strings it doesn't cover.
+	s.cases2015 = append(s.cases2015,
+		V4SignerSuiteCase{
+			label: "get-vanilla",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\n\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\nbb579772317eb040ac9ed261061d46c1f17a8133879d6129b6e1c25292927e63",
+			signature:        "5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31",
+		},
+		V4SignerSuiteCase{
+			label: "get-vanilla-query-order-key-case",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?Param2=value2&Param1=value1",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\nParam1=value1&Param2=value2\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n816cd5b414d056048ba4f7c5386d6e0533120fb1fcfa93762cf0fc39e2cf19e0",
+			signature:        "b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500",
+		},
+		V4SignerSuiteCase{
+			label: "get-vanilla-query-order-key",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?Param1=value2&Param1=Value1",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\nParam1=Value1&Param1=value2\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n704b4cef673542d84cdff252633f065e8daeba5f168b77116f8b1bcaf3d38f89",
+			signature:        "eedbc4e291e521cf13422ffca22be7d2eb8146eecf653089df300a15b2382bd1",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=eedbc4e291e521cf13422ffca22be7d2eb8146eecf653089df300a15b2382bd1",
+		},
+		V4SignerSuiteCase{
+			label: "get-vanilla-query-unreserved",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?-._~0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz=-._~0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\n-._~0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz=-._~0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n1375a04cf3bfeedf65f312b763806ea4159b8d7eb9a890faf31bf971f660701f",
+			signature:        "c0e2549664ab6caf8a0e49ec520df161cca33ec1de41067db4994a4467d458ff",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=c0e2549664ab6caf8a0e49ec520df161cca33ec1de41067db4994a4467d458ff",
+		},
+		V4SignerSuiteCase{
+			label: "get-vanilla-utf8-query",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?ሴ=bar",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\n%E1%88%B4=bar\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\neb30c5bed55734080471a834cc727ae56beb50e5f39d1bff6d0d38cb192a7073",
+			signature:        "2cdec8eed098649ff3a119c94853b13c643bcf08f8b0a1d91e12c9027818dd04",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=2cdec8eed098649ff3a119c94853b13c643bcf08f8b0a1d91e12c9027818dd04",
+		},
+		V4SignerSuiteCase{
+			label: "get-space",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/example%20space/",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/example%20space/\n\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n63ee75631ed7234ae61b5f736dfc7754cdccfedbff4b5128a915706ee9390d86",
+			signature:        "652487583200325589f1fba4c7e578f72c47cb61beeca81406b39ddec1366741",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=652487583200325589f1fba4c7e578f72c47cb61beeca81406b39ddec1366741",
+		},
+		V4SignerSuiteCase{
+			label: "get-header-key-duplicate",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/",
+				headers: []string{"My-Header1:value2", "My-Header1:value2", "My-Header1:value1", "X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\n\nhost:example.amazonaws.com\nmy-header1:value2,value2,value1\nx-amz-date:20150830T123600Z\n\nhost;my-header1;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\ndc7f04a3abfde8d472b0ab1a418b741b7c67174dad1551b4117b15527fbe966c",
+			signature:        "c9d5ea9f3f72853aea855b47ea873832890dbdd183b4468f858259531a5138ea",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;my-header1;x-amz-date, Signature=c9d5ea9f3f72853aea855b47ea873832890dbdd183b4468f858259531a5138ea",
+		},
+		V4SignerSuiteCase{
+			label: "get-header-value-order",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/",
+				headers: []string{"My-Header1:value4", "My-Header1:value1", "My-Header1:value3", "My-Header1:value2", "X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\n\nhost:example.amazonaws.com\nmy-header1:value4,value1,value3,value2\nx-amz-date:20150830T123600Z\n\nhost;my-header1;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n31ce73cd3f3d9f66977ad3dd957dc47af14df92fcd8509f59b349e9137c58b86",
+			signature:        "08c7e5a9acfcfeb3ab6b2185e75ce8b1deb5e634ec47601a50643f830c755c01",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;my-header1;x-amz-date, Signature=08c7e5a9acfcfeb3ab6b2185e75ce8b1deb5e634ec47601a50643f830c755c01",
+		},
+		V4SignerSuiteCase{
+			label: "get-header-value-trim",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/",
+				headers: []string{"My-Header1: value1", "My-Header2: \"a   b   c\"", "X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\n\nhost:example.amazonaws.com\nmy-header1:value1\nmy-header2:\"a b c\"\nx-amz-date:20150830T123600Z\n\nhost;my-header1;my-header2;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\na726db9b0df21c14f559d0a978e563112acb1b9e05476f0a6a1c7d68f28605c7",
+			signature:        "acc3ed3afb60bb290fc8d2dd0098b9911fcaa05412b367055dee359757a9c736",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;my-header1;my-header2;x-amz-date, Signature=acc3ed3afb60bb290fc8d2dd0098b9911fcaa05412b367055dee359757a9c736",
+		},
+		V4SignerSuiteCase{
+			label: "post-vanilla",
+			request: V4SignerSuiteCaseRequest{
+				method:  "POST",
+				host:    "example.amazonaws.com",
+				url:     "/",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "POST\n/\n\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n553f88c9e4d10fc9e109e2aeb65f030801b70c2f6468faca261d401ae622fc87",
+			signature:        "5da7c1a2acd57cee7505fc6676e4e544621c30862966e37dddb68e92efbe5d6b",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5da7c1a2acd57cee7505fc6676e4e544621c30862966e37dddb68e92efbe5d6b",
+		},
+		V4SignerSuiteCase{
+			label: "post-vanilla-query",
+			request: V4SignerSuiteCaseRequest{
+				method:  "POST",
+				host:    "example.amazonaws.com",
+				url:     "/?Param1=value1",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "POST\n/\nParam1=value1\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n9d659678c1756bb3113e2ce898845a0a79dbbc57b740555917687f1b3340fbbd",
+			signature:        "28038455d6de14eafc1f9222cf5aa6f1a96197d7deb8263271d420d138af7f11",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=28038455d6de14eafc1f9222cf5aa6f1a96197d7deb8263271d420d138af7f11",
+		},
+		V4SignerSuiteCase{
+			label: "query-value-space",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?Param1=value%201",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\nParam1=value%201\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\nb937f14001fbbcb8b663176ee5d375651324b079039ee04be6a4c3edc5712aa6",
+			signature:        "d5f7dfb94dbee0f11545df2a51017f561a6b0cb001ddb1964f78d84bab0f91e0",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=d5f7dfb94dbee0f11545df2a51017f561a6b0cb001ddb1964f78d84bab0f91e0",
+		},
+		V4SignerSuiteCase{
+			label: "query-empty-values",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?Param2&Param1=",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\nParam1=&Param2=\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\nc56b96f16343d78fe34764a41e93bcf297ee165cb59cf59290adf1836c7fcca7",
+			signature:        "9364278df90c84490665f1b83eff90efa3ef3a9b4a506ed68d292aca64ca3ee4",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=9364278df90c84490665f1b83eff90efa3ef3a9b4a506ed68d292aca64ca3ee4",
+		},
+		V4SignerSuiteCase{
+			label: "query-key-prefix-order",
+			request: V4SignerSuiteCaseRequest{
+				method:  "GET",
+				host:    "example.amazonaws.com",
+				url:     "/?Param-1=value2&Param=value1",
+				headers: []string{"X-Amz-Date:20150830T123600Z"},
+			},
+			canonicalRequest: "GET\n/\nParam=value1&Param-1=value2\nhost:example.amazonaws.com\nx-amz-date:20150830T123600Z\n\nhost;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			stringToSign:     "AWS4-HMAC-SHA256\n20150830T123600Z\n20150830/us-east-1/service/aws4_request\n2b5e20a72afd046e3de6d6664f9a27d75c6d418e5be851abac89fca48ebd5146",
+			signature:        "5ef7e38c4acbdb3186fb9e0310f533add76664b66a1b4528f355b4ceb1226ca8",
+			authorization:    "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5ef7e38c4acbdb3186fb9e0310f533add76664b66a1b4528f355b4ceb1226ca8",
+		},
+	)
 }
 
 func (s *V4SignerSuite) TestCases(c *check.C) {
-	signer := aws.NewV4Signer(s.auth, "host", s.region)
+	s.checkCases(c, aws.NewV4Signer(s.auth, "host", s.region), s.cases)
+}
 
-	for _, testCase := range s.cases {
+func (s *V4SignerSuite) TestCases2015(c *check.C) {
+	s.checkCases(c, aws.NewV4Signer(s.auth, "service", s.region), s.cases2015)
+}
+
+func (s *V4SignerSuite) checkCases(c *check.C, signer *aws.V4Signer, cases []V4SignerSuiteCase) {
+	for _, testCase := range cases {
 
 		req, err := http.NewRequest(testCase.request.method, "http://"+testCase.request.host+testCase.request.url, strings.NewReader(testCase.request.body))
 		c.Assert(err, check.IsNil, check.Commentf("Testcase: %s", testCase.label))
@@ -542,6 +741,25 @@ func (s *V4SignerSuite) TestCases(c *check.C) {
 	}
 }
 
+// Header maps set directly can hold names differing only in case, which
+// must be signed as a single header, the same way every time.
+func (s *V4SignerSuite) TestCanonicalRequestHeaderCase(c *check.C) {
+	signer := aws.NewV4Signer(s.auth, "service", s.region)
+	expected := "GET\n/\n\nhost:example.amazonaws.com\nmy-header1:value1,value2\nx-amz-date:20150830T123600Z\n\nhost;my-header1;x-amz-date\ne3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	for i := 0; i < 20; i++ {
+		req, err := http.NewRequest("GET", "http://example.amazonaws.com/", nil)
+		c.Assert(err, check.IsNil)
+		req.Header["Host"] = []string{"example.amazonaws.com"}
+		req.Header["X-Amz-Date"] = []string{"20150830T123600Z"}
+		req.Header["My-Header1"] = []string{"value1"}
+		req.Header["my-header1"] = []string{" value2 "}
+
+		c.Assert(signer.CanonicalRequest(req), check.Equals, expected)
+		c.Assert(req.Header["my-header1"], check.DeepEquals, []string{" value2 "})
+	}
+}
+
 func ExampleV4Signer() {
 	// Get auth from env vars
 	auth, err := aws.EnvAuth()
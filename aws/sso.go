@@ -0,0 +1,164 @@
+package aws
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os/user"
+	"path"
+	"time"
+)
+
+// The portal endpoint of IAM Identity Center in a region, and the
+// directory where `aws sso login` caches access tokens; overridden by
+// tests.
+var (
+	ssoPortalEndpoint = "https://portal.sso.%s.amazonaws.com"
+	ssoCacheDir       = ""
+)
+
+// The format of the expiresAt of the tokens cached by version 1 of the
+// AWS CLI; version 2 uses RFC 3339.
+const ssoCLIv1TimeFormat = "2006-01-02T15:04:05UTC"
+
+// ssoToken is an access token cached by `aws sso login`.
+type ssoToken struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+type ssoRoleCredentials struct {
+	RoleCredentials struct {
+		AccessKeyId     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		SessionToken    string `json:"sessionToken"`
+		Expiration      int64  `json:"expiration"` // In milliseconds since the epoch
+	} `json:"roleCredentials"`
+}
+
+// SSOAuth creates an Auth from the credentials of an IAM Identity Center
+// (SSO) profile of the shared config file, as for CredentialProcessAuth.
+//
+// The profile sets sso_account_id and sso_role_name, and either
+// sso_start_url and sso_region, or sso_session naming an "sso-session"
+// section setting them. The access token cached by `aws sso login` for the
+// profile is exchanged for the credentials of the role, with the
+// GetRoleCredentials API; an error is returned if it has expired, and the
+// user must log in again.
+//
+// See http://docs.aws.amazon.com/cli/latest/userguide/sso-configure-profile-token.html
+func SSOAuth(filePath string, profile string) (auth Auth, err error) {
+	profiles, profile, err := sharedConfig(filePath, profile)
+	if err != nil {
+		return
+	}
+	settings, err := lookupProfile(profiles, profile)
+	if err != nil {
+		return
+	}
+
+	accountId, roleName := settings["sso_account_id"], settings["sso_role_name"]
+	if accountId == "" || roleName == "" {
+		err = fmt.Errorf("The profile %s has no sso_account_id or sso_role_name", profile)
+		return
+	}
+
+	// The token of an sso-session is cached under the name of the session,
+	// and the legacy one under the start URL.
+	startURL, region, cacheKey := settings["sso_start_url"], settings["sso_region"], settings["sso_start_url"]
+	if session := settings["sso_session"]; session != "" {
+		sessionSettings, ok := profiles["sso-session "+session]
+		if !ok {
+			err = fmt.Errorf("The config file did not contain the sso-session %s", session)
+			return
+		}
+		startURL, region, cacheKey = sessionSettings["sso_start_url"], sessionSettings["sso_region"], session
+	}
+	if startURL == "" || region == "" {
+		err = fmt.Errorf("The profile %s has no sso_start_url or sso_region", profile)
+		return
+	}
+
+	token, err := readSSOToken(cacheKey)
+	if err != nil {
+		return
+	}
+
+	creds, err := getSSORoleCredentials(region, token, accountId, roleName)
+	if err != nil {
+		return
+	}
+	auth.AccessKey = creds.RoleCredentials.AccessKeyId
+	auth.SecretKey = creds.RoleCredentials.SecretAccessKey
+	auth.token = creds.RoleCredentials.SessionToken
+	auth.expiration = time.Unix(0, creds.RoleCredentials.Expiration*int64(time.Millisecond))
+	return
+}
+
+// readSSOToken reads the cached access token of a start URL or session,
+// stored in a file named after the SHA-1 of cacheKey.
+func readSSOToken(cacheKey string) (string, error) {
+	dir := ssoCacheDir
+	if dir == "" {
+		u, err := user.Current()
+		if err != nil {
+			return "", err
+		}
+		dir = path.Join(u.HomeDir, ".aws", "sso", "cache")
+	}
+	sum := sha1.Sum([]byte(cacheKey))
+	data, err := ioutil.ReadFile(path.Join(dir, fmt.Sprintf("%x.json", sum)))
+	if err != nil {
+		return "", fmt.Errorf("No cached SSO token, run aws sso login: %s", err)
+	}
+
+	var token ssoToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return "", err
+	}
+	expiresAt, err := time.Parse(time.RFC3339, token.ExpiresAt)
+	if err != nil {
+		expiresAt, err = time.Parse(ssoCLIv1TimeFormat, token.ExpiresAt)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Invalid expiresAt in cached SSO token: %s", err)
+	}
+	if !time.Now().Before(expiresAt) {
+		return "", fmt.Errorf("The cached SSO token expired at %s, run aws sso login", token.ExpiresAt)
+	}
+	return token.AccessToken, nil
+}
+
+// getSSORoleCredentials calls GetRoleCredentials.
+//
+// See http://docs.aws.amazon.com/singlesignon/latest/PortalAPIReference/API_GetRoleCredentials.html
+func getSSORoleCredentials(region, token, accountId, roleName string) (*ssoRoleCredentials, error) {
+	params := url.Values{"account_id": {accountId}, "role_name": {roleName}}
+	req, err := http.NewRequest("GET", fmt.Sprintf(ssoPortalEndpoint, region)+"/federation/credentials?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("x-amz-sso_bearer_token", token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("SSO GetRoleCredentials failed: %s: %s", resp.Status, data)
+	}
+
+	creds := new(ssoRoleCredentials)
+	if err := json.Unmarshal(data, creds); err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
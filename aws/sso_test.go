@@ -0,0 +1,91 @@
+package aws_test
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestSSOAuth(c *check.C) {
+	var request *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		w.Write([]byte(`{"roleCredentials": {"accessKeyId": "keyid", "secretAccessKey": "key", "sessionToken": "token", "expiration": 4102444800000}}`))
+	}))
+	defer srv.Close()
+
+	dir := c.MkDir()
+	aws.SetSSO(srv.URL+"/%s", dir)
+	defer aws.SetSSO("https://portal.sso.%s.amazonaws.com", "")
+
+	config := filepath.Join(dir, "config")
+	err := ioutil.WriteFile(config, []byte(`
+[profile dev]
+sso_session = corp
+sso_account_id = 111122223333
+sso_role_name = Developer
+
+[profile legacy]
+sso_start_url = https://corp.awsapps.com/start
+sso_region = us-east-1
+sso_account_id = 111122223333
+sso_role_name = Developer
+
+[sso-session corp]
+sso_start_url = https://corp.awsapps.com/start
+sso_region = eu-west-1
+`), 0600)
+	c.Assert(err, check.IsNil)
+
+	writeToken := func(key, expiresAt string) {
+		name := fmt.Sprintf("%x.json", sha1.Sum([]byte(key)))
+		data := fmt.Sprintf(`{"accessToken": "access-%s", "expiresAt": "%s", "region": "eu-west-1"}`, key, expiresAt)
+		c.Assert(ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0600), check.IsNil)
+	}
+	writeToken("corp", time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+
+	auth, err := aws.SSOAuth(config, "dev")
+	c.Assert(err, check.IsNil)
+	c.Assert(auth.AccessKey, check.Equals, "keyid")
+	c.Assert(auth.SecretKey, check.Equals, "key")
+	c.Assert(auth.Token(), check.Equals, "token")
+	c.Assert(auth.Expiration().Equal(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)), check.Equals, true)
+
+	c.Assert(request.URL.Path, check.Equals, "/eu-west-1/federation/credentials")
+	c.Assert(request.URL.Query().Get("account_id"), check.Equals, "111122223333")
+	c.Assert(request.URL.Query().Get("role_name"), check.Equals, "Developer")
+	c.Assert(request.Header.Get("x-amz-sso_bearer_token"), check.Equals, "access-corp")
+
+	_, err = aws.SSOAuth(config, "legacy")
+	c.Assert(err, check.ErrorMatches, "No cached SSO token, run aws sso login: .*")
+
+	// The format of version 1 of the AWS CLI.
+	writeToken("https://corp.awsapps.com/start", time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05UTC"))
+	_, err = aws.SSOAuth(config, "legacy")
+	c.Assert(err, check.IsNil)
+
+	writeToken("https://corp.awsapps.com/start", "2020-01-01T00:00:00UTC")
+	_, err = aws.SSOAuth(config, "legacy")
+	c.Assert(err, check.ErrorMatches, "The cached SSO token expired at 2020-01-01T00:00:00UTC, run aws sso login")
+
+	writeToken("https://corp.awsapps.com/start", "2020-01-01T00:00:00Z")
+	_, err = aws.SSOAuth(config, "legacy")
+	c.Assert(err, check.ErrorMatches, "The cached SSO token expired at 2020-01-01T00:00:00Z, run aws sso login")
+}
+
+func (s *S) TestSSOAuthNotSSOProfile(c *check.C) {
+	config := filepath.Join(c.MkDir(), "config")
+	c.Assert(ioutil.WriteFile(config, []byte("[default]\nregion = us-east-1\n"), 0600), check.IsNil)
+	os.Setenv("AWS_CONFIG_FILE", config)
+
+	_, err := aws.SSOAuth("", "")
+	c.Assert(err, check.ErrorMatches, "The profile default has no sso_account_id or sso_role_name")
+}
@@ -0,0 +1,165 @@
+// Package awsutil provides helpers shared by the service packages.
+package awsutil
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// ItemError is the error returned by the function of ForEachWithRetry for
+// an item, once retries are exhausted.
+type ItemError struct {
+	Index int // Index of the item
+	Err   error
+}
+
+func (e ItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e ItemError) Unwrap() error {
+	return e.Err
+}
+
+// BatchError is returned by ForEachWithRetry when the function fails for
+// some of the items. Errors is ordered by index.
+type BatchError struct {
+	Errors []ItemError
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+	return fmt.Sprintf("%v (and %d more errors)", e.Errors[0], len(e.Errors)-1)
+}
+
+// Unwrap returns the errors of the items, so that errors.Is and errors.As
+// match any of them.
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// ForEachWithRetry calls fn for every item, running at most concurrency
+// calls at once, and retries the calls that fail as aws.DefaultRetryPolicy
+// would retry a request: throttling errors, 5xx responses and temporary
+// network errors, with an exponential backoff.
+//
+// All items are processed even if some fail, in which case a *BatchError
+// is returned.
+func ForEachWithRetry[T any](items []T, concurrency int, fn func(T) error) error {
+	return ForEachWithRetryPolicy(items, concurrency, aws.DefaultRetryPolicy{}, fn)
+}
+
+// ForEachWithRetryPolicy is ForEachWithRetry, retrying as set by policy.
+func ForEachWithRetryPolicy[T any](items []T, concurrency int, policy aws.RetryPolicy, fn func(T) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	errs := make([]error, len(items))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = callWithRetry(policy, func() error { return fn(items[i]) })
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	var failures []ItemError
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, ItemError{i, err})
+		}
+	}
+	if failures != nil {
+		return &BatchError{failures}
+	}
+	return nil
+}
+
+// ForEachBatchWithRetry splits items into batches of at most size items,
+// and calls fn for every batch as ForEachWithRetry does, for the APIs that
+// take items in bulk.
+//
+// fn may return a *BatchError for the items of the batch that failed,
+// indexed within the batch; it is not retried. Any other error that fn
+// still returns once retries are exhausted is reported for every item of
+// the batch, so that the Index of the returned ItemErrors is always that
+// of an item.
+func ForEachBatchWithRetry[T any](items []T, size, concurrency int, fn func([]T) error) error {
+	if size < 1 {
+		size = 1
+	}
+	var batches [][]T
+	for start := 0; start < len(items); start += size {
+		end := start + size
+		if end > len(items) {
+			end = len(items)
+		}
+		batches = append(batches, items[start:end])
+	}
+	err := ForEachWithRetry(batches, concurrency, fn)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		return err
+	}
+
+	var failures []ItemError
+	for _, failure := range batchErr.Errors {
+		start := failure.Index * size
+		var partial *BatchError
+		if errors.As(failure.Err, &partial) {
+			for _, itemErr := range partial.Errors {
+				failures = append(failures, ItemError{start + itemErr.Index, itemErr.Err})
+			}
+			continue
+		}
+		for i := range batches[failure.Index] {
+			failures = append(failures, ItemError{start + i, failure.Err})
+		}
+	}
+	return &BatchError{failures}
+}
+
+// callWithRetry calls f until it succeeds or policy gives up. Partial
+// failures, reported with a *BatchError, are not retried.
+func callWithRetry(policy aws.RetryPolicy, f func() error) error {
+	for numRetries := 0; ; numRetries++ {
+		err := f()
+		if err == nil {
+			aws.RecordSuccess(policy, "", numRetries)
+			return nil
+		}
+		var batchErr *BatchError
+		if errors.As(err, &batchErr) {
+			return err
+		}
+		var r *http.Response
+		var apiErr aws.APIError
+		if errors.As(err, &apiErr) {
+			r = &http.Response{StatusCode: apiErr.HTTPStatus()}
+		}
+		if !policy.ShouldRetry("", r, err, numRetries) {
+			return err
+		}
+		time.Sleep(policy.Delay("", r, err, numRetries))
+	}
+}
@@ -0,0 +1,157 @@
+package awsutil
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// retryFast retries twice, without delay, the failures DefaultRetryPolicy
+// would retry.
+type retryFast struct{}
+
+func (retryFast) ShouldRetry(target string, r *http.Response, err error, numRetries int) bool {
+	return numRetries < 2 && aws.DefaultRetryPolicy{}.ShouldRetry(target, r, err, numRetries)
+}
+
+func (retryFast) Delay(target string, r *http.Response, err error, numRetries int) time.Duration {
+	return 0
+}
+
+func TestForEachWithRetryConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	seen := make(map[int]bool)
+	items := make([]int, 50)
+	for i := range items {
+		items[i] = i
+	}
+
+	err := ForEachWithRetry(items, 4, func(i int) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		seen[i] = true
+		mu.Unlock()
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != len(items) {
+		t.Errorf("expected %d items to be processed, got %d", len(items), len(seen))
+	}
+	if maxRunning > 4 {
+		t.Errorf("expected at most 4 concurrent calls, got %d", maxRunning)
+	}
+}
+
+func TestForEachWithRetryPolicy(t *testing.T) {
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	throttled := &aws.Error{StatusCode: 400, Code: "Throttling"}
+	invalid := &aws.Error{StatusCode: 400, Code: "InvalidParameterValue"}
+
+	err := ForEachWithRetryPolicy([]string{"ok", "throttled-once", "invalid", "throttled"}, 2, retryFast{}, func(item string) error {
+		mu.Lock()
+		calls[item]++
+		n := calls[item]
+		mu.Unlock()
+		switch {
+		case item == "throttled-once" && n == 1, item == "throttled":
+			return throttled
+		case item == "invalid":
+			return invalid
+		}
+		return nil
+	})
+
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("expected a *BatchError, got %#v", err)
+	}
+	if len(batchErr.Errors) != 2 || batchErr.Errors[0] != (ItemError{2, invalid}) || batchErr.Errors[1] != (ItemError{3, throttled}) {
+		t.Errorf("unexpected errors: %#v", batchErr.Errors)
+	}
+	if batchErr.Error() != "item 2: Type: , Code: InvalidParameterValue, Message:  (and 1 more errors)" {
+		t.Errorf("unexpected message: %q", batchErr.Error())
+	}
+	want := map[string]int{"ok": 1, "throttled-once": 2, "invalid": 1, "throttled": 3}
+	for item, n := range want {
+		if calls[item] != n {
+			t.Errorf("expected %d calls for %s, got %d", n, item, calls[item])
+		}
+	}
+}
+
+func TestForEachWithRetryEmpty(t *testing.T) {
+	err := ForEachWithRetry(nil, 4, func(int) error { return errors.New("unexpected call") })
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestForEachBatchWithRetry(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]int
+	invalid := &aws.Error{StatusCode: 400, Code: "InvalidParameterValue"}
+	itemErr := errors.New("item failed")
+
+	items := []int{0, 1, 2, 3, 4, 5, 6}
+	err := ForEachBatchWithRetry(items, 3, 2, func(batch []int) error {
+		mu.Lock()
+		batches = append(batches, batch)
+		mu.Unlock()
+		switch batch[0] {
+		case 3:
+			return invalid
+		case 6:
+			return &BatchError{[]ItemError{{0, itemErr}}}
+		}
+		return nil
+	})
+
+	batchErr, ok := err.(*BatchError)
+	if !ok {
+		t.Fatalf("expected a *BatchError, got %#v", err)
+	}
+	want := []ItemError{{3, invalid}, {4, invalid}, {5, invalid}, {6, itemErr}}
+	if len(batchErr.Errors) != len(want) {
+		t.Fatalf("unexpected errors: %#v", batchErr.Errors)
+	}
+	for i := range want {
+		if batchErr.Errors[i] != want[i] {
+			t.Errorf("unexpected error %d: %#v", i, batchErr.Errors[i])
+		}
+	}
+	if len(batches) != 3 {
+		t.Errorf("expected 3 batches, got %v", batches)
+	}
+}
+
+func TestForEachWithRetryPolicyAPIError(t *testing.T) {
+	calls := 0
+	err := ForEachWithRetryPolicy([]int{0}, 1, retryFast{}, func(int) error {
+		calls++
+		if calls == 1 {
+			return &aws.JSONError{Service: "kms", StatusCode: 503, Code: "ServiceUnavailable"}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
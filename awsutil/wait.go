@@ -0,0 +1,45 @@
+package awsutil
+
+import (
+	"context"
+	"time"
+)
+
+// WaitOptions configures how the WaitUntil methods of the service packages
+// poll. Zero values use the defaults of the service package.
+type WaitOptions struct {
+	Interval time.Duration
+	Timeout  time.Duration
+}
+
+// Poll calls check every interval until it is done or fails, or the
+// timeout is reached. The interval and timeout are those of opts, or of
+// defaults where opts is nil or leaves them zero.
+//
+// The wait ends early with ctx's error when ctx is done, and with
+// context.DeadlineExceeded when the timeout is reached.
+func Poll(ctx context.Context, opts *WaitOptions, defaults WaitOptions, check func() (done bool, err error)) error {
+	interval, timeout := defaults.Interval, defaults.Timeout
+	if opts != nil {
+		if opts.Interval > 0 {
+			interval = opts.Interval
+		}
+		if opts.Timeout > 0 {
+			timeout = opts.Timeout
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		done, err := check()
+		if done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package awsutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPoll(t *testing.T) {
+	calls := 0
+	err := Poll(context.Background(), nil, WaitOptions{Interval: time.Millisecond, Timeout: time.Second}, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("Poll returned %v after %d calls, expected nil after 3", err, calls)
+	}
+
+	fail := errors.New("failed")
+	err = Poll(context.Background(), nil, WaitOptions{Interval: time.Millisecond, Timeout: time.Second}, func() (bool, error) {
+		return false, fail
+	})
+	if err != fail {
+		t.Errorf("Poll returned %v, expected %v", err, fail)
+	}
+}
+
+func TestPollOptions(t *testing.T) {
+	// The options override the defaults, which would wait for an hour.
+	defaults := WaitOptions{Interval: time.Hour, Timeout: time.Hour}
+	start := time.Now()
+	err := Poll(context.Background(), &WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}, defaults, func() (bool, error) {
+		return false, nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Errorf("Poll returned %v, expected %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Poll took %v to time out", elapsed)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = Poll(ctx, &WaitOptions{Interval: time.Millisecond}, defaults, func() (bool, error) {
+		return false, nil
+	})
+	if err != context.Canceled {
+		t.Errorf("Poll returned %v, expected %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,122 @@
+// Package cloudformation provides helpers for working with AWS CloudFormation.
+package cloudformation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// Implements the custom resource request and response protocol.
+// See http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/crpg-ref.html for details.
+
+const (
+	RequestTypeCreate = "Create"
+	RequestTypeUpdate = "Update"
+	RequestTypeDelete = "Delete"
+
+	ResponseStatusSuccess = "SUCCESS"
+	ResponseStatusFailed  = "FAILED"
+)
+
+// The response body, including Data, must not be larger than this.
+const maxCustomResourceResponseSize = 4096
+
+// CustomResourceRequest is the request CloudFormation sends to a custom
+// resource provider, for example as the event of a Lambda function.
+type CustomResourceRequest struct {
+	RequestType           string
+	ResponseURL           string
+	StackId               string
+	RequestId             string
+	ResourceType          string
+	LogicalResourceId     string
+	PhysicalResourceId    string                 `json:",omitempty"`
+	ResourceProperties    map[string]interface{} `json:",omitempty"`
+	OldResourceProperties map[string]interface{} `json:",omitempty"`
+}
+
+// CustomResourceResponse is the document uploaded to the ResponseURL of a
+// CustomResourceRequest.
+type CustomResourceResponse struct {
+	Status             string
+	Reason             string `json:",omitempty"`
+	PhysicalResourceId string
+	StackId            string
+	RequestId          string
+	LogicalResourceId  string
+	NoEcho             bool                   `json:",omitempty"`
+	Data               map[string]interface{} `json:",omitempty"`
+}
+
+// ParseCustomResourceRequest decodes a custom resource request from its JSON
+// representation.
+func ParseCustomResourceRequest(data []byte) (*CustomResourceRequest, error) {
+	req := &CustomResourceRequest{}
+	if err := json.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// Succeed reports that the request completed. The values in data can be
+// read from the template with Fn::GetAtt.
+func (req *CustomResourceRequest) Succeed(physicalResourceId string, data map[string]interface{}) error {
+	return req.Respond(&CustomResourceResponse{
+		Status:             ResponseStatusSuccess,
+		PhysicalResourceId: physicalResourceId,
+		Data:               data,
+	})
+}
+
+// Fail reports that the request failed. reason is shown in the stack events.
+func (req *CustomResourceRequest) Fail(physicalResourceId, reason string) error {
+	return req.Respond(&CustomResourceResponse{
+		Status:             ResponseStatusFailed,
+		Reason:             reason,
+		PhysicalResourceId: physicalResourceId,
+	})
+}
+
+// Respond uploads resp to the presigned ResponseURL of req. The StackId,
+// RequestId and LogicalResourceId of resp are copied from req. When resp
+// has no PhysicalResourceId, the one in req is used if set, and otherwise
+// one is derived from the request ID.
+func (req *CustomResourceRequest) Respond(resp *CustomResourceResponse) error {
+	resp.StackId = req.StackId
+	resp.RequestId = req.RequestId
+	resp.LogicalResourceId = req.LogicalResourceId
+	if resp.PhysicalResourceId == "" {
+		resp.PhysicalResourceId = req.PhysicalResourceId
+	}
+	if resp.PhysicalResourceId == "" {
+		resp.PhysicalResourceId = req.LogicalResourceId + "-" + req.RequestId
+	}
+
+	body, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if len(body) > maxCustomResourceResponseSize {
+		return fmt.Errorf("cloudformation: custom resource response is %d bytes, larger than the %d byte limit",
+			len(body), maxCustomResourceResponseSize)
+	}
+
+	// The URL is presigned without a Content-Type, so none must be sent.
+	hreq, err := http.NewRequest("PUT", req.ResponseURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	hresp, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer hresp.Body.Close()
+	if hresp.StatusCode != 200 {
+		msg, _ := ioutil.ReadAll(hresp.Body)
+		return fmt.Errorf("cloudformation: uploading custom resource response: %s: %s", hresp.Status, msg)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package cloudformation_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/zackbloom/goamz/cloudformation"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct{}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) parseRequest(c *check.C) *cloudformation.CustomResourceRequest {
+	event := strings.Replace(CreateRequestEvent, "RESPONSE_URL", testServer.URL+"/cloudformation-custom-resource-response", 1)
+	req, err := cloudformation.ParseCustomResourceRequest([]byte(event))
+	c.Assert(err, check.IsNil)
+	return req
+}
+
+func (s *S) TestParseCustomResourceRequest(c *check.C) {
+	req := s.parseRequest(c)
+	c.Assert(req.RequestType, check.Equals, cloudformation.RequestTypeCreate)
+	c.Assert(req.StackId, check.Equals, "arn:aws:cloudformation:us-west-2:123456789012:stack/mystack/5b918d10-cd98-11ea-90d5-0a9cd3354c10")
+	c.Assert(req.LogicalResourceId, check.Equals, "MyTestResource")
+	c.Assert(req.PhysicalResourceId, check.Equals, "")
+	c.Assert(req.ResourceProperties["StackName"], check.Equals, "MyStack")
+	c.Assert(req.ResourceProperties["List"], check.DeepEquals, []interface{}{"1", "2", "3"})
+}
+
+func (s *S) TestSucceed(c *check.C) {
+	testServer.Response(200, nil, "")
+	req := s.parseRequest(c)
+
+	err := req.Succeed("my-resource-id", map[string]interface{}{"Endpoint": "example.com"})
+	c.Assert(err, check.IsNil)
+
+	hreq := testServer.WaitRequest()
+	c.Assert(hreq.Method, check.Equals, "PUT")
+	c.Assert(hreq.URL.Path, check.Equals, "/cloudformation-custom-resource-response")
+	c.Assert(hreq.Header.Get("Content-Type"), check.Equals, "")
+
+	body, err := ioutil.ReadAll(hreq.Body)
+	c.Assert(err, check.IsNil)
+	var resp map[string]interface{}
+	c.Assert(json.Unmarshal(body, &resp), check.IsNil)
+	c.Assert(resp, check.DeepEquals, map[string]interface{}{
+		"Status":             "SUCCESS",
+		"PhysicalResourceId": "my-resource-id",
+		"StackId":            req.StackId,
+		"RequestId":          "unique id for this create request",
+		"LogicalResourceId":  "MyTestResource",
+		"Data":               map[string]interface{}{"Endpoint": "example.com"},
+	})
+}
+
+func (s *S) TestFail(c *check.C) {
+	testServer.Response(200, nil, "")
+	req := s.parseRequest(c)
+
+	err := req.Fail("", "something went wrong")
+	c.Assert(err, check.IsNil)
+
+	body, err := ioutil.ReadAll(testServer.WaitRequest().Body)
+	c.Assert(err, check.IsNil)
+	var resp cloudformation.CustomResourceResponse
+	c.Assert(json.Unmarshal(body, &resp), check.IsNil)
+	c.Assert(resp.Status, check.Equals, cloudformation.ResponseStatusFailed)
+	c.Assert(resp.Reason, check.Equals, "something went wrong")
+	c.Assert(resp.PhysicalResourceId, check.Equals, "MyTestResource-unique id for this create request")
+}
+
+func (s *S) TestRespondKeepsPhysicalResourceId(c *check.C) {
+	testServer.Response(200, nil, "")
+	req := s.parseRequest(c)
+	req.RequestType = cloudformation.RequestTypeDelete
+	req.PhysicalResourceId = "existing-id"
+
+	err := req.Succeed("", nil)
+	c.Assert(err, check.IsNil)
+
+	body, err := ioutil.ReadAll(testServer.WaitRequest().Body)
+	c.Assert(err, check.IsNil)
+	var resp cloudformation.CustomResourceResponse
+	c.Assert(json.Unmarshal(body, &resp), check.IsNil)
+	c.Assert(resp.PhysicalResourceId, check.Equals, "existing-id")
+	c.Assert(resp.Data, check.IsNil)
+}
+
+func (s *S) TestRespondTooLarge(c *check.C) {
+	req := s.parseRequest(c)
+	err := req.Succeed("id", map[string]interface{}{"Big": strings.Repeat("x", 4096)})
+	c.Assert(err, check.ErrorMatches, "cloudformation: custom resource response is [0-9]+ bytes, larger than the 4096 byte limit")
+}
+
+func (s *S) TestRespondUploadError(c *check.C) {
+	testServer.Response(403, nil, "<Error><Code>AccessDenied</Code></Error>")
+	req := s.parseRequest(c)
+	err := req.Succeed("id", nil)
+	testServer.WaitRequest()
+	c.Assert(err, check.ErrorMatches, "cloudformation: uploading custom resource response: 403 Forbidden: <Error><Code>AccessDenied</Code></Error>")
+}
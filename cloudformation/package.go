@@ -0,0 +1,235 @@
+package cloudformation
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/zackbloom/goamz/s3"
+)
+
+// Implements the equivalent of "aws cloudformation package" for JSON
+// templates. See http://docs.aws.amazon.com/cli/latest/reference/cloudformation/package.html
+// for details.
+
+// PackageOptions are the options of Package. Artifacts are stored under
+// Prefix, and are not uploaded again when an object of the same name
+// already exists unless ForceUpload is set.
+type PackageOptions struct {
+	Prefix      string
+	ForceUpload bool
+}
+
+// artifactLocation returns the property value referring to the object key
+// of bucket.
+type artifactLocation func(bucket *s3.Bucket, key string) interface{}
+
+// s3Location refers to an object with a property holding its bucket name
+// and one holding its key.
+func s3Location(bucketProperty, keyProperty string) artifactLocation {
+	return func(bucket *s3.Bucket, key string) interface{} {
+		return map[string]interface{}{bucketProperty: bucket.Name, keyProperty: key}
+	}
+}
+
+func s3URI(bucket *s3.Bucket, key string) interface{} {
+	return "s3://" + bucket.Name + "/" + key
+}
+
+func objectURL(bucket *s3.Bucket, key string) interface{} {
+	return bucket.URL(key)
+}
+
+// A resource property that may refer to a local file or directory.
+// Directories are always zipped, and files are zipped when zip is set
+// unless they already are zip or jar archives. Templates are packaged
+// before being uploaded.
+type packagedProperty struct {
+	name     string
+	zip      bool
+	template bool
+	location artifactLocation
+}
+
+var packagedProperties = map[string][]packagedProperty{
+	"AWS::Lambda::Function": {
+		{name: "Code", zip: true, location: s3Location("S3Bucket", "S3Key")},
+	},
+	"AWS::Lambda::LayerVersion": {
+		{name: "Content", zip: true, location: s3Location("S3Bucket", "S3Key")},
+	},
+	"AWS::Serverless::Function": {
+		{name: "CodeUri", zip: true, location: s3URI},
+	},
+	"AWS::Serverless::LayerVersion": {
+		{name: "ContentUri", zip: true, location: s3URI},
+	},
+	"AWS::Serverless::Api": {
+		{name: "DefinitionUri", location: s3URI},
+	},
+	"AWS::ApiGateway::RestApi": {
+		{name: "BodyS3Location", location: s3Location("Bucket", "Key")},
+	},
+	"AWS::CloudFormation::Stack": {
+		{name: "TemplateURL", template: true, location: objectURL},
+	},
+}
+
+// Package uploads the local artifacts referred to by the JSON template at
+// templatePath to bucket, and returns the template rewritten to refer to
+// the uploaded objects. Relative paths are resolved against the directory
+// of the template, and nested stack templates are packaged the same way.
+// Values that are already S3 or HTTP URLs are left untouched.
+//
+// Artifacts are named after the MD5 sum of their content, so that an
+// unchanged artifact keeps its name and is not uploaded again.
+func Package(templatePath string, bucket *s3.Bucket, options *PackageOptions) ([]byte, error) {
+	p := &packager{bucket: bucket}
+	if options != nil {
+		p.options = *options
+	}
+	return p.packageTemplate(templatePath)
+}
+
+type packager struct {
+	bucket  *s3.Bucket
+	options PackageOptions
+}
+
+func (p *packager) packageTemplate(templatePath string) ([]byte, error) {
+	data, err := ioutil.ReadFile(templatePath)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var template map[string]interface{}
+	if err := dec.Decode(&template); err != nil {
+		return nil, fmt.Errorf("cloudformation: parsing %s: %v", templatePath, err)
+	}
+
+	resources, _ := template["Resources"].(map[string]interface{})
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		resource, _ := resources[name].(map[string]interface{})
+		resourceType, _ := resource["Type"].(string)
+		properties, _ := resource["Properties"].(map[string]interface{})
+		for _, property := range packagedProperties[resourceType] {
+			value, ok := properties[property.name].(string)
+			if !ok || isRemote(value) {
+				continue
+			}
+			if !filepath.IsAbs(value) {
+				value = filepath.Join(filepath.Dir(templatePath), value)
+			}
+			key, err := p.upload(value, property)
+			if err != nil {
+				return nil, fmt.Errorf("cloudformation: packaging %s of %s: %v", property.name, name, err)
+			}
+			properties[property.name] = property.location(p.bucket, key)
+		}
+	}
+	return json.MarshalIndent(template, "", "  ")
+}
+
+// upload stores the artifact at path and returns its key.
+func (p *packager) upload(path string, property packagedProperty) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+
+	var data []byte
+	var contType string
+	ext := strings.ToLower(filepath.Ext(path))
+	switch {
+	case property.template:
+		if info.IsDir() {
+			return "", fmt.Errorf("%s is a directory", path)
+		}
+		data, err = p.packageTemplate(path)
+		ext, contType = ".template", "application/json"
+	case info.IsDir() || property.zip && ext != ".zip" && ext != ".jar":
+		data, err = zipArtifact(path, info)
+		ext, contType = ".zip", "application/zip"
+	default:
+		data, err = ioutil.ReadFile(path)
+		contType = "application/octet-stream"
+	}
+	if err != nil {
+		return "", err
+	}
+
+	sum := md5.Sum(data)
+	key := hex.EncodeToString(sum[:]) + ext
+	if p.options.Prefix != "" {
+		key = strings.TrimSuffix(p.options.Prefix, "/") + "/" + key
+	}
+	if !p.options.ForceUpload {
+		exists, err := p.bucket.Exists(key)
+		if err != nil {
+			return "", err
+		}
+		if exists {
+			return key, nil
+		}
+	}
+	return key, p.bucket.Put(key, data, contType, s3.Private, s3.Options{})
+}
+
+func isRemote(value string) bool {
+	return strings.HasPrefix(value, "s3://") || strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://")
+}
+
+// zipArtifact archives the file at path, or the files under the directory
+// at path. Entries carry no modification time, so the archive only changes
+// when the files do.
+func zipArtifact(path string, info os.FileInfo) ([]byte, error) {
+	root := path
+	if !info.IsDir() {
+		root = filepath.Dir(path)
+	}
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	err := filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		name, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		header := &zip.FileHeader{Name: filepath.ToSlash(name), Method: zip.Deflate}
+		header.SetMode(info.Mode())
+		entry, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		_, err = entry.Write(data)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
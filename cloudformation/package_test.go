@@ -0,0 +1,125 @@
+package cloudformation_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/cloudformation"
+	"github.com/zackbloom/goamz/s3"
+	"github.com/zackbloom/goamz/s3/s3test"
+	"gopkg.in/check.v1"
+)
+
+var _ = check.Suite(&PackageS{})
+
+type PackageS struct {
+	srv    *s3test.Server
+	bucket *s3.Bucket
+	dir    string
+}
+
+func (s *PackageS) SetUpTest(c *check.C) {
+	srv, err := s3test.NewServer(nil)
+	c.Assert(err, check.IsNil)
+	s.srv = srv
+	region := aws.Region{Name: "faux-region-1", S3Endpoint: srv.URL(), S3LocationConstraint: true}
+	s.bucket = s3.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, region).Bucket("artifacts")
+	c.Assert(s.bucket.PutBucket(s3.Private), check.IsNil)
+
+	s.dir = c.MkDir()
+	s.writeFile(c, "template.json", PackageTemplate)
+	s.writeFile(c, "nested/stack.json", NestedTemplate)
+	s.writeFile(c, "src/index.js", "exports.handler = function() {};\n")
+	s.writeFile(c, "src/lib/util.js", "module.exports = {};\n")
+	s.writeFile(c, "api.json", `{"swagger": "2.0"}`)
+}
+
+func (s *PackageS) TearDownTest(c *check.C) {
+	s.srv.Quit()
+}
+
+func (s *PackageS) writeFile(c *check.C, name, content string) {
+	path := filepath.Join(s.dir, name)
+	c.Assert(os.MkdirAll(filepath.Dir(path), 0755), check.IsNil)
+	c.Assert(ioutil.WriteFile(path, []byte(content), 0644), check.IsNil)
+}
+
+func resourceProperties(c *check.C, template []byte, name string) map[string]interface{} {
+	var t struct {
+		Resources map[string]struct {
+			Properties map[string]interface{}
+		}
+	}
+	c.Assert(json.Unmarshal(template, &t), check.IsNil)
+	return t.Resources[name].Properties
+}
+
+func (s *PackageS) TestPackage(c *check.C) {
+	out, err := cloudformation.Package(filepath.Join(s.dir, "template.json"), s.bucket, &cloudformation.PackageOptions{Prefix: "deploy/"})
+	c.Assert(err, check.IsNil)
+
+	code := resourceProperties(c, out, "Function")["Code"].(map[string]interface{})
+	c.Assert(code["S3Bucket"], check.Equals, "artifacts")
+	c.Assert(code["S3Key"], check.Matches, "deploy/[0-9a-f]{32}\\.zip")
+
+	data, err := s.bucket.Get(code["S3Key"].(string))
+	c.Assert(err, check.IsNil)
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	c.Assert(err, check.IsNil)
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	c.Assert(names, check.DeepEquals, []string{"index.js", "lib/util.js"})
+
+	sum := md5.Sum([]byte(`{"swagger": "2.0"}`))
+	c.Assert(resourceProperties(c, out, "Api")["BodyS3Location"], check.DeepEquals, map[string]interface{}{
+		"Bucket": "artifacts",
+		"Key":    "deploy/" + hex.EncodeToString(sum[:]) + ".json",
+	})
+
+	// Remote and intrinsic values are left untouched.
+	c.Assert(resourceProperties(c, out, "Remote")["CodeUri"], check.Equals, "s3://elsewhere/code.zip")
+	c.Assert(resourceProperties(c, out, "Inline")["Code"], check.DeepEquals, map[string]interface{}{"ZipFile": "exports.handler = null;"})
+	c.Assert(resourceProperties(c, out, "Function")["MemorySize"], check.Equals, 256.0)
+
+	// The nested template is packaged relative to its own directory, and
+	// refers to the same artifact.
+	url := resourceProperties(c, out, "Nested")["TemplateURL"].(string)
+	c.Assert(url, check.Matches, s.bucket.URL("deploy/")+"[0-9a-f]{32}\\.template")
+	nested, err := s.bucket.Get(url[len(s.bucket.URL("")):])
+	c.Assert(err, check.IsNil)
+	c.Assert(resourceProperties(c, nested, "Function")["CodeUri"], check.Equals, "s3://artifacts/"+code["S3Key"].(string))
+}
+
+func (s *PackageS) TestPackageExistingArtifact(c *check.C) {
+	sum := md5.Sum([]byte(`{"swagger": "2.0"}`))
+	key := hex.EncodeToString(sum[:]) + ".json"
+	c.Assert(s.bucket.Put(key, []byte("uploaded before"), "application/json", s3.Private, s3.Options{}), check.IsNil)
+
+	_, err := cloudformation.Package(filepath.Join(s.dir, "template.json"), s.bucket, nil)
+	c.Assert(err, check.IsNil)
+	data, err := s.bucket.Get(key)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, "uploaded before")
+
+	_, err = cloudformation.Package(filepath.Join(s.dir, "template.json"), s.bucket, &cloudformation.PackageOptions{ForceUpload: true})
+	c.Assert(err, check.IsNil)
+	data, err = s.bucket.Get(key)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, `{"swagger": "2.0"}`)
+}
+
+func (s *PackageS) TestPackageMissingArtifact(c *check.C) {
+	c.Assert(os.Remove(filepath.Join(s.dir, "api.json")), check.IsNil)
+
+	_, err := cloudformation.Package(filepath.Join(s.dir, "template.json"), s.bucket, nil)
+	c.Assert(err, check.ErrorMatches, "cloudformation: packaging BodyS3Location of Api: .*api.json: no such file or directory")
+}
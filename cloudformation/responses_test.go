@@ -0,0 +1,71 @@
+package cloudformation_test
+
+// http://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/crpg-ref-requesttypes-create.html
+var CreateRequestEvent = `
+{
+   "RequestType" : "Create",
+   "ResponseURL" : "RESPONSE_URL",
+   "StackId" : "arn:aws:cloudformation:us-west-2:123456789012:stack/mystack/5b918d10-cd98-11ea-90d5-0a9cd3354c10",
+   "RequestId" : "unique id for this create request",
+   "ResourceType" : "Custom::TestResource",
+   "LogicalResourceId" : "MyTestResource",
+   "ResourceProperties" : {
+      "StackName" : "MyStack",
+      "List" : [ "1", "2", "3" ]
+   }
+}
+`
+
+var PackageTemplate = `
+{
+  "AWSTemplateFormatVersion": "2010-09-09",
+  "Resources": {
+    "Function": {
+      "Type": "AWS::Lambda::Function",
+      "Properties": {
+        "Code": "src",
+        "Handler": "index.handler",
+        "MemorySize": 256,
+        "Runtime": "nodejs20.x"
+      }
+    },
+    "Inline": {
+      "Type": "AWS::Lambda::Function",
+      "Properties": {
+        "Code": {"ZipFile": "exports.handler = null;"}
+      }
+    },
+    "Remote": {
+      "Type": "AWS::Serverless::Function",
+      "Properties": {
+        "CodeUri": "s3://elsewhere/code.zip"
+      }
+    },
+    "Api": {
+      "Type": "AWS::ApiGateway::RestApi",
+      "Properties": {
+        "BodyS3Location": "api.json"
+      }
+    },
+    "Nested": {
+      "Type": "AWS::CloudFormation::Stack",
+      "Properties": {
+        "TemplateURL": "nested/stack.json"
+      }
+    }
+  }
+}
+`
+
+var NestedTemplate = `
+{
+  "Resources": {
+    "Function": {
+      "Type": "AWS::Serverless::Function",
+      "Properties": {
+        "CodeUri": "../src"
+      }
+    }
+  }
+}
+`
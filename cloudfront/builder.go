@@ -0,0 +1,181 @@
+package cloudfront
+
+// HTTP methods of AllowedMethods.
+const (
+	MethodGet     = "GET"
+	MethodHead    = "HEAD"
+	MethodOptions = "OPTIONS"
+	MethodPut     = "PUT"
+	MethodPatch   = "PATCH"
+	MethodPost    = "POST"
+	MethodDelete  = "DELETE"
+)
+
+// DistributionBuilder builds a DistributionConfig from the few settings
+// that matter, filling the rest with the defaults of the CloudFront
+// console. Its methods return the builder so that calls can be chained:
+//
+//	config, err := cloudfront.NewDistributionBuilder().
+//		WithS3Origin("assets", "assets.s3.amazonaws.com", "").
+//		WithAlias("cdn.example.com").
+//		WithACMCertificate(certArn).
+//		Build()
+//
+// Settings of the config that the builder has no method for can be
+// changed after Build.
+type DistributionBuilder struct {
+	config          DistributionConfig
+	defaultBehavior bool
+}
+
+// NewDistributionBuilder returns a builder for an enabled distribution,
+// served from all edge locations.
+func NewDistributionBuilder() *DistributionBuilder {
+	return &DistributionBuilder{
+		config: DistributionConfig{
+			PriceClass: PriceClassAll,
+			Enabled:    true,
+		},
+	}
+}
+
+// WithS3Origin adds an S3 bucket, given by its domain name such as
+// "bucket.s3.amazonaws.com", as an origin. originAccessIdentity, such as
+// "origin-access-identity/cloudfront/E127EXAMPLE51Z", restricts the bucket
+// to CloudFront; it may be "" for a public bucket.
+func (b *DistributionBuilder) WithS3Origin(id, domainName, originAccessIdentity string) *DistributionBuilder {
+	b.config.Origins = append(b.config.Origins, Origin{
+		Id:             id,
+		DomainName:     domainName,
+		S3OriginConfig: &S3OriginConfig{OriginAccessIdentity: originAccessIdentity},
+	})
+	return b
+}
+
+// WithCustomOrigin adds an HTTP server, listening on the ports 80 and 443,
+// as an origin.
+func (b *DistributionBuilder) WithCustomOrigin(id, domainName string, policy OriginProtocolPolicy) *DistributionBuilder {
+	b.config.Origins = append(b.config.Origins, Origin{
+		Id:         id,
+		DomainName: domainName,
+		CustomOriginConfig: &CustomOriginConfig{
+			HTTPPort:             80,
+			HTTPSPort:            443,
+			OriginProtocolPolicy: policy,
+		},
+	})
+	return b
+}
+
+// WithAlias adds alternate domain names (CNAMEs) of the distribution.
+// Serving them over HTTPS needs a certificate; see WithACMCertificate.
+func (b *DistributionBuilder) WithAlias(aliases ...string) *DistributionBuilder {
+	b.config.Aliases = append(b.config.Aliases, aliases...)
+	return b
+}
+
+// WithDefaultBehavior sets the origin, and the viewer protocol policy, of
+// the requests that match no other cache behavior. Without it, they go to
+// the first origin and HTTP requests are redirected to HTTPS.
+func (b *DistributionBuilder) WithDefaultBehavior(targetOriginId string, policy ViewerProtocolPolicy) *DistributionBuilder {
+	b.config.DefaultCacheBehavior = newCacheBehavior("", targetOriginId, policy)
+	b.defaultBehavior = true
+	return b
+}
+
+// WithBehavior adds a cache behavior sending the requests whose path
+// matches pathPattern, such as "/images/*.jpg", to an origin. Behaviors
+// are matched in the order they are added.
+func (b *DistributionBuilder) WithBehavior(pathPattern, targetOriginId string, policy ViewerProtocolPolicy) *DistributionBuilder {
+	b.config.CacheBehaviors = append(b.config.CacheBehaviors, newCacheBehavior(pathPattern, targetOriginId, policy))
+	return b
+}
+
+// newCacheBehavior returns a cache behavior with the defaults of the
+// CloudFront console: GET and HEAD requests, cached without their query
+// string and cookies, and a MinTTL of 0 so the origin's Cache-Control
+// headers apply, for a day when they have none and a year at most.
+func newCacheBehavior(pathPattern, targetOriginId string, policy ViewerProtocolPolicy) CacheBehavior {
+	cookies := CookiesDefault
+	defaultTTL, maxTTL := 86400, 31536000
+	return CacheBehavior{
+		TargetOriginId:       targetOriginId,
+		PathPattern:          pathPattern,
+		ForwardedValues:      ForwardedValues{Cookies: &cookies, Headers: Names{}},
+		ViewerProtocolPolicy: policy,
+		MinTTL:               0,
+		DefaultTTL:           &defaultTTL,
+		MaxTTL:               &maxTTL,
+		AllowedMethods: AllowedMethods{
+			Allowed: []string{MethodGet, MethodHead},
+			Cached:  []string{MethodGet, MethodHead},
+		},
+	}
+}
+
+// WithDefaultRootObject sets the object, such as "index.html", returned
+// for requests of the root URL.
+func (b *DistributionBuilder) WithDefaultRootObject(object string) *DistributionBuilder {
+	b.config.DefaultRootObject = object
+	return b
+}
+
+// WithComment sets the comment of the distribution.
+func (b *DistributionBuilder) WithComment(comment string) *DistributionBuilder {
+	b.config.Comment = comment
+	return b
+}
+
+// WithPriceClass restricts the edge locations the distribution is served
+// from.
+func (b *DistributionBuilder) WithPriceClass(priceClass PriceClass) *DistributionBuilder {
+	b.config.PriceClass = priceClass
+	return b
+}
+
+// WithACMCertificate serves the aliases over HTTPS with an ACM
+// certificate, which must have been requested in us-east-1, using SNI and
+// at least TLS 1.2. The TLSv1.2_2021 policy it sets needs the 2020-05-31
+// API, the ApiVersion of this package.
+func (b *DistributionBuilder) WithACMCertificate(certificateArn string) *DistributionBuilder {
+	b.config.ViewerCertificate = &ViewerCertificate{
+		ACMCertificateArn:      certificateArn,
+		SSLSupportMethod:       SSLSupportMethodSNIOnly,
+		MinimumProtocolVersion: MinimumProtocolVersionTLSv12_2021,
+	}
+	return b
+}
+
+// WithGeoRestriction denies, or allows only, the given countries, given by
+// ISO 3166-1 alpha-2 code.
+func (b *DistributionBuilder) WithGeoRestriction(restrictionType RestrictionType, locations ...string) *DistributionBuilder {
+	b.config.Restrictions = &GeoRestriction{RestrictionType: restrictionType, Locations: locations}
+	return b
+}
+
+// WithLogging writes the access logs to a bucket, given by its domain name
+// such as "logs.s3.amazonaws.com", under prefix.
+func (b *DistributionBuilder) WithLogging(bucket, prefix string) *DistributionBuilder {
+	b.config.Logging = Logging{Enabled: true, Bucket: bucket, Prefix: prefix}
+	return b
+}
+
+// WithWebACL protects the distribution with a web ACL, given by the ARN of
+// a wafv2 web ACL of scope CLOUDFRONT.
+func (b *DistributionBuilder) WithWebACL(webACLId string) *DistributionBuilder {
+	b.config.WebACLId = webACLId
+	return b
+}
+
+// Build returns the config, or the error of DistributionConfig.Validate if
+// it is invalid.
+func (b *DistributionBuilder) Build() (DistributionConfig, error) {
+	config := b.config
+	if !b.defaultBehavior && len(config.Origins) > 0 {
+		config.DefaultCacheBehavior = newCacheBehavior("", config.Origins[0].Id, ViewerProtocolPolicyRedirectToHTTPS)
+	}
+	if err := config.Validate(); err != nil {
+		return DistributionConfig{}, err
+	}
+	return config, nil
+}
@@ -0,0 +1,92 @@
+package cloudfront
+
+import (
+	"encoding/xml"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDistributionBuilder(t *testing.T) {
+	config, err := NewDistributionBuilder().
+		WithS3Origin("assets", "assets.s3.amazonaws.com", "origin-access-identity/cloudfront/E127EXAMPLE51Z").
+		WithCustomOrigin("app", "app.example.com", OriginProtocolPolicyHTTPSOnly).
+		WithAlias("cdn.example.com", "www.example.com").
+		WithDefaultBehavior("app", ViewerProtocolPolicyRedirectToHTTPS).
+		WithBehavior("/static/*", "assets", ViewerProtocolPolicyHTTPSOnly).
+		WithACMCertificate("arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012").
+		WithGeoRestriction(RestrictionTypeWhitelist, "US", "CA").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !config.Enabled || config.PriceClass != PriceClassAll {
+		t.Errorf("unexpected defaults: Enabled %v, PriceClass %q", config.Enabled, config.PriceClass)
+	}
+	if !reflect.DeepEqual(config.Aliases, Aliases{"cdn.example.com", "www.example.com"}) {
+		t.Errorf("unexpected aliases: %v", config.Aliases)
+	}
+	if config.Origins[1].CustomOriginConfig.HTTPSPort != 443 {
+		t.Errorf("unexpected custom origin: %#v", config.Origins[1].CustomOriginConfig)
+	}
+	if config.DefaultCacheBehavior.TargetOriginId != "app" || config.CacheBehaviors[0].PathPattern != "/static/*" {
+		t.Errorf("unexpected behaviors: %#v, %#v", config.DefaultCacheBehavior, config.CacheBehaviors)
+	}
+	methods := AllowedMethods{Allowed: []string{"GET", "HEAD"}, Cached: []string{"GET", "HEAD"}}
+	if !reflect.DeepEqual(config.CacheBehaviors[0].AllowedMethods, methods) {
+		t.Errorf("unexpected allowed methods: %#v", config.CacheBehaviors[0].AllowedMethods)
+	}
+	if config.ViewerCertificate.SSLSupportMethod != SSLSupportMethodSNIOnly {
+		t.Errorf("unexpected viewer certificate: %#v", config.ViewerCertificate)
+	}
+
+	data, err := xml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"<Cookies><Forward>none</Forward><WhitelistedNames><Quantity>0</Quantity>",
+		"<AllowedMethods><Quantity>2</Quantity><Items><Method>GET</Method><Method>HEAD</Method></Items>",
+		"<Restrictions><GeoRestriction><RestrictionType>whitelist</RestrictionType><Quantity>2</Quantity>",
+		"<PriceClass>PriceClass_All</PriceClass>",
+		"<MinTTL>0</MinTTL><DefaultTTL>86400</DefaultTTL><MaxTTL>31536000</MaxTTL>",
+		"<MinimumProtocolVersion>TLSv1.2_2021</MinimumProtocolVersion>",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s in %s", want, data)
+		}
+	}
+}
+
+func TestDistributionBuilderDefaultBehavior(t *testing.T) {
+	config, err := NewDistributionBuilder().
+		WithS3Origin("assets", "assets.s3.amazonaws.com", "").
+		WithPriceClass(PriceClass100).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	behavior := config.DefaultCacheBehavior
+	if behavior.TargetOriginId != "assets" || behavior.ViewerProtocolPolicy != ViewerProtocolPolicyRedirectToHTTPS {
+		t.Errorf("unexpected default behavior: %#v", behavior)
+	}
+	if config.PriceClass != PriceClass100 {
+		t.Errorf("unexpected price class: %q", config.PriceClass)
+	}
+}
+
+func TestDistributionBuilderInvalid(t *testing.T) {
+	_, err := NewDistributionBuilder().Build()
+	if err == nil || err.Error() != "cloudfront: invalid Origins: at least one origin is required" {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	_, err = NewDistributionBuilder().
+		WithS3Origin("assets", "assets.s3.amazonaws.com", "").
+		WithBehavior("/api/*", "api", ViewerProtocolPolicyHTTPSOnly).
+		Build()
+	if err == nil || err.Error() != `cloudfront: invalid CacheBehaviors[0].TargetOriginId: no origin with ID "api"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -8,19 +8,27 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/zackbloom/goamz/aws"
 )
 
+// ApiVersion is the version of the CloudFront API the requests are sent
+// to. The fields of DistributionConfig, and their order, follow its
+// schema.
 const (
 	ServiceName = "cloudfront"
-	ApiVersion  = "2014-11-06"
+	ApiVersion  = "2020-05-31"
 )
 
 // TODO Reconcile with 'New' fn below
@@ -33,29 +41,65 @@ func NewCloudFront(auth aws.Auth) *CloudFront {
 	}
 }
 
+// CloudFront is a client of the CloudFront API, which also signs the URLs
+// of private content.
+//
+// A CloudFront is safe for concurrent use by multiple goroutines: its API
+// calls and CannedSignedURL only read its configuration, and the state they
+// share, such as rate limits and the credentials of Auth, is locked. Every
+// API request is signed with a copy of Auth, refreshed first if its token
+// has expired. Its exported fields must not be changed once it is in use;
+// create another client instead.
 type CloudFront struct {
+	// Deprecated: Signer is not used; the API requests are signed with the
+	// current credentials of Auth.
 	Signer    *aws.V4Signer
 	Auth      aws.Auth
 	BaseURL   string
 	keyPairId string
 	key       *rsa.PrivateKey
+
+	// Set by the options of NewCloudFrontWithOptions.
+	endpoint    string
+	httpClient  *http.Client
+	retryPolicy aws.RetryPolicy
+	region      aws.Region
+	logger      *log.Logger
+	rateLimits  map[OperationClass]*tokenBucket
+	metrics     aws.Metrics
+
+	// authMu guards Auth, which is rewritten when its token expires.
+	authMu sync.Mutex
+
+	// signingKey is a precomputed copy of key, made on first use, or nil
+	// when key is not a valid private key.
+	signingKeyOnce sync.Once
+	signingKey     *rsa.PrivateKey
 }
 
 type DistributionConfig struct {
-	XMLName              xml.Name `xml:"DistributionConfig"`
+	XMLName              xml.Name `xml:"DistributionConfig" json:"-"`
 	CallerReference      string
 	Aliases              Aliases
 	DefaultRootObject    string
 	Origins              Origins
+	OriginGroups         *UnknownElement `xml:",omitempty" json:",omitempty"` // Kept as raw XML
 	DefaultCacheBehavior CacheBehavior
-	Comment              string
 	CacheBehaviors       CacheBehaviors
 	CustomErrorResponses CustomErrorResponses
-	Restrictions         *GeoRestriction `xml:"Restrictions>GeoRestriction,omitempty"`
+	Comment              string
 	Logging              Logging
-	ViewerCertificate    *ViewerCertificate `xml:",omitempty"`
-	PriceClass           string
+	PriceClass           PriceClass
 	Enabled              bool
+	ViewerCertificate    *ViewerCertificate `xml:",omitempty"`
+	Restrictions         *GeoRestriction    `xml:"Restrictions>GeoRestriction,omitempty"`
+	WebACLId             string             `xml:",omitempty"` // The ARN of a wafv2 web ACL of scope CLOUDFRONT
+	HttpVersion          string             `xml:",omitempty"` // "http1.1", "http2", "http3" or "http2and3"
+	IsIPV6Enabled        bool               `xml:",omitempty"`
+
+	// Unknown holds the elements of a fetched config that have no field,
+	// so that updating the distribution keeps them.
+	Unknown []UnknownElement `xml:",any" json:",omitempty"`
 }
 
 type DistributionSummary struct {
@@ -155,20 +199,24 @@ type Logging struct {
 	Prefix         string
 }
 
+// ViewerCertificate selects the certificate served to viewers. A
+// certificate issued by ACM is referenced by ACMCertificateArn and must
+// have been requested in us-east-1; see the acm package.
 type ViewerCertificate struct {
-	IAMCertificateId             string `xml:",omitempty"`
-	CloudFrontDefaultCertificate bool   `xml:",omitempty"`
-	SSLSupportMethod             string
-	MinimumProtocolVersion       string
+	CloudFrontDefaultCertificate bool                   `xml:",omitempty"`
+	IAMCertificateId             string                 `xml:",omitempty"`
+	ACMCertificateArn            string                 `xml:",omitempty"`
+	SSLSupportMethod             SSLSupportMethod       `xml:",omitempty"`
+	MinimumProtocolVersion       MinimumProtocolVersion `xml:",omitempty"`
 }
 
 type GeoRestriction struct {
-	RestrictionType string
+	RestrictionType RestrictionType
 	Locations       []string
 }
 
 type EncodedGeoRestriction struct {
-	RestrictionType string
+	RestrictionType RestrictionType
 	Quantity        int
 	Locations       []string `xml:"Items>Location"`
 }
@@ -196,7 +244,7 @@ func (n *GeoRestriction) UnmarshalXML(d *xml.Decoder, start xml.StartElement) er
 }
 
 type CustomErrorResponse struct {
-	XMLName            xml.Name `xml:"CustomErrorResponse"`
+	XMLName            xml.Name `xml:"CustomErrorResponse" json:"-"`
 	ErrorCode          int
 	ResponsePagePath   string
 	ResponseCode       int
@@ -204,7 +252,7 @@ type CustomErrorResponse struct {
 }
 
 type Origin struct {
-	XMLName            xml.Name `xml:"Origin"`
+	XMLName            xml.Name `xml:"Origin" json:"-"`
 	Id                 string
 	DomainName         string
 	OriginPath         string              `xml:"OriginPath,omitempty"`
@@ -219,7 +267,7 @@ type S3OriginConfig struct {
 type CustomOriginConfig struct {
 	HTTPPort             int
 	HTTPSPort            int
-	OriginProtocolPolicy string
+	OriginProtocolPolicy OriginProtocolPolicy
 }
 
 type Origins []Origin
@@ -249,15 +297,21 @@ func (o *Origins) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	return nil
 }
 
+// CacheBehavior sets how the requests matching PathPattern are cached.
+// DefaultTTL and MaxTTL, in seconds, bound the time objects are cached
+// according to their Cache-Control headers; when nil, CloudFront uses
+// 86400 and 31536000.
 type CacheBehavior struct {
-	TargetOriginId       string
 	PathPattern          string `xml:",omitempty"`
-	ForwardedValues      ForwardedValues
+	TargetOriginId       string
 	TrustedSigners       TrustedSigners
-	ViewerProtocolPolicy string
-	MinTTL               int
+	ViewerProtocolPolicy ViewerProtocolPolicy
 	AllowedMethods       AllowedMethods
 	SmoothStreaming      bool
+	ForwardedValues      ForwardedValues
+	MinTTL               int
+	DefaultTTL           *int `xml:",omitempty" json:",omitempty"`
+	MaxTTL               *int `xml:",omitempty" json:",omitempty"`
 }
 
 type ForwardedValues struct {
@@ -267,12 +321,12 @@ type ForwardedValues struct {
 }
 
 type Cookies struct {
-	Forward          string
+	Forward          CookieForward
 	WhitelistedNames Names
 }
 
 var CookiesDefault = Cookies{
-	Forward:          "none",
+	Forward:          CookieForwardNone,
 	WhitelistedNames: Names{},
 }
 
@@ -437,8 +491,8 @@ func (cf *CloudFront) generateSignature(policy []byte) (string, error) {
 
 	hashed := hash.Sum(nil)
 	var signed []byte
-	if cf.key.Validate() == nil {
-		signed, err = rsa.SignPKCS1v15(nil, cf.key, crypto.SHA1, hashed)
+	if key := cf.privateKey(); key != nil {
+		signed, err = rsa.SignPKCS1v15(nil, key, crypto.SHA1, hashed)
 		if err != nil {
 			return "", err
 		}
@@ -450,6 +504,21 @@ func (cf *CloudFront) generateSignature(policy []byte) (string, error) {
 	return encoded, nil
 }
 
+// privateKey returns the key to sign URLs with, or nil if the client has
+// none. The key is validated and precomputed once, on a copy so that the
+// caller's key is not changed, instead of on every signature.
+func (cf *CloudFront) privateKey() *rsa.PrivateKey {
+	cf.signingKeyOnce.Do(func() {
+		if cf.key == nil || cf.key.Validate() != nil {
+			return
+		}
+		key := *cf.key
+		key.Precompute()
+		cf.signingKey = &key
+	})
+	return cf.signingKey
+}
+
 // Create a CloudFront distribution
 //
 // Usage:
@@ -526,41 +595,16 @@ func (cf *CloudFront) Create(config DistributionConfig) (summary DistributionSum
 	for i, _ := range config.CacheBehaviors {
 		cacheBehaviorDefault(&(config.CacheBehaviors[i]))
 	}
-
-	body, err := xml.Marshal(config)
-	if err != nil {
-		return
-	}
-
-	client := http.Client{}
-	req, err := http.NewRequest("POST", "https://"+ServiceName+".amazonaws.com/"+ApiVersion+"/distribution", bytes.NewReader(body))
-	if err != nil {
+	if err = config.Validate(); err != nil {
 		return
 	}
 
-	cf.Signer.Sign(req)
-
-	resp, err := client.Do(req)
+	body, err := xml.Marshal(config)
 	if err != nil {
 		return
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode >= 400 {
-		errors := aws.ErrorResponse{}
-		xml.NewDecoder(resp.Body).Decode(&errors)
-
-		err := errors.Errors
-		err.RequestId = errors.RequestId
-		err.StatusCode = resp.StatusCode
-		if err.Message == "" {
-			err.Message = resp.Status
-		}
-		return summary, &err
-	} else {
-		err = xml.NewDecoder(resp.Body).Decode(&summary)
-	}
 
+	_, err = cf.query("CreateDistribution", "POST", "/distribution", nil, nil, body, &summary)
 	return
 }
 
@@ -593,39 +637,187 @@ func (cf *CloudFront) List(marker string, max int) (items *DistributionsResp, er
 		params["Marker"] = []string{marker}
 	}
 
-	uri, _ := url.Parse("https://" + ServiceName + ".amazonaws.com/" + ApiVersion + "/distribution")
-	uri.RawQuery = params.Encode()
+	items = &DistributionsResp{}
+	if _, err = cf.query("ListDistributions", "GET", "/distribution", params, nil, nil, items); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ListDistributionsByWebACLId lists the distributions protected by a web
+// ACL, given by its ARN for a wafv2 web ACL. Marker and max are as in List.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/API_ListDistributionsByWebACLId.html
+func (cf *CloudFront) ListDistributionsByWebACLId(webACLId, marker string, max int) (*DistributionsResp, error) {
+	params := url.Values{
+		"MaxItems": []string{strconv.FormatInt(int64(max), 10)},
+	}
+	if marker != "" {
+		params["Marker"] = []string{marker}
+	}
+
+	items := &DistributionsResp{}
+	if _, err := cf.query("ListDistributionsByWebACLId", "GET", "/distributionsByWebACLId/"+url.PathEscape(webACLId), params, nil, nil, items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
-	client := http.Client{}
-	req, err := http.NewRequest("GET", uri.String(), nil)
+// ListDistributionsByOrigin returns the distributions of the account with
+// an origin whose domain name is domainName, compared without regard to
+// case. All the pages of List are read.
+func (cf *CloudFront) ListDistributionsByOrigin(domainName string) ([]DistributionSummary, error) {
+	var dists []DistributionSummary
+	marker := ""
+	for {
+		resp, err := cf.List(marker, 100)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Items {
+			for _, origin := range item.Origins {
+				if strings.EqualFold(origin.DomainName, domainName) {
+					dists = append(dists, item.DistributionSummary)
+					break
+				}
+			}
+		}
+		if !resp.IsTruncated {
+			return dists, nil
+		}
+		marker = resp.NextMarker
+	}
+}
+
+// query sends a request to the CloudFront API, with the given headers,
+// retrying it as set by the retry policy of cf. It decodes the response
+// into resp, if not nil, and returns its headers.
+func (cf *CloudFront) query(action, method, path string, params url.Values, header http.Header, body []byte, resp interface{}) (http.Header, error) {
+	endpoint := cf.endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	uri, err := url.Parse(endpoint + "/" + ApiVersion + path)
 	if err != nil {
-		return
+		return nil, err
 	}
+	uri.RawQuery = params.Encode()
 
-	cf.Signer.Sign(req)
+	client := cf.httpClient
+	if client == nil {
+		client = &http.Client{}
+	}
+	policy := cf.retryPolicy
+	if policy == nil {
+		policy = aws.NeverRetryPolicy{}
+	}
+
+	metrics := cf.metrics
+	if metrics == nil {
+		metrics = aws.NopMetrics{}
+	}
+	start := time.Now()
+	metrics.RequestStart(ServiceName, action)
+	respHeader, err := cf.attempt(client, policy, metrics, action, method, uri.String(), header, body, resp)
+	metrics.RequestFinish(ServiceName, action, time.Since(start), err)
+	return respHeader, err
+}
+
+// attempt makes the request, again as long as policy retries it.
+func (cf *CloudFront) attempt(client *http.Client, policy aws.RetryPolicy, metrics aws.Metrics, action, method, uri string, header http.Header, body []byte, resp interface{}) (http.Header, error) {
+	limit := cf.rateLimits[operationClass(action, method)]
+	for numRetries := 0; ; numRetries++ {
+		if limit != nil {
+			limit.wait()
+		}
+		data, respHeader, err := cf.send(client, method, uri, header, body)
+		if err == nil {
+			aws.RecordSuccess(policy, action, numRetries)
+			if resp == nil || len(data) == 0 {
+				return respHeader, nil
+			}
+			return respHeader, xml.Unmarshal(data, resp)
+		}
+		metrics.RequestError(ServiceName, action, err)
+		var hresp *http.Response
+		var apiErr aws.APIError
+		if errors.As(err, &apiErr) {
+			hresp = &http.Response{StatusCode: apiErr.HTTPStatus()}
+		}
+		if !policy.ShouldRetry(action, hresp, err, numRetries) {
+			return nil, err
+		}
+		delay := policy.Delay(action, hresp, err, numRetries)
+		if cf.logger != nil {
+			cf.logger.Printf("cloudfront: retrying %s in %s after: %v", action, delay, err)
+		}
+		metrics.RequestRetry(ServiceName, action, numRetries+1, err)
+		time.Sleep(delay)
+	}
+}
+
+// send makes a single signed request, and returns the body and headers
+// of the response or the error it holds.
+func (cf *CloudFront) send(client *http.Client, method, uri string, header http.Header, body []byte) ([]byte, http.Header, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequest(method, uri, reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	auth := cf.credentials()
+	if token := auth.Token(); token != "" {
+		req.Header.Set("X-Amz-Security-Token", token)
+	}
+	region := cf.region
+	if region.Name == "" {
+		region = aws.USEast
+	}
+	aws.NewV4Signer(auth, ServiceName, region).Sign(req)
 
 	resp, err := client.Do(req)
 	if err != nil {
-		return
+		if cf.logger != nil {
+			cf.logger.Printf("cloudfront: %s %s: %v", method, uri, err)
+		}
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
+	if cf.logger != nil {
+		cf.logger.Printf("cloudfront: %s %s: %s", method, uri, resp.Status)
+	}
 
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
 	if resp.StatusCode >= 400 {
 		errors := aws.ErrorResponse{}
-		xml.NewDecoder(resp.Body).Decode(&errors)
+		xml.Unmarshal(data, &errors)
 
-		errors.Errors.RequestId = errors.RequestId
-		errors.Errors.StatusCode = resp.StatusCode
-		if errors.Errors.Message == "" {
-			errors.Errors.Message = resp.Status
+		err := errors.Errors
+		err.RequestId = errors.RequestId
+		err.StatusCode = resp.StatusCode
+		if err.Message == "" {
+			err.Message = resp.Status
 		}
-		err = &errors.Errors
-	} else {
-		items = &DistributionsResp{}
-		err = xml.NewDecoder(resp.Body).Decode(items)
+		return nil, nil, &err
 	}
+	return data, resp.Header, nil
+}
 
-	return
+// credentials returns a copy of Auth, refreshing Auth first if its token
+// has expired.
+func (cf *CloudFront) credentials() aws.Auth {
+	cf.authMu.Lock()
+	defer cf.authMu.Unlock()
+	cf.Auth.Token()
+	return cf.Auth
 }
 
 func (cf *CloudFront) FindDistributionByAlias(alias string) (dist *DistributionSummary, err error) {
@@ -1,12 +1,20 @@
 package cloudfront
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/pem"
+	"encoding/xml"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/zackbloom/goamz/aws"
 )
 
 func TestSignedCannedURL(t *testing.T) {
@@ -50,3 +58,101 @@ func TestSignedCannedURL(t *testing.T) {
 		t.Fatal("Encoded signature is empty")
 	}
 }
+
+func TestViewerCertificateACM(t *testing.T) {
+	cert := &ViewerCertificate{
+		ACMCertificateArn:      "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+		SSLSupportMethod:       "sni-only",
+		MinimumProtocolVersion: "TLSv1.2_2021",
+	}
+	data, err := xml.Marshal(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "<ViewerCertificate>" +
+		"<ACMCertificateArn>arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012</ACMCertificateArn>" +
+		"<SSLSupportMethod>sni-only</SSLSupportMethod>" +
+		"<MinimumProtocolVersion>TLSv1.2_2021</MinimumProtocolVersion>" +
+		"</ViewerCertificate>"
+	if string(data) != want {
+		t.Fatalf("got %s, want %s", data, want)
+	}
+}
+
+func TestDistributionConfigWebACLId(t *testing.T) {
+	config := DistributionConfig{WebACLId: "arn:aws:wafv2:us-east-1:123456789012:global/webacl/edge/a1b2c3d4"}
+	data, err := xml.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "<WebACLId>arn:aws:wafv2:us-east-1:123456789012:global/webacl/edge/a1b2c3d4</WebACLId>"
+	if !strings.Contains(string(data), want) {
+		t.Fatalf("%s not found in %s", want, data)
+	}
+
+	data, err = xml.Marshal(DistributionConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "WebACLId") {
+		t.Fatalf("empty WebACLId marshaled in %s", data)
+	}
+}
+
+func TestCreateRequestVersion(t *testing.T) {
+	var path string
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		body, _ = ioutil.ReadAll(r.Body)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(createDistributionResponse))
+	}))
+	defer srv.Close()
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+
+	config, err := NewDistributionBuilder().
+		WithCustomOrigin("app", "app.example.com", OriginProtocolPolicyHTTPSOnly).
+		WithACMCertificate("arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012").
+		WithWebACL("arn:aws:wafv2:us-east-1:123456789012:global/webacl/edge/a1b2c3d4").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.HttpVersion = "http2"
+	if _, err := cf.Create(config); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/2020-05-31/distribution" {
+		t.Errorf("unexpected path %s", path)
+	}
+
+	// The elements must be in the order of the 2020-05-31 schema.
+	var elements []string
+	d := xml.NewDecoder(bytes.NewReader(body))
+	for depth := 0; ; {
+		tok, err := d.Token()
+		if err != nil {
+			break
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if depth == 1 {
+				elements = append(elements, tok.Name.Local)
+			}
+			depth++
+		case xml.EndElement:
+			depth--
+		}
+	}
+	want := []string{"CallerReference", "Aliases", "DefaultRootObject", "Origins", "DefaultCacheBehavior",
+		"CacheBehaviors", "CustomErrorResponses", "Comment", "Logging", "PriceClass", "Enabled",
+		"ViewerCertificate", "WebACLId", "HttpVersion"}
+	if !reflect.DeepEqual(elements, want) {
+		t.Errorf("unexpected elements %v", elements)
+	}
+	if !strings.Contains(string(body), "<SmoothStreaming>false</SmoothStreaming><ForwardedValues>") {
+		t.Errorf("ForwardedValues not after SmoothStreaming in %s", body)
+	}
+}
@@ -0,0 +1,151 @@
+package cloudfront
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// These tests are meant to be run with -race.
+
+func TestCannedSignedURLConcurrent(t *testing.T) {
+	rawKey, err := ioutil.ReadFile("testdata/key.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey, _ := pem.Decode(rawKey)
+	privateKey, err := x509.ParsePKCS1PrivateKey(pemKey.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"},
+		WithSigningKey("https://d111111abcdef8.cloudfront.net", privateKey, "APKAEIBAERJR2EXAMPLE"))
+	expires := time.Date(2014, 3, 28, 14, 0, 21, 0, time.UTC)
+	expected, err := cf.CannedSignedURL("/private/a.jpg", "", expires)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uri, err := cf.CannedSignedURL("/private/a.jpg", "", expires)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if uri != expected {
+				t.Errorf("expected %s, got %s", expected, uri)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCannedSignedURLKeyLess(t *testing.T) {
+	cf := NewKeyLess(aws.Auth{AccessKey: "APKAEIBAERJR2EXAMPLE"}, "https://d111111abcdef8.cloudfront.net")
+	uri, err := cf.CannedSignedURL("/a.jpg", "", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.Query().Get("Signature") == "" || parsed.Query().Get("Key-Pair-Id") != "APKAEIBAERJR2EXAMPLE" {
+		t.Fatalf("unexpected URL: %s", uri)
+	}
+}
+
+func TestAPIConcurrent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(listDistributionsResponse))
+	}))
+	defer srv.Close()
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"},
+		WithEndpoint(srv.URL),
+		WithRetry(aws.DefaultRetryPolicy{}),
+		WithRateLimit(OperationRead, 1000, 10),
+		WithMetrics(aws.NopMetrics{}))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := cf.List("", 100)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if len(resp.Items) != 1 {
+				t.Errorf("unexpected distributions: %#v", resp.Items)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAPIConcurrentSessionToken(t *testing.T) {
+	var mu sync.Mutex
+	var tokens, credentials []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens = append(tokens, r.Header.Get("X-Amz-Security-Token"))
+		credentials = append(credentials, strings.SplitN(r.Header.Get("Authorization"), "/", 2)[0])
+		mu.Unlock()
+		w.Write([]byte(listDistributionsResponse))
+	}))
+	defer srv.Close()
+
+	list := func(cf *CloudFront) {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := cf.List("", 100); err != nil {
+					t.Error(err)
+				}
+			}()
+		}
+		wg.Wait()
+	}
+
+	auth := aws.NewAuth("ASIAEXAMPLE", "secret", "session-token", time.Now().Add(time.Hour))
+	list(NewCloudFrontWithOptions(*auth, WithEndpoint(srv.URL)))
+	for i := range tokens {
+		if tokens[i] != "session-token" || credentials[i] != "AWS4-HMAC-SHA256 Credential=ASIAEXAMPLE" {
+			t.Fatalf("request %d: unexpected token %q and credential %q", i, tokens[i], credentials[i])
+		}
+	}
+
+	// An expired token is refreshed once, here from the environment, and
+	// the requests are signed with the new credentials.
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "refreshed")
+	tokens, credentials = nil, nil
+	expired := aws.NewAuth("ASIAEXAMPLE", "secret", "session-token", time.Now().Add(-time.Minute))
+	list(NewCloudFrontWithOptions(*expired, WithEndpoint(srv.URL)))
+	for i := range tokens {
+		if tokens[i] != "" || credentials[i] != "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE" {
+			t.Fatalf("request %d: unexpected token %q and credential %q", i, tokens[i], credentials[i])
+		}
+	}
+	if len(tokens) != 10 {
+		t.Errorf("expected 10 requests, got %d", len(tokens))
+	}
+}
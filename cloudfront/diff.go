@@ -0,0 +1,120 @@
+package cloudfront
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Kinds of Change.
+const (
+	ChangeAdded    = "added"
+	ChangeRemoved  = "removed"
+	ChangeModified = "modified"
+)
+
+// Change is a difference between two DistributionConfigs. Path locates the
+// setting, such as "Comment", "DefaultCacheBehavior.MinTTL" or
+// "Origins[app].CustomOriginConfig.HTTPSPort": origins are identified by
+// their Id and cache behaviors by their PathPattern. Old is nil for an
+// added setting, and New for a removed one.
+type Change struct {
+	Kind string
+	Path string
+	Old  interface{}
+	New  interface{}
+}
+
+func (c Change) String() string {
+	switch c.Kind {
+	case ChangeAdded:
+		return fmt.Sprintf("+ %s: %v", c.Path, c.New)
+	case ChangeRemoved:
+		return fmt.Sprintf("- %s: %v", c.Path, c.Old)
+	}
+	return fmt.Sprintf("~ %s: %v -> %v", c.Path, c.Old, c.New)
+}
+
+// Diff returns the differences between two DistributionConfigs, such as a
+// fetched config and the config about to be applied by UpdateDistribution.
+// Origins and cache behaviors are matched by Id and PathPattern, so that
+// reordering them is not a change; an added or removed origin or cache
+// behavior is a single Change.
+func Diff(old, new DistributionConfig) []Change {
+	var changes []Change
+	diffValue(&changes, "", reflect.ValueOf(old), reflect.ValueOf(new))
+	return changes
+}
+
+var (
+	originsType        = reflect.TypeOf(Origins{})
+	cacheBehaviorsType = reflect.TypeOf(CacheBehaviors{})
+	xmlNameType        = reflect.TypeOf(DistributionConfig{}.XMLName)
+)
+
+func diffValue(changes *[]Change, path string, old, new reflect.Value) {
+	switch {
+	case old.Type() == originsType:
+		diffKeyed(changes, path, old, new, "Id")
+	case old.Type() == cacheBehaviorsType:
+		diffKeyed(changes, path, old, new, "PathPattern")
+	case old.Kind() == reflect.Struct:
+		for i := 0; i < old.NumField(); i++ {
+			field := old.Type().Field(i)
+			if field.Type == xmlNameType {
+				continue
+			}
+			diffValue(changes, joinPath(path, field.Name), old.Field(i), new.Field(i))
+		}
+	case old.Kind() == reflect.Ptr && !old.IsNil() && !new.IsNil():
+		diffValue(changes, path, old.Elem(), new.Elem())
+	case old.Kind() == reflect.Ptr && old.IsNil() && !new.IsNil():
+		*changes = append(*changes, Change{ChangeAdded, path, nil, new.Elem().Interface()})
+	case old.Kind() == reflect.Ptr && !old.IsNil() && new.IsNil():
+		*changes = append(*changes, Change{ChangeRemoved, path, old.Elem().Interface(), nil})
+	case !equalValues(old, new):
+		*changes = append(*changes, Change{ChangeModified, path, old.Interface(), new.Interface()})
+	}
+}
+
+// diffKeyed diffs slices of structs, matching their elements by the value
+// of the field key.
+func diffKeyed(changes *[]Change, path string, old, new reflect.Value, key string) {
+	index := func(v reflect.Value) map[string]reflect.Value {
+		m := make(map[string]reflect.Value, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			m[v.Index(i).FieldByName(key).String()] = v.Index(i)
+		}
+		return m
+	}
+	oldItems, newItems := index(old), index(new)
+	for i := 0; i < old.Len(); i++ {
+		k := old.Index(i).FieldByName(key).String()
+		itemPath := fmt.Sprintf("%s[%s]", path, k)
+		if n, ok := newItems[k]; ok {
+			diffValue(changes, itemPath, old.Index(i), n)
+		} else {
+			*changes = append(*changes, Change{ChangeRemoved, itemPath, old.Index(i).Interface(), nil})
+		}
+	}
+	for i := 0; i < new.Len(); i++ {
+		k := new.Index(i).FieldByName(key).String()
+		if _, ok := oldItems[k]; !ok {
+			*changes = append(*changes, Change{ChangeAdded, fmt.Sprintf("%s[%s]", path, k), nil, new.Index(i).Interface()})
+		}
+	}
+}
+
+// equalValues compares values, treating nil and empty slices as equal.
+func equalValues(old, new reflect.Value) bool {
+	if old.Kind() == reflect.Slice && old.Len() == 0 && new.Len() == 0 {
+		return true
+	}
+	return reflect.DeepEqual(old.Interface(), new.Interface())
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
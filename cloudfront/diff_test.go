@@ -0,0 +1,58 @@
+package cloudfront
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	old, err := NewDistributionBuilder().
+		WithCustomOrigin("app", "app.example.com", OriginProtocolPolicyHTTPSOnly).
+		WithS3Origin("assets", "assets.s3.amazonaws.com", "").
+		WithBehavior("/static/*", "assets", ViewerProtocolPolicyHTTPSOnly).
+		WithComment("site").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if changes := Diff(old, old); changes != nil {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+
+	new, err := NewDistributionBuilder().
+		WithS3Origin("media", "media.s3.amazonaws.com", "").
+		WithCustomOrigin("app", "app.example.com", OriginProtocolPolicyMatchViewer).
+		WithBehavior("/static/*", "media", ViewerProtocolPolicyHTTPSOnly).
+		WithDefaultBehavior("app", ViewerProtocolPolicyRedirectToHTTPS).
+		WithComment("site").
+		WithACMCertificate("arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	new.DefaultCacheBehavior.MinTTL = 60
+
+	var got []string
+	for _, c := range Diff(old, new) {
+		got = append(got, c.Kind+" "+c.Path)
+	}
+	want := []string{
+		"modified Origins[app].CustomOriginConfig.OriginProtocolPolicy",
+		"removed Origins[assets]",
+		"added Origins[media]",
+		"modified DefaultCacheBehavior.MinTTL",
+		"modified CacheBehaviors[/static/*].TargetOriginId",
+		"added ViewerCertificate",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected changes:\n%v\nexpected:\n%v", got, want)
+	}
+}
+
+func TestChangeString(t *testing.T) {
+	c := Change{ChangeModified, "DefaultCacheBehavior.MinTTL", 0, 60}
+	if c.String() != "~ DefaultCacheBehavior.MinTTL: 0 -> 60" {
+		t.Errorf("unexpected string: %s", c)
+	}
+}
@@ -0,0 +1,243 @@
+package cloudfront
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/zackbloom/goamz/cloudfront/yaml"
+)
+
+// UnknownElement is a top-level element of a DistributionConfig that this
+// package has no field for, such as a setting newer than the API version
+// it uses, or OriginGroups. It is kept as raw XML. Unknown elements nested
+// in known ones, such as in cache behaviors, are not kept, so
+// ExportDistributionConfig refuses the configs that have some.
+type UnknownElement struct {
+	Name     string
+	InnerXML string
+}
+
+type encodedUnknownElement struct {
+	InnerXML string `xml:",innerxml"`
+}
+
+func (u UnknownElement) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(encodedUnknownElement{u.InnerXML}, xml.StartElement{Name: xml.Name{Local: u.Name}})
+}
+
+func (u *UnknownElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	enc := encodedUnknownElement{}
+	if err := d.DecodeElement(&enc, &start); err != nil {
+		return err
+	}
+	u.Name = start.Name.Local
+	u.InnerXML = enc.InnerXML
+	return nil
+}
+
+// GetDistributionConfig returns the config of a distribution, and its
+// ETag, which UpdateDistribution requires.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/GetDistributionConfig.html
+func (cf *CloudFront) GetDistributionConfig(id string) (config DistributionConfig, etag string, err error) {
+	header, err := cf.query("GetDistributionConfig", "GET", "/distribution/"+url.PathEscape(id)+"/config", nil, nil, nil, &config)
+	if err != nil {
+		return DistributionConfig{}, "", err
+	}
+	return config, header.Get("ETag"), nil
+}
+
+// UpdateDistribution replaces the config of a distribution. etag is the
+// ETag of the config it replaces, as returned by GetDistributionConfig;
+// the update fails if the config changed since. The new ETag is returned.
+//
+// The config must be complete, as fetched by GetDistributionConfig and
+// modified; settings left out are reset to their defaults.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/UpdateDistribution.html
+func (cf *CloudFront) UpdateDistribution(id string, config DistributionConfig, etag string) (summary DistributionSummary, newETag string, err error) {
+	if err = config.Validate(); err != nil {
+		return
+	}
+	body, err := xml.Marshal(config)
+	if err != nil {
+		return
+	}
+	header := http.Header{"If-Match": {etag}}
+	respHeader, err := cf.query("UpdateDistribution", "PUT", "/distribution/"+url.PathEscape(id)+"/config", nil, header, body, &summary)
+	if err != nil {
+		return DistributionSummary{}, "", err
+	}
+	return summary, respHeader.Get("ETag"), nil
+}
+
+// ExportedDistributionConfig is the config of a distribution as exported
+// by GetDistributionConfigAsJSON and GetDistributionConfigAsYAML, along
+// with the ETag it had, so that applying it back fails if the config
+// changed since the export.
+type ExportedDistributionConfig struct {
+	ETag               string
+	DistributionConfig DistributionConfig
+}
+
+// ExportDistributionConfig returns the config of a distribution and its
+// ETag, to be exported.
+//
+// The config is refused if it holds elements, nested in known ones, that
+// this package has no field for: they would be lost when applying it back.
+// The unknown elements at the top level are kept in its Unknown field.
+func (cf *CloudFront) ExportDistributionConfig(id string) (*ExportedDistributionConfig, error) {
+	var raw rawDistributionConfig
+	header, err := cf.query("GetDistributionConfig", "GET", "/distribution/"+url.PathEscape(id)+"/config", nil, nil, nil, &raw)
+	if err != nil {
+		return nil, err
+	}
+	dropped, err := droppedElements(raw.data, raw.config)
+	if err != nil {
+		return nil, err
+	}
+	if len(dropped) > 0 {
+		return nil, fmt.Errorf("cloudfront: distribution %s has settings that would be lost on export: %s", id, strings.Join(dropped, ", "))
+	}
+	return &ExportedDistributionConfig{header.Get("ETag"), raw.config}, nil
+}
+
+// GetDistributionConfigAsJSON returns the config of a distribution and its
+// ETag as indented JSON, to be stored in version control and applied back
+// with UpdateDistributionFromJSON. See ExportDistributionConfig.
+func (cf *CloudFront) GetDistributionConfigAsJSON(id string) ([]byte, error) {
+	exported, err := cf.ExportDistributionConfig(id)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(exported, "", "  ")
+}
+
+// GetDistributionConfigAsYAML is GetDistributionConfigAsJSON, exporting
+// YAML, with the same field names, as encoded by the cloudfront/yaml
+// package.
+func (cf *CloudFront) GetDistributionConfigAsYAML(id string) ([]byte, error) {
+	exported, err := cf.ExportDistributionConfig(id)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(exported)
+}
+
+// UpdateDistributionFromJSON replaces the config of a distribution with a
+// config exported by GetDistributionConfigAsJSON, possibly edited. The
+// update fails with a PreconditionFailed error if the config changed since
+// the export.
+func (cf *CloudFront) UpdateDistributionFromJSON(id string, data []byte) (DistributionSummary, error) {
+	var exported ExportedDistributionConfig
+	if err := json.Unmarshal(data, &exported); err != nil {
+		return DistributionSummary{}, err
+	}
+	return cf.updateDistributionFromExport(id, &exported)
+}
+
+// UpdateDistributionFromYAML is UpdateDistributionFromJSON, for a config
+// exported by GetDistributionConfigAsYAML.
+func (cf *CloudFront) UpdateDistributionFromYAML(id string, data []byte) (DistributionSummary, error) {
+	var exported ExportedDistributionConfig
+	if err := yaml.Unmarshal(data, &exported); err != nil {
+		return DistributionSummary{}, err
+	}
+	return cf.updateDistributionFromExport(id, &exported)
+}
+
+func (cf *CloudFront) updateDistributionFromExport(id string, exported *ExportedDistributionConfig) (DistributionSummary, error) {
+	if exported.ETag == "" {
+		return DistributionSummary{}, errors.New("cloudfront: exported config has no ETag")
+	}
+	summary, _, err := cf.UpdateDistribution(id, exported.DistributionConfig, exported.ETag)
+	return summary, err
+}
+
+// rawDistributionConfig decodes a DistributionConfig, keeping its XML.
+type rawDistributionConfig struct {
+	config DistributionConfig
+	data   []byte
+}
+
+func (r *rawDistributionConfig) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var inner struct {
+		XML []byte `xml:",innerxml"`
+	}
+	if err := d.DecodeElement(&inner, &start); err != nil {
+		return err
+	}
+	r.data = append(append([]byte("<DistributionConfig>"), inner.XML...), "</DistributionConfig>"...)
+	return xml.Unmarshal(r.data, &r.config)
+}
+
+// droppedElements returns the paths of the elements of data, the XML of
+// config, that encoding config leaves out. Elements without content are
+// ignored, as no setting is lost with them.
+func droppedElements(data []byte, config DistributionConfig) ([]string, error) {
+	encoded, err := xml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	kept, err := elementPaths(encoded)
+	if err != nil {
+		return nil, err
+	}
+	found, err := elementPaths(data)
+	if err != nil {
+		return nil, err
+	}
+	var dropped []string
+	for path := range found {
+		if !kept[path] {
+			dropped = append(dropped, path)
+		}
+	}
+	sort.Strings(dropped)
+	return dropped, nil
+}
+
+// elementPaths returns the paths, such as "DistributionConfig>Comment", of
+// the elements of data that hold text or other elements.
+func elementPaths(data []byte) (map[string]bool, error) {
+	paths := make(map[string]bool)
+	var names []string
+	var content []bool
+	d := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return paths, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			names = append(names, tok.Name.Local)
+			content = append(content, false)
+		case xml.CharData:
+			if len(content) > 0 && len(bytes.TrimSpace(tok)) > 0 {
+				content[len(content)-1] = true
+			}
+		case xml.EndElement:
+			n := len(content) - 1
+			if content[n] {
+				paths[strings.Join(names, ">")] = true
+				if n > 0 {
+					content[n-1] = true
+				}
+			}
+			names = names[:n]
+			content = content[:n]
+		}
+	}
+}
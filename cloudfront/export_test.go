@@ -0,0 +1,200 @@
+package cloudfront
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+const getDistributionConfigResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<DistributionConfig xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <CallerReference>20120229090000</CallerReference>
+  <Aliases><Quantity>1</Quantity><Items><CNAME>cdn.example.com</CNAME></Items></Aliases>
+  <DefaultRootObject>index.html</DefaultRootObject>
+  <Origins>
+    <Quantity>1</Quantity>
+    <Items>
+      <Origin>
+        <Id>app</Id>
+        <DomainName>app.example.com</DomainName>
+        <CustomOriginConfig>
+          <HTTPPort>80</HTTPPort>
+          <HTTPSPort>443</HTTPSPort>
+          <OriginProtocolPolicy>https-only</OriginProtocolPolicy>
+        </CustomOriginConfig>
+      </Origin>
+    </Items>
+  </Origins>
+  <OriginGroups><Quantity>0</Quantity></OriginGroups>
+  <DefaultCacheBehavior>
+    <TargetOriginId>app</TargetOriginId>
+    <TrustedSigners><Enabled>false</Enabled><Quantity>0</Quantity></TrustedSigners>
+    <ViewerProtocolPolicy>redirect-to-https</ViewerProtocolPolicy>
+    <ForwardedValues>
+      <QueryString>true</QueryString>
+      <Cookies><Forward>all</Forward></Cookies>
+    </ForwardedValues>
+    <MinTTL>0</MinTTL>
+    <DefaultTTL>0</DefaultTTL>
+    <MaxTTL>31536000</MaxTTL>
+  </DefaultCacheBehavior>
+  <Comment>app</Comment>
+  <Logging><Enabled>false</Enabled><IncludeCookies>false</IncludeCookies><Bucket></Bucket><Prefix></Prefix></Logging>
+  <PriceClass>PriceClass_100</PriceClass>
+  <Enabled>true</Enabled>
+  <HttpVersion>http2</HttpVersion>
+  <Staging>false</Staging>
+</DistributionConfig>`
+
+// distributionConfigServer serves config as the config of EDFDVBD6EXAMPLE,
+// with the ETag E2QWRUHEXAMPLE, and records the body of the updates.
+func distributionConfigServer(t *testing.T, config string, update *string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/2020-05-31/distribution/EDFDVBD6EXAMPLE/config" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case "GET":
+			w.Header().Set("ETag", "E2QWRUHEXAMPLE")
+			w.Write([]byte(config))
+		case "PUT":
+			if r.Header.Get("If-Match") != "E2QWRUHEXAMPLE" {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>PreconditionFailed</Code><Message>The request failed because it didn't meet the preconditions</Message></Error></ErrorResponse>`))
+				return
+			}
+			body, _ := ioutil.ReadAll(r.Body)
+			*update = string(body)
+			w.Header().Set("ETag", "E3QWRUHEXAMPLE")
+			w.Write([]byte(createDistributionResponse))
+		}
+	}))
+}
+
+func checkDistributionConfigUpdate(t *testing.T, update string) {
+	for _, s := range []string{
+		"<Comment>edited</Comment>",
+		"<CNAME>cdn.example.com</CNAME>",
+		"</Origins><OriginGroups><Quantity>0</Quantity></OriginGroups><DefaultCacheBehavior>",
+		"<MinTTL>0</MinTTL><DefaultTTL>0</DefaultTTL><MaxTTL>31536000</MaxTTL>",
+		"<HttpVersion>http2</HttpVersion><Staging>false</Staging></DistributionConfig>",
+	} {
+		if !strings.Contains(update, s) {
+			t.Errorf("expected %s in update: %s", s, update)
+		}
+	}
+}
+
+func TestDistributionConfigJSON(t *testing.T) {
+	var update string
+	srv := distributionConfigServer(t, getDistributionConfigResponse, &update)
+	defer srv.Close()
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+
+	data, err := cf.GetDistributionConfigAsJSON("EDFDVBD6EXAMPLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var exported struct {
+		ETag               string
+		DistributionConfig map[string]interface{}
+	}
+	if err := json.Unmarshal(data, &exported); err != nil {
+		t.Fatal(err)
+	}
+	config := exported.DistributionConfig
+	if exported.ETag != "E2QWRUHEXAMPLE" || config["PriceClass"] != "PriceClass_100" || config["Comment"] != "app" {
+		t.Errorf("unexpected export: %s", data)
+	}
+	if _, ok := config["XMLName"]; ok {
+		t.Errorf("unexpected XMLName in export: %s", data)
+	}
+
+	edited := strings.Replace(string(data), `"Comment": "app"`, `"Comment": "edited"`, 1)
+	summary, err := cf.UpdateDistributionFromJSON("EDFDVBD6EXAMPLE", []byte(edited))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Id != "EDFDVBD6EXAMPLE" {
+		t.Errorf("unexpected summary: %#v", summary)
+	}
+	checkDistributionConfigUpdate(t, update)
+}
+
+func TestDistributionConfigYAML(t *testing.T) {
+	var update string
+	srv := distributionConfigServer(t, getDistributionConfigResponse, &update)
+	defer srv.Close()
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+
+	data, err := cf.GetDistributionConfigAsYAML("EDFDVBD6EXAMPLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"ETag: \"E2QWRUHEXAMPLE\"\n",
+		"DistributionConfig:\n  CallerReference: \"20120229090000\"\n  Aliases:\n    - \"cdn.example.com\"\n",
+		"  Comment: \"app\"\n",
+		"  OriginGroups:\n    Name: \"OriginGroups\"\n    InnerXML: \"<Quantity>0</Quantity>\"\n",
+		"    - Name: \"Staging\"\n      InnerXML: \"false\"\n",
+	} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("expected %q in export:\n%s", s, data)
+		}
+	}
+
+	edited := strings.Replace(string(data), `Comment: "app"`, "Comment: edited # was app", 1)
+	if _, err := cf.UpdateDistributionFromYAML("EDFDVBD6EXAMPLE", []byte(edited)); err != nil {
+		t.Fatal(err)
+	}
+	checkDistributionConfigUpdate(t, update)
+}
+
+func TestUpdateDistributionFromJSONChanged(t *testing.T) {
+	var update string
+	srv := distributionConfigServer(t, getDistributionConfigResponse, &update)
+	defer srv.Close()
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL), WithRetry(aws.NeverRetryPolicy{}))
+
+	data, err := cf.GetDistributionConfigAsJSON("EDFDVBD6EXAMPLE")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale := strings.Replace(string(data), "E2QWRUHEXAMPLE", "E1QWRUHEXAMPLE", 1)
+	_, err = cf.UpdateDistributionFromJSON("EDFDVBD6EXAMPLE", []byte(stale))
+	var apiErr aws.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code() != "PreconditionFailed" {
+		t.Errorf("expected a PreconditionFailed error, got %v", err)
+	}
+
+	var exported map[string]interface{}
+	json.Unmarshal(data, &exported)
+	delete(exported, "ETag")
+	data, _ = json.Marshal(exported)
+	_, err = cf.UpdateDistributionFromJSON("EDFDVBD6EXAMPLE", data)
+	if err == nil || err.Error() != "cloudfront: exported config has no ETag" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if update != "" {
+		t.Errorf("unexpected update: %s", update)
+	}
+}
+
+func TestExportDistributionConfigNestedUnknown(t *testing.T) {
+	config := strings.Replace(getDistributionConfigResponse, "<MinTTL>0</MinTTL>", "<MinTTL>0</MinTTL><Compress>true</Compress><FieldLevelEncryptionId></FieldLevelEncryptionId>", 1)
+	srv := distributionConfigServer(t, config, nil)
+	defer srv.Close()
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+
+	_, err := cf.GetDistributionConfigAsJSON("EDFDVBD6EXAMPLE")
+	if err == nil || err.Error() != "cloudfront: distribution EDFDVBD6EXAMPLE has settings that would be lost on export: DistributionConfig>DefaultCacheBehavior>Compress" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
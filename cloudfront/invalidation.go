@@ -0,0 +1,177 @@
+package cloudfront
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Limits of CloudFront on the invalidations of a distribution.
+const (
+	MaxInvalidationPaths       = 3000
+	MaxInvalidationsInProgress = 15
+)
+
+// Statuses of an invalidation.
+const (
+	InvalidationStatusInProgress = "InProgress"
+	InvalidationStatusCompleted  = "Completed"
+)
+
+// invalidationPollInterval is the delay between checks of the
+// invalidations in progress, when InvalidatePaths has to wait for one.
+var invalidationPollInterval = 20 * time.Second
+
+// maxInvalidationRefusals is how many times in a row InvalidatePaths
+// retries a batch refused with TooManyInvalidationsInProgress, about 15
+// minutes at invalidationPollInterval.
+var maxInvalidationRefusals = 45
+
+type Paths []string
+
+type EncodedPaths struct {
+	Quantity int
+	Items    []string `xml:"Items>Path"`
+}
+
+func (p Paths) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	enc := EncodedPaths{
+		Quantity: len(p),
+		Items:    []string(p),
+	}
+
+	return e.EncodeElement(enc, start)
+}
+
+func (p *Paths) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	enc := EncodedPaths{}
+	err := d.DecodeElement(&enc, &start)
+	if err != nil {
+		return err
+	}
+
+	*p = Paths(enc.Items)
+	return nil
+}
+
+type InvalidationBatch struct {
+	XMLName         xml.Name `xml:"InvalidationBatch"`
+	Paths           Paths
+	CallerReference string
+}
+
+type Invalidation struct {
+	XMLName           xml.Name `xml:"Invalidation"`
+	Id                string
+	Status            string
+	CreateTime        time.Time
+	InvalidationBatch InvalidationBatch
+}
+
+// CreateInvalidation removes objects from the edge caches of a
+// distribution, given their paths, which may end with a "*" wildcard.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/CreateInvalidation.html
+func (cf *CloudFront) CreateInvalidation(distributionId string, paths []string) (*Invalidation, error) {
+	body, err := xml.Marshal(InvalidationBatch{
+		Paths:           Paths(paths),
+		CallerReference: strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	if err != nil {
+		return nil, err
+	}
+	inv := &Invalidation{}
+	_, err = cf.query("CreateInvalidation", "POST", "/distribution/"+url.PathEscape(distributionId)+"/invalidation", nil, nil, body, inv)
+	if err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// GetInvalidation describes an invalidation of a distribution.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/GetInvalidation.html
+func (cf *CloudFront) GetInvalidation(distributionId, id string) (*Invalidation, error) {
+	inv := &Invalidation{}
+	path := "/distribution/" + url.PathEscape(distributionId) + "/invalidation/" + url.PathEscape(id)
+	if _, err := cf.query("GetInvalidation", "GET", path, nil, nil, nil, inv); err != nil {
+		return nil, err
+	}
+	return inv, nil
+}
+
+// InvalidatePaths invalidates any number of paths of a distribution, in
+// batches of up to MaxInvalidationPaths paths, and returns the IDs of the
+// invalidations created.
+//
+// No more than MaxInvalidationsInProgress batches are in progress at once:
+// InvalidatePaths waits for the earlier ones to complete before creating
+// more, and retries the batches refused with
+// TooManyInvalidationsInProgress, which happens when other invalidations
+// of the distribution are in progress. It gives up with that error once a
+// batch has been refused for about 15 minutes, and with ctx's error when
+// ctx is done. On error, the IDs of the invalidations already created are
+// returned with it.
+func (cf *CloudFront) InvalidatePaths(ctx context.Context, distributionId string, paths []string) ([]string, error) {
+	var ids, inProgress []string
+	refusals := 0
+	for len(paths) > 0 {
+		if len(inProgress) >= MaxInvalidationsInProgress {
+			var err error
+			if inProgress, err = cf.waitForInvalidations(ctx, distributionId, inProgress); err != nil {
+				return ids, err
+			}
+			continue
+		}
+
+		n := len(paths)
+		if n > MaxInvalidationPaths {
+			n = MaxInvalidationPaths
+		}
+		inv, err := cf.CreateInvalidation(distributionId, paths[:n])
+		var apiErr aws.APIError
+		if errors.As(err, &apiErr) && apiErr.Code() == "TooManyInvalidationsInProgress" {
+			if refusals++; refusals > maxInvalidationRefusals {
+				return ids, err
+			}
+			if inProgress, err = cf.waitForInvalidations(ctx, distributionId, inProgress); err != nil {
+				return ids, err
+			}
+			continue
+		}
+		if err != nil {
+			return ids, err
+		}
+		refusals = 0
+		ids = append(ids, inv.Id)
+		inProgress = append(inProgress, inv.Id)
+		paths = paths[n:]
+	}
+	return ids, nil
+}
+
+// waitForInvalidations waits for invalidationPollInterval, and returns the
+// invalidations of ids still in progress.
+func (cf *CloudFront) waitForInvalidations(ctx context.Context, distributionId string, ids []string) ([]string, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(invalidationPollInterval):
+	}
+	var inProgress []string
+	for _, id := range ids {
+		inv, err := cf.GetInvalidation(distributionId, id)
+		if err != nil {
+			return nil, err
+		}
+		if inv.Status != InvalidationStatusCompleted {
+			inProgress = append(inProgress, id)
+		}
+	}
+	return inProgress, nil
+}
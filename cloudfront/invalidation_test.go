@@ -0,0 +1,143 @@
+package cloudfront
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+const tooManyInvalidationsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ErrorResponse xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>TooManyInvalidationsInProgress</Code>
+    <Message>Processing your request will cause you to exceed the maximum number of in-progress wildcard invalidations.</Message>
+  </Error>
+  <RequestId>b0be6d8a-4d2d-11e4-a2f6-5f1a5EXAMPLE</RequestId>
+</ErrorResponse>`
+
+func TestInvalidatePaths(t *testing.T) {
+	defer func(d time.Duration) { invalidationPollInterval = d }(invalidationPollInterval)
+	invalidationPollInterval = 0
+
+	var batchSizes []int
+	completed := map[string]bool{}
+	refused := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/2020-05-31/distribution/EDFDVBD6EXAMPLE/invalidation") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "GET" {
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			status := InvalidationStatusInProgress
+			if completed[id] {
+				status = InvalidationStatusCompleted
+			}
+			fmt.Fprintf(w, "<Invalidation><Id>%s</Id><Status>%s</Status></Invalidation>", id, status)
+			return
+		}
+		if !refused {
+			refused = true
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(tooManyInvalidationsResponse))
+			return
+		}
+		// Complete the first invalidation once 15 are in progress, and the
+		// others once the next batch waits for them.
+		switch len(batchSizes) {
+		case MaxInvalidationsInProgress:
+			if !completed["I1"] {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		case MaxInvalidationsInProgress + 1:
+			for i := 2; i <= len(batchSizes); i++ {
+				if !completed[fmt.Sprintf("I%d", i)] {
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+			}
+		}
+		data, _ := ioutil.ReadAll(r.Body)
+		var batch InvalidationBatch
+		if err := xml.Unmarshal(data, &batch); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		batchSizes = append(batchSizes, len(batch.Paths))
+		id := fmt.Sprintf("I%d", len(batchSizes))
+		if len(batchSizes) == MaxInvalidationsInProgress {
+			completed["I1"] = true
+		}
+		if len(batchSizes) == MaxInvalidationsInProgress+1 {
+			for i := 2; i <= len(batchSizes); i++ {
+				completed[fmt.Sprintf("I%d", i)] = true
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, "<Invalidation><Id>%s</Id><Status>InProgress</Status></Invalidation>", id)
+	}))
+	defer srv.Close()
+
+	paths := make([]string, (MaxInvalidationsInProgress+1)*MaxInvalidationPaths+1)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("/images/%d.png", i)
+	}
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+	ids, err := cf.InvalidatePaths(context.Background(), "EDFDVBD6EXAMPLE", paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != MaxInvalidationsInProgress+2 || ids[0] != "I1" || ids[len(ids)-1] != "I17" {
+		t.Errorf("unexpected ids: %v", ids)
+	}
+	if len(batchSizes) != len(ids) || batchSizes[0] != MaxInvalidationPaths || batchSizes[len(batchSizes)-1] != 1 {
+		t.Errorf("unexpected batch sizes: %v", batchSizes)
+	}
+}
+
+func TestInvalidatePathsGivesUp(t *testing.T) {
+	defer func(d time.Duration, n int) {
+		invalidationPollInterval, maxInvalidationRefusals = d, n
+	}(invalidationPollInterval, maxInvalidationRefusals)
+	invalidationPollInterval, maxInvalidationRefusals = 0, 3
+
+	// The invalidations in progress were created by someone else, so
+	// InvalidatePaths has none of its own to wait for.
+	refusals := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refusals++
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(tooManyInvalidationsResponse))
+	}))
+	defer srv.Close()
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+	ids, err := cf.InvalidatePaths(context.Background(), "EDFDVBD6EXAMPLE", []string{"/index.html"})
+	var apiErr aws.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code() != "TooManyInvalidationsInProgress" {
+		t.Fatalf("expected TooManyInvalidationsInProgress, got %v", err)
+	}
+	if len(ids) != 0 || refusals != 4 {
+		t.Errorf("got ids %v after %d requests, expected none after 4", ids, refusals)
+	}
+
+	// A done context stops the wait.
+	invalidationPollInterval, maxInvalidationRefusals = time.Hour, 45
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cf.InvalidatePaths(ctx, "EDFDVBD6EXAMPLE", []string{"/index.html"}); err != context.Canceled {
+		t.Errorf("expected %v, got %v", context.Canceled, err)
+	}
+}
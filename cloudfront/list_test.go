@@ -0,0 +1,89 @@
+package cloudfront
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+const distributionListPage = `<?xml version="1.0" encoding="UTF-8"?>
+<DistributionList xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Marker>%s</Marker>
+  <NextMarker>%s</NextMarker>
+  <MaxItems>100</MaxItems>
+  <IsTruncated>%v</IsTruncated>
+  <Quantity>1</Quantity>
+  <Items>
+    <DistributionSummary>
+      <Id>%s</Id>
+      <Status>Deployed</Status>
+      <DomainName>d111111abcdef8.cloudfront.net</DomainName>
+      <Origins>
+        <Quantity>1</Quantity>
+        <Items>
+          <Origin>
+            <Id>origin</Id>
+            <DomainName>%s</DomainName>
+          </Origin>
+        </Items>
+      </Origins>
+    </DistributionSummary>
+  </Items>
+</DistributionList>`
+
+func TestListDistributionsByWebACLId(t *testing.T) {
+	var request *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		fmt.Fprintf(w, distributionListPage, "", "", false, "EDFDVBD6EXAMPLE", "app.example.com")
+	}))
+	defer srv.Close()
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+	webACLId := "arn:aws:wafv2:us-east-1:123456789012:global/webacl/site/a1b2c3d4"
+	resp, err := cf.ListDistributionsByWebACLId(webACLId, "", 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if request.URL.EscapedPath() != "/2020-05-31/distributionsByWebACLId/arn:aws:wafv2:us-east-1:123456789012:global%2Fwebacl%2Fsite%2Fa1b2c3d4" {
+		t.Errorf("unexpected path: %s", request.URL.EscapedPath())
+	}
+	if request.URL.Query().Get("MaxItems") != "50" {
+		t.Errorf("unexpected query: %s", request.URL.RawQuery)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].Id != "EDFDVBD6EXAMPLE" {
+		t.Errorf("unexpected response: %#v", resp)
+	}
+}
+
+func TestListDistributionsByOrigin(t *testing.T) {
+	var markers []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		marker := r.URL.Query().Get("Marker")
+		markers = append(markers, marker)
+		switch marker {
+		case "":
+			fmt.Fprintf(w, distributionListPage, "", "EDFDVBD6EXAMPLE", true, "EDFDVBD6EXAMPLE", "assets.s3.amazonaws.com")
+		case "EDFDVBD6EXAMPLE":
+			fmt.Fprintf(w, distributionListPage, marker, "E2QWRUHEXAMPLE", true, "E2QWRUHEXAMPLE", "other.example.com")
+		default:
+			fmt.Fprintf(w, distributionListPage, marker, "", false, "E1GNUMQEXAMPLE", "Assets.S3.amazonaws.com")
+		}
+	}))
+	defer srv.Close()
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+	dists, err := cf.ListDistributionsByOrigin("assets.s3.amazonaws.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(markers) != 3 {
+		t.Errorf("expected 3 pages, got %v", markers)
+	}
+	if len(dists) != 2 || dists[0].Id != "EDFDVBD6EXAMPLE" || dists[1].Id != "E1GNUMQEXAMPLE" {
+		t.Errorf("unexpected distributions: %#v", dists)
+	}
+}
@@ -0,0 +1,338 @@
+// Package logs reads the standard access logs that CloudFront delivers to
+// an S3 bucket.
+//
+// CloudFront writes the log files of a distribution, gzip compressed,
+// under the prefix set in its Logging config, with names such as
+// "EMLARXS9EXAMPLE.2019-11-14-20.RT4KCN4SGK9.gz". Every line of a file is a
+// request, with the tab-separated fields listed by its "#Fields" header.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html
+package logs
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/s3"
+)
+
+// Record is a request in an access log. Fields logged as "-" are left
+// empty, or -1 for the numbers of a missing range.
+type Record struct {
+	Time                   time.Time
+	EdgeLocation           string
+	BytesSent              int64
+	ClientIP               string
+	Method                 string
+	Host                   string // Domain name of the distribution
+	URIStem                string
+	Status                 int
+	Referer                string
+	UserAgent              string
+	QueryString            string
+	Cookie                 string
+	EdgeResultType         string
+	RequestId              string
+	HostHeader             string
+	Protocol               string
+	BytesReceived          int64
+	TimeTaken              float64 // In seconds
+	ForwardedFor           string
+	SSLProtocol            string
+	SSLCipher              string
+	EdgeResponseResultType string
+	ProtocolVersion        string
+	FLEStatus              string
+	FLEEncryptedFields     string
+	ClientPort             int
+	TimeToFirstByte        float64 // In seconds
+	EdgeDetailedResultType string
+	ContentType            string
+	ContentLength          int64
+	RangeStart             int64
+	RangeEnd               int64
+}
+
+// defaultFields are the fields of the logs, used for files without a
+// "#Fields" header.
+var defaultFields = strings.Fields("date time x-edge-location sc-bytes c-ip cs-method cs(Host) " +
+	"cs-uri-stem sc-status cs(Referer) cs(User-Agent) cs-uri-query cs(Cookie) x-edge-result-type " +
+	"x-edge-request-id x-host-header cs-protocol cs-bytes time-taken x-forwarded-for ssl-protocol " +
+	"ssl-cipher x-edge-response-result-type cs-protocol-version fle-status fle-encrypted-fields " +
+	"c-port time-to-first-byte x-edge-detailed-result-type sc-content-type sc-content-len " +
+	"sc-range-start sc-range-end")
+
+// Reader reads the records of a decompressed log file, in the manner of a
+// bufio.Scanner:
+//
+//	r := logs.NewReader(f)
+//	for r.Next() {
+//		rec := r.Record()
+//		...
+//	}
+//	if err := r.Err(); err != nil {
+//		...
+//	}
+type Reader struct {
+	scanner *bufio.Scanner
+	fields  []string
+	line    int
+	record  Record
+	err     error
+}
+
+// NewReader returns a Reader reading the log file from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{scanner: bufio.NewScanner(r), fields: defaultFields}
+}
+
+// Next reads the next record, which is then returned by Record. It returns
+// false at the end of the file or on error.
+func (r *Reader) Next() bool {
+	if r.err != nil {
+		return false
+	}
+	for r.scanner.Scan() {
+		r.line++
+		line := r.scanner.Text()
+		if strings.HasPrefix(line, "#Fields:") {
+			r.fields = strings.Fields(strings.TrimPrefix(line, "#Fields:"))
+			continue
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if r.err = r.parse(line); r.err != nil {
+			r.err = fmt.Errorf("logs: line %d: %w", r.line, r.err)
+			return false
+		}
+		return true
+	}
+	r.err = r.scanner.Err()
+	return false
+}
+
+// Record returns the record read by the last call to Next. It is
+// overwritten by the next call.
+func (r *Reader) Record() *Record {
+	return &r.record
+}
+
+// Err returns the error met by Next, if any.
+func (r *Reader) Err() error {
+	return r.err
+}
+
+func (r *Reader) parse(line string) error {
+	values := strings.Split(line, "\t")
+	if len(values) != len(r.fields) {
+		return fmt.Errorf("%d fields, expected %d", len(values), len(r.fields))
+	}
+	rec := Record{RangeStart: -1, RangeEnd: -1}
+	var date, clock string
+	var err error
+	for i, name := range r.fields {
+		v := values[i]
+		if v == "-" {
+			continue
+		}
+		switch name {
+		case "date":
+			date = v
+		case "time":
+			clock = v
+		case "x-edge-location":
+			rec.EdgeLocation = v
+		case "sc-bytes":
+			rec.BytesSent, err = strconv.ParseInt(v, 10, 64)
+		case "c-ip":
+			rec.ClientIP = v
+		case "cs-method":
+			rec.Method = v
+		case "cs(Host)":
+			rec.Host = v
+		case "cs-uri-stem":
+			rec.URIStem = v
+		case "sc-status":
+			rec.Status, err = strconv.Atoi(v)
+		case "cs(Referer)":
+			rec.Referer = unescape(v)
+		case "cs(User-Agent)":
+			rec.UserAgent = unescape(v)
+		case "cs-uri-query":
+			rec.QueryString = v
+		case "cs(Cookie)":
+			rec.Cookie = unescape(v)
+		case "x-edge-result-type":
+			rec.EdgeResultType = v
+		case "x-edge-request-id":
+			rec.RequestId = v
+		case "x-host-header":
+			rec.HostHeader = v
+		case "cs-protocol":
+			rec.Protocol = v
+		case "cs-bytes":
+			rec.BytesReceived, err = strconv.ParseInt(v, 10, 64)
+		case "time-taken":
+			rec.TimeTaken, err = strconv.ParseFloat(v, 64)
+		case "x-forwarded-for":
+			rec.ForwardedFor = v
+		case "ssl-protocol":
+			rec.SSLProtocol = v
+		case "ssl-cipher":
+			rec.SSLCipher = v
+		case "x-edge-response-result-type":
+			rec.EdgeResponseResultType = v
+		case "cs-protocol-version":
+			rec.ProtocolVersion = v
+		case "fle-status":
+			rec.FLEStatus = v
+		case "fle-encrypted-fields":
+			rec.FLEEncryptedFields = v
+		case "c-port":
+			rec.ClientPort, err = strconv.Atoi(v)
+		case "time-to-first-byte":
+			rec.TimeToFirstByte, err = strconv.ParseFloat(v, 64)
+		case "x-edge-detailed-result-type":
+			rec.EdgeDetailedResultType = v
+		case "sc-content-type":
+			rec.ContentType = v
+		case "sc-content-len":
+			rec.ContentLength, err = strconv.ParseInt(v, 10, 64)
+		case "sc-range-start":
+			rec.RangeStart, err = strconv.ParseInt(v, 10, 64)
+		case "sc-range-end":
+			rec.RangeEnd, err = strconv.ParseInt(v, 10, 64)
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", name, err)
+		}
+	}
+	if rec.Time, err = time.Parse("2006-01-02 15:04:05", date+" "+clock); err != nil {
+		return err
+	}
+	r.record = rec
+	return nil
+}
+
+// unescape decodes the fields CloudFront URL-encodes, leaving them as is
+// if they are not valid.
+func unescape(v string) string {
+	if s, err := url.PathUnescape(v); err == nil {
+		return s
+	}
+	return v
+}
+
+// ListLogFiles returns the log files in b whose names start with prefix,
+// such as the prefix of the Logging config of a distribution followed by
+// the ID of the distribution and a date: "cdn/EMLARXS9EXAMPLE.2019-11-14-".
+func ListLogFiles(b *s3.Bucket, prefix string) ([]s3.Key, error) {
+	var keys []s3.Key
+	marker := ""
+	for {
+		resp, err := b.List(prefix, "", marker, 1000)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range resp.Contents {
+			if strings.HasSuffix(key.Key, ".gz") {
+				keys = append(keys, key)
+			}
+		}
+		if !resp.IsTruncated || len(resp.Contents) == 0 {
+			return keys, nil
+		}
+		marker = resp.NextMarker
+		if marker == "" {
+			marker = resp.Contents[len(resp.Contents)-1].Key
+		}
+	}
+}
+
+// Iterator reads the records of several log files of a bucket, one file
+// after the other, downloading each when its first record is read. It is
+// used as a Reader, and must be closed.
+type Iterator struct {
+	bucket *s3.Bucket
+	keys   []string
+	body   io.ReadCloser
+	reader *Reader
+	err    error
+}
+
+// NewIterator returns an Iterator over the records of the log files of b
+// at keys, such as those returned by ListLogFiles.
+func NewIterator(b *s3.Bucket, keys []string) *Iterator {
+	return &Iterator{bucket: b, keys: keys}
+}
+
+// Next reads the next record, which is then returned by Record. It returns
+// false once all the files are read, or on error.
+func (it *Iterator) Next() bool {
+	for it.err == nil {
+		if it.reader != nil && it.reader.Next() {
+			return true
+		}
+		if it.reader != nil {
+			if err := it.reader.Err(); err != nil {
+				it.err = fmt.Errorf("%s: %w", it.keys[0], err)
+				return false
+			}
+			it.body.Close()
+			it.reader, it.body = nil, nil
+			it.keys = it.keys[1:]
+		}
+		if len(it.keys) == 0 {
+			return false
+		}
+		it.err = it.open(it.keys[0])
+	}
+	return false
+}
+
+func (it *Iterator) open(key string) error {
+	body, err := it.bucket.GetReader(key)
+	if err != nil {
+		return err
+	}
+	zr, err := gzip.NewReader(body)
+	if err != nil {
+		body.Close()
+		return fmt.Errorf("%s: %w", key, err)
+	}
+	it.body = body
+	it.reader = NewReader(zr)
+	return nil
+}
+
+// Record returns the record read by the last call to Next.
+func (it *Iterator) Record() *Record {
+	return it.reader.Record()
+}
+
+// Key returns the key of the log file of the current record.
+func (it *Iterator) Key() string {
+	return it.keys[0]
+}
+
+// Err returns the error met by Next, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close closes the log file being read.
+func (it *Iterator) Close() error {
+	if it.body == nil {
+		return nil
+	}
+	err := it.body.Close()
+	it.reader, it.body = nil, nil
+	return err
+}
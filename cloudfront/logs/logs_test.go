@@ -0,0 +1,129 @@
+package logs_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/cloudfront/logs"
+	"github.com/zackbloom/goamz/s3"
+)
+
+// http://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/AccessLogs.html
+const logFile = `#Version: 1.0
+#Fields: date time x-edge-location sc-bytes c-ip cs-method cs(Host) cs-uri-stem sc-status cs(Referer) cs(User-Agent) cs-uri-query cs(Cookie) x-edge-result-type x-edge-request-id x-host-header cs-protocol cs-bytes time-taken x-forwarded-for ssl-protocol ssl-cipher x-edge-response-result-type cs-protocol-version fle-status fle-encrypted-fields c-port time-to-first-byte x-edge-detailed-result-type sc-content-type sc-content-len sc-range-start sc-range-end
+2019-12-04	21:02:31	LAX1	392	192.0.2.100	GET	d111111abcdef8.cloudfront.net	/index.html	200	-	Mozilla/5.0%20(Windows%20NT%2010.0;%20Win64;%20x64)	-	-	Hit	SOX4xwn4XV6Q4rgb7XiVGOHms_BGlTAC4KyHmureZmBNrjGdRLiNIQ==	d111111abcdef8.cloudfront.net	https	23	0.001	-	TLSv1.2	ECDHE-RSA-AES128-GCM-SHA256	Hit	HTTP/2.0	-	-	11040	0.001	Hit	text/html	78	-	-
+2019-12-04	21:02:31	LAX1	172	192.0.2.100	GET	d111111abcdef8.cloudfront.net	/favicon.ico	206	https://www.example.com/	curl/7.64.1	size=large	-	Error	2Ws_PBmXGwpgjPNhL5YgmAvVpJFL5pQGLRwBfyVfqmYazs-kyXNvHg==	www.example.com	https	58	0.002	-	TLSv1.2	ECDHE-RSA-AES128-GCM-SHA256	Error	HTTP/2.0	-	-	11040	0.002	Error	text/html	3	0	2
+`
+
+func TestReader(t *testing.T) {
+	r := logs.NewReader(strings.NewReader(logFile))
+	var records []logs.Record
+	for r.Next() {
+		records = append(records, *r.Record())
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+
+	rec := records[0]
+	if !rec.Time.Equal(time.Date(2019, 12, 4, 21, 2, 31, 0, time.UTC)) {
+		t.Errorf("unexpected time: %v", rec.Time)
+	}
+	if rec.EdgeLocation != "LAX1" || rec.BytesSent != 392 || rec.Status != 200 || rec.URIStem != "/index.html" {
+		t.Errorf("unexpected record: %#v", rec)
+	}
+	if rec.UserAgent != "Mozilla/5.0 (Windows NT 10.0; Win64; x64)" || rec.Referer != "" {
+		t.Errorf("unexpected user agent or referer: %q, %q", rec.UserAgent, rec.Referer)
+	}
+	if rec.TimeTaken != 0.001 || rec.ClientPort != 11040 || rec.ContentLength != 78 || rec.RangeStart != -1 || rec.RangeEnd != -1 {
+		t.Errorf("unexpected record: %#v", rec)
+	}
+
+	rec = records[1]
+	if rec.Status != 206 || rec.QueryString != "size=large" || rec.HostHeader != "www.example.com" || rec.RangeStart != 0 || rec.RangeEnd != 2 {
+		t.Errorf("unexpected record: %#v", rec)
+	}
+}
+
+func TestReaderInvalidLine(t *testing.T) {
+	r := logs.NewReader(strings.NewReader("#Fields: date time sc-status\n2019-12-04\t21:02:31\tOK\n"))
+	if r.Next() {
+		t.Fatal("expected no record")
+	}
+	if err := r.Err(); err == nil || err.Error() != `logs: line 2: invalid sc-status: strconv.Atoi: parsing "OK": invalid syntax` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func gzipped(s string) []byte {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(s))
+	zw.Close()
+	return buf.Bytes()
+}
+
+const listLogFilesResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ListBucketResult xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Name>logs</Name>
+  <Prefix>cdn/</Prefix>
+  <IsTruncated>%v</IsTruncated>
+  <Contents><Key>%s</Key><Size>10</Size></Contents>
+</ListBucketResult>`
+
+func TestListAndIterate(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/logs/":
+			if r.FormValue("marker") == "" {
+				fmt.Fprintf(w, listLogFilesResponse, true, "cdn/EMLARXS9EXAMPLE.2019-12-04-21.RT4KCN4SGK9.gz")
+			} else {
+				fmt.Fprintf(w, listLogFilesResponse, false, "cdn/EMLARXS9EXAMPLE.2019-12-04-22.T2KCN4SGK9R.gz")
+			}
+		case "/logs/cdn/EMLARXS9EXAMPLE.2019-12-04-21.RT4KCN4SGK9.gz", "/logs/cdn/EMLARXS9EXAMPLE.2019-12-04-22.T2KCN4SGK9R.gz":
+			w.Write(gzipped(logFile))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	b := s3.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{Name: "faux-region-1", S3Endpoint: srv.URL}).Bucket("logs")
+	files, err := logs.ListLogFiles(b, "cdn/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var keys []string
+	for _, f := range files {
+		keys = append(keys, f.Key)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("unexpected files: %v", keys)
+	}
+
+	it := logs.NewIterator(b, keys)
+	defer it.Close()
+	var n int
+	for it.Next() {
+		if n == 2 && it.Key() != keys[1] {
+			t.Errorf("unexpected key: %s", it.Key())
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 4 {
+		t.Errorf("expected 4 records, got %d", n)
+	}
+}
@@ -0,0 +1,113 @@
+package cloudfront
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+const throttlingResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ErrorResponse xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>b0be6d8a-4d2d-11e4-a2f6-5f1a5EXAMPLE</RequestId>
+</ErrorResponse>`
+
+// recordMetrics records the callbacks it gets as strings.
+type recordMetrics struct {
+	calls     []string
+	latency   time.Duration
+	throttled int
+}
+
+func (m *recordMetrics) RequestStart(service, operation string) {
+	m.calls = append(m.calls, "start "+service+" "+operation)
+}
+
+func (m *recordMetrics) RequestFinish(service, operation string, latency time.Duration, err error) {
+	m.latency = latency
+	m.calls = append(m.calls, fmt.Sprintf("finish %s %s %v", service, operation, err != nil))
+}
+
+func (m *recordMetrics) RequestRetry(service, operation string, retry int, err error) {
+	m.calls = append(m.calls, fmt.Sprintf("retry %s %s %d", service, operation, retry))
+}
+
+func (m *recordMetrics) RequestError(service, operation string, err error) {
+	if aws.IsThrottle(err) {
+		m.throttled++
+	}
+	m.calls = append(m.calls, "error "+service+" "+operation)
+}
+
+func TestWithMetrics(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(throttlingResponse))
+			return
+		}
+		w.Write([]byte(listDistributionsResponse))
+	}))
+	defer srv.Close()
+
+	metrics := &recordMetrics{}
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"},
+		WithEndpoint(srv.URL), WithRetry(retryTwice{}), WithMetrics(metrics))
+	if _, err := cf.List("", 100); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"start cloudfront ListDistributions",
+		"error cloudfront ListDistributions",
+		"retry cloudfront ListDistributions 1",
+		"finish cloudfront ListDistributions false",
+	}
+	if !reflect.DeepEqual(metrics.calls, expected) {
+		t.Fatalf("expected %q, got %q", expected, metrics.calls)
+	}
+	if metrics.throttled != 1 {
+		t.Fatalf("expected 1 throttled request, got %d", metrics.throttled)
+	}
+	if metrics.latency <= 0 {
+		t.Fatalf("expected a latency, got %s", metrics.latency)
+	}
+}
+
+func TestWithMetricsError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(accessDeniedResponse))
+	}))
+	defer srv.Close()
+
+	metrics := &recordMetrics{}
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"},
+		WithEndpoint(srv.URL), WithMetrics(metrics))
+	if _, err := cf.List("", 100); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	expected := []string{
+		"start cloudfront ListDistributions",
+		"error cloudfront ListDistributions",
+		"finish cloudfront ListDistributions true",
+	}
+	if !reflect.DeepEqual(metrics.calls, expected) {
+		t.Fatalf("expected %q, got %q", expected, metrics.calls)
+	}
+	if metrics.throttled != 0 {
+		t.Fatalf("expected no throttled request, got %d", metrics.throttled)
+	}
+}
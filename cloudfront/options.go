@@ -0,0 +1,85 @@
+package cloudfront
+
+import (
+	"crypto/rsa"
+	"log"
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// DefaultEndpoint is the endpoint of the CloudFront API, which is global.
+const DefaultEndpoint = "https://" + ServiceName + ".amazonaws.com"
+
+// An Option configures a CloudFront client created with
+// NewCloudFrontWithOptions.
+type Option func(*CloudFront)
+
+// WithEndpoint sends the API requests to endpoint instead of
+// DefaultEndpoint, such as the endpoint of the China regions, along with
+// WithRegion, or a test server.
+func WithEndpoint(endpoint string) Option {
+	return func(cf *CloudFront) {
+		cf.endpoint = endpoint
+	}
+}
+
+// WithHTTPClient sends the API requests with client instead of a default
+// http.Client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(cf *CloudFront) {
+		cf.httpClient = client
+	}
+}
+
+// WithRetry retries the failed API requests as set by policy, such as
+// aws.DefaultRetryPolicy{}. By default they are not retried.
+func WithRetry(policy aws.RetryPolicy) Option {
+	return func(cf *CloudFront) {
+		cf.retryPolicy = policy
+	}
+}
+
+// WithRegion signs the API requests for region instead of aws.USEast, in
+// which the global CloudFront API lives.
+func WithRegion(region aws.Region) Option {
+	return func(cf *CloudFront) {
+		cf.region = region
+	}
+}
+
+// WithLogger logs every API request, with its outcome, to logger.
+func WithLogger(logger *log.Logger) Option {
+	return func(cf *CloudFront) {
+		cf.logger = logger
+	}
+}
+
+// WithMetrics calls metrics back around every API call, e.g. to export
+// their latency and the number of retries and throttled requests.
+func WithMetrics(metrics aws.Metrics) Option {
+	return func(cf *CloudFront) {
+		cf.metrics = metrics
+	}
+}
+
+// WithSigningKey sets the key pair that CannedSignedURL signs the URLs of
+// private content with, and the BaseURL of the distribution serving it.
+func WithSigningKey(baseurl string, key *rsa.PrivateKey, keyPairId string) Option {
+	return func(cf *CloudFront) {
+		cf.BaseURL = baseurl
+		cf.key = key
+		cf.keyPairId = keyPairId
+	}
+}
+
+// NewCloudFrontWithOptions returns a CloudFront client for auth, configured
+// by opts. Without options it is the same as NewCloudFront(auth).
+func NewCloudFrontWithOptions(auth aws.Auth, opts ...Option) *CloudFront {
+	cf := &CloudFront{Auth: auth, region: aws.USEast}
+	for _, opt := range opts {
+		opt(cf)
+	}
+	cf.Signer = aws.NewV4Signer(auth, ServiceName, cf.region)
+	return cf
+}
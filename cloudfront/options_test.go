@@ -0,0 +1,136 @@
+package cloudfront
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+const listDistributionsResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<DistributionList xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Marker></Marker>
+  <MaxItems>100</MaxItems>
+  <IsTruncated>false</IsTruncated>
+  <Quantity>1</Quantity>
+  <Items>
+    <DistributionSummary>
+      <Id>EDFDVBD6EXAMPLE</Id>
+      <Status>Deployed</Status>
+      <DomainName>d111111abcdef8.cloudfront.net</DomainName>
+    </DistributionSummary>
+  </Items>
+</DistributionList>`
+
+const accessDeniedResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<ErrorResponse xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>Access denied.</Message>
+  </Error>
+  <RequestId>b0be6d8a-4d2d-11e4-a2f6-5f1a5EXAMPLE</RequestId>
+</ErrorResponse>`
+
+// retryTwice retries any failure twice, without delay.
+type retryTwice struct{}
+
+func (retryTwice) ShouldRetry(target string, r *http.Response, err error, numRetries int) bool {
+	return numRetries < 2
+}
+
+func (retryTwice) Delay(target string, r *http.Response, err error, numRetries int) time.Duration {
+	return 0
+}
+
+func TestNewCloudFrontWithOptions(t *testing.T) {
+	var requests []*http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		if len(requests) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(listDistributionsResponse))
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	cf := NewCloudFrontWithOptions(auth,
+		WithEndpoint(srv.URL),
+		WithHTTPClient(&http.Client{Timeout: time.Second}),
+		WithRetry(retryTwice{}),
+		WithRegion(aws.USWest2),
+		WithLogger(log.New(&logs, "", 0)),
+	)
+
+	resp, err := cf.List("", 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Items) != 1 || resp.Items[0].Id != "EDFDVBD6EXAMPLE" {
+		t.Fatalf("unexpected distributions: %#v", resp.Items)
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	req := requests[1]
+	if req.URL.Path != "/2020-05-31/distribution" || req.URL.Query().Get("MaxItems") != "100" {
+		t.Fatalf("unexpected request URL: %s", req.URL)
+	}
+	if auth := req.Header.Get("Authorization"); !strings.Contains(auth, "/us-west-2/cloudfront/aws4_request") {
+		t.Fatalf("request not signed for us-west-2: %s", auth)
+	}
+	if !strings.Contains(logs.String(), "cloudfront: retrying ListDistributions") {
+		t.Fatalf("retry not logged: %q", logs.String())
+	}
+}
+
+func TestNewCloudFrontWithOptionsDefaults(t *testing.T) {
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"})
+	if cf.endpoint != "" || cf.httpClient != nil || cf.retryPolicy != nil {
+		t.Fatalf("unexpected defaults: %#v", cf)
+	}
+	if cf.region.Name != aws.USEast.Name || cf.Signer == nil {
+		t.Fatalf("expected a signer for %s, got region %q", aws.USEast.Name, cf.region.Name)
+	}
+}
+
+func TestWithSigningKey(t *testing.T) {
+	key := &rsa.PrivateKey{}
+	cf := NewCloudFrontWithOptions(aws.Auth{}, WithSigningKey("https://d111111abcdef8.cloudfront.net", key, "APKAEIBAERJR2EXAMPLE"))
+	if cf.BaseURL != "https://d111111abcdef8.cloudfront.net" || cf.key != key || cf.keyPairId != "APKAEIBAERJR2EXAMPLE" {
+		t.Fatalf("signing key not set: %#v", cf)
+	}
+}
+
+func TestQueryError(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(accessDeniedResponse))
+	}))
+	defer srv.Close()
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"}, WithEndpoint(srv.URL))
+	_, err := cf.List("", 100)
+	awsErr, ok := err.(*aws.Error)
+	if !ok {
+		t.Fatalf("expected an *aws.Error, got %#v", err)
+	}
+	if awsErr.Code != "AccessDenied" || awsErr.StatusCode != 403 || awsErr.RequestId != "b0be6d8a-4d2d-11e4-a2f6-5f1a5EXAMPLE" {
+		t.Fatalf("unexpected error: %#v", awsErr)
+	}
+	if requests != 1 {
+		t.Fatalf("expected no retries by default, got %d requests", requests)
+	}
+}
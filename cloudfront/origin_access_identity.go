@@ -0,0 +1,223 @@
+package cloudfront
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/s3"
+)
+
+type OriginAccessIdentityConfig struct {
+	XMLName         xml.Name `xml:"CloudFrontOriginAccessIdentityConfig"`
+	CallerReference string
+	Comment         string
+}
+
+// OriginAccessIdentity is a CloudFront user that S3 origins can grant
+// access to, so that their objects are only served through CloudFront.
+// ETag is the current version of the identity, required to delete it.
+type OriginAccessIdentity struct {
+	XMLName           xml.Name `xml:"CloudFrontOriginAccessIdentity"`
+	Id                string
+	S3CanonicalUserId string
+	Config            OriginAccessIdentityConfig `xml:"CloudFrontOriginAccessIdentityConfig"`
+	ETag              string                     `xml:"-"`
+}
+
+// S3OriginConfig returns the origin config of an S3 origin accessed
+// through the identity.
+func (oai *OriginAccessIdentity) S3OriginConfig() *S3OriginConfig {
+	return &S3OriginConfig{OriginAccessIdentity: "origin-access-identity/cloudfront/" + oai.Id}
+}
+
+// PrincipalArn returns the ARN of the identity, the principal to grant
+// access to in a bucket policy.
+func (oai *OriginAccessIdentity) PrincipalArn() string {
+	return "arn:aws:iam::cloudfront:user/CloudFront Origin Access Identity " + oai.Id
+}
+
+// CreateOriginAccessIdentity creates an origin access identity.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/CreateOriginAccessIdentity.html
+func (cf *CloudFront) CreateOriginAccessIdentity(comment string) (*OriginAccessIdentity, error) {
+	body, err := xml.Marshal(OriginAccessIdentityConfig{
+		CallerReference: strconv.FormatInt(time.Now().UnixNano(), 10),
+		Comment:         comment,
+	})
+	if err != nil {
+		return nil, err
+	}
+	oai := &OriginAccessIdentity{}
+	header, err := cf.query("CreateCloudFrontOriginAccessIdentity", "POST", "/origin-access-identity/cloudfront", nil, nil, body, oai)
+	if err != nil {
+		return nil, err
+	}
+	oai.ETag = header.Get("ETag")
+	return oai, nil
+}
+
+// DeleteOriginAccessIdentity deletes an origin access identity, given its
+// ID and ETag. Distributions must no longer use it.
+//
+// See http://docs.aws.amazon.com/AmazonCloudFront/latest/APIReference/DeleteOriginAccessIdentity.html
+func (cf *CloudFront) DeleteOriginAccessIdentity(id, etag string) error {
+	header := http.Header{"If-Match": {etag}}
+	_, err := cf.query("DeleteCloudFrontOriginAccessIdentity", "DELETE", "/origin-access-identity/cloudfront/"+url.PathEscape(id), nil, header, nil, nil)
+	return err
+}
+
+// S3DistributionOptions holds the optional settings of
+// CreateS3BackedDistribution. Serving Aliases over HTTPS needs the ARN of
+// an ACM certificate, requested in us-east-1, covering them.
+type S3DistributionOptions struct {
+	Aliases           []string
+	CertificateArn    string
+	DefaultRootObject string
+	Comment           string
+	PriceClass        PriceClass
+}
+
+// S3BackedDistribution is the result of CreateS3BackedDistribution.
+type S3BackedDistribution struct {
+	Distribution         DistributionSummary
+	OriginAccessIdentity *OriginAccessIdentity
+}
+
+// RollbackError is returned by CreateS3BackedDistribution when a step
+// fails and undoing the previous steps fails too, leaving resources
+// behind.
+type RollbackError struct {
+	Err         error // The error of the failed step
+	RollbackErr error // The first error met while undoing the previous steps
+}
+
+func (e *RollbackError) Error() string {
+	return e.Err.Error() + " (rollback failed: " + e.RollbackErr.Error() + ")"
+}
+
+// Unwrap returns the error of the failed step.
+func (e *RollbackError) Unwrap() error {
+	return e.Err
+}
+
+type policyStatement struct {
+	Sid       string `json:",omitempty"`
+	Effect    string
+	Principal map[string]string
+	Action    string
+	Resource  string
+}
+
+// CreateS3BackedDistribution serves a private bucket over CloudFront. It
+// creates an origin access identity, adds a statement to the policy of the
+// bucket granting the identity read access to its objects, and creates a
+// distribution with the bucket as origin, built by DistributionBuilder.
+//
+// If a step fails, the previous ones are undone: the policy of the bucket
+// is restored and the identity deleted. A *RollbackError is returned if
+// that fails too.
+func (cf *CloudFront) CreateS3BackedDistribution(bucket *s3.Bucket, opts *S3DistributionOptions) (*S3BackedDistribution, error) {
+	if opts == nil {
+		opts = &S3DistributionOptions{}
+	}
+	domainName := bucket.Name + ".s3.amazonaws.com"
+	builder := NewDistributionBuilder().
+		WithS3Origin(bucket.Name, domainName, "").
+		WithAlias(opts.Aliases...).
+		WithDefaultRootObject(opts.DefaultRootObject).
+		WithComment(opts.Comment)
+	if opts.CertificateArn != "" {
+		builder.WithACMCertificate(opts.CertificateArn)
+	}
+	if opts.PriceClass != "" {
+		builder.WithPriceClass(opts.PriceClass)
+	}
+	config, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	oldPolicy, err := bucket.GetBucketPolicy()
+	var apiErr aws.APIError
+	if errors.As(err, &apiErr) && apiErr.Code() == "NoSuchBucketPolicy" {
+		oldPolicy, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	oai, err := cf.CreateOriginAccessIdentity("Access to " + bucket.Name)
+	if err != nil {
+		return nil, err
+	}
+	deleteOAI := func() error {
+		return cf.DeleteOriginAccessIdentity(oai.Id, oai.ETag)
+	}
+
+	policy, err := addPolicyStatement(oldPolicy, policyStatement{
+		Effect:    "Allow",
+		Principal: map[string]string{"AWS": oai.PrincipalArn()},
+		Action:    "s3:GetObject",
+		Resource:  "arn:aws:s3:::" + bucket.Name + "/*",
+	})
+	if err == nil {
+		err = bucket.PutBucketPolicy(policy)
+	}
+	if err != nil {
+		return nil, rollback(err, deleteOAI)
+	}
+
+	config.Origins[0].S3OriginConfig = oai.S3OriginConfig()
+	dist, err := cf.Create(config)
+	if err != nil {
+		restorePolicy := func() error {
+			if oldPolicy == nil {
+				return bucket.DeleteBucketPolicy()
+			}
+			return bucket.PutBucketPolicy(oldPolicy)
+		}
+		return nil, rollback(err, restorePolicy, deleteOAI)
+	}
+	return &S3BackedDistribution{Distribution: dist, OriginAccessIdentity: oai}, nil
+}
+
+// rollback calls the undo functions in order, and returns err, or a
+// *RollbackError if one of them fails.
+func rollback(err error, undo ...func() error) error {
+	var rollbackErr error
+	for _, f := range undo {
+		if e := f(); e != nil && rollbackErr == nil {
+			rollbackErr = e
+		}
+	}
+	if rollbackErr != nil {
+		return &RollbackError{err, rollbackErr}
+	}
+	return err
+}
+
+// addPolicyStatement adds a statement to a JSON bucket policy, which may
+// be nil for a new policy, keeping its other fields and statements.
+func addPolicyStatement(policy []byte, statement policyStatement) ([]byte, error) {
+	doc := map[string]interface{}{"Version": "2012-10-17"}
+	if policy != nil {
+		if err := json.Unmarshal(policy, &doc); err != nil {
+			return nil, err
+		}
+	}
+	var statements []interface{}
+	switch s := doc["Statement"].(type) {
+	case []interface{}:
+		statements = s
+	case map[string]interface{}:
+		statements = []interface{}{s}
+	}
+	doc["Statement"] = append(statements, statement)
+	return json.Marshal(doc)
+}
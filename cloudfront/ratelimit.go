@@ -0,0 +1,97 @@
+package cloudfront
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OperationClass groups the API operations sharing a rate limit.
+type OperationClass string
+
+const (
+	// OperationRead covers the GET requests, such as List and
+	// GetDistributionConfig.
+	OperationRead OperationClass = "read"
+	// OperationWrite covers the requests changing resources, such as
+	// Create and UpdateDistribution.
+	OperationWrite OperationClass = "write"
+	// OperationInvalidation covers CreateInvalidation.
+	OperationInvalidation OperationClass = "invalidation"
+)
+
+// operationClass returns the class of an API request.
+func operationClass(action, method string) OperationClass {
+	switch {
+	case method == "GET":
+		return OperationRead
+	case strings.HasSuffix(action, "Invalidation"):
+		return OperationInvalidation
+	}
+	return OperationWrite
+}
+
+// WithRateLimit limits the API requests of class to requestsPerSecond on
+// average, allowing bursts of up to burst requests, by delaying the
+// requests that exceed it. The limit is shared by all the calls made
+// through the client, from any goroutine, so that concurrent tooling stays
+// under the CloudFront quotas instead of failing with Throttling errors.
+//
+// WithRateLimit panics if requestsPerSecond is not positive or burst is
+// less than 1, as no request could ever be sent.
+func WithRateLimit(class OperationClass, requestsPerSecond float64, burst int) Option {
+	if !(requestsPerSecond > 0) {
+		panic(fmt.Sprintf("cloudfront: non-positive rate %v for %s requests", requestsPerSecond, class))
+	}
+	if burst < 1 {
+		panic(fmt.Sprintf("cloudfront: burst %d for %s requests is less than 1", burst, class))
+	}
+	return func(cf *CloudFront) {
+		if cf.rateLimits == nil {
+			cf.rateLimits = make(map[OperationClass]*tokenBucket)
+		}
+		cf.rateLimits[class] = newTokenBucket(requestsPerSecond, burst)
+	}
+}
+
+// tokenBucket is a rate limiter holding up to burst tokens, refilled at
+// rate tokens per second. Every request takes a token, waiting for one if
+// the bucket is empty.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// reserve takes a token, and returns how long to wait before using it.
+// Tokens taken in advance make the bucket negative, so that waiting
+// callers are served in turn.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+	b.last = now
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// wait blocks until a token is available, and takes it.
+func (b *tokenBucket) wait() {
+	if d := b.reserve(); d > 0 {
+		time.Sleep(d)
+	}
+}
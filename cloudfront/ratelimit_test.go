@@ -0,0 +1,89 @@
+package cloudfront
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+func TestOperationClass(t *testing.T) {
+	tests := []struct {
+		action, method string
+		class          OperationClass
+	}{
+		{"ListDistributions", "GET", OperationRead},
+		{"GetInvalidation", "GET", OperationRead},
+		{"CreateDistribution", "POST", OperationWrite},
+		{"DeleteCloudFrontOriginAccessIdentity", "DELETE", OperationWrite},
+		{"CreateInvalidation", "POST", OperationInvalidation},
+	}
+	for _, test := range tests {
+		if class := operationClass(test.action, test.method); class != test.class {
+			t.Errorf("%s: expected %s, got %s", test.action, test.class, class)
+		}
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	var times []time.Time
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+		w.Write([]byte(listDistributionsResponse))
+	}))
+	defer srv.Close()
+
+	cf := NewCloudFrontWithOptions(aws.Auth{AccessKey: "abc", SecretKey: "123"},
+		WithEndpoint(srv.URL), WithRateLimit(OperationRead, 20, 2))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cf.List("", 100); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 2 requests are sent at once, and the 4 others 50ms apart.
+	if elapsed := time.Since(start); elapsed < 190*time.Millisecond {
+		t.Errorf("expected the requests to take at least 200ms, took %s", elapsed)
+	}
+	if len(times) != 6 {
+		t.Errorf("expected 6 requests, got %d", len(times))
+	}
+}
+
+func TestWithRateLimitInvalid(t *testing.T) {
+	tests := []struct {
+		rate  float64
+		burst int
+		err   string
+	}{
+		{0, 1, "cloudfront: non-positive rate 0 for write requests"},
+		{-5, 1, "cloudfront: non-positive rate -5 for write requests"},
+		{math.NaN(), 1, "cloudfront: non-positive rate NaN for write requests"},
+		{10, 0, "cloudfront: burst 0 for write requests is less than 1"},
+	}
+	for _, test := range tests {
+		func() {
+			defer func() {
+				if r := recover(); r != test.err {
+					t.Errorf("rate %v, burst %d: expected panic %q, got %v", test.rate, test.burst, test.err, r)
+				}
+			}()
+			WithRateLimit(OperationWrite, test.rate, test.burst)
+		}()
+	}
+}
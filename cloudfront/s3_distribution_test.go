@@ -0,0 +1,174 @@
+package cloudfront
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/s3"
+)
+
+const createOriginAccessIdentityResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<CloudFrontOriginAccessIdentity xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Id>E74FTE3AEXAMPLE</Id>
+  <S3CanonicalUserId>cd13868f797c227fbea2830611a26fe0a21ba1b826ab4bed9b7771c9aEXAMPLE</S3CanonicalUserId>
+  <CloudFrontOriginAccessIdentityConfig>
+    <CallerReference>20120229090000</CallerReference>
+    <Comment>Access to assets</Comment>
+  </CloudFrontOriginAccessIdentityConfig>
+</CloudFrontOriginAccessIdentity>`
+
+const createDistributionResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<Distribution xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <Id>EDFDVBD6EXAMPLE</Id>
+  <Status>InProgress</Status>
+  <DomainName>d111111abcdef8.cloudfront.net</DomainName>
+</Distribution>`
+
+const noSuchBucketPolicyResponse = `<?xml version="1.0" encoding="UTF-8"?>
+<Error>
+  <Code>NoSuchBucketPolicy</Code>
+  <Message>The bucket policy does not exist</Message>
+  <BucketName>assets</BucketName>
+</Error>`
+
+// s3DistributionServer fakes CloudFront and S3, failing the creation of
+// distributions when failCreate is true. It records the requests, and the
+// policy of the "assets" bucket.
+type s3DistributionServer struct {
+	failCreate bool
+	policy     string
+	requests   []string
+}
+
+func (s *s3DistributionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+	switch {
+	case r.URL.Path == "/assets/":
+		switch r.Method {
+		case "GET":
+			if s.policy == "" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(noSuchBucketPolicyResponse))
+				return
+			}
+			w.Write([]byte(s.policy))
+		case "PUT":
+			s.policy = string(body)
+		case "DELETE":
+			s.policy = ""
+			w.WriteHeader(http.StatusNoContent)
+		}
+	case strings.HasSuffix(r.URL.Path, "/origin-access-identity/cloudfront"):
+		w.Header().Set("ETag", "E2QWRUHEXAMPLE")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(createOriginAccessIdentityResponse))
+	case strings.HasSuffix(r.URL.Path, "/origin-access-identity/cloudfront/E74FTE3AEXAMPLE"):
+		if r.Header.Get("If-Match") != "E2QWRUHEXAMPLE" {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasSuffix(r.URL.Path, "/distribution"):
+		if s.failCreate {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(accessDeniedResponse))
+			return
+		}
+		if !strings.Contains(string(body), "<OriginAccessIdentity>origin-access-identity/cloudfront/E74FTE3AEXAMPLE</OriginAccessIdentity>") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(createDistributionResponse))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newS3DistributionTest(s *s3DistributionServer) (*CloudFront, *s3.Bucket, func()) {
+	srv := httptest.NewServer(s)
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	cf := NewCloudFrontWithOptions(auth, WithEndpoint(srv.URL))
+	bucket := s3.New(auth, aws.Region{Name: "faux-region-1", S3Endpoint: srv.URL}).Bucket("assets")
+	return cf, bucket, srv.Close
+}
+
+func TestCreateS3BackedDistribution(t *testing.T) {
+	s := &s3DistributionServer{
+		policy: `{"Version":"2012-10-17","Statement":[{"Sid":"Existing","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"s3:ListBucket","Resource":"arn:aws:s3:::assets"}]}`,
+	}
+	cf, bucket, closeServer := newS3DistributionTest(s)
+	defer closeServer()
+
+	result, err := cf.CreateS3BackedDistribution(bucket, &S3DistributionOptions{
+		Aliases:        []string{"assets.example.com"},
+		CertificateArn: "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Distribution.Id != "EDFDVBD6EXAMPLE" || result.OriginAccessIdentity.Id != "E74FTE3AEXAMPLE" {
+		t.Errorf("unexpected result: %#v", result)
+	}
+	if result.OriginAccessIdentity.ETag != "E2QWRUHEXAMPLE" {
+		t.Errorf("unexpected ETag: %q", result.OriginAccessIdentity.ETag)
+	}
+
+	var policy struct {
+		Statement []policyStatement
+	}
+	if err := json.Unmarshal([]byte(s.policy), &policy); err != nil {
+		t.Fatal(err)
+	}
+	if len(policy.Statement) != 2 || policy.Statement[0].Sid != "Existing" {
+		t.Fatalf("unexpected policy: %s", s.policy)
+	}
+	want := policyStatement{
+		Effect:    "Allow",
+		Principal: map[string]string{"AWS": "arn:aws:iam::cloudfront:user/CloudFront Origin Access Identity E74FTE3AEXAMPLE"},
+		Action:    "s3:GetObject",
+		Resource:  "arn:aws:s3:::assets/*",
+	}
+	got := policy.Statement[1]
+	if got.Effect != want.Effect || got.Principal["AWS"] != want.Principal["AWS"] || got.Action != want.Action || got.Resource != want.Resource {
+		t.Errorf("unexpected statement: %#v", got)
+	}
+}
+
+func TestCreateS3BackedDistributionRollback(t *testing.T) {
+	s := &s3DistributionServer{failCreate: true}
+	cf, bucket, closeServer := newS3DistributionTest(s)
+	defer closeServer()
+
+	_, err := cf.CreateS3BackedDistribution(bucket, nil)
+	if awsErr, ok := err.(*aws.Error); !ok || awsErr.Code != "AccessDenied" {
+		t.Fatalf("expected AccessDenied, got %v", err)
+	}
+	if s.policy != "" {
+		t.Errorf("bucket policy not removed: %s", s.policy)
+	}
+	last := s.requests[len(s.requests)-2:]
+	if last[0] != "DELETE /assets/" || !strings.HasPrefix(last[1], "DELETE ") || !strings.HasSuffix(last[1], "/E74FTE3AEXAMPLE") {
+		t.Errorf("unexpected rollback requests: %v", last)
+	}
+}
+
+func TestCreateS3BackedDistributionInvalidConfig(t *testing.T) {
+	s := &s3DistributionServer{}
+	cf, bucket, closeServer := newS3DistributionTest(s)
+	defer closeServer()
+
+	_, err := cf.CreateS3BackedDistribution(bucket, &S3DistributionOptions{PriceClass: "PriceClass_1"})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("expected a validation error, got %v", err)
+	}
+	if len(s.requests) != 0 {
+		t.Errorf("unexpected requests: %v", s.requests)
+	}
+}
@@ -0,0 +1,262 @@
+package cloudfront
+
+import (
+	"fmt"
+)
+
+// PriceClass sets the edge locations a distribution is served from.
+type PriceClass string
+
+const (
+	PriceClassAll PriceClass = "PriceClass_All"
+	PriceClass200 PriceClass = "PriceClass_200"
+	PriceClass100 PriceClass = "PriceClass_100"
+)
+
+// Valid reports whether p is one of the PriceClass constants.
+func (p PriceClass) Valid() bool {
+	switch p {
+	case PriceClassAll, PriceClass200, PriceClass100:
+		return true
+	}
+	return false
+}
+
+// ViewerProtocolPolicy sets the protocols viewers may use for the paths of
+// a cache behavior.
+type ViewerProtocolPolicy string
+
+const (
+	ViewerProtocolPolicyAllowAll        ViewerProtocolPolicy = "allow-all"
+	ViewerProtocolPolicyHTTPSOnly       ViewerProtocolPolicy = "https-only"
+	ViewerProtocolPolicyRedirectToHTTPS ViewerProtocolPolicy = "redirect-to-https"
+)
+
+// Valid reports whether p is one of the ViewerProtocolPolicy constants.
+func (p ViewerProtocolPolicy) Valid() bool {
+	switch p {
+	case ViewerProtocolPolicyAllowAll, ViewerProtocolPolicyHTTPSOnly, ViewerProtocolPolicyRedirectToHTTPS:
+		return true
+	}
+	return false
+}
+
+// OriginProtocolPolicy sets the protocol CloudFront uses to reach a custom
+// origin.
+type OriginProtocolPolicy string
+
+const (
+	OriginProtocolPolicyHTTPOnly    OriginProtocolPolicy = "http-only"
+	OriginProtocolPolicyMatchViewer OriginProtocolPolicy = "match-viewer"
+	OriginProtocolPolicyHTTPSOnly   OriginProtocolPolicy = "https-only"
+)
+
+// Valid reports whether p is one of the OriginProtocolPolicy constants.
+func (p OriginProtocolPolicy) Valid() bool {
+	switch p {
+	case OriginProtocolPolicyHTTPOnly, OriginProtocolPolicyMatchViewer, OriginProtocolPolicyHTTPSOnly:
+		return true
+	}
+	return false
+}
+
+// SSLSupportMethod sets how a custom certificate is served: with SNI, or
+// from dedicated IP addresses, which is charged extra.
+type SSLSupportMethod string
+
+const (
+	SSLSupportMethodSNIOnly  SSLSupportMethod = "sni-only"
+	SSLSupportMethodVIP      SSLSupportMethod = "vip"
+	SSLSupportMethodStaticIP SSLSupportMethod = "static-ip"
+)
+
+// Valid reports whether m is one of the SSLSupportMethod constants.
+func (m SSLSupportMethod) Valid() bool {
+	switch m {
+	case SSLSupportMethodSNIOnly, SSLSupportMethodVIP, SSLSupportMethodStaticIP:
+		return true
+	}
+	return false
+}
+
+// MinimumProtocolVersion sets the oldest protocol and ciphers viewers may
+// use over HTTPS.
+type MinimumProtocolVersion string
+
+const (
+	MinimumProtocolVersionSSLv3       MinimumProtocolVersion = "SSLv3"
+	MinimumProtocolVersionTLSv1       MinimumProtocolVersion = "TLSv1"
+	MinimumProtocolVersionTLSv1_2016  MinimumProtocolVersion = "TLSv1_2016"
+	MinimumProtocolVersionTLSv11_2016 MinimumProtocolVersion = "TLSv1.1_2016"
+	MinimumProtocolVersionTLSv12_2018 MinimumProtocolVersion = "TLSv1.2_2018"
+	MinimumProtocolVersionTLSv12_2019 MinimumProtocolVersion = "TLSv1.2_2019"
+	MinimumProtocolVersionTLSv12_2021 MinimumProtocolVersion = "TLSv1.2_2021"
+)
+
+// Valid reports whether v is one of the MinimumProtocolVersion constants.
+func (v MinimumProtocolVersion) Valid() bool {
+	switch v {
+	case MinimumProtocolVersionSSLv3, MinimumProtocolVersionTLSv1, MinimumProtocolVersionTLSv1_2016,
+		MinimumProtocolVersionTLSv11_2016, MinimumProtocolVersionTLSv12_2018,
+		MinimumProtocolVersionTLSv12_2019, MinimumProtocolVersionTLSv12_2021:
+		return true
+	}
+	return false
+}
+
+// RestrictionType sets whether the Locations of a GeoRestriction are the
+// countries denied or the only ones allowed.
+type RestrictionType string
+
+const (
+	RestrictionTypeNone      RestrictionType = "none"
+	RestrictionTypeBlacklist RestrictionType = "blacklist"
+	RestrictionTypeWhitelist RestrictionType = "whitelist"
+)
+
+// Valid reports whether t is one of the RestrictionType constants.
+func (t RestrictionType) Valid() bool {
+	switch t {
+	case RestrictionTypeNone, RestrictionTypeBlacklist, RestrictionTypeWhitelist:
+		return true
+	}
+	return false
+}
+
+// CookieForward sets which cookies are forwarded to the origin, and so
+// vary the cached responses.
+type CookieForward string
+
+const (
+	CookieForwardNone      CookieForward = "none"
+	CookieForwardWhitelist CookieForward = "whitelist"
+	CookieForwardAll       CookieForward = "all"
+)
+
+// Valid reports whether f is one of the CookieForward constants.
+func (f CookieForward) Valid() bool {
+	switch f {
+	case CookieForwardNone, CookieForwardWhitelist, CookieForwardAll:
+		return true
+	}
+	return false
+}
+
+// ValidationError is returned by DistributionConfig.Validate for the first
+// invalid field found.
+type ValidationError struct {
+	Field   string // Path of the field, such as "CacheBehaviors[1].TargetOriginId"
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("cloudfront: invalid %s: %s", e.Field, e.Message)
+}
+
+func invalid(field, format string, args ...interface{}) error {
+	return &ValidationError{field, fmt.Sprintf(format, args...)}
+}
+
+// Validate checks the config for missing fields, misspelled values and
+// invalid combinations the CloudFront API would reject. Create validates
+// the config before sending it.
+func (config *DistributionConfig) Validate() error {
+	if len(config.Origins) == 0 {
+		return invalid("Origins", "at least one origin is required")
+	}
+	origins := map[string]bool{}
+	for i, o := range config.Origins {
+		field := fmt.Sprintf("Origins[%d]", i)
+		if o.Id == "" {
+			return invalid(field+".Id", "required")
+		}
+		if origins[o.Id] {
+			return invalid(field+".Id", "duplicate origin %q", o.Id)
+		}
+		origins[o.Id] = true
+		if o.DomainName == "" {
+			return invalid(field+".DomainName", "required")
+		}
+		if (o.S3OriginConfig == nil) == (o.CustomOriginConfig == nil) {
+			return invalid(field, "exactly one of S3OriginConfig and CustomOriginConfig is required")
+		}
+		if c := o.CustomOriginConfig; c != nil && !c.OriginProtocolPolicy.Valid() {
+			return invalid(field+".CustomOriginConfig.OriginProtocolPolicy", "unknown policy %q", c.OriginProtocolPolicy)
+		}
+	}
+
+	if err := config.DefaultCacheBehavior.validate("DefaultCacheBehavior", origins); err != nil {
+		return err
+	}
+	for i := range config.CacheBehaviors {
+		field := fmt.Sprintf("CacheBehaviors[%d]", i)
+		if config.CacheBehaviors[i].PathPattern == "" {
+			return invalid(field+".PathPattern", "required")
+		}
+		if err := config.CacheBehaviors[i].validate(field, origins); err != nil {
+			return err
+		}
+	}
+
+	if config.PriceClass != "" && !config.PriceClass.Valid() {
+		return invalid("PriceClass", "unknown price class %q", config.PriceClass)
+	}
+
+	if r := config.Restrictions; r != nil {
+		if !r.RestrictionType.Valid() {
+			return invalid("Restrictions.RestrictionType", "unknown restriction type %q", r.RestrictionType)
+		}
+		if r.RestrictionType == RestrictionTypeNone && len(r.Locations) > 0 {
+			return invalid("Restrictions.Locations", "must be empty with restriction type none")
+		}
+		if r.RestrictionType != RestrictionTypeNone && len(r.Locations) == 0 {
+			return invalid("Restrictions.Locations", "at least one location is required with restriction type %s", r.RestrictionType)
+		}
+	}
+
+	if c := config.ViewerCertificate; c != nil {
+		certs := 0
+		for _, set := range []bool{c.IAMCertificateId != "", c.ACMCertificateArn != "", c.CloudFrontDefaultCertificate} {
+			if set {
+				certs++
+			}
+		}
+		if certs != 1 {
+			return invalid("ViewerCertificate", "exactly one of IAMCertificateId, ACMCertificateArn and CloudFrontDefaultCertificate is required")
+		}
+		if !c.CloudFrontDefaultCertificate && c.SSLSupportMethod == "" {
+			return invalid("ViewerCertificate.SSLSupportMethod", "required with a custom certificate")
+		}
+		if c.SSLSupportMethod != "" && !c.SSLSupportMethod.Valid() {
+			return invalid("ViewerCertificate.SSLSupportMethod", "unknown method %q", c.SSLSupportMethod)
+		}
+		if c.MinimumProtocolVersion != "" && !c.MinimumProtocolVersion.Valid() {
+			return invalid("ViewerCertificate.MinimumProtocolVersion", "unknown version %q", c.MinimumProtocolVersion)
+		}
+	}
+	return nil
+}
+
+func (cache *CacheBehavior) validate(field string, origins map[string]bool) error {
+	if cache.TargetOriginId == "" {
+		return invalid(field+".TargetOriginId", "required")
+	}
+	if !origins[cache.TargetOriginId] {
+		return invalid(field+".TargetOriginId", "no origin with ID %q", cache.TargetOriginId)
+	}
+	if !cache.ViewerProtocolPolicy.Valid() {
+		return invalid(field+".ViewerProtocolPolicy", "unknown policy %q", cache.ViewerProtocolPolicy)
+	}
+	if c := cache.ForwardedValues.Cookies; c != nil {
+		if !c.Forward.Valid() {
+			return invalid(field+".ForwardedValues.Cookies.Forward", "unknown value %q", c.Forward)
+		}
+		if c.Forward == CookieForwardWhitelist && len(c.WhitelistedNames) == 0 {
+			return invalid(field+".ForwardedValues.Cookies.WhitelistedNames", "at least one name is required with whitelist")
+		}
+		if c.Forward != CookieForwardWhitelist && len(c.WhitelistedNames) > 0 {
+			return invalid(field+".ForwardedValues.Cookies.WhitelistedNames", "must be empty unless forwarding a whitelist")
+		}
+	}
+	return nil
+}
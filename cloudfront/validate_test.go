@@ -0,0 +1,103 @@
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+func validConfig() DistributionConfig {
+	return DistributionConfig{
+		Origins: Origins{
+			Origin{
+				Id:             "assets",
+				DomainName:     "assets.s3.amazonaws.com",
+				S3OriginConfig: &S3OriginConfig{},
+			},
+			Origin{
+				Id:         "app",
+				DomainName: "app.example.com",
+				CustomOriginConfig: &CustomOriginConfig{
+					HTTPPort:             80,
+					HTTPSPort:            443,
+					OriginProtocolPolicy: OriginProtocolPolicyHTTPSOnly,
+				},
+			},
+		},
+		DefaultCacheBehavior: CacheBehavior{
+			TargetOriginId:       "app",
+			ViewerProtocolPolicy: ViewerProtocolPolicyRedirectToHTTPS,
+			ForwardedValues: ForwardedValues{
+				Cookies: &Cookies{Forward: CookieForwardWhitelist, WhitelistedNames: Names{"session"}},
+			},
+		},
+		CacheBehaviors: CacheBehaviors{
+			CacheBehavior{
+				TargetOriginId:       "assets",
+				PathPattern:          "/static/*",
+				ViewerProtocolPolicy: ViewerProtocolPolicyAllowAll,
+			},
+		},
+		Restrictions: &GeoRestriction{RestrictionType: RestrictionTypeBlacklist, Locations: []string{"CA"}},
+		ViewerCertificate: &ViewerCertificate{
+			ACMCertificateArn:      "arn:aws:acm:us-east-1:123456789012:certificate/12345678-1234-1234-1234-123456789012",
+			SSLSupportMethod:       SSLSupportMethodSNIOnly,
+			MinimumProtocolVersion: MinimumProtocolVersionTLSv12_2021,
+		},
+		PriceClass: PriceClass100,
+		Enabled:    true,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	config := validConfig()
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		change func(*DistributionConfig)
+		err    string
+	}{
+		{func(c *DistributionConfig) { c.Origins = nil }, "cloudfront: invalid Origins: at least one origin is required"},
+		{func(c *DistributionConfig) { c.Origins[1].Id = "assets" }, `cloudfront: invalid Origins[1].Id: duplicate origin "assets"`},
+		{func(c *DistributionConfig) { c.Origins[0].DomainName = "" }, "cloudfront: invalid Origins[0].DomainName: required"},
+		{func(c *DistributionConfig) { c.Origins[0].CustomOriginConfig = &CustomOriginConfig{} }, "cloudfront: invalid Origins[0]: exactly one of S3OriginConfig and CustomOriginConfig is required"},
+		{func(c *DistributionConfig) { c.Origins[1].CustomOriginConfig.OriginProtocolPolicy = "https" }, `cloudfront: invalid Origins[1].CustomOriginConfig.OriginProtocolPolicy: unknown policy "https"`},
+		{func(c *DistributionConfig) { c.DefaultCacheBehavior.TargetOriginId = "" }, "cloudfront: invalid DefaultCacheBehavior.TargetOriginId: required"},
+		{func(c *DistributionConfig) { c.CacheBehaviors[0].TargetOriginId = "cdn" }, `cloudfront: invalid CacheBehaviors[0].TargetOriginId: no origin with ID "cdn"`},
+		{func(c *DistributionConfig) { c.CacheBehaviors[0].PathPattern = "" }, "cloudfront: invalid CacheBehaviors[0].PathPattern: required"},
+		{func(c *DistributionConfig) { c.DefaultCacheBehavior.ViewerProtocolPolicy = "redirect-to-http" }, `cloudfront: invalid DefaultCacheBehavior.ViewerProtocolPolicy: unknown policy "redirect-to-http"`},
+		{func(c *DistributionConfig) { c.DefaultCacheBehavior.ForwardedValues.Cookies.Forward = "some" }, `cloudfront: invalid DefaultCacheBehavior.ForwardedValues.Cookies.Forward: unknown value "some"`},
+		{func(c *DistributionConfig) { c.DefaultCacheBehavior.ForwardedValues.Cookies.WhitelistedNames = nil }, "cloudfront: invalid DefaultCacheBehavior.ForwardedValues.Cookies.WhitelistedNames: at least one name is required with whitelist"},
+		{func(c *DistributionConfig) { c.DefaultCacheBehavior.ForwardedValues.Cookies.Forward = CookieForwardAll }, "cloudfront: invalid DefaultCacheBehavior.ForwardedValues.Cookies.WhitelistedNames: must be empty unless forwarding a whitelist"},
+		{func(c *DistributionConfig) { c.PriceClass = "PriceClass_300" }, `cloudfront: invalid PriceClass: unknown price class "PriceClass_300"`},
+		{func(c *DistributionConfig) { c.Restrictions.RestrictionType = "blocklist" }, `cloudfront: invalid Restrictions.RestrictionType: unknown restriction type "blocklist"`},
+		{func(c *DistributionConfig) { c.Restrictions.Locations = nil }, "cloudfront: invalid Restrictions.Locations: at least one location is required with restriction type blacklist"},
+		{func(c *DistributionConfig) { c.Restrictions.RestrictionType = RestrictionTypeNone }, "cloudfront: invalid Restrictions.Locations: must be empty with restriction type none"},
+		{func(c *DistributionConfig) { c.ViewerCertificate.CloudFrontDefaultCertificate = true }, "cloudfront: invalid ViewerCertificate: exactly one of IAMCertificateId, ACMCertificateArn and CloudFrontDefaultCertificate is required"},
+		{func(c *DistributionConfig) { c.ViewerCertificate.SSLSupportMethod = "" }, "cloudfront: invalid ViewerCertificate.SSLSupportMethod: required with a custom certificate"},
+		{func(c *DistributionConfig) { c.ViewerCertificate.SSLSupportMethod = "sni" }, `cloudfront: invalid ViewerCertificate.SSLSupportMethod: unknown method "sni"`},
+		{func(c *DistributionConfig) { c.ViewerCertificate.MinimumProtocolVersion = "TLSv1.3" }, `cloudfront: invalid ViewerCertificate.MinimumProtocolVersion: unknown version "TLSv1.3"`},
+	}
+	for _, test := range tests {
+		config := validConfig()
+		test.change(&config)
+		err := config.Validate()
+		if err == nil {
+			t.Errorf("expected error %q, got none", test.err)
+			continue
+		}
+		if _, ok := err.(*ValidationError); !ok || err.Error() != test.err {
+			t.Errorf("expected error %q, got %#v", test.err, err)
+		}
+	}
+}
+
+func TestCreateValidates(t *testing.T) {
+	cf := NewCloudFrontWithOptions(aws.Auth{}, WithEndpoint("http://127.0.0.1:0"))
+	_, err := cf.Create(DistributionConfig{})
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("expected a *ValidationError, got %#v", err)
+	}
+}
@@ -0,0 +1,521 @@
+// Package yaml is a minimal YAML encoding, with which the cloudfront
+// package exports distribution configs without depending on a YAML
+// package. Values go through their JSON encoding, so their json tags
+// apply, and are written in block style with every string quoted.
+// Unmarshal reads that style back, along with the comments, plain scalars
+// and flow sequences of scalars that hand edits may add; anchors, tags,
+// block scalars and nested flow collections are not supported.
+package yaml
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// mapping is a mapping, in the order of its keys.
+type mapping []keyValue
+
+type keyValue struct {
+	key   string
+	value interface{}
+}
+
+func (m mapping) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, pair := range m {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := json.Marshal(pair.key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(pair.value)
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// Marshal returns the YAML encoding of v, which must encode to a JSON
+// object or array.
+func Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	node, err := decodeJSONNode(d)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	switch node := node.(type) {
+	case mapping:
+		err = writeMapping(&b, node, 0)
+	case []interface{}:
+		err = writeSeq(&b, node, 0)
+	default:
+		return nil, fmt.Errorf("yaml: can't encode %T as a document", v)
+	}
+	return b.Bytes(), err
+}
+
+// Unmarshal decodes the YAML document in data into v, as if it were
+// the equivalent JSON document.
+func Unmarshal(data []byte, v interface{}) error {
+	p := &parser{}
+	if err := p.split(data); err != nil {
+		return err
+	}
+	if len(p.lines) == 0 {
+		return fmt.Errorf("yaml: empty document")
+	}
+	node, err := p.parseBlock(p.lines[0].indent)
+	if err != nil {
+		return err
+	}
+	if p.pos < len(p.lines) {
+		return p.errorf("unexpected indentation")
+	}
+	data, err = json.Marshal(node)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// decodeJSONNode reads the next value of d, keeping the order of the keys
+// of objects.
+func decodeJSONNode(d *json.Decoder) (interface{}, error) {
+	tok, err := d.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		m := mapping{}
+		for d.More() {
+			key, err := d.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeJSONNode(d)
+			if err != nil {
+				return nil, err
+			}
+			m = append(m, keyValue{key.(string), value})
+		}
+		_, err = d.Token()
+		return m, err
+	case json.Delim('['):
+		seq := []interface{}{}
+		for d.More() {
+			value, err := decodeJSONNode(d)
+			if err != nil {
+				return nil, err
+			}
+			seq = append(seq, value)
+		}
+		_, err = d.Token()
+		return seq, err
+	}
+	return tok, nil
+}
+
+func writeMapping(b *bytes.Buffer, m mapping, indent int) error {
+	for _, pair := range m {
+		b.WriteString(strings.Repeat(" ", indent))
+		if plainKey.MatchString(pair.key) {
+			b.WriteString(pair.key)
+		} else if err := writeString(b, pair.key); err != nil {
+			return err
+		}
+		b.WriteByte(':')
+		if err := writeValue(b, pair.value, indent+2); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeSeq(b *bytes.Buffer, seq []interface{}, indent int) error {
+	for _, item := range seq {
+		if m, ok := item.(mapping); ok && len(m) > 0 {
+			// The first key goes on the line of the dash.
+			var item bytes.Buffer
+			if err := writeMapping(&item, m, indent+2); err != nil {
+				return err
+			}
+			b.WriteString(strings.Repeat(" ", indent))
+			b.WriteString("- ")
+			b.Write(item.Bytes()[indent+2:])
+			continue
+		}
+		b.WriteString(strings.Repeat(" ", indent))
+		b.WriteByte('-')
+		if err := writeValue(b, item, indent+2); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeValue writes v after a key or a dash, with the collections it
+// holds at indent.
+func writeValue(b *bytes.Buffer, v interface{}, indent int) error {
+	switch v := v.(type) {
+	case mapping:
+		if len(v) > 0 {
+			b.WriteByte('\n')
+			return writeMapping(b, v, indent)
+		}
+		b.WriteString(" {}")
+	case []interface{}:
+		if len(v) > 0 {
+			b.WriteByte('\n')
+			return writeSeq(b, v, indent)
+		}
+		b.WriteString(" []")
+	case string:
+		b.WriteByte(' ')
+		if err := writeString(b, v); err != nil {
+			return err
+		}
+	case json.Number:
+		b.WriteString(" " + v.String())
+	case bool:
+		fmt.Fprintf(b, " %t", v)
+	case nil:
+		b.WriteString(" null")
+	default:
+		return fmt.Errorf("yaml: can't encode %T", v)
+	}
+	b.WriteByte('\n')
+	return nil
+}
+
+// writeString writes s double-quoted. The escapes of JSON strings are
+// valid in YAML double-quoted scalars.
+func writeString(b *bytes.Buffer, s string) error {
+	var quoted bytes.Buffer
+	enc := json.NewEncoder(&quoted)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	b.Write(bytes.TrimSuffix(quoted.Bytes(), []byte("\n")))
+	return nil
+}
+
+var (
+	plainKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+	number   = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+	// The characters that start YAML syntax the decoder doesn't support.
+	indicators = "&*!|>%@`{"
+)
+
+type sourceLine struct {
+	num    int // line number, from 1
+	indent int
+	text   string
+}
+
+type parser struct {
+	lines []sourceLine
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	num := 0
+	if p.pos < len(p.lines) {
+		num = p.lines[p.pos].num
+	} else if len(p.lines) > 0 {
+		num = p.lines[len(p.lines)-1].num
+	}
+	return fmt.Errorf("yaml: line %d: %s", num, fmt.Sprintf(format, args...))
+}
+
+// split reads the lines of data that hold content, without comments.
+func (p *parser) split(data []byte) error {
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimRight(stripComment(strings.TrimSuffix(line, "\r")), " \t")
+		text := strings.TrimLeft(line, " ")
+		if text == "" || (len(p.lines) == 0 && text == "---") {
+			continue
+		}
+		if text[0] == '\t' {
+			return fmt.Errorf("yaml: line %d: tabs can't indent", i+1)
+		}
+		p.lines = append(p.lines, sourceLine{i + 1, len(line) - len(text), text})
+	}
+	return nil
+}
+
+// stripComment removes the comment at the end of line, if any.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.IndexByte(" \t[,", line[i-1]) >= 0):
+			// Quotes only start a scalar, as in key: "value".
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+func isSeqItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseBlock parses the mapping or sequence at the current line.
+func (p *parser) parseBlock(indent int) (interface{}, error) {
+	if isSeqItem(p.lines[p.pos].text) {
+		return p.parseSeq(indent)
+	}
+	return p.parseMap(indent)
+}
+
+func (p *parser) parseMap(indent int) (interface{}, error) {
+	m := mapping{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && !isSeqItem(p.lines[p.pos].text) {
+		key, rest, ok := splitKey(p.lines[p.pos].text)
+		if !ok {
+			return nil, p.errorf("expected a key")
+		}
+		for _, pair := range m {
+			if pair.key == key {
+				return nil, p.errorf("duplicate key %q", key)
+			}
+		}
+		var value interface{}
+		var err error
+		if rest == "" {
+			p.pos++
+			if value, err = p.parseNested(indent, true); err != nil {
+				return nil, err
+			}
+		} else {
+			if value, err = parseScalar(rest); err != nil {
+				return nil, p.errorf("%v", err)
+			}
+			p.pos++
+		}
+		m = append(m, keyValue{key, value})
+	}
+	if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+		return nil, p.errorf("unexpected indentation")
+	}
+	return m, nil
+}
+
+func (p *parser) parseSeq(indent int) (interface{}, error) {
+	seq := []interface{}{}
+	for p.pos < len(p.lines) && p.lines[p.pos].indent == indent && isSeqItem(p.lines[p.pos].text) {
+		line := p.lines[p.pos]
+		rest := strings.TrimLeft(strings.TrimPrefix(line.text, "-"), " ")
+		var value interface{}
+		var err error
+		switch {
+		case rest == "":
+			p.pos++
+			value, err = p.parseNested(indent, false)
+		case isMapEntry(rest):
+			// A mapping starting on the line of the dash: parse it from
+			// the column of its first key.
+			col := indent + len(line.text) - len(rest)
+			p.lines[p.pos] = sourceLine{line.num, col, rest}
+			value, err = p.parseMap(col)
+		default:
+			value, err = parseScalar(rest)
+			if err != nil {
+				return nil, p.errorf("%v", err)
+			}
+			p.pos++
+		}
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, value)
+	}
+	if p.pos < len(p.lines) && p.lines[p.pos].indent > indent {
+		return nil, p.errorf("unexpected indentation")
+	}
+	return seq, nil
+}
+
+// parseNested parses the value of a key or dash left empty on its line:
+// the block indented below it, if any. The sequence of a key may also be
+// at the indentation of the key.
+func (p *parser) parseNested(indent int, key bool) (interface{}, error) {
+	if p.pos == len(p.lines) {
+		return nil, nil
+	}
+	next := p.lines[p.pos]
+	switch {
+	case next.indent > indent:
+		return p.parseBlock(next.indent)
+	case key && next.indent == indent && isSeqItem(next.text):
+		return p.parseSeq(indent)
+	}
+	return nil, nil
+}
+
+func isMapEntry(text string) bool {
+	_, _, ok := splitKey(text)
+	return ok
+}
+
+// splitKey splits a "key: value" line into its key and value.
+func splitKey(text string) (key, rest string, ok bool) {
+	var end int
+	switch text[0] {
+	case '"', '\'':
+		end = quotedEnd(text)
+		if end < 0 {
+			return "", "", false
+		}
+		k, err := parseScalar(text[:end])
+		if err != nil {
+			return "", "", false
+		}
+		key = k.(string)
+		if !strings.HasPrefix(text[end:], ":") {
+			return "", "", false
+		}
+	default:
+		end = -1
+		for i := 1; i < len(text); i++ {
+			if text[i] == ':' && (i+1 == len(text) || text[i+1] == ' ') {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return "", "", false
+		}
+		key = strings.TrimRight(text[:end], " ")
+	}
+	rest = text[end+1:]
+	if rest != "" && rest[0] != ' ' {
+		return "", "", false
+	}
+	return key, strings.TrimSpace(rest), true
+}
+
+// quotedEnd returns the index following the quoted scalar text starts
+// with, or -1 if it is not terminated.
+func quotedEnd(text string) int {
+	quote := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case quote == '"' && text[i] == '\\':
+			i++
+		case text[i] == quote:
+			if quote == '\'' && i+1 < len(text) && text[i+1] == '\'' {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return -1
+}
+
+func parseScalar(s string) (interface{}, error) {
+	switch {
+	case s[0] == '"' || s[0] == '\'':
+		if quotedEnd(s) != len(s) {
+			return nil, fmt.Errorf("invalid quoted scalar %s", s)
+		}
+		if s[0] == '\'' {
+			return strings.Replace(s[1:len(s)-1], "''", "'", -1), nil
+		}
+		var str string
+		if err := json.Unmarshal([]byte(s), &str); err != nil {
+			return nil, fmt.Errorf("unsupported escape in %s", s)
+		}
+		return str, nil
+	case s[0] == '[':
+		return parseFlowSeq(s)
+	case s == "{}":
+		return mapping{}, nil
+	case strings.IndexByte(indicators, s[0]) >= 0:
+		return nil, fmt.Errorf("unsupported syntax %s", s)
+	}
+	switch s {
+	case "null", "Null", "NULL", "~":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if number.MatchString(s) {
+		return json.Number(s), nil
+	}
+	return s, nil
+}
+
+// parseFlowSeq parses a flow sequence of scalars, such as [a, "b"].
+func parseFlowSeq(s string) (interface{}, error) {
+	if !strings.HasSuffix(s, "]") {
+		return nil, fmt.Errorf("unterminated flow sequence %s", s)
+	}
+	seq := []interface{}{}
+	inner := strings.TrimSpace(s[1 : len(s)-1])
+	for inner != "" {
+		end := strings.IndexByte(inner, ',')
+		if inner[0] == '"' || inner[0] == '\'' {
+			end = quotedEnd(inner)
+			if end < 0 {
+				return nil, fmt.Errorf("invalid quoted scalar in %s", s)
+			}
+			if rest := strings.TrimLeft(inner[end:], " "); rest != "" && rest[0] != ',' {
+				return nil, fmt.Errorf("invalid flow sequence %s", s)
+			}
+			end += strings.IndexByte(inner[end:]+",", ',')
+		}
+		if end < 0 {
+			end = len(inner)
+		}
+		item := strings.TrimSpace(inner[:end])
+		if item == "" || item[0] == '[' || item[0] == '{' {
+			return nil, fmt.Errorf("unsupported flow sequence %s", s)
+		}
+		value, err := parseScalar(item)
+		if err != nil {
+			return nil, err
+		}
+		seq = append(seq, value)
+		if end == len(inner) {
+			break
+		}
+		inner = strings.TrimSpace(inner[end+1:])
+		if inner == "" {
+			return nil, fmt.Errorf("invalid flow sequence %s", s)
+		}
+	}
+	return seq, nil
+}
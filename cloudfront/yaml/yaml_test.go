@@ -0,0 +1,123 @@
+package yaml
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testValue struct {
+	Name    string
+	Count   int
+	Enabled bool
+	Tags    []string
+	Items   []testItem
+	Empty   map[string]string
+	Extra   *string
+	Nested  [][]int
+}
+
+type testItem struct {
+	Id   string
+	Port int
+}
+
+func TestRoundTrip(t *testing.T) {
+	v := testValue{
+		Name:    "a \"quoted\" # name: with\nlines",
+		Count:   3,
+		Enabled: true,
+		Tags:    []string{"true", "42", "- dash"},
+		Items:   []testItem{{"app", 80}, {"it's", 443}},
+		Empty:   map[string]string{},
+		Nested:  [][]int{{1, 2}, {}},
+	}
+	data, err := Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `Name: "a \"quoted\" # name: with\nlines"
+Count: 3
+Enabled: true
+Tags:
+  - "true"
+  - "42"
+  - "- dash"
+Items:
+  - Id: "app"
+    Port: 80
+  - Id: "it's"
+    Port: 443
+Empty: {}
+Extra: null
+Nested:
+  -
+    - 1
+    - 2
+  - []
+`
+	if string(data) != want {
+		t.Errorf("unexpected YAML:\n%s", data)
+	}
+
+	var decoded testValue
+	if err := Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, v) {
+		t.Errorf("unexpected value: %#v", decoded)
+	}
+}
+
+func TestUnmarshalHandEdited(t *testing.T) {
+	data := `---
+# A hand-edited document.
+Name: it's plain   # trailing comment
+Count: 7
+Enabled: True
+Tags: [a, "b, c", 'd''s']
+Items:
+- Id: 'app'
+  Port: 8080
+
+-   Id: api
+    Port: 9090
+Extra: ~
+`
+	var v testValue
+	if err := Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	want := testValue{
+		Name:    "it's plain",
+		Count:   7,
+		Enabled: true,
+		Tags:    []string{"a", "b, c", "d's"},
+		Items:   []testItem{{"app", 8080}, {"api", 9090}},
+	}
+	if !reflect.DeepEqual(v, want) {
+		t.Errorf("unexpected value: %#v", v)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		data string
+		err  string
+	}{
+		{"", "yaml: empty document"},
+		{"Name: a\n  Count: 1\n", "yaml: line 2: unexpected indentation"},
+		{"Name: a\nName: b\n", `yaml: line 2: duplicate key "Name"`},
+		{"Name: &anchor a\n", "yaml: line 1: unsupported syntax &anchor a"},
+		{"Tags: [[a]]\n", "yaml: line 1: unsupported flow sequence [[a]]"},
+		{"Name: \"open\n", `yaml: line 1: invalid quoted scalar "open`},
+		{"just a scalar\n", "yaml: line 1: expected a key"},
+		{"Count: many\n", "json: cannot unmarshal string into Go struct field testValue.Count of type int"},
+	}
+	for _, test := range tests {
+		var v testValue
+		err := Unmarshal([]byte(test.data), &v)
+		if err == nil || err.Error() != test.err {
+			t.Errorf("%q: expected error %q, got %v", test.data, test.err, err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package cloudwatch
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// States of an alarm.
+const (
+	AlarmStateOK               = "OK"
+	AlarmStateAlarm            = "ALARM"
+	AlarmStateInsufficientData = "INSUFFICIENT_DATA"
+)
+
+// Values of MetricAlarm.TreatMissingData.
+const (
+	TreatMissingDataBreaching    = "breaching"
+	TreatMissingDataNotBreaching = "notBreaching"
+	TreatMissingDataIgnore       = "ignore"
+	TreatMissingDataMissing      = "missing"
+)
+
+// Alarm is a metric alarm as returned by DescribeAlarms, including its
+// current state.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_MetricAlarm.html
+type Alarm struct {
+	AlarmArn                string
+	AlarmName               string
+	AlarmDescription        string
+	ActionsEnabled          bool
+	AlarmActions            []string `xml:"AlarmActions>member"`
+	OKActions               []string `xml:"OKActions>member"`
+	InsufficientDataActions []string `xml:"InsufficientDataActions>member"`
+	ComparisonOperator      string
+	DatapointsToAlarm       int
+	Dimensions              []Dimension `xml:"Dimensions>member"`
+	EvaluationPeriods       int
+	MetricName              string
+	Namespace               string
+	Period                  int
+	Statistic               string
+	ExtendedStatistic       string
+	Threshold               float64
+	TreatMissingData        string
+	Unit                    string
+	StateValue              string
+	StateReason             string
+	StateUpdatedTimestamp   time.Time
+}
+
+// DescribeAlarmsRequest selects the alarms to describe. AlarmNames and
+// AlarmNamePrefix can't both be set. An empty request describes every
+// alarm.
+type DescribeAlarmsRequest struct {
+	AlarmNames      []string
+	AlarmNamePrefix string
+	StateValue      string
+	ActionPrefix    string
+	MaxRecords      int
+	NextToken       string
+}
+
+type DescribeAlarmsResult struct {
+	MetricAlarms []Alarm `xml:"MetricAlarms>member"`
+	NextToken    string
+}
+
+type DescribeAlarmsResponse struct {
+	DescribeAlarmsResult DescribeAlarmsResult
+	ResponseMetadata     aws.ResponseMetadata
+}
+
+// DescribeAlarms fetches a page of alarms. If the response has a
+// NextToken, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_DescribeAlarms.html
+func (c *CloudWatch) DescribeAlarms(req *DescribeAlarmsRequest) (result *DescribeAlarmsResponse, err error) {
+	if len(req.AlarmNames) > 0 && req.AlarmNamePrefix != "" {
+		err = errors.New("AlarmNames and AlarmNamePrefix can't both be supplied")
+		return
+	}
+
+	params := aws.MakeParams("DescribeAlarms")
+	for i, name := range req.AlarmNames {
+		params["AlarmNames.member."+strconv.Itoa(i+1)] = name
+	}
+	if req.AlarmNamePrefix != "" {
+		params["AlarmNamePrefix"] = req.AlarmNamePrefix
+	}
+	if req.StateValue != "" {
+		params["StateValue"] = req.StateValue
+	}
+	if req.ActionPrefix != "" {
+		params["ActionPrefix"] = req.ActionPrefix
+	}
+	if req.MaxRecords > 0 {
+		params["MaxRecords"] = strconv.Itoa(req.MaxRecords)
+	}
+	if req.NextToken != "" {
+		params["NextToken"] = req.NextToken
+	}
+
+	result = new(DescribeAlarmsResponse)
+	err = c.query("GET", "/", params, result)
+	return
+}
+
+// DeleteAlarms deletes up to 100 alarms by name.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_DeleteAlarms.html
+func (c *CloudWatch) DeleteAlarms(names ...string) (result *aws.BaseResponse, err error) {
+	if len(names) == 0 {
+		err = errors.New("No AlarmNames supplied")
+		return
+	}
+
+	params := aws.MakeParams("DeleteAlarms")
+	for i, name := range names {
+		params["AlarmNames.member."+strconv.Itoa(i+1)] = name
+	}
+
+	result = new(aws.BaseResponse)
+	err = c.query("POST", "/", params, result)
+	return
+}
+
+// SetAlarmState temporarily sets the state of an alarm, which is useful to
+// test its actions. The alarm goes back to its actual state the next time
+// it is evaluated. reasonData is an optional JSON document.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_SetAlarmState.html
+func (c *CloudWatch) SetAlarmState(name, state, reason, reasonData string) (result *aws.BaseResponse, err error) {
+	switch {
+	case name == "":
+		err = errors.New("No AlarmName supplied")
+	case state != AlarmStateOK && state != AlarmStateAlarm && state != AlarmStateInsufficientData:
+		err = errors.New("StateValue is not valid")
+	case reason == "":
+		err = errors.New("No StateReason supplied")
+	}
+	if err != nil {
+		return
+	}
+
+	params := aws.MakeParams("SetAlarmState")
+	params["AlarmName"] = name
+	params["StateValue"] = state
+	params["StateReason"] = reason
+	if reasonData != "" {
+		params["StateReasonData"] = reasonData
+	}
+
+	result = new(aws.BaseResponse)
+	err = c.query("POST", "/", params, result)
+	return
+}
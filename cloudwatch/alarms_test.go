@@ -0,0 +1,130 @@
+package cloudwatch_test
+
+import (
+	"github.com/zackbloom/goamz/cloudwatch"
+	"gopkg.in/check.v1"
+)
+
+var describeAlarmsResponse = `
+<DescribeAlarmsResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <DescribeAlarmsResult>
+    <MetricAlarms>
+      <member>
+        <AlarmArn>arn:aws:cloudwatch:us-east-1:123456789012:alarm:cdn-5xx</AlarmArn>
+        <AlarmName>cdn-5xx</AlarmName>
+        <ActionsEnabled>true</ActionsEnabled>
+        <AlarmActions>
+          <member>arn:aws:sns:us-east-1:123456789012:oncall</member>
+        </AlarmActions>
+        <OKActions/>
+        <ComparisonOperator>GreaterThanThreshold</ComparisonOperator>
+        <DatapointsToAlarm>2</DatapointsToAlarm>
+        <Dimensions>
+          <member>
+            <Name>DistributionId</Name>
+            <Value>E1ABCDEF</Value>
+          </member>
+          <member>
+            <Name>Region</Name>
+            <Value>Global</Value>
+          </member>
+        </Dimensions>
+        <EvaluationPeriods>3</EvaluationPeriods>
+        <MetricName>5xxErrorRate</MetricName>
+        <Namespace>AWS/CloudFront</Namespace>
+        <Period>300</Period>
+        <Statistic>Average</Statistic>
+        <Threshold>1.5</Threshold>
+        <TreatMissingData>notBreaching</TreatMissingData>
+        <StateValue>ALARM</StateValue>
+        <StateReason>Threshold Crossed</StateReason>
+        <StateUpdatedTimestamp>2017-03-01T10:05:00.000Z</StateUpdatedTimestamp>
+      </member>
+    </MetricAlarms>
+    <NextToken>next</NextToken>
+  </DescribeAlarmsResult>
+  <ResponseMetadata>
+    <RequestId>123</RequestId>
+  </ResponseMetadata>
+</DescribeAlarmsResponse>`
+
+func (s *S) TestPutAlarmDatapointsToAlarm(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	alarm := getTestAlarm()
+	alarm.DatapointsToAlarm = 2
+	alarm.TreatMissingData = cloudwatch.TreatMissingDataNotBreaching
+	_, err := s.cw.PutMetricAlarm(alarm)
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("DatapointsToAlarm"), check.Equals, "2")
+	c.Assert(req.Form.Get("TreatMissingData"), check.Equals, "notBreaching")
+}
+
+func (s *S) TestDescribeAlarms(c *check.C) {
+	testServer.Response(200, nil, describeAlarmsResponse)
+
+	resp, err := s.cw.DescribeAlarms(&cloudwatch.DescribeAlarmsRequest{
+		AlarmNamePrefix: "cdn-",
+		StateValue:      cloudwatch.AlarmStateAlarm,
+		MaxRecords:      10,
+	})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.Form.Get("Action"), check.Equals, "DescribeAlarms")
+	c.Assert(req.Form.Get("AlarmNamePrefix"), check.Equals, "cdn-")
+	c.Assert(req.Form.Get("StateValue"), check.Equals, "ALARM")
+	c.Assert(req.Form.Get("MaxRecords"), check.Equals, "10")
+
+	result := resp.DescribeAlarmsResult
+	c.Assert(result.NextToken, check.Equals, "next")
+	c.Assert(result.MetricAlarms, check.HasLen, 1)
+	alarm := result.MetricAlarms[0]
+	c.Assert(alarm.AlarmName, check.Equals, "cdn-5xx")
+	c.Assert(alarm.ActionsEnabled, check.Equals, true)
+	c.Assert(alarm.AlarmActions, check.DeepEquals, []string{"arn:aws:sns:us-east-1:123456789012:oncall"})
+	c.Assert(alarm.OKActions, check.HasLen, 0)
+	c.Assert(alarm.Dimensions, check.DeepEquals, []cloudwatch.Dimension{{"DistributionId", "E1ABCDEF"}, {"Region", "Global"}})
+	c.Assert(alarm.Threshold, check.Equals, 1.5)
+	c.Assert(alarm.DatapointsToAlarm, check.Equals, 2)
+	c.Assert(alarm.StateValue, check.Equals, cloudwatch.AlarmStateAlarm)
+	c.Assert(alarm.StateUpdatedTimestamp.IsZero(), check.Equals, false)
+}
+
+func (s *S) TestDescribeAlarmsNamesAndPrefix(c *check.C) {
+	_, err := s.cw.DescribeAlarms(&cloudwatch.DescribeAlarmsRequest{AlarmNames: []string{"a"}, AlarmNamePrefix: "b"})
+	c.Assert(err, check.NotNil)
+}
+
+func (s *S) TestDeleteAlarms(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	_, err := s.cw.DeleteAlarms("cdn-4xx", "cdn-5xx")
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("Action"), check.Equals, "DeleteAlarms")
+	c.Assert(req.Form.Get("AlarmNames.member.1"), check.Equals, "cdn-4xx")
+	c.Assert(req.Form.Get("AlarmNames.member.2"), check.Equals, "cdn-5xx")
+}
+
+func (s *S) TestSetAlarmState(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	_, err := s.cw.SetAlarmState("cdn-5xx", cloudwatch.AlarmStateAlarm, "Testing the pager", "")
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("Action"), check.Equals, "SetAlarmState")
+	c.Assert(req.Form.Get("AlarmName"), check.Equals, "cdn-5xx")
+	c.Assert(req.Form.Get("StateValue"), check.Equals, "ALARM")
+	c.Assert(req.Form.Get("StateReason"), check.Equals, "Testing the pager")
+	_, ok := req.Form["StateReasonData"]
+	c.Assert(ok, check.Equals, false)
+
+	_, err = s.cw.SetAlarmState("cdn-5xx", "BROKEN", "Testing the pager", "")
+	c.Assert(err, check.ErrorMatches, "StateValue is not valid")
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"github.com/zackbloom/goamz/aws"
 	"github.com/feyeleanor/sets"
+	"net/http"
 	"strconv"
 	"time"
 )
@@ -27,6 +28,11 @@ import (
 // The CloudWatch type encapsulates all the CloudWatch operations in a region.
 type CloudWatch struct {
 	Service aws.AWSService
+
+	// If not 0, PutMetricData requests of at least this many bytes are
+	// sent gzipped, when Service is an aws.CompressingService.
+	// aws.DefaultMinCompressSize is a good value.
+	MinCompressSize int
 }
 
 type Dimension struct {
@@ -41,13 +47,17 @@ type StatisticSet struct {
 	Sum         float64
 }
 
+// MetricDatum is a data point of a metric. If StatisticValues is set, it
+// is sent instead of Value. StorageResolution is 1 for a high-resolution
+// metric, or 60 (the default when 0) for a standard one.
 type MetricDatum struct {
-	Dimensions      []Dimension
-	MetricName      string
-	StatisticValues *StatisticSet
-	Timestamp       time.Time
-	Unit            string
-	Value           float64
+	Dimensions        []Dimension
+	MetricName        string
+	StatisticValues   *StatisticSet
+	StorageResolution int
+	Timestamp         time.Time
+	Unit              string
+	Value             float64
 }
 
 type Datapoint struct {
@@ -108,11 +118,16 @@ type AlarmAction struct {
 	ARN string
 }
 
+// MetricAlarm is an alarm to create with PutMetricAlarm. The alarm goes
+// off when DatapointsToAlarm of the last EvaluationPeriods periods breach
+// the threshold; if DatapointsToAlarm is 0, all of them must.
+// TreatMissingData is one of the TreatMissingData constants.
 type MetricAlarm struct {
 	AlarmActions            []AlarmAction
 	AlarmDescription        string
 	AlarmName               string
 	ComparisonOperator      string
+	DatapointsToAlarm       int
 	Dimensions              []Dimension
 	EvaluationPeriods       int
 	InsufficientDataActions []AlarmAction
@@ -122,6 +137,7 @@ type MetricAlarm struct {
 	Period                  int
 	Statistic               string
 	Threshold               float64
+	TreatMissingData        string
 	Unit                    string
 }
 
@@ -225,6 +241,10 @@ const (
 	MetricNameCPUUtilization = "CPUUtilization"
 )
 
+// MaxMetricDataPerRequest is the number of data points CloudWatch accepts
+// in a single PutMetricData request.
+const MaxMetricDataPerRequest = 1000
+
 // Create a new CloudWatch object for a given namespace
 func NewCloudWatch(auth aws.Auth, region aws.ServiceInfo) (*CloudWatch, error) {
 	service, err := aws.NewService(auth, region)
@@ -237,10 +257,22 @@ func NewCloudWatch(auth aws.Auth, region aws.ServiceInfo) (*CloudWatch, error) {
 }
 
 func (c *CloudWatch) query(method, path string, params map[string]string, resp interface{}) error {
+	return c.queryCompressed(method, path, params, resp, 0)
+}
+
+// queryCompressed is like query but gzips requests of at least minSize
+// bytes if minSize is not 0.
+func (c *CloudWatch) queryCompressed(method, path string, params map[string]string, resp interface{}, minSize int) error {
 	// Add basic Cloudwatch param
 	params["Version"] = "2010-08-01"
 
-	r, err := c.Service.Query(method, path, params)
+	var r *http.Response
+	var err error
+	if service, ok := c.Service.(aws.CompressingService); ok && minSize > 0 {
+		r, err = service.QueryCompressed(method, path, params, minSize)
+	} else {
+		r, err = c.Service.Query(method, path, params)
+	}
 	if err != nil {
 		return err
 	}
@@ -352,11 +384,49 @@ func (c *CloudWatch) ListMetrics(req *ListMetricsRequest) (result *ListMetricsRe
 	return
 }
 
+// PutMetricData publishes metric data points without a namespace. See
+// PutMetricDataNamespace.
 func (c *CloudWatch) PutMetricData(metrics []MetricDatum) (result *aws.BaseResponse, err error) {
 	return c.PutMetricDataNamespace(metrics, "")
 }
 
+// PutMetricDataNamespace publishes metric data points in the given
+// namespace. Metrics are sent in requests of at most
+// MaxMetricDataPerRequest data points; if a request fails, the error is
+// returned and the remaining requests are not made. The result is the
+// response to the last request.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutMetricData.html for more details.
 func (c *CloudWatch) PutMetricDataNamespace(metrics []MetricDatum, namespace string) (result *aws.BaseResponse, err error) {
+	for i, metric := range metrics {
+		switch {
+		case metric.MetricName == "":
+			err = fmt.Errorf("No metric name supplied for metric: %d", i)
+		case metric.Unit != "" && !validUnits.Member(metric.Unit):
+			err = fmt.Errorf("Unit is not a valid value for metric: %d", i)
+		case metric.StorageResolution != 0 && metric.StorageResolution != 1 && metric.StorageResolution != 60:
+			err = fmt.Errorf("StorageResolution is not 1 or 60 for metric: %d", i)
+		}
+		if err != nil {
+			return
+		}
+	}
+
+	for len(metrics) > 0 {
+		n := len(metrics)
+		if n > MaxMetricDataPerRequest {
+			n = MaxMetricDataPerRequest
+		}
+		result, err = c.putMetricData(metrics[:n], namespace)
+		if err != nil {
+			return nil, err
+		}
+		metrics = metrics[n:]
+	}
+	return
+}
+
+func (c *CloudWatch) putMetricData(metrics []MetricDatum, namespace string) (result *aws.BaseResponse, err error) {
 	// Serialize the params
 	params := aws.MakeParams("PutMetricData")
 	if namespace != "" {
@@ -364,22 +434,23 @@ func (c *CloudWatch) PutMetricDataNamespace(metrics []MetricDatum, namespace str
 	}
 	for i, metric := range metrics {
 		prefix := "MetricData.member." + strconv.Itoa(i+1)
-		if metric.MetricName == "" {
-			err = fmt.Errorf("No metric name supplied for metric: %d", i)
-			return
-		}
 		params[prefix+".MetricName"] = metric.MetricName
 		if metric.Unit != "" {
 			params[prefix+".Unit"] = metric.Unit
 		}
-		if metric.Value != 0 {
-			params[prefix+".Value"] = strconv.FormatFloat(metric.Value, 'E', 10, 64)
-		} else {
-			params[prefix+".Value"] = "0"
+		if metric.StatisticValues == nil {
+			if metric.Value != 0 {
+				params[prefix+".Value"] = strconv.FormatFloat(metric.Value, 'E', 10, 64)
+			} else {
+				params[prefix+".Value"] = "0"
+			}
 		}
 		if !metric.Timestamp.IsZero() {
 			params[prefix+".Timestamp"] = metric.Timestamp.UTC().Format(time.RFC3339)
 		}
+		if metric.StorageResolution != 0 {
+			params[prefix+".StorageResolution"] = strconv.Itoa(metric.StorageResolution)
+		}
 		for j, dim := range metric.Dimensions {
 			dimprefix := prefix + ".Dimensions.member." + strconv.Itoa(j+1)
 			params[dimprefix+".Name"] = dim.Name
@@ -394,7 +465,7 @@ func (c *CloudWatch) PutMetricDataNamespace(metrics []MetricDatum, namespace str
 		}
 	}
 	result = new(aws.BaseResponse)
-	err = c.query("POST", "/", params, result)
+	err = c.queryCompressed("POST", "/", params, result, c.MinCompressSize)
 	return
 }
 
@@ -446,6 +517,12 @@ func (c *CloudWatch) PutMetricAlarm(alarm *MetricAlarm) (result *aws.BaseRespons
 		params[dimprefix+".Value"] = dim.Value
 	}
 	params["EvaluationPeriods"] = strconv.Itoa(alarm.EvaluationPeriods)
+	if alarm.DatapointsToAlarm != 0 {
+		params["DatapointsToAlarm"] = strconv.Itoa(alarm.DatapointsToAlarm)
+	}
+	if alarm.TreatMissingData != "" {
+		params["TreatMissingData"] = alarm.TreatMissingData
+	}
 	params["MetricName"] = alarm.MetricName
 	params["Namespace"] = alarm.Namespace
 	params["Period"] = strconv.Itoa(alarm.Period)
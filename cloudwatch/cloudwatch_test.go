@@ -1,10 +1,16 @@
 package cloudwatch_test
 
 import (
+	"compress/gzip"
+	"fmt"
 	"github.com/zackbloom/goamz/aws"
 	"github.com/zackbloom/goamz/cloudwatch"
 	"github.com/zackbloom/goamz/testutil"
 	"gopkg.in/check.v1"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -117,3 +123,109 @@ func (s *S) TestPutAlarmInvalidStatistic(c *check.C) {
 	c.Assert(err, check.NotNil)
 	c.Assert(err.Error(), check.Equals, "Invalid statistic value supplied")
 }
+
+func (s *S) TestPutMetricDataCompressed(c *check.C) {
+	var encoding string
+	var form url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding = r.Header.Get("Content-Encoding")
+		zr, err := gzip.NewReader(r.Body)
+		c.Assert(err, check.IsNil)
+		body, err := ioutil.ReadAll(zr)
+		c.Assert(err, check.IsNil)
+		form, err = url.ParseQuery(string(body))
+		c.Assert(err, check.IsNil)
+		fmt.Fprint(w, "<RequestId>123</RequestId>")
+	}))
+	defer server.Close()
+
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	cw, err := cloudwatch.NewCloudWatch(auth, aws.ServiceInfo{Endpoint: server.URL, Signer: aws.V2Signature})
+	c.Assert(err, check.IsNil)
+	cw.MinCompressSize = aws.DefaultMinCompressSize
+
+	metrics := make([]cloudwatch.MetricDatum, 200)
+	for i := range metrics {
+		metrics[i] = cloudwatch.MetricDatum{
+			MetricName: "RequestLatency",
+			Unit:       cloudwatch.UnitMilliseconds,
+			Value:      float64(i),
+			Dimensions: []cloudwatch.Dimension{{Name: "Endpoint", Value: "/v1/orders"}},
+		}
+	}
+	_, err = cw.PutMetricDataNamespace(metrics, "Shop")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(encoding, check.Equals, "gzip")
+	c.Assert(form.Get("Action"), check.Equals, "PutMetricData")
+	c.Assert(form.Get("Namespace"), check.Equals, "Shop")
+	c.Assert(form.Get("MetricData.member.200.Value"), check.Equals, "1.9900000000E+02")
+	c.Assert(form.Get("Signature"), check.Not(check.Equals), "")
+}
+
+func (s *S) TestPutMetricDataSmallNotCompressed(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+	s.cw.MinCompressSize = aws.DefaultMinCompressSize
+	defer func() { s.cw.MinCompressSize = 0 }()
+
+	_, err := s.cw.PutMetricData([]cloudwatch.MetricDatum{{MetricName: "Orders", Value: 1}})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Header.Get("Content-Encoding"), check.Equals, "")
+	c.Assert(req.Form.Get("MetricData.member.1.MetricName"), check.Equals, "Orders")
+}
+
+func (s *S) TestPutMetricDataBatches(c *check.C) {
+	for i := 0; i < 3; i++ {
+		testServer.Response(200, nil, "<RequestId>123</RequestId>")
+	}
+
+	metrics := make([]cloudwatch.MetricDatum, 2500)
+	for i := range metrics {
+		metrics[i] = cloudwatch.MetricDatum{MetricName: "Orders", Value: float64(i)}
+	}
+	_, err := s.cw.PutMetricDataNamespace(metrics, "Shop")
+	c.Assert(err, check.IsNil)
+
+	reqs := testServer.WaitRequests(3)
+	c.Assert(reqs[0].Form.Get("MetricData.member.1000.Value"), check.Equals, "9.9900000000E+02")
+	c.Assert(reqs[0].Form.Get("MetricData.member.1001.MetricName"), check.Equals, "")
+	c.Assert(reqs[1].Form.Get("MetricData.member.1.Value"), check.Equals, "1.0000000000E+03")
+	c.Assert(reqs[2].Form.Get("MetricData.member.500.Value"), check.Equals, "2.4990000000E+03")
+	c.Assert(reqs[2].Form.Get("MetricData.member.501.MetricName"), check.Equals, "")
+	for _, req := range reqs {
+		c.Assert(req.Form.Get("Namespace"), check.Equals, "Shop")
+	}
+}
+
+func (s *S) TestPutMetricDataStatisticSet(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	_, err := s.cw.PutMetricData([]cloudwatch.MetricDatum{{
+		MetricName:        "Latency",
+		Unit:              cloudwatch.UnitMilliseconds,
+		StorageResolution: 1,
+		Dimensions:        []cloudwatch.Dimension{{Name: "Endpoint", Value: "/v1/orders"}},
+		StatisticValues:   &cloudwatch.StatisticSet{Maximum: 20, Minimum: 1, SampleCount: 4, Sum: 30},
+	}})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("MetricData.member.1.Unit"), check.Equals, "Milliseconds")
+	c.Assert(req.Form.Get("MetricData.member.1.StorageResolution"), check.Equals, "1")
+	c.Assert(req.Form.Get("MetricData.member.1.Dimensions.member.1.Name"), check.Equals, "Endpoint")
+	c.Assert(req.Form.Get("MetricData.member.1.Dimensions.member.1.Value"), check.Equals, "/v1/orders")
+	c.Assert(req.Form.Get("MetricData.member.1.StatisticValues.SampleCount"), check.Equals, "4.0000000000E+00")
+	c.Assert(req.Form.Get("MetricData.member.1.StatisticValues.Sum"), check.Equals, "3.0000000000E+01")
+	_, ok := req.Form["MetricData.member.1.Value"]
+	c.Assert(ok, check.Equals, false)
+}
+
+func (s *S) TestPutMetricDataInvalid(c *check.C) {
+	_, err := s.cw.PutMetricData([]cloudwatch.MetricDatum{{MetricName: "Orders"}, {MetricName: "Orders", Unit: "Apples"}})
+	c.Assert(err, check.ErrorMatches, "Unit is not a valid value for metric: 1")
+
+	_, err = s.cw.PutMetricData([]cloudwatch.MetricDatum{{MetricName: "Orders", StorageResolution: 10}})
+	c.Assert(err, check.ErrorMatches, "StorageResolution is not 1 or 60 for metric: 0")
+}
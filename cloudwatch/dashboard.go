@@ -0,0 +1,173 @@
+package cloudwatch
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Dashboard widget layout. CloudWatch dashboards are 24 units wide; the
+// generated widgets are laid out two per row.
+const (
+	dashboardWidgetWidth  = 12
+	dashboardWidgetHeight = 6
+	dashboardColumns      = 24
+)
+
+// DashboardResources lists the resources a generated dashboard covers.
+type DashboardResources struct {
+	LoadBalancers []string // Classic ELB names
+	Distributions []string // CloudFront distribution IDs
+	Tables        []string // DynamoDB table names
+}
+
+// Dashboard is the body of a CloudWatch dashboard.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html
+type Dashboard struct {
+	Widgets []DashboardWidget `json:"widgets"`
+}
+
+type DashboardWidget struct {
+	Type       string                    `json:"type"`
+	X          int                       `json:"x"`
+	Y          int                       `json:"y"`
+	Width      int                       `json:"width"`
+	Height     int                       `json:"height"`
+	Properties DashboardWidgetProperties `json:"properties"`
+}
+
+// DashboardWidgetProperties holds the properties of a metric widget. Each
+// entry of Metrics is the namespace, the metric name, then pairs of
+// dimension name and value, optionally followed by a rendering map such as
+// {"stat": "Sum"}.
+type DashboardWidgetProperties struct {
+	Title   string          `json:"title"`
+	View    string          `json:"view"`
+	Stacked bool            `json:"stacked"`
+	Region  string          `json:"region"`
+	Stat    string          `json:"stat"`
+	Period  int             `json:"period"`
+	Metrics [][]interface{} `json:"metrics"`
+}
+
+type dashboardMetric struct {
+	namespace string
+	name      string
+	stat      string
+}
+
+var (
+	elbDashboardMetrics = []dashboardMetric{
+		{"AWS/ELB", "RequestCount", StatisticDatapointSum},
+		{"AWS/ELB", "Latency", StatisticDatapointAverage},
+		{"AWS/ELB", "HTTPCode_Backend_5XX", StatisticDatapointSum},
+		{"AWS/ELB", "UnHealthyHostCount", StatisticDatapointMaximum},
+	}
+	cloudFrontDashboardMetrics = []dashboardMetric{
+		{"AWS/CloudFront", "Requests", StatisticDatapointSum},
+		{"AWS/CloudFront", "BytesDownloaded", StatisticDatapointSum},
+		{"AWS/CloudFront", "4xxErrorRate", StatisticDatapointAverage},
+		{"AWS/CloudFront", "5xxErrorRate", StatisticDatapointAverage},
+	}
+	dynamoDBDashboardMetrics = []dashboardMetric{
+		{"AWS/DynamoDB", "ConsumedReadCapacityUnits", StatisticDatapointSum},
+		{"AWS/DynamoDB", "ConsumedWriteCapacityUnits", StatisticDatapointSum},
+		{"AWS/DynamoDB", "ReadThrottleEvents", StatisticDatapointSum},
+		{"AWS/DynamoDB", "WriteThrottleEvents", StatisticDatapointSum},
+	}
+)
+
+// NewDashboard generates a dashboard with a default set of widgets for each
+// of the resources. Metrics for load balancers and tables are read from
+// region; CloudFront metrics are always published in us-east-1.
+func NewDashboard(region string, resources DashboardResources) *Dashboard {
+	d := &Dashboard{}
+	for _, name := range resources.LoadBalancers {
+		d.addWidgets("ELB "+name, region, elbDashboardMetrics, "LoadBalancerName", name)
+	}
+	for _, id := range resources.Distributions {
+		d.addWidgets("CloudFront "+id, aws.USEast.Name, cloudFrontDashboardMetrics, "DistributionId", id, "Region", "Global")
+	}
+	for _, name := range resources.Tables {
+		d.addWidgets("DynamoDB "+name, region, dynamoDBDashboardMetrics, "TableName", name)
+	}
+	return d
+}
+
+func (d *Dashboard) addWidgets(title, region string, metrics []dashboardMetric, dimensions ...string) {
+	for _, m := range metrics {
+		metric := []interface{}{m.namespace, m.name}
+		for _, dim := range dimensions {
+			metric = append(metric, dim)
+		}
+		d.AddWidget(DashboardWidgetProperties{
+			Title:   title + " " + m.name,
+			View:    "timeSeries",
+			Region:  region,
+			Stat:    m.stat,
+			Period:  300,
+			Metrics: [][]interface{}{metric},
+		})
+	}
+}
+
+// AddWidget appends a metric widget to d, placing it after the last widget.
+func (d *Dashboard) AddWidget(properties DashboardWidgetProperties) {
+	n := len(d.Widgets)
+	perRow := dashboardColumns / dashboardWidgetWidth
+	d.Widgets = append(d.Widgets, DashboardWidget{
+		Type:       "metric",
+		X:          (n % perRow) * dashboardWidgetWidth,
+		Y:          (n / perRow) * dashboardWidgetHeight,
+		Width:      dashboardWidgetWidth,
+		Height:     dashboardWidgetHeight,
+		Properties: properties,
+	})
+}
+
+// Body returns the JSON document accepted by PutDashboard.
+func (d *Dashboard) Body() (string, error) {
+	b, err := json.Marshal(d)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+type DashboardValidationMessage struct {
+	DataPath string
+	Message  string
+}
+
+type PutDashboardResult struct {
+	DashboardValidationMessages []DashboardValidationMessage `xml:"DashboardValidationMessages>member"`
+}
+
+type PutDashboardResponse struct {
+	PutDashboardResult PutDashboardResult
+	ResponseMetadata   aws.ResponseMetadata
+}
+
+// PutDashboard creates or replaces the dashboard named name.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_PutDashboard.html
+func (c *CloudWatch) PutDashboard(name string, dashboard *Dashboard) (result *PutDashboardResponse, err error) {
+	if name == "" {
+		err = errors.New("No DashboardName supplied")
+		return
+	}
+	body, err := dashboard.Body()
+	if err != nil {
+		return
+	}
+
+	params := aws.MakeParams("PutDashboard")
+	params["DashboardName"] = name
+	params["DashboardBody"] = body
+
+	result = new(PutDashboardResponse)
+	err = c.query("POST", "/", params, result)
+	return
+}
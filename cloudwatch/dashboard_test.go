@@ -0,0 +1,78 @@
+package cloudwatch_test
+
+import (
+	"encoding/json"
+
+	"github.com/zackbloom/goamz/cloudwatch"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestNewDashboard(c *check.C) {
+	d := cloudwatch.NewDashboard("us-west-2", cloudwatch.DashboardResources{
+		LoadBalancers: []string{"web"},
+		Distributions: []string{"E1ABCDEF"},
+		Tables:        []string{"users"},
+	})
+	c.Assert(d.Widgets, check.HasLen, 12)
+
+	elb := d.Widgets[0]
+	c.Assert(elb.X, check.Equals, 0)
+	c.Assert(elb.Y, check.Equals, 0)
+	c.Assert(elb.Properties.Region, check.Equals, "us-west-2")
+	c.Assert(elb.Properties.Stat, check.Equals, "Sum")
+	c.Assert(elb.Properties.Metrics, check.DeepEquals, [][]interface{}{
+		{"AWS/ELB", "RequestCount", "LoadBalancerName", "web"},
+	})
+
+	c.Assert(d.Widgets[1].X, check.Equals, 12)
+	c.Assert(d.Widgets[1].Y, check.Equals, 0)
+	c.Assert(d.Widgets[2].X, check.Equals, 0)
+	c.Assert(d.Widgets[2].Y, check.Equals, 6)
+
+	cf := d.Widgets[4]
+	c.Assert(cf.Properties.Region, check.Equals, "us-east-1")
+	c.Assert(cf.Properties.Metrics, check.DeepEquals, [][]interface{}{
+		{"AWS/CloudFront", "Requests", "DistributionId", "E1ABCDEF", "Region", "Global"},
+	})
+
+	table := d.Widgets[8]
+	c.Assert(table.Properties.Metrics, check.DeepEquals, [][]interface{}{
+		{"AWS/DynamoDB", "ConsumedReadCapacityUnits", "TableName", "users"},
+	})
+}
+
+func (s *S) TestPutDashboard(c *check.C) {
+	testServer.Response(200, nil, PutDashboardResponse)
+
+	d := cloudwatch.NewDashboard("us-east-1", cloudwatch.DashboardResources{Tables: []string{"users"}})
+	resp, err := s.cw.PutDashboard("service", d)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.PutDashboardResult.DashboardValidationMessages, check.HasLen, 0)
+	c.Assert(resp.ResponseMetadata.RequestId, check.Equals, "3b4b1f6c-2d3e-11e7-8e2b-9d8ec0a1b2c3")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"PutDashboard"})
+	c.Assert(req.Form["DashboardName"], check.DeepEquals, []string{"service"})
+
+	var body cloudwatch.Dashboard
+	c.Assert(json.Unmarshal([]byte(req.Form.Get("DashboardBody")), &body), check.IsNil)
+	c.Assert(body.Widgets, check.HasLen, 4)
+	c.Assert(body.Widgets[3].Properties.Title, check.Equals, "DynamoDB users WriteThrottleEvents")
+}
+
+func (s *S) TestPutDashboardNoName(c *check.C) {
+	_, err := s.cw.PutDashboard("", &cloudwatch.Dashboard{})
+	c.Assert(err, check.ErrorMatches, "No DashboardName supplied")
+}
+
+var PutDashboardResponse = `
+<PutDashboardResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <PutDashboardResult>
+    <DashboardValidationMessages/>
+  </PutDashboardResult>
+  <ResponseMetadata>
+    <RequestId>3b4b1f6c-2d3e-11e7-8e2b-9d8ec0a1b2c3</RequestId>
+  </ResponseMetadata>
+</PutDashboardResponse>
+`
@@ -0,0 +1,178 @@
+package cloudwatch
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Values of GetMetricDataRequest.ScanBy.
+const (
+	ScanByTimestampAscending  = "TimestampAscending"
+	ScanByTimestampDescending = "TimestampDescending"
+)
+
+// MetricStat is a statistic of a metric over each Period seconds, such as
+// the "Average" or "p99" of a metric.
+type MetricStat struct {
+	Metric Metric
+	Period int
+	Stat   string
+	Unit   string
+}
+
+// MetricDataQuery is either a metric to retrieve, given by MetricStat, or a
+// metric math Expression over the other queries, referring to them by Id.
+// Ids start with a lowercase letter. Set ReturnData to false for the
+// queries that are only used in expressions.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_MetricDataQuery.html
+type MetricDataQuery struct {
+	Id         string
+	MetricStat *MetricStat
+	Expression string
+	Label      string
+	Period     int
+	ReturnData *bool
+}
+
+type GetMetricDataRequest struct {
+	MetricDataQueries []MetricDataQuery
+	StartTime         time.Time
+	EndTime           time.Time
+	ScanBy            string
+	MaxDatapoints     int
+	NextToken         string
+}
+
+type MessageData struct {
+	Code  string
+	Value string
+}
+
+// MetricDataResult holds the data points of a MetricDataQuery. StatusCode
+// is "Complete", or "PartialData" if there are more data points to get
+// with the NextToken of the response.
+type MetricDataResult struct {
+	Id         string
+	Label      string
+	StatusCode string
+	Timestamps []time.Time   `xml:"Timestamps>member"`
+	Values     []float64     `xml:"Values>member"`
+	Messages   []MessageData `xml:"Messages>member"`
+}
+
+type GetMetricDataResult struct {
+	MetricDataResults []MetricDataResult `xml:"MetricDataResults>member"`
+	Messages          []MessageData      `xml:"Messages>member"`
+	NextToken         string
+}
+
+type GetMetricDataResponse struct {
+	GetMetricDataResult GetMetricDataResult
+	ResponseMetadata    aws.ResponseMetadata
+}
+
+// GetMetricData retrieves a page of the data points of up to 500 metrics
+// and metric math expressions at once.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/API_GetMetricData.html
+func (c *CloudWatch) GetMetricData(req *GetMetricDataRequest) (result *GetMetricDataResponse, err error) {
+	switch {
+	case req.EndTime.IsZero():
+		err = errors.New("No endTime specified")
+	case req.StartTime.IsZero():
+		err = errors.New("No startTime specified")
+	case len(req.MetricDataQueries) == 0:
+		err = errors.New("No MetricDataQueries supplied")
+	}
+	if err != nil {
+		return
+	}
+
+	params := aws.MakeParams("GetMetricData")
+	params["StartTime"] = req.StartTime.UTC().Format(time.RFC3339)
+	params["EndTime"] = req.EndTime.UTC().Format(time.RFC3339)
+	if req.ScanBy != "" {
+		params["ScanBy"] = req.ScanBy
+	}
+	if req.MaxDatapoints > 0 {
+		params["MaxDatapoints"] = strconv.Itoa(req.MaxDatapoints)
+	}
+	if req.NextToken != "" {
+		params["NextToken"] = req.NextToken
+	}
+	for i, q := range req.MetricDataQueries {
+		prefix := "MetricDataQueries.member." + strconv.Itoa(i+1)
+		if q.Id == "" {
+			err = errors.New("No Id supplied for query: " + strconv.Itoa(i))
+			return
+		}
+		params[prefix+".Id"] = q.Id
+		if q.Expression != "" {
+			params[prefix+".Expression"] = q.Expression
+		}
+		if q.Label != "" {
+			params[prefix+".Label"] = q.Label
+		}
+		if q.Period != 0 {
+			params[prefix+".Period"] = strconv.Itoa(q.Period)
+		}
+		if q.ReturnData != nil {
+			params[prefix+".ReturnData"] = strconv.FormatBool(*q.ReturnData)
+		}
+		if q.MetricStat != nil {
+			statprefix := prefix + ".MetricStat"
+			params[statprefix+".Metric.Namespace"] = q.MetricStat.Metric.Namespace
+			params[statprefix+".Metric.MetricName"] = q.MetricStat.Metric.MetricName
+			for j, dim := range q.MetricStat.Metric.Dimensions {
+				dimprefix := statprefix + ".Metric.Dimensions.member." + strconv.Itoa(j+1)
+				params[dimprefix+".Name"] = dim.Name
+				params[dimprefix+".Value"] = dim.Value
+			}
+			params[statprefix+".Period"] = strconv.Itoa(q.MetricStat.Period)
+			params[statprefix+".Stat"] = q.MetricStat.Stat
+			if q.MetricStat.Unit != "" {
+				params[statprefix+".Unit"] = q.MetricStat.Unit
+			}
+		}
+	}
+
+	result = new(GetMetricDataResponse)
+	err = c.query("POST", "/", params, result)
+	return
+}
+
+// GetAllMetricData is like GetMetricData, but follows NextToken until every
+// data point is retrieved. The data points of each query are merged into a
+// single MetricDataResult.
+func (c *CloudWatch) GetAllMetricData(req *GetMetricDataRequest) ([]MetricDataResult, error) {
+	page := *req
+	var results []MetricDataResult
+	index := make(map[string]int)
+	for {
+		resp, err := c.GetMetricData(&page)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resp.GetMetricDataResult.MetricDataResults {
+			i, ok := index[r.Id]
+			if !ok {
+				index[r.Id] = len(results)
+				results = append(results, r)
+				continue
+			}
+			merged := &results[i]
+			merged.StatusCode = r.StatusCode
+			merged.Timestamps = append(merged.Timestamps, r.Timestamps...)
+			merged.Values = append(merged.Values, r.Values...)
+			merged.Messages = append(merged.Messages, r.Messages...)
+		}
+		if resp.GetMetricDataResult.NextToken == "" {
+			return results, nil
+		}
+		page.NextToken = resp.GetMetricDataResult.NextToken
+	}
+}
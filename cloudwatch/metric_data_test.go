@@ -0,0 +1,131 @@
+package cloudwatch_test
+
+import (
+	"time"
+
+	"github.com/zackbloom/goamz/cloudwatch"
+	"gopkg.in/check.v1"
+)
+
+var getMetricDataPage1 = `
+<GetMetricDataResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <GetMetricDataResult>
+    <MetricDataResults>
+      <member>
+        <Id>errors</Id>
+        <Label>5xx error rate</Label>
+        <StatusCode>PartialData</StatusCode>
+        <Timestamps>
+          <member>2017-03-01T10:05:00Z</member>
+          <member>2017-03-01T10:00:00Z</member>
+        </Timestamps>
+        <Values>
+          <member>0.5</member>
+          <member>1.25</member>
+        </Values>
+      </member>
+    </MetricDataResults>
+    <NextToken>page2</NextToken>
+  </GetMetricDataResult>
+  <ResponseMetadata>
+    <RequestId>123</RequestId>
+  </ResponseMetadata>
+</GetMetricDataResponse>`
+
+var getMetricDataPage2 = `
+<GetMetricDataResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <GetMetricDataResult>
+    <MetricDataResults>
+      <member>
+        <Id>errors</Id>
+        <Label>5xx error rate</Label>
+        <StatusCode>Complete</StatusCode>
+        <Timestamps>
+          <member>2017-03-01T09:55:00Z</member>
+        </Timestamps>
+        <Values>
+          <member>0</member>
+        </Values>
+      </member>
+    </MetricDataResults>
+  </GetMetricDataResult>
+  <ResponseMetadata>
+    <RequestId>124</RequestId>
+  </ResponseMetadata>
+</GetMetricDataResponse>`
+
+func (s *S) TestGetMetricData(c *check.C) {
+	testServer.Response(200, nil, getMetricDataPage1)
+
+	returnData := false
+	end := time.Date(2017, 3, 1, 10, 10, 0, 0, time.UTC)
+	resp, err := s.cw.GetMetricData(&cloudwatch.GetMetricDataRequest{
+		StartTime: end.Add(-time.Hour),
+		EndTime:   end,
+		ScanBy:    cloudwatch.ScanByTimestampDescending,
+		MetricDataQueries: []cloudwatch.MetricDataQuery{
+			{
+				Id: "rate",
+				MetricStat: &cloudwatch.MetricStat{
+					Metric: cloudwatch.Metric{
+						Namespace:  "AWS/CloudFront",
+						MetricName: "5xxErrorRate",
+						Dimensions: []cloudwatch.Dimension{{"DistributionId", "E1ABCDEF"}, {"Region", "Global"}},
+					},
+					Period: 300,
+					Stat:   "Average",
+				},
+				ReturnData: &returnData,
+			},
+			{Id: "errors", Expression: "rate * 100", Label: "5xx error rate"},
+		},
+	})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("Action"), check.Equals, "GetMetricData")
+	c.Assert(req.Form.Get("StartTime"), check.Equals, "2017-03-01T09:10:00Z")
+	c.Assert(req.Form.Get("ScanBy"), check.Equals, "TimestampDescending")
+	c.Assert(req.Form.Get("MetricDataQueries.member.1.Id"), check.Equals, "rate")
+	c.Assert(req.Form.Get("MetricDataQueries.member.1.ReturnData"), check.Equals, "false")
+	c.Assert(req.Form.Get("MetricDataQueries.member.1.MetricStat.Metric.Namespace"), check.Equals, "AWS/CloudFront")
+	c.Assert(req.Form.Get("MetricDataQueries.member.1.MetricStat.Metric.Dimensions.member.2.Value"), check.Equals, "Global")
+	c.Assert(req.Form.Get("MetricDataQueries.member.1.MetricStat.Period"), check.Equals, "300")
+	c.Assert(req.Form.Get("MetricDataQueries.member.1.MetricStat.Stat"), check.Equals, "Average")
+	c.Assert(req.Form.Get("MetricDataQueries.member.2.Expression"), check.Equals, "rate * 100")
+	c.Assert(req.Form.Get("MetricDataQueries.member.2.ReturnData"), check.Equals, "")
+
+	result := resp.GetMetricDataResult
+	c.Assert(result.NextToken, check.Equals, "page2")
+	c.Assert(result.MetricDataResults, check.HasLen, 1)
+	c.Assert(result.MetricDataResults[0].Values, check.DeepEquals, []float64{0.5, 1.25})
+	c.Assert(result.MetricDataResults[0].Timestamps[1], check.Equals, time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC))
+}
+
+func (s *S) TestGetAllMetricData(c *check.C) {
+	testServer.Response(200, nil, getMetricDataPage1)
+	testServer.Response(200, nil, getMetricDataPage2)
+
+	end := time.Date(2017, 3, 1, 10, 10, 0, 0, time.UTC)
+	results, err := s.cw.GetAllMetricData(&cloudwatch.GetMetricDataRequest{
+		StartTime:         end.Add(-time.Hour),
+		EndTime:           end,
+		MetricDataQueries: []cloudwatch.MetricDataQuery{{Id: "errors", Expression: "SEARCH('5xxErrorRate', 'Average', 300)"}},
+	})
+	c.Assert(err, check.IsNil)
+
+	reqs := testServer.WaitRequests(2)
+	c.Assert(reqs[0].Form.Get("NextToken"), check.Equals, "")
+	c.Assert(reqs[1].Form.Get("NextToken"), check.Equals, "page2")
+
+	c.Assert(results, check.HasLen, 1)
+	c.Assert(results[0].StatusCode, check.Equals, "Complete")
+	c.Assert(results[0].Values, check.DeepEquals, []float64{0.5, 1.25, 0})
+	c.Assert(results[0].Timestamps, check.HasLen, 3)
+}
+
+func (s *S) TestGetMetricDataNoQueries(c *check.C) {
+	now := time.Now()
+	_, err := s.cw.GetMetricData(&cloudwatch.GetMetricDataRequest{StartTime: now.Add(-time.Hour), EndTime: now})
+	c.Assert(err, check.ErrorMatches, "No MetricDataQueries supplied")
+}
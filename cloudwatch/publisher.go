@@ -0,0 +1,146 @@
+package cloudwatch
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Publisher buffers metric data points and publishes them in the
+// background with PutMetricDataNamespace, every Interval or as soon as
+// MaxMetricDataPerRequest data points are buffered.
+//
+// Publishers must be created with NewPublisher. Set the fields before
+// calling Start and don't change them afterwards.
+type Publisher struct {
+	CloudWatch *CloudWatch
+	Namespace  string
+
+	// Time between flushes of the buffer (default 1 minute).
+	Interval time.Duration
+
+	// ErrorHandler, if set, is called with the errors of the background
+	// flushes. The data points of a failed flush are dropped.
+	ErrorHandler func(error)
+
+	mu      sync.Mutex
+	buffer  []MetricDatum
+	started bool
+	// Serializes flushes, so data points are published in order.
+	flushMu sync.Mutex
+
+	full     chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewPublisher returns a Publisher of metrics in namespace with the default
+// settings.
+func NewPublisher(cw *CloudWatch, namespace string) *Publisher {
+	return &Publisher{
+		CloudWatch: cw,
+		Namespace:  namespace,
+		full:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start starts publishing in the background until Stop is called.
+func (p *Publisher) Start() {
+	interval := p.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	p.mu.Lock()
+	p.started = true
+	p.mu.Unlock()
+	go p.run(interval)
+}
+
+func (p *Publisher) run(interval time.Duration) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-p.full:
+		case <-p.stop:
+			return
+		}
+		if err := p.Flush(); err != nil && p.ErrorHandler != nil {
+			p.ErrorHandler(err)
+		}
+	}
+}
+
+// Put adds data points to the buffer. It doesn't block on the network.
+func (p *Publisher) Put(metrics ...MetricDatum) {
+	p.mu.Lock()
+	p.buffer = append(p.buffer, metrics...)
+	full := len(p.buffer) >= MaxMetricDataPerRequest
+	p.mu.Unlock()
+
+	if full {
+		select {
+		case p.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// PutMetric adds a data point to the buffer, timestamped now. It makes
+// Publisher an aws.MetricsSink.
+func (p *Publisher) PutMetric(name string, value float64, unit string, dimensions map[string]string) {
+	datum := MetricDatum{
+		MetricName: name,
+		Value:      value,
+		Unit:       unit,
+		Timestamp:  time.Now().UTC(),
+	}
+	for dimName, dimValue := range dimensions {
+		datum.Dimensions = append(datum.Dimensions, Dimension{Name: dimName, Value: dimValue})
+	}
+	sort.Sort(byDimensionName(datum.Dimensions))
+	p.Put(datum)
+}
+
+type byDimensionName []Dimension
+
+func (d byDimensionName) Len() int           { return len(d) }
+func (d byDimensionName) Less(i, j int) bool { return d[i].Name < d[j].Name }
+func (d byDimensionName) Swap(i, j int)      { d[i], d[j] = d[j], d[i] }
+
+// Flush publishes the buffered data points now.
+func (p *Publisher) Flush() error {
+	p.flushMu.Lock()
+	defer p.flushMu.Unlock()
+
+	p.mu.Lock()
+	metrics := p.buffer
+	p.buffer = nil
+	p.mu.Unlock()
+
+	if len(metrics) == 0 {
+		return nil
+	}
+	_, err := p.CloudWatch.PutMetricDataNamespace(metrics, p.Namespace)
+	return err
+}
+
+// Stop stops publishing in the background and publishes the data points
+// that are still buffered. It waits for a flush that is in progress to
+// finish, and returns the error of the last flush.
+func (p *Publisher) Stop() error {
+	p.stopOnce.Do(func() { close(p.stop) })
+	p.mu.Lock()
+	started := p.started
+	p.mu.Unlock()
+	if started {
+		<-p.done
+	}
+	return p.Flush()
+}
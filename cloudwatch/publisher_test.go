@@ -0,0 +1,88 @@
+package cloudwatch_test
+
+import (
+	"errors"
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/cloudwatch"
+	"gopkg.in/check.v1"
+	"time"
+)
+
+func (s *S) TestPublisherStopFlushes(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	p := cloudwatch.NewPublisher(s.cw, "Shop")
+	p.Interval = time.Hour
+	p.Start()
+	p.Put(cloudwatch.MetricDatum{MetricName: "Orders", Value: 1})
+	p.Put(cloudwatch.MetricDatum{MetricName: "Orders", Value: 2})
+	c.Assert(p.Stop(), check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("Namespace"), check.Equals, "Shop")
+	c.Assert(req.Form.Get("MetricData.member.1.Value"), check.Equals, "1.0000000000E+00")
+	c.Assert(req.Form.Get("MetricData.member.2.Value"), check.Equals, "2.0000000000E+00")
+}
+
+func (s *S) TestPublisherFlushesFullBuffer(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	p := cloudwatch.NewPublisher(s.cw, "Shop")
+	p.Interval = time.Hour
+	p.Start()
+	defer p.Stop()
+	metrics := make([]cloudwatch.MetricDatum, cloudwatch.MaxMetricDataPerRequest)
+	for i := range metrics {
+		metrics[i] = cloudwatch.MetricDatum{MetricName: "Orders", Value: 1}
+	}
+	p.Put(metrics...)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("MetricData.member.1000.MetricName"), check.Equals, "Orders")
+}
+
+func (s *S) TestPublisherInterval(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+	testServer.Response(400, nil, `<Response><Errors><Error><Code>Throttling</Code><Message>Rate exceeded</Message></Error></Errors><RequestID>123</RequestID></Response>`)
+
+	errs := make(chan error, 1)
+	p := cloudwatch.NewPublisher(s.cw, "Shop")
+	p.Interval = 10 * time.Millisecond
+	p.ErrorHandler = func(err error) { errs <- err }
+	p.Start()
+	defer p.Stop()
+
+	p.Put(cloudwatch.MetricDatum{MetricName: "Orders", Value: 1})
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("MetricData.member.1.MetricName"), check.Equals, "Orders")
+
+	p.Put(cloudwatch.MetricDatum{MetricName: "Refunds", Value: 1})
+	req = testServer.WaitRequest()
+	c.Assert(req.Form.Get("MetricData.member.1.MetricName"), check.Equals, "Refunds")
+	select {
+	case err := <-errs:
+		c.Assert(err, check.NotNil)
+	case <-time.After(5 * time.Second):
+		c.Fatal(errors.New("ErrorHandler not called"))
+	}
+}
+
+func (s *S) TestPublisherPutMetric(c *check.C) {
+	testServer.Response(200, nil, "<RequestId>123</RequestId>")
+
+	var sink aws.MetricsSink = cloudwatch.NewPublisher(s.cw, "Streams")
+	sink.PutMetric("IteratorAgeMilliseconds", 1500, "Milliseconds", map[string]string{
+		"StreamName": "orders",
+		"ShardId":    "shardId-000000000001",
+	})
+	c.Assert(sink.(*cloudwatch.Publisher).Stop(), check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("MetricData.member.1.MetricName"), check.Equals, "IteratorAgeMilliseconds")
+	c.Assert(req.Form.Get("MetricData.member.1.Unit"), check.Equals, "Milliseconds")
+	c.Assert(req.Form.Get("MetricData.member.1.Value"), check.Equals, "1.5000000000E+03")
+	c.Assert(req.Form.Get("MetricData.member.1.Timestamp"), check.Not(check.Equals), "")
+	c.Assert(req.Form.Get("MetricData.member.1.Dimensions.member.1.Name"), check.Equals, "ShardId")
+	c.Assert(req.Form.Get("MetricData.member.1.Dimensions.member.1.Value"), check.Equals, "shardId-000000000001")
+	c.Assert(req.Form.Get("MetricData.member.1.Dimensions.member.2.Name"), check.Equals, "StreamName")
+}
@@ -0,0 +1,248 @@
+package cloudwatchlogs
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Limits of a PutLogEvents request. Each event counts as the length of its
+// message plus EventOverhead bytes towards MaxBatchSize.
+const (
+	MaxBatchEvents = 10000
+	MaxBatchSize   = 1048576
+	EventOverhead  = 26
+	MaxBatchSpan   = 24 * time.Hour
+)
+
+// InputLogEvent is a log event to upload. Timestamp is in milliseconds
+// since the epoch; see Timestamp.
+type InputLogEvent struct {
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// Timestamp returns t in milliseconds since the epoch, as used by log
+// events.
+func Timestamp(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// PutLogEventsRequest holds the parameters of PutLogEvents. SequenceToken
+// is the NextSequenceToken of the previous upload to the stream, or "" for
+// the first one.
+type PutLogEventsRequest struct {
+	LogGroupName  string          `json:"logGroupName"`
+	LogStreamName string          `json:"logStreamName"`
+	LogEvents     []InputLogEvent `json:"logEvents"`
+	SequenceToken string          `json:"sequenceToken,omitempty"`
+}
+
+// RejectedLogEventsInfo gives the indexes of events that were not stored
+// because they were too old, too new or had expired.
+type RejectedLogEventsInfo struct {
+	TooNewLogEventStartIndex *int `json:"tooNewLogEventStartIndex"`
+	TooOldLogEventEndIndex   *int `json:"tooOldLogEventEndIndex"`
+	ExpiredLogEventEndIndex  *int `json:"expiredLogEventEndIndex"`
+}
+
+type PutLogEventsResponse struct {
+	NextSequenceToken     string                 `json:"nextSequenceToken"`
+	RejectedLogEventsInfo *RejectedLogEventsInfo `json:"rejectedLogEventsInfo"`
+}
+
+var (
+	errNoEvents      = errors.New("cloudwatchlogs: no log events")
+	errTooManyEvents = errors.New("cloudwatchlogs: too many log events in batch")
+	errBatchTooLarge = errors.New("cloudwatchlogs: log event batch too large")
+	errBatchTooLong  = errors.New("cloudwatchlogs: log event batch spans more than 24 hours")
+	errOutOfOrder    = errors.New("cloudwatchlogs: log events not in chronological order")
+)
+
+// PutLogEvents uploads a batch of log events to a log stream. The events
+// must be in chronological order and within the batch limits; use a
+// StreamWriter to have them sorted, split and sequenced.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutLogEvents.html
+func (l *CloudWatchLogs) PutLogEvents(req *PutLogEventsRequest) (resp *PutLogEventsResponse, err error) {
+	if err := checkBatch(req.LogEvents); err != nil {
+		return nil, err
+	}
+	resp = new(PutLogEventsResponse)
+	if err := l.query("PutLogEvents", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func checkBatch(events []InputLogEvent) error {
+	switch {
+	case len(events) == 0:
+		return errNoEvents
+	case len(events) > MaxBatchEvents:
+		return errTooManyEvents
+	}
+	size := 0
+	for i, e := range events {
+		size += len(e.Message) + EventOverhead
+		if i > 0 && e.Timestamp < events[i-1].Timestamp {
+			return errOutOfOrder
+		}
+	}
+	if size > MaxBatchSize {
+		return errBatchTooLarge
+	}
+	if time.Duration(events[len(events)-1].Timestamp-events[0].Timestamp)*time.Millisecond > MaxBatchSpan {
+		return errBatchTooLong
+	}
+	return nil
+}
+
+// StreamWriter uploads log events to a log stream, keeping track of its
+// sequence token. It is safe for concurrent use.
+type StreamWriter struct {
+	logs          *CloudWatchLogs
+	logGroupName  string
+	logStreamName string
+
+	mu            sync.Mutex
+	sequenceToken string
+}
+
+// NewStreamWriter returns a StreamWriter for an existing log stream.
+func (l *CloudWatchLogs) NewStreamWriter(logGroupName, logStreamName string) *StreamWriter {
+	return &StreamWriter{logs: l, logGroupName: logGroupName, logStreamName: logStreamName}
+}
+
+// Put uploads events in as many PutLogEvents requests as the batch limits
+// require, sorting them by timestamp first. A message larger than
+// MaxBatchSize can't be uploaded.
+//
+// If the sequence token is out of date, because another writer uploaded to
+// the stream, the request is retried with the token CloudWatch Logs
+// expects.
+func (w *StreamWriter) Put(events []InputLogEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	sorted := make([]InputLogEvent, len(events))
+	copy(sorted, events)
+	sort.Stable(byTimestamp(sorted))
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for len(sorted) > 0 {
+		n := batchLen(sorted)
+		if err := w.put(sorted[:n]); err != nil {
+			return err
+		}
+		sorted = sorted[n:]
+	}
+	return nil
+}
+
+func (w *StreamWriter) put(events []InputLogEvent) error {
+	req := &PutLogEventsRequest{
+		LogGroupName:  w.logGroupName,
+		LogStreamName: w.logStreamName,
+		LogEvents:     events,
+		SequenceToken: w.sequenceToken,
+	}
+	resp, err := w.logs.PutLogEvents(req)
+	var logsErr *Error
+	if errors.As(err, &logsErr) && logsErr.Code == "InvalidSequenceTokenException" {
+		req.SequenceToken = logsErr.ExpectedSequenceToken
+		resp, err = w.logs.PutLogEvents(req)
+	}
+	if errors.As(err, &logsErr) && logsErr.Code == "DataAlreadyAcceptedException" {
+		w.sequenceToken = logsErr.ExpectedSequenceToken
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	w.sequenceToken = resp.NextSequenceToken
+	return nil
+}
+
+// batchLen returns how many of the sorted events fit in a batch.
+func batchLen(events []InputLogEvent) int {
+	size := 0
+	for i, e := range events {
+		size += len(e.Message) + EventOverhead
+		switch {
+		case i == MaxBatchEvents,
+			i > 0 && size > MaxBatchSize,
+			time.Duration(e.Timestamp-events[0].Timestamp)*time.Millisecond > MaxBatchSpan:
+			return i
+		}
+	}
+	return len(events)
+}
+
+type byTimestamp []InputLogEvent
+
+func (e byTimestamp) Len() int           { return len(e) }
+func (e byTimestamp) Less(i, j int) bool { return e[i].Timestamp < e[j].Timestamp }
+func (e byTimestamp) Swap(i, j int)      { e[i], e[j] = e[j], e[i] }
+
+// FilterLogEventsRequest selects the events of a log group to return.
+// StartTime and EndTime are in milliseconds since the epoch. All fields
+// but LogGroupName are optional.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_FilterLogEvents.html
+type FilterLogEventsRequest struct {
+	LogGroupName        string   `json:"logGroupName"`
+	LogStreamNames      []string `json:"logStreamNames,omitempty"`
+	LogStreamNamePrefix string   `json:"logStreamNamePrefix,omitempty"`
+	FilterPattern       string   `json:"filterPattern,omitempty"`
+	StartTime           int64    `json:"startTime,omitempty"`
+	EndTime             int64    `json:"endTime,omitempty"`
+	Limit               int      `json:"limit,omitempty"`
+	NextToken           string   `json:"nextToken,omitempty"`
+}
+
+type FilteredLogEvent struct {
+	EventId       string `json:"eventId"`
+	LogStreamName string `json:"logStreamName"`
+	Message       string `json:"message"`
+	Timestamp     int64  `json:"timestamp"`
+	IngestionTime int64  `json:"ingestionTime"`
+}
+
+type FilterLogEventsResponse struct {
+	Events    []FilteredLogEvent `json:"events"`
+	NextToken string             `json:"nextToken"`
+}
+
+// FilterLogEvents fetches a page of the events of a log group matching a
+// filter pattern. A page may be empty and still have a NextToken; set it
+// in req to get the next page.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_FilterLogEvents.html
+func (l *CloudWatchLogs) FilterLogEvents(req *FilterLogEventsRequest) (resp *FilterLogEventsResponse, err error) {
+	resp = new(FilterLogEventsResponse)
+	if err := l.query("FilterLogEvents", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FilterAllLogEvents is like FilterLogEvents, but follows NextToken until
+// every matching event is fetched.
+func (l *CloudWatchLogs) FilterAllLogEvents(req *FilterLogEventsRequest) ([]FilteredLogEvent, error) {
+	page := *req
+	var events []FilteredLogEvent
+	for {
+		resp, err := l.FilterLogEvents(&page)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, resp.Events...)
+		if resp.NextToken == "" {
+			return events, nil
+		}
+		page.NextToken = resp.NextToken
+	}
+}
@@ -0,0 +1,123 @@
+package cloudwatchlogs_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/cloudwatchlogs"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestPutLogEvents(c *check.C) {
+	testServer.Response(200, nil, PutLogEventsRejectedResponse)
+
+	resp, err := s.logs.PutLogEvents(&cloudwatchlogs.PutLogEventsRequest{
+		LogGroupName:  "/app/web",
+		LogStreamName: "i-1234",
+		LogEvents: []cloudwatchlogs.InputLogEvent{
+			{Message: "started", Timestamp: 1396035378988},
+			{Message: "ready", Timestamp: 1396035378989},
+		},
+		SequenceToken: "49542672486831074009579604567656788214806863282469607346",
+	})
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.PutLogEvents")
+	c.Assert(body["sequenceToken"], check.Equals, "49542672486831074009579604567656788214806863282469607346")
+	c.Assert(body["logEvents"], check.DeepEquals, []interface{}{
+		map[string]interface{}{"message": "started", "timestamp": float64(1396035378988)},
+		map[string]interface{}{"message": "ready", "timestamp": float64(1396035378989)},
+	})
+	c.Assert(resp.NextSequenceToken, check.Equals, "49542672486831074009579604567656788214806863282469607347")
+	c.Assert(*resp.RejectedLogEventsInfo.TooOldLogEventEndIndex, check.Equals, 1)
+	c.Assert(resp.RejectedLogEventsInfo.TooNewLogEventStartIndex, check.IsNil)
+}
+
+func (s *S) TestPutLogEventsInvalidBatch(c *check.C) {
+	req := &cloudwatchlogs.PutLogEventsRequest{LogGroupName: "/app/web", LogStreamName: "i-1234"}
+	_, err := s.logs.PutLogEvents(req)
+	c.Assert(err, check.ErrorMatches, "cloudwatchlogs: no log events")
+
+	req.LogEvents = []cloudwatchlogs.InputLogEvent{{"b", 2}, {"a", 1}}
+	_, err = s.logs.PutLogEvents(req)
+	c.Assert(err, check.ErrorMatches, "cloudwatchlogs: log events not in chronological order")
+
+	req.LogEvents = []cloudwatchlogs.InputLogEvent{{"a", 0}, {"b", 25 * 3600 * 1000}}
+	_, err = s.logs.PutLogEvents(req)
+	c.Assert(err, check.ErrorMatches, "cloudwatchlogs: log event batch spans more than 24 hours")
+
+	req.LogEvents = []cloudwatchlogs.InputLogEvent{{strings.Repeat("x", cloudwatchlogs.MaxBatchSize), 0}}
+	_, err = s.logs.PutLogEvents(req)
+	c.Assert(err, check.ErrorMatches, "cloudwatchlogs: log event batch too large")
+}
+
+func (s *S) TestStreamWriterSequencesAndSplits(c *check.C) {
+	testServer.Response(200, nil, `{"nextSequenceToken": "token-1"}`)
+	testServer.Response(200, nil, `{"nextSequenceToken": "token-2"}`)
+	testServer.Response(200, nil, `{"nextSequenceToken": "token-3"}`)
+
+	now := cloudwatchlogs.Timestamp(time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC))
+	events := make([]cloudwatchlogs.InputLogEvent, cloudwatchlogs.MaxBatchEvents+1)
+	for i := range events {
+		events[i] = cloudwatchlogs.InputLogEvent{Message: "tick", Timestamp: now + int64(len(events)-i)}
+	}
+
+	w := s.logs.NewStreamWriter("/app/web", "i-1234")
+	c.Assert(w.Put(events), check.IsNil)
+
+	_, body := testServer.WaitJSONRequest(c, "logs")
+	_, ok := body["sequenceToken"]
+	c.Assert(ok, check.Equals, false)
+	batch := body["logEvents"].([]interface{})
+	c.Assert(batch, check.HasLen, cloudwatchlogs.MaxBatchEvents)
+	c.Assert(batch[0].(map[string]interface{})["timestamp"], check.Equals, float64(now+1))
+
+	_, body = testServer.WaitJSONRequest(c, "logs")
+	c.Assert(body["sequenceToken"], check.Equals, "token-1")
+	c.Assert(body["logEvents"], check.HasLen, 1)
+
+	c.Assert(w.Put([]cloudwatchlogs.InputLogEvent{{"again", now}}), check.IsNil)
+	_, body = testServer.WaitJSONRequest(c, "logs")
+	c.Assert(body["sequenceToken"], check.Equals, "token-2")
+}
+
+func (s *S) TestStreamWriterRetriesInvalidSequenceToken(c *check.C) {
+	testServer.Response(400, nil, InvalidSequenceTokenResponse)
+	testServer.Response(200, nil, PutLogEventsResponse)
+
+	w := s.logs.NewStreamWriter("/app/web", "i-1234")
+	c.Assert(w.Put([]cloudwatchlogs.InputLogEvent{{"hello", 1396035378988}}), check.IsNil)
+
+	_, body := testServer.WaitJSONRequest(c, "logs")
+	_, ok := body["sequenceToken"]
+	c.Assert(ok, check.Equals, false)
+	_, body = testServer.WaitJSONRequest(c, "logs")
+	c.Assert(body["sequenceToken"], check.Equals, "49590302846983474467208212347568916553470234789398290578")
+}
+
+func (s *S) TestFilterAllLogEvents(c *check.C) {
+	testServer.Response(200, nil, FilterLogEventsPage1Response)
+	testServer.Response(200, nil, FilterLogEventsPage2Response)
+
+	events, err := s.logs.FilterAllLogEvents(&cloudwatchlogs.FilterLogEventsRequest{
+		LogGroupName:  "/app/web",
+		FilterPattern: "ERROR",
+		StartTime:     1396035378000,
+	})
+	c.Assert(err, check.IsNil)
+
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(target, check.Equals, "Logs_20140328.FilterLogEvents")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"logGroupName":  "/app/web",
+		"filterPattern": "ERROR",
+		"startTime":     float64(1396035378000),
+	})
+	_, body = testServer.WaitJSONRequest(c, "logs")
+	c.Assert(body["nextToken"], check.Equals, "ZNUEPl7FcQuXbIH4Swk9D9eFu2XBg-ijZIZlvzz4ea9zZRjw-MMtQtvcoMdmq4T29K7Q6Y1e_KvyfpcT_f_tUw")
+
+	c.Assert(events, check.HasLen, 2)
+	c.Assert(events[0].Message, check.Equals, "ERROR Event 1")
+	c.Assert(events[1].LogStreamName, check.Equals, "exampleStreamName2")
+}
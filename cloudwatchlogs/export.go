@@ -0,0 +1,99 @@
+package cloudwatchlogs
+
+// Status codes of an export task.
+const (
+	ExportTaskCancelled     = "CANCELLED"
+	ExportTaskCompleted     = "COMPLETED"
+	ExportTaskFailed        = "FAILED"
+	ExportTaskPending       = "PENDING"
+	ExportTaskPendingCancel = "PENDING_CANCEL"
+	ExportTaskRunning       = "RUNNING"
+)
+
+// CreateExportTaskRequest holds the parameters of CreateExportTask. From
+// and To are in milliseconds since the epoch; see Timestamp. Destination is
+// the name of an S3 bucket in the same region, whose policy must let
+// CloudWatch Logs write to it. TaskName, LogStreamNamePrefix and
+// DestinationPrefix are optional.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateExportTask.html
+type CreateExportTaskRequest struct {
+	TaskName            string `json:"taskName,omitempty"`
+	LogGroupName        string `json:"logGroupName"`
+	LogStreamNamePrefix string `json:"logStreamNamePrefix,omitempty"`
+	From                int64  `json:"from"`
+	To                  int64  `json:"to"`
+	Destination         string `json:"destination"`
+	DestinationPrefix   string `json:"destinationPrefix,omitempty"`
+}
+
+// CreateExportTask starts exporting the events of a log group to S3 and
+// returns the ID of the task. An account runs one export task at a time;
+// use DescribeExportTasks to follow its progress.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateExportTask.html
+func (l *CloudWatchLogs) CreateExportTask(req *CreateExportTaskRequest) (taskId string, err error) {
+	var resp struct {
+		TaskId string `json:"taskId"`
+	}
+	if err := l.query("CreateExportTask", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.TaskId, nil
+}
+
+// CancelExportTask cancels a pending or running export task.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CancelExportTask.html
+func (l *CloudWatchLogs) CancelExportTask(taskId string) error {
+	req := map[string]string{"taskId": taskId}
+	return l.query("CancelExportTask", req, nil)
+}
+
+// ExportTask describes an export task. Times are in milliseconds since the
+// epoch; CompletionTime is 0 until the task ends.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_ExportTask.html
+type ExportTask struct {
+	TaskId            string `json:"taskId"`
+	TaskName          string `json:"taskName"`
+	LogGroupName      string `json:"logGroupName"`
+	From              int64  `json:"from"`
+	To                int64  `json:"to"`
+	Destination       string `json:"destination"`
+	DestinationPrefix string `json:"destinationPrefix"`
+	Status            struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	} `json:"status"`
+	ExecutionInfo struct {
+		CreationTime   int64 `json:"creationTime"`
+		CompletionTime int64 `json:"completionTime"`
+	} `json:"executionInfo"`
+}
+
+// DescribeExportTasksRequest selects the export tasks to describe, by ID or
+// by status code. All fields are optional.
+type DescribeExportTasksRequest struct {
+	TaskId     string `json:"taskId,omitempty"`
+	StatusCode string `json:"statusCode,omitempty"`
+	Limit      int    `json:"limit,omitempty"`
+	NextToken  string `json:"nextToken,omitempty"`
+}
+
+type DescribeExportTasksResponse struct {
+	ExportTasks []ExportTask `json:"exportTasks"`
+	NextToken   string       `json:"nextToken"`
+}
+
+// DescribeExportTasks fetches a page of export tasks. If the response has a
+// NextToken, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeExportTasks.html
+func (l *CloudWatchLogs) DescribeExportTasks(req *DescribeExportTasksRequest) (resp *DescribeExportTasksResponse, err error) {
+	resp = new(DescribeExportTasksResponse)
+	if err := l.query("DescribeExportTasks", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,64 @@
+package cloudwatchlogs_test
+
+import (
+	"github.com/zackbloom/goamz/cloudwatchlogs"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCreateExportTask(c *check.C) {
+	testServer.Response(200, nil, CreateExportTaskResponse)
+
+	taskId, err := s.logs.CreateExportTask(&cloudwatchlogs.CreateExportTaskRequest{
+		TaskName:          "my-log-group-09-10-2015",
+		LogGroupName:      "my-log-group",
+		From:              1441490400000,
+		To:                1441494000000,
+		Destination:       "my-exported-logs",
+		DestinationPrefix: "export-task-output",
+	})
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.CreateExportTask")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"taskName":          "my-log-group-09-10-2015",
+		"logGroupName":      "my-log-group",
+		"from":              1441490400000.0,
+		"to":                1441494000000.0,
+		"destination":       "my-exported-logs",
+		"destinationPrefix": "export-task-output",
+	})
+	c.Assert(taskId, check.Equals, "cda45419-90ea-4db5-9833-aade86253e66")
+}
+
+func (s *S) TestDescribeExportTasks(c *check.C) {
+	testServer.Response(200, nil, DescribeExportTasksResponse)
+
+	resp, err := s.logs.DescribeExportTasks(&cloudwatchlogs.DescribeExportTasksRequest{
+		StatusCode: cloudwatchlogs.ExportTaskRunning,
+	})
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.DescribeExportTasks")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"statusCode": "RUNNING"})
+	c.Assert(resp.NextToken, check.Equals, "dGFzazI=")
+	c.Assert(resp.ExportTasks, check.HasLen, 1)
+	task := resp.ExportTasks[0]
+	c.Assert(task.TaskId, check.Equals, "cda45419-90ea-4db5-9833-aade86253e66")
+	c.Assert(task.Destination, check.Equals, "my-exported-logs")
+	c.Assert(task.Status.Code, check.Equals, cloudwatchlogs.ExportTaskRunning)
+	c.Assert(task.ExecutionInfo.CreationTime, check.Equals, int64(1441495400000))
+	c.Assert(task.ExecutionInfo.CompletionTime, check.Equals, int64(0))
+}
+
+func (s *S) TestCancelExportTask(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	err := s.logs.CancelExportTask("cda45419-90ea-4db5-9833-aade86253e66")
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.CancelExportTask")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"taskId": "cda45419-90ea-4db5-9833-aade86253e66"})
+}
@@ -0,0 +1,177 @@
+package cloudwatchlogs
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// StartLiveTailRequest selects the log events to follow. LogGroupIdentifiers
+// holds up to 10 log group names or ARNs; ARNs are required to tail log
+// groups in other accounts. LogStreamNames and LogStreamNamePrefixes can't
+// both be set, and require a single log group.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_StartLiveTail.html
+type StartLiveTailRequest struct {
+	LogGroupIdentifiers   []string `json:"logGroupIdentifiers"`
+	LogStreamNames        []string `json:"logStreamNames,omitempty"`
+	LogStreamNamePrefixes []string `json:"logStreamNamePrefixes,omitempty"`
+	LogEventFilterPattern string   `json:"logEventFilterPattern,omitempty"`
+}
+
+var errNoLogGroups = errors.New("cloudwatchlogs: no log groups to tail")
+
+// LiveTailSessionStart describes a live tail session, as sent at its start.
+type LiveTailSessionStart struct {
+	RequestId             string   `json:"requestId"`
+	SessionId             string   `json:"sessionId"`
+	LogGroupIdentifiers   []string `json:"logGroupIdentifiers"`
+	LogStreamNames        []string `json:"logStreamNames"`
+	LogStreamNamePrefixes []string `json:"logStreamNamePrefixes"`
+	LogEventFilterPattern string   `json:"logEventFilterPattern"`
+}
+
+// LiveTailSessionLogEvent is a log event of a live tail session. Times are
+// in milliseconds since the epoch.
+type LiveTailSessionLogEvent struct {
+	LogGroupIdentifier string `json:"logGroupIdentifier"`
+	LogStreamName      string `json:"logStreamName"`
+	Message            string `json:"message"`
+	Timestamp          int64  `json:"timestamp"`
+	IngestionTime      int64  `json:"ingestionTime"`
+}
+
+// LiveTailSessionUpdate holds the log events received since the previous
+// update. Sampled is set when there were too many events to send them all.
+type LiveTailSessionUpdate struct {
+	SessionMetadata struct {
+		Sampled bool `json:"sampled"`
+	} `json:"sessionMetadata"`
+	SessionResults []LiveTailSessionLogEvent `json:"sessionResults"`
+}
+
+// LiveTailStream delivers the updates of a live tail session. Updates is
+// closed when the session ends, which happens after three hours or when
+// the stream is closed; Err must then be checked to tell why.
+type LiveTailStream struct {
+	Session *LiveTailSessionStart
+	Updates <-chan *LiveTailSessionUpdate
+
+	body     io.ReadCloser
+	r        *bufio.Reader
+	done     chan struct{}
+	finished chan struct{}
+	once     sync.Once
+	err      error
+}
+
+// Err returns the error that terminated the stream, if any. It blocks until
+// Updates is closed.
+func (s *LiveTailStream) Err() error {
+	<-s.finished
+	return s.err
+}
+
+// Close ends the live tail session and releases the underlying connection.
+// It is safe to call Close before Updates is drained.
+func (s *LiveTailStream) Close() error {
+	var err error
+	s.once.Do(func() {
+		close(s.done)
+		err = s.body.Close()
+	})
+	<-s.finished
+	return err
+}
+
+// StartLiveTail starts a live tail session, which streams the log events
+// matching req as they are ingested. Live tail is sent to the
+// "streaming-logs" endpoint of the region.
+//
+// The caller must call Close on the returned stream when done with it.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_StartLiveTail.html
+func (l *CloudWatchLogs) StartLiveTail(req *StartLiveTailRequest) (*LiveTailStream, error) {
+	if len(req.LogGroupIdentifiers) == 0 {
+		return nil, errNoLogGroups
+	}
+	hresp, err := l.send("StartLiveTail", req)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &LiveTailStream{
+		body:     hresp.Body,
+		r:        bufio.NewReader(hresp.Body),
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+	start := new(LiveTailSessionStart)
+	if err := s.next("sessionStart", start); err != nil {
+		hresp.Body.Close()
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	s.Session = start
+
+	updates := make(chan *LiveTailSessionUpdate)
+	s.Updates = updates
+	go s.read(updates)
+	return s, nil
+}
+
+func (s *LiveTailStream) read(updates chan<- *LiveTailSessionUpdate) {
+	defer close(s.finished)
+	defer close(updates)
+
+	for {
+		update := new(LiveTailSessionUpdate)
+		if err := s.next("sessionUpdate", update); err != nil {
+			select {
+			case <-s.done:
+				// Closed by the caller; the read error is expected.
+			default:
+				if err != io.EOF {
+					s.err = err
+				}
+			}
+			return
+		}
+		select {
+		case updates <- update:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// next reads the next event of the stream, which must be of eventType, and
+// decodes its payload into v.
+func (s *LiveTailStream) next(eventType string, v interface{}) error {
+	msg, err := aws.ReadEventStreamMessage(s.r)
+	if err != nil {
+		return err
+	}
+	switch msg.Headers[":message-type"] {
+	case "event":
+	case "exception":
+		logsErr := &Error{Code: msg.Headers[":exception-type"]}
+		json.Unmarshal(msg.Payload, logsErr)
+		return logsErr
+	case "error":
+		return &Error{Code: msg.Headers[":error-code"], Message: msg.Headers[":error-message"]}
+	default:
+		return fmt.Errorf("cloudwatchlogs: unexpected message type %q", msg.Headers[":message-type"])
+	}
+	if t := msg.Headers[":event-type"]; t != eventType {
+		return fmt.Errorf("cloudwatchlogs: unexpected event %q", t)
+	}
+	return json.Unmarshal(msg.Payload, v)
+}
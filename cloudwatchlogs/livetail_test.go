@@ -0,0 +1,85 @@
+package cloudwatchlogs_test
+
+import (
+	"bytes"
+
+	"github.com/zackbloom/goamz/cloudwatchlogs"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func liveTailEvent(eventType, payload string) []byte {
+	return testutil.EventStreamMessage([][2]string{
+		{":message-type", "event"},
+		{":event-type", eventType},
+		{":content-type", "application/json"},
+	}, payload)
+}
+
+func (s *S) TestStartLiveTail(c *check.C) {
+	var body bytes.Buffer
+	body.Write(liveTailEvent("sessionStart", LiveTailSessionStartEvent))
+	body.Write(liveTailEvent("sessionUpdate", LiveTailSessionUpdateEvent))
+	body.Write(liveTailEvent("sessionUpdate", `{"sessionMetadata":{"sampled":true},"sessionResults":[]}`))
+	testServer.Response(200, nil, body.String())
+	defer testServer.DialAll()()
+
+	stream, err := s.logs.StartLiveTail(&cloudwatchlogs.StartLiveTailRequest{
+		LogGroupIdentifiers:   []string{"arn:aws:logs:us-east-1:123456789012:log-group:/app/web"},
+		LogEventFilterPattern: "ERROR",
+	})
+	target, reqBody := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+	defer stream.Close()
+
+	c.Assert(target, check.Equals, "Logs_20140328.StartLiveTail")
+	c.Assert(reqBody, check.DeepEquals, map[string]interface{}{
+		"logGroupIdentifiers":   []interface{}{"arn:aws:logs:us-east-1:123456789012:log-group:/app/web"},
+		"logEventFilterPattern": "ERROR",
+	})
+	c.Assert(stream.Session.SessionId, check.Equals, "5d1fa0a7-95a5-4e41-b1c1-3a5d4f1d2c8e")
+
+	var updates []*cloudwatchlogs.LiveTailSessionUpdate
+	for u := range stream.Updates {
+		updates = append(updates, u)
+	}
+	c.Assert(stream.Err(), check.IsNil)
+	c.Assert(updates, check.HasLen, 2)
+	c.Assert(updates[0].SessionResults, check.HasLen, 1)
+	c.Assert(updates[0].SessionResults[0].Message, check.Equals, "ERROR timeout")
+	c.Assert(updates[0].SessionResults[0].Timestamp, check.Equals, int64(1396035378988))
+	c.Assert(updates[1].SessionMetadata.Sampled, check.Equals, true)
+}
+
+func (s *S) TestStartLiveTailException(c *check.C) {
+	var body bytes.Buffer
+	body.Write(liveTailEvent("sessionStart", LiveTailSessionStartEvent))
+	body.Write(testutil.EventStreamMessage([][2]string{
+		{":message-type", "exception"},
+		{":exception-type", "SessionTimeoutException"},
+		{":content-type", "application/json"},
+	}, `{"message":"Session timed out after 3 hours"}`))
+	testServer.Response(200, nil, body.String())
+	defer testServer.DialAll()()
+
+	stream, err := s.logs.StartLiveTail(&cloudwatchlogs.StartLiveTailRequest{
+		LogGroupIdentifiers: []string{"/app/web"},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Host, check.Equals, "streaming-localhost:4444")
+	defer stream.Close()
+
+	for range stream.Updates {
+		c.Fatal("unexpected update")
+	}
+	logsErr, ok := stream.Err().(*cloudwatchlogs.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(logsErr.Code, check.Equals, "SessionTimeoutException")
+	c.Assert(logsErr.Message, check.Equals, "Session timed out after 3 hours")
+}
+
+func (s *S) TestStartLiveTailNoLogGroups(c *check.C) {
+	_, err := s.logs.StartLiveTail(&cloudwatchlogs.StartLiveTailRequest{})
+	c.Assert(err, check.ErrorMatches, "cloudwatchlogs: no log groups to tail")
+}
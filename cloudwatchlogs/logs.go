@@ -0,0 +1,242 @@
+// Package cloudwatchlogs provides types and functions to interact with
+// Amazon CloudWatch Logs.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/Welcome.html
+package cloudwatchlogs
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type CloudWatchLogs struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *CloudWatchLogs {
+	return &CloudWatchLogs{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with CloudWatch Logs.
+// ExpectedSequenceToken is set by InvalidSequenceTokenException and
+// DataAlreadyAcceptedException errors.
+type Error struct {
+	StatusCode            int    // HTTP status code (200, 403, ...)
+	Code                  string `json:"__type"`
+	Message               string `json:"message"`
+	ExpectedSequenceToken string `json:"expectedSequenceToken"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("cloudwatchlogs: %s: %s", e.Code, e.Message)
+}
+
+func (e *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, e, e.StatusCode, e.Code, e.Message, "")
+}
+
+// query calls the CloudWatch Logs action with req encoded as JSON and
+// decodes the response into resp.
+func (l *CloudWatchLogs) query(action string, req, resp interface{}) error {
+	return l.client().Call(action, req, resp)
+}
+
+// send calls the action with req encoded as JSON. The body of the response
+// must be closed by the caller unless an error is returned.
+func (l *CloudWatchLogs) send(action string, req interface{}) (*http.Response, error) {
+	return l.client().Send(action, req)
+}
+
+func (l *CloudWatchLogs) client() *aws.JSONClient {
+	return &aws.JSONClient{
+		Auth:         l.Auth,
+		Region:       l.Region,
+		HTTPClient:   l.HTTPClient,
+		Endpoint:     l.Region.CloudWatchLogsEndpoint,
+		TargetPrefix: "Logs_20140328",
+		SigningName:  "logs",
+		HostPrefixes: map[string]string{"StartLiveTail": "streaming-"},
+		DecodeError:  decodeError,
+	}
+}
+
+// decodeError returns the *Error of an error response, with the fields
+// that only CloudWatch Logs errors have.
+func decodeError(jsonErr *aws.JSONError, body []byte) error {
+	logsErr := &Error{}
+	json.Unmarshal(body, logsErr)
+	logsErr.StatusCode = jsonErr.StatusCode
+	logsErr.Code = jsonErr.Code
+	logsErr.Message = jsonErr.Message
+	return logsErr
+}
+
+// LogGroup is a group of log streams sharing retention and access control
+// settings. CreationTime is in milliseconds since the epoch.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_LogGroup.html
+type LogGroup struct {
+	Arn               string `json:"arn"`
+	LogGroupName      string `json:"logGroupName"`
+	CreationTime      int64  `json:"creationTime"`
+	RetentionInDays   int    `json:"retentionInDays"`
+	StoredBytes       int64  `json:"storedBytes"`
+	MetricFilterCount int    `json:"metricFilterCount"`
+	KmsKeyId          string `json:"kmsKeyId"`
+}
+
+// CreateLogGroupRequest holds the parameters of CreateLogGroup. KmsKeyId
+// and Tags are optional.
+type CreateLogGroupRequest struct {
+	LogGroupName string            `json:"logGroupName"`
+	KmsKeyId     string            `json:"kmsKeyId,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+}
+
+// CreateLogGroup creates a log group, whose events never expire until a
+// retention policy is set with PutRetentionPolicy.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateLogGroup.html
+func (l *CloudWatchLogs) CreateLogGroup(req *CreateLogGroupRequest) error {
+	return l.query("CreateLogGroup", req, nil)
+}
+
+// DeleteLogGroup deletes a log group and all of its log events.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DeleteLogGroup.html
+func (l *CloudWatchLogs) DeleteLogGroup(name string) error {
+	req := map[string]string{"logGroupName": name}
+	return l.query("DeleteLogGroup", req, nil)
+}
+
+// DescribeLogGroupsRequest selects the log groups to describe. All fields
+// are optional.
+type DescribeLogGroupsRequest struct {
+	LogGroupNamePrefix string `json:"logGroupNamePrefix,omitempty"`
+	Limit              int    `json:"limit,omitempty"`
+	NextToken          string `json:"nextToken,omitempty"`
+}
+
+type DescribeLogGroupsResponse struct {
+	LogGroups []LogGroup `json:"logGroups"`
+	NextToken string     `json:"nextToken"`
+}
+
+// DescribeLogGroups fetches a page of log groups in order of name. If the
+// response has a NextToken, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeLogGroups.html
+func (l *CloudWatchLogs) DescribeLogGroups(req *DescribeLogGroupsRequest) (resp *DescribeLogGroupsResponse, err error) {
+	resp = new(DescribeLogGroupsResponse)
+	if err := l.query("DescribeLogGroups", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RetentionDays lists the values PutRetentionPolicy accepts.
+var RetentionDays = []int{1, 3, 5, 7, 14, 30, 60, 90, 120, 150, 180, 365, 400, 545, 731, 1096, 1827, 2192, 2557, 2922, 3288, 3653}
+
+// PutRetentionPolicy sets the number of days the events of a log group are
+// kept for, which must be one of RetentionDays.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutRetentionPolicy.html
+func (l *CloudWatchLogs) PutRetentionPolicy(logGroupName string, days int) error {
+	valid := false
+	for _, d := range RetentionDays {
+		if d == days {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("cloudwatchlogs: invalid retention of %d days", days)
+	}
+	req := map[string]interface{}{
+		"logGroupName":    logGroupName,
+		"retentionInDays": days,
+	}
+	return l.query("PutRetentionPolicy", req, nil)
+}
+
+// DeleteRetentionPolicy removes the retention policy of a log group, so its
+// events never expire.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DeleteRetentionPolicy.html
+func (l *CloudWatchLogs) DeleteRetentionPolicy(logGroupName string) error {
+	req := map[string]string{"logGroupName": logGroupName}
+	return l.query("DeleteRetentionPolicy", req, nil)
+}
+
+// LogStream is a sequence of log events from a single source. Times are in
+// milliseconds since the epoch.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_LogStream.html
+type LogStream struct {
+	Arn                 string `json:"arn"`
+	LogStreamName       string `json:"logStreamName"`
+	CreationTime        int64  `json:"creationTime"`
+	FirstEventTimestamp int64  `json:"firstEventTimestamp"`
+	LastEventTimestamp  int64  `json:"lastEventTimestamp"`
+	LastIngestionTime   int64  `json:"lastIngestionTime"`
+	UploadSequenceToken string `json:"uploadSequenceToken"`
+}
+
+// CreateLogStream creates a log stream in a log group.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateLogStream.html
+func (l *CloudWatchLogs) CreateLogStream(logGroupName, logStreamName string) error {
+	req := map[string]string{
+		"logGroupName":  logGroupName,
+		"logStreamName": logStreamName,
+	}
+	return l.query("CreateLogStream", req, nil)
+}
+
+// DeleteLogStream deletes a log stream and all of its log events.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DeleteLogStream.html
+func (l *CloudWatchLogs) DeleteLogStream(logGroupName, logStreamName string) error {
+	req := map[string]string{
+		"logGroupName":  logGroupName,
+		"logStreamName": logStreamName,
+	}
+	return l.query("DeleteLogStream", req, nil)
+}
+
+// DescribeLogStreamsRequest selects the log streams of a log group to
+// describe. OrderBy is "LogStreamName" (the default) or "LastEventTime";
+// LogStreamNamePrefix can only be used with the former.
+type DescribeLogStreamsRequest struct {
+	LogGroupName        string `json:"logGroupName"`
+	LogStreamNamePrefix string `json:"logStreamNamePrefix,omitempty"`
+	OrderBy             string `json:"orderBy,omitempty"`
+	Descending          bool   `json:"descending,omitempty"`
+	Limit               int    `json:"limit,omitempty"`
+	NextToken           string `json:"nextToken,omitempty"`
+}
+
+type DescribeLogStreamsResponse struct {
+	LogStreams []LogStream `json:"logStreams"`
+	NextToken  string      `json:"nextToken"`
+}
+
+// DescribeLogStreams fetches a page of the log streams of a log group. If
+// the response has a NextToken, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeLogStreams.html
+func (l *CloudWatchLogs) DescribeLogStreams(req *DescribeLogStreamsRequest) (resp *DescribeLogStreamsResponse, err error) {
+	resp = new(DescribeLogStreamsResponse)
+	if err := l.query("DescribeLogStreams", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
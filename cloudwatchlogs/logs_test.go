@@ -0,0 +1,131 @@
+package cloudwatchlogs_test
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/cloudwatchlogs"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	logs *cloudwatchlogs.CloudWatchLogs
+}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.logs = cloudwatchlogs.New(auth, aws.Region{Name: "us-east-1", CloudWatchLogsEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) TestCreateLogGroup(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	err := s.logs.CreateLogGroup(&cloudwatchlogs.CreateLogGroupRequest{
+		LogGroupName: "/app/web",
+		Tags:         map[string]string{"team": "web"},
+	})
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.CreateLogGroup")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"logGroupName": "/app/web",
+		"tags":         map[string]interface{}{"team": "web"},
+	})
+}
+
+func (s *S) TestDescribeLogGroups(c *check.C) {
+	testServer.Response(200, nil, DescribeLogGroupsResponse)
+
+	resp, err := s.logs.DescribeLogGroups(&cloudwatchlogs.DescribeLogGroupsRequest{LogGroupNamePrefix: "example"})
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.DescribeLogGroups")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"logGroupNamePrefix": "example"})
+	c.Assert(resp.NextToken, check.Equals, "Z3JvdXAy")
+	c.Assert(resp.LogGroups, check.HasLen, 1)
+	c.Assert(resp.LogGroups[0].LogGroupName, check.Equals, "exampleLogGroupName1")
+	c.Assert(resp.LogGroups[0].RetentionInDays, check.Equals, 14)
+	c.Assert(resp.LogGroups[0].StoredBytes, check.Equals, int64(1048576))
+}
+
+func (s *S) TestCreateLogStream(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	err := s.logs.CreateLogStream("/app/web", "i-1234")
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.CreateLogStream")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"logGroupName":  "/app/web",
+		"logStreamName": "i-1234",
+	})
+}
+
+func (s *S) TestDescribeLogStreams(c *check.C) {
+	testServer.Response(200, nil, DescribeLogStreamsResponse)
+
+	resp, err := s.logs.DescribeLogStreams(&cloudwatchlogs.DescribeLogStreamsRequest{
+		LogGroupName: "exampleLogGroupName",
+		OrderBy:      "LastEventTime",
+		Descending:   true,
+	})
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.DescribeLogStreams")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"logGroupName": "exampleLogGroupName",
+		"orderBy":      "LastEventTime",
+		"descending":   true,
+	})
+	c.Assert(resp.LogStreams, check.HasLen, 1)
+	c.Assert(resp.LogStreams[0].LastEventTimestamp, check.Equals, int64(1393567800000))
+	c.Assert(resp.LogStreams[0].UploadSequenceToken, check.Equals, "88602967394531410094953670125156212707622379445839968487")
+}
+
+func (s *S) TestPutRetentionPolicy(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	err := s.logs.PutRetentionPolicy("/app/web", 30)
+	target, body := testServer.WaitJSONRequest(c, "logs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "Logs_20140328.PutRetentionPolicy")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"logGroupName":    "/app/web",
+		"retentionInDays": float64(30),
+	})
+
+	err = s.logs.PutRetentionPolicy("/app/web", 31)
+	c.Assert(err, check.ErrorMatches, "cloudwatchlogs: invalid retention of 31 days")
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(400, nil, `{"__type": "com.amazonaws.logs#ResourceNotFoundException", "message": "The specified log group does not exist."}`)
+
+	err := s.logs.DeleteLogGroup("/app/missing")
+	testServer.WaitJSONRequest(c, "logs")
+
+	logsErr, ok := err.(*cloudwatchlogs.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(logsErr.StatusCode, check.Equals, 400)
+	c.Assert(logsErr.Code, check.Equals, "ResourceNotFoundException")
+	c.Assert(logsErr.Message, check.Equals, "The specified log group does not exist.")
+}
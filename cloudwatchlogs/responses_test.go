@@ -0,0 +1,147 @@
+package cloudwatchlogs_test
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeLogGroups.html
+var DescribeLogGroupsResponse = `
+{
+  "logGroups": [
+    {
+      "storedBytes": 1048576,
+      "metricFilterCount": 0,
+      "creationTime": 1393545600000,
+      "logGroupName": "exampleLogGroupName1",
+      "retentionInDays": 14,
+      "arn": "arn:aws:logs:us-east-1:123456789012:log-group:exampleLogGroupName1:*"
+    }
+  ],
+  "nextToken": "Z3JvdXAy"
+}
+`
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeLogStreams.html
+var DescribeLogStreamsResponse = `
+{
+  "logStreams": [
+    {
+      "storedBytes": 0,
+      "arn": "arn:aws:logs:us-east-1:123456789012:log-group:exampleLogGroupName:log-stream:exampleLogStreamName1",
+      "creationTime": 1393545600000,
+      "firstEventTimestamp": 1393545600000,
+      "lastEventTimestamp": 1393567800000,
+      "lastIngestionTime": 1393589200000,
+      "logStreamName": "exampleLogStreamName1",
+      "uploadSequenceToken": "88602967394531410094953670125156212707622379445839968487"
+    }
+  ]
+}
+`
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_PutLogEvents.html
+var PutLogEventsResponse = `
+{
+  "nextSequenceToken": "49542672486831074009579604567656788214806863282469607346"
+}
+`
+
+var PutLogEventsRejectedResponse = `
+{
+  "nextSequenceToken": "49542672486831074009579604567656788214806863282469607347",
+  "rejectedLogEventsInfo": {
+    "tooOldLogEventEndIndex": 1
+  }
+}
+`
+
+var InvalidSequenceTokenResponse = `
+{
+  "__type": "InvalidSequenceTokenException",
+  "expectedSequenceToken": "49590302846983474467208212347568916553470234789398290578",
+  "message": "The given sequenceToken is invalid. The next expected sequenceToken is: 49590302846983474467208212347568916553470234789398290578"
+}
+`
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_FilterLogEvents.html
+var FilterLogEventsPage1Response = `
+{
+  "events": [
+    {
+      "ingestionTime": 1396035394997,
+      "timestamp": 1396035378988,
+      "message": "ERROR Event 1",
+      "logStreamName": "exampleStreamName1",
+      "eventId": "31132629274945519779805322857203735586714454643391594505"
+    }
+  ],
+  "nextToken": "ZNUEPl7FcQuXbIH4Swk9D9eFu2XBg-ijZIZlvzz4ea9zZRjw-MMtQtvcoMdmq4T29K7Q6Y1e_KvyfpcT_f_tUw"
+}
+`
+
+var FilterLogEventsPage2Response = `
+{
+  "events": [
+    {
+      "ingestionTime": 1396035394997,
+      "timestamp": 1396035378989,
+      "message": "ERROR Event 2",
+      "logStreamName": "exampleStreamName2",
+      "eventId": "31132629274945519779805322857203735586814454643391594505"
+    }
+  ]
+}
+`
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_CreateExportTask.html
+var CreateExportTaskResponse = `
+{
+  "taskId": "cda45419-90ea-4db5-9833-aade86253e66"
+}
+`
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_DescribeExportTasks.html
+var DescribeExportTasksResponse = `
+{
+  "exportTasks": [
+    {
+      "destination": "my-exported-logs",
+      "destinationPrefix": "export-task-output",
+      "executionInfo": {
+        "creationTime": 1441495400000
+      },
+      "from": 1441490400000,
+      "logGroupName": "my-log-group",
+      "status": {
+        "code": "RUNNING",
+        "message": "Started Successfully"
+      },
+      "taskId": "cda45419-90ea-4db5-9833-aade86253e66",
+      "taskName": "my-log-group-09-10-2015",
+      "to": 1441494000000
+    }
+  ],
+  "nextToken": "dGFzazI="
+}
+`
+
+// http://docs.aws.amazon.com/AmazonCloudWatchLogs/latest/APIReference/API_StartLiveTail.html
+var LiveTailSessionStartEvent = `
+{
+  "requestId": "d4d26ba4-1e9b-4e6c-8d0a-3f0e1b9c7a55",
+  "sessionId": "5d1fa0a7-95a5-4e41-b1c1-3a5d4f1d2c8e",
+  "logGroupIdentifiers": ["arn:aws:logs:us-east-1:123456789012:log-group:/app/web"],
+  "logEventFilterPattern": "ERROR"
+}
+`
+
+var LiveTailSessionUpdateEvent = `
+{
+  "sessionMetadata": {"sampled": false},
+  "sessionResults": [
+    {
+      "logGroupIdentifier": "123456789012:/app/web",
+      "logStreamName": "web-1",
+      "message": "ERROR timeout",
+      "timestamp": 1396035378988,
+      "ingestionTime": 1396035394997
+    }
+  ]
+}
+`
@@ -0,0 +1,239 @@
+package dynamodb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	simplejson "github.com/bitly/go-simplejson"
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
+)
+
+// The most keys a BatchGetItem request and the most put and delete requests
+// a BatchWriteItem request may hold.
+const (
+	batchGetItemMaxKeys       = 100
+	batchWriteItemMaxRequests = 25
+)
+
+// ErrUnprocessed is returned by ExecuteAll when keys or items are still
+// unprocessed once the retry policy of the server gives up.
+var ErrUnprocessed = errors.New("dynamodb: batch has unprocessed keys or items after retrying")
+
+// DynamoDB leaves keys and items unprocessed when a batch exceeds the
+// provisioned throughput of a table, so they are retried as throttling
+// errors would be.
+var errUnprocessedThrottled = &Error{StatusCode: 400, Code: "ProvisionedThroughputExceededException"}
+
+// A key or write request of a batch, in the format of RequestItems.
+type batchEntry struct {
+	table   string
+	request interface{}
+}
+
+// ExecuteAll gets every key of the batch, however many there are. The keys
+// are split into requests of at most 100 keys, run Concurrency at a time,
+// and the keys DynamoDB leaves unprocessed are requested again, waiting
+// between attempts as set by the RetryPolicy of the server.
+//
+// If keys are still unprocessed once the policy gives up, the items
+// retrieved so far are returned with ErrUnprocessed.
+func (batchGetItem *BatchGetItem) ExecuteAll() (map[string][]map[string]*Attribute, error) {
+	q := NewEmptyQuery()
+	q.AddGetRequestItems(batchGetItem.Keys)
+
+	var pending []batchEntry
+	for table, keys := range q.buffer["RequestItems"].(msi) {
+		for _, key := range keys.(msi)["Keys"].([]msi) {
+			pending = append(pending, batchEntry{table, key})
+		}
+	}
+
+	results := make(map[string][]map[string]*Attribute)
+	build := func(entries []batchEntry) msi {
+		requestItems := msi{}
+		for _, e := range entries {
+			t, ok := requestItems[e.table].(msi)
+			if !ok {
+				t = msi{"Keys": []interface{}{}}
+				requestItems[e.table] = t
+			}
+			t["Keys"] = append(t["Keys"].([]interface{}), e.request)
+		}
+		return requestItems
+	}
+	parse := func(json *simplejson.Json) ([]batchEntry, error) {
+		tables, err := json.Get("Responses").Map()
+		if err != nil {
+			return nil, err
+		}
+		for table := range tables {
+			items, err := json.Get("Responses").Get(table).Array()
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				m, ok := item.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("item of %s is not an object", table)
+				}
+				results[table] = append(results[table], parseAttributes(m))
+			}
+		}
+
+		var unprocessed []batchEntry
+		for table := range json.Get("UnprocessedKeys").MustMap() {
+			keys, err := json.Get("UnprocessedKeys").Get(table).Get("Keys").Array()
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				unprocessed = append(unprocessed, batchEntry{table, key})
+			}
+		}
+		return unprocessed, nil
+	}
+
+	_, err := batchGetItem.Server.runBatch("BatchGetItem", pending, batchGetItemMaxKeys, batchGetItem.Concurrency, build, parse)
+	return results, err
+}
+
+// ExecuteAll runs every put and delete request of the batch, however many
+// there are. The requests are split into BatchWriteItem calls of at most
+// 25 requests, run Concurrency at a time, and the requests DynamoDB leaves
+// unprocessed are sent again, waiting between attempts as set by the
+// RetryPolicy of the server.
+//
+// If requests are still unprocessed once the policy gives up, they are
+// returned with ErrUnprocessed, in the same format as the UnprocessedItems
+// returned by Execute.
+func (batchWriteItem *BatchWriteItem) ExecuteAll() (map[string]interface{}, error) {
+	q := NewEmptyQuery()
+	q.AddWriteRequestItems(batchWriteItem.ItemActions)
+
+	var pending []batchEntry
+	for table, requests := range q.buffer["RequestItems"].(msi) {
+		for _, request := range requests.([]interface{}) {
+			pending = append(pending, batchEntry{table, request})
+		}
+	}
+
+	build := func(entries []batchEntry) msi {
+		return msi(batchRequestItems(entries))
+	}
+	parse := func(json *simplejson.Json) ([]batchEntry, error) {
+		tables, err := json.Get("UnprocessedItems").Map()
+		if err != nil {
+			return nil, err
+		}
+		var unprocessed []batchEntry
+		for table := range tables {
+			requests, err := json.Get("UnprocessedItems").Get(table).Array()
+			if err != nil {
+				return nil, err
+			}
+			for _, request := range requests {
+				unprocessed = append(unprocessed, batchEntry{table, request})
+			}
+		}
+		return unprocessed, nil
+	}
+
+	left, err := batchWriteItem.Server.runBatch("BatchWriteItem", pending, batchWriteItemMaxRequests, batchWriteItem.Concurrency, build, parse)
+	if err == ErrUnprocessed {
+		return batchRequestItems(left), err
+	}
+	return nil, err
+}
+
+// runBatch sends the entries in pending with action, in requests of at
+// most max entries, running at most concurrency requests at once. build
+// returns the RequestItems of a request and parse handles its response,
+// returning the entries left unprocessed, which are sent again. parse is
+// never called concurrently.
+//
+// If the retry policy gives up while entries are unprocessed, runBatch
+// returns them with ErrUnprocessed. Any other error of a request is
+// returned once the other requests are done.
+func (s *Server) runBatch(action string, pending []batchEntry, max, concurrency int,
+	build func([]batchEntry) msi, parse func(*simplejson.Json) ([]batchEntry, error)) ([]batchEntry, error) {
+
+	var chunks []*[]batchEntry
+	for len(pending) > 0 {
+		n := len(pending)
+		if n > max {
+			n = max
+		}
+		chunk := pending[:n]
+		chunks = append(chunks, &chunk)
+		pending = pending[n:]
+	}
+
+	var mu sync.Mutex
+	policy := unprocessedRetryPolicy{s.RetryPolicy}
+	err := awsutil.ForEachWithRetryPolicy(chunks, concurrency, policy, func(chunk *[]batchEntry) error {
+		q := NewEmptyQuery()
+		q.buffer["RequestItems"] = build(*chunk)
+
+		jsonResponse, err := s.queryServer(target(action), q)
+		if err != nil {
+			return err
+		}
+		json, err := simplejson.NewJson(jsonResponse)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		unprocessed, err := parse(json)
+		mu.Unlock()
+		if err != nil {
+			return fmt.Errorf("Unexpected response %s", jsonResponse)
+		}
+		*chunk = unprocessed
+		if len(unprocessed) > 0 {
+			return errUnprocessedThrottled
+		}
+		return nil
+	})
+	if err == nil {
+		return nil, nil
+	}
+
+	var left []batchEntry
+	for _, failure := range err.(*awsutil.BatchError).Errors {
+		if failure.Err != errUnprocessedThrottled {
+			return nil, failure.Err
+		}
+		left = append(left, *chunks[failure.Index]...)
+	}
+	return left, ErrUnprocessed
+}
+
+// unprocessedRetryPolicy retries the requests of a batch that left entries
+// unprocessed as policy would retry a throttled request. The errors of the
+// requests themselves were already retried by queryServer.
+type unprocessedRetryPolicy struct {
+	policy aws.RetryPolicy
+}
+
+func (p unprocessedRetryPolicy) ShouldRetry(target string, r *http.Response, err error, numRetries int) bool {
+	return err == errUnprocessedThrottled && p.policy.ShouldRetry(target, r, err, numRetries)
+}
+
+func (p unprocessedRetryPolicy) Delay(target string, r *http.Response, err error, numRetries int) time.Duration {
+	return p.policy.Delay(target, r, err, numRetries)
+}
+
+// batchRequestItems groups entries by table, as in the RequestItems of a
+// BatchWriteItem request.
+func batchRequestItems(entries []batchEntry) map[string]interface{} {
+	items := make(map[string]interface{})
+	for _, e := range entries {
+		list, _ := items[e.table].([]interface{})
+		items[e.table] = append(list, e.request)
+	}
+	return items
+}
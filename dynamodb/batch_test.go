@@ -0,0 +1,129 @@
+package dynamodb
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+type BatchSuite struct {
+	httpServer *httptest.Server
+	table      *Table
+	mu         sync.Mutex
+	requests   []map[string]interface{}
+	responses  []string
+}
+
+var _ = check.Suite(&BatchSuite{})
+
+func (s *BatchSuite) SetUpTest(c *check.C) {
+	s.requests = nil
+	s.responses = nil
+	s.httpServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			c.Fatal(err)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		n := len(s.requests)
+		s.requests = append(s.requests, req)
+		if n < len(s.responses) {
+			io.WriteString(w, s.responses[n])
+		} else {
+			io.WriteString(w, `{"Responses": {}, "UnprocessedKeys": {}, "UnprocessedItems": {}}`)
+		}
+	}))
+	auth := aws.Auth{AccessKey: "key", SecretKey: "secret"}
+	server := New(auth, aws.Region{Name: "us-east-1", DynamoDBEndpoint: s.httpServer.URL})
+	s.table = server.NewTable("widgets", PrimaryKey{NewStringAttribute("id", ""), nil})
+}
+
+func (s *BatchSuite) TearDownTest(c *check.C) {
+	s.httpServer.Close()
+}
+
+func puts(n int) map[string][][]Attribute {
+	var items [][]Attribute
+	for i := 0; i < n; i++ {
+		items = append(items, []Attribute{*NewStringAttribute("id", fmt.Sprint(i))})
+	}
+	return map[string][][]Attribute{"Put": items}
+}
+
+func requestCount(req map[string]interface{}, table string) int {
+	return len(req["RequestItems"].(map[string]interface{})[table].([]interface{}))
+}
+
+func (s *BatchSuite) TestBatchWriteSplitsAndRetries(c *check.C) {
+	s.responses = []string{
+		`{"UnprocessedItems": {"widgets": [
+			{"PutRequest": {"Item": {"id": {"S": "3"}}}},
+			{"PutRequest": {"Item": {"id": {"S": "4"}}}}
+		]}}`,
+	}
+
+	unprocessed, err := s.table.BatchWriteItems(puts(30)).ExecuteAll()
+	c.Assert(err, check.IsNil)
+	c.Assert(unprocessed, check.IsNil)
+
+	// The unprocessed requests are sent again, ahead of the 5 remaining ones.
+	c.Assert(s.requests, check.HasLen, 3)
+	c.Check(requestCount(s.requests[0], "widgets"), check.Equals, 25)
+	c.Check(requestCount(s.requests[1], "widgets"), check.Equals, 2)
+	c.Check(requestCount(s.requests[2], "widgets"), check.Equals, 5)
+}
+
+func (s *BatchSuite) TestBatchWriteGivesUp(c *check.C) {
+	s.table.Server.RetryPolicy = aws.NeverRetryPolicy{}
+	s.responses = []string{
+		`{"UnprocessedItems": {"widgets": [{"PutRequest": {"Item": {"id": {"S": "3"}}}}]}}`,
+	}
+
+	unprocessed, err := s.table.BatchWriteItems(puts(30)).ExecuteAll()
+	c.Assert(err, check.Equals, ErrUnprocessed)
+	// The remaining 5 requests are still sent.
+	c.Assert(s.requests, check.HasLen, 2)
+	c.Check(unprocessed["widgets"], check.HasLen, 1)
+}
+
+func (s *BatchSuite) TestBatchGetSplitsAndRetries(c *check.C) {
+	s.responses = []string{
+		`{"Responses": {"widgets": [{"id": {"S": "0"}}]},
+		  "UnprocessedKeys": {"widgets": {"Keys": [{"id": {"S": "1"}}]}}}`,
+		`{"Responses": {"widgets": [{"id": {"S": "1"}}, {"id": {"S": "100"}}]}, "UnprocessedKeys": {}}`,
+	}
+
+	var keys []Key
+	for i := 0; i < 150; i++ {
+		keys = append(keys, Key{HashKey: fmt.Sprint(i)})
+	}
+	results, err := s.table.BatchGetItems(keys).ExecuteAll()
+	c.Assert(err, check.IsNil)
+	c.Assert(results["widgets"], check.HasLen, 3)
+
+	c.Assert(s.requests, check.HasLen, 3)
+	keysSent := func(req map[string]interface{}) int {
+		table := req["RequestItems"].(map[string]interface{})["widgets"].(map[string]interface{})
+		return len(table["Keys"].([]interface{}))
+	}
+	c.Check(keysSent(s.requests[0]), check.Equals, 100)
+	c.Check(keysSent(s.requests[1]), check.Equals, 1)
+	c.Check(keysSent(s.requests[2]), check.Equals, 50)
+}
+
+func (s *BatchSuite) TestBatchWriteConcurrency(c *check.C) {
+	batch := s.table.BatchWriteItems(puts(100))
+	batch.Concurrency = 4
+
+	unprocessed, err := batch.ExecuteAll()
+	c.Assert(err, check.IsNil)
+	c.Assert(unprocessed, check.IsNil)
+	c.Assert(s.requests, check.HasLen, 4)
+}
@@ -13,15 +13,20 @@ import (
 // A DynamoAttribute represents the union of possible DynamoDB attribute values.
 // See http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_AttributeValue.html
 //
-// Since the intention is to use this with JSON documents (or structs
-// representing JSON documents), the binary and set types have been omitted.
+// ToDynamo is intended for JSON documents (or structs representing JSON
+// documents) and never produces the binary and set types; use Marshal for
+// those.
 type DynamoAttribute struct {
 	S    *string                     `json:",omitempty"` // pointer so we can represent the zero-value
 	N    string                      `json:",omitempty"`
+	B    []byte                      `json:",omitempty"`
 	BOOL *bool                       `json:",omitempty"` // pointer so we can represent the zero-value
 	NULL bool                        `json:",omitempty"`
 	M    map[string]*DynamoAttribute `json:",omitempty"`
 	L    []*DynamoAttribute          `json:",omitempty"`
+	SS   []string                    `json:",omitempty"`
+	NS   []string                    `json:",omitempty"`
+	BS   [][]byte                    `json:",omitempty"`
 }
 
 // A DynamoItem represents a the top level item stored in DyanmoDB.
@@ -201,19 +206,11 @@ func undynamize(a *DynamoAttribute) interface{} {
 	}
 
 	if a.N != "" {
-		// Number is tricky b/c we don't know which numeric type to use. Here we
-		// simply try the different types from most to least restrictive.
-		if n, err := strconv.ParseInt(a.N, 10, 64); err == nil {
-			return int(n)
-		}
-		if n, err := strconv.ParseUint(a.N, 10, 64); err == nil {
-			return uint(n)
-		}
-		n, err := strconv.ParseFloat(a.N, 64)
-		if err != nil {
-			panic(err)
-		}
-		return n
+		return undynamizeNumber(a.N)
+	}
+
+	if a.B != nil {
+		return a.B
 	}
 
 	if a.BOOL != nil {
@@ -240,5 +237,37 @@ func undynamize(a *DynamoAttribute) interface{} {
 		return l
 	}
 
+	if a.SS != nil {
+		return a.SS
+	}
+
+	if a.NS != nil {
+		l := make([]interface{}, len(a.NS))
+		for index, n := range a.NS {
+			l[index] = undynamizeNumber(n)
+		}
+		return l
+	}
+
+	if a.BS != nil {
+		return a.BS
+	}
+
 	panic(fmt.Sprintf("unsupported dynamo attribute %#v", a))
 }
+
+func undynamizeNumber(s string) interface{} {
+	// Number is tricky b/c we don't know which numeric type to use. Here we
+	// simply try the different types from most to least restrictive.
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return int(n)
+	}
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return uint(n)
+	}
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
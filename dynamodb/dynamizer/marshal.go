@@ -0,0 +1,569 @@
+package dynamizer
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Marshal converts a struct, or a map with string keys, into a DynamoDB
+// item. Unlike ToDynamo it works on the Go values directly, so it can
+// produce binary and set attributes and keeps the full precision of
+// numbers.
+//
+// Struct fields are encoded under their name, or the name given by a
+// "dynamodb" struct tag. The tag may be followed by comma-separated
+// options:
+//
+//	Field int    `dynamodb:"-"`              // Field is ignored.
+//	Field int    `dynamodb:"name"`           // Field is stored as "name".
+//	Field int    `dynamodb:",omitempty"`     // Field is skipped if empty.
+//	Field []int  `dynamodb:",set"`           // Field is a number set.
+//	Field int64  `dynamodb:",string"`        // Field is stored as a string.
+//
+// Values are encoded as follows:
+//
+//   - bool as BOOL
+//   - integers, floats and json.Number as N
+//   - strings as S
+//   - []byte as B
+//   - slices and arrays as L, or as SS, NS or BS with the set option
+//   - maps with string keys and structs as M
+//   - nil pointers, interfaces, maps and slices as NULL
+//
+// Values implementing encoding.TextMarshaler, such as time.Time, are
+// stored as S. Empty sets can't be stored in DynamoDB, so set fields are
+// skipped when empty.
+func Marshal(in interface{}) (DynamoItem, error) {
+	v := reflect.ValueOf(in)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, errors.New("dynamizer: cannot marshal nil item")
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Struct, reflect.Map:
+		a, err := marshalValue(v, tagOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if a.M == nil {
+			return nil, errors.New("dynamizer: cannot marshal nil item")
+		}
+		return DynamoItem(a.M), nil
+	}
+	return nil, fmt.Errorf("dynamizer: item must be a struct or map, got %s", v.Type())
+}
+
+// Unmarshal stores the attributes of item in the struct or map pointed to
+// by out. It is the inverse of Marshal and follows the same struct tags.
+// Attributes that don't match any field are ignored, and fields without a
+// matching attribute are left untouched.
+//
+// Numbers can be decoded into any numeric type, into json.Number or into a
+// string, which preserves their full precision.
+func Unmarshal(item DynamoItem, out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return errors.New("dynamizer: out must be a non-nil pointer")
+	}
+	return unmarshalValue(&DynamoAttribute{M: item}, rv.Elem())
+}
+
+// UnmarshalListOfMaps stores items in the slice pointed to by out, such as
+// a *[]MyStruct, as Unmarshal does for each of them. The slice is replaced
+// by one with an element per item.
+func UnmarshalListOfMaps(items []DynamoItem, out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return errors.New("dynamizer: out must be a non-nil pointer to a slice")
+	}
+	slice := reflect.MakeSlice(rv.Elem().Type(), len(items), len(items))
+	for i, item := range items {
+		if err := unmarshalValue(&DynamoAttribute{M: item}, slice.Index(i)); err != nil {
+			return err
+		}
+	}
+	rv.Elem().Set(slice)
+	return nil
+}
+
+type tagOptions struct {
+	omitEmpty bool
+	set       bool
+	asString  bool
+}
+
+type structField struct {
+	name  string
+	index []int
+	opts  tagOptions
+}
+
+// structFields returns the encoded fields of t, including the fields of
+// embedded structs without a tag.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("dynamodb")
+		if tag == "-" {
+			continue
+		}
+		name, opts := parseTagOptions(tag)
+
+		if sf.Anonymous && name == "" {
+			ft := sf.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for _, f := range structFields(ft) {
+					f.index = append([]int{i}, f.index...)
+					fields = append(fields, f)
+				}
+				continue
+			}
+		}
+		if sf.PkgPath != "" { // unexported
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields = append(fields, structField{name: name, index: []int{i}, opts: opts})
+	}
+	return fields
+}
+
+func parseTagOptions(tag string) (string, tagOptions) {
+	parts := strings.Split(tag, ",")
+	var opts tagOptions
+	for _, o := range parts[1:] {
+		switch o {
+		case "omitempty":
+			opts.omitEmpty = true
+		case "set":
+			opts.set = true
+		case "string":
+			opts.asString = true
+		}
+	}
+	return parts[0], opts
+}
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+	numberType          = reflect.TypeOf(json.Number(""))
+)
+
+func marshalValue(v reflect.Value, opts tagOptions) (*DynamoAttribute, error) {
+	a := &DynamoAttribute{}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			a.NULL = true
+			return a, nil
+		}
+	}
+
+	if v.Type().Implements(textMarshalerType) {
+		text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		s := string(text)
+		a.S = &s
+		return a, nil
+	}
+
+	if v.Type() == numberType {
+		if opts.asString {
+			s := v.String()
+			a.S = &s
+		} else {
+			a.N = v.String()
+		}
+		return a, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return marshalValue(v.Elem(), opts)
+
+	case reflect.Bool:
+		a.BOOL = new(bool)
+		*a.BOOL = v.Bool()
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		n, err := formatNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		if opts.asString {
+			a.S = &n
+		} else {
+			a.N = n
+		}
+
+	case reflect.String:
+		s := v.String()
+		a.S = &s
+
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 && !opts.set {
+			b := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(b), v)
+			a.B = b
+			return a, nil
+		}
+		if opts.set {
+			return marshalSet(v)
+		}
+		a.L = make([]*DynamoAttribute, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elem, err := marshalValue(v.Index(i), tagOptions{})
+			if err != nil {
+				return nil, err
+			}
+			a.L[i] = elem
+		}
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("dynamizer: unsupported map key type %s", v.Type().Key())
+		}
+		a.M = make(map[string]*DynamoAttribute, v.Len())
+		for _, key := range v.MapKeys() {
+			elem, err := marshalValue(v.MapIndex(key), tagOptions{})
+			if err != nil {
+				return nil, err
+			}
+			a.M[key.String()] = elem
+		}
+
+	case reflect.Struct:
+		a.M = make(map[string]*DynamoAttribute)
+		for _, f := range structFields(v.Type()) {
+			fv, ok := fieldByIndex(v, f.index)
+			if !ok {
+				continue
+			}
+			if f.opts.omitEmpty && isEmptyValue(fv) {
+				continue
+			}
+			if f.opts.set && (fv.Kind() == reflect.Slice || fv.Kind() == reflect.Array) && fv.Len() == 0 {
+				continue
+			}
+			elem, err := marshalValue(fv, f.opts)
+			if err != nil {
+				return nil, fmt.Errorf("dynamizer: field %s: %v", f.name, err)
+			}
+			a.M[f.name] = elem
+		}
+
+	default:
+		return nil, fmt.Errorf("dynamizer: unsupported type %s", v.Type())
+	}
+
+	return a, nil
+}
+
+func marshalSet(v reflect.Value) (*DynamoAttribute, error) {
+	a := &DynamoAttribute{}
+	elemType := v.Type().Elem()
+	switch {
+	case elemType.Kind() == reflect.String && elemType != numberType:
+		a.SS = make([]string, v.Len())
+		for i := range a.SS {
+			a.SS[i] = v.Index(i).String()
+		}
+	case elemType == numberType:
+		a.NS = make([]string, v.Len())
+		for i := range a.NS {
+			a.NS[i] = v.Index(i).String()
+		}
+	case elemType.Kind() == reflect.Slice && elemType.Elem().Kind() == reflect.Uint8:
+		a.BS = make([][]byte, v.Len())
+		for i := range a.BS {
+			a.BS[i] = v.Index(i).Bytes()
+		}
+	default:
+		a.NS = make([]string, v.Len())
+		for i := range a.NS {
+			n, err := formatNumber(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			a.NS[i] = n
+		}
+	}
+	return a, nil
+}
+
+func formatNumber(v reflect.Value) (string, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return "", fmt.Errorf("dynamizer: unsupported number %v", f)
+		}
+		return strconv.FormatFloat(f, 'f', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("dynamizer: %s is not a number type", v.Type())
+}
+
+// fieldByIndex returns the field of v at index, or false if it is inside a
+// nil embedded pointer.
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+	return v, true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool:
+		return !v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() == 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return v.Uint() == 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() == 0
+	case reflect.Interface, reflect.Ptr:
+		return v.IsNil()
+	}
+	return false
+}
+
+func unmarshalValue(a *DynamoAttribute, v reflect.Value) error {
+	if a.NULL {
+		v.Set(reflect.Zero(v.Type()))
+		return nil
+	}
+
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return unmarshalValue(a, v.Elem())
+	}
+
+	if v.Kind() == reflect.Interface && v.NumMethod() == 0 {
+		v.Set(reflect.ValueOf(undynamize(a)))
+		return nil
+	}
+
+	if a.S != nil && reflect.PtrTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(*a.S))
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if a.BOOL == nil {
+			return unmarshalTypeError(a, v)
+		}
+		v.SetBool(*a.BOOL)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s, ok := numberString(a)
+		if !ok {
+			return unmarshalTypeError(a, v)
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || v.OverflowInt(n) {
+			return fmt.Errorf("dynamizer: cannot unmarshal %q into %s", s, v.Type())
+		}
+		v.SetInt(n)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s, ok := numberString(a)
+		if !ok {
+			return unmarshalTypeError(a, v)
+		}
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil || v.OverflowUint(n) {
+			return fmt.Errorf("dynamizer: cannot unmarshal %q into %s", s, v.Type())
+		}
+		v.SetUint(n)
+
+	case reflect.Float32, reflect.Float64:
+		s, ok := numberString(a)
+		if !ok {
+			return unmarshalTypeError(a, v)
+		}
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil || v.OverflowFloat(n) {
+			return fmt.Errorf("dynamizer: cannot unmarshal %q into %s", s, v.Type())
+		}
+		v.SetFloat(n)
+
+	case reflect.String:
+		s, ok := numberString(a)
+		if !ok {
+			return unmarshalTypeError(a, v)
+		}
+		v.SetString(s)
+
+	case reflect.Slice:
+		return unmarshalSlice(a, v)
+
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 && a.B != nil {
+			reflect.Copy(v, reflect.ValueOf(a.B))
+			return nil
+		}
+		if a.L == nil {
+			return unmarshalTypeError(a, v)
+		}
+		for i := 0; i < v.Len() && i < len(a.L); i++ {
+			if err := unmarshalValue(a.L[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		if a.M == nil || v.Type().Key().Kind() != reflect.String {
+			return unmarshalTypeError(a, v)
+		}
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		for key, elemAttr := range a.M {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			if err := unmarshalValue(elemAttr, elem); err != nil {
+				return err
+			}
+			v.SetMapIndex(reflect.ValueOf(key).Convert(v.Type().Key()), elem)
+		}
+
+	case reflect.Struct:
+		if a.M == nil {
+			return unmarshalTypeError(a, v)
+		}
+		for _, f := range structFields(v.Type()) {
+			elemAttr, ok := a.M[f.name]
+			if !ok {
+				continue
+			}
+			fv := v
+			for _, i := range f.index {
+				if fv.Kind() == reflect.Ptr {
+					if fv.IsNil() {
+						fv.Set(reflect.New(fv.Type().Elem()))
+					}
+					fv = fv.Elem()
+				}
+				fv = fv.Field(i)
+			}
+			if err := unmarshalValue(elemAttr, fv); err != nil {
+				return fmt.Errorf("dynamizer: field %s: %v", f.name, err)
+			}
+		}
+
+	default:
+		return unmarshalTypeError(a, v)
+	}
+
+	return nil
+}
+
+func unmarshalSlice(a *DynamoAttribute, v reflect.Value) error {
+	if v.Type().Elem().Kind() == reflect.Uint8 && a.B != nil {
+		v.SetBytes(append([]byte(nil), a.B...))
+		return nil
+	}
+
+	var elems []*DynamoAttribute
+	switch {
+	case a.L != nil:
+		elems = a.L
+	case a.SS != nil:
+		for i := range a.SS {
+			elems = append(elems, &DynamoAttribute{S: &a.SS[i]})
+		}
+	case a.NS != nil:
+		for _, n := range a.NS {
+			elems = append(elems, &DynamoAttribute{N: n})
+		}
+	case a.BS != nil:
+		for _, b := range a.BS {
+			elems = append(elems, &DynamoAttribute{B: b})
+		}
+	default:
+		return unmarshalTypeError(a, v)
+	}
+
+	s := reflect.MakeSlice(v.Type(), len(elems), len(elems))
+	for i, elemAttr := range elems {
+		if err := unmarshalValue(elemAttr, s.Index(i)); err != nil {
+			return err
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+// numberString returns the value of a number or string attribute. Numbers
+// may be stored as strings with the string tag option.
+func numberString(a *DynamoAttribute) (string, bool) {
+	if a.N != "" {
+		return a.N, true
+	}
+	if a.S != nil {
+		return *a.S, true
+	}
+	return "", false
+}
+
+func unmarshalTypeError(a *DynamoAttribute, v reflect.Value) error {
+	return fmt.Errorf("dynamizer: cannot unmarshal %s attribute into %s", attributeType(a), v.Type())
+}
+
+func attributeType(a *DynamoAttribute) string {
+	switch {
+	case a.S != nil:
+		return "S"
+	case a.N != "":
+		return "N"
+	case a.B != nil:
+		return "B"
+	case a.BOOL != nil:
+		return "BOOL"
+	case a.M != nil:
+		return "M"
+	case a.L != nil:
+		return "L"
+	case a.SS != nil:
+		return "SS"
+	case a.NS != nil:
+		return "NS"
+	case a.BS != nil:
+		return "BS"
+	}
+	return "empty"
+}
@@ -0,0 +1,235 @@
+package dynamizer
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type marshalAddress struct {
+	Street string `dynamodb:"street"`
+	Zip    string `dynamodb:"zip,omitempty"`
+}
+
+type MarshalEmbedded struct {
+	CreatedAt time.Time
+}
+
+type marshalStruct struct {
+	MarshalEmbedded
+	Id       string            `dynamodb:"id"`
+	Count    int               `dynamodb:"count"`
+	Price    float64           `dynamodb:"price,omitempty"`
+	Big      int64             `dynamodb:"big,string"`
+	Exact    json.Number       `dynamodb:"exact"`
+	Active   bool              `dynamodb:"active"`
+	Data     []byte            `dynamodb:"data,omitempty"`
+	Tags     []string          `dynamodb:"tags,set"`
+	Scores   []int             `dynamodb:"scores,set,omitempty"`
+	Blobs    [][]byte          `dynamodb:"blobs,set,omitempty"`
+	List     []interface{}     `dynamodb:"list"`
+	Address  *marshalAddress   `dynamodb:"address"`
+	Previous []marshalAddress  `dynamodb:"previous,omitempty"`
+	Attrs    map[string]string `dynamodb:"attrs,omitempty"`
+	Nothing  *marshalAddress   `dynamodb:"nothing"`
+	Ignored  string            `dynamodb:"-"`
+	private  string
+}
+
+var marshalTestItem = marshalStruct{
+	MarshalEmbedded: MarshalEmbedded{CreatedAt: time.Date(2015, 6, 1, 12, 0, 0, 0, time.UTC)},
+	Id:              "item1",
+	Count:           3,
+	Big:             9007199254740993,
+	Exact:           json.Number("12345678901234567890.5"),
+	Active:          true,
+	Data:            []byte("hello"),
+	Tags:            []string{"a", "b"},
+	Scores:          []int{1, 2},
+	Blobs:           [][]byte{[]byte("x")},
+	List:            []interface{}{"s", true},
+	Address:         &marshalAddress{Street: "1 Main St"},
+	Attrs:           map[string]string{"color": "red"},
+	Ignored:         "ignored",
+	private:         "private",
+}
+
+const marshalTestJSON = `{
+	"CreatedAt": {"S": "2015-06-01T12:00:00Z"},
+	"id": {"S": "item1"},
+	"count": {"N": "3"},
+	"big": {"S": "9007199254740993"},
+	"exact": {"N": "12345678901234567890.5"},
+	"active": {"BOOL": true},
+	"data": {"B": "aGVsbG8="},
+	"tags": {"SS": ["a", "b"]},
+	"scores": {"NS": ["1", "2"]},
+	"blobs": {"BS": ["eA=="]},
+	"list": {"L": [{"S": "s"}, {"BOOL": true}]},
+	"address": {"M": {"street": {"S": "1 Main St"}}},
+	"attrs": {"M": {"color": {"S": "red"}}},
+	"nothing": {"NULL": true}
+}`
+
+func TestMarshal(t *testing.T) {
+	item, err := Marshal(&marshalTestItem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected DynamoItem
+	if err := json.Unmarshal([]byte(marshalTestJSON), &expected); err != nil {
+		t.Fatal(err)
+	}
+	compareObjects(t, expected, item)
+}
+
+func TestMarshalOmitsEmptySets(t *testing.T) {
+	item, err := Marshal(marshalStruct{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := item["tags"]; ok {
+		t.Errorf("Expected the empty tags set to be omitted, got %#v", item["tags"])
+	}
+	if item["count"].N != "0" {
+		t.Errorf("Expected count to be kept without omitempty, got %#v", item["count"])
+	}
+	if _, ok := item["price"]; ok {
+		t.Errorf("Expected the zero price to be omitted")
+	}
+}
+
+func TestMarshalMap(t *testing.T) {
+	item, err := Marshal(map[string]interface{}{"n": 1.5, "m": map[string]int{"x": 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	compareObjects(t, map[string]interface{}{
+		"n": map[string]interface{}{"N": "1.5"},
+		"m": map[string]interface{}{"M": map[string]interface{}{"x": map[string]interface{}{"N": "1"}}},
+	}, item)
+}
+
+func TestMarshalErrors(t *testing.T) {
+	if _, err := Marshal("string"); err == nil {
+		t.Error("Expected an error when marshaling a string")
+	}
+	if _, err := Marshal((*marshalStruct)(nil)); err == nil {
+		t.Error("Expected an error when marshaling a nil pointer")
+	}
+	if _, err := Marshal(map[string]interface{}{"c": make(chan int)}); err == nil {
+		t.Error("Expected an error when marshaling a channel")
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	var item DynamoItem
+	if err := json.Unmarshal([]byte(marshalTestJSON), &item); err != nil {
+		t.Fatal(err)
+	}
+	var actual marshalStruct
+	if err := Unmarshal(item, &actual); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := marshalTestItem
+	expected.Ignored = ""
+	expected.private = ""
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Unexpected result:\n%#v\nexpected:\n%#v", actual, expected)
+	}
+}
+
+func TestUnmarshalNumbers(t *testing.T) {
+	item := DynamoItem{
+		"a": &DynamoAttribute{N: "12345678901234567890123"},
+		"b": &DynamoAttribute{N: "42"},
+		"c": &DynamoAttribute{NS: []string{"1.5", "2"}},
+		"d": &DynamoAttribute{N: "7"},
+	}
+	var out struct {
+		A string      `dynamodb:"a"`
+		B *uint8      `dynamodb:"b"`
+		C []float32   `dynamodb:"c"`
+		D interface{} `dynamodb:"d"`
+	}
+	if err := Unmarshal(item, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.A != "12345678901234567890123" || *out.B != 42 || !reflect.DeepEqual(out.C, []float32{1.5, 2}) || out.D != 7 {
+		t.Errorf("Unexpected result %#v", out)
+	}
+
+	var overflow struct {
+		B int8 `dynamodb:"a"`
+	}
+	if err := Unmarshal(item, &overflow); err == nil || !strings.Contains(err.Error(), "field a") {
+		t.Errorf("Expected an overflow error, got %v", err)
+	}
+}
+
+func TestUnmarshalMap(t *testing.T) {
+	item := DynamoItem{
+		"s": &DynamoAttribute{SS: []string{"x"}},
+		"m": &DynamoAttribute{M: map[string]*DynamoAttribute{"k": &DynamoAttribute{N: "1"}}},
+	}
+	var out map[string]interface{}
+	if err := Unmarshal(item, &out); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{
+		"s": []string{"x"},
+		"m": map[string]interface{}{"k": 1},
+	}
+	if !reflect.DeepEqual(expected, out) {
+		t.Errorf("Unexpected result %#v", out)
+	}
+}
+
+func TestUnmarshalTypeMismatch(t *testing.T) {
+	s := "x"
+	item := DynamoItem{"count": &DynamoAttribute{BOOL: new(bool)}, "id": &DynamoAttribute{S: &s}}
+	var out marshalStruct
+	err := Unmarshal(item, &out)
+	if err == nil || err.Error() != "dynamizer: field count: dynamizer: cannot unmarshal BOOL attribute into int" {
+		t.Errorf("Unexpected error %v", err)
+	}
+	if err := Unmarshal(item, out); err == nil {
+		t.Error("Expected an error when unmarshaling into a non-pointer")
+	}
+}
+
+func TestUnmarshalListOfMaps(t *testing.T) {
+	var items []DynamoItem
+	err := json.Unmarshal([]byte(`[
+		{"street": {"S": "Main St"}, "zip": {"S": "12345"}},
+		{"street": {"S": "Elm St"}}
+	]`), &items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := []marshalAddress{{Street: "stale"}, {}, {}}
+	if err := UnmarshalListOfMaps(items, &out); err != nil {
+		t.Fatal(err)
+	}
+	expected := []marshalAddress{{"Main St", "12345"}, {"Elm St", ""}}
+	if !reflect.DeepEqual(out, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, out)
+	}
+
+	var ptrs []*marshalAddress
+	if err := UnmarshalListOfMaps(items, &ptrs); err != nil {
+		t.Fatal(err)
+	}
+	if len(ptrs) != 2 || *ptrs[1] != expected[1] {
+		t.Errorf("Expected pointers to %#v, got %#v", expected, ptrs)
+	}
+
+	var notSlice marshalAddress
+	if err := UnmarshalListOfMaps(items, &notSlice); err == nil {
+		t.Error("Expected an error unmarshalling into a struct")
+	}
+}
@@ -30,6 +30,10 @@ type Error struct {
 	Status     string
 	Code       string // Dynamodb error code ("MalformedQueryString", ...)
 	Message    string // The human-oriented error message
+
+	// Set for TransactionCanceledException errors, with one reason for
+	// each action of the transaction.
+	CancellationReasons []CancellationReason
 }
 
 func (e Error) Error() string {
@@ -39,6 +43,10 @@ func (e Error) Error() string {
 	return e.Code
 }
 
+func (e Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, e, e.StatusCode, e.Code, e.Message, "")
+}
+
 func (e Error) ErrorCode() string {
 	return e.Code
 }
@@ -69,6 +77,19 @@ func buildError(r *http.Response, jsonBody []byte) error {
 	}
 	ddbError.Code = codeStr
 
+	reasons, _ := json.Get("CancellationReasons").Array()
+	for i := range reasons {
+		reason := json.Get("CancellationReasons").GetIndex(i)
+		cr := CancellationReason{
+			Code:    reason.Get("Code").MustString(),
+			Message: reason.Get("Message").MustString(),
+		}
+		if item, err := reason.Get("Item").Map(); err == nil {
+			cr.Item = parseAttributes(item)
+		}
+		ddbError.CancellationReasons = append(ddbError.CancellationReasons, cr)
+	}
+
 	return &ddbError
 }
 
@@ -127,6 +148,7 @@ func (s *Server) queryServer(target string, query Query) ([]byte, error) {
 			return nil, err
 		}
 
+		aws.RecordSuccess(s.RetryPolicy, target, numRetries)
 		return body, nil
 	}
 }
@@ -0,0 +1,192 @@
+package dynamodb
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ExpressionBuilder generates the text of condition expressions, replacing
+// every attribute name and value with a placeholder so that reserved words
+// and special characters need no escaping.
+//
+// The expressions of a single request share their placeholders, so build
+// all of them (for example a key condition and a filter) with the same
+// builder:
+//
+//	b := NewExpressionBuilder()
+//	key := b.Condition(*NewStringAttributeComparison("user", COMPARISON_EQUAL, "alice"))
+//	filter := b.Condition(*NewNumericAttributeComparison("count", COMPARISON_GREATER_THAN, 5))
+//	q.AddKeyConditionExpression(b.Expression(key))
+//	q.AddFilterExpression(b.Expression(filter))
+//
+// Every name and value given to the builder ends up in the request, and
+// DynamoDB rejects requests with unused placeholders, so don't build
+// conditions that won't be sent.
+type ExpressionBuilder struct {
+	names  map[string]string // attribute name -> placeholder
+	values []Attribute
+	err    error
+}
+
+func NewExpressionBuilder() *ExpressionBuilder {
+	return &ExpressionBuilder{names: map[string]string{}}
+}
+
+// A path element with an optional list index, such as "items[0]".
+var expressionPathElement = regexp.MustCompile(`^([^\[\]]+)((?:\[[0-9]+\])*)$`)
+
+// Name returns the placeholder for an attribute path. Nested attributes are
+// separated by dots and list elements are selected with [n], as in
+// "address.lines[0]".
+func (b *ExpressionBuilder) Name(path string) string {
+	elements := strings.Split(path, ".")
+	for i, element := range elements {
+		m := expressionPathElement.FindStringSubmatch(element)
+		if m == nil {
+			b.setError(fmt.Errorf("invalid attribute path %q", path))
+			return path
+		}
+		placeholder, ok := b.names[m[1]]
+		if !ok {
+			placeholder = fmt.Sprintf("#n%d", len(b.names))
+			b.names[m[1]] = placeholder
+		}
+		elements[i] = placeholder + m[2]
+	}
+	return strings.Join(elements, ".")
+}
+
+// Value returns the placeholder for an attribute value. The name of a is
+// ignored.
+func (b *ExpressionBuilder) Value(a Attribute) string {
+	a.Name = fmt.Sprintf(":v%d", len(b.values))
+	b.values = append(b.values, a)
+	return a.Name
+}
+
+var comparisonOperators = map[string]string{
+	COMPARISON_EQUAL:                 "=",
+	COMPARISON_NOT_EQUAL:             "<>",
+	COMPARISON_LESS_THAN_OR_EQUAL:    "<=",
+	COMPARISON_LESS_THAN:             "<",
+	COMPARISON_GREATER_THAN_OR_EQUAL: ">=",
+	COMPARISON_GREATER_THAN:          ">",
+}
+
+// Condition returns the expression equivalent of an attribute comparison,
+// so the comparisons used with AddKeyConditions and AddQueryFilter can be
+// reused as expressions. Only the operators supported by DynamoDB
+// expressions are accepted; BEGINS_WITH and BETWEEN are the only ones
+// allowed besides EQ, LT, LE, GT and GE in key conditions.
+func (b *ExpressionBuilder) Condition(c AttributeComparison) string {
+	name := b.Name(c.AttributeName)
+	values := c.AttributeValueList
+
+	want := 1
+	switch c.ComparisonOperator {
+	case COMPARISON_ATTRIBUTE_EXISTS, COMPARISON_ATTRIBUTE_DOES_NOT_EXIST:
+		want = 0
+	case COMPARISON_BETWEEN:
+		want = 2
+	case COMPARISON_IN:
+		want = len(values)
+		if want == 0 {
+			want = 1
+		}
+	}
+	if len(values) != want {
+		b.setError(fmt.Errorf("%s comparison on %q needs %d values, got %d", c.ComparisonOperator, c.AttributeName, want, len(values)))
+		return ""
+	}
+
+	if op, ok := comparisonOperators[c.ComparisonOperator]; ok {
+		return fmt.Sprintf("%s %s %s", name, op, b.Value(values[0]))
+	}
+	switch c.ComparisonOperator {
+	case COMPARISON_ATTRIBUTE_EXISTS:
+		return fmt.Sprintf("attribute_exists(%s)", name)
+	case COMPARISON_ATTRIBUTE_DOES_NOT_EXIST:
+		return fmt.Sprintf("attribute_not_exists(%s)", name)
+	case COMPARISON_BEGINS_WITH:
+		return fmt.Sprintf("begins_with(%s, %s)", name, b.Value(values[0]))
+	case COMPARISON_CONTAINS:
+		return fmt.Sprintf("contains(%s, %s)", name, b.Value(values[0]))
+	case COMPARISON_DOES_NOT_CONTAIN:
+		return fmt.Sprintf("NOT contains(%s, %s)", name, b.Value(values[0]))
+	case COMPARISON_BETWEEN:
+		return fmt.Sprintf("%s BETWEEN %s AND %s", name, b.Value(values[0]), b.Value(values[1]))
+	case COMPARISON_IN:
+		placeholders := make([]string, len(values))
+		for i, v := range values {
+			placeholders[i] = b.Value(v)
+		}
+		return fmt.Sprintf("%s IN (%s)", name, strings.Join(placeholders, ", "))
+	}
+	b.setError(fmt.Errorf("unsupported comparison operator %q", c.ComparisonOperator))
+	return ""
+}
+
+// Conditions returns the conjunction of the expressions of comparisons.
+func (b *ExpressionBuilder) Conditions(comparisons []AttributeComparison) string {
+	conditions := make([]string, len(comparisons))
+	for i, c := range comparisons {
+		conditions[i] = b.Condition(c)
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+// And returns the conjunction of conditions.
+func (b *ExpressionBuilder) And(conditions ...string) string {
+	return joinConditions(conditions, " AND ")
+}
+
+// Or returns the disjunction of conditions.
+func (b *ExpressionBuilder) Or(conditions ...string) string {
+	return joinConditions(conditions, " OR ")
+}
+
+// Not returns the negation of condition.
+func (b *ExpressionBuilder) Not(condition string) string {
+	return "NOT (" + condition + ")"
+}
+
+func joinConditions(conditions []string, op string) string {
+	if len(conditions) == 1 {
+		return conditions[0]
+	}
+	parenthesized := make([]string, len(conditions))
+	for i, c := range conditions {
+		parenthesized[i] = "(" + c + ")"
+	}
+	return strings.Join(parenthesized, op)
+}
+
+// Expression returns an Expression with the given text and every
+// placeholder created by b so far. It returns nil if any of the conditions
+// given to b was invalid; Err returns the reason.
+func (b *ExpressionBuilder) Expression(text string) *Expression {
+	if b.err != nil {
+		return nil
+	}
+	e := &Expression{
+		Text:            text,
+		AttributeNames:  make(map[string]string, len(b.names)),
+		AttributeValues: append([]Attribute(nil), b.values...),
+	}
+	for name, placeholder := range b.names {
+		e.AttributeNames[placeholder] = name
+	}
+	return e
+}
+
+// Err returns the first error found while building conditions.
+func (b *ExpressionBuilder) Err() error {
+	return b.err
+}
+
+func (b *ExpressionBuilder) setError(err error) {
+	if b.err == nil {
+		b.err = fmt.Errorf("dynamodb: %v", err)
+	}
+}
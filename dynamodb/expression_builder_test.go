@@ -0,0 +1,104 @@
+package dynamodb
+
+import (
+	simplejson "github.com/bitly/go-simplejson"
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+type ExpressionBuilderSuite struct{}
+
+var _ = check.Suite(&ExpressionBuilderSuite{})
+
+func (s *ExpressionBuilderSuite) TestConditions(c *check.C) {
+	b := NewExpressionBuilder()
+	for _, t := range []struct {
+		comparison AttributeComparison
+		expected   string
+	}{
+		{*NewStringAttributeComparison("name", COMPARISON_EQUAL, "a"), "#n0 = :v0"},
+		{*NewNumericAttributeComparison("count", COMPARISON_GREATER_THAN, 5), "#n1 > :v1"},
+		{*NewStringAttributeComparison("name", COMPARISON_BEGINS_WITH, "b"), "begins_with(#n0, :v2)"},
+		{*NewStringAttributeComparison("tags", COMPARISON_DOES_NOT_CONTAIN, "c"), "NOT contains(#n2, :v3)"},
+		{AttributeComparison{"deleted", COMPARISON_ATTRIBUTE_DOES_NOT_EXIST, nil}, "attribute_not_exists(#n3)"},
+		{AttributeComparison{"count", COMPARISON_BETWEEN, []Attribute{
+			*NewNumericAttribute("", "1"), *NewNumericAttribute("", "9"),
+		}}, "#n1 BETWEEN :v4 AND :v5"},
+		{AttributeComparison{"name", COMPARISON_IN, []Attribute{
+			*NewStringAttribute("", "x"), *NewStringAttribute("", "y"),
+		}}, "#n0 IN (:v6, :v7)"},
+	} {
+		c.Check(b.Condition(t.comparison), check.Equals, t.expected)
+	}
+	c.Check(b.Err(), check.IsNil)
+}
+
+func (s *ExpressionBuilderSuite) TestNestedNames(c *check.C) {
+	b := NewExpressionBuilder()
+	c.Check(b.Name("address.lines[0]"), check.Equals, "#n0.#n1[0]")
+	c.Check(b.Name("lines.address"), check.Equals, "#n1.#n0")
+	c.Check(b.Expression("").AttributeNames, check.DeepEquals, map[string]string{
+		"#n0": "address",
+		"#n1": "lines",
+	})
+}
+
+func (s *ExpressionBuilderSuite) TestAndOrNot(c *check.C) {
+	b := NewExpressionBuilder()
+	c.Check(b.And("a", b.Or("b", "c")), check.Equals, "(a) AND ((b) OR (c))")
+	c.Check(b.And("a"), check.Equals, "a")
+	c.Check(b.Not("a"), check.Equals, "NOT (a)")
+}
+
+func (s *ExpressionBuilderSuite) TestInvalidConditions(c *check.C) {
+	b := NewExpressionBuilder()
+	b.Condition(AttributeComparison{"count", COMPARISON_BETWEEN, []Attribute{*NewNumericAttribute("", "1")}})
+	c.Check(b.Err(), check.ErrorMatches, `dynamodb: BETWEEN comparison on "count" needs 2 values, got 1`)
+	c.Check(b.Expression("x"), check.IsNil)
+
+	b = NewExpressionBuilder()
+	b.Name("a..b")
+	c.Check(b.Err(), check.ErrorMatches, `dynamodb: invalid attribute path "a..b"`)
+}
+
+func (s *ExpressionBuilderSuite) TestKeyConditionAndFilterExpression(c *check.C) {
+	auth := &aws.Auth{AccessKey: "", SecretKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+	server := New(*auth, aws.USEast)
+	table := server.NewTable("sites", PrimaryKey{NewStringAttribute("domain", ""), nil})
+
+	b := NewExpressionBuilder()
+	key := b.Condition(*NewStringAttributeComparison("domain", COMPARISON_EQUAL, "example.com"))
+	filter := b.Conditions([]AttributeComparison{
+		*NewNumericAttributeComparison("count", COMPARISON_GREATER_THAN, 5),
+		{"status", COMPARISON_ATTRIBUTE_EXISTS, nil},
+	})
+
+	q := NewQuery(table)
+	q.AddKeyConditionExpression(b.Expression(key))
+	q.AddFilterExpression(b.Expression(filter))
+	queryJson, err := simplejson.NewJson([]byte(q.String()))
+	if err != nil {
+		c.Fatal(err)
+	}
+
+	expectedJson, err := simplejson.NewJson([]byte(`
+{
+  "KeyConditionExpression": "#n0 = :v0",
+  "FilterExpression": "#n1 > :v1 AND attribute_exists(#n2)",
+  "ExpressionAttributeNames": {
+    "#n0": "domain",
+    "#n1": "count",
+    "#n2": "status"
+  },
+  "ExpressionAttributeValues": {
+    ":v0": {"S": "example.com"},
+    ":v1": {"N": "5"}
+  },
+  "TableName": "sites"
+}
+	`))
+	if err != nil {
+		c.Fatal(err)
+	}
+	c.Check(queryJson, check.DeepEquals, expectedJson)
+}
@@ -12,22 +12,30 @@ import (
 type BatchGetItem struct {
 	Server *Server
 	Keys   map[*Table][]Key
+
+	// Concurrency is the most requests ExecuteAll runs at once. It runs
+	// one at a time when Concurrency is 0.
+	Concurrency int
 }
 
 type BatchWriteItem struct {
 	Server      *Server
 	ItemActions map[*Table]map[string][][]Attribute
+
+	// Concurrency is the most requests ExecuteAll runs at once. It runs
+	// one at a time when Concurrency is 0.
+	Concurrency int
 }
 
 func (t *Table) BatchGetItems(keys []Key) *BatchGetItem {
-	batchGetItem := &BatchGetItem{t.Server, make(map[*Table][]Key)}
+	batchGetItem := &BatchGetItem{Server: t.Server, Keys: make(map[*Table][]Key)}
 
 	batchGetItem.Keys[t] = keys
 	return batchGetItem
 }
 
 func (t *Table) BatchWriteItems(itemActions map[string][][]Attribute) *BatchWriteItem {
-	batchWriteItem := &BatchWriteItem{t.Server, make(map[*Table]map[string][][]Attribute)}
+	batchWriteItem := &BatchWriteItem{Server: t.Server, ItemActions: make(map[*Table]map[string][][]Attribute)}
 
 	batchWriteItem.ItemActions[t] = itemActions
 	return batchWriteItem
@@ -0,0 +1,94 @@
+package dynamodb
+
+import (
+	"encoding/json"
+)
+
+// Statuses of a Kinesis streaming destination of a table.
+const (
+	KinesisDestinationEnabling     = "ENABLING"
+	KinesisDestinationActive       = "ACTIVE"
+	KinesisDestinationDisabling    = "DISABLING"
+	KinesisDestinationDisabled     = "DISABLED"
+	KinesisDestinationEnableFailed = "ENABLE_FAILED"
+)
+
+// KinesisDataStreamDestination is a Kinesis data stream that the item
+// changes of a table are written to. DestinationStatusDescription tells
+// why a destination failed to be enabled.
+type KinesisDataStreamDestination struct {
+	StreamArn                    string
+	DestinationStatus            string
+	DestinationStatusDescription string
+}
+
+// KinesisStreamingDestinationResp is the response of
+// EnableKinesisStreamingDestination and DisableKinesisStreamingDestination.
+// DestinationStatus is ENABLING or DISABLING; the destination is ACTIVE or
+// DISABLED once DescribeKinesisStreamingDestination says so.
+type KinesisStreamingDestinationResp struct {
+	TableName         string
+	StreamArn         string
+	DestinationStatus string
+}
+
+// EnableKinesisStreamingDestination starts writing the item changes of the
+// table to the Kinesis data stream streamArn, where they can be read as any
+// other stream. The table must be ACTIVE, and have at most two streaming
+// destinations.
+//
+// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_EnableKinesisStreamingDestination.html
+func (t *Table) EnableKinesisStreamingDestination(streamArn string) (*KinesisStreamingDestinationResp, error) {
+	return t.kinesisStreamingDestination("EnableKinesisStreamingDestination", streamArn)
+}
+
+// DisableKinesisStreamingDestination stops writing the item changes of the
+// table to the Kinesis data stream streamArn.
+//
+// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_DisableKinesisStreamingDestination.html
+func (t *Table) DisableKinesisStreamingDestination(streamArn string) (*KinesisStreamingDestinationResp, error) {
+	return t.kinesisStreamingDestination("DisableKinesisStreamingDestination", streamArn)
+}
+
+func (t *Table) kinesisStreamingDestination(action, streamArn string) (*KinesisStreamingDestinationResp, error) {
+	q := NewEmptyQuery()
+	q.addTableByName(t.Name)
+	q.buffer["StreamArn"] = streamArn
+
+	jsonResponse, err := t.Server.queryServer(target(action), q)
+	if err != nil {
+		return nil, err
+	}
+
+	var r KinesisStreamingDestinationResp
+	if err := json.Unmarshal(jsonResponse, &r); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+type describeKinesisStreamingDestinationResponse struct {
+	TableName                     string
+	KinesisDataStreamDestinations []KinesisDataStreamDestination
+}
+
+// DescribeKinesisStreamingDestination returns the Kinesis data streams
+// that the item changes of the table are written to, including the
+// disabled ones.
+//
+// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_DescribeKinesisStreamingDestination.html
+func (t *Table) DescribeKinesisStreamingDestination() ([]KinesisDataStreamDestination, error) {
+	q := NewEmptyQuery()
+	q.addTableByName(t.Name)
+
+	jsonResponse, err := t.Server.queryServer(target("DescribeKinesisStreamingDestination"), q)
+	if err != nil {
+		return nil, err
+	}
+
+	var r describeKinesisStreamingDestinationResponse
+	if err := json.Unmarshal(jsonResponse, &r); err != nil {
+		return nil, err
+	}
+	return r.KinesisDataStreamDestinations, nil
+}
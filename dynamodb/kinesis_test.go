@@ -0,0 +1,67 @@
+package dynamodb
+
+import (
+	"gopkg.in/check.v1"
+)
+
+// The Kinesis streaming destination tests reuse the recording server of
+// TransactSuite.
+
+func (s *TransactSuite) TestEnableKinesisStreamingDestination(c *check.C) {
+	s.response = `{"TableName": "accounts", "StreamArn": "arn:aws:kinesis:us-east-1:123456789012:stream/accounts", "DestinationStatus": "ENABLING"}`
+
+	resp, err := s.accounts.EnableKinesisStreamingDestination("arn:aws:kinesis:us-east-1:123456789012:stream/accounts")
+	c.Assert(err, check.IsNil)
+	c.Assert(s.targets, check.DeepEquals, []string{"DynamoDB_20120810.EnableKinesisStreamingDestination"})
+	c.Assert(s.requests[0], check.DeepEquals, map[string]interface{}{
+		"TableName": "accounts",
+		"StreamArn": "arn:aws:kinesis:us-east-1:123456789012:stream/accounts",
+	})
+	c.Assert(resp, check.DeepEquals, &KinesisStreamingDestinationResp{
+		TableName:         "accounts",
+		StreamArn:         "arn:aws:kinesis:us-east-1:123456789012:stream/accounts",
+		DestinationStatus: KinesisDestinationEnabling,
+	})
+}
+
+func (s *TransactSuite) TestDisableKinesisStreamingDestination(c *check.C) {
+	s.response = `{"TableName": "accounts", "StreamArn": "arn:aws:kinesis:us-east-1:123456789012:stream/accounts", "DestinationStatus": "DISABLING"}`
+
+	resp, err := s.accounts.DisableKinesisStreamingDestination("arn:aws:kinesis:us-east-1:123456789012:stream/accounts")
+	c.Assert(err, check.IsNil)
+	c.Assert(s.targets, check.DeepEquals, []string{"DynamoDB_20120810.DisableKinesisStreamingDestination"})
+	c.Assert(resp.DestinationStatus, check.Equals, KinesisDestinationDisabling)
+}
+
+func (s *TransactSuite) TestDescribeKinesisStreamingDestination(c *check.C) {
+	s.response = `{
+	  "TableName": "accounts",
+	  "KinesisDataStreamDestinations": [
+	    {"StreamArn": "arn:aws:kinesis:us-east-1:123456789012:stream/accounts", "DestinationStatus": "ACTIVE"},
+	    {"StreamArn": "arn:aws:kinesis:us-east-1:123456789012:stream/audit", "DestinationStatus": "ENABLE_FAILED",
+	     "DestinationStatusDescription": "The stream is not ACTIVE"}
+	  ]
+	}`
+
+	dests, err := s.accounts.DescribeKinesisStreamingDestination()
+	c.Assert(err, check.IsNil)
+	c.Assert(s.targets, check.DeepEquals, []string{"DynamoDB_20120810.DescribeKinesisStreamingDestination"})
+	c.Assert(s.requests[0], check.DeepEquals, map[string]interface{}{"TableName": "accounts"})
+	c.Assert(dests, check.DeepEquals, []KinesisDataStreamDestination{
+		{StreamArn: "arn:aws:kinesis:us-east-1:123456789012:stream/accounts", DestinationStatus: KinesisDestinationActive},
+		{
+			StreamArn:                    "arn:aws:kinesis:us-east-1:123456789012:stream/audit",
+			DestinationStatus:            KinesisDestinationEnableFailed,
+			DestinationStatusDescription: "The stream is not ACTIVE",
+		},
+	})
+}
+
+func (s *TransactSuite) TestKinesisStreamingDestinationError(c *check.C) {
+	s.status = 400
+	s.response = `{"__type": "com.amazonaws.dynamodb.v20120810#ResourceNotFoundException", "message": "Requested resource not found"}`
+
+	_, err := s.accounts.EnableKinesisStreamingDestination("arn:aws:kinesis:us-east-1:123456789012:stream/missing")
+	c.Assert(err, check.NotNil)
+	c.Assert(err.(*Error).Code, check.Equals, "ResourceNotFoundException")
+}
@@ -0,0 +1,143 @@
+package dynamodb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	simplejson "github.com/bitly/go-simplejson"
+	"github.com/zackbloom/goamz/dynamodb/dynamizer"
+)
+
+// QueryPages runs q and calls cb with the items of every page of results,
+// following LastEvaluatedKey until the query is exhausted or cb returns an
+// error. Unlike QueryTableCallbackIterator, it works for queries on
+// secondary indexes, whose LastEvaluatedKey includes the index keys.
+//
+// The ExclusiveStartKey of q is overwritten as pages are fetched.
+func (t *Table) QueryPages(q *UntypedQuery, cb func([]map[string]*Attribute) error) error {
+	return t.pages("Query", q, cb)
+}
+
+// QueryAll runs q and returns the items of all of its pages.
+func (t *Table) QueryAll(q *UntypedQuery) ([]map[string]*Attribute, error) {
+	var results []map[string]*Attribute
+	err := t.QueryPages(q, func(items []map[string]*Attribute) error {
+		results = append(results, items...)
+		return nil
+	})
+	return results, err
+}
+
+// ScanPages is like QueryPages for Scan requests.
+func (t *Table) ScanPages(q *UntypedQuery, cb func([]map[string]*Attribute) error) error {
+	return t.pages("Scan", q, cb)
+}
+
+// ScanAll runs the scan q and returns the items of all of its pages.
+func (t *Table) ScanAll(q *UntypedQuery) ([]map[string]*Attribute, error) {
+	var results []map[string]*Attribute
+	err := t.ScanPages(q, func(items []map[string]*Attribute) error {
+		results = append(results, items...)
+		return nil
+	})
+	return results, err
+}
+
+// QueryItemPages is like QueryPages but calls cb with the items as they
+// were received, ready to be unmarshalled with dynamizer.Unmarshal or
+// dynamizer.UnmarshalListOfMaps.
+func (t *Table) QueryItemPages(q *UntypedQuery, cb func([]dynamizer.DynamoItem) error) error {
+	return t.itemPages("Query", q, cb)
+}
+
+// QueryAllInto runs q and stores the items of all of its pages in the
+// slice pointed to by out, such as a *[]MyStruct, as
+// dynamizer.UnmarshalListOfMaps does.
+func (t *Table) QueryAllInto(q *UntypedQuery, out interface{}) error {
+	return t.allInto("Query", q, out)
+}
+
+// ScanItemPages is like QueryItemPages for Scan requests.
+func (t *Table) ScanItemPages(q *UntypedQuery, cb func([]dynamizer.DynamoItem) error) error {
+	return t.itemPages("Scan", q, cb)
+}
+
+// ScanAllInto is like QueryAllInto for Scan requests.
+func (t *Table) ScanAllInto(q *UntypedQuery, out interface{}) error {
+	return t.allInto("Scan", q, out)
+}
+
+func (t *Table) allInto(action string, q *UntypedQuery, out interface{}) error {
+	var items []dynamizer.DynamoItem
+	err := t.itemPages(action, q, func(page []dynamizer.DynamoItem) error {
+		items = append(items, page...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return dynamizer.UnmarshalListOfMaps(items, out)
+}
+
+// itemPages is like pages, but decodes the items straight into their
+// dynamizer form instead of going through simplejson and Attribute.
+func (t *Table) itemPages(action string, q *UntypedQuery, cb func([]dynamizer.DynamoItem) error) error {
+	for {
+		jsonResponse, err := t.Server.queryServer(target(action), q)
+		if err != nil {
+			return err
+		}
+		var page struct {
+			Items            []dynamizer.DynamoItem
+			LastEvaluatedKey map[string]interface{}
+		}
+		if err := json.Unmarshal(jsonResponse, &page); err != nil {
+			return fmt.Errorf("Unexpected response %s", jsonResponse)
+		}
+		if err := cb(page.Items); err != nil {
+			return err
+		}
+
+		if len(page.LastEvaluatedKey) == 0 {
+			return nil
+		}
+		q.buffer["ExclusiveStartKey"] = page.LastEvaluatedKey
+	}
+}
+
+func (t *Table) pages(action string, q *UntypedQuery, cb func([]map[string]*Attribute) error) error {
+	for {
+		jsonResponse, err := t.Server.queryServer(target(action), q)
+		if err != nil {
+			return err
+		}
+		json, err := simplejson.NewJson(jsonResponse)
+		if err != nil {
+			return err
+		}
+
+		items, err := json.Get("Items").Array()
+		if err != nil {
+			return fmt.Errorf("Unexpected response %s", jsonResponse)
+		}
+		results := make([]map[string]*Attribute, len(items))
+		for i, item := range items {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("Unexpected response %s", jsonResponse)
+			}
+			results[i] = parseAttributes(m)
+		}
+		if err := cb(results); err != nil {
+			return err
+		}
+
+		// The key is sent back as is, so it needn't match the table's
+		// primary key.
+		lastEvaluatedKey, err := json.Get("LastEvaluatedKey").Map()
+		if err != nil || len(lastEvaluatedKey) == 0 {
+			return nil
+		}
+		q.buffer["ExclusiveStartKey"] = lastEvaluatedKey
+	}
+}
@@ -0,0 +1,118 @@
+package dynamodb
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/dynamodb/dynamizer"
+	"gopkg.in/check.v1"
+)
+
+type PagesSuite struct{}
+
+var _ = check.Suite(&PagesSuite{})
+
+var pagesResponses = []string{
+	`{"Count": 2, "Items": [{"domain": {"S": "a"}}, {"domain": {"S": "b"}}],
+	  "LastEvaluatedKey": {"domain": {"S": "b"}, "owner": {"S": "x"}}}`,
+	`{"Count": 1, "Items": [{"domain": {"S": "c"}}]}`,
+}
+
+// pagesServer serves pagesResponses in order and records the requests made.
+func pagesServer(c *check.C, requests *[]map[string]interface{}) (*httptest.Server, *Table) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			c.Fatal(err)
+		}
+		n := len(*requests)
+		*requests = append(*requests, req)
+		io.WriteString(w, pagesResponses[n])
+	}))
+	auth := aws.Auth{AccessKey: "key", SecretKey: "secret"}
+	s := New(auth, aws.Region{Name: "us-east-1", DynamoDBEndpoint: server.URL})
+	table := s.NewTable("sites", PrimaryKey{NewStringAttribute("domain", ""), nil})
+	return server, table
+}
+
+func (s *PagesSuite) TestQueryAll(c *check.C) {
+	var requests []map[string]interface{}
+	server, table := pagesServer(c, &requests)
+	defer server.Close()
+
+	q := NewQuery(table)
+	q.AddIndex("owner-index")
+	items, err := table.QueryAll(q)
+	c.Assert(err, check.IsNil)
+	c.Assert(items, check.HasLen, 3)
+	c.Check(items[2]["domain"].Value, check.Equals, "c")
+
+	c.Assert(requests, check.HasLen, 2)
+	c.Check(requests[0]["ExclusiveStartKey"], check.IsNil)
+	c.Check(requests[1]["ExclusiveStartKey"], check.DeepEquals, map[string]interface{}{
+		"domain": map[string]interface{}{"S": "b"},
+		"owner":  map[string]interface{}{"S": "x"},
+	})
+}
+
+func (s *PagesSuite) TestScanPagesStopsOnError(c *check.C) {
+	var requests []map[string]interface{}
+	server, table := pagesServer(c, &requests)
+	defer server.Close()
+
+	stop := &Error{Message: "stop"}
+	pages := 0
+	err := table.ScanPages(NewQuery(table), func(items []map[string]*Attribute) error {
+		pages++
+		return stop
+	})
+	c.Check(err, check.Equals, stop)
+	c.Check(pages, check.Equals, 1)
+	c.Check(requests, check.HasLen, 1)
+}
+
+type pagesSite struct {
+	Domain string `dynamodb:"domain"`
+}
+
+func (s *PagesSuite) TestQueryAllInto(c *check.C) {
+	var requests []map[string]interface{}
+	server, table := pagesServer(c, &requests)
+	defer server.Close()
+
+	q := NewQuery(table)
+	q.AddIndex("owner-index")
+	var sites []pagesSite
+	err := table.QueryAllInto(q, &sites)
+	c.Assert(err, check.IsNil)
+	c.Check(sites, check.DeepEquals, []pagesSite{{"a"}, {"b"}, {"c"}})
+
+	c.Assert(requests, check.HasLen, 2)
+	c.Check(requests[1]["ExclusiveStartKey"], check.DeepEquals, map[string]interface{}{
+		"domain": map[string]interface{}{"S": "b"},
+		"owner":  map[string]interface{}{"S": "x"},
+	})
+}
+
+func (s *PagesSuite) TestScanItemPages(c *check.C) {
+	var requests []map[string]interface{}
+	server, table := pagesServer(c, &requests)
+	defer server.Close()
+
+	var sites []*pagesSite
+	err := table.ScanItemPages(NewQuery(table), func(items []dynamizer.DynamoItem) error {
+		var page []*pagesSite
+		if err := dynamizer.UnmarshalListOfMaps(items, &page); err != nil {
+			return err
+		}
+		sites = append(sites, page...)
+		return nil
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(sites, check.HasLen, 3)
+	c.Check(sites[1].Domain, check.Equals, "b")
+	c.Check(requests, check.HasLen, 2)
+}
@@ -299,6 +299,12 @@ func (q *UntypedQuery) AddConditionExpression(e *Expression) {
 	q.addExpressionAttributeValues(e)
 }
 
+func (q *UntypedQuery) AddKeyConditionExpression(e *Expression) {
+	q.buffer["KeyConditionExpression"] = e.Text
+	q.addExpressionAttributeNames(e)
+	q.addExpressionAttributeValues(e)
+}
+
 func (q *UntypedQuery) AddFilterExpression(e *Expression) {
 	q.buffer["FilterExpression"] = e.Text
 	q.addExpressionAttributeNames(e)
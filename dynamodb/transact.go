@@ -0,0 +1,187 @@
+package dynamodb
+
+import (
+	"errors"
+	"fmt"
+
+	simplejson "github.com/bitly/go-simplejson"
+	"github.com/zackbloom/goamz/aws"
+)
+
+// CancellationReason tells why one action of a canceled transaction failed.
+// Code is "None" for the actions that didn't cause the cancellation.
+type CancellationReason struct {
+	Code    string
+	Message string
+	Item    map[string]*Attribute
+}
+
+// IsConditionalCheckFailed reports whether err is the error returned when
+// the condition expression of a write is not met.
+func IsConditionalCheckFailed(err error) bool {
+	var apiErr aws.APIError
+	return errors.As(err, &apiErr) && apiErr.Code() == "ConditionalCheckFailedException"
+}
+
+// UpdateItem updates the item with key as described by the update
+// expression. condition may be nil; otherwise the update only happens if
+// the condition is met.
+func (t *Table) UpdateItem(key *Key, update, condition *Expression) (bool, error) {
+	q := NewQuery(t)
+	q.AddKey(key)
+	q.AddUpdateExpression(update)
+	if condition != nil {
+		q.AddConditionExpression(condition)
+	}
+
+	jsonResponse, err := t.Server.queryServer(target("UpdateItem"), q)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = simplejson.NewJson(jsonResponse)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// TransactWriteItems groups puts, updates, deletes and condition checks on
+// items of one or more tables into a single all-or-nothing request. At most
+// 100 actions may be grouped, and no two may target the same item.
+//
+// If any condition isn't met the transaction is canceled, and Execute
+// returns an *Error with the code "TransactionCanceledException" whose
+// CancellationReasons hold the reason of each action, in order.
+type TransactWriteItems struct {
+	Server *Server
+	// ClientRequestToken makes the request idempotent: repeating it with
+	// the same token within ten minutes has no further effect.
+	ClientRequestToken string
+
+	actions []msi
+}
+
+func (s *Server) NewTransactWriteItems() *TransactWriteItems {
+	return &TransactWriteItems{Server: s}
+}
+
+func (tw *TransactWriteItems) add(action string, q *UntypedQuery, condition *Expression) *TransactWriteItems {
+	if condition != nil {
+		q.AddConditionExpression(condition)
+	}
+	tw.actions = append(tw.actions, msi{action: q.buffer})
+	return tw
+}
+
+// Put adds the creation or replacement of an item. condition may be nil.
+func (tw *TransactWriteItems) Put(t *Table, hashKey, rangeKey string, attributes []Attribute, condition *Expression) *TransactWriteItems {
+	q := NewQuery(t)
+	q.AddItem(append(attributes, t.Key.Clone(hashKey, rangeKey)...))
+	return tw.add("Put", q, condition)
+}
+
+// Update adds the update of an item by an update expression. condition
+// may be nil.
+func (tw *TransactWriteItems) Update(t *Table, key *Key, update, condition *Expression) *TransactWriteItems {
+	q := NewQuery(t)
+	q.AddKey(key)
+	q.AddUpdateExpression(update)
+	return tw.add("Update", q, condition)
+}
+
+// Delete adds the deletion of an item. condition may be nil.
+func (tw *TransactWriteItems) Delete(t *Table, key *Key, condition *Expression) *TransactWriteItems {
+	q := NewQuery(t)
+	q.AddKey(key)
+	return tw.add("Delete", q, condition)
+}
+
+// ConditionCheck adds a condition on an item that isn't otherwise written
+// by the transaction.
+func (tw *TransactWriteItems) ConditionCheck(t *Table, key *Key, condition *Expression) *TransactWriteItems {
+	q := NewQuery(t)
+	q.AddKey(key)
+	return tw.add("ConditionCheck", q, condition)
+}
+
+func (tw *TransactWriteItems) Execute() error {
+	if len(tw.actions) == 0 {
+		return errors.New("At least one action is required.")
+	}
+	q := NewEmptyQuery()
+	q.buffer["TransactItems"] = tw.actions
+	if tw.ClientRequestToken != "" {
+		q.buffer["ClientRequestToken"] = tw.ClientRequestToken
+	}
+
+	jsonResponse, err := tw.Server.queryServer(target("TransactWriteItems"), q)
+	if err != nil {
+		return err
+	}
+
+	_, err = simplejson.NewJson(jsonResponse)
+	return err
+}
+
+// TransactGetItems reads up to 100 items of one or more tables as a single
+// consistent snapshot.
+type TransactGetItems struct {
+	Server *Server
+
+	gets []msi
+}
+
+func (s *Server) NewTransactGetItems() *TransactGetItems {
+	return &TransactGetItems{Server: s}
+}
+
+// Get adds the item with key to the items read.
+func (tg *TransactGetItems) Get(t *Table, key *Key) *TransactGetItems {
+	q := NewQuery(t)
+	q.AddKey(key)
+	tg.gets = append(tg.gets, msi{"Get": q.buffer})
+	return tg
+}
+
+// Execute returns the items in the order they were added. Items that don't
+// exist are nil.
+func (tg *TransactGetItems) Execute() ([]map[string]*Attribute, error) {
+	if len(tg.gets) == 0 {
+		return nil, errors.New("At least one item is required.")
+	}
+	q := NewEmptyQuery()
+	q.buffer["TransactItems"] = tg.gets
+
+	jsonResponse, err := tg.Server.queryServer(target("TransactGetItems"), q)
+	if err != nil {
+		return nil, err
+	}
+
+	json, err := simplejson.NewJson(jsonResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	responses, err := json.Get("Responses").Array()
+	if err != nil || len(responses) != len(tg.gets) {
+		message := fmt.Sprintf("Unexpected response %s", jsonResponse)
+		return nil, errors.New(message)
+	}
+
+	results := make([]map[string]*Attribute, len(responses))
+	for i := range responses {
+		itemJson, ok := json.Get("Responses").GetIndex(i).CheckGet("Item")
+		if !ok {
+			continue
+		}
+		item, err := itemJson.Map()
+		if err != nil {
+			message := fmt.Sprintf("Unexpected response %s", jsonResponse)
+			return nil, errors.New(message)
+		}
+		results[i] = parseAttributes(item)
+	}
+	return results, nil
+}
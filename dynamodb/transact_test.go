@@ -0,0 +1,176 @@
+package dynamodb
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+type TransactSuite struct {
+	httpServer *httptest.Server
+	server     *Server
+	accounts   *Table
+	requests   []map[string]interface{}
+	targets    []string
+	status     int
+	response   string
+}
+
+var _ = check.Suite(&TransactSuite{})
+
+func (s *TransactSuite) SetUpTest(c *check.C) {
+	s.requests = nil
+	s.targets = nil
+	s.status = 200
+	s.response = "{}"
+	s.httpServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			c.Fatal(err)
+		}
+		s.requests = append(s.requests, req)
+		s.targets = append(s.targets, r.Header.Get("X-Amz-Target"))
+		w.WriteHeader(s.status)
+		io.WriteString(w, s.response)
+	}))
+	auth := aws.Auth{AccessKey: "key", SecretKey: "secret"}
+	s.server = New(auth, aws.Region{Name: "us-east-1", DynamoDBEndpoint: s.httpServer.URL})
+	s.server.RetryPolicy = aws.NeverRetryPolicy{}
+	s.accounts = s.server.NewTable("accounts", PrimaryKey{NewStringAttribute("id", ""), nil})
+}
+
+func (s *TransactSuite) TearDownTest(c *check.C) {
+	s.httpServer.Close()
+}
+
+func (s *TransactSuite) TestTransactWriteItems(c *check.C) {
+	b := NewExpressionBuilder()
+	enough := b.Condition(*NewNumericAttributeComparison("balance", COMPARISON_GREATER_THAN_OR_EQUAL, 10))
+	withdraw := b.Expression(enough)
+
+	tw := s.server.NewTransactWriteItems()
+	tw.ClientRequestToken = "transfer-1"
+	tw.Update(s.accounts, &Key{HashKey: "alice"}, &Expression{
+		Text:            "SET #n0 = #n0 - :amount",
+		AttributeNames:  withdraw.AttributeNames,
+		AttributeValues: []Attribute{*NewNumericAttribute(":amount", "10")},
+	}, withdraw).
+		Put(s.accounts, "bob", "", []Attribute{*NewNumericAttribute("balance", "10")}, &Expression{
+			Text:           "attribute_not_exists(#id)",
+			AttributeNames: map[string]string{"#id": "id"},
+		}).
+		Delete(s.accounts, &Key{HashKey: "carol"}, nil)
+	c.Assert(tw.Execute(), check.IsNil)
+
+	c.Assert(s.targets, check.DeepEquals, []string{"DynamoDB_20120810.TransactWriteItems"})
+	var expected map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+	  "ClientRequestToken": "transfer-1",
+	  "TransactItems": [
+	    {"Update": {
+	      "TableName": "accounts",
+	      "Key": {"id": {"S": "alice"}},
+	      "UpdateExpression": "SET #n0 = #n0 - :amount",
+	      "ConditionExpression": "#n0 >= :v0",
+	      "ExpressionAttributeNames": {"#n0": "balance"},
+	      "ExpressionAttributeValues": {":amount": {"N": "10"}, ":v0": {"N": "10"}}
+	    }},
+	    {"Put": {
+	      "TableName": "accounts",
+	      "Item": {"id": {"S": "bob"}, "balance": {"N": "10"}},
+	      "ConditionExpression": "attribute_not_exists(#id)",
+	      "ExpressionAttributeNames": {"#id": "id"}
+	    }},
+	    {"Delete": {
+	      "TableName": "accounts",
+	      "Key": {"id": {"S": "carol"}}
+	    }}
+	  ]
+	}`), &expected)
+	c.Assert(err, check.IsNil)
+	c.Check(s.requests[0], check.DeepEquals, expected)
+}
+
+func (s *TransactSuite) TestTransactWriteItemsCanceled(c *check.C) {
+	s.status = 400
+	s.response = `{
+	  "__type": "com.amazonaws.dynamodb.v20120810#TransactionCanceledException",
+	  "Message": "Transaction cancelled, please refer cancellation reasons for specific reasons [None, ConditionalCheckFailed]",
+	  "CancellationReasons": [
+	    {"Code": "None"},
+	    {"Code": "ConditionalCheckFailed", "Message": "The conditional request failed",
+	     "Item": {"id": {"S": "bob"}, "balance": {"N": "3"}}}
+	  ]
+	}`
+
+	err := s.server.NewTransactWriteItems().
+		Delete(s.accounts, &Key{HashKey: "alice"}, nil).
+		ConditionCheck(s.accounts, &Key{HashKey: "bob"}, &Expression{Text: "attribute_not_exists(id)"}).
+		Execute()
+	c.Assert(err, check.NotNil)
+	ddbErr := err.(*Error)
+	c.Check(ddbErr.Code, check.Equals, "TransactionCanceledException")
+	c.Assert(ddbErr.CancellationReasons, check.HasLen, 2)
+	c.Check(ddbErr.CancellationReasons[0].Code, check.Equals, "None")
+	c.Check(ddbErr.CancellationReasons[1].Code, check.Equals, "ConditionalCheckFailed")
+	c.Check(ddbErr.CancellationReasons[1].Item["balance"].Value, check.Equals, "3")
+
+	actions := s.requests[0]["TransactItems"].([]interface{})
+	c.Check(actions[1], check.DeepEquals, map[string]interface{}{
+		"ConditionCheck": map[string]interface{}{
+			"TableName":           "accounts",
+			"Key":                 map[string]interface{}{"id": map[string]interface{}{"S": "bob"}},
+			"ConditionExpression": "attribute_not_exists(id)",
+		},
+	})
+}
+
+func (s *TransactSuite) TestTransactGetItems(c *check.C) {
+	s.response = `{"Responses": [{"Item": {"id": {"S": "alice"}, "balance": {"N": "20"}}}, {}]}`
+
+	items, err := s.server.NewTransactGetItems().
+		Get(s.accounts, &Key{HashKey: "alice"}).
+		Get(s.accounts, &Key{HashKey: "nobody"}).
+		Execute()
+	c.Assert(err, check.IsNil)
+	c.Assert(items, check.HasLen, 2)
+	c.Check(items[0]["balance"].Value, check.Equals, "20")
+	c.Check(items[1], check.IsNil)
+
+	c.Check(s.targets, check.DeepEquals, []string{"DynamoDB_20120810.TransactGetItems"})
+	c.Check(s.requests[0], check.DeepEquals, map[string]interface{}{
+		"TransactItems": []interface{}{
+			map[string]interface{}{"Get": map[string]interface{}{
+				"TableName": "accounts",
+				"Key":       map[string]interface{}{"id": map[string]interface{}{"S": "alice"}},
+			}},
+			map[string]interface{}{"Get": map[string]interface{}{
+				"TableName": "accounts",
+				"Key":       map[string]interface{}{"id": map[string]interface{}{"S": "nobody"}},
+			}},
+		},
+	})
+}
+
+func (s *TransactSuite) TestConditionalUpdateItem(c *check.C) {
+	s.status = 400
+	s.response = `{"__type": "com.amazonaws.dynamodb.v20120810#ConditionalCheckFailedException", "message": "The conditional request failed"}`
+
+	b := NewExpressionBuilder()
+	cond := b.Condition(AttributeComparison{"locked", COMPARISON_ATTRIBUTE_DOES_NOT_EXIST, nil})
+	set := "SET " + b.Name("owner") + " = " + b.Value(*NewStringAttribute("", "worker-1"))
+	_, err := s.accounts.UpdateItem(&Key{HashKey: "alice"}, b.Expression(set), b.Expression(cond))
+	c.Check(IsConditionalCheckFailed(err), check.Equals, true)
+
+	c.Check(s.targets, check.DeepEquals, []string{"DynamoDB_20120810.UpdateItem"})
+	c.Check(s.requests[0]["UpdateExpression"], check.Equals, "SET #n1 = :v0")
+	c.Check(s.requests[0]["ConditionExpression"], check.Equals, "attribute_not_exists(#n0)")
+	c.Check(s.requests[0]["ExpressionAttributeNames"], check.DeepEquals, map[string]interface{}{
+		"#n0": "locked",
+		"#n1": "owner",
+	})
+}
@@ -0,0 +1,339 @@
+// Package dynamodbstreams provides access to the change records of
+// DynamoDB tables with streams enabled.
+//
+// See http://docs.aws.amazon.com/amazondynamodb/latest/developerguide/Streams.html
+package dynamodbstreams
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/dynamodb/dynamizer"
+)
+
+type ShardIteratorType string
+
+const (
+	// Start reading exactly from the position denoted by a specific sequence number.
+	ShardIteratorAtSequenceNumber ShardIteratorType = "AT_SEQUENCE_NUMBER"
+
+	// Start reading right after the position denoted by a specific sequence number.
+	ShardIteratorAfterSequenceNumber ShardIteratorType = "AFTER_SEQUENCE_NUMBER"
+
+	// Start reading at the oldest record in the shard, up to 24 hours old.
+	ShardIteratorTrimHorizon ShardIteratorType = "TRIM_HORIZON"
+
+	// Start reading just after the most recent record in the shard.
+	ShardIteratorLatest ShardIteratorType = "LATEST"
+)
+
+// Values of StreamDescription.StreamStatus.
+const (
+	StreamStatusEnabling  = "ENABLING"
+	StreamStatusEnabled   = "ENABLED"
+	StreamStatusDisabling = "DISABLING"
+	StreamStatusDisabled  = "DISABLED"
+)
+
+// Values of Record.EventName.
+const (
+	EventInsert = "INSERT"
+	EventModify = "MODIFY"
+	EventRemove = "REMOVE"
+)
+
+// DynamoDBStreams is a client for the DynamoDB Streams API.
+type DynamoDBStreams struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+// New creates a new DynamoDBStreams client.
+func New(auth aws.Auth, region aws.Region) *DynamoDBStreams {
+	return &DynamoDBStreams{Auth: auth, Region: region}
+}
+
+// Error represents an error returned by DynamoDB Streams.
+type Error struct {
+	StatusCode int // HTTP status code (200, 403, ...)
+	Status     string
+	Code       string `json:"__type"` // Error code ("ResourceNotFoundException", ...)
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return "dynamodbstreams: " + e.Code + ": " + e.Message
+	}
+	return "dynamodbstreams: " + e.Code
+}
+
+func (e *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, e, e.StatusCode, e.Code, e.Message, "")
+}
+
+// Stream identifies the stream of a table.
+type Stream struct {
+	StreamArn   string
+	StreamLabel string
+	TableName   string
+}
+
+// The range of possible sequence numbers for the shard. EndingSequenceNumber
+// is empty while the shard is open.
+type SequenceNumberRange struct {
+	StartingSequenceNumber string
+	EndingSequenceNumber   string
+}
+
+// A Shard is a group of stream records. Shards are closed and replaced by
+// child shards over time; the records of a parent shard precede those of
+// its children.
+type Shard struct {
+	ShardId             string
+	ParentShardId       string
+	SequenceNumberRange SequenceNumberRange
+}
+
+// Closed reports whether the shard no longer receives records.
+func (s Shard) Closed() bool {
+	return s.SequenceNumberRange.EndingSequenceNumber != ""
+}
+
+// Description of a stream. When LastEvaluatedShardId is set, more shards
+// may be listed by passing it to DescribeStream.
+type StreamDescription struct {
+	StreamArn               string
+	StreamLabel             string
+	StreamStatus            string
+	StreamViewType          string
+	TableName               string
+	CreationRequestDateTime float64
+	KeySchema               []KeySchemaElement
+	Shards                  []Shard
+	LastEvaluatedShardId    string
+}
+
+type KeySchemaElement struct {
+	AttributeName string
+	KeyType       string // "HASH" or "RANGE"
+}
+
+// StreamRecord holds the item-level change of a Record. Which images are
+// set depends on the StreamViewType of the stream.
+type StreamRecord struct {
+	ApproximateCreationDateTime float64 // seconds since the epoch
+	Keys                        dynamizer.DynamoItem
+	NewImage                    dynamizer.DynamoItem
+	OldImage                    dynamizer.DynamoItem
+	SequenceNumber              string
+	SizeBytes                   int64
+	StreamViewType              string
+}
+
+// CreationTime returns the approximate time the change was made.
+func (r StreamRecord) CreationTime() time.Time {
+	return time.Unix(0, int64(r.ApproximateCreationDateTime*float64(time.Second)))
+}
+
+// Identity is set on records of items deleted by Time to Live.
+type Identity struct {
+	PrincipalId string `json:"principalId"`
+	Type        string `json:"type"`
+}
+
+// A Record describes a single change to an item of the table.
+type Record struct {
+	AwsRegion    string       `json:"awsRegion"`
+	Dynamodb     StreamRecord `json:"dynamodb"`
+	EventID      string       `json:"eventID"`
+	EventName    string       `json:"eventName"`
+	EventSource  string       `json:"eventSource"`
+	EventVersion string       `json:"eventVersion"`
+	UserIdentity *Identity    `json:"userIdentity"`
+}
+
+// Represents the output of a ListStreams operation.
+type ListStreamsResponse struct {
+	Streams                []Stream
+	LastEvaluatedStreamArn string
+}
+
+// Represents the output of a GetRecords operation. NextShardIterator is
+// empty once a closed shard has been read to its end.
+type GetRecordsResponse struct {
+	Records           []Record
+	NextShardIterator string
+}
+
+// ListStreams lists the streams of tableName, or of every table if
+// tableName is "". exclusiveStartStreamArn and limit may be "" and 0.
+//
+// See http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_streams_ListStreams.html
+func (s *DynamoDBStreams) ListStreams(tableName, exclusiveStartStreamArn string, limit int) (*ListStreamsResponse, error) {
+	req := map[string]interface{}{}
+	if tableName != "" {
+		req["TableName"] = tableName
+	}
+	if exclusiveStartStreamArn != "" {
+		req["ExclusiveStartStreamArn"] = exclusiveStartStreamArn
+	}
+	if limit > 0 {
+		req["Limit"] = limit
+	}
+	var resp ListStreamsResponse
+	if err := s.query("ListStreams", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DescribeStream describes a stream and up to limit of its shards, starting
+// after exclusiveStartShardId. Both may be zero to describe from the first
+// shard with the service default limit.
+//
+// See http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_streams_DescribeStream.html
+func (s *DynamoDBStreams) DescribeStream(streamArn, exclusiveStartShardId string, limit int) (*StreamDescription, error) {
+	req := map[string]interface{}{"StreamArn": streamArn}
+	if exclusiveStartShardId != "" {
+		req["ExclusiveStartShardId"] = exclusiveStartShardId
+	}
+	if limit > 0 {
+		req["Limit"] = limit
+	}
+	var resp struct {
+		StreamDescription StreamDescription
+	}
+	if err := s.query("DescribeStream", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.StreamDescription, nil
+}
+
+// DescribeStreamAll describes a stream with every one of its shards,
+// following DescribeStream pagination.
+func (s *DynamoDBStreams) DescribeStreamAll(streamArn string) (*StreamDescription, error) {
+	desc, err := s.DescribeStream(streamArn, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	for desc.LastEvaluatedShardId != "" {
+		page, err := s.DescribeStream(streamArn, desc.LastEvaluatedShardId, 0)
+		if err != nil {
+			return nil, err
+		}
+		desc.Shards = append(desc.Shards, page.Shards...)
+		desc.StreamStatus = page.StreamStatus
+		desc.LastEvaluatedShardId = page.LastEvaluatedShardId
+	}
+	return desc, nil
+}
+
+// GetShardIterator returns an iterator for reading the records of a shard
+// from the given position. sequenceNumber is only used with
+// ShardIteratorAtSequenceNumber and ShardIteratorAfterSequenceNumber.
+// Iterators expire 15 minutes after they are returned.
+//
+// See http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_streams_GetShardIterator.html
+func (s *DynamoDBStreams) GetShardIterator(streamArn, shardId string, iteratorType ShardIteratorType, sequenceNumber string) (string, error) {
+	req := map[string]interface{}{
+		"StreamArn":         streamArn,
+		"ShardId":           shardId,
+		"ShardIteratorType": iteratorType,
+	}
+	if sequenceNumber != "" {
+		req["SequenceNumber"] = sequenceNumber
+	}
+	var resp struct {
+		ShardIterator string
+	}
+	if err := s.query("GetShardIterator", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.ShardIterator, nil
+}
+
+// GetRecords reads up to limit records from a shard iterator, or up to the
+// service default of 1000 if limit is 0.
+//
+// See http://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_streams_GetRecords.html
+func (s *DynamoDBStreams) GetRecords(shardIterator string, limit int) (*GetRecordsResponse, error) {
+	req := map[string]interface{}{"ShardIterator": shardIterator}
+	if limit > 0 {
+		req["Limit"] = limit
+	}
+	var resp GetRecordsResponse
+	if err := s.query("GetRecords", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *DynamoDBStreams) query(action string, req, resp interface{}) error {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	hreq, err := http.NewRequest("POST", s.Region.DynamoDBStreamsEndpoint+"/", strings.NewReader(string(body)))
+	if err != nil {
+		return err
+	}
+
+	hreq.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(aws.ISO8601BasicFormat))
+	hreq.Header.Set("X-Amz-Target", target(action))
+
+	token := s.Auth.Token()
+	if token != "" {
+		hreq.Header.Set("X-Amz-Security-Token", token)
+	}
+
+	signer := aws.NewV4Signer(s.Auth, "dynamodb", s.Region)
+	signer.Sign(hreq)
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	hresp, err := client.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer hresp.Body.Close()
+
+	data, err := ioutil.ReadAll(hresp.Body)
+	if err != nil {
+		return err
+	}
+
+	if hresp.StatusCode != 200 {
+		return buildError(hresp, data)
+	}
+	return json.Unmarshal(data, resp)
+}
+
+func buildError(r *http.Response, jsonBody []byte) error {
+	err := &Error{
+		StatusCode: r.StatusCode,
+		Status:     r.Status,
+	}
+	json.Unmarshal(jsonBody, err)
+
+	// Of the form: com.amazonaws.dynamodb.v20120810#ExpiredIteratorException
+	if i := strings.Index(err.Code, "#"); i >= 0 {
+		err.Code = err.Code[i+1:]
+	}
+	return err
+}
+
+func target(name string) string {
+	return "DynamoDBStreams_20120810." + name
+}
@@ -0,0 +1,216 @@
+package dynamodbstreams_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/dynamodb/dynamizer"
+	"github.com/zackbloom/goamz/dynamodbstreams"
+)
+
+const (
+	streamArn   = "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/stream/2015-05-11T21:21:33.291"
+	parentShard = "shardId-00000001431379293291-a1b2c3d4"
+	childShard  = "shardId-00000001431393693291-e5f6a7b8"
+)
+
+func equals(t *testing.T, exp, act interface{}) {
+	if !reflect.DeepEqual(exp, act) {
+		t.Fatalf("\n\texp: %#v\n\n\tgot: %#v", exp, act)
+	}
+}
+
+// fakeStream serves a stream whose parent shard is already closed and whose
+// child shard closes once it has been read, after which the stream reports
+// itself disabled.
+type fakeStream struct {
+	requests    []map[string]interface{}
+	childClosed bool
+}
+
+func (f *fakeStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	req := map[string]interface{}{}
+	json.Unmarshal(body, &req)
+	req["X-Amz-Target"] = r.Header.Get("X-Amz-Target")
+	f.requests = append(f.requests, req)
+
+	switch strings.TrimPrefix(r.Header.Get("X-Amz-Target"), "DynamoDBStreams_20120810.") {
+	case "DescribeStream":
+		if f.childClosed {
+			fmt.Fprint(w, describeStreamDisabled)
+		} else if req["ExclusiveStartShardId"] == nil {
+			fmt.Fprint(w, describeStreamFirstPage)
+		} else {
+			fmt.Fprint(w, describeStreamSecondPage)
+		}
+	case "GetShardIterator":
+		fmt.Fprintf(w, `{"ShardIterator": "iterator-%s"}`, req["ShardId"])
+	case "GetRecords":
+		switch req["ShardIterator"] {
+		case "iterator-" + parentShard:
+			fmt.Fprint(w, getRecordsParent)
+		case "iterator-" + childShard:
+			fmt.Fprint(w, getRecordsChild)
+		case "iterator-child-2":
+			f.childClosed = true
+			fmt.Fprint(w, getRecordsEmpty)
+		default:
+			w.WriteHeader(400)
+			fmt.Fprint(w, expiredIterator)
+		}
+	}
+}
+
+func (f *fakeStream) targets() []string {
+	var targets []string
+	for _, req := range f.requests {
+		targets = append(targets, strings.TrimPrefix(req["X-Amz-Target"].(string), "DynamoDBStreams_20120810."))
+	}
+	return targets
+}
+
+func newClient(handler http.Handler) (*dynamodbstreams.DynamoDBStreams, func()) {
+	server := httptest.NewServer(handler)
+	s := dynamodbstreams.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{Name: "us-east-1", DynamoDBStreamsEndpoint: server.URL})
+	return s, server.Close
+}
+
+func TestDescribeStreamAll(t *testing.T) {
+	f := &fakeStream{}
+	s, done := newClient(f)
+	defer done()
+
+	desc, err := s.DescribeStreamAll(streamArn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "Orders", desc.TableName)
+	equals(t, []dynamodbstreams.KeySchemaElement{{"OrderId", "HASH"}}, desc.KeySchema)
+	equals(t, 2, len(desc.Shards))
+	equals(t, true, desc.Shards[0].Closed())
+	equals(t, false, desc.Shards[1].Closed())
+	equals(t, parentShard, desc.Shards[1].ParentShardId)
+
+	equals(t, 2, len(f.requests))
+	equals(t, streamArn, f.requests[0]["StreamArn"])
+	equals(t, parentShard, f.requests[1]["ExclusiveStartShardId"])
+}
+
+func TestGetRecords(t *testing.T) {
+	f := &fakeStream{}
+	s, done := newClient(f)
+	defer done()
+
+	iterator, err := s.GetShardIterator(streamArn, parentShard, dynamodbstreams.ShardIteratorAfterSequenceNumber, "100000000000000000000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "iterator-"+parentShard, iterator)
+	equals(t, "AFTER_SEQUENCE_NUMBER", f.requests[0]["ShardIteratorType"])
+	equals(t, "100000000000000000000", f.requests[0]["SequenceNumber"])
+
+	resp, err := s.GetRecords(iterator, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	equals(t, float64(100), f.requests[1]["Limit"])
+	equals(t, "", resp.NextShardIterator)
+	equals(t, 1, len(resp.Records))
+
+	record := resp.Records[0]
+	equals(t, dynamodbstreams.EventInsert, record.EventName)
+	equals(t, time.Unix(1431379320, 0), record.Dynamodb.CreationTime())
+	equals(t, (*dynamodbstreams.Identity)(nil), record.UserIdentity)
+
+	var order struct {
+		OrderId string
+		Total   int
+		Gift    bool
+	}
+	if err := dynamizer.FromDynamo(record.Dynamodb.NewImage, &order); err != nil {
+		t.Fatal(err)
+	}
+	equals(t, "o-1", order.OrderId)
+	equals(t, 42, order.Total)
+	equals(t, true, order.Gift)
+}
+
+func TestError(t *testing.T) {
+	s, done := newClient(&fakeStream{})
+	defer done()
+
+	_, err := s.GetRecords("iterator-stale", 0)
+	e, ok := err.(*dynamodbstreams.Error)
+	if !ok {
+		t.Fatalf("expected *dynamodbstreams.Error, got %#v", err)
+	}
+	equals(t, 400, e.StatusCode)
+	equals(t, "ExpiredIteratorException", e.Code)
+	equals(t, "dynamodbstreams: ExpiredIteratorException: Iterator expired. The iterator was created at time Mon May 11 21:21:33 UTC 2015", e.Error())
+}
+
+func TestTailFollowsShardSplits(t *testing.T) {
+	f := &fakeStream{}
+	s, done := newClient(f)
+	defer done()
+
+	tailer := s.NewTailer(streamArn, dynamodbstreams.ShardIteratorTrimHorizon)
+	tailer.PollInterval = time.Millisecond
+
+	var events []string
+	err := tailer.Tail(func(shardId string, records []dynamodbstreams.Record) error {
+		for _, record := range records {
+			events = append(events, shardId+" "+record.EventName)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The child is only read once its parent is drained; the trimmed
+	// grandparent is not waited for.
+	equals(t, []string{parentShard + " INSERT", childShard + " REMOVE"}, events)
+	equals(t, []string{
+		"DescribeStream", "DescribeStream", "GetShardIterator", "GetRecords",
+		"DescribeStream", "DescribeStream", "GetShardIterator", "GetRecords", "GetRecords",
+		"DescribeStream",
+	}, f.targets())
+	equals(t, "TRIM_HORIZON", f.requests[2]["ShardIteratorType"])
+	equals(t, childShard, f.requests[6]["ShardId"])
+	equals(t, "TRIM_HORIZON", f.requests[6]["ShardIteratorType"])
+}
+
+func TestTailLatestSkipsClosedShards(t *testing.T) {
+	f := &fakeStream{}
+	s, done := newClient(f)
+	defer done()
+
+	stop := errors.New("stop")
+	tailer := s.NewTailer(streamArn, dynamodbstreams.ShardIteratorLatest)
+	err := tailer.Tail(func(shardId string, records []dynamodbstreams.Record) error {
+		equals(t, childShard, shardId)
+		return stop
+	})
+	equals(t, stop, err)
+
+	equals(t, []string{"DescribeStream", "DescribeStream", "GetShardIterator", "GetRecords"}, f.targets())
+	equals(t, childShard, f.requests[2]["ShardId"])
+	equals(t, "LATEST", f.requests[2]["ShardIteratorType"])
+}
+
+func TestTailRejectsSequenceNumberIterators(t *testing.T) {
+	s := dynamodbstreams.New(aws.Auth{}, aws.Region{})
+	err := s.NewTailer(streamArn, dynamodbstreams.ShardIteratorAtSequenceNumber).Tail(nil)
+	equals(t, "dynamodbstreams: Tail needs a TRIM_HORIZON or LATEST iterator type", err.Error())
+}
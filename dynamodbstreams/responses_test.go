@@ -0,0 +1,130 @@
+package dynamodbstreams_test
+
+var describeStreamFirstPage = `
+{
+  "StreamDescription": {
+    "StreamArn": "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/stream/2015-05-11T21:21:33.291",
+    "StreamLabel": "2015-05-11T21:21:33.291",
+    "StreamStatus": "ENABLED",
+    "StreamViewType": "NEW_AND_OLD_IMAGES",
+    "TableName": "Orders",
+    "CreationRequestDateTime": 1431379293.291,
+    "KeySchema": [{"AttributeName": "OrderId", "KeyType": "HASH"}],
+    "Shards": [
+      {
+        "ShardId": "shardId-00000001431379293291-a1b2c3d4",
+        "ParentShardId": "shardId-00000001431365000000-trimmed0",
+        "SequenceNumberRange": {
+          "StartingSequenceNumber": "100000000000000000001",
+          "EndingSequenceNumber": "100000000000000000002"
+        }
+      }
+    ],
+    "LastEvaluatedShardId": "shardId-00000001431379293291-a1b2c3d4"
+  }
+}
+`
+
+var describeStreamSecondPage = `
+{
+  "StreamDescription": {
+    "StreamArn": "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/stream/2015-05-11T21:21:33.291",
+    "StreamStatus": "ENABLED",
+    "Shards": [
+      {
+        "ShardId": "shardId-00000001431393693291-e5f6a7b8",
+        "ParentShardId": "shardId-00000001431379293291-a1b2c3d4",
+        "SequenceNumberRange": {
+          "StartingSequenceNumber": "200000000000000000001"
+        }
+      }
+    ]
+  }
+}
+`
+
+var describeStreamDisabled = `
+{
+  "StreamDescription": {
+    "StreamArn": "arn:aws:dynamodb:us-east-1:123456789012:table/Orders/stream/2015-05-11T21:21:33.291",
+    "StreamStatus": "DISABLED",
+    "Shards": [
+      {
+        "ShardId": "shardId-00000001431379293291-a1b2c3d4",
+        "ParentShardId": "shardId-00000001431365000000-trimmed0",
+        "SequenceNumberRange": {
+          "StartingSequenceNumber": "100000000000000000001",
+          "EndingSequenceNumber": "100000000000000000002"
+        }
+      },
+      {
+        "ShardId": "shardId-00000001431393693291-e5f6a7b8",
+        "ParentShardId": "shardId-00000001431379293291-a1b2c3d4",
+        "SequenceNumberRange": {
+          "StartingSequenceNumber": "200000000000000000001",
+          "EndingSequenceNumber": "200000000000000000001"
+        }
+      }
+    ]
+  }
+}
+`
+
+var getRecordsParent = `
+{
+  "Records": [
+    {
+      "awsRegion": "us-east-1",
+      "dynamodb": {
+        "ApproximateCreationDateTime": 1431379320,
+        "Keys": {"OrderId": {"S": "o-1"}},
+        "NewImage": {"OrderId": {"S": "o-1"}, "Total": {"N": "42"}, "Gift": {"BOOL": true}},
+        "SequenceNumber": "100000000000000000001",
+        "SizeBytes": 38,
+        "StreamViewType": "NEW_AND_OLD_IMAGES"
+      },
+      "eventID": "c4ca4238a0b923820dcc509a6f75849b",
+      "eventName": "INSERT",
+      "eventSource": "aws:dynamodb",
+      "eventVersion": "1.1"
+    }
+  ]
+}
+`
+
+var getRecordsChild = `
+{
+  "Records": [
+    {
+      "awsRegion": "us-east-1",
+      "dynamodb": {
+        "ApproximateCreationDateTime": 1431393720,
+        "Keys": {"OrderId": {"S": "o-1"}},
+        "OldImage": {"OrderId": {"S": "o-1"}, "Total": {"N": "42"}, "Gift": {"BOOL": true}},
+        "SequenceNumber": "200000000000000000001",
+        "SizeBytes": 24,
+        "StreamViewType": "NEW_AND_OLD_IMAGES"
+      },
+      "eventID": "c81e728d9d4c2f636f067f89cc14862c",
+      "eventName": "REMOVE",
+      "eventSource": "aws:dynamodb",
+      "eventVersion": "1.1",
+      "userIdentity": {"principalId": "dynamodb.amazonaws.com", "type": "Service"}
+    }
+  ],
+  "NextShardIterator": "iterator-child-2"
+}
+`
+
+var getRecordsEmpty = `
+{
+  "Records": []
+}
+`
+
+var expiredIterator = `
+{
+  "__type": "com.amazonaws.dynamodb.v20120810#ExpiredIteratorException",
+  "message": "Iterator expired. The iterator was created at time Mon May 11 21:21:33 UTC 2015"
+}
+`
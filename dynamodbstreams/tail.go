@@ -0,0 +1,130 @@
+package dynamodbstreams
+
+import (
+	"errors"
+	"time"
+)
+
+// Tailer reads the records of every shard of a stream, following shard
+// splits: the shards of a stream are closed every few hours and replaced
+// by child shards, whose records are only read once their parent is
+// drained so that changes to an item are seen in order.
+type Tailer struct {
+	Streams   *DynamoDBStreams
+	StreamArn string
+
+	// Where to start reading the shards that exist when Tail is called;
+	// either ShardIteratorTrimHorizon or ShardIteratorLatest. Shards
+	// found later are always read from their start.
+	IteratorType ShardIteratorType
+
+	// The largest number of records requested at once, or 0 for the
+	// service default.
+	Limit int
+
+	// How long to wait before polling again after a round of GetRecords
+	// calls returned no records.
+	PollInterval time.Duration
+}
+
+// NewTailer returns a Tailer for streamArn that polls every second.
+func (s *DynamoDBStreams) NewTailer(streamArn string, iteratorType ShardIteratorType) *Tailer {
+	return &Tailer{
+		Streams:      s,
+		StreamArn:    streamArn,
+		IteratorType: iteratorType,
+		PollInterval: time.Second,
+	}
+}
+
+// Tail calls handle with each batch of records read from the stream, in
+// order within each shard. It returns nil once the stream is disabled and
+// all of its shards are drained, or the first error from handle or from
+// DynamoDB Streams.
+func (t *Tailer) Tail(handle func(shardId string, records []Record) error) error {
+	if t.IteratorType != ShardIteratorTrimHorizon && t.IteratorType != ShardIteratorLatest {
+		return errors.New("dynamodbstreams: Tail needs a TRIM_HORIZON or LATEST iterator type")
+	}
+
+	known := map[string]bool{}
+	done := map[string]bool{}
+	iterators := map[string]string{}
+	var order []string // Shard IDs in the order they were started.
+	first := true
+	describe := true
+
+	for {
+		if describe {
+			desc, err := t.Streams.DescribeStreamAll(t.StreamArn)
+			if err != nil {
+				return err
+			}
+			for _, shard := range desc.Shards {
+				known[shard.ShardId] = true
+			}
+			for _, shard := range desc.Shards {
+				if done[shard.ShardId] || iterators[shard.ShardId] != "" {
+					continue
+				}
+				iteratorType := ShardIteratorTrimHorizon
+				if first && t.IteratorType == ShardIteratorLatest {
+					if shard.Closed() {
+						done[shard.ShardId] = true
+						continue
+					}
+					iteratorType = ShardIteratorLatest
+				} else if shard.ParentShardId != "" && known[shard.ParentShardId] && !done[shard.ParentShardId] {
+					// Read once the parent is drained. Parents older than
+					// the trim horizon are no longer listed.
+					continue
+				}
+				iterator, err := t.Streams.GetShardIterator(t.StreamArn, shard.ShardId, iteratorType, "")
+				if err != nil {
+					return err
+				}
+				iterators[shard.ShardId] = iterator
+				order = append(order, shard.ShardId)
+			}
+			if len(iterators) == 0 && desc.StreamStatus == StreamStatusDisabled {
+				return nil
+			}
+			first = false
+			describe = false
+		}
+
+		gotRecords := false
+		active := order[:0]
+		for _, shardId := range order {
+			resp, err := t.Streams.GetRecords(iterators[shardId], t.Limit)
+			if err != nil {
+				return err
+			}
+			if len(resp.Records) > 0 {
+				gotRecords = true
+				if err := handle(shardId, resp.Records); err != nil {
+					return err
+				}
+			}
+			if resp.NextShardIterator == "" {
+				// The shard is closed and drained; its children may
+				// now be read.
+				delete(iterators, shardId)
+				done[shardId] = true
+				describe = true
+				continue
+			}
+			iterators[shardId] = resp.NextShardIterator
+			active = append(active, shardId)
+		}
+		order = active
+
+		if len(order) == 0 {
+			describe = true
+		}
+		// Drained shards are replaced right away, but a stream without
+		// open shards is only described again after a pause.
+		if !gotRecords && (!describe || len(order) == 0) {
+			time.Sleep(t.PollInterval)
+		}
+	}
+}
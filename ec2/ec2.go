@@ -14,14 +14,17 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/sts"
 	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -97,6 +100,9 @@ type Error struct {
 	// The human-oriented error message
 	Message   string
 	RequestId string `xml:"RequestID"`
+	// For UnauthorizedOperation errors, the encoded authorization failure
+	// message once decoded by EC2.DecodeError
+	DecodedMessage string `xml:"-"`
 }
 
 func (err *Error) Error() string {
@@ -107,6 +113,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
 // For now a single error inst is being exposed. In the future it may be useful
 // to provide access to all of them, but rather than doing it as an array/slice,
 // use a *next pointer, so that it's backward compatible and it continues to be
@@ -118,8 +128,15 @@ type xmlErrors struct {
 
 var timeNow = time.Now
 
+// Actions added to EC2 after the API version used by query, such as Spot
+// Fleet and NAT gateways, are sent with this one.
+const recentVersion = "2016-11-15"
+
 func (ec2 *EC2) query(params map[string]string, resp interface{}) error {
-	params["Version"] = "2014-02-01"
+	// Actions added after this API version set their own.
+	if params["Version"] == "" {
+		params["Version"] = "2014-02-01"
+	}
 	params["Timestamp"] = timeNow().In(time.UTC).Format(time.RFC3339)
 	endpoint, err := url.Parse(ec2.Region.EC2Endpoint)
 	if err != nil {
@@ -155,6 +172,36 @@ func (ec2 *EC2) query(params map[string]string, resp interface{}) error {
 	return err
 }
 
+const encodedAuthorizationMessagePrefix = "Encoded authorization failure message: "
+
+// DecodeError decodes through STS the encoded authorization failure
+// message of an UnauthorizedOperation error, and sets the DecodedMessage
+// of the error to it. This takes a request to STS, which the credentials
+// must be allowed to make with sts:DecodeAuthorizationMessage; its error
+// is returned. Other errors are left as they are.
+//
+// See https://docs.aws.amazon.com/STS/latest/APIReference/API_DecodeAuthorizationMessage.html for more details.
+func (ec2 *EC2) DecodeError(err error) error {
+	var ec2err *Error
+	if !errors.As(err, &ec2err) || ec2err.Code != "UnauthorizedOperation" {
+		return nil
+	}
+	i := strings.Index(ec2err.Message, encodedAuthorizationMessagePrefix)
+	if i < 0 {
+		return nil
+	}
+	if ec2.Region.STSEndpoint == "" {
+		return fmt.Errorf("ec2: no STS endpoint in region %s", ec2.Region.Name)
+	}
+	encoded := strings.TrimSpace(ec2err.Message[i+len(encodedAuthorizationMessagePrefix):])
+	resp, stsErr := sts.New(ec2.Auth, ec2.Region).DecodeAuthorizationMessage(encoded)
+	if stsErr != nil {
+		return stsErr
+	}
+	ec2err.DecodedMessage = resp.DecodedMessage
+	return nil
+}
+
 func multimap(p map[string]string) url.Values {
 	q := make(url.Values, len(p))
 	for k, v := range p {
@@ -1142,21 +1189,84 @@ type SecurityGroupInfo struct {
 
 // IPPerm represents an allowance within an EC2 security group.
 //
+// SourceIPs holds the CIDR blocks of IPRanges. When authorizing or
+// revoking, IPRanges is sent so that the ranges keep their descriptions,
+// unless SourceIPs no longer lists the same blocks, as after editing a
+// permission read from SecurityGroups: the blocks of SourceIPs are sent
+// then, with the descriptions IPRanges has for them.
+//
 // See http://goo.gl/4oTxv for more details.
 type IPPerm struct {
-	Protocol     string              `xml:"ipProtocol"`
-	FromPort     int                 `xml:"fromPort"`
-	ToPort       int                 `xml:"toPort"`
-	SourceIPs    []string            `xml:"ipRanges>item>cidrIp"`
-	SourceGroups []UserSecurityGroup `xml:"groups>item"`
+	Protocol      string              `xml:"ipProtocol"`
+	FromPort      int                 `xml:"fromPort"`
+	ToPort        int                 `xml:"toPort"`
+	SourceIPs     []string            `xml:"-"`
+	IPRanges      []IPRange           `xml:"ipRanges>item"`
+	IPv6Ranges    []IPv6Range         `xml:"ipv6Ranges>item"`
+	PrefixListIds []PrefixListId      `xml:"prefixListIds>item"`
+	SourceGroups  []UserSecurityGroup `xml:"groups>item"`
+}
+
+func (p *IPPerm) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type ipPerm IPPerm
+	if err := d.DecodeElement((*ipPerm)(p), &start); err != nil {
+		return err
+	}
+	for _, r := range p.IPRanges {
+		p.SourceIPs = append(p.SourceIPs, r.CidrIp)
+	}
+	return nil
+}
+
+// ipRanges returns the IPv4 ranges to send for p.
+func (p *IPPerm) ipRanges() []IPRange {
+	if len(p.SourceIPs) == 0 {
+		return p.IPRanges
+	}
+	same := len(p.SourceIPs) == len(p.IPRanges)
+	descriptions := make(map[string]string, len(p.IPRanges))
+	for i, r := range p.IPRanges {
+		descriptions[r.CidrIp] = r.Description
+		if same && p.SourceIPs[i] != r.CidrIp {
+			same = false
+		}
+	}
+	if same {
+		return p.IPRanges
+	}
+	ranges := make([]IPRange, len(p.SourceIPs))
+	for i, ip := range p.SourceIPs {
+		ranges[i] = IPRange{CidrIp: ip, Description: descriptions[ip]}
+	}
+	return ranges
+}
+
+// IPRange is an IPv4 CIDR block allowed by an IPPerm.
+type IPRange struct {
+	CidrIp      string `xml:"cidrIp"`
+	Description string `xml:"description"`
+}
+
+// IPv6Range is an IPv6 CIDR block allowed by an IPPerm.
+type IPv6Range struct {
+	CidrIpv6    string `xml:"cidrIpv6"`
+	Description string `xml:"description"`
+}
+
+// PrefixListId is a prefix list, such as one of a VPC endpoint, allowed
+// by an IPPerm.
+type PrefixListId struct {
+	PrefixListId string `xml:"prefixListId"`
+	Description  string `xml:"description"`
 }
 
 // UserSecurityGroup holds a security group and the owner
 // of that group.
 type UserSecurityGroup struct {
-	Id      string `xml:"groupId"`
-	Name    string `xml:"groupName"`
-	OwnerId string `xml:"userId"`
+	Id          string `xml:"groupId"`
+	Name        string `xml:"groupName"`
+	OwnerId     string `xml:"userId"`
+	Description string `xml:"description"`
 }
 
 // SecurityGroup represents an EC2 security group.
@@ -1256,13 +1366,42 @@ func (ec2 *EC2) authOrRevoke(op string, group SecurityGroup, perms []IPPerm) (re
 		params["GroupName"] = group.Name
 	}
 
+	addIPPermParams(params, perms)
+
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func addIPPermParams(params map[string]string, perms []IPPerm) {
 	for i, perm := range perms {
 		prefix := "IpPermissions." + strconv.Itoa(i+1)
 		params[prefix+".IpProtocol"] = perm.Protocol
 		params[prefix+".FromPort"] = strconv.Itoa(perm.FromPort)
 		params[prefix+".ToPort"] = strconv.Itoa(perm.ToPort)
-		for j, ip := range perm.SourceIPs {
-			params[prefix+".IpRanges."+strconv.Itoa(j+1)+".CidrIp"] = ip
+		for j, r := range perm.ipRanges() {
+			subprefix := prefix + ".IpRanges." + strconv.Itoa(j+1)
+			params[subprefix+".CidrIp"] = r.CidrIp
+			if r.Description != "" {
+				params[subprefix+".Description"] = r.Description
+			}
+		}
+		for j, r := range perm.IPv6Ranges {
+			subprefix := prefix + ".Ipv6Ranges." + strconv.Itoa(j+1)
+			params[subprefix+".CidrIpv6"] = r.CidrIpv6
+			if r.Description != "" {
+				params[subprefix+".Description"] = r.Description
+			}
+		}
+		for j, pl := range perm.PrefixListIds {
+			subprefix := prefix + ".PrefixListIds." + strconv.Itoa(j+1)
+			params[subprefix+".PrefixListId"] = pl.PrefixListId
+			if pl.Description != "" {
+				params[subprefix+".Description"] = pl.Description
+			}
 		}
 		for j, g := range perm.SourceGroups {
 			subprefix := prefix + ".Groups." + strconv.Itoa(j+1)
@@ -1274,15 +1413,11 @@ func (ec2 *EC2) authOrRevoke(op string, group SecurityGroup, perms []IPPerm) (re
 			} else {
 				params[subprefix+".GroupName"] = g.Name
 			}
+			if g.Description != "" {
+				params[subprefix+".Description"] = g.Description
+			}
 		}
 	}
-
-	resp = &SimpleResp{}
-	err = ec2.query(params, resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
 }
 
 // ResourceTag represents key-value metadata used to classify and organize
@@ -1478,6 +1613,10 @@ type SystemStateStruct struct {
 	Status     string `xml:"details>item>status"`
 	Since      string `xml:"details>item>impairedSince"`
 }
+
+// EventSetStruct is the first scheduled event of an instance.
+//
+// Deprecated: use InstanceStatus.Events, which has all of them.
 type EventSetStruct struct {
 	EventCode   string `xml:"item>code"`
 	Description string `xml:"item>description"`
@@ -1485,16 +1624,58 @@ type EventSetStruct struct {
 	NotAfter    string `xml:"item>notAfter"`
 }
 type InstanceStatus struct {
-	InstanceId       string            `xml:"instanceId"`
-	AvailabilityZone string            `xml:"availabilityZone"`
-	InstanceState    string            `xml:"instanceState>name"`
-	InstanceStatus   SystemStateStruct `xml:"instanceStatus"`
-	SystemStatus     SystemStateStruct `xml:"systemStatus"`
-	EventDetails     EventSetStruct    `xml:"eventsSet"`
+	InstanceId       string                `xml:"instanceId"`
+	AvailabilityZone string                `xml:"availabilityZone"`
+	InstanceState    string                `xml:"instanceState>name"`
+	InstanceStatus   SystemStateStruct     `xml:"instanceStatus"`
+	SystemStatus     SystemStateStruct     `xml:"systemStatus"`
+	EventDetails     EventSetStruct        `xml:"-"` // Deprecated: use Events
+	Events           []InstanceStatusEvent `xml:"eventsSet>item"`
+}
+
+// Codes of InstanceStatusEvent.
+const (
+	EventInstanceReboot     = "instance-reboot"
+	EventSystemReboot       = "system-reboot"
+	EventSystemMaintenance  = "system-maintenance"
+	EventInstanceRetirement = "instance-retirement"
+	EventInstanceStop       = "instance-stop"
+)
+
+// InstanceStatusEvent is an event scheduled for an instance, to happen
+// between NotBefore and NotAfter. NotAfter is zero for events without a
+// deadline. Once the event is completed or canceled, its description
+// starts with "[Completed]" or "[Canceled]".
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/UserGuide/monitoring-instances-status-check_sched.html for more details.
+type InstanceStatusEvent struct {
+	Code        string    `xml:"code"`
+	Description string    `xml:"description"`
+	NotBefore   time.Time `xml:"notBefore"`
+	NotAfter    time.Time `xml:"notAfter"`
+}
+
+// Upcoming returns whether the event is neither completed nor canceled.
+func (e InstanceStatusEvent) Upcoming() bool {
+	return !strings.HasPrefix(e.Description, "[Completed]") && !strings.HasPrefix(e.Description, "[Canceled]")
+}
+
+// UpcomingEvents returns the events of the instance that are neither
+// completed nor canceled.
+func (st InstanceStatus) UpcomingEvents() []InstanceStatusEvent {
+	var events []InstanceStatusEvent
+	for _, e := range st.Events {
+		if e.Upcoming() {
+			events = append(events, e)
+		}
+	}
+	return events
 }
+
 type DescribeInstanceStatusResponse struct {
 	RequestId        string           `xml:"requestId"`
 	InstanceStatuses []InstanceStatus `xml:"instanceStatusSet>item"`
+	NextToken        string           `xml:"nextToken"`
 }
 
 func (ec2 *EC2) DescribeInstanceStatus(instIds []string, filter *Filter) (resp *DescribeInstanceStatusResponse, err error) {
@@ -1506,9 +1687,57 @@ func (ec2 *EC2) DescribeInstanceStatus(instIds []string, filter *Filter) (resp *
 	if err != nil {
 		return nil, err
 	}
+	setEventDetails(resp)
 	return resp, err
 }
 
+// InstancesWithScheduledEvents returns the status of all of the instances,
+// running or not, that have upcoming scheduled events, such as a system
+// reboot or a retirement. filter may limit the instances looked at.
+func (ec2 *EC2) InstancesWithScheduledEvents(filter *Filter) ([]InstanceStatus, error) {
+	var statuses []InstanceStatus
+	nextToken := ""
+	for {
+		params := makeParams("DescribeInstanceStatus")
+		params["IncludeAllInstances"] = "true"
+		if nextToken != "" {
+			params["NextToken"] = nextToken
+		}
+		filter.addParams(params)
+		resp := &DescribeInstanceStatusResponse{}
+		if err := ec2.query(params, resp); err != nil {
+			return nil, err
+		}
+		setEventDetails(resp)
+		for _, st := range resp.InstanceStatuses {
+			if len(st.UpcomingEvents()) > 0 {
+				statuses = append(statuses, st)
+			}
+		}
+		if resp.NextToken == "" {
+			return statuses, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
+func setEventDetails(resp *DescribeInstanceStatusResponse) {
+	for i, st := range resp.InstanceStatuses {
+		if len(st.Events) > 0 {
+			e := st.Events[0]
+			details := EventSetStruct{
+				EventCode:   e.Code,
+				Description: e.Description,
+				NotBefore:   e.NotBefore.Format(time.RFC3339),
+			}
+			if !e.NotAfter.IsZero() {
+				details.NotAfter = e.NotAfter.Format(time.RFC3339)
+			}
+			resp.InstanceStatuses[i].EventDetails = details
+		}
+	}
+}
+
 type AttachmentSetStruct struct {
 	VolumeId            string `xml:"volumeId"`
 	InstanceId          string `xml:"instanceId"`
@@ -1635,6 +1864,7 @@ type VpcStruct struct {
 	DhcpOptionsId   string `xml:"dhcpOptionsId"`
 	InstanceTenancy string `xml:"instanceTenancy"`
 	IsDefault       bool   `xml:"isDefault"`
+	Tags            []Tag  `xml:"tagSet>item"`
 }
 
 type DescribeVpcsResp struct {
@@ -1644,7 +1874,7 @@ type DescribeVpcsResp struct {
 
 func (ec2 *EC2) DescribeVpcs(vpcIds []string, filter *Filter) (resp *DescribeVpcsResp, err error) {
 	params := makeParams("DescribeVpcs")
-	addParamsList(params, "vpcId", vpcIds)
+	addParamsList(params, "VpcId", vpcIds)
 	filter.addParams(params)
 	resp = &DescribeVpcsResp{}
 	err = ec2.query(params, resp)
@@ -1708,6 +1938,7 @@ type InternetGatewayStruct struct {
 	InternetGatewayId string `xml:"internetGatewayId"`
 	AttachedVpcId     string `xml:"attachmentSet>item>vpcId"`
 	AttachState       string `xml:"attachmentSet>item>state"`
+	Tags              []Tag  `xml:"tagSet>item"`
 }
 
 type DescribeInternetGatewaysResp struct {
@@ -1,11 +1,13 @@
 package ec2_test
 
 import (
+	"fmt"
 	"github.com/zackbloom/goamz/aws"
 	"github.com/zackbloom/goamz/ec2"
 	"github.com/zackbloom/goamz/testutil"
 	"gopkg.in/check.v1"
 	"testing"
+	"time"
 )
 
 func Test(t *testing.T) {
@@ -55,6 +57,59 @@ func (s *S) TestRunInstancesErrorDump(c *check.C) {
 	c.Assert(ec2err.RequestId, check.Equals, "0503f4e9-bbd6-483c-b54f-c4ae9f3b30f4")
 }
 
+func (s *S) TestUnauthorizedOperationNotDecoded(c *check.C) {
+	testServer.Response(403, nil, UnauthorizedOperationDump)
+
+	region := aws.Region{EC2Endpoint: testServer.URL, STSEndpoint: testServer.URL}
+	e := ec2.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, region)
+	_, err := e.RunInstances(&ec2.RunInstancesOptions{ImageId: "image-id"})
+
+	testServer.WaitRequest()
+	ec2err, ok := err.(*ec2.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(ec2err.Code, check.Equals, "UnauthorizedOperation")
+	c.Assert(ec2err.DecodedMessage, check.Equals, "")
+}
+
+func (s *S) TestDecodeError(c *check.C) {
+	testServer.Response(403, nil, UnauthorizedOperationDump)
+	testServer.Response(200, nil, DecodeAuthorizationMessageDump)
+
+	region := aws.Region{EC2Endpoint: testServer.URL, STSEndpoint: testServer.URL}
+	e := ec2.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, region)
+	_, err := e.RunInstances(&ec2.RunInstancesOptions{ImageId: "image-id"})
+	testServer.WaitRequest()
+	c.Assert(e.DecodeError(fmt.Errorf("running: %w", err)), check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.PostForm.Get("Action"), check.Equals, "DecodeAuthorizationMessage")
+	c.Assert(req.PostForm.Get("EncodedMessage"), check.Equals, "EXAMPLE-ENCODED-MESSAGE")
+
+	ec2err, ok := err.(*ec2.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(ec2err.Code, check.Equals, "UnauthorizedOperation")
+	c.Assert(ec2err.DecodedMessage, check.Equals, `{"allowed":false,"context":{"action":"ec2:RunInstances"}}`)
+}
+
+func (s *S) TestDecodeErrorDenied(c *check.C) {
+	testServer.Response(403, nil, UnauthorizedOperationDump)
+	testServer.Response(403, nil, "")
+
+	region := aws.Region{EC2Endpoint: testServer.URL, STSEndpoint: testServer.URL}
+	e := ec2.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, region)
+	_, err := e.RunInstances(&ec2.RunInstancesOptions{ImageId: "image-id"})
+	decodeErr := e.DecodeError(err)
+
+	testServer.WaitRequests(2)
+	c.Assert(decodeErr, check.NotNil)
+	c.Assert(err.(*ec2.Error).DecodedMessage, check.Equals, "")
+}
+
+func (s *S) TestDecodeErrorOtherErrors(c *check.C) {
+	c.Assert(s.ec2.DecodeError(&ec2.Error{Code: "UnsupportedOperation"}), check.IsNil)
+	c.Assert(s.ec2.DecodeError(nil), check.IsNil)
+}
+
 func (s *S) TestRunInstancesErrorWithoutXML(c *check.C) {
 	testServer.Response(500, nil, "")
 	options := ec2.RunInstancesOptions{ImageId: "image-id"}
@@ -643,6 +698,7 @@ func (s *S) TestDescribeSecurityGroups(c *check.C) {
 						FromPort:     80,
 						ToPort:       80,
 						SourceIPs:    []string{"0.0.0.0/0"},
+						IPRanges:     []ec2.IPRange{{CidrIp: "0.0.0.0/0"}},
 						SourceGroups: nil,
 					},
 				},
@@ -652,6 +708,7 @@ func (s *S) TestDescribeSecurityGroups(c *check.C) {
 						FromPort:     22,
 						ToPort:       22,
 						SourceIPs:    []string{"10.0.0.0/8"},
+						IPRanges:     []ec2.IPRange{{CidrIp: "10.0.0.0/8"}},
 						SourceGroups: nil,
 					},
 				},
@@ -1022,6 +1079,48 @@ func (s *S) TestDescribeInstanceStatus(c *check.C) {
 	c.Assert(r0.SystemStatus.StatusName, check.Equals, "impaired")
 	c.Assert(r0.SystemStatus.Status, check.Equals, "failed")
 	c.Assert(r0.InstanceStatus.StatusName, check.Equals, "impaired")
+	c.Assert(r0.Events, check.DeepEquals, []ec2.InstanceStatusEvent{{
+		Code:        ec2.EventInstanceRetirement,
+		Description: "The instance is running on degraded hardware",
+		NotBefore:   time.Date(2014, 11, 19, 0, 0, 0, 0, time.UTC),
+		NotAfter:    time.Date(2014, 11, 21, 0, 0, 0, 0, time.UTC),
+	}})
+	c.Assert(r0.EventDetails.EventCode, check.Equals, "instance-retirement")
+	c.Assert(r0.EventDetails.NotAfter, check.Equals, "2014-11-21T00:00:00Z")
+}
+
+func (s *S) TestInstancesWithScheduledEvents(c *check.C) {
+	testServer.Response(200, nil, ScheduledEventsPage1)
+	testServer.Response(200, nil, ScheduledEventsPage2)
+
+	filter := ec2.NewFilter()
+	filter.Add("availability-zone", "us-east-1c", "us-east-1d")
+	statuses, err := s.ec2.InstancesWithScheduledEvents(filter)
+
+	reqs := testServer.WaitRequests(2)
+	c.Assert(reqs[0].Form["Action"], check.DeepEquals, []string{"DescribeInstanceStatus"})
+	c.Assert(reqs[0].Form["IncludeAllInstances"], check.DeepEquals, []string{"true"})
+	c.Assert(reqs[0].Form["Filter.1.Name"], check.DeepEquals, []string{"availability-zone"})
+	c.Assert(reqs[0].Form["NextToken"], check.IsNil)
+	c.Assert(reqs[1].Form["NextToken"], check.DeepEquals, []string{"AAEAAQAAAIC1YSw6EXAMPLE"})
+	c.Assert(reqs[1].Form["Filter.1.Name"], check.DeepEquals, []string{"availability-zone"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(statuses, check.HasLen, 2)
+	c.Assert(statuses[0].InstanceId, check.Equals, "i-2a2b3c4d")
+	c.Assert(statuses[0].Events, check.HasLen, 2)
+	c.Assert(statuses[0].UpcomingEvents(), check.DeepEquals, []ec2.InstanceStatusEvent{{
+		Code:        ec2.EventSystemReboot,
+		Description: "Scheduled reboot",
+		NotBefore:   time.Date(2014, 11, 20, 4, 0, 0, 0, time.UTC),
+		NotAfter:    time.Date(2014, 11, 20, 6, 0, 0, 0, time.UTC),
+	}})
+	c.Assert(statuses[1].InstanceId, check.Equals, "i-3a2b3c4d")
+	c.Assert(statuses[1].InstanceState, check.Equals, "stopped")
+	events := statuses[1].UpcomingEvents()
+	c.Assert(events, check.HasLen, 1)
+	c.Assert(events[0].Code, check.Equals, ec2.EventInstanceRetirement)
+	c.Assert(events[0].NotAfter.IsZero(), check.Equals, true)
 }
 
 func (s *S) TestDescribeVolumes(c *check.C) {
@@ -242,6 +242,37 @@ func (s *ServerTests) TestIPPerms(c *check.C) {
 	c.Assert(resp.Groups, check.HasLen, 0)
 }
 
+func (s *ServerTests) TestRevokeEditedIPPerm(c *check.C) {
+	g0 := s.makeTestGroup(c, "goamz-test0", "ec2test group 0")
+	defer s.ec2.DeleteSecurityGroup(g0)
+
+	_, err := s.ec2.AuthorizeSecurityGroup(g0, []ec2.IPPerm{{
+		Protocol:  "tcp",
+		FromPort:  80,
+		ToPort:    80,
+		SourceIPs: []string{"127.0.0.0/24", "200.1.1.34/32"},
+	}})
+	c.Assert(err, check.IsNil)
+
+	resp, err := s.ec2.SecurityGroups([]ec2.SecurityGroup{g0}, nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Groups[0].IPPerms, check.HasLen, 1)
+
+	// Revoking the permission read back, with one of its blocks taken
+	// out of SourceIPs, leaves that block alone.
+	perm := resp.Groups[0].IPPerms[0]
+	c.Assert(perm.IPRanges, check.HasLen, 2)
+	sort.Strings(perm.SourceIPs)
+	perm.SourceIPs = perm.SourceIPs[1:]
+	_, err = s.ec2.RevokeSecurityGroup(g0, []ec2.IPPerm{perm})
+	c.Assert(err, check.IsNil)
+
+	resp, err = s.ec2.SecurityGroups([]ec2.SecurityGroup{g0}, nil)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Groups[0].IPPerms, check.HasLen, 1)
+	c.Check(resp.Groups[0].IPPerms[0].SourceIPs, check.DeepEquals, []string{"127.0.0.0/24"})
+}
+
 func (s *ServerTests) TestDuplicateIPPerm(c *check.C) {
 	name := "goamz-test"
 	descr := "goamz security group for tests"
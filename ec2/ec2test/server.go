@@ -181,7 +181,7 @@ func (g *securityGroup) ec2Perms() (perms []ec2.IPPerm) {
 					OwnerId: ownerId,
 				})
 		} else {
-			ec2p.SourceIPs = append(ec2p.SourceIPs, k.ipAddr)
+			ec2p.IPRanges = append(ec2p.IPRanges, ec2.IPRange{CidrIp: k.ipAddr})
 		}
 	}
 	for _, ec2p := range result {
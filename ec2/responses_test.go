@@ -6,6 +6,24 @@ var (
 <Response><Errors><Error><Code>UnsupportedOperation</Code>
 <Message>AMIs with an instance-store root device are not supported for the instance type 't1.micro'.</Message>
 </Error></Errors><RequestID>0503f4e9-bbd6-483c-b54f-c4ae9f3b30f4</RequestID></Response>
+`
+
+	UnauthorizedOperationDump = `
+<?xml version="1.0" encoding="UTF-8"?>
+<Response><Errors><Error><Code>UnauthorizedOperation</Code>
+<Message>You are not authorized to perform this operation. Encoded authorization failure message: EXAMPLE-ENCODED-MESSAGE</Message>
+</Error></Errors><RequestID>b5cb0a0c-6bb4-4d7f-9a0c-3f1f8b0d4b57</RequestID></Response>
+`
+
+	DecodeAuthorizationMessageDump = `
+<DecodeAuthorizationMessageResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <DecodeAuthorizationMessageResult>
+    <DecodedMessage>{"allowed":false,"context":{"action":"ec2:RunInstances"}}</DecodedMessage>
+  </DecodeAuthorizationMessageResult>
+  <ResponseMetadata>
+    <RequestId>6624a9ca-cd25-4f50-b2a5-7ba65bf07453</RequestId>
+  </ResponseMetadata>
+</DecodeAuthorizationMessageResponse>
 `
 
 	// http://goo.gl/Mcm3b
@@ -920,8 +938,8 @@ var (
               <item>
                 <code>instance-retirement</code>
                 <description>The instance is running on degraded hardware</description>
-                <notBefore>YYYY-MM-DDTHH:MM:SS+0000</notBefore>
-                <notAfter>YYYY-MM-DDTHH:MM:SS+0000</notAfter>
+                <notBefore>2014-11-19T00:00:00.000Z</notBefore>
+                <notAfter>2014-11-21T00:00:00.000Z</notAfter>
               </item>
             </eventsSet>
         </item>
@@ -954,8 +972,8 @@ var (
               <item>
                 <code>instance-reboot</code>
                 <description>The instance is scheduled for a reboot</description>
-                <notBefore>YYYY-MM-DDTHH:MM:SS+0000</notBefore>
-                <notAfter>YYYY-MM-DDTHH:MM:SS+0000</notAfter>
+                <notBefore>2014-11-20T04:00:00.000Z</notBefore>
+                <notAfter>2014-11-20T06:00:00.000Z</notAfter>
               </item>
             </eventsSet>
         </item>
@@ -1142,5 +1160,593 @@ var (
       </item>
    </internetGatewaySet>
 </DescribeInternetGatewaysResponse>
+`
+
+	InstanceNotFoundDump = `
+<?xml version="1.0" encoding="UTF-8"?>
+<Response><Errors><Error><Code>InvalidInstanceID.NotFound</Code>
+<Message>The instance ID 'i-1a2b3c4d' does not exist</Message>
+</Error></Errors><RequestID>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestID></Response>
+`
+
+	// Formatted with the items of InstanceStateItem.
+	DescribeInstanceStatesDump = `
+<DescribeInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2014-10-01/">
+  <requestId>98e3c9a4-848c-4d6d-8e8a-b1bdEXAMPLE</requestId>
+  <reservationSet>
+    <item>
+      <reservationId>r-b27e30d9</reservationId>
+      <ownerId>999988887777</ownerId>
+      <instancesSet>%s
+      </instancesSet>
+    </item>
+  </reservationSet>
+</DescribeInstancesResponse>
+`
+
+	// Formatted with the instance id and the state name.
+	InstanceStateItem = `
+        <item>
+          <instanceId>%s</instanceId>
+          <instanceState>
+            <name>%s</name>
+          </instanceState>
+        </item>`
+
+	// Formatted with the instance id, the system status and the instance
+	// status.
+	InstanceStatusItem = `
+    <item>
+      <instanceId>%s</instanceId>
+      <availabilityZone>us-east-1d</availabilityZone>
+      <instanceState>
+        <code>16</code>
+        <name>running</name>
+      </instanceState>
+      <systemStatus>
+        <status>%s</status>
+      </systemStatus>
+      <instanceStatus>
+        <status>%s</status>
+      </instanceStatus>
+    </item>`
+
+	// Formatted with the items of InstanceStatusItem.
+	DescribeInstanceStatusesDump = `
+<DescribeInstanceStatusResponse xmlns="http://ec2.amazonaws.com/doc/2014-10-01/">
+  <requestId>3be1508e-c444-4fef-89cc-0b1223c4f02fEXAMPLE</requestId>
+  <instanceStatusSet>%s
+  </instanceStatusSet>
+</DescribeInstanceStatusResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotInstances.html
+	RequestSpotInstancesExample = `
+<RequestSpotInstancesResponse xmlns="http://ec2.amazonaws.com/doc/2014-02-01/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <spotInstanceRequestSet>
+    <item>
+      <spotInstanceRequestId>sir-1a2b3c4d</spotInstanceRequestId>
+      <spotPrice>0.5</spotPrice>
+      <type>one-time</type>
+      <state>open</state>
+      <status>
+        <code>pending-evaluation</code>
+        <updateTime>2014-04-30T18:14:55.000Z</updateTime>
+        <message>Your Spot request has been submitted for review, and is pending evaluation.</message>
+      </status>
+      <availabilityZoneGroup>MyAzGroup</availabilityZoneGroup>
+      <launchSpecification>
+        <imageId>ami-1a2b3c4d</imageId>
+        <keyName>my-key-pair</keyName>
+        <groupSet>
+          <item>
+            <groupId>sg-1a2b3c4d</groupId>
+            <groupName>websrv</groupName>
+          </item>
+        </groupSet>
+        <instanceType>m1.small</instanceType>
+        <blockDeviceMapping/>
+        <monitoring>
+          <enabled>false</enabled>
+        </monitoring>
+        <ebsOptimized>false</ebsOptimized>
+      </launchSpecification>
+      <createTime>2014-04-30T18:14:55.000Z</createTime>
+      <productDescription>Linux/UNIX</productDescription>
+    </item>
+  </spotInstanceRequestSet>
+</RequestSpotInstancesResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeSpotPriceHistory.html
+	DescribeSpotPriceHistoryExample = `
+<DescribeSpotPriceHistoryResponse xmlns="http://ec2.amazonaws.com/doc/2014-02-01/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <spotPriceHistorySet>
+    <item>
+      <instanceType>m3.medium</instanceType>
+      <productDescription>Linux/UNIX</productDescription>
+      <spotPrice>0.0287</spotPrice>
+      <timestamp>2014-01-06T04:32:53.000Z</timestamp>
+      <availabilityZone>us-west-2a</availabilityZone>
+    </item>
+    <item>
+      <instanceType>m3.medium</instanceType>
+      <productDescription>Linux/UNIX</productDescription>
+      <spotPrice>0.0287</spotPrice>
+      <timestamp>2014-01-05T11:28:26.000Z</timestamp>
+      <availabilityZone>us-west-2c</availabilityZone>
+    </item>
+  </spotPriceHistorySet>
+  <nextToken>d0c3a5b9-ab68-4ec1-a5b3-EXAMPLE</nextToken>
+</DescribeSpotPriceHistoryResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotFleet.html
+	RequestSpotFleetExample = `
+<RequestSpotFleetResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>60262cc5-2bd4-4c8d-98ed-example</requestId>
+  <spotFleetRequestId>sfr-123f8fc2-cb31-425e-abcd-example2710</spotFleetRequestId>
+</RequestSpotFleetResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySpotFleetRequest.html
+	ModifySpotFleetRequestExample = `
+<ModifySpotFleetRequestResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>4d68a6cc-8f2e-4be1-b425-example</requestId>
+  <return>true</return>
+</ModifySpotFleetRequestResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CancelSpotFleetRequests.html
+	CancelSpotFleetRequestsExample = `
+<CancelSpotFleetRequestsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>e12d2fe5-6503-4b4b-911c-example</requestId>
+  <unsuccessfulFleetRequestSet>
+    <item>
+      <spotFleetRequestId>sfr-0e3b2f17-4b2f-4ae2-bd4b-example</spotFleetRequestId>
+      <error>
+        <code>fleetRequestIdDoesNotExist</code>
+        <message>The spot fleet request Id does not exist</message>
+      </error>
+    </item>
+  </unsuccessfulFleetRequestSet>
+  <successfulFleetRequestSet>
+    <item>
+      <spotFleetRequestId>sfr-123f8fc2-cb31-425e-abcd-example2710</spotFleetRequestId>
+      <currentSpotFleetRequestState>cancelled_terminating</currentSpotFleetRequestState>
+      <previousSpotFleetRequestState>active</previousSpotFleetRequestState>
+    </item>
+  </successfulFleetRequestSet>
+</CancelSpotFleetRequestsResponse>
+`
+
+	// First page of the statuses listed by InstancesWithScheduledEvents.
+	ScheduledEventsPage1 = `
+<DescribeInstanceStatusResponse xmlns="http://ec2.amazonaws.com/doc/2014-10-01/">
+  <requestId>3be1508e-c444-4fef-89cc-0b1223c4f02fEXAMPLE</requestId>
+  <instanceStatusSet>
+    <item>
+      <instanceId>i-1a2b3c4d</instanceId>
+      <availabilityZone>us-east-1d</availabilityZone>
+      <instanceState>
+        <code>16</code>
+        <name>running</name>
+      </instanceState>
+      <systemStatus>
+        <status>ok</status>
+      </systemStatus>
+      <instanceStatus>
+        <status>ok</status>
+      </instanceStatus>
+      <eventsSet>
+        <item>
+          <code>system-reboot</code>
+          <description>[Completed] Scheduled reboot</description>
+          <notBefore>2014-10-02T04:00:00.000Z</notBefore>
+          <notAfter>2014-10-02T06:00:00.000Z</notAfter>
+        </item>
+      </eventsSet>
+    </item>
+    <item>
+      <instanceId>i-2a2b3c4d</instanceId>
+      <availabilityZone>us-east-1d</availabilityZone>
+      <instanceState>
+        <code>16</code>
+        <name>running</name>
+      </instanceState>
+      <systemStatus>
+        <status>ok</status>
+      </systemStatus>
+      <instanceStatus>
+        <status>ok</status>
+      </instanceStatus>
+      <eventsSet>
+        <item>
+          <code>system-reboot</code>
+          <description>[Canceled] Scheduled reboot</description>
+          <notBefore>2014-10-09T04:00:00.000Z</notBefore>
+          <notAfter>2014-10-09T06:00:00.000Z</notAfter>
+        </item>
+        <item>
+          <code>system-reboot</code>
+          <description>Scheduled reboot</description>
+          <notBefore>2014-11-20T04:00:00.000Z</notBefore>
+          <notAfter>2014-11-20T06:00:00.000Z</notAfter>
+        </item>
+      </eventsSet>
+    </item>
+  </instanceStatusSet>
+  <nextToken>AAEAAQAAAIC1YSw6EXAMPLE</nextToken>
+</DescribeInstanceStatusResponse>
+`
+
+	// Last page of the statuses listed by InstancesWithScheduledEvents.
+	ScheduledEventsPage2 = `
+<DescribeInstanceStatusResponse xmlns="http://ec2.amazonaws.com/doc/2014-10-01/">
+  <requestId>5a4ec3b1-8c3d-4bd3-a2a8-3f1f4a1bEXAMPLE</requestId>
+  <instanceStatusSet>
+    <item>
+      <instanceId>i-3a2b3c4d</instanceId>
+      <availabilityZone>us-east-1c</availabilityZone>
+      <instanceState>
+        <code>80</code>
+        <name>stopped</name>
+      </instanceState>
+      <systemStatus>
+        <status>not-applicable</status>
+      </systemStatus>
+      <instanceStatus>
+        <status>not-applicable</status>
+      </instanceStatus>
+      <eventsSet>
+        <item>
+          <code>instance-retirement</code>
+          <description>The instance is running on degraded hardware</description>
+          <notBefore>2014-11-25T00:00:00.000Z</notBefore>
+        </item>
+      </eventsSet>
+    </item>
+    <item>
+      <instanceId>i-4a2b3c4d</instanceId>
+      <availabilityZone>us-east-1c</availabilityZone>
+      <instanceState>
+        <code>16</code>
+        <name>running</name>
+      </instanceState>
+      <systemStatus>
+        <status>ok</status>
+      </systemStatus>
+      <instanceStatus>
+        <status>ok</status>
+      </instanceStatus>
+    </item>
+  </instanceStatusSet>
+</DescribeInstanceStatusResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateVpc.html
+	CreateVpcExample = `
+<CreateVpcResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <vpc>
+    <vpcId>vpc-1a2b3c4d</vpcId>
+    <state>pending</state>
+    <cidrBlock>10.0.0.0/16</cidrBlock>
+    <dhcpOptionsId>dopt-1a2b3c4d2</dhcpOptionsId>
+    <instanceTenancy>default</instanceTenancy>
+  </vpc>
+</CreateVpcResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DeleteVpc.html
+	DeleteVpcExample = `
+<DeleteVpcResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <return>true</return>
+</DeleteVpcResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateSubnet.html
+	CreateSubnetExample = `
+<CreateSubnetResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <subnet>
+    <subnetId>subnet-9d4a7b6c</subnetId>
+    <state>pending</state>
+    <vpcId>vpc-1a2b3c4d</vpcId>
+    <cidrBlock>10.0.1.0/24</cidrBlock>
+    <availableIpAddressCount>251</availableIpAddressCount>
+    <availabilityZone>us-east-1a</availabilityZone>
+    <defaultForAz>false</defaultForAz>
+    <mapPublicIpOnLaunch>false</mapPublicIpOnLaunch>
+  </subnet>
+</CreateSubnetResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeRouteTables.html
+	DescribeRouteTablesExample = `
+<DescribeRouteTablesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>6f570b0b-9c18-4b07-bdec-73740dcf861aEXAMPLE</requestId>
+  <routeTableSet>
+    <item>
+      <routeTableId>rtb-f9ad4890</routeTableId>
+      <vpcId>vpc-11ad4878</vpcId>
+      <routeSet>
+        <item>
+          <destinationCidrBlock>10.0.0.0/22</destinationCidrBlock>
+          <gatewayId>local</gatewayId>
+          <state>active</state>
+          <origin>CreateRouteTable</origin>
+        </item>
+        <item>
+          <destinationCidrBlock>0.0.0.0/0</destinationCidrBlock>
+          <natGatewayId>nat-08d48af2a8e83edfd</natGatewayId>
+          <state>active</state>
+          <origin>CreateRoute</origin>
+        </item>
+      </routeSet>
+      <associationSet>
+        <item>
+          <routeTableAssociationId>rtbassoc-faad4893</routeTableAssociationId>
+          <routeTableId>rtb-f9ad4890</routeTableId>
+          <subnetId>subnet-15ad487c</subnetId>
+          <main>false</main>
+        </item>
+      </associationSet>
+      <tagSet/>
+    </item>
+  </routeTableSet>
+</DescribeRouteTablesResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_AssociateRouteTable.html
+	AssociateRouteTableExample = `
+<AssociateRouteTableResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <associationId>rtbassoc-f8ad4891</associationId>
+</AssociateRouteTableResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateInternetGateway.html
+	CreateInternetGatewayExample = `
+<CreateInternetGatewayResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <internetGateway>
+    <internetGatewayId>igw-eaad4883</internetGatewayId>
+    <attachmentSet/>
+    <tagSet/>
+  </internetGateway>
+</CreateInternetGatewayResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_AttachInternetGateway.html
+	AttachInternetGatewayExample = `
+<AttachInternetGatewayResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <return>true</return>
+</AttachInternetGatewayResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateNatGateway.html
+	CreateNatGatewayExample = `
+<CreateNatGatewayResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>1b74dc5c-bcda-403f-867d-example</requestId>
+  <natGateway>
+    <subnetId>subnet-1a2b3c4d</subnetId>
+    <natGatewayAddressSet>
+      <item>
+        <allocationId>eipalloc-37fc1a52</allocationId>
+      </item>
+    </natGatewayAddressSet>
+    <createTime>2015-11-25T14:00:55.416Z</createTime>
+    <vpcId>vpc-4e20d42b</vpcId>
+    <natGatewayId>nat-04e77a5e9c34432f9</natGatewayId>
+    <state>pending</state>
+  </natGateway>
+</CreateNatGatewayResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeNatGateways.html
+	DescribeNatGatewaysExample = `
+<DescribeNatGatewaysResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>bfed02c6-dae9-47c0-86a2-example</requestId>
+  <natGatewaySet>
+    <item>
+      <subnetId>subnet-1a2a3a4a</subnetId>
+      <natGatewayAddressSet>
+        <item>
+          <networkInterfaceId>eni-00e37850</networkInterfaceId>
+          <publicIp>198.18.125.129</publicIp>
+          <allocationId>eipalloc-37fc1a52</allocationId>
+          <privateIp>10.0.2.147</privateIp>
+        </item>
+      </natGatewayAddressSet>
+      <createTime>2015-11-25T14:00:55.416Z</createTime>
+      <vpcId>vpc-4e20d42b</vpcId>
+      <natGatewayId>nat-04e77a5e9c34432f9</natGatewayId>
+      <state>available</state>
+    </item>
+    <item>
+      <subnetId>subnet-7f7e7d7c</subnetId>
+      <natGatewayAddressSet/>
+      <createTime>2015-11-26T09:12:01.301Z</createTime>
+      <vpcId>vpc-4e20d42b</vpcId>
+      <natGatewayId>nat-0a93acc57881d4199</natGatewayId>
+      <state>failed</state>
+      <failureCode>Gateway.NotAttached</failureCode>
+      <failureMessage>Network vpc-4e20d42b has no Internet gateway attached</failureMessage>
+    </item>
+  </natGatewaySet>
+  <nextToken>eyJ2IjoiMSIsImMiOiJFWEFNUExFIn0=</nextToken>
+</DescribeNatGatewaysResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateVpcPeeringConnection.html
+	CreateVpcPeeringConnectionExample = `
+<CreateVpcPeeringConnectionResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <vpcPeeringConnection>
+    <vpcPeeringConnectionId>pcx-73a5401a</vpcPeeringConnectionId>
+    <requesterVpcInfo>
+      <ownerId>777788889999</ownerId>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <cidrBlock>10.0.0.0/28</cidrBlock>
+    </requesterVpcInfo>
+    <accepterVpcInfo>
+      <ownerId>123456789012</ownerId>
+      <vpcId>vpc-a1b2c3d4</vpcId>
+    </accepterVpcInfo>
+    <status>
+      <code>initiating-request</code>
+      <message>Initiating Request to 123456789012</message>
+    </status>
+    <expirationTime>2014-02-18T14:37:25.000Z</expirationTime>
+    <tagSet/>
+  </vpcPeeringConnection>
+</CreateVpcPeeringConnectionResponse>
+`
+
+	// http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_AcceptVpcPeeringConnection.html
+	AcceptVpcPeeringConnectionExample = `
+<AcceptVpcPeeringConnectionResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</requestId>
+  <vpcPeeringConnection>
+    <vpcPeeringConnectionId>pcx-1a2b3c4d</vpcPeeringConnectionId>
+    <requesterVpcInfo>
+      <ownerId>123456789012</ownerId>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <cidrBlock>10.0.0.0/28</cidrBlock>
+    </requesterVpcInfo>
+    <accepterVpcInfo>
+      <ownerId>777788889999</ownerId>
+      <vpcId>vpc-111aaa22</vpcId>
+      <cidrBlock>10.0.1.0/28</cidrBlock>
+    </accepterVpcInfo>
+    <status>
+      <code>active</code>
+      <message>Active</message>
+    </status>
+    <tagSet/>
+  </vpcPeeringConnection>
+</AcceptVpcPeeringConnectionResponse>
+`
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_AuthorizeSecurityGroupEgress.html
+	AuthorizeSecurityGroupEgressExample = `
+<AuthorizeSecurityGroupEgressResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <return>true</return>
+  <securityGroupRuleSet>
+    <item>
+      <securityGroupRuleId>sgr-0b6ad7b5a4f3e4f1a</securityGroupRuleId>
+      <groupId>sg-1a2b3c4d</groupId>
+      <groupOwnerId>123456789012</groupOwnerId>
+      <isEgress>true</isEgress>
+      <ipProtocol>tcp</ipProtocol>
+      <fromPort>443</fromPort>
+      <toPort>443</toPort>
+      <cidrIpv6>2001:db8::/32</cidrIpv6>
+      <description>HTTPS out</description>
+    </item>
+  </securityGroupRuleSet>
+</AuthorizeSecurityGroupEgressResponse>
+`
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeSecurityGroupRules.html
+	DescribeSecurityGroupRulesExample = `
+<DescribeSecurityGroupRulesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <securityGroupRuleSet>
+    <item>
+      <securityGroupRuleId>sgr-0a1b2c3d4e5f6a7b8</securityGroupRuleId>
+      <groupId>sg-1a2b3c4d</groupId>
+      <groupOwnerId>123456789012</groupOwnerId>
+      <isEgress>false</isEgress>
+      <ipProtocol>tcp</ipProtocol>
+      <fromPort>22</fromPort>
+      <toPort>22</toPort>
+      <cidrIpv4>203.0.113.0/24</cidrIpv4>
+      <description>SSH from the office</description>
+      <tagSet>
+        <item>
+          <key>team</key>
+          <value>ops</value>
+        </item>
+      </tagSet>
+    </item>
+    <item>
+      <securityGroupRuleId>sgr-0f1e2d3c4b5a69788</securityGroupRuleId>
+      <groupId>sg-1a2b3c4d</groupId>
+      <groupOwnerId>123456789012</groupOwnerId>
+      <isEgress>false</isEgress>
+      <ipProtocol>-1</ipProtocol>
+      <fromPort>-1</fromPort>
+      <toPort>-1</toPort>
+      <referencedGroupInfo>
+        <groupId>sg-9f8e7d6c</groupId>
+        <userId>123456789012</userId>
+      </referencedGroupInfo>
+    </item>
+  </securityGroupRuleSet>
+  <nextToken>token-2</nextToken>
+</DescribeSecurityGroupRulesResponse>
+`
+
+	// https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySecurityGroupRules.html
+	ModifySecurityGroupRulesExample = `
+<ModifySecurityGroupRulesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <return>true</return>
+</ModifySecurityGroupRulesResponse>
+`
+
+	SecurityGroupsDescribedRulesExample = `
+<DescribeSecurityGroupsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>59dbff89-35bd-4eac-99ed-be587EXAMPLE</requestId>
+  <securityGroupInfo>
+    <item>
+      <ownerId>123456789012</ownerId>
+      <groupId>sg-1a2b3c4d</groupId>
+      <groupName>web</groupName>
+      <groupDescription>Web servers</groupDescription>
+      <vpcId>vpc-1a2b3c4d</vpcId>
+      <ipPermissions>
+        <item>
+          <ipProtocol>tcp</ipProtocol>
+          <fromPort>443</fromPort>
+          <toPort>443</toPort>
+          <groups>
+            <item>
+              <userId>123456789012</userId>
+              <groupId>sg-9f8e7d6c</groupId>
+              <description>Load balancers</description>
+            </item>
+          </groups>
+          <ipRanges>
+            <item>
+              <cidrIp>203.0.113.0/24</cidrIp>
+              <description>Office</description>
+            </item>
+          </ipRanges>
+          <ipv6Ranges>
+            <item>
+              <cidrIpv6>2001:db8::/32</cidrIpv6>
+              <description>Office IPv6</description>
+            </item>
+          </ipv6Ranges>
+          <prefixListIds>
+            <item>
+              <prefixListId>pl-12345678</prefixListId>
+              <description>S3 endpoint</description>
+            </item>
+          </prefixListIds>
+        </item>
+      </ipPermissions>
+      <ipPermissionsEgress/>
+    </item>
+  </securityGroupInfo>
+</DescribeSecurityGroupsResponse>
 `
 )
@@ -0,0 +1,240 @@
+package ec2
+
+import (
+	"strconv"
+)
+
+// AuthorizeSecurityGroupResp is the response to AuthorizeSecurityGroupIngress
+// and AuthorizeSecurityGroupEgress, holding the rules that were added.
+type AuthorizeSecurityGroupResp struct {
+	RequestId string              `xml:"requestId"`
+	Return    bool                `xml:"return"`
+	Rules     []SecurityGroupRule `xml:"securityGroupRuleSet>item"`
+}
+
+// AuthorizeSecurityGroupIngress is like AuthorizeSecurityGroup, but returns
+// the rules that were added, with their ids.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_AuthorizeSecurityGroupIngress.html for more details.
+func (ec2 *EC2) AuthorizeSecurityGroupIngress(group SecurityGroup, perms []IPPerm) (resp *AuthorizeSecurityGroupResp, err error) {
+	return ec2.authorize("AuthorizeSecurityGroupIngress", group, perms)
+}
+
+// AuthorizeSecurityGroupEgress adds outbound rules to the given VPC security
+// group, which must be given by Id.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_AuthorizeSecurityGroupEgress.html for more details.
+func (ec2 *EC2) AuthorizeSecurityGroupEgress(group SecurityGroup, perms []IPPerm) (resp *AuthorizeSecurityGroupResp, err error) {
+	return ec2.authorize("AuthorizeSecurityGroupEgress", group, perms)
+}
+
+// RevokeSecurityGroupEgress removes outbound rules from the given VPC
+// security group, which must be given by Id.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RevokeSecurityGroupEgress.html for more details.
+func (ec2 *EC2) RevokeSecurityGroupEgress(group SecurityGroup, perms []IPPerm) (resp *SimpleResp, err error) {
+	return ec2.authOrRevoke("RevokeSecurityGroupEgress", group, perms)
+}
+
+func (ec2 *EC2) authorize(op string, group SecurityGroup, perms []IPPerm) (resp *AuthorizeSecurityGroupResp, err error) {
+	params := makeParams(op)
+	params["Version"] = recentVersion
+	if group.Id != "" {
+		params["GroupId"] = group.Id
+	} else {
+		params["GroupName"] = group.Name
+	}
+	addIPPermParams(params, perms)
+
+	resp = &AuthorizeSecurityGroupResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SecurityGroupRule is a single inbound or outbound rule of a security
+// group. Exactly one of CidrIpv4, CidrIpv6, PrefixListId and
+// ReferencedGroup is set.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SecurityGroupRule.html for more details.
+type SecurityGroupRule struct {
+	SecurityGroupRuleId string                   `xml:"securityGroupRuleId"`
+	GroupId             string                   `xml:"groupId"`
+	GroupOwnerId        string                   `xml:"groupOwnerId"`
+	IsEgress            bool                     `xml:"isEgress"`
+	IpProtocol          string                   `xml:"ipProtocol"`
+	FromPort            int                      `xml:"fromPort"`
+	ToPort              int                      `xml:"toPort"`
+	CidrIpv4            string                   `xml:"cidrIpv4"`
+	CidrIpv6            string                   `xml:"cidrIpv6"`
+	PrefixListId        string                   `xml:"prefixListId"`
+	ReferencedGroup     *ReferencedSecurityGroup `xml:"referencedGroupInfo"`
+	Description         string                   `xml:"description"`
+	Tags                []Tag                    `xml:"tagSet>item"`
+}
+
+// ReferencedSecurityGroup is the security group allowed by a
+// SecurityGroupRule.
+type ReferencedSecurityGroup struct {
+	GroupId                string `xml:"groupId"`
+	UserId                 string `xml:"userId"`
+	VpcId                  string `xml:"vpcId"`
+	VpcPeeringConnectionId string `xml:"vpcPeeringConnectionId"`
+}
+
+// DescribeSecurityGroupRulesResp is the response to a
+// DescribeSecurityGroupRules request.
+type DescribeSecurityGroupRulesResp struct {
+	RequestId string              `xml:"requestId"`
+	Rules     []SecurityGroupRule `xml:"securityGroupRuleSet>item"`
+	NextToken string              `xml:"nextToken"`
+}
+
+// DescribeSecurityGroupRules returns the given security group rules, or
+// all of them. Rules can be filtered, such as by "group-id". Results are
+// paginated; pass the NextToken of a response to get the next page.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeSecurityGroupRules.html for more details.
+func (ec2 *EC2) DescribeSecurityGroupRules(ruleIds []string, filter *Filter, nextToken string) (resp *DescribeSecurityGroupRulesResp, err error) {
+	params := makeParams("DescribeSecurityGroupRules")
+	params["Version"] = recentVersion
+	addParamsList(params, "SecurityGroupRuleId", ruleIds)
+	filter.addParams(params)
+	if nextToken != "" {
+		params["NextToken"] = nextToken
+	}
+
+	resp = &DescribeSecurityGroupRulesResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SecurityGroupRuleUpdate replaces the rule SecurityGroupRuleId of a
+// security group with Rule. ReferencedGroupId in Rule allows another
+// security group.
+type SecurityGroupRuleUpdate struct {
+	SecurityGroupRuleId string
+	Rule                SecurityGroupRuleRequest
+}
+
+// SecurityGroupRuleRequest describes a security group rule in
+// ModifySecurityGroupRules. Only one of CidrIpv4, CidrIpv6, PrefixListId
+// and ReferencedGroupId may be set.
+type SecurityGroupRuleRequest struct {
+	IpProtocol        string
+	FromPort          int
+	ToPort            int
+	CidrIpv4          string
+	CidrIpv6          string
+	PrefixListId      string
+	ReferencedGroupId string
+	Description       string
+}
+
+// ModifySecurityGroupRules updates rules of the given security group in
+// place, keeping their ids, instead of revoking and authorizing them again.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_ModifySecurityGroupRules.html for more details.
+func (ec2 *EC2) ModifySecurityGroupRules(groupId string, updates []SecurityGroupRuleUpdate) (resp *SimpleResp, err error) {
+	params := makeParams("ModifySecurityGroupRules")
+	params["Version"] = recentVersion
+	params["GroupId"] = groupId
+	for i, u := range updates {
+		prefix := "SecurityGroupRule." + strconv.Itoa(i+1)
+		params[prefix+".SecurityGroupRuleId"] = u.SecurityGroupRuleId
+		prefix += ".SecurityGroupRule"
+		params[prefix+".IpProtocol"] = u.Rule.IpProtocol
+		params[prefix+".FromPort"] = strconv.Itoa(u.Rule.FromPort)
+		params[prefix+".ToPort"] = strconv.Itoa(u.Rule.ToPort)
+		if u.Rule.CidrIpv4 != "" {
+			params[prefix+".CidrIpv4"] = u.Rule.CidrIpv4
+		}
+		if u.Rule.CidrIpv6 != "" {
+			params[prefix+".CidrIpv6"] = u.Rule.CidrIpv6
+		}
+		if u.Rule.PrefixListId != "" {
+			params[prefix+".PrefixListId"] = u.Rule.PrefixListId
+		}
+		if u.Rule.ReferencedGroupId != "" {
+			params[prefix+".ReferencedGroupId"] = u.Rule.ReferencedGroupId
+		}
+		if u.Rule.Description != "" {
+			params[prefix+".Description"] = u.Rule.Description
+		}
+	}
+
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Request returns the rule as a SecurityGroupRuleRequest, to update it
+// with ModifySecurityGroupRules after changing some of its fields.
+func (r *SecurityGroupRule) Request() SecurityGroupRuleRequest {
+	req := SecurityGroupRuleRequest{
+		IpProtocol:   r.IpProtocol,
+		FromPort:     r.FromPort,
+		ToPort:       r.ToPort,
+		CidrIpv4:     r.CidrIpv4,
+		CidrIpv6:     r.CidrIpv6,
+		PrefixListId: r.PrefixListId,
+		Description:  r.Description,
+	}
+	if r.ReferencedGroup != nil {
+		req.ReferencedGroupId = r.ReferencedGroup.GroupId
+	}
+	return req
+}
+
+// RevokeSecurityGroupRules removes rules from the given security group by
+// their ids, instead of by their permissions. The rules must all be
+// outbound if egress is true, and all inbound otherwise.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RevokeSecurityGroupIngress.html for more details.
+func (ec2 *EC2) RevokeSecurityGroupRules(groupId string, egress bool, ruleIds []string) (resp *SimpleResp, err error) {
+	op := "RevokeSecurityGroupIngress"
+	if egress {
+		op = "RevokeSecurityGroupEgress"
+	}
+	params := makeParams(op)
+	params["Version"] = recentVersion
+	params["GroupId"] = groupId
+	addParamsList(params, "SecurityGroupRuleId", ruleIds)
+
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TagSecurityGroupRules adds or overwrites tags of the given security
+// group rules. It is like CreateTags, with the API version that knows
+// about rule ids.
+//
+// See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateTags.html for more details.
+func (ec2 *EC2) TagSecurityGroupRules(ruleIds []string, tags []Tag) (resp *SimpleResp, err error) {
+	params := makeParams("CreateTags")
+	params["Version"] = recentVersion
+	addParamsList(params, "ResourceId", ruleIds)
+	for j, tag := range tags {
+		params["Tag."+strconv.Itoa(j+1)+".Key"] = tag.Key
+		params["Tag."+strconv.Itoa(j+1)+".Value"] = tag.Value
+	}
+
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
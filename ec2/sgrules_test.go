@@ -0,0 +1,243 @@
+package ec2_test
+
+import (
+	"github.com/zackbloom/goamz/ec2"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestAuthorizeSecurityGroupIngressWithDescriptions(c *check.C) {
+	testServer.Response(200, nil, AuthorizeSecurityGroupIngressExample)
+
+	perms := []ec2.IPPerm{{
+		Protocol:      "tcp",
+		FromPort:      443,
+		ToPort:        443,
+		IPRanges:      []ec2.IPRange{{CidrIp: "203.0.113.0/24", Description: "Office"}, {CidrIp: "198.51.100.7/32"}},
+		IPv6Ranges:    []ec2.IPv6Range{{CidrIpv6: "2001:db8::/32", Description: "Office IPv6"}},
+		PrefixListIds: []ec2.PrefixListId{{PrefixListId: "pl-12345678", Description: "S3 endpoint"}},
+		SourceGroups:  []ec2.UserSecurityGroup{{Id: "sg-9f8e7d6c", Description: "Load balancers"}},
+	}}
+	resp, err := s.ec2.AuthorizeSecurityGroupIngress(ec2.SecurityGroup{Id: "sg-1a2b3c4d"}, perms)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"AuthorizeSecurityGroupIngress"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["GroupId"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.1.CidrIp"], check.DeepEquals, []string{"203.0.113.0/24"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.1.Description"], check.DeepEquals, []string{"Office"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.2.CidrIp"], check.DeepEquals, []string{"198.51.100.7/32"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.2.Description"], check.IsNil)
+	c.Assert(req.Form["IpPermissions.1.IpRanges.3.CidrIp"], check.IsNil)
+	c.Assert(req.Form["IpPermissions.1.Ipv6Ranges.1.CidrIpv6"], check.DeepEquals, []string{"2001:db8::/32"})
+	c.Assert(req.Form["IpPermissions.1.Ipv6Ranges.1.Description"], check.DeepEquals, []string{"Office IPv6"})
+	c.Assert(req.Form["IpPermissions.1.PrefixListIds.1.PrefixListId"], check.DeepEquals, []string{"pl-12345678"})
+	c.Assert(req.Form["IpPermissions.1.PrefixListIds.1.Description"], check.DeepEquals, []string{"S3 endpoint"})
+	c.Assert(req.Form["IpPermissions.1.Groups.1.GroupId"], check.DeepEquals, []string{"sg-9f8e7d6c"})
+	c.Assert(req.Form["IpPermissions.1.Groups.1.Description"], check.DeepEquals, []string{"Load balancers"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "59dbff89-35bd-4eac-99ed-be587EXAMPLE")
+}
+
+func (s *S) TestAuthorizeSecurityGroupIngressEditedSourceIPs(c *check.C) {
+	testServer.Response(200, nil, AuthorizeSecurityGroupIngressExample)
+
+	// A permission read back from SecurityGroups, with SourceIPs edited.
+	perm := ec2.IPPerm{
+		Protocol:  "tcp",
+		FromPort:  443,
+		ToPort:    443,
+		SourceIPs: []string{"203.0.113.0/24", "192.0.2.0/24"},
+		IPRanges:  []ec2.IPRange{{CidrIp: "203.0.113.0/24", Description: "Office"}, {CidrIp: "198.51.100.7/32"}},
+	}
+	_, err := s.ec2.AuthorizeSecurityGroupIngress(ec2.SecurityGroup{Id: "sg-1a2b3c4d"}, []ec2.IPPerm{perm})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["IpPermissions.1.IpRanges.1.CidrIp"], check.DeepEquals, []string{"203.0.113.0/24"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.1.Description"], check.DeepEquals, []string{"Office"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.2.CidrIp"], check.DeepEquals, []string{"192.0.2.0/24"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.2.Description"], check.IsNil)
+	c.Assert(req.Form["IpPermissions.1.IpRanges.3.CidrIp"], check.IsNil)
+}
+
+func (s *S) TestAuthorizeSecurityGroupEgress(c *check.C) {
+	testServer.Response(200, nil, AuthorizeSecurityGroupEgressExample)
+
+	perms := []ec2.IPPerm{{
+		Protocol:   "tcp",
+		FromPort:   443,
+		ToPort:     443,
+		IPv6Ranges: []ec2.IPv6Range{{CidrIpv6: "2001:db8::/32", Description: "HTTPS out"}},
+	}}
+	resp, err := s.ec2.AuthorizeSecurityGroupEgress(ec2.SecurityGroup{Id: "sg-1a2b3c4d"}, perms)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"AuthorizeSecurityGroupEgress"})
+	c.Assert(req.Form["GroupId"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["IpPermissions.1.Ipv6Ranges.1.CidrIpv6"], check.DeepEquals, []string{"2001:db8::/32"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Return, check.Equals, true)
+	c.Assert(resp.Rules, check.DeepEquals, []ec2.SecurityGroupRule{{
+		SecurityGroupRuleId: "sgr-0b6ad7b5a4f3e4f1a",
+		GroupId:             "sg-1a2b3c4d",
+		GroupOwnerId:        "123456789012",
+		IsEgress:            true,
+		IpProtocol:          "tcp",
+		FromPort:            443,
+		ToPort:              443,
+		CidrIpv6:            "2001:db8::/32",
+		Description:         "HTTPS out",
+	}})
+}
+
+func (s *S) TestRevokeSecurityGroupEgress(c *check.C) {
+	testServer.Response(200, nil, ModifySecurityGroupRulesExample)
+
+	perms := []ec2.IPPerm{{Protocol: "-1", FromPort: -1, ToPort: -1, SourceIPs: []string{"0.0.0.0/0"}}}
+	_, err := s.ec2.RevokeSecurityGroupEgress(ec2.SecurityGroup{Id: "sg-1a2b3c4d"}, perms)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"RevokeSecurityGroupEgress"})
+	c.Assert(req.Form["IpPermissions.1.IpProtocol"], check.DeepEquals, []string{"-1"})
+	c.Assert(req.Form["IpPermissions.1.IpRanges.1.CidrIp"], check.DeepEquals, []string{"0.0.0.0/0"})
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestDescribeSecurityGroupRules(c *check.C) {
+	testServer.Response(200, nil, DescribeSecurityGroupRulesExample)
+
+	filter := ec2.NewFilter()
+	filter.Add("group-id", "sg-1a2b3c4d")
+	resp, err := s.ec2.DescribeSecurityGroupRules(nil, filter, "token-1")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeSecurityGroupRules"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["Filter.1.Name"], check.DeepEquals, []string{"group-id"})
+	c.Assert(req.Form["Filter.1.Value.1"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["NextToken"], check.DeepEquals, []string{"token-1"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.NextToken, check.Equals, "token-2")
+	c.Assert(resp.Rules, check.HasLen, 2)
+	c.Assert(resp.Rules[0], check.DeepEquals, ec2.SecurityGroupRule{
+		SecurityGroupRuleId: "sgr-0a1b2c3d4e5f6a7b8",
+		GroupId:             "sg-1a2b3c4d",
+		GroupOwnerId:        "123456789012",
+		IpProtocol:          "tcp",
+		FromPort:            22,
+		ToPort:              22,
+		CidrIpv4:            "203.0.113.0/24",
+		Description:         "SSH from the office",
+		Tags:                []ec2.Tag{{Key: "team", Value: "ops"}},
+	})
+	c.Assert(resp.Rules[1].FromPort, check.Equals, -1)
+	c.Assert(resp.Rules[1].ReferencedGroup, check.DeepEquals, &ec2.ReferencedSecurityGroup{
+		GroupId: "sg-9f8e7d6c",
+		UserId:  "123456789012",
+	})
+}
+
+func (s *S) TestModifySecurityGroupRules(c *check.C) {
+	testServer.Response(200, nil, ModifySecurityGroupRulesExample)
+
+	resp, err := s.ec2.ModifySecurityGroupRules("sg-1a2b3c4d", []ec2.SecurityGroupRuleUpdate{{
+		SecurityGroupRuleId: "sgr-0a1b2c3d4e5f6a7b8",
+		Rule: ec2.SecurityGroupRuleRequest{
+			IpProtocol:  "tcp",
+			FromPort:    22,
+			ToPort:      22,
+			CidrIpv4:    "198.51.100.0/24",
+			Description: "SSH from the new office",
+		},
+	}})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"ModifySecurityGroupRules"})
+	c.Assert(req.Form["GroupId"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRuleId"], check.DeepEquals, []string{"sgr-0a1b2c3d4e5f6a7b8"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.IpProtocol"], check.DeepEquals, []string{"tcp"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.FromPort"], check.DeepEquals, []string{"22"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.ToPort"], check.DeepEquals, []string{"22"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.CidrIpv4"], check.DeepEquals, []string{"198.51.100.0/24"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.Description"], check.DeepEquals, []string{"SSH from the new office"})
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.CidrIpv6"], check.IsNil)
+	c.Assert(req.Form["SecurityGroupRule.1.SecurityGroupRule.ReferencedGroupId"], check.IsNil)
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "59dbff89-35bd-4eac-99ed-be587EXAMPLE")
+}
+
+func (s *S) TestSecurityGroupRuleRequest(c *check.C) {
+	rule := ec2.SecurityGroupRule{
+		SecurityGroupRuleId: "sgr-0a1b2c3d4e5f6a7b8",
+		GroupId:             "sg-1a2b3c4d",
+		IpProtocol:          "tcp",
+		FromPort:            443,
+		ToPort:              443,
+		ReferencedGroup:     &ec2.ReferencedSecurityGroup{GroupId: "sg-9f8e7d6c"},
+		Description:         "Load balancers",
+	}
+	c.Assert(rule.Request(), check.DeepEquals, ec2.SecurityGroupRuleRequest{
+		IpProtocol:        "tcp",
+		FromPort:          443,
+		ToPort:            443,
+		ReferencedGroupId: "sg-9f8e7d6c",
+		Description:       "Load balancers",
+	})
+}
+
+func (s *S) TestRevokeSecurityGroupRules(c *check.C) {
+	testServer.Response(200, nil, ModifySecurityGroupRulesExample)
+
+	_, err := s.ec2.RevokeSecurityGroupRules("sg-1a2b3c4d", true, []string{"sgr-0a1b2c3d4e5f6a7b8", "sgr-0b6ad7b5a4f3e4f1a"})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"RevokeSecurityGroupEgress"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["GroupId"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["SecurityGroupRuleId.1"], check.DeepEquals, []string{"sgr-0a1b2c3d4e5f6a7b8"})
+	c.Assert(req.Form["SecurityGroupRuleId.2"], check.DeepEquals, []string{"sgr-0b6ad7b5a4f3e4f1a"})
+	c.Assert(req.Form["IpPermissions.1.IpProtocol"], check.IsNil)
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestTagSecurityGroupRules(c *check.C) {
+	testServer.Response(200, nil, CreateTagsExample)
+
+	_, err := s.ec2.TagSecurityGroupRules([]string{"sgr-0a1b2c3d4e5f6a7b8"}, []ec2.Tag{{Key: "team", Value: "ops"}})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateTags"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["ResourceId.1"], check.DeepEquals, []string{"sgr-0a1b2c3d4e5f6a7b8"})
+	c.Assert(req.Form["Tag.1.Key"], check.DeepEquals, []string{"team"})
+	c.Assert(req.Form["Tag.1.Value"], check.DeepEquals, []string{"ops"})
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestDescribeSecurityGroupsRuleDescriptions(c *check.C) {
+	testServer.Response(200, nil, SecurityGroupsDescribedRulesExample)
+
+	resp, err := s.ec2.SecurityGroups(ec2.SecurityGroupIds("sg-1a2b3c4d"), nil)
+	testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Groups, check.HasLen, 1)
+	c.Assert(resp.Groups[0].IPPerms, check.DeepEquals, []ec2.IPPerm{{
+		Protocol:      "tcp",
+		FromPort:      443,
+		ToPort:        443,
+		SourceIPs:     []string{"203.0.113.0/24"},
+		IPRanges:      []ec2.IPRange{{CidrIp: "203.0.113.0/24", Description: "Office"}},
+		IPv6Ranges:    []ec2.IPv6Range{{CidrIpv6: "2001:db8::/32", Description: "Office IPv6"}},
+		PrefixListIds: []ec2.PrefixListId{{PrefixListId: "pl-12345678", Description: "S3 endpoint"}},
+		SourceGroups: []ec2.UserSecurityGroup{{
+			Id:          "sg-9f8e7d6c",
+			OwnerId:     "123456789012",
+			Description: "Load balancers",
+		}},
+	}})
+}
@@ -0,0 +1,393 @@
+package ec2
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SpotLaunchSpecification describes the instances launched for a Spot
+// Instance request or a Spot Fleet request. WeightedCapacity and SpotPrice
+// are only used by Spot Fleet, where they set the number of units of the
+// target capacity an instance counts for and the maximum price of the
+// specification.
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetLaunchSpecification.html for more details.
+type SpotLaunchSpecification struct {
+	ImageId             string
+	InstanceType        string
+	KeyName             string
+	SecurityGroups      []SecurityGroup
+	UserData            []byte
+	AvailabilityZone    string
+	SubnetId            string
+	IamInstanceProfile  IamInstanceProfile
+	BlockDeviceMappings []BlockDeviceMapping
+	EbsOptimized        bool
+	Monitoring          bool
+	WeightedCapacity    float64
+	SpotPrice           string
+}
+
+// The RequestSpotInstancesOptions type encapsulates options for the
+// respective request in EC2. Type is "one-time" (the default) or
+// "persistent".
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RequestSpotInstances.html for more details.
+type RequestSpotInstancesOptions struct {
+	SpotPrice             string
+	InstanceCount         int
+	Type                  string
+	ValidFrom             time.Time
+	ValidUntil            time.Time
+	LaunchGroup           string
+	AvailabilityZoneGroup string
+	LaunchSpecification   SpotLaunchSpecification
+}
+
+// SpotInstanceRequest describes a request for Spot Instances. State is one
+// of "open", "active", "closed", "cancelled" or "failed"; StatusCode
+// tells more about it, such as "pending-evaluation" or "price-too-low".
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotInstanceRequest.html for more details.
+type SpotInstanceRequest struct {
+	SpotInstanceRequestId    string `xml:"spotInstanceRequestId"`
+	SpotPrice                string `xml:"spotPrice"`
+	Type                     string `xml:"type"`
+	State                    string `xml:"state"`
+	StatusCode               string `xml:"status>code"`
+	StatusMessage            string `xml:"status>message"`
+	StatusUpdateTime         string `xml:"status>updateTime"`
+	InstanceId               string `xml:"instanceId"`
+	CreateTime               string `xml:"createTime"`
+	ProductDescription       string `xml:"productDescription"`
+	LaunchedAvailabilityZone string `xml:"launchedAvailabilityZone"`
+	ImageId                  string `xml:"launchSpecification>imageId"`
+	InstanceType             string `xml:"launchSpecification>instanceType"`
+	Tags                     []Tag  `xml:"tagSet>item"`
+}
+
+// Response to a RequestSpotInstances request.
+type RequestSpotInstancesResp struct {
+	RequestId            string                `xml:"requestId"`
+	SpotInstanceRequests []SpotInstanceRequest `xml:"spotInstanceRequestSet>item"`
+}
+
+// RequestSpotInstances requests Spot Instances, which are launched once
+// capacity is available at a price below options.SpotPrice.
+func (ec2 *EC2) RequestSpotInstances(options *RequestSpotInstancesOptions) (resp *RequestSpotInstancesResp, err error) {
+	params := makeParams("RequestSpotInstances")
+	params["SpotPrice"] = options.SpotPrice
+	if options.InstanceCount != 0 {
+		params["InstanceCount"] = strconv.Itoa(options.InstanceCount)
+	}
+	if options.Type != "" {
+		params["Type"] = options.Type
+	}
+	if !options.ValidFrom.IsZero() {
+		params["ValidFrom"] = options.ValidFrom.In(time.UTC).Format(time.RFC3339)
+	}
+	if !options.ValidUntil.IsZero() {
+		params["ValidUntil"] = options.ValidUntil.In(time.UTC).Format(time.RFC3339)
+	}
+	if options.LaunchGroup != "" {
+		params["LaunchGroup"] = options.LaunchGroup
+	}
+	if options.AvailabilityZoneGroup != "" {
+		params["AvailabilityZoneGroup"] = options.AvailabilityZoneGroup
+	}
+	addSpotLaunchSpecParams(params, "LaunchSpecification.", &options.LaunchSpecification, false)
+
+	token, err := clientToken()
+	if err != nil {
+		return nil, err
+	}
+	params["ClientToken"] = token
+
+	resp = &RequestSpotInstancesResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// The DescribeSpotPriceHistoryOptions type encapsulates options for the
+// respective request in EC2. All of them are optional; a zero StartTime
+// returns the current prices.
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeSpotPriceHistory.html for more details.
+type DescribeSpotPriceHistoryOptions struct {
+	StartTime           time.Time
+	EndTime             time.Time
+	InstanceTypes       []string
+	ProductDescriptions []string // such as "Linux/UNIX (Amazon VPC)"
+	AvailabilityZone    string
+	MaxResults          int
+	NextToken           string
+}
+
+// SpotPrice is the Spot price of an instance type in an Availability Zone
+// from Timestamp on.
+type SpotPrice struct {
+	InstanceType       string `xml:"instanceType"`
+	ProductDescription string `xml:"productDescription"`
+	SpotPrice          string `xml:"spotPrice"`
+	Timestamp          string `xml:"timestamp"`
+	AvailabilityZone   string `xml:"availabilityZone"`
+}
+
+// Response to a DescribeSpotPriceHistory request. NextToken is set when
+// there are more prices to get.
+type DescribeSpotPriceHistoryResp struct {
+	RequestId        string      `xml:"requestId"`
+	SpotPriceHistory []SpotPrice `xml:"spotPriceHistorySet>item"`
+	NextToken        string      `xml:"nextToken"`
+}
+
+// DescribeSpotPriceHistory returns a page of the Spot price history.
+func (ec2 *EC2) DescribeSpotPriceHistory(options *DescribeSpotPriceHistoryOptions, filter *Filter) (resp *DescribeSpotPriceHistoryResp, err error) {
+	params := makeParams("DescribeSpotPriceHistory")
+	if options != nil {
+		if !options.StartTime.IsZero() {
+			params["StartTime"] = options.StartTime.In(time.UTC).Format(time.RFC3339)
+		}
+		if !options.EndTime.IsZero() {
+			params["EndTime"] = options.EndTime.In(time.UTC).Format(time.RFC3339)
+		}
+		addParamsList(params, "InstanceType", options.InstanceTypes)
+		addParamsList(params, "ProductDescription", options.ProductDescriptions)
+		if options.AvailabilityZone != "" {
+			params["AvailabilityZone"] = options.AvailabilityZone
+		}
+		if options.MaxResults != 0 {
+			params["MaxResults"] = strconv.Itoa(options.MaxResults)
+		}
+		if options.NextToken != "" {
+			params["NextToken"] = options.NextToken
+		}
+	}
+	filter.addParams(params)
+	resp = &DescribeSpotPriceHistoryResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// SpotFleetRequestConfig describes a Spot Fleet. IamFleetRole is the ARN
+// of the role that lets Spot Fleet launch and terminate instances.
+// AllocationStrategy is "lowestPrice" (the default) or "diversified", and
+// Type is "maintain" (the default) or "request".
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_SpotFleetRequestConfigData.html for more details.
+type SpotFleetRequestConfig struct {
+	IamFleetRole                     string
+	TargetCapacity                   int
+	SpotPrice                        string
+	AllocationStrategy               string
+	Type                             string
+	ValidFrom                        time.Time
+	ValidUntil                       time.Time
+	TerminateInstancesWithExpiration bool
+	ReplaceUnhealthyInstances        bool
+	LaunchSpecifications             []SpotLaunchSpecification
+}
+
+// Response to a RequestSpotFleet request.
+type RequestSpotFleetResp struct {
+	RequestId          string `xml:"requestId"`
+	SpotFleetRequestId string `xml:"spotFleetRequestId"`
+}
+
+// RequestSpotFleet requests a Spot Fleet, which keeps TargetCapacity units
+// of Spot Instances running from its launch specifications.
+func (ec2 *EC2) RequestSpotFleet(config *SpotFleetRequestConfig) (resp *RequestSpotFleetResp, err error) {
+	params := makeParams("RequestSpotFleet")
+	params["Version"] = recentVersion
+	prefix := "SpotFleetRequestConfig."
+	params[prefix+"IamFleetRole"] = config.IamFleetRole
+	params[prefix+"TargetCapacity"] = strconv.Itoa(config.TargetCapacity)
+	if config.SpotPrice != "" {
+		params[prefix+"SpotPrice"] = config.SpotPrice
+	}
+	if config.AllocationStrategy != "" {
+		params[prefix+"AllocationStrategy"] = config.AllocationStrategy
+	}
+	if config.Type != "" {
+		params[prefix+"Type"] = config.Type
+	}
+	if !config.ValidFrom.IsZero() {
+		params[prefix+"ValidFrom"] = config.ValidFrom.In(time.UTC).Format(time.RFC3339)
+	}
+	if !config.ValidUntil.IsZero() {
+		params[prefix+"ValidUntil"] = config.ValidUntil.In(time.UTC).Format(time.RFC3339)
+	}
+	if config.TerminateInstancesWithExpiration {
+		params[prefix+"TerminateInstancesWithExpiration"] = "true"
+	}
+	if config.ReplaceUnhealthyInstances {
+		params[prefix+"ReplaceUnhealthyInstances"] = "true"
+	}
+	for i := range config.LaunchSpecifications {
+		specPrefix := fmt.Sprintf("%sLaunchSpecifications.%d.", prefix, i+1)
+		addSpotLaunchSpecParams(params, specPrefix, &config.LaunchSpecifications[i], true)
+	}
+
+	token, err := clientToken()
+	if err != nil {
+		return nil, err
+	}
+	params[prefix+"ClientToken"] = token
+
+	resp = &RequestSpotFleetResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a ModifySpotFleetRequest request.
+type ModifySpotFleetRequestResp struct {
+	RequestId string `xml:"requestId"`
+	Return    bool   `xml:"return"`
+}
+
+// ModifySpotFleetRequest changes the target capacity of a Spot Fleet of
+// type "maintain". When the capacity is decreased, excessCapacityPolicy
+// "noTermination" keeps the running instances above it, and "default" or
+// "" terminates them.
+func (ec2 *EC2) ModifySpotFleetRequest(spotFleetRequestId string, targetCapacity int, excessCapacityPolicy string) (resp *ModifySpotFleetRequestResp, err error) {
+	params := makeParams("ModifySpotFleetRequest")
+	params["Version"] = recentVersion
+	params["SpotFleetRequestId"] = spotFleetRequestId
+	params["TargetCapacity"] = strconv.Itoa(targetCapacity)
+	if excessCapacityPolicy != "" {
+		params["ExcessCapacityTerminationPolicy"] = excessCapacityPolicy
+	}
+	resp = &ModifySpotFleetRequestResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// CancelledSpotFleetRequest is a Spot Fleet request that was cancelled.
+type CancelledSpotFleetRequest struct {
+	SpotFleetRequestId string `xml:"spotFleetRequestId"`
+	CurrentState       string `xml:"currentSpotFleetRequestState"`
+	PreviousState      string `xml:"previousSpotFleetRequestState"`
+}
+
+// UncancelledSpotFleetRequest is a Spot Fleet request that couldn't be
+// cancelled, with the reason.
+type UncancelledSpotFleetRequest struct {
+	SpotFleetRequestId string `xml:"spotFleetRequestId"`
+	Code               string `xml:"error>code"`
+	Message            string `xml:"error>message"`
+}
+
+// Response to a CancelSpotFleetRequests request.
+type CancelSpotFleetRequestsResp struct {
+	RequestId    string                        `xml:"requestId"`
+	Successful   []CancelledSpotFleetRequest   `xml:"successfulFleetRequestSet>item"`
+	Unsuccessful []UncancelledSpotFleetRequest `xml:"unsuccessfulFleetRequestSet>item"`
+}
+
+// CancelSpotFleetRequests cancels Spot Fleet requests, and terminates their
+// instances if terminateInstances is true.
+func (ec2 *EC2) CancelSpotFleetRequests(spotFleetRequestIds []string, terminateInstances bool) (resp *CancelSpotFleetRequestsResp, err error) {
+	params := makeParams("CancelSpotFleetRequests")
+	params["Version"] = recentVersion
+	addParamsList(params, "SpotFleetRequestId", spotFleetRequestIds)
+	params["TerminateInstances"] = strconv.FormatBool(terminateInstances)
+	resp = &CancelSpotFleetRequestsResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// addSpotLaunchSpecParams adds the parameters of spec with the given
+// prefix. The security group and block device parameters are named
+// differently for Spot Fleet requests.
+func addSpotLaunchSpecParams(params map[string]string, prefix string, spec *SpotLaunchSpecification, fleet bool) {
+	params[prefix+"ImageId"] = spec.ImageId
+	params[prefix+"InstanceType"] = spec.InstanceType
+	if spec.KeyName != "" {
+		params[prefix+"KeyName"] = spec.KeyName
+	}
+	for i, g := range spec.SecurityGroups {
+		n := strconv.Itoa(i + 1)
+		switch {
+		case fleet:
+			params[prefix+"SecurityGroups."+n+".GroupId"] = g.Id
+		case g.Id != "":
+			params[prefix+"SecurityGroupId."+n] = g.Id
+		default:
+			params[prefix+"SecurityGroup."+n] = g.Name
+		}
+	}
+	if spec.UserData != nil {
+		params[prefix+"UserData"] = b64.EncodeToString(spec.UserData)
+	}
+	if spec.AvailabilityZone != "" {
+		params[prefix+"Placement.AvailabilityZone"] = spec.AvailabilityZone
+	}
+	if spec.SubnetId != "" {
+		params[prefix+"SubnetId"] = spec.SubnetId
+	}
+	if spec.IamInstanceProfile.ARN != "" {
+		params[prefix+"IamInstanceProfile.Arn"] = spec.IamInstanceProfile.ARN
+	}
+	if spec.IamInstanceProfile.Name != "" {
+		params[prefix+"IamInstanceProfile.Name"] = spec.IamInstanceProfile.Name
+	}
+	if spec.EbsOptimized {
+		params[prefix+"EbsOptimized"] = "true"
+	}
+	if spec.Monitoring {
+		params[prefix+"Monitoring.Enabled"] = "true"
+	}
+	if fleet {
+		if spec.WeightedCapacity != 0 {
+			params[prefix+"WeightedCapacity"] = strconv.FormatFloat(spec.WeightedCapacity, 'f', -1, 64)
+		}
+		if spec.SpotPrice != "" {
+			params[prefix+"SpotPrice"] = spec.SpotPrice
+		}
+	}
+
+	bdm := prefix + "BlockDeviceMapping."
+	if fleet {
+		bdm = prefix + "BlockDeviceMappings."
+	}
+	for i, d := range spec.BlockDeviceMappings {
+		p := bdm + strconv.Itoa(i+1) + "."
+		if d.DeviceName != "" {
+			params[p+"DeviceName"] = d.DeviceName
+		}
+		if d.VirtualName != "" {
+			params[p+"VirtualName"] = d.VirtualName
+		}
+		if d.SnapshotId != "" {
+			params[p+"Ebs.SnapshotId"] = d.SnapshotId
+		}
+		if d.VolumeType != "" {
+			params[p+"Ebs.VolumeType"] = d.VolumeType
+		}
+		if d.VolumeSize != 0 {
+			params[p+"Ebs.VolumeSize"] = strconv.FormatInt(d.VolumeSize, 10)
+		}
+		if d.DeleteOnTermination {
+			params[p+"Ebs.DeleteOnTermination"] = "true"
+		}
+		if d.IOPS != 0 {
+			params[p+"Ebs.Iops"] = strconv.FormatInt(d.IOPS, 10)
+		}
+	}
+}
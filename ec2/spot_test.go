@@ -0,0 +1,179 @@
+package ec2_test
+
+import (
+	"time"
+
+	"github.com/zackbloom/goamz/ec2"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestRequestSpotInstances(c *check.C) {
+	testServer.Response(200, nil, RequestSpotInstancesExample)
+
+	options := ec2.RequestSpotInstancesOptions{
+		SpotPrice:     "0.5",
+		InstanceCount: 2,
+		ValidUntil:    time.Date(2014, 5, 1, 0, 0, 0, 0, time.UTC),
+		LaunchSpecification: ec2.SpotLaunchSpecification{
+			ImageId:        "ami-1a2b3c4d",
+			InstanceType:   "m1.small",
+			KeyName:        "my-key-pair",
+			SecurityGroups: []ec2.SecurityGroup{{Id: "sg-1a2b3c4d"}, {Name: "websrv"}},
+			UserData:       []byte("1234"),
+			BlockDeviceMappings: []ec2.BlockDeviceMapping{
+				{DeviceName: "/dev/sdb", VolumeSize: 20, DeleteOnTermination: true},
+			},
+		},
+	}
+	resp, err := s.ec2.RequestSpotInstances(&options)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"RequestSpotInstances"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2014-02-01"})
+	c.Assert(req.Form["SpotPrice"], check.DeepEquals, []string{"0.5"})
+	c.Assert(req.Form["InstanceCount"], check.DeepEquals, []string{"2"})
+	c.Assert(req.Form["ValidUntil"], check.DeepEquals, []string{"2014-05-01T00:00:00Z"})
+	c.Assert(req.Form["Type"], check.IsNil)
+	c.Assert(req.Form["LaunchSpecification.ImageId"], check.DeepEquals, []string{"ami-1a2b3c4d"})
+	c.Assert(req.Form["LaunchSpecification.InstanceType"], check.DeepEquals, []string{"m1.small"})
+	c.Assert(req.Form["LaunchSpecification.KeyName"], check.DeepEquals, []string{"my-key-pair"})
+	c.Assert(req.Form["LaunchSpecification.SecurityGroupId.1"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["LaunchSpecification.SecurityGroup.2"], check.DeepEquals, []string{"websrv"})
+	c.Assert(req.Form["LaunchSpecification.UserData"], check.DeepEquals, []string{"MTIzNA=="})
+	c.Assert(req.Form["LaunchSpecification.BlockDeviceMapping.1.DeviceName"], check.DeepEquals, []string{"/dev/sdb"})
+	c.Assert(req.Form["LaunchSpecification.BlockDeviceMapping.1.Ebs.VolumeSize"], check.DeepEquals, []string{"20"})
+	c.Assert(req.Form["LaunchSpecification.BlockDeviceMapping.1.Ebs.DeleteOnTermination"], check.DeepEquals, []string{"true"})
+	c.Assert(req.Form["ClientToken"], check.HasLen, 1)
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.SpotInstanceRequests, check.HasLen, 1)
+	r0 := resp.SpotInstanceRequests[0]
+	c.Assert(r0.SpotInstanceRequestId, check.Equals, "sir-1a2b3c4d")
+	c.Assert(r0.State, check.Equals, "open")
+	c.Assert(r0.StatusCode, check.Equals, "pending-evaluation")
+	c.Assert(r0.ImageId, check.Equals, "ami-1a2b3c4d")
+	c.Assert(r0.ProductDescription, check.Equals, "Linux/UNIX")
+}
+
+func (s *S) TestDescribeSpotPriceHistory(c *check.C) {
+	testServer.Response(200, nil, DescribeSpotPriceHistoryExample)
+
+	options := ec2.DescribeSpotPriceHistoryOptions{
+		StartTime:           time.Date(2014, 1, 5, 0, 0, 0, 0, time.UTC),
+		InstanceTypes:       []string{"m3.medium"},
+		ProductDescriptions: []string{"Linux/UNIX"},
+		MaxResults:          2,
+	}
+	filter := ec2.NewFilter()
+	filter.Add("availability-zone", "us-west-2a", "us-west-2c")
+	resp, err := s.ec2.DescribeSpotPriceHistory(&options, filter)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeSpotPriceHistory"})
+	c.Assert(req.Form["StartTime"], check.DeepEquals, []string{"2014-01-05T00:00:00Z"})
+	c.Assert(req.Form["EndTime"], check.IsNil)
+	c.Assert(req.Form["InstanceType.1"], check.DeepEquals, []string{"m3.medium"})
+	c.Assert(req.Form["ProductDescription.1"], check.DeepEquals, []string{"Linux/UNIX"})
+	c.Assert(req.Form["MaxResults"], check.DeepEquals, []string{"2"})
+	c.Assert(req.Form["Filter.1.Name"], check.DeepEquals, []string{"availability-zone"})
+	c.Assert(req.Form["Filter.1.Value.2"], check.DeepEquals, []string{"us-west-2c"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.NextToken, check.Equals, "d0c3a5b9-ab68-4ec1-a5b3-EXAMPLE")
+	c.Assert(resp.SpotPriceHistory, check.HasLen, 2)
+	c.Assert(resp.SpotPriceHistory[1], check.DeepEquals, ec2.SpotPrice{
+		InstanceType:       "m3.medium",
+		ProductDescription: "Linux/UNIX",
+		SpotPrice:          "0.0287",
+		Timestamp:          "2014-01-05T11:28:26.000Z",
+		AvailabilityZone:   "us-west-2c",
+	})
+}
+
+func (s *S) TestRequestSpotFleet(c *check.C) {
+	testServer.Response(200, nil, RequestSpotFleetExample)
+
+	config := ec2.SpotFleetRequestConfig{
+		IamFleetRole:       "arn:aws:iam::123456789011:role/spot-fleet-role",
+		TargetCapacity:     20,
+		SpotPrice:          "0.04",
+		AllocationStrategy: "diversified",
+		LaunchSpecifications: []ec2.SpotLaunchSpecification{
+			{
+				ImageId:          "ami-1a2b3c4d",
+				InstanceType:     "c3.large",
+				SecurityGroups:   []ec2.SecurityGroup{{Id: "sg-1a2b3c4d"}},
+				SubnetId:         "subnet-1a2b3c4d",
+				WeightedCapacity: 1,
+			},
+			{
+				ImageId:          "ami-1a2b3c4d",
+				InstanceType:     "c3.xlarge",
+				SubnetId:         "subnet-1a2b3c4d",
+				WeightedCapacity: 2.5,
+				SpotPrice:        "0.09",
+				BlockDeviceMappings: []ec2.BlockDeviceMapping{
+					{DeviceName: "/dev/sdb", VolumeSize: 100, VolumeType: "gp2"},
+				},
+			},
+		},
+	}
+	resp, err := s.ec2.RequestSpotFleet(&config)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"RequestSpotFleet"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["SpotFleetRequestConfig.IamFleetRole"], check.DeepEquals, []string{"arn:aws:iam::123456789011:role/spot-fleet-role"})
+	c.Assert(req.Form["SpotFleetRequestConfig.TargetCapacity"], check.DeepEquals, []string{"20"})
+	c.Assert(req.Form["SpotFleetRequestConfig.SpotPrice"], check.DeepEquals, []string{"0.04"})
+	c.Assert(req.Form["SpotFleetRequestConfig.AllocationStrategy"], check.DeepEquals, []string{"diversified"})
+	c.Assert(req.Form["SpotFleetRequestConfig.ClientToken"], check.HasLen, 1)
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.1.InstanceType"], check.DeepEquals, []string{"c3.large"})
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.1.SecurityGroups.1.GroupId"], check.DeepEquals, []string{"sg-1a2b3c4d"})
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.1.WeightedCapacity"], check.DeepEquals, []string{"1"})
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.1.SpotPrice"], check.IsNil)
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.2.WeightedCapacity"], check.DeepEquals, []string{"2.5"})
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.2.SpotPrice"], check.DeepEquals, []string{"0.09"})
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.2.BlockDeviceMappings.1.DeviceName"], check.DeepEquals, []string{"/dev/sdb"})
+	c.Assert(req.Form["SpotFleetRequestConfig.LaunchSpecifications.2.BlockDeviceMappings.1.Ebs.VolumeType"], check.DeepEquals, []string{"gp2"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.SpotFleetRequestId, check.Equals, "sfr-123f8fc2-cb31-425e-abcd-example2710")
+}
+
+func (s *S) TestModifySpotFleetRequest(c *check.C) {
+	testServer.Response(200, nil, ModifySpotFleetRequestExample)
+
+	resp, err := s.ec2.ModifySpotFleetRequest("sfr-123f8fc2-cb31-425e-abcd-example2710", 10, "noTermination")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"ModifySpotFleetRequest"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["SpotFleetRequestId"], check.DeepEquals, []string{"sfr-123f8fc2-cb31-425e-abcd-example2710"})
+	c.Assert(req.Form["TargetCapacity"], check.DeepEquals, []string{"10"})
+	c.Assert(req.Form["ExcessCapacityTerminationPolicy"], check.DeepEquals, []string{"noTermination"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Return, check.Equals, true)
+}
+
+func (s *S) TestCancelSpotFleetRequests(c *check.C) {
+	testServer.Response(200, nil, CancelSpotFleetRequestsExample)
+
+	ids := []string{"sfr-123f8fc2-cb31-425e-abcd-example2710", "sfr-0e3b2f17-4b2f-4ae2-bd4b-example"}
+	resp, err := s.ec2.CancelSpotFleetRequests(ids, true)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CancelSpotFleetRequests"})
+	c.Assert(req.Form["SpotFleetRequestId.1"], check.DeepEquals, []string{ids[0]})
+	c.Assert(req.Form["SpotFleetRequestId.2"], check.DeepEquals, []string{ids[1]})
+	c.Assert(req.Form["TerminateInstances"], check.DeepEquals, []string{"true"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Successful, check.DeepEquals, []ec2.CancelledSpotFleetRequest{
+		{SpotFleetRequestId: ids[0], CurrentState: "cancelled_terminating", PreviousState: "active"},
+	})
+	c.Assert(resp.Unsuccessful, check.DeepEquals, []ec2.UncancelledSpotFleetRequest{
+		{SpotFleetRequestId: ids[1], Code: "fleetRequestIdDoesNotExist", Message: "The spot fleet request Id does not exist"},
+	})
+}
@@ -0,0 +1,557 @@
+package ec2
+
+// ----------------------------------------------------------------------------
+// VPC management functions and types. VPCs, subnets and internet gateways
+// are described by DescribeVpcs, Subnets and DescribeInternetGateways.
+//
+// See http://docs.aws.amazon.com/AmazonVPC/latest/UserGuide/VPC_Introduction.html for more details.
+
+// Response to a CreateVpc request.
+type CreateVpcResp struct {
+	RequestId string    `xml:"requestId"`
+	Vpc       VpcStruct `xml:"vpc"`
+}
+
+// CreateVpc creates a VPC with the given IPv4 CIDR block, such as
+// "10.0.0.0/16". instanceTenancy is "default" or "dedicated"; "" uses
+// "default".
+func (ec2 *EC2) CreateVpc(cidrBlock, instanceTenancy string) (resp *CreateVpcResp, err error) {
+	params := makeParams("CreateVpc")
+	params["Version"] = recentVersion
+	params["CidrBlock"] = cidrBlock
+	if instanceTenancy != "" {
+		params["InstanceTenancy"] = instanceTenancy
+	}
+	resp = &CreateVpcResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DeleteVpc deletes a VPC. Its subnets, gateways and non-main route tables
+// must be deleted or detached first.
+func (ec2 *EC2) DeleteVpc(vpcId string) (resp *SimpleResp, err error) {
+	params := makeParams("DeleteVpc")
+	params["Version"] = recentVersion
+	params["VpcId"] = vpcId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a CreateSubnet request.
+type CreateSubnetResp struct {
+	RequestId string `xml:"requestId"`
+	Subnet    Subnet `xml:"subnet"`
+}
+
+// CreateSubnet creates a subnet in a VPC. An empty availabilityZone lets
+// EC2 pick one.
+func (ec2 *EC2) CreateSubnet(vpcId, cidrBlock, availabilityZone string) (resp *CreateSubnetResp, err error) {
+	params := makeParams("CreateSubnet")
+	params["Version"] = recentVersion
+	params["VpcId"] = vpcId
+	params["CidrBlock"] = cidrBlock
+	if availabilityZone != "" {
+		params["AvailabilityZone"] = availabilityZone
+	}
+	resp = &CreateSubnetResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DeleteSubnet deletes a subnet. Its instances must be terminated first.
+func (ec2 *EC2) DeleteSubnet(subnetId string) (resp *SimpleResp, err error) {
+	params := makeParams("DeleteSubnet")
+	params["Version"] = recentVersion
+	params["SubnetId"] = subnetId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// ModifySubnetMapPublicIpOnLaunch sets whether the instances launched in a
+// subnet get a public IPv4 address, which makes it a public subnet along
+// with a route to an internet gateway.
+func (ec2 *EC2) ModifySubnetMapPublicIpOnLaunch(subnetId string, mapPublicIpOnLaunch bool) (resp *SimpleResp, err error) {
+	params := makeParams("ModifySubnetAttribute")
+	params["Version"] = recentVersion
+	params["SubnetId"] = subnetId
+	if mapPublicIpOnLaunch {
+		params["MapPublicIpOnLaunch.Value"] = "true"
+	} else {
+		params["MapPublicIpOnLaunch.Value"] = "false"
+	}
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Route is a route of a route table. It has one target: GatewayId (an
+// internet or virtual private gateway, or "local"), InstanceId,
+// NatGatewayId, NetworkInterfaceId or VpcPeeringConnectionId. State is
+// "active" or "blackhole", when the target is gone.
+type Route struct {
+	DestinationCidrBlock   string `xml:"destinationCidrBlock"`
+	GatewayId              string `xml:"gatewayId"`
+	InstanceId             string `xml:"instanceId"`
+	NatGatewayId           string `xml:"natGatewayId"`
+	NetworkInterfaceId     string `xml:"networkInterfaceId"`
+	VpcPeeringConnectionId string `xml:"vpcPeeringConnectionId"`
+	State                  string `xml:"state"`
+	Origin                 string `xml:"origin"`
+}
+
+// RouteTableAssociation associates a route table with a subnet, or marks
+// the main route table of a VPC, which is used by the subnets that are not
+// associated with another one.
+type RouteTableAssociation struct {
+	RouteTableAssociationId string `xml:"routeTableAssociationId"`
+	RouteTableId            string `xml:"routeTableId"`
+	SubnetId                string `xml:"subnetId"`
+	Main                    bool   `xml:"main"`
+}
+
+// RouteTable describes a route table of a VPC.
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_RouteTable.html for more details.
+type RouteTable struct {
+	RouteTableId string                  `xml:"routeTableId"`
+	VpcId        string                  `xml:"vpcId"`
+	Routes       []Route                 `xml:"routeSet>item"`
+	Associations []RouteTableAssociation `xml:"associationSet>item"`
+	Tags         []Tag                   `xml:"tagSet>item"`
+}
+
+// Response to a CreateRouteTable request.
+type CreateRouteTableResp struct {
+	RequestId  string     `xml:"requestId"`
+	RouteTable RouteTable `xml:"routeTable"`
+}
+
+// CreateRouteTable creates a route table in a VPC, with only the local
+// route.
+func (ec2 *EC2) CreateRouteTable(vpcId string) (resp *CreateRouteTableResp, err error) {
+	params := makeParams("CreateRouteTable")
+	params["Version"] = recentVersion
+	params["VpcId"] = vpcId
+	resp = &CreateRouteTableResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DeleteRouteTable deletes a route table, which must not be associated
+// with a subnet.
+func (ec2 *EC2) DeleteRouteTable(routeTableId string) (resp *SimpleResp, err error) {
+	params := makeParams("DeleteRouteTable")
+	params["Version"] = recentVersion
+	params["RouteTableId"] = routeTableId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a DescribeRouteTables request.
+type DescribeRouteTablesResp struct {
+	RequestId   string       `xml:"requestId"`
+	RouteTables []RouteTable `xml:"routeTableSet>item"`
+}
+
+// DescribeRouteTables describes route tables. Both parameters are
+// optional, and if provided will limit the route tables returned to those
+// matching the given ids or filtering rules, such as "vpc-id" or
+// "association.subnet-id".
+func (ec2 *EC2) DescribeRouteTables(routeTableIds []string, filter *Filter) (resp *DescribeRouteTablesResp, err error) {
+	params := makeParams("DescribeRouteTables")
+	params["Version"] = recentVersion
+	addParamsList(params, "RouteTableId", routeTableIds)
+	filter.addParams(params)
+	resp = &DescribeRouteTablesResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to an AssociateRouteTable request.
+type AssociateRouteTableResp struct {
+	RequestId     string `xml:"requestId"`
+	AssociationId string `xml:"associationId"`
+}
+
+// AssociateRouteTable associates a route table with a subnet. The
+// association id is needed to disassociate them.
+func (ec2 *EC2) AssociateRouteTable(routeTableId, subnetId string) (resp *AssociateRouteTableResp, err error) {
+	params := makeParams("AssociateRouteTable")
+	params["Version"] = recentVersion
+	params["RouteTableId"] = routeTableId
+	params["SubnetId"] = subnetId
+	resp = &AssociateRouteTableResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DisassociateRouteTable removes the association of a route table with a
+// subnet, which then uses the main route table of its VPC.
+func (ec2 *EC2) DisassociateRouteTable(associationId string) (resp *SimpleResp, err error) {
+	params := makeParams("DisassociateRouteTable")
+	params["Version"] = recentVersion
+	params["AssociationId"] = associationId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// The CreateRouteOptions type encapsulates options for the CreateRoute
+// request in EC2. Exactly one target must be set.
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateRoute.html for more details.
+type CreateRouteOptions struct {
+	RouteTableId           string
+	DestinationCidrBlock   string
+	GatewayId              string
+	InstanceId             string
+	NatGatewayId           string
+	NetworkInterfaceId     string
+	VpcPeeringConnectionId string
+}
+
+// CreateRoute adds a route to a route table.
+func (ec2 *EC2) CreateRoute(options *CreateRouteOptions) (resp *SimpleResp, err error) {
+	params := makeParams("CreateRoute")
+	params["Version"] = recentVersion
+	params["RouteTableId"] = options.RouteTableId
+	params["DestinationCidrBlock"] = options.DestinationCidrBlock
+	if options.GatewayId != "" {
+		params["GatewayId"] = options.GatewayId
+	}
+	if options.InstanceId != "" {
+		params["InstanceId"] = options.InstanceId
+	}
+	if options.NatGatewayId != "" {
+		params["NatGatewayId"] = options.NatGatewayId
+	}
+	if options.NetworkInterfaceId != "" {
+		params["NetworkInterfaceId"] = options.NetworkInterfaceId
+	}
+	if options.VpcPeeringConnectionId != "" {
+		params["VpcPeeringConnectionId"] = options.VpcPeeringConnectionId
+	}
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DeleteRoute deletes the route of a route table to the given destination.
+func (ec2 *EC2) DeleteRoute(routeTableId, destinationCidrBlock string) (resp *SimpleResp, err error) {
+	params := makeParams("DeleteRoute")
+	params["Version"] = recentVersion
+	params["RouteTableId"] = routeTableId
+	params["DestinationCidrBlock"] = destinationCidrBlock
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a CreateInternetGateway request.
+type CreateInternetGatewayResp struct {
+	RequestId       string                `xml:"requestId"`
+	InternetGateway InternetGatewayStruct `xml:"internetGateway"`
+}
+
+// CreateInternetGateway creates an internet gateway, to be attached to a
+// VPC with AttachInternetGateway.
+func (ec2 *EC2) CreateInternetGateway() (resp *CreateInternetGatewayResp, err error) {
+	params := makeParams("CreateInternetGateway")
+	params["Version"] = recentVersion
+	resp = &CreateInternetGatewayResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DeleteInternetGateway deletes an internet gateway, which must be
+// detached first.
+func (ec2 *EC2) DeleteInternetGateway(internetGatewayId string) (resp *SimpleResp, err error) {
+	params := makeParams("DeleteInternetGateway")
+	params["Version"] = recentVersion
+	params["InternetGatewayId"] = internetGatewayId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// AttachInternetGateway attaches an internet gateway to a VPC.
+func (ec2 *EC2) AttachInternetGateway(internetGatewayId, vpcId string) (resp *SimpleResp, err error) {
+	return ec2.attachInternetGateway("AttachInternetGateway", internetGatewayId, vpcId)
+}
+
+// DetachInternetGateway detaches an internet gateway from a VPC.
+func (ec2 *EC2) DetachInternetGateway(internetGatewayId, vpcId string) (resp *SimpleResp, err error) {
+	return ec2.attachInternetGateway("DetachInternetGateway", internetGatewayId, vpcId)
+}
+
+func (ec2 *EC2) attachInternetGateway(action, internetGatewayId, vpcId string) (resp *SimpleResp, err error) {
+	params := makeParams(action)
+	params["Version"] = recentVersion
+	params["InternetGatewayId"] = internetGatewayId
+	params["VpcId"] = vpcId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// NatGatewayAddress is an address of a NAT gateway.
+type NatGatewayAddress struct {
+	AllocationId       string `xml:"allocationId"`
+	NetworkInterfaceId string `xml:"networkInterfaceId"`
+	PrivateIp          string `xml:"privateIp"`
+	PublicIp           string `xml:"publicIp"`
+}
+
+// NatGateway describes a NAT gateway. State is one of "pending", "failed",
+// "available", "deleting" or "deleted"; FailureCode and FailureMessage
+// explain the "failed" state.
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_NatGateway.html for more details.
+type NatGateway struct {
+	NatGatewayId   string              `xml:"natGatewayId"`
+	SubnetId       string              `xml:"subnetId"`
+	VpcId          string              `xml:"vpcId"`
+	State          string              `xml:"state"`
+	FailureCode    string              `xml:"failureCode"`
+	FailureMessage string              `xml:"failureMessage"`
+	CreateTime     string              `xml:"createTime"`
+	DeleteTime     string              `xml:"deleteTime"`
+	Addresses      []NatGatewayAddress `xml:"natGatewayAddressSet>item"`
+	Tags           []Tag               `xml:"tagSet>item"`
+}
+
+// Response to a CreateNatGateway request.
+type CreateNatGatewayResp struct {
+	RequestId  string     `xml:"requestId"`
+	NatGateway NatGateway `xml:"natGateway"`
+}
+
+// CreateNatGateway creates a NAT gateway in a public subnet, with the
+// Elastic IP address of the given allocation id. Instances of private
+// subnets reach the internet through it once their route table has a
+// route to it.
+func (ec2 *EC2) CreateNatGateway(subnetId, allocationId string) (resp *CreateNatGatewayResp, err error) {
+	params := makeParams("CreateNatGateway")
+	params["Version"] = recentVersion
+	params["SubnetId"] = subnetId
+	params["AllocationId"] = allocationId
+	token, err := clientToken()
+	if err != nil {
+		return nil, err
+	}
+	params["ClientToken"] = token
+	resp = &CreateNatGatewayResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a DeleteNatGateway request.
+type DeleteNatGatewayResp struct {
+	RequestId    string `xml:"requestId"`
+	NatGatewayId string `xml:"natGatewayId"`
+}
+
+// DeleteNatGateway deletes a NAT gateway. Its Elastic IP address is
+// released from it, but not from the account.
+func (ec2 *EC2) DeleteNatGateway(natGatewayId string) (resp *DeleteNatGatewayResp, err error) {
+	params := makeParams("DeleteNatGateway")
+	params["Version"] = recentVersion
+	params["NatGatewayId"] = natGatewayId
+	resp = &DeleteNatGatewayResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a DescribeNatGateways request. NextToken is set when there
+// are more NAT gateways to get.
+type DescribeNatGatewaysResp struct {
+	RequestId   string       `xml:"requestId"`
+	NatGateways []NatGateway `xml:"natGatewaySet>item"`
+	NextToken   string       `xml:"nextToken"`
+}
+
+// DescribeNatGateways describes a page of NAT gateways. All parameters are
+// optional; filter may use names such as "vpc-id", "subnet-id" or "state".
+func (ec2 *EC2) DescribeNatGateways(natGatewayIds []string, filter *Filter, nextToken string) (resp *DescribeNatGatewaysResp, err error) {
+	params := makeParams("DescribeNatGateways")
+	params["Version"] = recentVersion
+	addParamsList(params, "NatGatewayId", natGatewayIds)
+	filter.addParams(params)
+	if nextToken != "" {
+		params["NextToken"] = nextToken
+	}
+	resp = &DescribeNatGatewaysResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// VpcPeeringConnectionVpcInfo describes one side of a VPC peering
+// connection.
+type VpcPeeringConnectionVpcInfo struct {
+	VpcId     string `xml:"vpcId"`
+	OwnerId   string `xml:"ownerId"`
+	CidrBlock string `xml:"cidrBlock"`
+	Region    string `xml:"region"`
+}
+
+// VpcPeeringConnection describes a VPC peering connection. StatusCode is
+// one of "initiating-request", "pending-acceptance", "active", "deleted",
+// "rejected", "failed", "expired", "provisioning" or "deleting".
+//
+// See http://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_VpcPeeringConnection.html for more details.
+type VpcPeeringConnection struct {
+	VpcPeeringConnectionId string                      `xml:"vpcPeeringConnectionId"`
+	RequesterVpc           VpcPeeringConnectionVpcInfo `xml:"requesterVpcInfo"`
+	AccepterVpc            VpcPeeringConnectionVpcInfo `xml:"accepterVpcInfo"`
+	StatusCode             string                      `xml:"status>code"`
+	StatusMessage          string                      `xml:"status>message"`
+	ExpirationTime         string                      `xml:"expirationTime"`
+	Tags                   []Tag                       `xml:"tagSet>item"`
+}
+
+// Response to a CreateVpcPeeringConnection or AcceptVpcPeeringConnection
+// request.
+type VpcPeeringConnectionResp struct {
+	RequestId            string               `xml:"requestId"`
+	VpcPeeringConnection VpcPeeringConnection `xml:"vpcPeeringConnection"`
+}
+
+// CreateVpcPeeringConnection requests a peering connection between vpcId
+// and peerVpcId, which the owner of peerVpcId must accept. An empty
+// peerOwnerId is the account of the request.
+func (ec2 *EC2) CreateVpcPeeringConnection(vpcId, peerVpcId, peerOwnerId string) (resp *VpcPeeringConnectionResp, err error) {
+	params := makeParams("CreateVpcPeeringConnection")
+	params["Version"] = recentVersion
+	params["VpcId"] = vpcId
+	params["PeerVpcId"] = peerVpcId
+	if peerOwnerId != "" {
+		params["PeerOwnerId"] = peerOwnerId
+	}
+	resp = &VpcPeeringConnectionResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// AcceptVpcPeeringConnection accepts a VPC peering connection request made
+// to a VPC of the account.
+func (ec2 *EC2) AcceptVpcPeeringConnection(vpcPeeringConnectionId string) (resp *VpcPeeringConnectionResp, err error) {
+	params := makeParams("AcceptVpcPeeringConnection")
+	params["Version"] = recentVersion
+	params["VpcPeeringConnectionId"] = vpcPeeringConnectionId
+	resp = &VpcPeeringConnectionResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// RejectVpcPeeringConnection rejects a VPC peering connection request made
+// to a VPC of the account.
+func (ec2 *EC2) RejectVpcPeeringConnection(vpcPeeringConnectionId string) (resp *SimpleResp, err error) {
+	params := makeParams("RejectVpcPeeringConnection")
+	params["Version"] = recentVersion
+	params["VpcPeeringConnectionId"] = vpcPeeringConnectionId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// DeleteVpcPeeringConnection deletes a VPC peering connection, or cancels
+// a request that was not accepted yet.
+func (ec2 *EC2) DeleteVpcPeeringConnection(vpcPeeringConnectionId string) (resp *SimpleResp, err error) {
+	params := makeParams("DeleteVpcPeeringConnection")
+	params["Version"] = recentVersion
+	params["VpcPeeringConnectionId"] = vpcPeeringConnectionId
+	resp = &SimpleResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
+
+// Response to a DescribeVpcPeeringConnections request.
+type DescribeVpcPeeringConnectionsResp struct {
+	RequestId             string                 `xml:"requestId"`
+	VpcPeeringConnections []VpcPeeringConnection `xml:"vpcPeeringConnectionSet>item"`
+}
+
+// DescribeVpcPeeringConnections describes VPC peering connections. Both
+// parameters are optional; filter may use names such as
+// "status-code" or "requester-vpc-info.vpc-id".
+func (ec2 *EC2) DescribeVpcPeeringConnections(vpcPeeringConnectionIds []string, filter *Filter) (resp *DescribeVpcPeeringConnectionsResp, err error) {
+	params := makeParams("DescribeVpcPeeringConnections")
+	params["Version"] = recentVersion
+	addParamsList(params, "VpcPeeringConnectionId", vpcPeeringConnectionIds)
+	filter.addParams(params)
+	resp = &DescribeVpcPeeringConnectionsResp{}
+	err = ec2.query(params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return
+}
@@ -0,0 +1,223 @@
+package ec2_test
+
+import (
+	"github.com/zackbloom/goamz/ec2"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCreateVpc(c *check.C) {
+	testServer.Response(200, nil, CreateVpcExample)
+
+	resp, err := s.ec2.CreateVpc("10.0.0.0/16", "")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateVpc"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["CidrBlock"], check.DeepEquals, []string{"10.0.0.0/16"})
+	c.Assert(req.Form["InstanceTenancy"], check.IsNil)
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Vpc, check.DeepEquals, ec2.VpcStruct{
+		VpcId:           "vpc-1a2b3c4d",
+		State:           "pending",
+		CidrBlock:       "10.0.0.0/16",
+		DhcpOptionsId:   "dopt-1a2b3c4d2",
+		InstanceTenancy: "default",
+	})
+}
+
+func (s *S) TestDeleteVpc(c *check.C) {
+	testServer.Response(200, nil, DeleteVpcExample)
+
+	resp, err := s.ec2.DeleteVpc("vpc-1a2b3c4d")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DeleteVpc"})
+	c.Assert(req.Form["VpcId"], check.DeepEquals, []string{"vpc-1a2b3c4d"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE")
+}
+
+func (s *S) TestCreateSubnet(c *check.C) {
+	testServer.Response(200, nil, CreateSubnetExample)
+
+	resp, err := s.ec2.CreateSubnet("vpc-1a2b3c4d", "10.0.1.0/24", "us-east-1a")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateSubnet"})
+	c.Assert(req.Form["VpcId"], check.DeepEquals, []string{"vpc-1a2b3c4d"})
+	c.Assert(req.Form["CidrBlock"], check.DeepEquals, []string{"10.0.1.0/24"})
+	c.Assert(req.Form["AvailabilityZone"], check.DeepEquals, []string{"us-east-1a"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Subnet.Id, check.Equals, "subnet-9d4a7b6c")
+	c.Assert(resp.Subnet.AvailableIpAddressCount, check.Equals, 251)
+}
+
+func (s *S) TestModifySubnetMapPublicIpOnLaunch(c *check.C) {
+	testServer.Response(200, nil, DeleteVpcExample)
+
+	_, err := s.ec2.ModifySubnetMapPublicIpOnLaunch("subnet-9d4a7b6c", true)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"ModifySubnetAttribute"})
+	c.Assert(req.Form["SubnetId"], check.DeepEquals, []string{"subnet-9d4a7b6c"})
+	c.Assert(req.Form["MapPublicIpOnLaunch.Value"], check.DeepEquals, []string{"true"})
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestDescribeRouteTables(c *check.C) {
+	testServer.Response(200, nil, DescribeRouteTablesExample)
+
+	filter := ec2.NewFilter()
+	filter.Add("vpc-id", "vpc-11ad4878")
+	resp, err := s.ec2.DescribeRouteTables([]string{"rtb-f9ad4890"}, filter)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeRouteTables"})
+	c.Assert(req.Form["RouteTableId.1"], check.DeepEquals, []string{"rtb-f9ad4890"})
+	c.Assert(req.Form["Filter.1.Name"], check.DeepEquals, []string{"vpc-id"})
+	c.Assert(req.Form["Filter.1.Value.1"], check.DeepEquals, []string{"vpc-11ad4878"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RouteTables, check.HasLen, 1)
+	rt := resp.RouteTables[0]
+	c.Assert(rt.RouteTableId, check.Equals, "rtb-f9ad4890")
+	c.Assert(rt.VpcId, check.Equals, "vpc-11ad4878")
+	c.Assert(rt.Routes, check.DeepEquals, []ec2.Route{
+		{DestinationCidrBlock: "10.0.0.0/22", GatewayId: "local", State: "active", Origin: "CreateRouteTable"},
+		{DestinationCidrBlock: "0.0.0.0/0", NatGatewayId: "nat-08d48af2a8e83edfd", State: "active", Origin: "CreateRoute"},
+	})
+	c.Assert(rt.Associations, check.DeepEquals, []ec2.RouteTableAssociation{
+		{RouteTableAssociationId: "rtbassoc-faad4893", RouteTableId: "rtb-f9ad4890", SubnetId: "subnet-15ad487c"},
+	})
+}
+
+func (s *S) TestAssociateRouteTable(c *check.C) {
+	testServer.Response(200, nil, AssociateRouteTableExample)
+
+	resp, err := s.ec2.AssociateRouteTable("rtb-e4ad488d", "subnet-15ad487c")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"AssociateRouteTable"})
+	c.Assert(req.Form["RouteTableId"], check.DeepEquals, []string{"rtb-e4ad488d"})
+	c.Assert(req.Form["SubnetId"], check.DeepEquals, []string{"subnet-15ad487c"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.AssociationId, check.Equals, "rtbassoc-f8ad4891")
+}
+
+func (s *S) TestCreateRoute(c *check.C) {
+	testServer.Response(200, nil, DeleteVpcExample)
+
+	_, err := s.ec2.CreateRoute(&ec2.CreateRouteOptions{
+		RouteTableId:         "rtb-e4ad488d",
+		DestinationCidrBlock: "0.0.0.0/0",
+		NatGatewayId:         "nat-04e77a5e9c34432f9",
+	})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateRoute"})
+	c.Assert(req.Form["RouteTableId"], check.DeepEquals, []string{"rtb-e4ad488d"})
+	c.Assert(req.Form["DestinationCidrBlock"], check.DeepEquals, []string{"0.0.0.0/0"})
+	c.Assert(req.Form["NatGatewayId"], check.DeepEquals, []string{"nat-04e77a5e9c34432f9"})
+	c.Assert(req.Form["GatewayId"], check.IsNil)
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestCreateAndAttachInternetGateway(c *check.C) {
+	testServer.Response(200, nil, CreateInternetGatewayExample)
+	testServer.Response(200, nil, AttachInternetGatewayExample)
+
+	resp, err := s.ec2.CreateInternetGateway()
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.InternetGateway.InternetGatewayId, check.Equals, "igw-eaad4883")
+
+	_, err = s.ec2.AttachInternetGateway(resp.InternetGateway.InternetGatewayId, "vpc-11ad4878")
+	c.Assert(err, check.IsNil)
+
+	reqs := testServer.WaitRequests(2)
+	c.Assert(reqs[0].Form["Action"], check.DeepEquals, []string{"CreateInternetGateway"})
+	c.Assert(reqs[1].Form["Action"], check.DeepEquals, []string{"AttachInternetGateway"})
+	c.Assert(reqs[1].Form["InternetGatewayId"], check.DeepEquals, []string{"igw-eaad4883"})
+	c.Assert(reqs[1].Form["VpcId"], check.DeepEquals, []string{"vpc-11ad4878"})
+}
+
+func (s *S) TestCreateNatGateway(c *check.C) {
+	testServer.Response(200, nil, CreateNatGatewayExample)
+
+	resp, err := s.ec2.CreateNatGateway("subnet-1a2b3c4d", "eipalloc-37fc1a52")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateNatGateway"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2016-11-15"})
+	c.Assert(req.Form["SubnetId"], check.DeepEquals, []string{"subnet-1a2b3c4d"})
+	c.Assert(req.Form["AllocationId"], check.DeepEquals, []string{"eipalloc-37fc1a52"})
+	c.Assert(req.Form["ClientToken"], check.HasLen, 1)
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.NatGateway.NatGatewayId, check.Equals, "nat-04e77a5e9c34432f9")
+	c.Assert(resp.NatGateway.State, check.Equals, "pending")
+	c.Assert(resp.NatGateway.Addresses, check.DeepEquals, []ec2.NatGatewayAddress{{AllocationId: "eipalloc-37fc1a52"}})
+}
+
+func (s *S) TestDescribeNatGateways(c *check.C) {
+	testServer.Response(200, nil, DescribeNatGatewaysExample)
+
+	filter := ec2.NewFilter()
+	filter.Add("vpc-id", "vpc-4e20d42b")
+	resp, err := s.ec2.DescribeNatGateways(nil, filter, "abc")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeNatGateways"})
+	c.Assert(req.Form["NextToken"], check.DeepEquals, []string{"abc"})
+	c.Assert(req.Form["Filter.1.Name"], check.DeepEquals, []string{"vpc-id"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.NextToken, check.Equals, "eyJ2IjoiMSIsImMiOiJFWEFNUExFIn0=")
+	c.Assert(resp.NatGateways, check.HasLen, 2)
+	c.Assert(resp.NatGateways[0].Addresses, check.DeepEquals, []ec2.NatGatewayAddress{{
+		AllocationId:       "eipalloc-37fc1a52",
+		NetworkInterfaceId: "eni-00e37850",
+		PrivateIp:          "10.0.2.147",
+		PublicIp:           "198.18.125.129",
+	}})
+	c.Assert(resp.NatGateways[1].State, check.Equals, "failed")
+	c.Assert(resp.NatGateways[1].FailureCode, check.Equals, "Gateway.NotAttached")
+}
+
+func (s *S) TestCreateVpcPeeringConnection(c *check.C) {
+	testServer.Response(200, nil, CreateVpcPeeringConnectionExample)
+
+	resp, err := s.ec2.CreateVpcPeeringConnection("vpc-1a2b3c4d", "vpc-a1b2c3d4", "123456789012")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateVpcPeeringConnection"})
+	c.Assert(req.Form["VpcId"], check.DeepEquals, []string{"vpc-1a2b3c4d"})
+	c.Assert(req.Form["PeerVpcId"], check.DeepEquals, []string{"vpc-a1b2c3d4"})
+	c.Assert(req.Form["PeerOwnerId"], check.DeepEquals, []string{"123456789012"})
+
+	c.Assert(err, check.IsNil)
+	pcx := resp.VpcPeeringConnection
+	c.Assert(pcx.VpcPeeringConnectionId, check.Equals, "pcx-73a5401a")
+	c.Assert(pcx.StatusCode, check.Equals, "initiating-request")
+	c.Assert(pcx.RequesterVpc, check.DeepEquals, ec2.VpcPeeringConnectionVpcInfo{
+		VpcId: "vpc-1a2b3c4d", OwnerId: "777788889999", CidrBlock: "10.0.0.0/28",
+	})
+	c.Assert(pcx.AccepterVpc.VpcId, check.Equals, "vpc-a1b2c3d4")
+}
+
+func (s *S) TestAcceptVpcPeeringConnection(c *check.C) {
+	testServer.Response(200, nil, AcceptVpcPeeringConnectionExample)
+
+	resp, err := s.ec2.AcceptVpcPeeringConnection("pcx-1a2b3c4d")
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"AcceptVpcPeeringConnection"})
+	c.Assert(req.Form["VpcPeeringConnectionId"], check.DeepEquals, []string{"pcx-1a2b3c4d"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.VpcPeeringConnection.StatusCode, check.Equals, "active")
+	c.Assert(resp.VpcPeeringConnection.AccepterVpc.CidrBlock, check.Equals, "10.0.1.0/28")
+}
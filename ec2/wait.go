@@ -0,0 +1,120 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
+)
+
+// The defaults of WaitOptions, as used by the AWS SDKs for their EC2
+// instance waiters.
+const (
+	DefaultWaitInterval = 15 * time.Second
+	DefaultWaitTimeout  = 10 * time.Minute
+)
+
+// WaitOptions configures how the WaitUntil methods poll EC2. Zero values
+// use DefaultWaitInterval and DefaultWaitTimeout.
+type WaitOptions = awsutil.WaitOptions
+
+var defaultWait = WaitOptions{Interval: DefaultWaitInterval, Timeout: DefaultWaitTimeout}
+
+// InstanceStateError is returned by the WaitUntil methods when an instance
+// reaches a state from which it can't get to the awaited one, such as a
+// terminated instance while waiting for it to be running.
+type InstanceStateError struct {
+	InstanceId string
+	State      string
+	Want       string
+}
+
+func (err *InstanceStateError) Error() string {
+	return fmt.Sprintf("ec2: instance %s is %s while waiting for it to be %s", err.InstanceId, err.State, err.Want)
+}
+
+// WaitUntilInstanceRunning polls EC2 until all of the given instances are
+// running. Instances that are not found yet, as happens right after
+// RunInstances, are waited for.
+//
+// The wait ends early with ctx's error when ctx is done, with
+// context.DeadlineExceeded when the timeout of opts is reached, and with an
+// *InstanceStateError when an instance is stopping or terminating.
+func (ec2 *EC2) WaitUntilInstanceRunning(ctx context.Context, opts *WaitOptions, instIds ...string) error {
+	return ec2.waitForInstanceState(ctx, opts, instIds, "running", "shutting-down", "terminated", "stopping")
+}
+
+// WaitUntilInstanceStopped polls EC2 until all of the given instances are
+// stopped. It ends early as WaitUntilInstanceRunning does, with an
+// *InstanceStateError when an instance is starting or terminated.
+func (ec2 *EC2) WaitUntilInstanceStopped(ctx context.Context, opts *WaitOptions, instIds ...string) error {
+	return ec2.waitForInstanceState(ctx, opts, instIds, "stopped", "pending", "terminated")
+}
+
+// WaitUntilInstanceTerminated polls EC2 until all of the given instances
+// are terminated or no longer found. It ends early as
+// WaitUntilInstanceRunning does, with an *InstanceStateError when an
+// instance is starting or stopping.
+func (ec2 *EC2) WaitUntilInstanceTerminated(ctx context.Context, opts *WaitOptions, instIds ...string) error {
+	return ec2.waitForInstanceState(ctx, opts, instIds, "terminated", "pending", "stopping")
+}
+
+// WaitUntilInstanceStatusOk polls EC2 until the instance and system status
+// checks of all of the given instances pass. It ends early as
+// WaitUntilInstanceRunning does, except for the state of the instances.
+func (ec2 *EC2) WaitUntilInstanceStatusOk(ctx context.Context, opts *WaitOptions, instIds ...string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := ec2.DescribeInstanceStatus(instIds, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		if len(resp.InstanceStatuses) < len(instIds) {
+			return false, nil
+		}
+		for _, st := range resp.InstanceStatuses {
+			if st.InstanceStatus.StatusName != "ok" || st.SystemStatus.StatusName != "ok" {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+func (ec2 *EC2) waitForInstanceState(ctx context.Context, opts *WaitOptions, instIds []string, want string, failStates ...string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := ec2.DescribeInstances(instIds, nil)
+		if err != nil {
+			if isNotFound(err) {
+				return want == "terminated", nil
+			}
+			return false, err
+		}
+		found, done := 0, true
+		for _, rsv := range resp.Reservations {
+			for _, inst := range rsv.Instances {
+				found++
+				state := inst.State.Name
+				for _, fail := range failStates {
+					if state == fail {
+						return false, &InstanceStateError{inst.InstanceId, state, want}
+					}
+				}
+				if state != want {
+					done = false
+				}
+			}
+		}
+		return done && found > 0, nil
+	})
+}
+
+func isNotFound(err error) bool {
+	var apiErr aws.APIError
+	return errors.As(err, &apiErr) && apiErr.Code() == "InvalidInstanceID.NotFound"
+}
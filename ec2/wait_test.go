@@ -0,0 +1,109 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/ec2"
+	"gopkg.in/check.v1"
+)
+
+var fastWait = &ec2.WaitOptions{Interval: time.Millisecond, Timeout: time.Second}
+
+// instanceStates formats DescribeInstanceStatesDump with instances
+// i-0, i-1, ... in the given states.
+func instanceStates(states ...string) string {
+	var items []string
+	for i, state := range states {
+		items = append(items, fmt.Sprintf(InstanceStateItem, fmt.Sprintf("i-%d", i), state))
+	}
+	return fmt.Sprintf(DescribeInstanceStatesDump, strings.Join(items, ""))
+}
+
+func (s *S) TestWaitUntilInstanceRunning(c *check.C) {
+	testServer.Response(400, nil, InstanceNotFoundDump)
+	testServer.Response(200, nil, instanceStates("pending", "pending"))
+	testServer.Response(200, nil, instanceStates("running", "pending"))
+	testServer.Response(200, nil, instanceStates("running", "running"))
+
+	err := s.ec2.WaitUntilInstanceRunning(context.Background(), fastWait, "i-0", "i-1")
+	c.Assert(err, check.IsNil)
+
+	for i := 0; i < 4; i++ {
+		req := testServer.WaitRequest()
+		c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeInstances"})
+		c.Assert(req.Form["InstanceId.1"], check.DeepEquals, []string{"i-0"})
+		c.Assert(req.Form["InstanceId.2"], check.DeepEquals, []string{"i-1"})
+	}
+}
+
+func (s *S) TestWaitUntilInstanceRunningFails(c *check.C) {
+	testServer.Response(200, nil, instanceStates("pending", "terminated"))
+
+	err := s.ec2.WaitUntilInstanceRunning(context.Background(), fastWait, "i-0", "i-1")
+	testServer.WaitRequest()
+	c.Assert(err, check.DeepEquals, &ec2.InstanceStateError{InstanceId: "i-1", State: "terminated", Want: "running"})
+	c.Assert(err, check.ErrorMatches, "ec2: instance i-1 is terminated while waiting for it to be running")
+}
+
+func (s *S) TestWaitUntilInstanceStopped(c *check.C) {
+	testServer.Response(200, nil, instanceStates("stopping"))
+	testServer.Response(200, nil, instanceStates("stopped"))
+
+	err := s.ec2.WaitUntilInstanceStopped(context.Background(), fastWait, "i-0")
+	c.Assert(err, check.IsNil)
+	testServer.WaitRequest()
+	testServer.WaitRequest()
+}
+
+func (s *S) TestWaitUntilInstanceTerminatedNotFound(c *check.C) {
+	testServer.Response(200, nil, instanceStates("shutting-down"))
+	testServer.Response(400, nil, InstanceNotFoundDump)
+
+	err := s.ec2.WaitUntilInstanceTerminated(context.Background(), fastWait, "i-0")
+	c.Assert(err, check.IsNil)
+	testServer.WaitRequest()
+	testServer.WaitRequest()
+}
+
+func (s *S) TestWaitUntilInstanceTimeout(c *check.C) {
+	for i := 0; i < 10; i++ {
+		testServer.Response(200, nil, instanceStates("pending"))
+	}
+
+	opts := &ec2.WaitOptions{Interval: 20 * time.Millisecond, Timeout: 50 * time.Millisecond}
+	err := s.ec2.WaitUntilInstanceRunning(context.Background(), opts, "i-0")
+	c.Assert(err, check.Equals, context.DeadlineExceeded)
+}
+
+func (s *S) TestWaitUntilInstanceCanceled(c *check.C) {
+	testServer.Response(200, nil, instanceStates("pending"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := s.ec2.WaitUntilInstanceRunning(ctx, nil, "i-0")
+	c.Assert(err, check.Equals, context.Canceled)
+	testServer.WaitRequest()
+}
+
+func (s *S) TestWaitUntilInstanceStatusOk(c *check.C) {
+	statuses := func(items ...string) string {
+		return fmt.Sprintf(DescribeInstanceStatusesDump, strings.Join(items, ""))
+	}
+	testServer.Response(200, nil, statuses(fmt.Sprintf(InstanceStatusItem, "i-0", "ok", "initializing")))
+	testServer.Response(200, nil, statuses(
+		fmt.Sprintf(InstanceStatusItem, "i-0", "ok", "ok"),
+		fmt.Sprintf(InstanceStatusItem, "i-1", "ok", "initializing")))
+	testServer.Response(200, nil, statuses(
+		fmt.Sprintf(InstanceStatusItem, "i-0", "ok", "ok"),
+		fmt.Sprintf(InstanceStatusItem, "i-1", "ok", "ok")))
+
+	err := s.ec2.WaitUntilInstanceStatusOk(context.Background(), fastWait, "i-0", "i-1")
+	c.Assert(err, check.IsNil)
+	for i := 0; i < 3; i++ {
+		req := testServer.WaitRequest()
+		c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeInstanceStatus"})
+	}
+}
@@ -0,0 +1,58 @@
+package ecr
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// AuthorizationData holds the Docker credentials of a registry, as used by
+// "docker login --username Username --password Password ProxyEndpoint".
+// The credentials are valid for 12 hours, until ExpiresAt.
+type AuthorizationData struct {
+	Username      string
+	Password      string
+	ProxyEndpoint string
+	ExpiresAt     time.Time
+}
+
+type authorizationData struct {
+	AuthorizationToken string  `json:"authorizationToken"`
+	ExpiresAt          float64 `json:"expiresAt"`
+	ProxyEndpoint      string  `json:"proxyEndpoint"`
+}
+
+type getAuthorizationTokenResponse struct {
+	AuthorizationData []authorizationData `json:"authorizationData"`
+}
+
+// GetAuthorizationToken returns the Docker credentials of the registry of
+// the account in the region of e, decoded from the base64 encoded
+// "user:password" token returned by ECR.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_GetAuthorizationToken.html
+func (e *ECR) GetAuthorizationToken() (*AuthorizationData, error) {
+	var resp getAuthorizationTokenResponse
+	if err := e.query("GetAuthorizationToken", struct{}{}, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.AuthorizationData) == 0 {
+		return nil, fmt.Errorf("ecr: no authorization data returned")
+	}
+	data := resp.AuthorizationData[0]
+	token, err := base64.StdEncoding.DecodeString(data.AuthorizationToken)
+	if err != nil {
+		return nil, fmt.Errorf("ecr: invalid authorization token: %v", err)
+	}
+	i := strings.Index(string(token), ":")
+	if i < 0 {
+		return nil, fmt.Errorf("ecr: invalid authorization token: no password")
+	}
+	return &AuthorizationData{
+		Username:      string(token[:i]),
+		Password:      string(token[i+1:]),
+		ProxyEndpoint: data.ProxyEndpoint,
+		ExpiresAt:     time.Unix(int64(data.ExpiresAt), 0),
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Package ecr provides types and functions to interact with the Amazon
+// Elastic Container Registry.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/Welcome.html
+package ecr
+
+import (
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type ECR struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *ECR {
+	return &ECR{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with ECR.
+type Error = aws.JSONError
+
+// query calls the ECR action with req encoded as JSON and decodes the
+// response into resp.
+func (e *ECR) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         e.Auth,
+		Region:       e.Region,
+		HTTPClient:   e.HTTPClient,
+		Endpoint:     e.Region.ECREndpoint,
+		TargetPrefix: "AmazonEC2ContainerRegistry_V20150921",
+		SigningName:  "ecr",
+	}
+	return client.Call(action, req, resp)
+}
+
+// ImageIdentifier identifies an image of a repository by digest or tag.
+type ImageIdentifier struct {
+	ImageDigest string `json:"imageDigest,omitempty"`
+	ImageTag    string `json:"imageTag,omitempty"`
+}
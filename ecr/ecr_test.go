@@ -0,0 +1,194 @@
+package ecr_test
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/ecr"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	ecr *ecr.ECR
+}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.ecr = ecr.New(auth, aws.Region{Name: "us-east-1", ECREndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) TestDescribeImageScanFindings(c *check.C) {
+	testServer.Response(200, nil, DescribeImageScanFindingsPage1)
+
+	resp, err := s.ecr.DescribeImageScanFindings(&ecr.DescribeImageScanFindingsRequest{
+		RepositoryName: "api",
+		ImageId:        ecr.ImageIdentifier{ImageTag: "v1.4.2"},
+		MaxResults:     3,
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.DescribeImageScanFindings")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"repositoryName": "api",
+		"imageId":        map[string]interface{}{"imageTag": "v1.4.2"},
+		"maxResults":     3.0,
+	})
+
+	c.Assert(resp.ImageScanStatus.Status, check.Equals, ecr.ScanStatusComplete)
+	c.Assert(resp.ImageId.ImageDigest, check.Equals, "sha256:74b2c688c700ec95a93e478cdb959737c148df3fbf5ea706abe0318726e885e6")
+	c.Assert(resp.NextToken, check.Equals, "page2")
+	findings := resp.ImageScanFindings
+	c.Assert(findings.ImageScanCompletedAt, check.Equals, 1579839105.0)
+	c.Assert(findings.Findings, check.HasLen, 3)
+	c.Assert(findings.Findings[0].Attributes, check.DeepEquals, []ecr.Attribute{
+		{Key: "package_version", Value: "1.44.5-1+deb10u2"},
+		{Key: "package_name", Value: "e2fsprogs"},
+	})
+	c.Assert(findings.CountAtLeast(ecr.SeverityHigh), check.Equals, 2)
+	c.Assert(findings.CountAtLeast(ecr.SeverityUndefined), check.Equals, 5)
+}
+
+func (s *S) TestImageScanFindingsAtLeast(c *check.C) {
+	testServer.Response(200, nil, DescribeImageScanFindingsPage1)
+	testServer.Response(200, nil, DescribeImageScanFindingsPage2)
+
+	resp, err := s.ecr.ImageScanFindingsAtLeast(&ecr.DescribeImageScanFindingsRequest{
+		RepositoryName: "api",
+		ImageId:        ecr.ImageIdentifier{ImageTag: "v1.4.2"},
+		NextToken:      "ignored",
+	}, ecr.SeverityHigh)
+	c.Assert(err, check.IsNil)
+
+	_, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(body["nextToken"], check.IsNil)
+	_, body = testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(body["nextToken"], check.Equals, "page2")
+
+	var names []string
+	for _, f := range resp.ImageScanFindings.Findings {
+		names = append(names, f.Name)
+	}
+	c.Assert(names, check.DeepEquals, []string{"CVE-2019-18224", "CVE-2020-1751"})
+	c.Assert(resp.NextToken, check.Equals, "")
+}
+
+func (s *S) TestImageScanFindingsAtLeastInProgress(c *check.C) {
+	testServer.Response(200, nil, DescribeImageScanFindingsInProgress)
+
+	resp, err := s.ecr.ImageScanFindingsAtLeast(&ecr.DescribeImageScanFindingsRequest{
+		RepositoryName: "api",
+		ImageId:        ecr.ImageIdentifier{ImageTag: "v1.4.3"},
+	}, ecr.SeverityCritical)
+	testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.ImageScanStatus.Status, check.Equals, ecr.ScanStatusInProgress)
+	c.Assert(resp.ImageScanFindings, check.IsNil)
+}
+
+func (s *S) TestAtLeast(c *check.C) {
+	c.Assert(ecr.AtLeast(ecr.SeverityCritical, ecr.SeverityHigh), check.Equals, true)
+	c.Assert(ecr.AtLeast(ecr.SeverityHigh, ecr.SeverityHigh), check.Equals, true)
+	c.Assert(ecr.AtLeast(ecr.SeverityMedium, ecr.SeverityHigh), check.Equals, false)
+	c.Assert(ecr.AtLeast(ecr.SeverityUndefined, ecr.SeverityInformational), check.Equals, false)
+}
+
+func (s *S) TestStartImageScan(c *check.C) {
+	testServer.Response(200, nil, `{"imageScanStatus": {"status": "IN_PROGRESS"}}`)
+
+	status, err := s.ecr.StartImageScan("api", ecr.ImageIdentifier{ImageTag: "v1.4.3"})
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.StartImageScan")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"repositoryName": "api",
+		"imageId":        map[string]interface{}{"imageTag": "v1.4.3"},
+	})
+	c.Assert(status.Status, check.Equals, ecr.ScanStatusInProgress)
+}
+
+func (s *S) TestPutReplicationConfiguration(c *check.C) {
+	testServer.Response(200, nil, PutReplicationConfigurationResponse)
+
+	config, err := s.ecr.PutReplicationConfiguration(&ecr.ReplicationConfiguration{
+		Rules: []ecr.ReplicationRule{{
+			Destinations: []ecr.ReplicationDestination{
+				{Region: "eu-west-1", RegistryId: "012345678910"},
+				{Region: "us-west-2", RegistryId: "109876543210"},
+			},
+			RepositoryFilters: []ecr.RepositoryFilter{
+				{Filter: "prod/", FilterType: ecr.RepositoryFilterTypePrefixMatch},
+			},
+		}},
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.PutReplicationConfiguration")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"replicationConfiguration": map[string]interface{}{
+			"rules": []interface{}{map[string]interface{}{
+				"destinations": []interface{}{
+					map[string]interface{}{"region": "eu-west-1", "registryId": "012345678910"},
+					map[string]interface{}{"region": "us-west-2", "registryId": "109876543210"},
+				},
+				"repositoryFilters": []interface{}{
+					map[string]interface{}{"filter": "prod/", "filterType": "PREFIX_MATCH"},
+				},
+			}},
+		},
+	})
+	c.Assert(config.Rules, check.HasLen, 1)
+	c.Assert(config.Rules[0].Destinations[1].RegistryId, check.Equals, "109876543210")
+}
+
+func (s *S) TestPutEmptyReplicationConfiguration(c *check.C) {
+	testServer.Response(200, nil, `{"replicationConfiguration": {"rules": []}}`)
+
+	_, err := s.ecr.PutReplicationConfiguration(&ecr.ReplicationConfiguration{})
+	_, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"replicationConfiguration": map[string]interface{}{"rules": []interface{}{}},
+	})
+}
+
+func (s *S) TestDescribeRegistry(c *check.C) {
+	testServer.Response(200, nil, `{"registryId": "012345678910", "replicationConfiguration": {"rules": []}}`)
+
+	resp, err := s.ecr.DescribeRegistry()
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.DescribeRegistry")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{})
+	c.Assert(resp.RegistryId, check.Equals, "012345678910")
+	c.Assert(resp.ReplicationConfiguration.Rules, check.HasLen, 0)
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(400, nil, RepositoryNotFoundResponse)
+
+	_, err := s.ecr.DescribeImageScanFindings(&ecr.DescribeImageScanFindingsRequest{RepositoryName: "missing"})
+	testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.FitsTypeOf, &ecr.Error{})
+	e := err.(*ecr.Error)
+	c.Assert(e.StatusCode, check.Equals, 400)
+	c.Assert(e.Code, check.Equals, "RepositoryNotFoundException")
+	c.Assert(err, check.ErrorMatches, "ecr: RepositoryNotFoundException: The repository with name 'missing' does not exist .*")
+}
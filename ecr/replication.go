@@ -0,0 +1,72 @@
+package ecr
+
+// RepositoryFilterTypePrefixMatch is the only type of repository filter:
+// the rule applies to the repositories whose name starts with the filter.
+const RepositoryFilterTypePrefixMatch = "PREFIX_MATCH"
+
+// ReplicationDestination is a registry images are copied to. RegistryId
+// is the account of the registry, which may be another one if it allows
+// it in its registry policy.
+type ReplicationDestination struct {
+	Region     string `json:"region"`
+	RegistryId string `json:"registryId"`
+}
+
+type RepositoryFilter struct {
+	Filter     string `json:"filter"`
+	FilterType string `json:"filterType"`
+}
+
+// ReplicationRule copies the images pushed to the repositories matching
+// RepositoryFilters, or to every repository if there are none, to each of
+// Destinations.
+type ReplicationRule struct {
+	Destinations      []ReplicationDestination `json:"destinations"`
+	RepositoryFilters []RepositoryFilter       `json:"repositoryFilters,omitempty"`
+}
+
+// ReplicationConfiguration holds the replication rules of a registry, at
+// most 10.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_ReplicationConfiguration.html
+type ReplicationConfiguration struct {
+	Rules []ReplicationRule `json:"rules"`
+}
+
+type replicationConfigurationResponse struct {
+	ReplicationConfiguration ReplicationConfiguration `json:"replicationConfiguration"`
+}
+
+// PutReplicationConfiguration replaces the replication rules of the
+// registry of the account in the region of e. Only images pushed
+// afterwards are replicated. An empty configuration turns replication off.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_PutReplicationConfiguration.html
+func (e *ECR) PutReplicationConfiguration(config *ReplicationConfiguration) (*ReplicationConfiguration, error) {
+	if config.Rules == nil {
+		config = &ReplicationConfiguration{Rules: []ReplicationRule{}}
+	}
+	req := map[string]interface{}{"replicationConfiguration": config}
+	var resp replicationConfigurationResponse
+	if err := e.query("PutReplicationConfiguration", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationConfiguration, nil
+}
+
+type DescribeRegistryResponse struct {
+	RegistryId               string                   `json:"registryId"`
+	ReplicationConfiguration ReplicationConfiguration `json:"replicationConfiguration"`
+}
+
+// DescribeRegistry returns the ID and the replication rules of the
+// registry of the account in the region of e.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DescribeRegistry.html
+func (e *ECR) DescribeRegistry() (resp *DescribeRegistryResponse, err error) {
+	resp = new(DescribeRegistryResponse)
+	if err := e.query("DescribeRegistry", struct{}{}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
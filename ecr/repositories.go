@@ -0,0 +1,235 @@
+package ecr
+
+// Tag mutability settings of a repository. With ImageTagImmutable, pushing
+// an image with a tag already in the repository fails.
+const (
+	ImageTagMutable   = "MUTABLE"
+	ImageTagImmutable = "IMMUTABLE"
+)
+
+type Tag struct {
+	Key   string `json:"Key"`
+	Value string `json:"Value"`
+}
+
+type ImageScanningConfiguration struct {
+	ScanOnPush bool `json:"scanOnPush"`
+}
+
+// EncryptionConfiguration sets how the images of a repository are
+// encrypted at rest: EncryptionType is "AES256", the default, or "KMS",
+// with the KMS key KmsKey or the AWS managed key if empty.
+type EncryptionConfiguration struct {
+	EncryptionType string `json:"encryptionType"`
+	KmsKey         string `json:"kmsKey,omitempty"`
+}
+
+// CreateRepositoryRequest holds the parameters of CreateRepository. Only
+// RepositoryName is required.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_CreateRepository.html
+type CreateRepositoryRequest struct {
+	RepositoryName             string                      `json:"repositoryName"`
+	ImageTagMutability         string                      `json:"imageTagMutability,omitempty"`
+	ImageScanningConfiguration *ImageScanningConfiguration `json:"imageScanningConfiguration,omitempty"`
+	EncryptionConfiguration    *EncryptionConfiguration    `json:"encryptionConfiguration,omitempty"`
+	Tags                       []Tag                       `json:"tags,omitempty"`
+}
+
+// Repository describes a repository. RepositoryUri is the name images are
+// tagged with to be pushed to it. CreatedAt is in seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_Repository.html
+type Repository struct {
+	RepositoryArn              string                      `json:"repositoryArn"`
+	RegistryId                 string                      `json:"registryId"`
+	RepositoryName             string                      `json:"repositoryName"`
+	RepositoryUri              string                      `json:"repositoryUri"`
+	CreatedAt                  float64                     `json:"createdAt"`
+	ImageTagMutability         string                      `json:"imageTagMutability"`
+	ImageScanningConfiguration *ImageScanningConfiguration `json:"imageScanningConfiguration"`
+	EncryptionConfiguration    *EncryptionConfiguration    `json:"encryptionConfiguration"`
+}
+
+type repositoryResponse struct {
+	Repository Repository `json:"repository"`
+}
+
+// CreateRepository creates a repository in the registry of the account.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_CreateRepository.html
+func (e *ECR) CreateRepository(req *CreateRepositoryRequest) (*Repository, error) {
+	var resp repositoryResponse
+	if err := e.query("CreateRepository", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Repository, nil
+}
+
+// DeleteRepository deletes a repository. A repository with images is only
+// deleted, along with its images, if force is set.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DeleteRepository.html
+func (e *ECR) DeleteRepository(repositoryName string, force bool) (*Repository, error) {
+	req := map[string]interface{}{"repositoryName": repositoryName}
+	if force {
+		req["force"] = true
+	}
+	var resp repositoryResponse
+	if err := e.query("DeleteRepository", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Repository, nil
+}
+
+// DescribeRepositoriesRequest holds the parameters of
+// DescribeRepositories. Without RepositoryNames, every repository of the
+// registry is described. All fields are optional.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DescribeRepositories.html
+type DescribeRepositoriesRequest struct {
+	RegistryId      string   `json:"registryId,omitempty"`
+	RepositoryNames []string `json:"repositoryNames,omitempty"`
+	NextToken       string   `json:"nextToken,omitempty"`
+	MaxResults      int      `json:"maxResults,omitempty"`
+}
+
+type DescribeRepositoriesResponse struct {
+	Repositories []Repository `json:"repositories"`
+	NextToken    string       `json:"nextToken"`
+}
+
+// DescribeRepositories describes a page of the repositories of a registry.
+// req may be nil.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DescribeRepositories.html
+func (e *ECR) DescribeRepositories(req *DescribeRepositoriesRequest) (resp *DescribeRepositoriesResponse, err error) {
+	if req == nil {
+		req = &DescribeRepositoriesRequest{}
+	}
+	resp = new(DescribeRepositoriesResponse)
+	if err := e.query("DescribeRepositories", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Filters of ListImages by whether images are tagged.
+const (
+	TagStatusTagged   = "TAGGED"
+	TagStatusUntagged = "UNTAGGED"
+	TagStatusAny      = "ANY"
+)
+
+// ListImagesRequest holds the parameters of ListImages. TagStatus defaults
+// to TagStatusAny; NextToken and MaxResults, from 1 to 1000, are optional.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_ListImages.html
+type ListImagesRequest struct {
+	RegistryId     string      `json:"registryId,omitempty"`
+	RepositoryName string      `json:"repositoryName"`
+	Filter         *ListFilter `json:"filter,omitempty"`
+	NextToken      string      `json:"nextToken,omitempty"`
+	MaxResults     int         `json:"maxResults,omitempty"`
+}
+
+type ListFilter struct {
+	TagStatus string `json:"tagStatus"`
+}
+
+type ListImagesResponse struct {
+	ImageIds  []ImageIdentifier `json:"imageIds"`
+	NextToken string            `json:"nextToken"`
+}
+
+// ListImages lists a page of the images of a repository. An image with
+// several tags is listed once per tag.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_ListImages.html
+func (e *ECR) ListImages(req *ListImagesRequest) (resp *ListImagesResponse, err error) {
+	resp = new(ListImagesResponse)
+	if err := e.query("ListImages", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ImageFailure tells why an image could not be deleted, such as
+// "ImageNotFound".
+type ImageFailure struct {
+	ImageId       ImageIdentifier `json:"imageId"`
+	FailureCode   string          `json:"failureCode"`
+	FailureReason string          `json:"failureReason"`
+}
+
+type BatchDeleteImageResponse struct {
+	ImageIds []ImageIdentifier `json:"imageIds"`
+	Failures []ImageFailure    `json:"failures"`
+}
+
+// BatchDeleteImage deletes up to 100 images of a repository. Deleting an
+// image by tag only removes the tag, unless it is the last tag of the
+// image. The images that could not be deleted are returned as Failures;
+// err is only set when the request as a whole fails.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_BatchDeleteImage.html
+func (e *ECR) BatchDeleteImage(repositoryName string, imageIds []ImageIdentifier) (resp *BatchDeleteImageResponse, err error) {
+	req := map[string]interface{}{
+		"repositoryName": repositoryName,
+		"imageIds":       imageIds,
+	}
+	resp = new(BatchDeleteImageResponse)
+	if err := e.query("BatchDeleteImage", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LifecyclePolicy is the lifecycle policy of a repository. LifecyclePolicyText
+// is the JSON document of the policy, whose rules expire images by age or
+// count. LastEvaluatedAt is in seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/userguide/LifecyclePolicies.html
+type LifecyclePolicy struct {
+	RegistryId          string  `json:"registryId"`
+	RepositoryName      string  `json:"repositoryName"`
+	LifecyclePolicyText string  `json:"lifecyclePolicyText"`
+	LastEvaluatedAt     float64 `json:"lastEvaluatedAt"`
+}
+
+// PutLifecyclePolicy sets the lifecycle policy of a repository, replacing
+// the previous one.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_PutLifecyclePolicy.html
+func (e *ECR) PutLifecyclePolicy(repositoryName, lifecyclePolicyText string) (*LifecyclePolicy, error) {
+	req := map[string]string{
+		"repositoryName":      repositoryName,
+		"lifecyclePolicyText": lifecyclePolicyText,
+	}
+	resp := new(LifecyclePolicy)
+	if err := e.query("PutLifecyclePolicy", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetLifecyclePolicy returns the lifecycle policy of a repository. It fails
+// with the code "LifecyclePolicyNotFoundException" if there is none.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_GetLifecyclePolicy.html
+func (e *ECR) GetLifecyclePolicy(repositoryName string) (*LifecyclePolicy, error) {
+	req := map[string]string{"repositoryName": repositoryName}
+	resp := new(LifecyclePolicy)
+	if err := e.query("GetLifecyclePolicy", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteLifecyclePolicy deletes the lifecycle policy of a repository.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DeleteLifecyclePolicy.html
+func (e *ECR) DeleteLifecyclePolicy(repositoryName string) error {
+	req := map[string]string{"repositoryName": repositoryName}
+	return e.query("DeleteLifecyclePolicy", req, nil)
+}
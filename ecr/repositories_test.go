@@ -0,0 +1,138 @@
+package ecr_test
+
+import (
+	"time"
+
+	"github.com/zackbloom/goamz/ecr"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCreateRepository(c *check.C) {
+	testServer.Response(200, nil, CreateRepositoryResponse)
+
+	repo, err := s.ecr.CreateRepository(&ecr.CreateRepositoryRequest{
+		RepositoryName:             "project-a/api",
+		ImageTagMutability:         ecr.ImageTagImmutable,
+		ImageScanningConfiguration: &ecr.ImageScanningConfiguration{ScanOnPush: true},
+		Tags:                       []ecr.Tag{{Key: "team", Value: "platform"}},
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.CreateRepository")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"repositoryName":             "project-a/api",
+		"imageTagMutability":         "IMMUTABLE",
+		"imageScanningConfiguration": map[string]interface{}{"scanOnPush": true},
+		"tags":                       []interface{}{map[string]interface{}{"Key": "team", "Value": "platform"}},
+	})
+	c.Assert(repo.RepositoryUri, check.Equals, "012345678910.dkr.ecr.us-east-1.amazonaws.com/project-a/api")
+	c.Assert(repo.ImageScanningConfiguration.ScanOnPush, check.Equals, true)
+	c.Assert(repo.EncryptionConfiguration.EncryptionType, check.Equals, "AES256")
+}
+
+func (s *S) TestDeleteRepository(c *check.C) {
+	testServer.Response(200, nil, CreateRepositoryResponse)
+
+	_, err := s.ecr.DeleteRepository("project-a/api", true)
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.DeleteRepository")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"repositoryName": "project-a/api", "force": true})
+}
+
+func (s *S) TestGetAuthorizationToken(c *check.C) {
+	testServer.Response(200, nil, GetAuthorizationTokenResponse)
+
+	auth, err := s.ecr.GetAuthorizationToken()
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.GetAuthorizationToken")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{})
+	c.Assert(auth.Username, check.Equals, "AWS")
+	c.Assert(auth.Password, check.Equals, "s3cr3t:pass")
+	c.Assert(auth.ProxyEndpoint, check.Equals, "https://012345678910.dkr.ecr.us-east-1.amazonaws.com")
+	c.Assert(auth.ExpiresAt.Equal(time.Unix(1470951892, 0)), check.Equals, true)
+}
+
+func (s *S) TestGetAuthorizationTokenInvalid(c *check.C) {
+	testServer.Response(200, nil, `{"authorizationData": [{"authorizationToken": "bm9wYXNzd29yZA=="}]}`)
+
+	_, err := s.ecr.GetAuthorizationToken()
+	testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.ErrorMatches, "ecr: invalid authorization token: no password")
+}
+
+func (s *S) TestListImages(c *check.C) {
+	testServer.Response(200, nil, `{"imageIds": [{"imageDigest": "sha256:99c6fb4377e9a420a1eb3b410a951c9f464eff3b7dbc76c65e434e39b94b6570"}], "nextToken": "page2"}`)
+
+	resp, err := s.ecr.ListImages(&ecr.ListImagesRequest{
+		RepositoryName: "project-a/api",
+		Filter:         &ecr.ListFilter{TagStatus: ecr.TagStatusUntagged},
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.ListImages")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"repositoryName": "project-a/api",
+		"filter":         map[string]interface{}{"tagStatus": "UNTAGGED"},
+	})
+	c.Assert(resp.ImageIds, check.DeepEquals, []ecr.ImageIdentifier{
+		{ImageDigest: "sha256:99c6fb4377e9a420a1eb3b410a951c9f464eff3b7dbc76c65e434e39b94b6570"},
+	})
+	c.Assert(resp.NextToken, check.Equals, "page2")
+}
+
+func (s *S) TestBatchDeleteImage(c *check.C) {
+	testServer.Response(200, nil, BatchDeleteImageResponse)
+
+	resp, err := s.ecr.BatchDeleteImage("project-a/api", []ecr.ImageIdentifier{{ImageTag: "v1.4.2"}, {ImageTag: "v0.9"}})
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.BatchDeleteImage")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"repositoryName": "project-a/api",
+		"imageIds": []interface{}{
+			map[string]interface{}{"imageTag": "v1.4.2"},
+			map[string]interface{}{"imageTag": "v0.9"},
+		},
+	})
+	c.Assert(resp.ImageIds, check.HasLen, 1)
+	c.Assert(resp.Failures, check.DeepEquals, []ecr.ImageFailure{{
+		ImageId:       ecr.ImageIdentifier{ImageTag: "v0.9"},
+		FailureCode:   "ImageNotFound",
+		FailureReason: "Requested image not found",
+	}})
+}
+
+func (s *S) TestPutLifecyclePolicy(c *check.C) {
+	policy := `{"rules":[{"rulePriority":1,"selection":{"tagStatus":"untagged","countType":"sinceImagePushed","countUnit":"days","countNumber":14},"action":{"type":"expire"}}]}`
+	testServer.Response(200, nil, `{"registryId": "012345678910", "repositoryName": "project-a/api", "lifecyclePolicyText": "{\"rules\":[]}"}`)
+
+	resp, err := s.ecr.PutLifecyclePolicy("project-a/api", policy)
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.PutLifecyclePolicy")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"repositoryName":      "project-a/api",
+		"lifecyclePolicyText": policy,
+	})
+	c.Assert(resp.RegistryId, check.Equals, "012345678910")
+	c.Assert(resp.LifecyclePolicyText, check.Equals, `{"rules":[]}`)
+}
+
+func (s *S) TestDeleteLifecyclePolicy(c *check.C) {
+	testServer.Response(200, nil, `{"registryId": "012345678910", "repositoryName": "project-a/api"}`)
+
+	err := s.ecr.DeleteLifecyclePolicy("project-a/api")
+	target, body := testServer.WaitJSONRequest(c, "ecr")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerRegistry_V20150921.DeleteLifecyclePolicy")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"repositoryName": "project-a/api"})
+}
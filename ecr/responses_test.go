@@ -0,0 +1,172 @@
+package ecr_test
+
+// http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DescribeImageScanFindings.html
+var DescribeImageScanFindingsPage1 = `
+{
+  "registryId": "012345678910",
+  "repositoryName": "api",
+  "imageId": {
+    "imageDigest": "sha256:74b2c688c700ec95a93e478cdb959737c148df3fbf5ea706abe0318726e885e6",
+    "imageTag": "v1.4.2"
+  },
+  "imageScanStatus": {
+    "status": "COMPLETE",
+    "description": "The scan was completed successfully."
+  },
+  "imageScanFindings": {
+    "imageScanCompletedAt": 1579839105.0,
+    "vulnerabilitySourceUpdatedAt": 1579811117.0,
+    "findingSeverityCounts": {
+      "CRITICAL": 1,
+      "HIGH": 1,
+      "MEDIUM": 2,
+      "LOW": 1
+    },
+    "findings": [
+      {
+        "name": "CVE-2019-5188",
+        "description": "A code execution vulnerability exists in the directory rehashing functionality of E2fsprogs.",
+        "uri": "https://security-tracker.debian.org/tracker/CVE-2019-5188",
+        "severity": "MEDIUM",
+        "attributes": [
+          {"key": "package_version", "value": "1.44.5-1+deb10u2"},
+          {"key": "package_name", "value": "e2fsprogs"}
+        ]
+      },
+      {
+        "name": "CVE-2019-18224",
+        "description": "idn2_to_ascii_4i in lib/lookup.c in GNU libidn2 has a heap-based buffer overflow.",
+        "uri": "https://security-tracker.debian.org/tracker/CVE-2019-18224",
+        "severity": "CRITICAL"
+      },
+      {
+        "name": "CVE-2018-12886",
+        "description": "stack_protect_prologue in cfgexpand.c and stack_protect_epilogue in function.c allows attackers to bypass stack protection.",
+        "uri": "https://security-tracker.debian.org/tracker/CVE-2018-12886",
+        "severity": "LOW"
+      }
+    ]
+  },
+  "nextToken": "page2"
+}
+`
+
+var DescribeImageScanFindingsPage2 = `
+{
+  "registryId": "012345678910",
+  "repositoryName": "api",
+  "imageId": {
+    "imageDigest": "sha256:74b2c688c700ec95a93e478cdb959737c148df3fbf5ea706abe0318726e885e6",
+    "imageTag": "v1.4.2"
+  },
+  "imageScanStatus": {
+    "status": "COMPLETE",
+    "description": "The scan was completed successfully."
+  },
+  "imageScanFindings": {
+    "findingSeverityCounts": {
+      "CRITICAL": 1,
+      "HIGH": 1,
+      "MEDIUM": 2,
+      "LOW": 1
+    },
+    "findings": [
+      {
+        "name": "CVE-2020-1751",
+        "description": "An out-of-bounds write vulnerability was found in glibc.",
+        "uri": "https://security-tracker.debian.org/tracker/CVE-2020-1751",
+        "severity": "HIGH"
+      },
+      {
+        "name": "CVE-2019-25013",
+        "description": "The iconv feature in the GNU C Library may hit an assertion failure.",
+        "uri": "https://security-tracker.debian.org/tracker/CVE-2019-25013",
+        "severity": "MEDIUM"
+      }
+    ]
+  }
+}
+`
+
+var DescribeImageScanFindingsInProgress = `
+{
+  "registryId": "012345678910",
+  "repositoryName": "api",
+  "imageId": {"imageTag": "v1.4.3"},
+  "imageScanStatus": {"status": "IN_PROGRESS"}
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_PutReplicationConfiguration.html
+var PutReplicationConfigurationResponse = `
+{
+  "replicationConfiguration": {
+    "rules": [
+      {
+        "destinations": [
+          {"region": "eu-west-1", "registryId": "012345678910"},
+          {"region": "us-west-2", "registryId": "109876543210"}
+        ],
+        "repositoryFilters": [
+          {"filter": "prod/", "filterType": "PREFIX_MATCH"}
+        ]
+      }
+    ]
+  }
+}
+`
+
+var RepositoryNotFoundResponse = `
+{
+  "__type": "RepositoryNotFoundException",
+  "message": "The repository with name 'missing' does not exist in the registry with id '012345678910'"
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_CreateRepository.html
+var CreateRepositoryResponse = `
+{
+  "repository": {
+    "registryId": "012345678910",
+    "repositoryName": "project-a/api",
+    "repositoryArn": "arn:aws:ecr:us-east-1:012345678910:repository/project-a/api",
+    "repositoryUri": "012345678910.dkr.ecr.us-east-1.amazonaws.com/project-a/api",
+    "createdAt": 1563223656.0,
+    "imageTagMutability": "IMMUTABLE",
+    "imageScanningConfiguration": {"scanOnPush": true},
+    "encryptionConfiguration": {"encryptionType": "AES256"}
+  }
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_GetAuthorizationToken.html
+var GetAuthorizationTokenResponse = `
+{
+  "authorizationData": [
+    {
+      "authorizationToken": "QVdTOnMzY3IzdDpwYXNz",
+      "expiresAt": 1470951892.0,
+      "proxyEndpoint": "https://012345678910.dkr.ecr.us-east-1.amazonaws.com"
+    }
+  ]
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_BatchDeleteImage.html
+var BatchDeleteImageResponse = `
+{
+  "imageIds": [
+    {
+      "imageDigest": "sha256:74b2c688c700ec95a93e478cdb959737c148df3fbf5ea706abe0318726e885e6",
+      "imageTag": "v1.4.2"
+    }
+  ],
+  "failures": [
+    {
+      "imageId": {"imageTag": "v0.9"},
+      "failureCode": "ImageNotFound",
+      "failureReason": "Requested image not found"
+    }
+  ]
+}
+`
@@ -0,0 +1,194 @@
+package ecr
+
+// Severities of image scan findings, from the least to the most severe.
+// SeverityUndefined is given to the findings the vulnerability source has
+// not rated, and is ranked below SeverityInformational.
+const (
+	SeverityUndefined     = "UNDEFINED"
+	SeverityInformational = "INFORMATIONAL"
+	SeverityLow           = "LOW"
+	SeverityMedium        = "MEDIUM"
+	SeverityHigh          = "HIGH"
+	SeverityCritical      = "CRITICAL"
+)
+
+var severityRanks = map[string]int{
+	SeverityUndefined:     0,
+	SeverityInformational: 1,
+	SeverityLow:           2,
+	SeverityMedium:        3,
+	SeverityHigh:          4,
+	SeverityCritical:      5,
+}
+
+// AtLeast reports whether severity is minSeverity or more severe.
+// Severities unknown to this package rank as SeverityUndefined.
+func AtLeast(severity, minSeverity string) bool {
+	return severityRanks[severity] >= severityRanks[minSeverity]
+}
+
+// Statuses of an image scan. Findings are only available once the scan is
+// ScanStatusComplete, or ScanStatusActive for continuous enhanced scanning.
+const (
+	ScanStatusInProgress = "IN_PROGRESS"
+	ScanStatusComplete   = "COMPLETE"
+	ScanStatusFailed     = "FAILED"
+	ScanStatusActive     = "ACTIVE"
+)
+
+type ImageScanStatus struct {
+	Status      string `json:"status"`
+	Description string `json:"description"`
+}
+
+type Attribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ImageScanFinding is a vulnerability found by basic scanning. Name is its
+// CVE identifier.
+type ImageScanFinding struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	URI         string      `json:"uri"`
+	Severity    string      `json:"severity"`
+	Attributes  []Attribute `json:"attributes"`
+}
+
+type PackageVulnerabilityDetails struct {
+	VulnerabilityId string `json:"vulnerabilityId"`
+	SourceUrl       string `json:"sourceUrl"`
+	Source          string `json:"source"`
+}
+
+// EnhancedImageScanFinding is a vulnerability found by enhanced scanning,
+// done by Amazon Inspector. Dates are in seconds since the epoch.
+type EnhancedImageScanFinding struct {
+	FindingArn                  string                       `json:"findingArn"`
+	Title                       string                       `json:"title"`
+	Description                 string                       `json:"description"`
+	Severity                    string                       `json:"severity"`
+	Score                       float64                      `json:"score"`
+	Status                      string                       `json:"status"`
+	Type                        string                       `json:"type"`
+	FirstObservedAt             float64                      `json:"firstObservedAt"`
+	LastObservedAt              float64                      `json:"lastObservedAt"`
+	PackageVulnerabilityDetails *PackageVulnerabilityDetails `json:"packageVulnerabilityDetails"`
+}
+
+// ImageScanFindings holds a page of the findings of an image scan.
+// FindingSeverityCounts counts the findings of the whole scan by severity.
+// Dates are in seconds since the epoch.
+type ImageScanFindings struct {
+	ImageScanCompletedAt         float64                    `json:"imageScanCompletedAt"`
+	VulnerabilitySourceUpdatedAt float64                    `json:"vulnerabilitySourceUpdatedAt"`
+	FindingSeverityCounts        map[string]int             `json:"findingSeverityCounts"`
+	Findings                     []ImageScanFinding         `json:"findings"`
+	EnhancedFindings             []EnhancedImageScanFinding `json:"enhancedFindings"`
+}
+
+// CountAtLeast returns how many findings of the whole scan are
+// minSeverity or more severe, which can gate a deployment without paging
+// through the findings.
+func (f *ImageScanFindings) CountAtLeast(minSeverity string) int {
+	n := 0
+	for severity, count := range f.FindingSeverityCounts {
+		if AtLeast(severity, minSeverity) {
+			n += count
+		}
+	}
+	return n
+}
+
+// DescribeImageScanFindingsRequest holds the parameters of
+// DescribeImageScanFindings. RegistryId defaults to the registry of the
+// account; NextToken and MaxResults, from 1 to 1000, are optional.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DescribeImageScanFindings.html
+type DescribeImageScanFindingsRequest struct {
+	RegistryId     string          `json:"registryId,omitempty"`
+	RepositoryName string          `json:"repositoryName"`
+	ImageId        ImageIdentifier `json:"imageId"`
+	NextToken      string          `json:"nextToken,omitempty"`
+	MaxResults     int             `json:"maxResults,omitempty"`
+}
+
+type DescribeImageScanFindingsResponse struct {
+	RegistryId        string             `json:"registryId"`
+	RepositoryName    string             `json:"repositoryName"`
+	ImageId           ImageIdentifier    `json:"imageId"`
+	ImageScanStatus   ImageScanStatus    `json:"imageScanStatus"`
+	ImageScanFindings *ImageScanFindings `json:"imageScanFindings"`
+	NextToken         string             `json:"nextToken"`
+}
+
+// DescribeImageScanFindings returns a page of the findings of the last
+// scan of an image. ImageScanFindings is nil while the scan is in progress.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_DescribeImageScanFindings.html
+func (e *ECR) DescribeImageScanFindings(req *DescribeImageScanFindingsRequest) (resp *DescribeImageScanFindingsResponse, err error) {
+	resp = new(DescribeImageScanFindingsResponse)
+	if err := e.query("DescribeImageScanFindings", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ImageScanFindingsAtLeast pages through the findings of the last scan of
+// an image and returns those of minSeverity or more severe. The returned
+// response is the last page, with its Findings and EnhancedFindings
+// replaced by the filtered findings of every page. Only the
+// RepositoryName, ImageId and RegistryId of req are used.
+func (e *ECR) ImageScanFindingsAtLeast(req *DescribeImageScanFindingsRequest, minSeverity string) (resp *DescribeImageScanFindingsResponse, err error) {
+	page := *req
+	page.NextToken = ""
+	var findings []ImageScanFinding
+	var enhanced []EnhancedImageScanFinding
+	for {
+		resp, err = e.DescribeImageScanFindings(&page)
+		if err != nil {
+			return nil, err
+		}
+		if resp.ImageScanFindings == nil {
+			return resp, nil
+		}
+		for _, f := range resp.ImageScanFindings.Findings {
+			if AtLeast(f.Severity, minSeverity) {
+				findings = append(findings, f)
+			}
+		}
+		for _, f := range resp.ImageScanFindings.EnhancedFindings {
+			if AtLeast(f.Severity, minSeverity) {
+				enhanced = append(enhanced, f)
+			}
+		}
+		if resp.NextToken == "" {
+			break
+		}
+		page.NextToken = resp.NextToken
+	}
+	resp.ImageScanFindings.Findings = findings
+	resp.ImageScanFindings.EnhancedFindings = enhanced
+	return resp, nil
+}
+
+type startImageScanResponse struct {
+	ImageScanStatus ImageScanStatus `json:"imageScanStatus"`
+}
+
+// StartImageScan starts a basic scan of an image. An image can be scanned
+// once a day.
+//
+// See http://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_StartImageScan.html
+func (e *ECR) StartImageScan(repositoryName string, imageId ImageIdentifier) (*ImageScanStatus, error) {
+	req := map[string]interface{}{
+		"repositoryName": repositoryName,
+		"imageId":        imageId,
+	}
+	var resp startImageScanResponse
+	if err := e.query("StartImageScan", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ImageScanStatus, nil
+}
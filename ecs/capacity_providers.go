@@ -0,0 +1,171 @@
+package ecs
+
+const (
+	ManagedScalingEnabled  = "ENABLED"
+	ManagedScalingDisabled = "DISABLED"
+
+	ManagedTerminationProtectionEnabled  = "ENABLED"
+	ManagedTerminationProtectionDisabled = "DISABLED"
+
+	// The capacity providers of Fargate, which need not be created.
+	CapacityProviderFargate     = "FARGATE"
+	CapacityProviderFargateSpot = "FARGATE_SPOT"
+)
+
+// ManagedScaling makes ECS adjust the desired capacity of the Auto Scaling
+// group so that it is TargetCapacity percent utilized by tasks.
+type ManagedScaling struct {
+	Status                 string `json:"status,omitempty"`
+	TargetCapacity         int    `json:"targetCapacity,omitempty"`
+	MinimumScalingStepSize int    `json:"minimumScalingStepSize,omitempty"`
+	MaximumScalingStepSize int    `json:"maximumScalingStepSize,omitempty"`
+	InstanceWarmupPeriod   int    `json:"instanceWarmupPeriod,omitempty"`
+}
+
+// AutoScalingGroupProvider links a capacity provider to an Auto Scaling
+// group. Managed termination protection requires managed scaling and
+// instance scale-in protection on the group.
+type AutoScalingGroupProvider struct {
+	AutoScalingGroupArn          string          `json:"autoScalingGroupArn"`
+	ManagedScaling               *ManagedScaling `json:"managedScaling,omitempty"`
+	ManagedTerminationProtection string          `json:"managedTerminationProtection,omitempty"`
+}
+
+// CapacityProvider is returned by the capacity provider actions.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CapacityProvider.html
+type CapacityProvider struct {
+	CapacityProviderArn      string                   `json:"capacityProviderArn"`
+	Name                     string                   `json:"name"`
+	Status                   string                   `json:"status"`
+	AutoScalingGroupProvider AutoScalingGroupProvider `json:"autoScalingGroupProvider"`
+	UpdateStatus             string                   `json:"updateStatus"`
+	UpdateStatusReason       string                   `json:"updateStatusReason"`
+	Tags                     []Tag                    `json:"tags"`
+}
+
+// CapacityProviderStrategyItem sets how tasks are spread over a capacity
+// provider. The first Base tasks are placed on the provider with a base,
+// and the rest are spread over the providers in proportion to Weight.
+type CapacityProviderStrategyItem struct {
+	CapacityProvider string `json:"capacityProvider"`
+	Weight           int    `json:"weight,omitempty"`
+	Base             int    `json:"base,omitempty"`
+}
+
+type CreateCapacityProviderRequest struct {
+	Name                     string                   `json:"name"`
+	AutoScalingGroupProvider AutoScalingGroupProvider `json:"autoScalingGroupProvider"`
+	Tags                     []Tag                    `json:"tags,omitempty"`
+}
+
+type capacityProviderResponse struct {
+	CapacityProvider CapacityProvider `json:"capacityProvider"`
+}
+
+// CreateCapacityProvider creates a capacity provider for an Auto Scaling
+// group. It must then be added to a cluster with
+// PutClusterCapacityProviders.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateCapacityProvider.html
+func (e *ECS) CreateCapacityProvider(req *CreateCapacityProviderRequest) (*CapacityProvider, error) {
+	resp := new(capacityProviderResponse)
+	if err := e.query("CreateCapacityProvider", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.CapacityProvider, nil
+}
+
+type deleteCapacityProviderRequest struct {
+	CapacityProvider string `json:"capacityProvider"`
+}
+
+// DeleteCapacityProvider deletes a capacity provider. It must first be
+// removed from the strategies of services and clusters.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DeleteCapacityProvider.html
+func (e *ECS) DeleteCapacityProvider(capacityProvider string) (*CapacityProvider, error) {
+	resp := new(capacityProviderResponse)
+	req := &deleteCapacityProviderRequest{CapacityProvider: capacityProvider}
+	if err := e.query("DeleteCapacityProvider", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.CapacityProvider, nil
+}
+
+type describeCapacityProvidersRequest struct {
+	CapacityProviders []string `json:"capacityProviders,omitempty"`
+	NextToken         string   `json:"nextToken,omitempty"`
+}
+
+type DescribeCapacityProvidersResp struct {
+	CapacityProviders []CapacityProvider `json:"capacityProviders"`
+	Failures          []Failure          `json:"failures"`
+	NextToken         string             `json:"nextToken"`
+}
+
+// DescribeCapacityProviders describes the named capacity providers, or all
+// of them a page at a time if names is empty. nextToken may be "".
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DescribeCapacityProviders.html
+func (e *ECS) DescribeCapacityProviders(names []string, nextToken string) (resp *DescribeCapacityProvidersResp, err error) {
+	req := &describeCapacityProvidersRequest{CapacityProviders: names, NextToken: nextToken}
+	resp = new(DescribeCapacityProvidersResp)
+	if err := e.query("DescribeCapacityProviders", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Cluster is returned by the cluster actions.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_Cluster.html
+type Cluster struct {
+	ClusterArn                        string                         `json:"clusterArn"`
+	ClusterName                       string                         `json:"clusterName"`
+	Status                            string                         `json:"status"`
+	RegisteredContainerInstancesCount int                            `json:"registeredContainerInstancesCount"`
+	RunningTasksCount                 int                            `json:"runningTasksCount"`
+	PendingTasksCount                 int                            `json:"pendingTasksCount"`
+	ActiveServicesCount               int                            `json:"activeServicesCount"`
+	CapacityProviders                 []string                       `json:"capacityProviders"`
+	DefaultCapacityProviderStrategy   []CapacityProviderStrategyItem `json:"defaultCapacityProviderStrategy"`
+	AttachmentsStatus                 string                         `json:"attachmentsStatus"`
+}
+
+// The fields are sent even when empty, since an empty list removes every
+// capacity provider or the default strategy.
+type putClusterCapacityProvidersRequest struct {
+	Cluster                         string                         `json:"cluster"`
+	CapacityProviders               []string                       `json:"capacityProviders"`
+	DefaultCapacityProviderStrategy []CapacityProviderStrategyItem `json:"defaultCapacityProviderStrategy"`
+}
+
+type putClusterCapacityProvidersResponse struct {
+	Cluster Cluster `json:"cluster"`
+}
+
+// PutClusterCapacityProviders replaces the capacity providers of a cluster
+// and its default capacity provider strategy, which is used by services
+// and tasks created without a launch type or strategy. Every provider in
+// use by a service must be listed.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_PutClusterCapacityProviders.html
+func (e *ECS) PutClusterCapacityProviders(cluster string, capacityProviders []string, defaultStrategy []CapacityProviderStrategyItem) (*Cluster, error) {
+	if capacityProviders == nil {
+		capacityProviders = []string{}
+	}
+	if defaultStrategy == nil {
+		defaultStrategy = []CapacityProviderStrategyItem{}
+	}
+	req := &putClusterCapacityProvidersRequest{
+		Cluster:                         cluster,
+		CapacityProviders:               capacityProviders,
+		DefaultCapacityProviderStrategy: defaultStrategy,
+	}
+	resp := new(putClusterCapacityProvidersResponse)
+	if err := e.query("PutClusterCapacityProviders", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Cluster, nil
+}
@@ -0,0 +1,54 @@
+// Package ecs provides types and functions to interact with the Amazon
+// Elastic Container Service.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/Welcome.html
+package ecs
+
+import (
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type ECS struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *ECS {
+	return &ECS{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with ECS.
+type Error = aws.JSONError
+
+// query calls the ECS action with req encoded as JSON and decodes the
+// response into resp.
+func (e *ECS) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         e.Auth,
+		Region:       e.Region,
+		HTTPClient:   e.HTTPClient,
+		Endpoint:     e.Region.ECSEndpoint,
+		TargetPrefix: "AmazonEC2ContainerServiceV20141113",
+		SigningName:  "ecs",
+	}
+	return client.Call(action, req, resp)
+}
+
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Failure is returned by the Describe actions for each resource that
+// couldn't be described.
+type Failure struct {
+	Arn    string `json:"arn"`
+	Reason string `json:"reason"`
+	Detail string `json:"detail"`
+}
@@ -0,0 +1,165 @@
+package ecs_test
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/ecs"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	ecs *ecs.ECS
+}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.ecs = ecs.New(auth, aws.Region{Name: "us-east-1", ECSEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) TestCreateCapacityProvider(c *check.C) {
+	testServer.Response(200, nil, CreateCapacityProviderResponse)
+
+	asg := "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:57ffcb94-11f0-4d6d-bf60-3bac5EXAMPLE:autoScalingGroupName/workers"
+	cp, err := s.ecs.CreateCapacityProvider(&ecs.CreateCapacityProviderRequest{
+		Name: "workers",
+		AutoScalingGroupProvider: ecs.AutoScalingGroupProvider{
+			AutoScalingGroupArn: asg,
+			ManagedScaling: &ecs.ManagedScaling{
+				Status:         ecs.ManagedScalingEnabled,
+				TargetCapacity: 90,
+			},
+			ManagedTerminationProtection: ecs.ManagedTerminationProtectionEnabled,
+		},
+		Tags: []ecs.Tag{{Key: "team", Value: "platform"}},
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.CreateCapacityProvider")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"name": "workers",
+		"autoScalingGroupProvider": map[string]interface{}{
+			"autoScalingGroupArn": asg,
+			"managedScaling": map[string]interface{}{
+				"status":         "ENABLED",
+				"targetCapacity": float64(90),
+			},
+			"managedTerminationProtection": "ENABLED",
+		},
+		"tags": []interface{}{map[string]interface{}{"key": "team", "value": "platform"}},
+	})
+
+	c.Assert(cp.CapacityProviderArn, check.Equals, "arn:aws:ecs:us-east-1:123456789012:capacity-provider/workers")
+	c.Assert(cp.Status, check.Equals, "ACTIVE")
+	c.Assert(cp.AutoScalingGroupProvider.ManagedScaling.MaximumScalingStepSize, check.Equals, 10)
+	c.Assert(cp.AutoScalingGroupProvider.ManagedScaling.InstanceWarmupPeriod, check.Equals, 300)
+}
+
+func (s *S) TestDescribeCapacityProviders(c *check.C) {
+	testServer.Response(200, nil, DescribeCapacityProvidersResponse)
+
+	resp, err := s.ecs.DescribeCapacityProviders([]string{"workers", "missing"}, "")
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.DescribeCapacityProviders")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"capacityProviders": []interface{}{"workers", "missing"},
+	})
+	c.Assert(resp.CapacityProviders, check.HasLen, 1)
+	c.Assert(resp.CapacityProviders[0].AutoScalingGroupProvider.ManagedScaling, check.IsNil)
+	c.Assert(resp.CapacityProviders[0].UpdateStatus, check.Equals, "UPDATE_COMPLETE")
+	c.Assert(resp.Failures, check.DeepEquals, []ecs.Failure{
+		{Arn: "arn:aws:ecs:us-east-1:123456789012:capacity-provider/missing", Reason: "MISSING"},
+	})
+}
+
+func (s *S) TestPutClusterCapacityProviders(c *check.C) {
+	testServer.Response(200, nil, PutClusterCapacityProvidersResponse)
+
+	cluster, err := s.ecs.PutClusterCapacityProviders("default", []string{"workers", ecs.CapacityProviderFargate},
+		[]ecs.CapacityProviderStrategyItem{{CapacityProvider: "workers", Weight: 1, Base: 2}})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.PutClusterCapacityProviders")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"cluster":           "default",
+		"capacityProviders": []interface{}{"workers", "FARGATE"},
+		"defaultCapacityProviderStrategy": []interface{}{
+			map[string]interface{}{"capacityProvider": "workers", "weight": float64(1), "base": float64(2)},
+		},
+	})
+	c.Assert(cluster.CapacityProviders, check.DeepEquals, []string{"workers", "FARGATE"})
+	c.Assert(cluster.DefaultCapacityProviderStrategy[0].Base, check.Equals, 2)
+	c.Assert(cluster.AttachmentsStatus, check.Equals, "UPDATE_IN_PROGRESS")
+}
+
+func (s *S) TestPutClusterCapacityProvidersRemovesAll(c *check.C) {
+	testServer.Response(200, nil, PutClusterCapacityProvidersResponse)
+
+	_, err := s.ecs.PutClusterCapacityProviders("default", nil, nil)
+	_, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"cluster":                         "default",
+		"capacityProviders":               []interface{}{},
+		"defaultCapacityProviderStrategy": []interface{}{},
+	})
+}
+
+func (s *S) TestUpdateServiceCapacityProviderStrategy(c *check.C) {
+	testServer.Response(200, nil, UpdateServiceResponse)
+
+	service, err := s.ecs.UpdateService(&ecs.UpdateServiceRequest{
+		Cluster: "default",
+		Service: "web",
+		CapacityProviderStrategy: []ecs.CapacityProviderStrategyItem{
+			{CapacityProvider: "workers", Weight: 3},
+			{CapacityProvider: ecs.CapacityProviderFargateSpot, Weight: 1},
+		},
+		ForceNewDeployment: true,
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.UpdateService")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"cluster": "default",
+		"service": "web",
+		"capacityProviderStrategy": []interface{}{
+			map[string]interface{}{"capacityProvider": "workers", "weight": float64(3)},
+			map[string]interface{}{"capacityProvider": "FARGATE_SPOT", "weight": float64(1)},
+		},
+		"forceNewDeployment": true,
+	})
+	c.Assert(service.ServiceName, check.Equals, "web")
+	c.Assert(service.CapacityProviderStrategy, check.HasLen, 2)
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(400, nil, ClientErrorResponse)
+
+	_, err := s.ecs.DeleteCapacityProvider("workers")
+	testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.NotNil)
+	ecsErr := err.(*ecs.Error)
+	c.Assert(ecsErr.StatusCode, check.Equals, 400)
+	c.Assert(ecsErr.Code, check.Equals, "ClientException")
+	c.Assert(err, check.ErrorMatches, "ecs: ClientException: The specified capacity provider is in use and cannot be removed.")
+}
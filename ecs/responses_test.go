@@ -0,0 +1,268 @@
+package ecs_test
+
+var CreateCapacityProviderResponse = `
+{
+  "capacityProvider": {
+    "capacityProviderArn": "arn:aws:ecs:us-east-1:123456789012:capacity-provider/workers",
+    "name": "workers",
+    "status": "ACTIVE",
+    "autoScalingGroupProvider": {
+      "autoScalingGroupArn": "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:57ffcb94-11f0-4d6d-bf60-3bac5EXAMPLE:autoScalingGroupName/workers",
+      "managedScaling": {
+        "status": "ENABLED",
+        "targetCapacity": 90,
+        "minimumScalingStepSize": 1,
+        "maximumScalingStepSize": 10,
+        "instanceWarmupPeriod": 300
+      },
+      "managedTerminationProtection": "ENABLED"
+    },
+    "tags": [{"key": "team", "value": "platform"}]
+  }
+}
+`
+
+var DescribeCapacityProvidersResponse = `
+{
+  "capacityProviders": [
+    {
+      "capacityProviderArn": "arn:aws:ecs:us-east-1:123456789012:capacity-provider/workers",
+      "name": "workers",
+      "status": "ACTIVE",
+      "autoScalingGroupProvider": {
+        "autoScalingGroupArn": "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:57ffcb94-11f0-4d6d-bf60-3bac5EXAMPLE:autoScalingGroupName/workers",
+        "managedTerminationProtection": "DISABLED"
+      },
+      "updateStatus": "UPDATE_COMPLETE"
+    }
+  ],
+  "failures": [
+    {"arn": "arn:aws:ecs:us-east-1:123456789012:capacity-provider/missing", "reason": "MISSING"}
+  ]
+}
+`
+
+var PutClusterCapacityProvidersResponse = `
+{
+  "cluster": {
+    "clusterArn": "arn:aws:ecs:us-east-1:123456789012:cluster/default",
+    "clusterName": "default",
+    "status": "ACTIVE",
+    "registeredContainerInstancesCount": 3,
+    "runningTasksCount": 5,
+    "pendingTasksCount": 0,
+    "activeServicesCount": 2,
+    "capacityProviders": ["workers", "FARGATE"],
+    "defaultCapacityProviderStrategy": [
+      {"capacityProvider": "workers", "weight": 1, "base": 2}
+    ],
+    "attachmentsStatus": "UPDATE_IN_PROGRESS"
+  }
+}
+`
+
+var UpdateServiceResponse = `
+{
+  "service": {
+    "serviceArn": "arn:aws:ecs:us-east-1:123456789012:service/default/web",
+    "serviceName": "web",
+    "clusterArn": "arn:aws:ecs:us-east-1:123456789012:cluster/default",
+    "status": "ACTIVE",
+    "taskDefinition": "arn:aws:ecs:us-east-1:123456789012:task-definition/web:7",
+    "desiredCount": 4,
+    "runningCount": 4,
+    "pendingCount": 0,
+    "capacityProviderStrategy": [
+      {"capacityProvider": "workers", "weight": 3},
+      {"capacityProvider": "FARGATE_SPOT", "weight": 1}
+    ]
+  }
+}
+`
+
+var ClientErrorResponse = `
+{
+  "__type": "ClientException",
+  "message": "The specified capacity provider is in use and cannot be removed."
+}
+`
+
+var RunTaskResponse = `
+{
+  "tasks": [
+    {
+      "taskArn": "arn:aws:ecs:us-east-1:123456789012:task/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b",
+      "clusterArn": "arn:aws:ecs:us-east-1:123456789012:cluster/default",
+      "taskDefinitionArn": "arn:aws:ecs:us-east-1:123456789012:task-definition/report:3",
+      "group": "family:report",
+      "launchType": "FARGATE",
+      "lastStatus": "PROVISIONING",
+      "desiredStatus": "RUNNING",
+      "createdAt": 1696250000.123,
+      "containers": [
+        {
+          "containerArn": "arn:aws:ecs:us-east-1:123456789012:container/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b/5ff0d1bb",
+          "taskArn": "arn:aws:ecs:us-east-1:123456789012:task/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b",
+          "name": "report",
+          "image": "123456789012.dkr.ecr.us-east-1.amazonaws.com/report:latest",
+          "lastStatus": "PENDING"
+        }
+      ]
+    }
+  ],
+  "failures": [
+    {
+      "arn": "arn:aws:ecs:us-east-1:123456789012:container-instance/default/0a8b2c40",
+      "reason": "RESOURCE:MEMORY"
+    }
+  ]
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DescribeTasks.html
+var DescribeTasksResponse = `
+{
+  "tasks": [
+    {
+      "taskArn": "arn:aws:ecs:us-east-1:123456789012:task/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b",
+      "clusterArn": "arn:aws:ecs:us-east-1:123456789012:cluster/default",
+      "lastStatus": "%s",
+      "desiredStatus": "STOPPED",
+      "stopCode": "EssentialContainerExited",
+      "stoppedReason": "Essential container in task exited",
+      "stoppedAt": 1696250100.5,
+      "containers": [
+        {"name": "report", "lastStatus": "STOPPED", "exitCode": 0},
+        {"name": "sidecar", "lastStatus": "STOPPED", "exitCode": 137, "reason": "OutOfMemoryError: Container killed due to memory usage"}
+      ]
+    },
+    {
+      "taskArn": "arn:aws:ecs:us-east-1:123456789012:task/default/83c6cd1a1bb54c34b4dfac77cf1a5e3f",
+      "clusterArn": "arn:aws:ecs:us-east-1:123456789012:cluster/default",
+      "lastStatus": "STOPPED",
+      "desiredStatus": "STOPPED",
+      "stopCode": "TaskFailedToStart",
+      "stoppedReason": "CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+      "containers": [
+        {"name": "report", "lastStatus": "STOPPED", "reason": "CannotPullContainerError"}
+      ]
+    }
+  ],
+  "failures": []
+}
+`
+
+var DescribeTasksMissingResponse = `
+{
+  "tasks": [],
+  "failures": [
+    {
+      "arn": "arn:aws:ecs:us-east-1:123456789012:task/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b",
+      "reason": "MISSING"
+    }
+  ]
+}
+`
+
+var StopTaskResponse = `
+{
+  "task": {
+    "taskArn": "arn:aws:ecs:us-east-1:123456789012:task/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b",
+    "lastStatus": "RUNNING",
+    "desiredStatus": "STOPPED",
+    "stoppedReason": "cancelled by job runner"
+  }
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_RegisterTaskDefinition.html
+var RegisterTaskDefinitionResponse = `
+{
+  "taskDefinition": {
+    "taskDefinitionArn": "arn:aws:ecs:us-east-1:123456789012:task-definition/web:8",
+    "family": "web",
+    "revision": 8,
+    "status": "ACTIVE",
+    "networkMode": "awsvpc",
+    "requiresCompatibilities": ["FARGATE"],
+    "cpu": "256",
+    "memory": "512",
+    "executionRoleArn": "arn:aws:iam::123456789012:role/ecsTaskExecutionRole",
+    "registeredAt": 1696250000.5,
+    "containerDefinitions": [
+      {
+        "name": "web",
+        "image": "nginx:1.25",
+        "essential": true,
+        "portMappings": [{"containerPort": 80, "hostPort": 80, "protocol": "tcp"}]
+      }
+    ]
+  },
+  "tags": []
+}
+`
+
+var CreateServiceResponse = `
+{
+  "service": {
+    "serviceArn": "arn:aws:ecs:us-east-1:123456789012:service/default/web",
+    "serviceName": "web",
+    "clusterArn": "arn:aws:ecs:us-east-1:123456789012:cluster/default",
+    "status": "ACTIVE",
+    "taskDefinition": "arn:aws:ecs:us-east-1:123456789012:task-definition/web:8",
+    "desiredCount": 2,
+    "runningCount": 0,
+    "pendingCount": 0,
+    "launchType": "FARGATE",
+    "deploymentConfiguration": {
+      "maximumPercent": 200,
+      "minimumHealthyPercent": 100,
+      "deploymentCircuitBreaker": {"enable": true, "rollback": true}
+    },
+    "deployments": [
+      {
+        "id": "ecs-svc/9223370478564824999",
+        "status": "PRIMARY",
+        "taskDefinition": "arn:aws:ecs:us-east-1:123456789012:task-definition/web:8",
+        "desiredCount": 2,
+        "runningCount": 0,
+        "pendingCount": 0,
+        "rolloutState": "IN_PROGRESS",
+        "rolloutStateReason": "ECS deployment ecs-svc/9223370478564824999 in progress."
+      }
+    ],
+    "events": [],
+    "createdAt": 1696250000.5
+  }
+}
+`
+
+// http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DescribeServices.html
+var DescribeServicesResponse = `
+{
+  "services": [
+    {
+      "serviceName": "web",
+      "status": "%s",
+      "desiredCount": 2,
+      "runningCount": %d,
+      "deployments": [
+        {"id": "ecs-svc/2", "status": "PRIMARY", "desiredCount": 2, "runningCount": 2, "rolloutState": "COMPLETED"}%s
+      ],
+      "events": [
+        {"id": "5d8a5b1e", "createdAt": 1696250100.5, "message": "(service web) has reached a steady state."}
+      ]
+    }
+  ],
+  "failures": []
+}
+`
+
+var DescribeServicesMissingResponse = `
+{
+  "services": [],
+  "failures": [
+    {"arn": "arn:aws:ecs:us-east-1:123456789012:service/default/api", "reason": "MISSING"}
+  ]
+}
+`
@@ -0,0 +1,216 @@
+package ecs
+
+// Statuses of a service. A deleted service is DRAINING until its tasks
+// are stopped, and INACTIVE afterwards.
+const (
+	ServiceStatusActive   = "ACTIVE"
+	ServiceStatusDraining = "DRAINING"
+	ServiceStatusInactive = "INACTIVE"
+)
+
+// Statuses of a deployment of a service. The PRIMARY deployment is the
+// most recent one; the ACTIVE ones still have tasks being replaced.
+const (
+	DeploymentStatusPrimary  = "PRIMARY"
+	DeploymentStatusActive   = "ACTIVE"
+	DeploymentStatusInactive = "INACTIVE"
+)
+
+// The most services DescribeServices describes at once.
+const MaxDescribeServiceCount = 10
+
+// Deployment is a rollout of a task definition to a service.
+// RolloutState is "IN_PROGRESS", "COMPLETED" or "FAILED" for services with
+// the ECS deployment controller. Dates are in seconds since the epoch.
+type Deployment struct {
+	Id                 string  `json:"id"`
+	Status             string  `json:"status"`
+	TaskDefinition     string  `json:"taskDefinition"`
+	DesiredCount       int     `json:"desiredCount"`
+	RunningCount       int     `json:"runningCount"`
+	PendingCount       int     `json:"pendingCount"`
+	FailedTasks        int     `json:"failedTasks"`
+	RolloutState       string  `json:"rolloutState"`
+	RolloutStateReason string  `json:"rolloutStateReason"`
+	CreatedAt          float64 `json:"createdAt"`
+	UpdatedAt          float64 `json:"updatedAt"`
+}
+
+// ServiceEvent is a message of the scheduler about a service, such as a
+// task that failed to be placed.
+type ServiceEvent struct {
+	Id        string  `json:"id"`
+	CreatedAt float64 `json:"createdAt"`
+	Message   string  `json:"message"`
+}
+
+// Service is returned by the service actions. Events holds the most recent
+// events first.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_Service.html
+type Service struct {
+	ServiceArn               string                         `json:"serviceArn"`
+	ServiceName              string                         `json:"serviceName"`
+	ClusterArn               string                         `json:"clusterArn"`
+	Status                   string                         `json:"status"`
+	TaskDefinition           string                         `json:"taskDefinition"`
+	DesiredCount             int                            `json:"desiredCount"`
+	RunningCount             int                            `json:"runningCount"`
+	PendingCount             int                            `json:"pendingCount"`
+	LaunchType               string                         `json:"launchType"`
+	CapacityProviderStrategy []CapacityProviderStrategyItem `json:"capacityProviderStrategy"`
+	DeploymentConfiguration  *DeploymentConfiguration       `json:"deploymentConfiguration"`
+	Deployments              []Deployment                   `json:"deployments"`
+	Events                   []ServiceEvent                 `json:"events"`
+	LoadBalancers            []LoadBalancer                 `json:"loadBalancers"`
+	NetworkConfiguration     *NetworkConfiguration          `json:"networkConfiguration"`
+	CreatedAt                float64                        `json:"createdAt"`
+}
+
+// Stable reports whether the service has a single deployment, whose tasks
+// are all running.
+func (s *Service) Stable() bool {
+	return len(s.Deployments) == 1 && s.RunningCount == s.DesiredCount
+}
+
+// DeploymentCircuitBreaker stops a deployment whose tasks keep failing to
+// start, and with Rollback rolls the service back to its last completed
+// deployment.
+type DeploymentCircuitBreaker struct {
+	Enable   bool `json:"enable"`
+	Rollback bool `json:"rollback"`
+}
+
+// DeploymentConfiguration bounds the number of tasks of a service during a
+// deployment, in percent of its desired count.
+type DeploymentConfiguration struct {
+	MaximumPercent           int                       `json:"maximumPercent,omitempty"`
+	MinimumHealthyPercent    int                       `json:"minimumHealthyPercent,omitempty"`
+	DeploymentCircuitBreaker *DeploymentCircuitBreaker `json:"deploymentCircuitBreaker,omitempty"`
+}
+
+// LoadBalancer registers a container port of the tasks of a service with
+// an Elastic Load Balancing target group.
+type LoadBalancer struct {
+	TargetGroupArn   string `json:"targetGroupArn,omitempty"`
+	LoadBalancerName string `json:"loadBalancerName,omitempty"`
+	ContainerName    string `json:"containerName"`
+	ContainerPort    int    `json:"containerPort"`
+}
+
+// CreateServiceRequest holds the parameters of CreateService.
+// LaunchType and CapacityProviderStrategy are exclusive.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html
+type CreateServiceRequest struct {
+	Cluster                       string                         `json:"cluster,omitempty"`
+	ServiceName                   string                         `json:"serviceName"`
+	TaskDefinition                string                         `json:"taskDefinition"`
+	DesiredCount                  int                            `json:"desiredCount"`
+	LaunchType                    string                         `json:"launchType,omitempty"`
+	CapacityProviderStrategy      []CapacityProviderStrategyItem `json:"capacityProviderStrategy,omitempty"`
+	PlatformVersion               string                         `json:"platformVersion,omitempty"`
+	DeploymentConfiguration       *DeploymentConfiguration       `json:"deploymentConfiguration,omitempty"`
+	LoadBalancers                 []LoadBalancer                 `json:"loadBalancers,omitempty"`
+	HealthCheckGracePeriodSeconds int                            `json:"healthCheckGracePeriodSeconds,omitempty"`
+	NetworkConfiguration          *NetworkConfiguration          `json:"networkConfiguration,omitempty"`
+	PropagateTags                 string                         `json:"propagateTags,omitempty"`
+	Tags                          []Tag                          `json:"tags,omitempty"`
+}
+
+// CreateService creates a service, which keeps DesiredCount tasks of a
+// task definition running.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html
+func (e *ECS) CreateService(req *CreateServiceRequest) (*Service, error) {
+	resp := new(serviceResponse)
+	if err := e.query("CreateService", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Service, nil
+}
+
+// UpdateServiceRequest holds the parameters of UpdateService. Fields left
+// empty are not changed.
+//
+// Moving a service to a capacity provider strategy, or between strategies,
+// requires ForceNewDeployment.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html
+type UpdateServiceRequest struct {
+	Cluster                  string                         `json:"cluster,omitempty"`
+	Service                  string                         `json:"service"`
+	DesiredCount             *int                           `json:"desiredCount,omitempty"`
+	TaskDefinition           string                         `json:"taskDefinition,omitempty"`
+	CapacityProviderStrategy []CapacityProviderStrategyItem `json:"capacityProviderStrategy,omitempty"`
+	DeploymentConfiguration  *DeploymentConfiguration       `json:"deploymentConfiguration,omitempty"`
+	ForceNewDeployment       bool                           `json:"forceNewDeployment,omitempty"`
+}
+
+type serviceResponse struct {
+	Service Service `json:"service"`
+}
+
+// UpdateService changes the settings of a service.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html
+func (e *ECS) UpdateService(req *UpdateServiceRequest) (*Service, error) {
+	resp := new(serviceResponse)
+	if err := e.query("UpdateService", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Service, nil
+}
+
+type describeServicesRequest struct {
+	Cluster  string   `json:"cluster,omitempty"`
+	Services []string `json:"services"`
+}
+
+// DescribeServicesResp is the response of DescribeServices. Failures holds
+// the services not found.
+type DescribeServicesResp struct {
+	Services []Service `json:"services"`
+	Failures []Failure `json:"failures"`
+}
+
+// DescribeServices describes services of a cluster, given by name or ARN.
+// More than MaxDescribeServiceCount services are described in several
+// requests.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DescribeServices.html
+func (e *ECS) DescribeServices(cluster string, services []string) (*DescribeServicesResp, error) {
+	resp := new(DescribeServicesResp)
+	for len(services) > 0 {
+		n := len(services)
+		if n > MaxDescribeServiceCount {
+			n = MaxDescribeServiceCount
+		}
+		var page DescribeServicesResp
+		if err := e.query("DescribeServices", &describeServicesRequest{cluster, services[:n]}, &page); err != nil {
+			return nil, err
+		}
+		resp.Services = append(resp.Services, page.Services...)
+		resp.Failures = append(resp.Failures, page.Failures...)
+		services = services[n:]
+	}
+	return resp, nil
+}
+
+type deleteServiceRequest struct {
+	Cluster string `json:"cluster,omitempty"`
+	Service string `json:"service"`
+	Force   bool   `json:"force,omitempty"`
+}
+
+// DeleteService deletes a service. Unless force is true, the desired
+// count of the service must first be set to 0 with UpdateService.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DeleteService.html
+func (e *ECS) DeleteService(cluster, service string, force bool) (*Service, error) {
+	resp := new(serviceResponse)
+	if err := e.query("DeleteService", &deleteServiceRequest{cluster, service, force}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Service, nil
+}
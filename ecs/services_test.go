@@ -0,0 +1,175 @@
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/ecs"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestRegisterTaskDefinition(c *check.C) {
+	testServer.Response(200, nil, RegisterTaskDefinitionResponse)
+
+	essential := true
+	td, err := s.ecs.RegisterTaskDefinition(&ecs.RegisterTaskDefinitionRequest{
+		Family: "web",
+		ContainerDefinitions: []ecs.ContainerDefinition{{
+			Name:         "web",
+			Image:        "nginx:1.25",
+			Essential:    &essential,
+			PortMappings: []ecs.PortMapping{{ContainerPort: 80}},
+			LogConfiguration: &ecs.LogConfiguration{
+				LogDriver: "awslogs",
+				Options:   map[string]string{"awslogs-group": "/ecs/web"},
+			},
+		}},
+		ExecutionRoleArn:        "arn:aws:iam::123456789012:role/ecsTaskExecutionRole",
+		NetworkMode:             ecs.NetworkModeAwsVpc,
+		RequiresCompatibilities: []string{ecs.LaunchTypeFargate},
+		Cpu:                     "256",
+		Memory:                  "512",
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.RegisterTaskDefinition")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"family": "web",
+		"containerDefinitions": []interface{}{map[string]interface{}{
+			"name":         "web",
+			"image":        "nginx:1.25",
+			"essential":    true,
+			"portMappings": []interface{}{map[string]interface{}{"containerPort": float64(80)}},
+			"logConfiguration": map[string]interface{}{
+				"logDriver": "awslogs",
+				"options":   map[string]interface{}{"awslogs-group": "/ecs/web"},
+			},
+		}},
+		"executionRoleArn":        "arn:aws:iam::123456789012:role/ecsTaskExecutionRole",
+		"networkMode":             "awsvpc",
+		"requiresCompatibilities": []interface{}{"FARGATE"},
+		"cpu":                     "256",
+		"memory":                  "512",
+	})
+
+	c.Assert(td.TaskDefinitionArn, check.Equals, "arn:aws:ecs:us-east-1:123456789012:task-definition/web:8")
+	c.Assert(td.Revision, check.Equals, 8)
+	c.Assert(td.Status, check.Equals, "ACTIVE")
+	c.Assert(td.ContainerDefinitions[0].PortMappings, check.DeepEquals, []ecs.PortMapping{{ContainerPort: 80, HostPort: 80, Protocol: "tcp"}})
+}
+
+func (s *S) TestDescribeTaskDefinition(c *check.C) {
+	testServer.Response(200, nil, RegisterTaskDefinitionResponse)
+
+	td, err := s.ecs.DescribeTaskDefinition("web")
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.DescribeTaskDefinition")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"taskDefinition": "web"})
+	c.Assert(td.Family, check.Equals, "web")
+}
+
+func (s *S) TestCreateService(c *check.C) {
+	testServer.Response(200, nil, CreateServiceResponse)
+
+	svc, err := s.ecs.CreateService(&ecs.CreateServiceRequest{
+		Cluster:        "default",
+		ServiceName:    "web",
+		TaskDefinition: "web:8",
+		DesiredCount:   2,
+		LaunchType:     ecs.LaunchTypeFargate,
+		DeploymentConfiguration: &ecs.DeploymentConfiguration{
+			MinimumHealthyPercent:    100,
+			DeploymentCircuitBreaker: &ecs.DeploymentCircuitBreaker{Enable: true, Rollback: true},
+		},
+		LoadBalancers: []ecs.LoadBalancer{{
+			TargetGroupArn: "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067",
+			ContainerName:  "web",
+			ContainerPort:  80,
+		}},
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.CreateService")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"cluster":        "default",
+		"serviceName":    "web",
+		"taskDefinition": "web:8",
+		"desiredCount":   float64(2),
+		"launchType":     "FARGATE",
+		"deploymentConfiguration": map[string]interface{}{
+			"minimumHealthyPercent":    float64(100),
+			"deploymentCircuitBreaker": map[string]interface{}{"enable": true, "rollback": true},
+		},
+		"loadBalancers": []interface{}{map[string]interface{}{
+			"targetGroupArn": "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/73e2d6bc24d8a067",
+			"containerName":  "web",
+			"containerPort":  float64(80),
+		}},
+	})
+
+	c.Assert(svc.ServiceName, check.Equals, "web")
+	c.Assert(svc.DeploymentConfiguration.MaximumPercent, check.Equals, 200)
+	c.Assert(svc.Deployments, check.HasLen, 1)
+	c.Assert(svc.Deployments[0].Status, check.Equals, ecs.DeploymentStatusPrimary)
+	c.Assert(svc.Deployments[0].RolloutState, check.Equals, "IN_PROGRESS")
+	c.Assert(svc.Stable(), check.Equals, false)
+}
+
+func (s *S) TestDeleteService(c *check.C) {
+	testServer.Response(200, nil, CreateServiceResponse)
+
+	_, err := s.ecs.DeleteService("default", "web", true)
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.DeleteService")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"cluster": "default", "service": "web", "force": true})
+}
+
+func (s *S) TestDescribeServices(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeServicesResponse, "ACTIVE", 2, ""))
+
+	resp, err := s.ecs.DescribeServices("default", []string{"web"})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.DescribeServices")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"cluster": "default", "services": []interface{}{"web"}})
+	c.Assert(resp.Services, check.HasLen, 1)
+	c.Assert(resp.Services[0].Events[0].Message, check.Equals, "(service web) has reached a steady state.")
+	c.Assert(resp.Services[0].Stable(), check.Equals, true)
+}
+
+func (s *S) TestWaitUntilServicesStable(c *check.C) {
+	oldDeployment := `, {"id": "ecs-svc/1", "status": "ACTIVE", "desiredCount": 0, "runningCount": 2}`
+	testServer.Response(200, nil, fmt.Sprintf(DescribeServicesResponse, "ACTIVE", 4, oldDeployment))
+	testServer.Response(200, nil, fmt.Sprintf(DescribeServicesResponse, "ACTIVE", 1, ""))
+	testServer.Response(200, nil, fmt.Sprintf(DescribeServicesResponse, "ACTIVE", 2, ""))
+
+	opts := &ecs.WaitOptions{Interval: time.Millisecond}
+	err := s.ecs.WaitUntilServicesStable(context.Background(), opts, "default", "web")
+	c.Assert(err, check.IsNil)
+	c.Assert(testServer.WaitRequests(3), check.HasLen, 3)
+}
+
+func (s *S) TestWaitUntilServicesStableDraining(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeServicesResponse, "DRAINING", 1, ""))
+
+	opts := &ecs.WaitOptions{Interval: time.Millisecond}
+	err := s.ecs.WaitUntilServicesStable(context.Background(), opts, "default", "web")
+	c.Assert(err, check.DeepEquals, &ecs.ServiceStatusError{Service: "web", Status: "DRAINING"})
+	c.Assert(err, check.ErrorMatches, "ecs: service web is DRAINING while waiting for it to be stable")
+}
+
+func (s *S) TestWaitUntilServicesStableMissing(c *check.C) {
+	testServer.Response(200, nil, DescribeServicesMissingResponse)
+
+	opts := &ecs.WaitOptions{Interval: time.Millisecond}
+	err := s.ecs.WaitUntilServicesStable(context.Background(), opts, "default", "api")
+	c.Assert(err, check.ErrorMatches, "ecs: service arn:aws:ecs:us-east-1:123456789012:service/default/api is MISSING .*")
+}
@@ -0,0 +1,138 @@
+package ecs
+
+// Network modes of a task definition. Fargate tasks use NetworkModeAwsVpc.
+const (
+	NetworkModeBridge = "bridge"
+	NetworkModeHost   = "host"
+	NetworkModeAwsVpc = "awsvpc"
+	NetworkModeNone   = "none"
+)
+
+// PortMapping exposes a port of a container. HostPort may be 0 in bridge
+// mode for a dynamic port, and must equal ContainerPort in awsvpc mode.
+type PortMapping struct {
+	ContainerPort int    `json:"containerPort"`
+	HostPort      int    `json:"hostPort,omitempty"`
+	Protocol      string `json:"protocol,omitempty"` // "tcp" or "udp"
+}
+
+// LogConfiguration sets where the output of a container goes, such as
+// LogDriver "awslogs" with the options "awslogs-group",
+// "awslogs-region" and "awslogs-stream-prefix".
+type LogConfiguration struct {
+	LogDriver string            `json:"logDriver"`
+	Options   map[string]string `json:"options,omitempty"`
+}
+
+// Secret is an environment variable of a container taken from a Secrets
+// Manager secret or SSM parameter, given by ARN in ValueFrom.
+type Secret struct {
+	Name      string `json:"name"`
+	ValueFrom string `json:"valueFrom"`
+}
+
+// ContainerDefinition describes a container of a task definition. Cpu is
+// in CPU units, of which a vCPU has 1024, and Memory and MemoryReservation
+// are in MiB. Essential defaults to true; the task stops when an essential
+// container stops.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_ContainerDefinition.html
+type ContainerDefinition struct {
+	Name              string            `json:"name"`
+	Image             string            `json:"image"`
+	Cpu               int               `json:"cpu,omitempty"`
+	Memory            int               `json:"memory,omitempty"`
+	MemoryReservation int               `json:"memoryReservation,omitempty"`
+	Essential         *bool             `json:"essential,omitempty"`
+	EntryPoint        []string          `json:"entryPoint,omitempty"`
+	Command           []string          `json:"command,omitempty"`
+	WorkingDirectory  string            `json:"workingDirectory,omitempty"`
+	Environment       []KeyValuePair    `json:"environment,omitempty"`
+	Secrets           []Secret          `json:"secrets,omitempty"`
+	PortMappings      []PortMapping     `json:"portMappings,omitempty"`
+	LogConfiguration  *LogConfiguration `json:"logConfiguration,omitempty"`
+}
+
+// RegisterTaskDefinitionRequest holds the parameters of
+// RegisterTaskDefinition. Cpu and Memory are the task size, such as "256"
+// and "512" or "1 vCPU" and "2 GB"; they are required for Fargate, along
+// with NetworkModeAwsVpc and an ExecutionRoleArn to pull images and write
+// logs with.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_RegisterTaskDefinition.html
+type RegisterTaskDefinitionRequest struct {
+	Family                  string                `json:"family"`
+	ContainerDefinitions    []ContainerDefinition `json:"containerDefinitions"`
+	TaskRoleArn             string                `json:"taskRoleArn,omitempty"`
+	ExecutionRoleArn        string                `json:"executionRoleArn,omitempty"`
+	NetworkMode             string                `json:"networkMode,omitempty"`
+	RequiresCompatibilities []string              `json:"requiresCompatibilities,omitempty"`
+	Cpu                     string                `json:"cpu,omitempty"`
+	Memory                  string                `json:"memory,omitempty"`
+	Tags                    []Tag                 `json:"tags,omitempty"`
+}
+
+// TaskDefinition describes a revision of a task definition family. Status
+// is "ACTIVE", or "INACTIVE" once deregistered.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_TaskDefinition.html
+type TaskDefinition struct {
+	TaskDefinitionArn       string                `json:"taskDefinitionArn"`
+	Family                  string                `json:"family"`
+	Revision                int                   `json:"revision"`
+	Status                  string                `json:"status"`
+	ContainerDefinitions    []ContainerDefinition `json:"containerDefinitions"`
+	TaskRoleArn             string                `json:"taskRoleArn"`
+	ExecutionRoleArn        string                `json:"executionRoleArn"`
+	NetworkMode             string                `json:"networkMode"`
+	RequiresCompatibilities []string              `json:"requiresCompatibilities"`
+	Cpu                     string                `json:"cpu"`
+	Memory                  string                `json:"memory"`
+	RegisteredAt            float64               `json:"registeredAt"`
+}
+
+type taskDefinitionResponse struct {
+	TaskDefinition TaskDefinition `json:"taskDefinition"`
+	Tags           []Tag          `json:"tags"`
+}
+
+// RegisterTaskDefinition registers a new revision of a task definition
+// family, creating the family if needed.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_RegisterTaskDefinition.html
+func (e *ECS) RegisterTaskDefinition(req *RegisterTaskDefinitionRequest) (*TaskDefinition, error) {
+	resp := new(taskDefinitionResponse)
+	if err := e.query("RegisterTaskDefinition", req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.TaskDefinition, nil
+}
+
+type taskDefinitionRequest struct {
+	TaskDefinition string `json:"taskDefinition"`
+}
+
+// DescribeTaskDefinition describes a task definition, given by ARN, by
+// "family:revision", or by family for its latest active revision.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DescribeTaskDefinition.html
+func (e *ECS) DescribeTaskDefinition(taskDefinition string) (*TaskDefinition, error) {
+	resp := new(taskDefinitionResponse)
+	if err := e.query("DescribeTaskDefinition", &taskDefinitionRequest{taskDefinition}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.TaskDefinition, nil
+}
+
+// DeregisterTaskDefinition marks a revision of a task definition
+// inactive. Running tasks and services are not affected, but no new tasks
+// or services can use it.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DeregisterTaskDefinition.html
+func (e *ECS) DeregisterTaskDefinition(taskDefinition string) (*TaskDefinition, error) {
+	resp := new(taskDefinitionResponse)
+	if err := e.query("DeregisterTaskDefinition", &taskDefinitionRequest{taskDefinition}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.TaskDefinition, nil
+}
@@ -0,0 +1,255 @@
+package ecs
+
+// Statuses of tasks and containers.
+const (
+	TaskStatusProvisioning   = "PROVISIONING"
+	TaskStatusPending        = "PENDING"
+	TaskStatusActivating     = "ACTIVATING"
+	TaskStatusRunning        = "RUNNING"
+	TaskStatusDeactivating   = "DEACTIVATING"
+	TaskStatusStopping       = "STOPPING"
+	TaskStatusDeprovisioning = "DEPROVISIONING"
+	TaskStatusStopped        = "STOPPED"
+)
+
+// Launch types of tasks and services.
+const (
+	LaunchTypeEC2      = "EC2"
+	LaunchTypeFargate  = "FARGATE"
+	LaunchTypeExternal = "EXTERNAL"
+)
+
+// The most tasks RunTask starts, and DescribeTasks describes, at once.
+const (
+	MaxRunTaskCount      = 10
+	MaxDescribeTaskCount = 100
+)
+
+type KeyValuePair struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// ContainerOverride changes the command or environment of a container of
+// the task definition for the tasks started by RunTask.
+type ContainerOverride struct {
+	Name        string         `json:"name"`
+	Command     []string       `json:"command,omitempty"`
+	Environment []KeyValuePair `json:"environment,omitempty"`
+}
+
+type TaskOverride struct {
+	ContainerOverrides []ContainerOverride `json:"containerOverrides,omitempty"`
+	TaskRoleArn        string              `json:"taskRoleArn,omitempty"`
+}
+
+// AwsVpcConfiguration places the tasks of network mode awsvpc, such as all
+// Fargate tasks. AssignPublicIp is "ENABLED" or "DISABLED".
+type AwsVpcConfiguration struct {
+	Subnets        []string `json:"subnets"`
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	AssignPublicIp string   `json:"assignPublicIp,omitempty"`
+}
+
+type NetworkConfiguration struct {
+	AwsVpcConfiguration *AwsVpcConfiguration `json:"awsvpcConfiguration,omitempty"`
+}
+
+// RunTaskRequest holds the parameters of RunTask. Only TaskDefinition is
+// required; Count is from 1 to MaxRunTaskCount and defaults to 1.
+// LaunchType and CapacityProviderStrategy are exclusive.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_RunTask.html
+type RunTaskRequest struct {
+	Cluster                  string                         `json:"cluster,omitempty"`
+	TaskDefinition           string                         `json:"taskDefinition"`
+	Count                    int                            `json:"count,omitempty"`
+	LaunchType               string                         `json:"launchType,omitempty"`
+	CapacityProviderStrategy []CapacityProviderStrategyItem `json:"capacityProviderStrategy,omitempty"`
+	PlatformVersion          string                         `json:"platformVersion,omitempty"`
+	Group                    string                         `json:"group,omitempty"`
+	StartedBy                string                         `json:"startedBy,omitempty"`
+	Overrides                *TaskOverride                  `json:"overrides,omitempty"`
+	NetworkConfiguration     *NetworkConfiguration          `json:"networkConfiguration,omitempty"`
+	PropagateTags            string                         `json:"propagateTags,omitempty"` // "TASK_DEFINITION" or "SERVICE"
+	Tags                     []Tag                          `json:"tags,omitempty"`
+}
+
+// Container describes a container of a task. ExitCode is nil until the
+// container exits, and stays nil if it never started or was killed by ECS;
+// Reason then tells why.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_Container.html
+type Container struct {
+	ContainerArn string `json:"containerArn"`
+	TaskArn      string `json:"taskArn"`
+	Name         string `json:"name"`
+	Image        string `json:"image"`
+	LastStatus   string `json:"lastStatus"`
+	ExitCode     *int   `json:"exitCode"`
+	Reason       string `json:"reason"`
+	HealthStatus string `json:"healthStatus"`
+}
+
+// Task describes a task. StopCode, such as "EssentialContainerExited" or
+// "TaskFailedToStart", and StoppedReason tell why a stopped task stopped.
+// Dates are in seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_Task.html
+type Task struct {
+	TaskArn           string      `json:"taskArn"`
+	ClusterArn        string      `json:"clusterArn"`
+	TaskDefinitionArn string      `json:"taskDefinitionArn"`
+	Group             string      `json:"group"`
+	StartedBy         string      `json:"startedBy"`
+	LaunchType        string      `json:"launchType"`
+	LastStatus        string      `json:"lastStatus"`
+	DesiredStatus     string      `json:"desiredStatus"`
+	CreatedAt         float64     `json:"createdAt"`
+	StartedAt         float64     `json:"startedAt"`
+	StoppedAt         float64     `json:"stoppedAt"`
+	StopCode          string      `json:"stopCode"`
+	StoppedReason     string      `json:"stoppedReason"`
+	Containers        []Container `json:"containers"`
+	Tags              []Tag       `json:"tags"`
+}
+
+// TasksResp is the response of RunTask and DescribeTasks. The Failures of
+// RunTask are the tasks that couldn't be placed, such as for lack of
+// resources; those of DescribeTasks the tasks not found.
+type TasksResp struct {
+	Tasks    []Task    `json:"tasks"`
+	Failures []Failure `json:"failures"`
+}
+
+// RunTask starts tasks from a task definition.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_RunTask.html
+func (e *ECS) RunTask(req *RunTaskRequest) (resp *TasksResp, err error) {
+	resp = new(TasksResp)
+	if err := e.query("RunTask", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type describeTasksRequest struct {
+	Cluster string   `json:"cluster,omitempty"`
+	Tasks   []string `json:"tasks"`
+}
+
+// DescribeTasks describes tasks of a cluster, given by ARN or ID. More
+// than MaxDescribeTaskCount tasks are described in several requests.
+// Stopped tasks can be described for about an hour. cluster may be "" for
+// the default cluster.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_DescribeTasks.html
+func (e *ECS) DescribeTasks(cluster string, taskArns []string) (*TasksResp, error) {
+	resp := new(TasksResp)
+	for len(taskArns) > 0 {
+		n := len(taskArns)
+		if n > MaxDescribeTaskCount {
+			n = MaxDescribeTaskCount
+		}
+		var page TasksResp
+		if err := e.query("DescribeTasks", &describeTasksRequest{cluster, taskArns[:n]}, &page); err != nil {
+			return nil, err
+		}
+		resp.Tasks = append(resp.Tasks, page.Tasks...)
+		resp.Failures = append(resp.Failures, page.Failures...)
+		taskArns = taskArns[n:]
+	}
+	return resp, nil
+}
+
+type stopTaskRequest struct {
+	Cluster string `json:"cluster,omitempty"`
+	Task    string `json:"task"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+type stopTaskResponse struct {
+	Task Task `json:"task"`
+}
+
+// StopTask stops a running task. reason, which may be "", is reported as
+// the StoppedReason of the task.
+//
+// See http://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_StopTask.html
+func (e *ECS) StopTask(cluster, taskArn, reason string) (*Task, error) {
+	resp := new(stopTaskResponse)
+	if err := e.query("StopTask", &stopTaskRequest{cluster, taskArn, reason}, resp); err != nil {
+		return nil, err
+	}
+	return &resp.Task, nil
+}
+
+// ContainerExit is the outcome of a container of a stopped task, along
+// with why its task stopped.
+type ContainerExit struct {
+	TaskArn       string
+	ContainerName string
+	ExitCode      *int
+	Reason        string
+	StopCode      string
+	StoppedReason string
+}
+
+// Succeeded reports whether the container exited with code 0.
+func (c *ContainerExit) Succeeded() bool {
+	return c.ExitCode != nil && *c.ExitCode == 0
+}
+
+// BatchResult holds the outcome of a batch of tasks, such as those started
+// by one or more RunTask calls: the exit of each of their containers, and
+// the tasks that failed to be placed or described.
+type BatchResult struct {
+	Exits    []ContainerExit
+	Failures []Failure
+}
+
+// CollectExits gathers the container exits of tasks, which should be
+// stopped, and the given failures.
+func CollectExits(tasks []Task, failures []Failure) *BatchResult {
+	r := &BatchResult{Failures: failures}
+	for _, t := range tasks {
+		for _, c := range t.Containers {
+			r.Exits = append(r.Exits, ContainerExit{
+				TaskArn:       t.TaskArn,
+				ContainerName: c.Name,
+				ExitCode:      c.ExitCode,
+				Reason:        c.Reason,
+				StopCode:      t.StopCode,
+				StoppedReason: t.StoppedReason,
+			})
+		}
+	}
+	return r
+}
+
+// Failed returns the exits of the containers that didn't exit with code 0.
+func (r *BatchResult) Failed() []ContainerExit {
+	var failed []ContainerExit
+	for _, exit := range r.Exits {
+		if !exit.Succeeded() {
+			failed = append(failed, exit)
+		}
+	}
+	return failed
+}
+
+// Succeeded reports whether every container exited with code 0 and no
+// task failed to be placed or described.
+func (r *BatchResult) Succeeded() bool {
+	return len(r.Failures) == 0 && len(r.Failed()) == 0
+}
+
+// BatchResult describes tasks that should be stopped, such as after
+// WaitUntilTasksStopped, and collects the exits of their containers.
+func (e *ECS) BatchResult(cluster string, taskArns []string) (*BatchResult, error) {
+	resp, err := e.DescribeTasks(cluster, taskArns)
+	if err != nil {
+		return nil, err
+	}
+	return CollectExits(resp.Tasks, resp.Failures), nil
+}
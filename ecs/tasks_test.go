@@ -0,0 +1,171 @@
+package ecs_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/ecs"
+	"gopkg.in/check.v1"
+)
+
+const (
+	taskArn1 = "arn:aws:ecs:us-east-1:123456789012:task/default/1dc5c17a422548ddb7d1bfd1bb4ffc3b"
+	taskArn2 = "arn:aws:ecs:us-east-1:123456789012:task/default/83c6cd1a1bb54c34b4dfac77cf1a5e3f"
+)
+
+func (s *S) TestRunTask(c *check.C) {
+	testServer.Response(200, nil, RunTaskResponse)
+
+	resp, err := s.ecs.RunTask(&ecs.RunTaskRequest{
+		Cluster:        "default",
+		TaskDefinition: "report:3",
+		Count:          2,
+		LaunchType:     ecs.LaunchTypeFargate,
+		StartedBy:      "job-runner",
+		Overrides: &ecs.TaskOverride{
+			ContainerOverrides: []ecs.ContainerOverride{{
+				Name:        "report",
+				Command:     []string{"run", "--day", "2023-10-02"},
+				Environment: []ecs.KeyValuePair{{Name: "LOG_LEVEL", Value: "debug"}},
+			}},
+		},
+		NetworkConfiguration: &ecs.NetworkConfiguration{
+			AwsVpcConfiguration: &ecs.AwsVpcConfiguration{Subnets: []string{"subnet-12345678"}},
+		},
+	})
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.RunTask")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"cluster":        "default",
+		"taskDefinition": "report:3",
+		"count":          float64(2),
+		"launchType":     "FARGATE",
+		"startedBy":      "job-runner",
+		"overrides": map[string]interface{}{
+			"containerOverrides": []interface{}{map[string]interface{}{
+				"name":        "report",
+				"command":     []interface{}{"run", "--day", "2023-10-02"},
+				"environment": []interface{}{map[string]interface{}{"name": "LOG_LEVEL", "value": "debug"}},
+			}},
+		},
+		"networkConfiguration": map[string]interface{}{
+			"awsvpcConfiguration": map[string]interface{}{"subnets": []interface{}{"subnet-12345678"}},
+		},
+	})
+
+	c.Assert(resp.Tasks, check.HasLen, 1)
+	task := resp.Tasks[0]
+	c.Assert(task.TaskArn, check.Equals, taskArn1)
+	c.Assert(task.LastStatus, check.Equals, ecs.TaskStatusProvisioning)
+	c.Assert(task.LaunchType, check.Equals, ecs.LaunchTypeFargate)
+	c.Assert(task.Containers, check.HasLen, 1)
+	c.Assert(task.Containers[0].Name, check.Equals, "report")
+	c.Assert(task.Containers[0].ExitCode, check.IsNil)
+	c.Assert(resp.Failures, check.DeepEquals, []ecs.Failure{{
+		Arn:    "arn:aws:ecs:us-east-1:123456789012:container-instance/default/0a8b2c40",
+		Reason: "RESOURCE:MEMORY",
+	}})
+}
+
+func (s *S) TestDescribeTasksPages(c *check.C) {
+	arns := make([]string, 150)
+	for i := range arns {
+		arns[i] = fmt.Sprintf("task-%d", i)
+	}
+	testServer.Response(200, nil, fmt.Sprintf(DescribeTasksResponse, "STOPPED"))
+	testServer.Response(200, nil, DescribeTasksMissingResponse)
+
+	resp, err := s.ecs.DescribeTasks("default", arns)
+	c.Assert(err, check.IsNil)
+
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.DescribeTasks")
+	c.Assert(body["cluster"], check.Equals, "default")
+	c.Assert(body["tasks"], check.HasLen, 100)
+	_, body = testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(body["tasks"], check.HasLen, 50)
+	c.Assert(body["tasks"].([]interface{})[0], check.Equals, "task-100")
+
+	c.Assert(resp.Tasks, check.HasLen, 2)
+	c.Assert(resp.Failures, check.HasLen, 1)
+	c.Assert(resp.Failures[0].Reason, check.Equals, "MISSING")
+}
+
+func (s *S) TestStopTask(c *check.C) {
+	testServer.Response(200, nil, StopTaskResponse)
+
+	task, err := s.ecs.StopTask("default", taskArn1, "cancelled by job runner")
+	target, body := testServer.WaitJSONRequest(c, "ecs")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonEC2ContainerServiceV20141113.StopTask")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"cluster": "default",
+		"task":    taskArn1,
+		"reason":  "cancelled by job runner",
+	})
+	c.Assert(task.DesiredStatus, check.Equals, ecs.TaskStatusStopped)
+}
+
+func (s *S) TestBatchResult(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeTasksResponse, "STOPPED"))
+
+	result, err := s.ecs.BatchResult("default", []string{taskArn1, taskArn2})
+	c.Assert(err, check.IsNil)
+
+	c.Assert(result.Exits, check.HasLen, 3)
+	c.Assert(result.Succeeded(), check.Equals, false)
+	c.Assert(result.Exits[0].TaskArn, check.Equals, taskArn1)
+	c.Assert(result.Exits[0].ContainerName, check.Equals, "report")
+	c.Assert(result.Exits[0].Succeeded(), check.Equals, true)
+
+	failed := result.Failed()
+	c.Assert(failed, check.HasLen, 2)
+	c.Assert(failed[0].ContainerName, check.Equals, "sidecar")
+	c.Assert(*failed[0].ExitCode, check.Equals, 137)
+	c.Assert(failed[0].Reason, check.Equals, "OutOfMemoryError: Container killed due to memory usage")
+	c.Assert(failed[0].StopCode, check.Equals, "EssentialContainerExited")
+	c.Assert(failed[1].TaskArn, check.Equals, taskArn2)
+	c.Assert(failed[1].ExitCode, check.IsNil)
+	c.Assert(failed[1].StopCode, check.Equals, "TaskFailedToStart")
+	c.Assert(failed[1].StoppedReason, check.Matches, "CannotPullContainerError: .*")
+}
+
+func (s *S) TestCollectExits(c *check.C) {
+	zero := 0
+	result := ecs.CollectExits([]ecs.Task{{
+		TaskArn:    taskArn1,
+		Containers: []ecs.Container{{Name: "report", ExitCode: &zero}},
+	}}, nil)
+	c.Assert(result.Succeeded(), check.Equals, true)
+	c.Assert(result.Failed(), check.HasLen, 0)
+
+	result = ecs.CollectExits(nil, []ecs.Failure{{Arn: taskArn2, Reason: "MISSING"}})
+	c.Assert(result.Succeeded(), check.Equals, false)
+}
+
+func (s *S) TestWaitUntilTasksStopped(c *check.C) {
+	testServer.Response(200, nil, DescribeTasksMissingResponse)
+	testServer.Response(200, nil, fmt.Sprintf(DescribeTasksResponse, "DEPROVISIONING"))
+	testServer.Response(200, nil, fmt.Sprintf(DescribeTasksResponse, "STOPPED"))
+
+	opts := &ecs.WaitOptions{Interval: time.Millisecond}
+	err := s.ecs.WaitUntilTasksStopped(context.Background(), opts, "default", taskArn1, taskArn2)
+	c.Assert(err, check.IsNil)
+
+	reqs := testServer.WaitRequests(3)
+	c.Assert(reqs, check.HasLen, 3)
+}
+
+func (s *S) TestWaitUntilTasksStoppedTimeout(c *check.C) {
+	for i := 0; i < 10; i++ {
+		testServer.Response(200, nil, fmt.Sprintf(DescribeTasksResponse, "RUNNING"))
+	}
+
+	opts := &ecs.WaitOptions{Interval: time.Millisecond, Timeout: 5 * time.Millisecond}
+	err := s.ecs.WaitUntilTasksStopped(context.Background(), opts, "default", taskArn1, taskArn2)
+	c.Assert(err, check.Equals, context.DeadlineExceeded)
+}
@@ -0,0 +1,88 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/awsutil"
+)
+
+// The defaults of WaitOptions, as used by the AWS SDKs for their ECS task
+// waiters.
+const (
+	DefaultWaitInterval = 6 * time.Second
+	DefaultWaitTimeout  = 10 * time.Minute
+)
+
+// WaitOptions configures how the WaitUntil methods poll ECS. Zero values
+// use DefaultWaitInterval and DefaultWaitTimeout.
+type WaitOptions = awsutil.WaitOptions
+
+var defaultWait = WaitOptions{Interval: DefaultWaitInterval, Timeout: DefaultWaitTimeout}
+
+// WaitUntilTasksStopped polls ECS until all of the given tasks of a cluster
+// are stopped. Tasks that are not found yet, as may happen right after
+// RunTask, are waited for. Use BatchResult afterwards to get how their
+// containers exited.
+//
+// The wait ends early with ctx's error when ctx is done, and with
+// context.DeadlineExceeded when the timeout of opts is reached.
+func (e *ECS) WaitUntilTasksStopped(ctx context.Context, opts *WaitOptions, cluster string, taskArns ...string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := e.DescribeTasks(cluster, taskArns)
+		if err != nil {
+			return false, err
+		}
+		if len(resp.Tasks) < len(taskArns) {
+			return false, nil
+		}
+		for _, t := range resp.Tasks {
+			if t.LastStatus != TaskStatusStopped {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// ServiceStatusError is returned by WaitUntilServicesStable when a service
+// is missing or being deleted, and so will never be stable.
+type ServiceStatusError struct {
+	Service string
+	Status  string
+}
+
+func (e *ServiceStatusError) Error() string {
+	return fmt.Sprintf("ecs: service %s is %s while waiting for it to be stable", e.Service, e.Status)
+}
+
+// WaitUntilServicesStable polls ECS until all of the given services of a
+// cluster are stable: their latest deployment is the only one left, and
+// runs the desired count of tasks. It fails with a *ServiceStatusError as
+// soon as a service is missing, draining or inactive.
+//
+// The wait ends early with ctx's error when ctx is done, and with
+// context.DeadlineExceeded when the timeout of opts is reached.
+func (e *ECS) WaitUntilServicesStable(ctx context.Context, opts *WaitOptions, cluster string, services ...string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := e.DescribeServices(cluster, services)
+		if err != nil {
+			return false, err
+		}
+		if len(resp.Failures) > 0 {
+			f := resp.Failures[0]
+			return false, &ServiceStatusError{f.Arn, f.Reason}
+		}
+		done := true
+		for _, s := range resp.Services {
+			if s.Status != ServiceStatusActive {
+				return false, &ServiceStatusError{s.ServiceName, s.Status}
+			}
+			if !s.Stable() {
+				done = false
+			}
+		}
+		return done, nil
+	})
+}
@@ -18,11 +18,16 @@ var (
 type ElastiCache struct {
 	aws.Auth
 	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
 }
 
 // DescribeReplicationGroupsResult represents the response
 type DescribeReplicationGroupsResult struct {
-	ReplicationGroups []ReplicationGroup `xml:"DescribeReplicationGroupsResult>ReplicationGroups"`
+	ReplicationGroups []ReplicationGroup `xml:"DescribeReplicationGroupsResult>ReplicationGroups>ReplicationGroup"`
+	Marker            string             `xml:"DescribeReplicationGroupsResult>Marker"`
 }
 
 func (repGroup *ReplicationGroup) GetPrimaryNode() (*PrimaryEndpoint, error) {
@@ -46,22 +51,32 @@ func (repGroup *ReplicationGroup) GetPrimaryNode() (*PrimaryEndpoint, error) {
 // DescribeCacheClustersResult represents the response from a
 // DescribeCacheClusters ElastiCache API call
 type DescribeCacheClustersResult struct {
-	CacheClusters []*CacheCluster `xml:"DescribeCacheClustersResult>CacheClusters"`
+	CacheClusters []*CacheCluster `xml:"DescribeCacheClustersResult>CacheClusters>CacheCluster"`
+	Marker        string          `xml:"DescribeCacheClustersResult>Marker"`
 }
 
 // ReplicationGroup represents a replication group
 type ReplicationGroup struct {
-	Status             string      `xml:"ReplicationGroup>Status"`
-	ReplicationGroupId string      `xml:"ReplicationGroup>ReplicationGroupId"`
-	MemberClusters     []string    `xml:"ReplicationGroup>MemberClusters>ClusterId"`
-	NodeGroups         []NodeGroup `xml:"ReplicationGroup>NodeGroups"`
+	Status                 string      `xml:"Status"`
+	ReplicationGroupId     string      `xml:"ReplicationGroupId"`
+	Description            string      `xml:"Description"`
+	MemberClusters         []string    `xml:"MemberClusters>ClusterId"`
+	NodeGroups             []NodeGroup `xml:"NodeGroups>NodeGroup"`
+	CacheNodeType          string      `xml:"CacheNodeType"`
+	AutomaticFailover      string      `xml:"AutomaticFailover"` // "enabled", "disabled", "enabling" or "disabling"
+	MultiAZ                string      `xml:"MultiAZ"`           // "enabled" or "disabled"
+	ClusterEnabled         bool        `xml:"ClusterEnabled"`
+	ConfigurationEndpoint  *Endpoint   `xml:"ConfigurationEndpoint"`
+	SnapshotRetentionLimit int         `xml:"SnapshotRetentionLimit"`
+	ARN                    string      `xml:"ARN"`
 }
 
 // NodeGroup represents a node group
 type NodeGroup struct {
-	Status           string             `xml:"NodeGroup>Status"`
-	PrimaryEndpoint  PrimaryEndpoint    `xml:"NodeGroup>PrimaryEndpoint"`
-	NodeGroupMembers []*NodeGroupMember `xml:"NodeGroup>NodeGroupMembers>NodeGroupMember"`
+	NodeGroupId      string             `xml:"NodeGroupId"`
+	Status           string             `xml:"Status"`
+	PrimaryEndpoint  PrimaryEndpoint    `xml:"PrimaryEndpoint"`
+	NodeGroupMembers []*NodeGroupMember `xml:"NodeGroupMembers>NodeGroupMember"`
 }
 
 // NodeGroupMember represents an individual node
@@ -87,13 +102,23 @@ type ReadEndpoint struct {
 
 // CacheCluster represents a cache cluster
 type CacheCluster struct {
-	CacheClusterId string       `xml:"CacheCluster>CacheClusterId"`
-	CacheNodes     []*CacheNode `xml:"CacheCluster>CacheNodes"`
+	CacheClusterId            string       `xml:"CacheClusterId"`
+	CacheClusterStatus        string       `xml:"CacheClusterStatus"`
+	CacheNodeType             string       `xml:"CacheNodeType"`
+	Engine                    string       `xml:"Engine"`
+	EngineVersion             string       `xml:"EngineVersion"`
+	NumCacheNodes             int          `xml:"NumCacheNodes"`
+	PreferredAvailabilityZone string       `xml:"PreferredAvailabilityZone"`
+	ReplicationGroupId        string       `xml:"ReplicationGroupId"`
+	ConfigurationEndpoint     *Endpoint    `xml:"ConfigurationEndpoint"` // Memcached clusters only
+	CacheNodes                []*CacheNode `xml:"CacheNodes>CacheNode"`
 }
 
 // CacheNode represents a cache node
 type CacheNode struct {
-	Endpoint *Endpoint `xml:"CacheNode>Endpoint"`
+	CacheNodeId     string    `xml:"CacheNodeId"`
+	CacheNodeStatus string    `xml:"CacheNodeStatus"`
+	Endpoint        *Endpoint `xml:"Endpoint"`
 }
 
 // Endpoint represents a cache node endpoint
@@ -104,7 +129,7 @@ type Endpoint struct {
 
 // New creates a new ElastiCache instance
 func New(auth aws.Auth, region aws.Region) *ElastiCache {
-	return &ElastiCache{auth, region}
+	return &ElastiCache{Auth: auth, Region: region}
 }
 
 // DescribeReplicationGroup returns information about a cache replication group
@@ -158,7 +183,11 @@ func (ec *ElastiCache) query(query string, response interface{}) error {
 	signer := aws.NewV4Signer(ec.Auth, "elasticache", ec.Region)
 	signer.Sign(hreq)
 
-	resp, err := http.DefaultClient.Do(hreq)
+	client := ec.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(hreq)
 
 	if err != nil {
 		return err
@@ -198,6 +227,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, "")
+}
+
 type xmlErrors struct {
 	Errors []Error `xml:"Error"`
 }
@@ -0,0 +1,162 @@
+package elasticache
+
+import (
+	"strconv"
+)
+
+// GlobalReplicationGroupMember is a replication group of a Global Datastore
+// in one region.
+type GlobalReplicationGroupMember struct {
+	ReplicationGroupId     string `xml:"ReplicationGroupId"`
+	ReplicationGroupRegion string `xml:"ReplicationGroupRegion"`
+	Role                   string `xml:"Role"` // "PRIMARY" or "SECONDARY"
+	AutomaticFailover      string `xml:"AutomaticFailover"`
+	Status                 string `xml:"Status"`
+}
+
+// GlobalReplicationGroup represents a Global Datastore, which replicates a
+// primary replication group to secondary groups in other regions.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_GlobalReplicationGroup.html
+type GlobalReplicationGroup struct {
+	GlobalReplicationGroupId          string                         `xml:"GlobalReplicationGroupId"`
+	GlobalReplicationGroupDescription string                         `xml:"GlobalReplicationGroupDescription"`
+	Status                            string                         `xml:"Status"`
+	CacheNodeType                     string                         `xml:"CacheNodeType"`
+	Engine                            string                         `xml:"Engine"`
+	EngineVersion                     string                         `xml:"EngineVersion"`
+	ClusterEnabled                    bool                           `xml:"ClusterEnabled"`
+	Members                           []GlobalReplicationGroupMember `xml:"Members>GlobalReplicationGroupMember"`
+	ARN                               string                         `xml:"ARN"`
+}
+
+type createGlobalReplicationGroupResult struct {
+	GlobalReplicationGroup GlobalReplicationGroup `xml:"CreateGlobalReplicationGroupResult>GlobalReplicationGroup"`
+}
+
+type describeGlobalReplicationGroupsResult struct {
+	GlobalReplicationGroups []GlobalReplicationGroup `xml:"DescribeGlobalReplicationGroupsResult>GlobalReplicationGroups>GlobalReplicationGroup"`
+}
+
+type disassociateGlobalReplicationGroupResult struct {
+	GlobalReplicationGroup GlobalReplicationGroup `xml:"DisassociateGlobalReplicationGroupResult>GlobalReplicationGroup"`
+}
+
+type failoverGlobalReplicationGroupResult struct {
+	GlobalReplicationGroup GlobalReplicationGroup `xml:"FailoverGlobalReplicationGroupResult>GlobalReplicationGroup"`
+}
+
+type deleteGlobalReplicationGroupResult struct {
+	GlobalReplicationGroup GlobalReplicationGroup `xml:"DeleteGlobalReplicationGroupResult>GlobalReplicationGroup"`
+}
+
+type createReplicationGroupResult struct {
+	ReplicationGroup ReplicationGroup `xml:"CreateReplicationGroupResult>ReplicationGroup"`
+}
+
+// CreateGlobalReplicationGroup creates a Global Datastore with an existing
+// replication group as its primary. AWS prefixes idSuffix with a string
+// identifying the account to form the GlobalReplicationGroupId.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateGlobalReplicationGroup.html
+func (ec *ElastiCache) CreateGlobalReplicationGroup(idSuffix, primaryReplicationGroupId, description string) (*GlobalReplicationGroup, error) {
+	params := makeParams("CreateGlobalReplicationGroup")
+	params.Set("GlobalReplicationGroupIdSuffix", idSuffix)
+	params.Set("PrimaryReplicationGroupId", primaryReplicationGroupId)
+	setIfNotEmpty(params, "GlobalReplicationGroupDescription", description)
+
+	var resp createGlobalReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.GlobalReplicationGroup, nil
+}
+
+// DescribeGlobalReplicationGroup returns information about a Global
+// Datastore, including its members in every region.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DescribeGlobalReplicationGroups.html
+func (ec *ElastiCache) DescribeGlobalReplicationGroup(globalReplicationGroupId string) (*GlobalReplicationGroup, error) {
+	params := makeParams("DescribeGlobalReplicationGroups")
+	params.Set("GlobalReplicationGroupId", globalReplicationGroupId)
+	params.Set("ShowMemberInfo", "true")
+
+	var resp describeGlobalReplicationGroupsResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.GlobalReplicationGroups) == 0 {
+		return nil, &Error{Code: "GlobalReplicationGroupNotFoundFault", Message: "Global replication group not found"}
+	}
+	return &resp.GlobalReplicationGroups[0], nil
+}
+
+// AddGlobalReplicationGroupRegion creates a secondary replication group in
+// the region of ec and adds it to a Global Datastore. The secondary group
+// inherits its engine and node type from the primary.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateReplicationGroup.html
+func (ec *ElastiCache) AddGlobalReplicationGroupRegion(globalReplicationGroupId, replicationGroupId, description string) (*ReplicationGroup, error) {
+	params := makeParams("CreateReplicationGroup")
+	params.Set("GlobalReplicationGroupId", globalReplicationGroupId)
+	params.Set("ReplicationGroupId", replicationGroupId)
+	params.Set("ReplicationGroupDescription", description)
+
+	var resp createReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationGroup, nil
+}
+
+// RemoveGlobalReplicationGroupRegion detaches the secondary replication
+// group in region from a Global Datastore. The group becomes a standalone
+// replication group and is not deleted.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DisassociateGlobalReplicationGroup.html
+func (ec *ElastiCache) RemoveGlobalReplicationGroupRegion(globalReplicationGroupId, replicationGroupId, region string) (*GlobalReplicationGroup, error) {
+	params := makeParams("DisassociateGlobalReplicationGroup")
+	params.Set("GlobalReplicationGroupId", globalReplicationGroupId)
+	params.Set("ReplicationGroupId", replicationGroupId)
+	params.Set("ReplicationGroupRegion", region)
+
+	var resp disassociateGlobalReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.GlobalReplicationGroup, nil
+}
+
+// FailoverGlobalReplicationGroup promotes the secondary replication group
+// in primaryRegion to be the primary of a Global Datastore.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_FailoverGlobalReplicationGroup.html
+func (ec *ElastiCache) FailoverGlobalReplicationGroup(globalReplicationGroupId, primaryRegion, primaryReplicationGroupId string) (*GlobalReplicationGroup, error) {
+	params := makeParams("FailoverGlobalReplicationGroup")
+	params.Set("GlobalReplicationGroupId", globalReplicationGroupId)
+	params.Set("PrimaryRegion", primaryRegion)
+	params.Set("PrimaryReplicationGroupId", primaryReplicationGroupId)
+
+	var resp failoverGlobalReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.GlobalReplicationGroup, nil
+}
+
+// DeleteGlobalReplicationGroup deletes a Global Datastore once its
+// secondary groups are removed. The primary replication group is deleted
+// too unless retainPrimary is set.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DeleteGlobalReplicationGroup.html
+func (ec *ElastiCache) DeleteGlobalReplicationGroup(globalReplicationGroupId string, retainPrimary bool) (*GlobalReplicationGroup, error) {
+	params := makeParams("DeleteGlobalReplicationGroup")
+	params.Set("GlobalReplicationGroupId", globalReplicationGroupId)
+	params.Set("RetainPrimaryReplicationGroup", strconv.FormatBool(retainPrimary))
+
+	var resp deleteGlobalReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.GlobalReplicationGroup, nil
+}
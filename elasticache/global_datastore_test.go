@@ -0,0 +1,90 @@
+package elasticache
+
+import (
+	check "gopkg.in/check.v1"
+)
+
+func (s *APIS) TestCreateGlobalReplicationGroup(c *check.C) {
+	testServer.Response(200, nil, CreateGlobalReplicationGroupResponse)
+
+	group, err := s.elasticache.CreateGlobalReplicationGroup("sessions", "sessions-use1", "Sessions")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "CreateGlobalReplicationGroup")
+	c.Assert(req.Form.Get("GlobalReplicationGroupIdSuffix"), check.Equals, "sessions")
+	c.Assert(req.Form.Get("PrimaryReplicationGroupId"), check.Equals, "sessions-use1")
+	c.Assert(req.Form.Get("GlobalReplicationGroupDescription"), check.Equals, "Sessions")
+
+	c.Assert(group.GlobalReplicationGroupId, check.Equals, "ldgnf-sessions")
+	c.Assert(group.ClusterEnabled, check.Equals, true)
+	c.Assert(group.Members, check.DeepEquals, []GlobalReplicationGroupMember{{
+		ReplicationGroupId:     "sessions-use1",
+		ReplicationGroupRegion: "us-east-1",
+		Role:                   "PRIMARY",
+		AutomaticFailover:      "enabled",
+		Status:                 "associating",
+	}})
+}
+
+func (s *APIS) TestAddGlobalReplicationGroupRegion(c *check.C) {
+	testServer.Response(200, nil, CreateReplicationGroupResponse)
+
+	group, err := s.elasticache.AddGlobalReplicationGroupRegion("ldgnf-sessions", "sessions-euw1", "Sessions in Ireland")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "CreateReplicationGroup")
+	c.Assert(req.Form.Get("GlobalReplicationGroupId"), check.Equals, "ldgnf-sessions")
+	c.Assert(req.Form.Get("ReplicationGroupId"), check.Equals, "sessions-euw1")
+	c.Assert(group.ReplicationGroupId, check.Equals, "sessions-euw1")
+	c.Assert(group.Status, check.Equals, "creating")
+}
+
+func (s *APIS) TestRemoveGlobalReplicationGroupRegion(c *check.C) {
+	testServer.Response(200, nil, `<DisassociateGlobalReplicationGroupResponse><DisassociateGlobalReplicationGroupResult><GlobalReplicationGroup><GlobalReplicationGroupId>ldgnf-sessions</GlobalReplicationGroupId><Status>modifying</Status></GlobalReplicationGroup></DisassociateGlobalReplicationGroupResult></DisassociateGlobalReplicationGroupResponse>`)
+
+	group, err := s.elasticache.RemoveGlobalReplicationGroupRegion("ldgnf-sessions", "sessions-euw1", "eu-west-1")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "DisassociateGlobalReplicationGroup")
+	c.Assert(req.Form.Get("ReplicationGroupRegion"), check.Equals, "eu-west-1")
+	c.Assert(group.Status, check.Equals, "modifying")
+}
+
+func (s *APIS) TestFailoverGlobalReplicationGroup(c *check.C) {
+	testServer.Response(200, nil, FailoverGlobalReplicationGroupResponse)
+
+	group, err := s.elasticache.FailoverGlobalReplicationGroup("ldgnf-sessions", "eu-west-1", "sessions-euw1")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "FailoverGlobalReplicationGroup")
+	c.Assert(req.Form.Get("PrimaryRegion"), check.Equals, "eu-west-1")
+	c.Assert(req.Form.Get("PrimaryReplicationGroupId"), check.Equals, "sessions-euw1")
+	c.Assert(group.Members, check.HasLen, 2)
+	c.Assert(group.Members[1].Role, check.Equals, "PRIMARY")
+}
+
+func (s *APIS) TestDeleteGlobalReplicationGroup(c *check.C) {
+	testServer.Response(200, nil, `<DeleteGlobalReplicationGroupResponse><DeleteGlobalReplicationGroupResult><GlobalReplicationGroup><GlobalReplicationGroupId>ldgnf-sessions</GlobalReplicationGroupId><Status>deleting</Status></GlobalReplicationGroup></DeleteGlobalReplicationGroupResult></DeleteGlobalReplicationGroupResponse>`)
+
+	_, err := s.elasticache.DeleteGlobalReplicationGroup("ldgnf-sessions", true)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form.Get("RetainPrimaryReplicationGroup"), check.Equals, "true")
+}
+
+func (s *APIS) TestDescribeGlobalReplicationGroupNotFound(c *check.C) {
+	testServer.Response(404, nil, GlobalReplicationGroupNotFoundResponse)
+
+	_, err := s.elasticache.DescribeGlobalReplicationGroup("ldgnf-missing")
+	req := testServer.WaitRequest()
+	c.Assert(req.Form.Get("ShowMemberInfo"), check.Equals, "true")
+	c.Assert(err, check.NotNil)
+	ecErr := err.(*Error)
+	c.Assert(ecErr.StatusCode, check.Equals, 404)
+	c.Assert(ecErr.Code, check.Equals, "GlobalReplicationGroupNotFoundFault")
+	c.Assert(ecErr.Message, check.Equals, "Global replication group ldgnf-missing not found.")
+}
@@ -0,0 +1,243 @@
+package elasticache
+
+import (
+	"strconv"
+)
+
+// CreateReplicationGroup holds the parameters of CreateReplicationGroup.
+// A NumNodeGroups above 1 creates a Redis cluster with cluster mode
+// enabled. AutomaticFailoverEnabled requires at least one replica per node
+// group.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateReplicationGroup.html
+type CreateReplicationGroup struct {
+	ReplicationGroupId         string
+	Description                string
+	Engine                     string // "redis" or "valkey"
+	EngineVersion              string
+	CacheNodeType              string
+	NumNodeGroups              int
+	ReplicasPerNodeGroup       int
+	AutomaticFailoverEnabled   bool
+	MultiAZEnabled             bool
+	CacheParameterGroupName    string
+	CacheSubnetGroupName       string
+	SecurityGroupIds           []string
+	Port                       int
+	SnapshotRetentionLimit     int
+	SnapshotWindow             string // "hh:mm-hh:mm", in UTC
+	PreferredMaintenanceWindow string
+	AtRestEncryptionEnabled    bool
+	TransitEncryptionEnabled   bool
+	AuthToken                  string
+	Tags                       map[string]string
+}
+
+// ModifyReplicationGroup holds the parameters of ModifyReplicationGroup.
+// Only the fields that are set are changed. Because false is meaningful
+// for AutomaticFailoverEnabled and MultiAZEnabled, they are only sent when
+// SetAutomaticFailoverEnabled or SetMultiAZEnabled is true.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_ModifyReplicationGroup.html
+type ModifyReplicationGroup struct {
+	ReplicationGroupId         string
+	ApplyImmediately           bool
+	Description                string
+	CacheNodeType              string
+	EngineVersion              string
+	CacheParameterGroupName    string
+	SecurityGroupIds           []string
+	SnapshotRetentionLimit     int
+	SnapshotWindow             string
+	PreferredMaintenanceWindow string
+	PrimaryClusterId           string
+
+	AutomaticFailoverEnabled    bool
+	SetAutomaticFailoverEnabled bool
+	MultiAZEnabled              bool
+	SetMultiAZEnabled           bool
+}
+
+type modifyReplicationGroupResult struct {
+	ReplicationGroup ReplicationGroup `xml:"ModifyReplicationGroupResult>ReplicationGroup"`
+}
+
+type deleteReplicationGroupResult struct {
+	ReplicationGroup ReplicationGroup `xml:"DeleteReplicationGroupResult>ReplicationGroup"`
+}
+
+type increaseReplicaCountResult struct {
+	ReplicationGroup ReplicationGroup `xml:"IncreaseReplicaCountResult>ReplicationGroup"`
+}
+
+type decreaseReplicaCountResult struct {
+	ReplicationGroup ReplicationGroup `xml:"DecreaseReplicaCountResult>ReplicationGroup"`
+}
+
+// DescribeCacheClusters describes a page of the cache clusters, with their
+// nodes. nextMarker is "" on the last page; marker may be "".
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DescribeCacheClusters.html
+func (ec *ElastiCache) DescribeCacheClusters(marker string) (clusters []*CacheCluster, nextMarker string, err error) {
+	params := makeParams("DescribeCacheClusters")
+	params.Set("ShowCacheNodeInfo", "true")
+	setIfNotEmpty(params, "Marker", marker)
+
+	var resp DescribeCacheClustersResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.CacheClusters, resp.Marker, nil
+}
+
+// DescribeReplicationGroups describes a page of the replication groups.
+// nextMarker is "" on the last page; marker may be "".
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DescribeReplicationGroups.html
+func (ec *ElastiCache) DescribeReplicationGroups(marker string) (groups []ReplicationGroup, nextMarker string, err error) {
+	params := makeParams("DescribeReplicationGroups")
+	setIfNotEmpty(params, "Marker", marker)
+
+	var resp DescribeReplicationGroupsResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.ReplicationGroups, resp.Marker, nil
+}
+
+// CreateReplicationGroup creates a Redis replication group, with a primary
+// and its replicas in each node group.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateReplicationGroup.html
+func (ec *ElastiCache) CreateReplicationGroup(options *CreateReplicationGroup) (*ReplicationGroup, error) {
+	params := makeParams("CreateReplicationGroup")
+	params.Set("ReplicationGroupId", options.ReplicationGroupId)
+	params.Set("ReplicationGroupDescription", options.Description)
+	setIfNotEmpty(params, "Engine", options.Engine)
+	setIfNotEmpty(params, "EngineVersion", options.EngineVersion)
+	setIfNotEmpty(params, "CacheNodeType", options.CacheNodeType)
+	setIfPositive(params, "NumNodeGroups", options.NumNodeGroups)
+	setIfPositive(params, "ReplicasPerNodeGroup", options.ReplicasPerNodeGroup)
+	if options.AutomaticFailoverEnabled {
+		params.Set("AutomaticFailoverEnabled", "true")
+	}
+	if options.MultiAZEnabled {
+		params.Set("MultiAZEnabled", "true")
+	}
+	setIfNotEmpty(params, "CacheParameterGroupName", options.CacheParameterGroupName)
+	setIfNotEmpty(params, "CacheSubnetGroupName", options.CacheSubnetGroupName)
+	for i, id := range options.SecurityGroupIds {
+		params.Set("SecurityGroupIds.SecurityGroupId."+strconv.Itoa(i+1), id)
+	}
+	setIfPositive(params, "Port", options.Port)
+	setIfPositive(params, "SnapshotRetentionLimit", options.SnapshotRetentionLimit)
+	setIfNotEmpty(params, "SnapshotWindow", options.SnapshotWindow)
+	setIfNotEmpty(params, "PreferredMaintenanceWindow", options.PreferredMaintenanceWindow)
+	if options.AtRestEncryptionEnabled {
+		params.Set("AtRestEncryptionEnabled", "true")
+	}
+	if options.TransitEncryptionEnabled {
+		params.Set("TransitEncryptionEnabled", "true")
+	}
+	setIfNotEmpty(params, "AuthToken", options.AuthToken)
+	addTags(params, options.Tags)
+
+	var resp createReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationGroup, nil
+}
+
+// ModifyReplicationGroup changes the settings of a replication group.
+// Unless ApplyImmediately is set, the changes are applied during the next
+// maintenance window.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_ModifyReplicationGroup.html
+func (ec *ElastiCache) ModifyReplicationGroup(options *ModifyReplicationGroup) (*ReplicationGroup, error) {
+	params := makeParams("ModifyReplicationGroup")
+	params.Set("ReplicationGroupId", options.ReplicationGroupId)
+	if options.ApplyImmediately {
+		params.Set("ApplyImmediately", "true")
+	}
+	setIfNotEmpty(params, "ReplicationGroupDescription", options.Description)
+	setIfNotEmpty(params, "CacheNodeType", options.CacheNodeType)
+	setIfNotEmpty(params, "EngineVersion", options.EngineVersion)
+	setIfNotEmpty(params, "CacheParameterGroupName", options.CacheParameterGroupName)
+	for i, id := range options.SecurityGroupIds {
+		params.Set("SecurityGroupIds.SecurityGroupId."+strconv.Itoa(i+1), id)
+	}
+	setIfPositive(params, "SnapshotRetentionLimit", options.SnapshotRetentionLimit)
+	setIfNotEmpty(params, "SnapshotWindow", options.SnapshotWindow)
+	setIfNotEmpty(params, "PreferredMaintenanceWindow", options.PreferredMaintenanceWindow)
+	setIfNotEmpty(params, "PrimaryClusterId", options.PrimaryClusterId)
+	if options.SetAutomaticFailoverEnabled {
+		params.Set("AutomaticFailoverEnabled", strconv.FormatBool(options.AutomaticFailoverEnabled))
+	}
+	if options.SetMultiAZEnabled {
+		params.Set("MultiAZEnabled", strconv.FormatBool(options.MultiAZEnabled))
+	}
+
+	var resp modifyReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationGroup, nil
+}
+
+// DeleteReplicationGroup deletes a replication group and its clusters. If
+// retainPrimaryCluster is set, the primary cluster is kept as a standalone
+// cluster. If finalSnapshotName is not "", a snapshot is taken first.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DeleteReplicationGroup.html
+func (ec *ElastiCache) DeleteReplicationGroup(replicationGroupId string, retainPrimaryCluster bool, finalSnapshotName string) (*ReplicationGroup, error) {
+	params := makeParams("DeleteReplicationGroup")
+	params.Set("ReplicationGroupId", replicationGroupId)
+	if retainPrimaryCluster {
+		params.Set("RetainPrimaryCluster", "true")
+	}
+	setIfNotEmpty(params, "FinalSnapshotIdentifier", finalSnapshotName)
+
+	var resp deleteReplicationGroupResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationGroup, nil
+}
+
+// IncreaseReplicaCount adds replicas to every node group of a replication
+// group, up to newReplicaCount per node group. The replicas are added
+// right away.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_IncreaseReplicaCount.html
+func (ec *ElastiCache) IncreaseReplicaCount(replicationGroupId string, newReplicaCount int) (*ReplicationGroup, error) {
+	params := makeParams("IncreaseReplicaCount")
+	params.Set("ReplicationGroupId", replicationGroupId)
+	params.Set("NewReplicaCount", strconv.Itoa(newReplicaCount))
+	params.Set("ApplyImmediately", "true")
+
+	var resp increaseReplicaCountResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationGroup, nil
+}
+
+// DecreaseReplicaCount removes replicas from every node group of a
+// replication group, down to newReplicaCount per node group. A replication
+// group with automatic failover keeps at least one replica. The replicas
+// are removed right away.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DecreaseReplicaCount.html
+func (ec *ElastiCache) DecreaseReplicaCount(replicationGroupId string, newReplicaCount int) (*ReplicationGroup, error) {
+	params := makeParams("DecreaseReplicaCount")
+	params.Set("ReplicationGroupId", replicationGroupId)
+	params.Set("NewReplicaCount", strconv.Itoa(newReplicaCount))
+	params.Set("ApplyImmediately", "true")
+
+	var resp decreaseReplicaCountResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ReplicationGroup, nil
+}
@@ -0,0 +1,155 @@
+package elasticache
+
+import (
+	"strings"
+
+	check "gopkg.in/check.v1"
+)
+
+func (s *APIS) TestDescribeCacheClusters(c *check.C) {
+	testServer.Response(200, nil, DescribeCacheClustersResponse)
+
+	clusters, marker, err := s.elasticache.DescribeCacheClusters("")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "DescribeCacheClusters")
+	c.Assert(req.Form.Get("ShowCacheNodeInfo"), check.Equals, "true")
+	c.Assert(req.Form["Marker"], check.IsNil)
+
+	c.Assert(marker, check.Equals, "pages")
+	c.Assert(clusters, check.HasLen, 2)
+	c.Assert(clusters[0].CacheClusterId, check.Equals, "sessions-001")
+	c.Assert(clusters[0].ReplicationGroupId, check.Equals, "sessions")
+	c.Assert(clusters[0].CacheNodes, check.HasLen, 1)
+	c.Assert(*clusters[0].CacheNodes[0].Endpoint, check.Equals, Endpoint{Host: "sessions-001.abc123.0001.use1.cache.amazonaws.com", Port: 6379})
+	c.Assert(clusters[1].Engine, check.Equals, "memcached")
+	c.Assert(clusters[1].NumCacheNodes, check.Equals, 2)
+	c.Assert(*clusters[1].ConfigurationEndpoint, check.Equals, Endpoint{Host: "pages.abc123.cfg.use1.cache.amazonaws.com", Port: 11211})
+	c.Assert(clusters[1].CacheNodes, check.HasLen, 2)
+	c.Assert(clusters[1].CacheNodes[1].CacheNodeId, check.Equals, "0002")
+}
+
+func (s *APIS) TestDescribeCacheCluster(c *check.C) {
+	testServer.Response(200, nil, DescribeCacheClustersResponse)
+
+	cluster, err := s.elasticache.DescribeCacheCluster("sessions-001")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.URL.Query().Get("CacheClusterId"), check.Equals, "sessions-001")
+	c.Assert(cluster.CacheClusterId, check.Equals, "sessions-001")
+	c.Assert(cluster.CacheNodes[0].Endpoint.Host, check.Equals, "sessions-001.abc123.0001.use1.cache.amazonaws.com")
+}
+
+func (s *APIS) TestDescribeReplicationGroups(c *check.C) {
+	testServer.Response(200, nil, DescribeReplicationGroupsResponse)
+
+	groups, marker, err := s.elasticache.DescribeReplicationGroups("example-prev")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "DescribeReplicationGroups")
+	c.Assert(req.Form.Get("Marker"), check.Equals, "example-prev")
+
+	c.Assert(marker, check.Equals, "")
+	c.Assert(groups, check.HasLen, 1)
+	group := groups[0]
+	c.Assert(group.ReplicationGroupId, check.Equals, "example-test")
+	c.Assert(group.Description, check.Equals, "CH Doc API test")
+	c.Assert(group.MemberClusters, check.DeepEquals, []string{"example-test-001", "example-test-002", "example-test-003"})
+	c.Assert(group.NodeGroups, check.HasLen, 1)
+	c.Assert(group.NodeGroups[0].NodeGroupId, check.Equals, "0001")
+	c.Assert(group.NodeGroups[0].NodeGroupMembers, check.HasLen, 3)
+	c.Assert(group.NodeGroups[0].NodeGroupMembers[1].CurrentRole, check.Equals, "replica")
+
+	primary, err := group.GetPrimaryNode()
+	c.Assert(err, check.IsNil)
+	c.Assert(primary.Address, check.Equals, "example-test.4q8cbh.ng.0001.euw1.cache.amazonaws.com")
+}
+
+func (s *APIS) TestCreateReplicationGroup(c *check.C) {
+	testServer.Response(200, nil, strings.Replace(CreateReplicationGroupResponse, "sessions-euw1", "sessions", 1))
+
+	group, err := s.elasticache.CreateReplicationGroup(&CreateReplicationGroup{
+		ReplicationGroupId:       "sessions",
+		Description:              "Sessions",
+		Engine:                   "redis",
+		CacheNodeType:            "cache.r6g.large",
+		ReplicasPerNodeGroup:     2,
+		AutomaticFailoverEnabled: true,
+		CacheSubnetGroupName:     "private",
+		SecurityGroupIds:         []string{"sg-0123456789abcdef0"},
+		TransitEncryptionEnabled: true,
+		Tags:                     map[string]string{"team": "web"},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "CreateReplicationGroup")
+	c.Assert(req.Form.Get("Version"), check.Equals, "2015-02-02")
+	c.Assert(req.Form.Get("ReplicationGroupId"), check.Equals, "sessions")
+	c.Assert(req.Form.Get("ReplicationGroupDescription"), check.Equals, "Sessions")
+	c.Assert(req.Form.Get("ReplicasPerNodeGroup"), check.Equals, "2")
+	c.Assert(req.Form["NumNodeGroups"], check.IsNil)
+	c.Assert(req.Form.Get("AutomaticFailoverEnabled"), check.Equals, "true")
+	c.Assert(req.Form["MultiAZEnabled"], check.IsNil)
+	c.Assert(req.Form.Get("SecurityGroupIds.SecurityGroupId.1"), check.Equals, "sg-0123456789abcdef0")
+	c.Assert(req.Form.Get("TransitEncryptionEnabled"), check.Equals, "true")
+	c.Assert(req.Form.Get("Tags.Tag.1.Key"), check.Equals, "team")
+
+	c.Assert(group.ReplicationGroupId, check.Equals, "sessions")
+	c.Assert(group.Status, check.Equals, "creating")
+}
+
+func (s *APIS) TestModifyReplicationGroup(c *check.C) {
+	testServer.Response(200, nil, ModifyReplicationGroupResponse)
+
+	group, err := s.elasticache.ModifyReplicationGroup(&ModifyReplicationGroup{
+		ReplicationGroupId:          "sessions",
+		ApplyImmediately:            true,
+		CacheNodeType:               "cache.r6g.xlarge",
+		SnapshotRetentionLimit:      7,
+		SetAutomaticFailoverEnabled: true,
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "ModifyReplicationGroup")
+	c.Assert(req.Form.Get("ApplyImmediately"), check.Equals, "true")
+	c.Assert(req.Form.Get("CacheNodeType"), check.Equals, "cache.r6g.xlarge")
+	c.Assert(req.Form.Get("SnapshotRetentionLimit"), check.Equals, "7")
+	c.Assert(req.Form.Get("AutomaticFailoverEnabled"), check.Equals, "false")
+	c.Assert(req.Form["MultiAZEnabled"], check.IsNil)
+	c.Assert(req.Form["ReplicationGroupDescription"], check.IsNil)
+
+	c.Assert(group.Status, check.Equals, "modifying")
+	c.Assert(group.AutomaticFailover, check.Equals, "disabling")
+	c.Assert(group.SnapshotRetentionLimit, check.Equals, 7)
+}
+
+func (s *APIS) TestDeleteReplicationGroup(c *check.C) {
+	testServer.Response(200, nil, DeleteReplicationGroupResponse)
+
+	group, err := s.elasticache.DeleteReplicationGroup("sessions", false, "sessions-final")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "DeleteReplicationGroup")
+	c.Assert(req.Form["RetainPrimaryCluster"], check.IsNil)
+	c.Assert(req.Form.Get("FinalSnapshotIdentifier"), check.Equals, "sessions-final")
+	c.Assert(group.Status, check.Equals, "deleting")
+}
+
+func (s *APIS) TestIncreaseReplicaCount(c *check.C) {
+	testServer.Response(200, nil, `<IncreaseReplicaCountResponse><IncreaseReplicaCountResult><ReplicationGroup><ReplicationGroupId>sessions</ReplicationGroupId><Status>modifying</Status></ReplicationGroup></IncreaseReplicaCountResult></IncreaseReplicaCountResponse>`)
+
+	group, err := s.elasticache.IncreaseReplicaCount("sessions", 3)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "IncreaseReplicaCount")
+	c.Assert(req.Form.Get("NewReplicaCount"), check.Equals, "3")
+	c.Assert(req.Form.Get("ApplyImmediately"), check.Equals, "true")
+	c.Assert(group.Status, check.Equals, "modifying")
+}
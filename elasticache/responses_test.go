@@ -63,3 +63,223 @@ var DescribeReplicationGroupsResponse = `<DescribeReplicationGroupsResponse xmln
 </ResponseMetadata>
 </DescribeReplicationGroupsResponse>
 `
+
+var CreateServerlessCacheResponse = `<CreateServerlessCacheResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<CreateServerlessCacheResult>
+<ServerlessCache>
+<ServerlessCacheName>sessions</ServerlessCacheName>
+<Description>Session store</Description>
+<Status>creating</Status>
+<Engine>redis</Engine>
+<MajorEngineVersion>7</MajorEngineVersion>
+<CreateTime>2024-01-10T18:32:55.123Z</CreateTime>
+<CacheUsageLimits>
+<DataStorage>
+<Maximum>10</Maximum>
+<Unit>GB</Unit>
+</DataStorage>
+<ECPUPerSecond>
+<Maximum>5000</Maximum>
+</ECPUPerSecond>
+</CacheUsageLimits>
+<ARN>arn:aws:elasticache:us-east-1:123456789012:serverlesscache:sessions</ARN>
+<SubnetIds>
+<SubnetId>subnet-0a1b2c3d</SubnetId>
+<SubnetId>subnet-4e5f6a7b</SubnetId>
+</SubnetIds>
+<SecurityGroupIds>
+<SecurityGroupId>sg-0123456789abcdef0</SecurityGroupId>
+</SecurityGroupIds>
+</ServerlessCache>
+</CreateServerlessCacheResult>
+<ResponseMetadata>
+<RequestId>9b5e2c41-6d4f-4b8c-a2a7-1f0d3e7a9c11</RequestId>
+</ResponseMetadata>
+</CreateServerlessCacheResponse>
+`
+
+var DescribeServerlessCachesResponse = `<DescribeServerlessCachesResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<DescribeServerlessCachesResult>
+<ServerlessCaches>
+<member>
+<ServerlessCacheName>sessions</ServerlessCacheName>
+<Status>available</Status>
+<Engine>redis</Engine>
+<Endpoint>
+<Address>sessions-abc123.serverless.use1.cache.amazonaws.com</Address>
+<Port>6379</Port>
+</Endpoint>
+<ReaderEndpoint>
+<Address>sessions-abc123.serverless.use1.cache.amazonaws.com</Address>
+<Port>6380</Port>
+</ReaderEndpoint>
+</member>
+</ServerlessCaches>
+<NextToken>token2</NextToken>
+</DescribeServerlessCachesResult>
+</DescribeServerlessCachesResponse>
+`
+
+var CreateGlobalReplicationGroupResponse = `<CreateGlobalReplicationGroupResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<CreateGlobalReplicationGroupResult>
+<GlobalReplicationGroup>
+<GlobalReplicationGroupId>ldgnf-sessions</GlobalReplicationGroupId>
+<GlobalReplicationGroupDescription>Sessions</GlobalReplicationGroupDescription>
+<Status>creating</Status>
+<CacheNodeType>cache.r6g.large</CacheNodeType>
+<Engine>redis</Engine>
+<EngineVersion>7.1</EngineVersion>
+<ClusterEnabled>true</ClusterEnabled>
+<Members>
+<GlobalReplicationGroupMember>
+<ReplicationGroupId>sessions-use1</ReplicationGroupId>
+<ReplicationGroupRegion>us-east-1</ReplicationGroupRegion>
+<Role>PRIMARY</Role>
+<AutomaticFailover>enabled</AutomaticFailover>
+<Status>associating</Status>
+</GlobalReplicationGroupMember>
+</Members>
+<ARN>arn:aws:elasticache::123456789012:globalreplicationgroup:ldgnf-sessions</ARN>
+</GlobalReplicationGroup>
+</CreateGlobalReplicationGroupResult>
+</CreateGlobalReplicationGroupResponse>
+`
+
+var FailoverGlobalReplicationGroupResponse = `<FailoverGlobalReplicationGroupResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<FailoverGlobalReplicationGroupResult>
+<GlobalReplicationGroup>
+<GlobalReplicationGroupId>ldgnf-sessions</GlobalReplicationGroupId>
+<Status>modifying</Status>
+<Members>
+<GlobalReplicationGroupMember>
+<ReplicationGroupId>sessions-use1</ReplicationGroupId>
+<ReplicationGroupRegion>us-east-1</ReplicationGroupRegion>
+<Role>SECONDARY</Role>
+<Status>associated</Status>
+</GlobalReplicationGroupMember>
+<GlobalReplicationGroupMember>
+<ReplicationGroupId>sessions-euw1</ReplicationGroupId>
+<ReplicationGroupRegion>eu-west-1</ReplicationGroupRegion>
+<Role>PRIMARY</Role>
+<Status>associated</Status>
+</GlobalReplicationGroupMember>
+</Members>
+</GlobalReplicationGroup>
+</FailoverGlobalReplicationGroupResult>
+</FailoverGlobalReplicationGroupResponse>
+`
+
+var CreateReplicationGroupResponse = `<CreateReplicationGroupResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<CreateReplicationGroupResult>
+<ReplicationGroup>
+<ReplicationGroupId>sessions-euw1</ReplicationGroupId>
+<Status>creating</Status>
+</ReplicationGroup>
+</CreateReplicationGroupResult>
+</CreateReplicationGroupResponse>
+`
+
+var GlobalReplicationGroupNotFoundResponse = `<ErrorResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<Error>
+<Type>Sender</Type>
+<Code>GlobalReplicationGroupNotFoundFault</Code>
+<Message>Global replication group ldgnf-missing not found.</Message>
+</Error>
+<RequestId>5a0b3d52-8e91-4d0c-9c3e-0d6f1a2b3c4d</RequestId>
+</ErrorResponse>
+`
+
+var DescribeCacheClustersResponse = `<DescribeCacheClustersResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<DescribeCacheClustersResult>
+<CacheClusters>
+<CacheCluster>
+<CacheClusterId>sessions-001</CacheClusterId>
+<CacheClusterStatus>available</CacheClusterStatus>
+<CacheNodeType>cache.r6g.large</CacheNodeType>
+<Engine>redis</Engine>
+<EngineVersion>7.1.0</EngineVersion>
+<NumCacheNodes>1</NumCacheNodes>
+<PreferredAvailabilityZone>us-east-1a</PreferredAvailabilityZone>
+<ReplicationGroupId>sessions</ReplicationGroupId>
+<CacheNodes>
+<CacheNode>
+<CacheNodeId>0001</CacheNodeId>
+<CacheNodeStatus>available</CacheNodeStatus>
+<Endpoint>
+<Address>sessions-001.abc123.0001.use1.cache.amazonaws.com</Address>
+<Port>6379</Port>
+</Endpoint>
+</CacheNode>
+</CacheNodes>
+</CacheCluster>
+<CacheCluster>
+<CacheClusterId>pages</CacheClusterId>
+<CacheClusterStatus>available</CacheClusterStatus>
+<CacheNodeType>cache.t4g.small</CacheNodeType>
+<Engine>memcached</Engine>
+<EngineVersion>1.6.22</EngineVersion>
+<NumCacheNodes>2</NumCacheNodes>
+<ConfigurationEndpoint>
+<Address>pages.abc123.cfg.use1.cache.amazonaws.com</Address>
+<Port>11211</Port>
+</ConfigurationEndpoint>
+<CacheNodes>
+<CacheNode>
+<CacheNodeId>0001</CacheNodeId>
+<CacheNodeStatus>available</CacheNodeStatus>
+<Endpoint>
+<Address>pages.abc123.0001.use1.cache.amazonaws.com</Address>
+<Port>11211</Port>
+</Endpoint>
+</CacheNode>
+<CacheNode>
+<CacheNodeId>0002</CacheNodeId>
+<CacheNodeStatus>available</CacheNodeStatus>
+<Endpoint>
+<Address>pages.abc123.0002.use1.cache.amazonaws.com</Address>
+<Port>11211</Port>
+</Endpoint>
+</CacheNode>
+</CacheNodes>
+</CacheCluster>
+</CacheClusters>
+<Marker>pages</Marker>
+</DescribeCacheClustersResult>
+<ResponseMetadata>
+<RequestId>2c5d8e1f-4a6b-4c7d-8e9f-0a1b2c3d4e5f</RequestId>
+</ResponseMetadata>
+</DescribeCacheClustersResponse>
+`
+
+var ModifyReplicationGroupResponse = `<ModifyReplicationGroupResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<ModifyReplicationGroupResult>
+<ReplicationGroup>
+<ReplicationGroupId>sessions</ReplicationGroupId>
+<Description>Sessions</Description>
+<Status>modifying</Status>
+<AutomaticFailover>disabling</AutomaticFailover>
+<MultiAZ>disabled</MultiAZ>
+<CacheNodeType>cache.r6g.xlarge</CacheNodeType>
+<ClusterEnabled>false</ClusterEnabled>
+<SnapshotRetentionLimit>7</SnapshotRetentionLimit>
+<ARN>arn:aws:elasticache:us-east-1:123456789012:replicationgroup:sessions</ARN>
+</ReplicationGroup>
+</ModifyReplicationGroupResult>
+<ResponseMetadata>
+<RequestId>3d6e9f2a-5b7c-4d8e-9f0a-1b2c3d4e5f6a</RequestId>
+</ResponseMetadata>
+</ModifyReplicationGroupResponse>
+`
+
+var DeleteReplicationGroupResponse = `<DeleteReplicationGroupResponse xmlns="http://elasticache.amazonaws.com/doc/2015-02-02/">
+<DeleteReplicationGroupResult>
+<ReplicationGroup>
+<ReplicationGroupId>sessions</ReplicationGroupId>
+<Status>deleting</Status>
+</ReplicationGroup>
+</DeleteReplicationGroupResult>
+<ResponseMetadata>
+<RequestId>4e7f0a3b-6c8d-4e9f-0a1b-2c3d4e5f6a7b</RequestId>
+</ResponseMetadata>
+</DeleteReplicationGroupResponse>
+`
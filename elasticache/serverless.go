@@ -0,0 +1,174 @@
+package elasticache
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// The serverless cache, Global Datastore and replication group management
+// actions need a later API version than DescribeReplicationGroup and
+// DescribeCacheCluster.
+const apiVersion20150202 = "2015-02-02"
+
+// CacheUsageLimits bounds the data stored in and the compute used by a
+// serverless cache. Zero values are left unset.
+type CacheUsageLimits struct {
+	DataStorageMinimum   int    `xml:"DataStorage>Minimum"`
+	DataStorageMaximum   int    `xml:"DataStorage>Maximum"`
+	DataStorageUnit      string `xml:"DataStorage>Unit"` // "GB"
+	ECPUPerSecondMinimum int    `xml:"ECPUPerSecond>Minimum"`
+	ECPUPerSecondMaximum int    `xml:"ECPUPerSecond>Maximum"`
+}
+
+// CreateServerlessCache holds the parameters of CreateServerlessCache.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateServerlessCache.html
+type CreateServerlessCache struct {
+	ServerlessCacheName    string
+	Engine                 string // "redis", "valkey" or "memcached"
+	MajorEngineVersion     string
+	Description            string
+	CacheUsageLimits       *CacheUsageLimits
+	KmsKeyId               string
+	SecurityGroupIds       []string
+	SubnetIds              []string
+	SnapshotRetentionLimit int
+	DailySnapshotTime      string // "hh:mm", in UTC
+	UserGroupId            string
+	Tags                   map[string]string
+}
+
+// ServerlessCache represents a serverless cache.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_ServerlessCache.html
+type ServerlessCache struct {
+	ServerlessCacheName    string            `xml:"ServerlessCacheName"`
+	Description            string            `xml:"Description"`
+	Status                 string            `xml:"Status"`
+	Engine                 string            `xml:"Engine"`
+	MajorEngineVersion     string            `xml:"MajorEngineVersion"`
+	FullEngineVersion      string            `xml:"FullEngineVersion"`
+	CreateTime             time.Time         `xml:"CreateTime"`
+	CacheUsageLimits       *CacheUsageLimits `xml:"CacheUsageLimits"`
+	Endpoint               *Endpoint         `xml:"Endpoint"`
+	ReaderEndpoint         *Endpoint         `xml:"ReaderEndpoint"`
+	ARN                    string            `xml:"ARN"`
+	SecurityGroupIds       []string          `xml:"SecurityGroupIds>SecurityGroupId"`
+	SubnetIds              []string          `xml:"SubnetIds>SubnetId"`
+	SnapshotRetentionLimit int               `xml:"SnapshotRetentionLimit"`
+	DailySnapshotTime      string            `xml:"DailySnapshotTime"`
+}
+
+type createServerlessCacheResult struct {
+	ServerlessCache ServerlessCache `xml:"CreateServerlessCacheResult>ServerlessCache"`
+}
+
+type deleteServerlessCacheResult struct {
+	ServerlessCache ServerlessCache `xml:"DeleteServerlessCacheResult>ServerlessCache"`
+}
+
+type describeServerlessCachesResult struct {
+	ServerlessCaches []ServerlessCache `xml:"DescribeServerlessCachesResult>ServerlessCaches>member"`
+	NextToken        string            `xml:"DescribeServerlessCachesResult>NextToken"`
+}
+
+// CreateServerlessCache creates a serverless cache, which scales on its
+// own within the usage limits given.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_CreateServerlessCache.html
+func (ec *ElastiCache) CreateServerlessCache(options *CreateServerlessCache) (*ServerlessCache, error) {
+	params := makeParams("CreateServerlessCache")
+	params.Set("ServerlessCacheName", options.ServerlessCacheName)
+	params.Set("Engine", options.Engine)
+	setIfNotEmpty(params, "MajorEngineVersion", options.MajorEngineVersion)
+	setIfNotEmpty(params, "Description", options.Description)
+	setIfNotEmpty(params, "KmsKeyId", options.KmsKeyId)
+	setIfNotEmpty(params, "DailySnapshotTime", options.DailySnapshotTime)
+	setIfNotEmpty(params, "UserGroupId", options.UserGroupId)
+	if options.SnapshotRetentionLimit > 0 {
+		params.Set("SnapshotRetentionLimit", strconv.Itoa(options.SnapshotRetentionLimit))
+	}
+	if l := options.CacheUsageLimits; l != nil {
+		setIfPositive(params, "CacheUsageLimits.DataStorage.Minimum", l.DataStorageMinimum)
+		setIfPositive(params, "CacheUsageLimits.DataStorage.Maximum", l.DataStorageMaximum)
+		setIfNotEmpty(params, "CacheUsageLimits.DataStorage.Unit", l.DataStorageUnit)
+		setIfPositive(params, "CacheUsageLimits.ECPUPerSecond.Minimum", l.ECPUPerSecondMinimum)
+		setIfPositive(params, "CacheUsageLimits.ECPUPerSecond.Maximum", l.ECPUPerSecondMaximum)
+	}
+	for i, id := range options.SecurityGroupIds {
+		params.Set("SecurityGroupIds.SecurityGroupId."+strconv.Itoa(i+1), id)
+	}
+	for i, id := range options.SubnetIds {
+		params.Set("SubnetIds.SubnetId."+strconv.Itoa(i+1), id)
+	}
+	addTags(params, options.Tags)
+
+	var resp createServerlessCacheResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ServerlessCache, nil
+}
+
+// DescribeServerlessCaches describes the serverless cache with the given
+// name, or a page of all of them if name is "". nextToken may be "".
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DescribeServerlessCaches.html
+func (ec *ElastiCache) DescribeServerlessCaches(name, nextToken string) ([]ServerlessCache, string, error) {
+	params := makeParams("DescribeServerlessCaches")
+	setIfNotEmpty(params, "ServerlessCacheName", name)
+	setIfNotEmpty(params, "NextToken", nextToken)
+
+	var resp describeServerlessCachesResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.ServerlessCaches, resp.NextToken, nil
+}
+
+// DeleteServerlessCache deletes a serverless cache. If finalSnapshotName
+// is not "", a snapshot of the cache is taken first.
+//
+// See http://docs.aws.amazon.com/AmazonElastiCache/latest/APIReference/API_DeleteServerlessCache.html
+func (ec *ElastiCache) DeleteServerlessCache(name, finalSnapshotName string) (*ServerlessCache, error) {
+	params := makeParams("DeleteServerlessCache")
+	params.Set("ServerlessCacheName", name)
+	setIfNotEmpty(params, "FinalSnapshotName", finalSnapshotName)
+
+	var resp deleteServerlessCacheResult
+	if err := ec.query(params.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.ServerlessCache, nil
+}
+
+func makeParams(action string) url.Values {
+	return url.Values{"Action": {action}, "Version": {apiVersion20150202}}
+}
+
+func setIfNotEmpty(params url.Values, name, value string) {
+	if value != "" {
+		params.Set(name, value)
+	}
+}
+
+func setIfPositive(params url.Values, name string, value int) {
+	if value > 0 {
+		params.Set(name, strconv.Itoa(value))
+	}
+}
+
+func addTags(params url.Values, tags map[string]string) {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for i, key := range keys {
+		prefix := "Tags.Tag." + strconv.Itoa(i+1)
+		params.Set(prefix+".Key", key)
+		params.Set(prefix+".Value", tags[key])
+	}
+}
@@ -0,0 +1,81 @@
+package elasticache
+
+import (
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	check "gopkg.in/check.v1"
+)
+
+// APIS tests the actions of the 2015-02-02 API version.
+type APIS struct {
+	elasticache *ElastiCache
+}
+
+var _ = check.Suite(&APIS{})
+
+func (s *APIS) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.elasticache = New(auth, aws.Region{Name: "us-east-1", ElastiCacheEndpoint: testServer.URL})
+}
+
+func (s *APIS) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *APIS) TestCreateServerlessCache(c *check.C) {
+	testServer.Response(200, nil, CreateServerlessCacheResponse)
+
+	cache, err := s.elasticache.CreateServerlessCache(&CreateServerlessCache{
+		ServerlessCacheName: "sessions",
+		Engine:              "redis",
+		Description:         "Session store",
+		CacheUsageLimits: &CacheUsageLimits{
+			DataStorageMaximum:   10,
+			DataStorageUnit:      "GB",
+			ECPUPerSecondMaximum: 5000,
+		},
+		SubnetIds:        []string{"subnet-0a1b2c3d", "subnet-4e5f6a7b"},
+		SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+		Tags:             map[string]string{"team": "web", "env": "prod"},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "CreateServerlessCache")
+	c.Assert(req.Form.Get("Version"), check.Equals, "2015-02-02")
+	c.Assert(req.Form.Get("ServerlessCacheName"), check.Equals, "sessions")
+	c.Assert(req.Form.Get("Engine"), check.Equals, "redis")
+	c.Assert(req.Form.Get("CacheUsageLimits.DataStorage.Maximum"), check.Equals, "10")
+	c.Assert(req.Form.Get("CacheUsageLimits.DataStorage.Unit"), check.Equals, "GB")
+	c.Assert(req.Form.Get("CacheUsageLimits.DataStorage.Minimum"), check.Equals, "")
+	c.Assert(req.Form.Get("CacheUsageLimits.ECPUPerSecond.Maximum"), check.Equals, "5000")
+	c.Assert(req.Form.Get("SubnetIds.SubnetId.2"), check.Equals, "subnet-4e5f6a7b")
+	c.Assert(req.Form.Get("SecurityGroupIds.SecurityGroupId.1"), check.Equals, "sg-0123456789abcdef0")
+	c.Assert(req.Form.Get("Tags.Tag.1.Key"), check.Equals, "env")
+	c.Assert(req.Form.Get("Tags.Tag.2.Value"), check.Equals, "web")
+	c.Assert(req.Header.Get("Authorization"), check.Matches, "AWS4-HMAC-SHA256 Credential=abc/[0-9]{8}/us-east-1/elasticache/aws4_request, .*")
+
+	c.Assert(cache.ServerlessCacheName, check.Equals, "sessions")
+	c.Assert(cache.Status, check.Equals, "creating")
+	c.Assert(cache.CreateTime.Equal(time.Date(2024, 1, 10, 18, 32, 55, 123e6, time.UTC)), check.Equals, true)
+	c.Assert(cache.CacheUsageLimits.DataStorageMaximum, check.Equals, 10)
+	c.Assert(cache.CacheUsageLimits.ECPUPerSecondMaximum, check.Equals, 5000)
+	c.Assert(cache.SubnetIds, check.DeepEquals, []string{"subnet-0a1b2c3d", "subnet-4e5f6a7b"})
+}
+
+func (s *APIS) TestDescribeServerlessCaches(c *check.C) {
+	testServer.Response(200, nil, DescribeServerlessCachesResponse)
+
+	caches, nextToken, err := s.elasticache.DescribeServerlessCaches("", "")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Form.Get("Action"), check.Equals, "DescribeServerlessCaches")
+	c.Assert(req.Form["ServerlessCacheName"], check.IsNil)
+	c.Assert(caches, check.HasLen, 1)
+	c.Assert(caches[0].Endpoint.Host, check.Equals, "sessions-abc123.serverless.use1.cache.amazonaws.com")
+	c.Assert(caches[0].ReaderEndpoint.Port, check.Equals, 6380)
+	c.Assert(nextToken, check.Equals, "token2")
+}
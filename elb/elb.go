@@ -314,6 +314,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, "")
+}
+
 type xmlErrors struct {
 	Errors []Error `xml:"Error"`
 }
@@ -0,0 +1,795 @@
+// This package provides types and functions to interact with the Elastic Load
+// Balancing v2 API, used by Application and Network Load Balancers.
+package elbv2
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// The ELBV2 type encapsulates operations with the Elastic Load Balancing v2
+// API, which shares its endpoint with classic ELB.
+type ELBV2 struct {
+	aws.Auth
+	aws.Region
+}
+
+func New(auth aws.Auth, region aws.Region) *ELBV2 {
+	return &ELBV2{auth, region}
+}
+
+type SimpleResp struct {
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+}
+
+// Tag is a key and value pair attached to a load balancer or target group.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// AvailabilityZone is a zone enabled for a load balancer, with the subnet
+// the load balancer uses in it.
+type AvailabilityZone struct {
+	ZoneName string
+	SubnetId string
+}
+
+// LoadBalancer describes an Application or Network Load Balancer.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_LoadBalancer.html for more details.
+type LoadBalancer struct {
+	LoadBalancerArn       string
+	LoadBalancerName      string
+	DNSName               string
+	CanonicalHostedZoneId string
+	CreatedTime           time.Time
+	Scheme                string
+	Type                  string
+	VpcId                 string
+	IpAddressType         string
+	State                 string             `xml:"State>Code"`
+	StateReason           string             `xml:"State>Reason"`
+	AvailabilityZones     []AvailabilityZone `xml:"AvailabilityZones>member"`
+	SecurityGroups        []string           `xml:"SecurityGroups>member"`
+}
+
+// CreateLoadBalancer holds the options of a new load balancer. Scheme
+// defaults to "internet-facing" and Type to "application"; an Application
+// Load Balancer needs subnets in at least two Availability Zones.
+type CreateLoadBalancer struct {
+	Name           string
+	Subnets        []string
+	SecurityGroups []string
+	Scheme         string
+	Type           string
+	IpAddressType  string
+	Tags           []Tag
+}
+
+type CreateLoadBalancerResp struct {
+	RequestId     string         `xml:"ResponseMetadata>RequestId"`
+	LoadBalancers []LoadBalancer `xml:"CreateLoadBalancerResult>LoadBalancers>member"`
+}
+
+// CreateLoadBalancer creates a load balancer. Creating a load balancer that
+// already exists with the same options returns the existing one.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateLoadBalancer.html for more details.
+func (elb *ELBV2) CreateLoadBalancer(options *CreateLoadBalancer) (resp *CreateLoadBalancerResp, err error) {
+	params := map[string]string{
+		"Action": "CreateLoadBalancer",
+		"Name":   options.Name,
+	}
+	for i, s := range options.Subnets {
+		params[fmt.Sprintf("Subnets.member.%d", i+1)] = s
+	}
+	for i, g := range options.SecurityGroups {
+		params[fmt.Sprintf("SecurityGroups.member.%d", i+1)] = g
+	}
+	if options.Scheme != "" {
+		params["Scheme"] = options.Scheme
+	}
+	if options.Type != "" {
+		params["Type"] = options.Type
+	}
+	if options.IpAddressType != "" {
+		params["IpAddressType"] = options.IpAddressType
+	}
+	addTagParams(params, options.Tags)
+	resp = new(CreateLoadBalancerResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TargetGroup describes a group of targets that load balancers route
+// requests to.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_TargetGroup.html for more details.
+type TargetGroup struct {
+	TargetGroupArn             string
+	TargetGroupName            string
+	Protocol                   string
+	Port                       int
+	VpcId                      string
+	TargetType                 string
+	HealthCheckProtocol        string
+	HealthCheckPort            string
+	HealthCheckPath            string
+	HealthCheckIntervalSeconds int
+	HealthCheckTimeoutSeconds  int
+	HealthyThresholdCount      int
+	UnhealthyThresholdCount    int
+	Matcher                    string   `xml:"Matcher>HttpCode"`
+	LoadBalancerArns           []string `xml:"LoadBalancerArns>member"`
+}
+
+// CreateTargetGroup holds the options of a new target group. TargetType
+// defaults to "instance". Zero values leave the health check settings to
+// their defaults; Matcher lists the HTTP codes of a healthy response, such
+// as "200" or "200-299".
+type CreateTargetGroup struct {
+	Name                       string
+	Protocol                   string
+	Port                       int
+	VpcId                      string
+	TargetType                 string
+	HealthCheckProtocol        string
+	HealthCheckPort            string
+	HealthCheckPath            string
+	HealthCheckIntervalSeconds int
+	HealthCheckTimeoutSeconds  int
+	HealthyThresholdCount      int
+	UnhealthyThresholdCount    int
+	Matcher                    string
+	Tags                       []Tag
+}
+
+type CreateTargetGroupResp struct {
+	RequestId    string        `xml:"ResponseMetadata>RequestId"`
+	TargetGroups []TargetGroup `xml:"CreateTargetGroupResult>TargetGroups>member"`
+}
+
+// CreateTargetGroup creates a target group.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateTargetGroup.html for more details.
+func (elb *ELBV2) CreateTargetGroup(options *CreateTargetGroup) (resp *CreateTargetGroupResp, err error) {
+	params := map[string]string{
+		"Action": "CreateTargetGroup",
+		"Name":   options.Name,
+	}
+	if options.Protocol != "" {
+		params["Protocol"] = options.Protocol
+	}
+	if options.Port != 0 {
+		params["Port"] = strconv.Itoa(options.Port)
+	}
+	if options.VpcId != "" {
+		params["VpcId"] = options.VpcId
+	}
+	if options.TargetType != "" {
+		params["TargetType"] = options.TargetType
+	}
+	if options.HealthCheckProtocol != "" {
+		params["HealthCheckProtocol"] = options.HealthCheckProtocol
+	}
+	if options.HealthCheckPort != "" {
+		params["HealthCheckPort"] = options.HealthCheckPort
+	}
+	if options.HealthCheckPath != "" {
+		params["HealthCheckPath"] = options.HealthCheckPath
+	}
+	if options.HealthCheckIntervalSeconds != 0 {
+		params["HealthCheckIntervalSeconds"] = strconv.Itoa(options.HealthCheckIntervalSeconds)
+	}
+	if options.HealthCheckTimeoutSeconds != 0 {
+		params["HealthCheckTimeoutSeconds"] = strconv.Itoa(options.HealthCheckTimeoutSeconds)
+	}
+	if options.HealthyThresholdCount != 0 {
+		params["HealthyThresholdCount"] = strconv.Itoa(options.HealthyThresholdCount)
+	}
+	if options.UnhealthyThresholdCount != 0 {
+		params["UnhealthyThresholdCount"] = strconv.Itoa(options.UnhealthyThresholdCount)
+	}
+	if options.Matcher != "" {
+		params["Matcher.HttpCode"] = options.Matcher
+	}
+	addTagParams(params, options.Tags)
+	resp = new(CreateTargetGroupResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Target is an instance, IP address or Lambda function in a target group.
+// Port and AvailabilityZone are optional.
+type Target struct {
+	Id               string
+	Port             int
+	AvailabilityZone string
+}
+
+// RegisterTargets adds targets to a target group.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_RegisterTargets.html for more details.
+func (elb *ELBV2) RegisterTargets(targetGroupArn string, targets ...Target) (resp *SimpleResp, err error) {
+	params := map[string]string{
+		"Action":         "RegisterTargets",
+		"TargetGroupArn": targetGroupArn,
+	}
+	addTargetParams(params, targets)
+	resp = new(SimpleResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// TargetHealthDescription is the health of a target in a target group.
+// State is one of "initial", "healthy", "unhealthy", "unused", "draining"
+// or "unavailable"; Reason and Description explain the states other than
+// "healthy".
+type TargetHealthDescription struct {
+	Target          Target
+	HealthCheckPort string
+	State           string `xml:"TargetHealth>State"`
+	Reason          string `xml:"TargetHealth>Reason"`
+	Description     string `xml:"TargetHealth>Description"`
+}
+
+type DescribeTargetHealthResp struct {
+	RequestId                string                    `xml:"ResponseMetadata>RequestId"`
+	TargetHealthDescriptions []TargetHealthDescription `xml:"DescribeTargetHealthResult>TargetHealthDescriptions>member"`
+}
+
+// DescribeTargetHealth describes the health of the given targets of a
+// target group, or of all of them if none are given.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeTargetHealth.html for more details.
+func (elb *ELBV2) DescribeTargetHealth(targetGroupArn string, targets ...Target) (resp *DescribeTargetHealthResp, err error) {
+	params := map[string]string{
+		"Action":         "DescribeTargetHealth",
+		"TargetGroupArn": targetGroupArn,
+	}
+	addTargetParams(params, targets)
+	resp = new(DescribeTargetHealthResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Values of Action.Type.
+const (
+	ActionForward             = "forward"
+	ActionRedirect            = "redirect"
+	ActionFixedResponse       = "fixed-response"
+	ActionAuthenticateOidc    = "authenticate-oidc"
+	ActionAuthenticateCognito = "authenticate-cognito"
+)
+
+// Values of OnUnauthenticatedRequest in authenticate actions.
+const (
+	OnUnauthenticatedDeny         = "deny"
+	OnUnauthenticatedAllow        = "allow"
+	OnUnauthenticatedAuthenticate = "authenticate"
+)
+
+// RedirectConfig describes the redirect of a "redirect" action. Empty
+// fields keep the corresponding part of the request URL; StatusCode is
+// "HTTP_301" or "HTTP_302".
+type RedirectConfig struct {
+	Protocol   string
+	Port       string
+	Host       string
+	Path       string
+	Query      string
+	StatusCode string
+}
+
+// FixedResponseConfig describes the response of a "fixed-response" action.
+type FixedResponseConfig struct {
+	StatusCode  string
+	ContentType string
+	MessageBody string
+}
+
+// AuthParams maps the names of extra query parameters sent to the
+// authorization endpoint of an authenticate action to their values.
+type AuthParams map[string]string
+
+// UnmarshalXML decodes the entries of an AuthenticationRequestExtraParams
+// element.
+func (m *AuthParams) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var entries struct {
+		Entry []struct {
+			Key   string `xml:"key"`
+			Value string `xml:"value"`
+		} `xml:"entry"`
+	}
+	if err := d.DecodeElement(&entries, &start); err != nil {
+		return err
+	}
+	*m = make(AuthParams, len(entries.Entry))
+	for _, e := range entries.Entry {
+		(*m)[e.Key] = e.Value
+	}
+	return nil
+}
+
+// AuthenticateOidcConfig describes how an "authenticate-oidc" action
+// authenticates users with an OpenID Connect identity provider. The
+// endpoints must be HTTPS URLs. ClientSecret is required when creating the
+// action; set UseExistingClientSecret instead to keep the current one when
+// modifying it. Scope defaults to "openid", SessionCookieName to
+// "AWSELBAuthSessionCookie" and SessionTimeout to 604800 seconds.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_AuthenticateOidcActionConfig.html for more details.
+type AuthenticateOidcConfig struct {
+	Issuer                           string
+	AuthorizationEndpoint            string
+	TokenEndpoint                    string
+	UserInfoEndpoint                 string
+	ClientId                         string
+	ClientSecret                     string
+	UseExistingClientSecret          bool
+	Scope                            string
+	SessionCookieName                string
+	SessionTimeout                   int64
+	OnUnauthenticatedRequest         string
+	AuthenticationRequestExtraParams AuthParams
+}
+
+// AuthenticateCognitoConfig describes how an "authenticate-cognito" action
+// authenticates users with an Amazon Cognito user pool. The optional
+// settings have the same defaults as in AuthenticateOidcConfig.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_AuthenticateCognitoActionConfig.html for more details.
+type AuthenticateCognitoConfig struct {
+	UserPoolArn                      string
+	UserPoolClientId                 string
+	UserPoolDomain                   string
+	Scope                            string
+	SessionCookieName                string
+	SessionTimeout                   int64
+	OnUnauthenticatedRequest         string
+	AuthenticationRequestExtraParams AuthParams
+}
+
+// Action is what a listener or rule does with the requests it matches.
+// Order sets the order of the actions when there are several; an
+// authenticate action must come before the forward or other action that
+// serves authenticated requests.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_Action.html for more details.
+type Action struct {
+	Type                string
+	TargetGroupArn      string
+	Order               int
+	RedirectConfig      *RedirectConfig
+	FixedResponseConfig *FixedResponseConfig
+
+	AuthenticateOidcConfig    *AuthenticateOidcConfig
+	AuthenticateCognitoConfig *AuthenticateCognitoConfig
+}
+
+// Listener describes a listener of a load balancer.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_Listener.html for more details.
+type Listener struct {
+	ListenerArn     string
+	LoadBalancerArn string
+	Port            int
+	Protocol        string
+	SslPolicy       string
+	Certificates    []Certificate `xml:"Certificates>member"`
+	DefaultActions  []Action      `xml:"DefaultActions>member"`
+}
+
+// CreateListener holds the options of a new listener. HTTPS and TLS
+// listeners need exactly one certificate, the default one; more can be
+// added with AddListenerCertificates.
+type CreateListener struct {
+	LoadBalancerArn string
+	Protocol        string
+	Port            int
+	SslPolicy       string
+	Certificates    []string
+	DefaultActions  []Action
+}
+
+type CreateListenerResp struct {
+	RequestId string     `xml:"ResponseMetadata>RequestId"`
+	Listeners []Listener `xml:"CreateListenerResult>Listeners>member"`
+}
+
+// CreateListener creates a listener for a load balancer.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateListener.html for more details.
+func (elb *ELBV2) CreateListener(options *CreateListener) (resp *CreateListenerResp, err error) {
+	params := map[string]string{
+		"Action":          "CreateListener",
+		"LoadBalancerArn": options.LoadBalancerArn,
+		"Protocol":        options.Protocol,
+		"Port":            strconv.Itoa(options.Port),
+	}
+	if options.SslPolicy != "" {
+		params["SslPolicy"] = options.SslPolicy
+	}
+	addCertificateParams(params, options.Certificates)
+	addActionParams(params, "DefaultActions", options.DefaultActions)
+	resp = new(CreateListenerResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ModifyListener holds the settings of a listener to change; zero values
+// leave the corresponding settings as they are.
+type ModifyListener struct {
+	ListenerArn    string
+	Protocol       string
+	Port           int
+	SslPolicy      string
+	Certificates   []string
+	DefaultActions []Action
+}
+
+type ModifyListenerResp struct {
+	RequestId string     `xml:"ResponseMetadata>RequestId"`
+	Listeners []Listener `xml:"ModifyListenerResult>Listeners>member"`
+}
+
+// ModifyListener changes the port, protocol, default certificate or
+// default actions of a listener. Changing the protocol from HTTPS to HTTP
+// removes its certificates and security policy.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_ModifyListener.html for more details.
+func (elb *ELBV2) ModifyListener(options *ModifyListener) (resp *ModifyListenerResp, err error) {
+	params := map[string]string{
+		"Action":      "ModifyListener",
+		"ListenerArn": options.ListenerArn,
+	}
+	if options.Protocol != "" {
+		params["Protocol"] = options.Protocol
+	}
+	if options.Port != 0 {
+		params["Port"] = strconv.Itoa(options.Port)
+	}
+	if options.SslPolicy != "" {
+		params["SslPolicy"] = options.SslPolicy
+	}
+	addCertificateParams(params, options.Certificates)
+	addActionParams(params, "DefaultActions", options.DefaultActions)
+	resp = new(ModifyListenerResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RuleCondition matches requests on a field such as "host-header" or
+// "path-pattern" against patterns that may use * and ? wildcards.
+type RuleCondition struct {
+	Field  string
+	Values []string `xml:"Values>member"`
+}
+
+// Rule describes a rule of a listener. The default rule has the priority
+// "default".
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_Rule.html for more details.
+type Rule struct {
+	RuleArn    string
+	Priority   string
+	IsDefault  bool
+	Conditions []RuleCondition `xml:"Conditions>member"`
+	Actions    []Action        `xml:"Actions>member"`
+}
+
+// CreateRule holds the options of a new listener rule. Rules are evaluated
+// by increasing Priority, from 1 to 50000, which must be unique to the
+// listener.
+type CreateRule struct {
+	ListenerArn string
+	Priority    int
+	Conditions  []RuleCondition
+	Actions     []Action
+}
+
+type CreateRuleResp struct {
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+	Rules     []Rule `xml:"CreateRuleResult>Rules>member"`
+}
+
+// CreateRule creates a rule for an Application Load Balancer listener.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_CreateRule.html for more details.
+func (elb *ELBV2) CreateRule(options *CreateRule) (resp *CreateRuleResp, err error) {
+	params := map[string]string{
+		"Action":      "CreateRule",
+		"ListenerArn": options.ListenerArn,
+		"Priority":    strconv.Itoa(options.Priority),
+	}
+	for i, cond := range options.Conditions {
+		prefix := fmt.Sprintf("Conditions.member.%d.", i+1)
+		params[prefix+"Field"] = cond.Field
+		for j, v := range cond.Values {
+			params[fmt.Sprintf("%sValues.member.%d", prefix, j+1)] = v
+		}
+	}
+	addActionParams(params, "Actions", options.Actions)
+	resp = new(CreateRuleResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func addTagParams(params map[string]string, tags []Tag) {
+	for i, tag := range tags {
+		params[fmt.Sprintf("Tags.member.%d.Key", i+1)] = tag.Key
+		params[fmt.Sprintf("Tags.member.%d.Value", i+1)] = tag.Value
+	}
+}
+
+func addTargetParams(params map[string]string, targets []Target) {
+	for i, t := range targets {
+		prefix := fmt.Sprintf("Targets.member.%d.", i+1)
+		params[prefix+"Id"] = t.Id
+		if t.Port != 0 {
+			params[prefix+"Port"] = strconv.Itoa(t.Port)
+		}
+		if t.AvailabilityZone != "" {
+			params[prefix+"AvailabilityZone"] = t.AvailabilityZone
+		}
+	}
+}
+
+func addActionParams(params map[string]string, name string, actions []Action) {
+	for i, a := range actions {
+		prefix := fmt.Sprintf("%s.member.%d.", name, i+1)
+		params[prefix+"Type"] = a.Type
+		if a.TargetGroupArn != "" {
+			params[prefix+"TargetGroupArn"] = a.TargetGroupArn
+		}
+		if a.Order != 0 {
+			params[prefix+"Order"] = strconv.Itoa(a.Order)
+		}
+		if r := a.RedirectConfig; r != nil {
+			setNonEmpty(params, prefix+"RedirectConfig.Protocol", r.Protocol)
+			setNonEmpty(params, prefix+"RedirectConfig.Port", r.Port)
+			setNonEmpty(params, prefix+"RedirectConfig.Host", r.Host)
+			setNonEmpty(params, prefix+"RedirectConfig.Path", r.Path)
+			setNonEmpty(params, prefix+"RedirectConfig.Query", r.Query)
+			setNonEmpty(params, prefix+"RedirectConfig.StatusCode", r.StatusCode)
+		}
+		if f := a.FixedResponseConfig; f != nil {
+			setNonEmpty(params, prefix+"FixedResponseConfig.StatusCode", f.StatusCode)
+			setNonEmpty(params, prefix+"FixedResponseConfig.ContentType", f.ContentType)
+			setNonEmpty(params, prefix+"FixedResponseConfig.MessageBody", f.MessageBody)
+		}
+		if o := a.AuthenticateOidcConfig; o != nil {
+			p := prefix + "AuthenticateOidcConfig."
+			setNonEmpty(params, p+"Issuer", o.Issuer)
+			setNonEmpty(params, p+"AuthorizationEndpoint", o.AuthorizationEndpoint)
+			setNonEmpty(params, p+"TokenEndpoint", o.TokenEndpoint)
+			setNonEmpty(params, p+"UserInfoEndpoint", o.UserInfoEndpoint)
+			setNonEmpty(params, p+"ClientId", o.ClientId)
+			setNonEmpty(params, p+"ClientSecret", o.ClientSecret)
+			if o.UseExistingClientSecret {
+				params[p+"UseExistingClientSecret"] = "true"
+			}
+			addAuthParams(params, p, o.Scope, o.SessionCookieName, o.SessionTimeout,
+				o.OnUnauthenticatedRequest, o.AuthenticationRequestExtraParams)
+		}
+		if g := a.AuthenticateCognitoConfig; g != nil {
+			p := prefix + "AuthenticateCognitoConfig."
+			setNonEmpty(params, p+"UserPoolArn", g.UserPoolArn)
+			setNonEmpty(params, p+"UserPoolClientId", g.UserPoolClientId)
+			setNonEmpty(params, p+"UserPoolDomain", g.UserPoolDomain)
+			addAuthParams(params, p, g.Scope, g.SessionCookieName, g.SessionTimeout,
+				g.OnUnauthenticatedRequest, g.AuthenticationRequestExtraParams)
+		}
+	}
+}
+
+// addAuthParams adds the session settings shared by both authenticate
+// actions. The extra parameters are numbered in order of name.
+func addAuthParams(params map[string]string, prefix, scope, cookieName string, timeout int64, onUnauthenticated string, extra AuthParams) {
+	setNonEmpty(params, prefix+"Scope", scope)
+	setNonEmpty(params, prefix+"SessionCookieName", cookieName)
+	if timeout != 0 {
+		params[prefix+"SessionTimeout"] = strconv.FormatInt(timeout, 10)
+	}
+	setNonEmpty(params, prefix+"OnUnauthenticatedRequest", onUnauthenticated)
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		entry := fmt.Sprintf("%sAuthenticationRequestExtraParams.entry.%d.", prefix, i+1)
+		params[entry+"key"] = k
+		params[entry+"value"] = extra[k]
+	}
+}
+
+func setNonEmpty(params map[string]string, key, value string) {
+	if value != "" {
+		params[key] = value
+	}
+}
+
+// Certificate is a server certificate attached to an HTTPS or TLS listener.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_Certificate.html for more details.
+type Certificate struct {
+	CertificateArn string
+	IsDefault      bool
+}
+
+type AddListenerCertificatesResp struct {
+	RequestId    string        `xml:"ResponseMetadata>RequestId"`
+	Certificates []Certificate `xml:"AddListenerCertificatesResult>Certificates>member"`
+}
+
+// AddListenerCertificates adds the certificates to the certificate list of
+// an HTTPS or TLS listener, where they are selected by SNI.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_AddListenerCertificates.html for more details.
+func (elb *ELBV2) AddListenerCertificates(listenerArn string, certificateArns ...string) (resp *AddListenerCertificatesResp, err error) {
+	params := map[string]string{
+		"Action":      "AddListenerCertificates",
+		"ListenerArn": listenerArn,
+	}
+	addCertificateParams(params, certificateArns)
+	resp = new(AddListenerCertificatesResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RemoveListenerCertificates removes the certificates from the certificate
+// list of an HTTPS or TLS listener. The default certificate can't be
+// removed this way.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_RemoveListenerCertificates.html for more details.
+func (elb *ELBV2) RemoveListenerCertificates(listenerArn string, certificateArns ...string) (resp *SimpleResp, err error) {
+	params := map[string]string{
+		"Action":      "RemoveListenerCertificates",
+		"ListenerArn": listenerArn,
+	}
+	addCertificateParams(params, certificateArns)
+	resp = new(SimpleResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type DescribeListenerCertificatesResp struct {
+	RequestId    string        `xml:"ResponseMetadata>RequestId"`
+	Certificates []Certificate `xml:"DescribeListenerCertificatesResult>Certificates>member"`
+	NextMarker   string        `xml:"DescribeListenerCertificatesResult>NextMarker"`
+}
+
+// DescribeListenerCertificates describes one page of the certificates of an
+// HTTPS or TLS listener, including the default certificate. Pass the
+// NextMarker of a response as marker to get the next page; marker and
+// pageSize may be "" and 0.
+//
+// See http://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_DescribeListenerCertificates.html for more details.
+func (elb *ELBV2) DescribeListenerCertificates(listenerArn, marker string, pageSize int) (resp *DescribeListenerCertificatesResp, err error) {
+	params := map[string]string{
+		"Action":      "DescribeListenerCertificates",
+		"ListenerArn": listenerArn,
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	if pageSize != 0 {
+		params["PageSize"] = strconv.Itoa(pageSize)
+	}
+	resp = new(DescribeListenerCertificatesResp)
+	if err := elb.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func addCertificateParams(params map[string]string, certificateArns []string) {
+	for i, arn := range certificateArns {
+		params[fmt.Sprintf("Certificates.member.%d.CertificateArn", i+1)] = arn
+	}
+}
+
+func (elb *ELBV2) query(params map[string]string, resp interface{}) error {
+	params["Version"] = "2015-12-01"
+	params["Timestamp"] = time.Now().In(time.UTC).Format(time.RFC3339)
+	endpoint, err := url.Parse(elb.Region.ELBEndpoint)
+	if err != nil {
+		return err
+	}
+	if endpoint.Path == "" {
+		endpoint.Path = "/"
+	}
+	signer, err := aws.NewV2Signer(elb.Auth, aws.ServiceInfo{Endpoint: elb.Region.ELBEndpoint, Signer: aws.V2Signature})
+	if err != nil {
+		return err
+	}
+	signer.Sign("GET", endpoint.Path, params)
+	endpoint.RawQuery = multimap(params).Encode()
+
+	r, err := http.Get(endpoint.String())
+	if err != nil {
+		return err
+	}
+	defer r.Body.Close()
+	if r.StatusCode != 200 {
+		return buildError(r)
+	}
+	return xml.NewDecoder(r.Body).Decode(resp)
+}
+
+// Error encapsulates an error returned by ELBv2.
+type Error struct {
+	// HTTP status code
+	StatusCode int
+	// AWS error code
+	Code string
+	// The human-oriented error message
+	Message string
+}
+
+func (err *Error) Error() string {
+	if err.Code == "" {
+		return err.Message
+	}
+
+	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
+}
+
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, "")
+}
+
+type xmlErrors struct {
+	Errors []Error `xml:"Error"`
+}
+
+func buildError(r *http.Response) error {
+	var (
+		err    Error
+		errors xmlErrors
+	)
+	xml.NewDecoder(r.Body).Decode(&errors)
+	if len(errors.Errors) > 0 {
+		err = errors.Errors[0]
+	}
+	err.StatusCode = r.StatusCode
+	if err.Message == "" {
+		err.Message = r.Status
+	}
+	return &err
+}
+
+func multimap(p map[string]string) url.Values {
+	q := make(url.Values, len(p))
+	for k, v := range p {
+		q[k] = []string{v}
+	}
+	return q
+}
@@ -0,0 +1,382 @@
+package elbv2_test
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/elbv2"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+type S struct {
+	elb *elbv2.ELBV2
+}
+
+var _ = check.Suite(&S{})
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.elb = elbv2.New(auth, aws.Region{ELBEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+const listenerArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-load-balancer/50dc6c495c0c9188/f2f7dc8efc522ab2"
+
+func (s *S) TestAddListenerCertificates(c *check.C) {
+	testServer.Response(200, nil, AddListenerCertificatesExample)
+	resp, err := s.elb.AddListenerCertificates(listenerArn,
+		"arn:aws:acm:us-west-2:123456789012:certificate/5cc54884-f4a3-4072-80be-05b9ba72f705")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Version"), check.Equals, "2015-12-01")
+	c.Assert(values.Get("Action"), check.Equals, "AddListenerCertificates")
+	c.Assert(values.Get("ListenerArn"), check.Equals, listenerArn)
+	c.Assert(values.Get("Certificates.member.1.CertificateArn"), check.Equals,
+		"arn:aws:acm:us-west-2:123456789012:certificate/5cc54884-f4a3-4072-80be-05b9ba72f705")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Certificates, check.DeepEquals, []elbv2.Certificate{
+		{CertificateArn: "arn:aws:acm:us-west-2:123456789012:certificate/5cc54884-f4a3-4072-80be-05b9ba72f705"},
+	})
+}
+
+func (s *S) TestRemoveListenerCertificates(c *check.C) {
+	testServer.Response(200, nil, RemoveListenerCertificatesExample)
+	resp, err := s.elb.RemoveListenerCertificates(listenerArn, "cert-1", "cert-2")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "RemoveListenerCertificates")
+	c.Assert(values.Get("Certificates.member.1.CertificateArn"), check.Equals, "cert-1")
+	c.Assert(values.Get("Certificates.member.2.CertificateArn"), check.Equals, "cert-2")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "8e7a7c3f-1b3a-11e8-8a4e-3b6d2e4a5c1f")
+}
+
+func (s *S) TestDescribeListenerCertificates(c *check.C) {
+	testServer.Response(200, nil, DescribeListenerCertificatesExample)
+	resp, err := s.elb.DescribeListenerCertificates(listenerArn, "abc", 10)
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DescribeListenerCertificates")
+	c.Assert(values.Get("Marker"), check.Equals, "abc")
+	c.Assert(values.Get("PageSize"), check.Equals, "10")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.NextMarker, check.Equals, "def")
+	c.Assert(resp.Certificates, check.DeepEquals, []elbv2.Certificate{
+		{CertificateArn: "arn:aws:acm:us-west-2:123456789012:certificate/default", IsDefault: true},
+		{CertificateArn: "arn:aws:acm:us-west-2:123456789012:certificate/tenant-a", IsDefault: false},
+	})
+}
+
+func (s *S) TestListenerNotFound(c *check.C) {
+	testServer.Response(400, nil, ListenerNotFoundExample)
+	_, err := s.elb.DescribeListenerCertificates(listenerArn, "", 0)
+	values := testServer.WaitRequest().URL.Query()
+	_, ok := values["Marker"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(err, check.NotNil)
+	e, ok := err.(*elbv2.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(e.StatusCode, check.Equals, 400)
+	c.Assert(e.Code, check.Equals, "ListenerNotFound")
+	c.Assert(err.Error(), check.Equals, "One or more listeners not found (ListenerNotFound)")
+}
+
+const (
+	loadBalancerArn = "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188"
+	targetGroupArn  = "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067"
+)
+
+func (s *S) TestCreateLoadBalancer(c *check.C) {
+	testServer.Response(200, nil, CreateLoadBalancerExample)
+	resp, err := s.elb.CreateLoadBalancer(&elbv2.CreateLoadBalancer{
+		Name:           "my-load-balancer",
+		Subnets:        []string{"subnet-8360a9e7", "subnet-b7d581c0"},
+		SecurityGroups: []string{"sg-5943793c"},
+		Tags:           []elbv2.Tag{{Key: "env", Value: "prod"}},
+	})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Version"), check.Equals, "2015-12-01")
+	c.Assert(values.Get("Action"), check.Equals, "CreateLoadBalancer")
+	c.Assert(values.Get("Name"), check.Equals, "my-load-balancer")
+	c.Assert(values.Get("Subnets.member.1"), check.Equals, "subnet-8360a9e7")
+	c.Assert(values.Get("Subnets.member.2"), check.Equals, "subnet-b7d581c0")
+	c.Assert(values.Get("SecurityGroups.member.1"), check.Equals, "sg-5943793c")
+	c.Assert(values.Get("Tags.member.1.Key"), check.Equals, "env")
+	c.Assert(values.Get("Tags.member.1.Value"), check.Equals, "prod")
+	_, ok := values["Scheme"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.LoadBalancers, check.HasLen, 1)
+	lb := resp.LoadBalancers[0]
+	c.Assert(lb.LoadBalancerArn, check.Equals, loadBalancerArn)
+	c.Assert(lb.DNSName, check.Equals, "my-load-balancer-424835706.us-west-2.elb.amazonaws.com")
+	c.Assert(lb.State, check.Equals, "provisioning")
+	c.Assert(lb.Type, check.Equals, "application")
+	c.Assert(lb.CreatedTime.Year(), check.Equals, 2016)
+	c.Assert(lb.SecurityGroups, check.DeepEquals, []string{"sg-5943793c"})
+	c.Assert(lb.AvailabilityZones, check.DeepEquals, []elbv2.AvailabilityZone{
+		{ZoneName: "us-west-2a", SubnetId: "subnet-8360a9e7"},
+		{ZoneName: "us-west-2b", SubnetId: "subnet-b7d581c0"},
+	})
+}
+
+func (s *S) TestCreateTargetGroup(c *check.C) {
+	testServer.Response(200, nil, CreateTargetGroupExample)
+	resp, err := s.elb.CreateTargetGroup(&elbv2.CreateTargetGroup{
+		Name:            "my-targets",
+		Protocol:        "HTTP",
+		Port:            80,
+		VpcId:           "vpc-3ac0fb5f",
+		HealthCheckPath: "/health",
+		Matcher:         "200",
+	})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "CreateTargetGroup")
+	c.Assert(values.Get("Name"), check.Equals, "my-targets")
+	c.Assert(values.Get("Protocol"), check.Equals, "HTTP")
+	c.Assert(values.Get("Port"), check.Equals, "80")
+	c.Assert(values.Get("VpcId"), check.Equals, "vpc-3ac0fb5f")
+	c.Assert(values.Get("HealthCheckPath"), check.Equals, "/health")
+	c.Assert(values.Get("Matcher.HttpCode"), check.Equals, "200")
+	_, ok := values["HealthCheckIntervalSeconds"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.TargetGroups, check.HasLen, 1)
+	tg := resp.TargetGroups[0]
+	c.Assert(tg.TargetGroupArn, check.Equals, targetGroupArn)
+	c.Assert(tg.HealthCheckPort, check.Equals, "traffic-port")
+	c.Assert(tg.HealthCheckIntervalSeconds, check.Equals, 30)
+	c.Assert(tg.Matcher, check.Equals, "200")
+}
+
+func (s *S) TestRegisterTargets(c *check.C) {
+	testServer.Response(200, nil, RegisterTargetsExample)
+	resp, err := s.elb.RegisterTargets(targetGroupArn, elbv2.Target{Id: "i-0f76fade"}, elbv2.Target{Id: "i-0f76fadf", Port: 8080})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "RegisterTargets")
+	c.Assert(values.Get("TargetGroupArn"), check.Equals, targetGroupArn)
+	c.Assert(values.Get("Targets.member.1.Id"), check.Equals, "i-0f76fade")
+	_, ok := values["Targets.member.1.Port"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(values.Get("Targets.member.2.Id"), check.Equals, "i-0f76fadf")
+	c.Assert(values.Get("Targets.member.2.Port"), check.Equals, "8080")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "f9880f01-f852-11e5-a4da-8d4bc5b8b0e6")
+}
+
+func (s *S) TestDescribeTargetHealth(c *check.C) {
+	testServer.Response(200, nil, DescribeTargetHealthExample)
+	resp, err := s.elb.DescribeTargetHealth(targetGroupArn)
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DescribeTargetHealth")
+	c.Assert(values.Get("TargetGroupArn"), check.Equals, targetGroupArn)
+	_, ok := values["Targets.member.1.Id"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.TargetHealthDescriptions, check.DeepEquals, []elbv2.TargetHealthDescription{
+		{
+			Target:          elbv2.Target{Id: "i-0f76fade", Port: 80},
+			HealthCheckPort: "80",
+			State:           "healthy",
+		},
+		{
+			Target:          elbv2.Target{Id: "i-0f76fadf", Port: 80},
+			HealthCheckPort: "80",
+			State:           "unhealthy",
+			Reason:          "Target.ResponseCodeMismatch",
+			Description:     "Health checks failed with these codes: [404]",
+		},
+	})
+}
+
+func (s *S) TestCreateListener(c *check.C) {
+	testServer.Response(200, nil, CreateListenerExample)
+	resp, err := s.elb.CreateListener(&elbv2.CreateListener{
+		LoadBalancerArn: loadBalancerArn,
+		Protocol:        "HTTPS",
+		Port:            443,
+		SslPolicy:       "ELBSecurityPolicy-2016-08",
+		Certificates:    []string{"arn:aws:acm:us-west-2:123456789012:certificate/default"},
+		DefaultActions:  []elbv2.Action{{Type: elbv2.ActionForward, TargetGroupArn: targetGroupArn}},
+	})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "CreateListener")
+	c.Assert(values.Get("LoadBalancerArn"), check.Equals, loadBalancerArn)
+	c.Assert(values.Get("Protocol"), check.Equals, "HTTPS")
+	c.Assert(values.Get("Port"), check.Equals, "443")
+	c.Assert(values.Get("SslPolicy"), check.Equals, "ELBSecurityPolicy-2016-08")
+	c.Assert(values.Get("Certificates.member.1.CertificateArn"), check.Equals, "arn:aws:acm:us-west-2:123456789012:certificate/default")
+	c.Assert(values.Get("DefaultActions.member.1.Type"), check.Equals, "forward")
+	c.Assert(values.Get("DefaultActions.member.1.TargetGroupArn"), check.Equals, targetGroupArn)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Listeners, check.HasLen, 1)
+	l := resp.Listeners[0]
+	c.Assert(l.ListenerArn, check.Equals, listenerArn)
+	c.Assert(l.Port, check.Equals, 443)
+	c.Assert(l.Certificates, check.HasLen, 1)
+	c.Assert(l.DefaultActions, check.DeepEquals, []elbv2.Action{{Type: elbv2.ActionForward, TargetGroupArn: targetGroupArn}})
+}
+
+func (s *S) TestModifyListener(c *check.C) {
+	testServer.Response(200, nil, ModifyListenerExample)
+	redirect := &elbv2.RedirectConfig{
+		Protocol:   "HTTPS",
+		Port:       "443",
+		StatusCode: "HTTP_301",
+	}
+	resp, err := s.elb.ModifyListener(&elbv2.ModifyListener{
+		ListenerArn:    listenerArn,
+		DefaultActions: []elbv2.Action{{Type: elbv2.ActionRedirect, RedirectConfig: redirect}},
+	})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ModifyListener")
+	c.Assert(values.Get("ListenerArn"), check.Equals, listenerArn)
+	c.Assert(values.Get("DefaultActions.member.1.Type"), check.Equals, "redirect")
+	c.Assert(values.Get("DefaultActions.member.1.RedirectConfig.Protocol"), check.Equals, "HTTPS")
+	c.Assert(values.Get("DefaultActions.member.1.RedirectConfig.Port"), check.Equals, "443")
+	c.Assert(values.Get("DefaultActions.member.1.RedirectConfig.StatusCode"), check.Equals, "HTTP_301")
+	for _, key := range []string{"Port", "Protocol", "SslPolicy", "DefaultActions.member.1.RedirectConfig.Host"} {
+		_, ok := values[key]
+		c.Assert(ok, check.Equals, false, check.Commentf("%s", key))
+	}
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Listeners, check.HasLen, 1)
+	action := resp.Listeners[0].DefaultActions[0]
+	c.Assert(action.Type, check.Equals, elbv2.ActionRedirect)
+	c.Assert(*action.RedirectConfig, check.DeepEquals, elbv2.RedirectConfig{
+		Protocol:   "HTTPS",
+		Port:       "443",
+		Host:       "#{host}",
+		Path:       "/#{path}",
+		Query:      "#{query}",
+		StatusCode: "HTTP_301",
+	})
+}
+
+func (s *S) TestCreateRule(c *check.C) {
+	testServer.Response(200, nil, CreateRuleExample)
+	resp, err := s.elb.CreateRule(&elbv2.CreateRule{
+		ListenerArn: listenerArn,
+		Priority:    10,
+		Conditions:  []elbv2.RuleCondition{{Field: "path-pattern", Values: []string{"/img/*"}}},
+		Actions:     []elbv2.Action{{Type: elbv2.ActionForward, TargetGroupArn: targetGroupArn}},
+	})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "CreateRule")
+	c.Assert(values.Get("ListenerArn"), check.Equals, listenerArn)
+	c.Assert(values.Get("Priority"), check.Equals, "10")
+	c.Assert(values.Get("Conditions.member.1.Field"), check.Equals, "path-pattern")
+	c.Assert(values.Get("Conditions.member.1.Values.member.1"), check.Equals, "/img/*")
+	c.Assert(values.Get("Actions.member.1.Type"), check.Equals, "forward")
+	c.Assert(values.Get("Actions.member.1.TargetGroupArn"), check.Equals, targetGroupArn)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Rules, check.HasLen, 1)
+	rule := resp.Rules[0]
+	c.Assert(rule.Priority, check.Equals, "10")
+	c.Assert(rule.IsDefault, check.Equals, false)
+	c.Assert(rule.Conditions, check.DeepEquals, []elbv2.RuleCondition{{Field: "path-pattern", Values: []string{"/img/*"}}})
+	c.Assert(rule.Actions, check.DeepEquals, []elbv2.Action{{Type: elbv2.ActionForward, TargetGroupArn: targetGroupArn}})
+}
+
+func (s *S) TestCreateRuleAuthenticateOidc(c *check.C) {
+	testServer.Response(200, nil, CreateAuthenticatedRuleExample)
+	oidc := &elbv2.AuthenticateOidcConfig{
+		Issuer:                   "https://idp.example.com",
+		AuthorizationEndpoint:    "https://idp.example.com/authorize",
+		TokenEndpoint:            "https://idp.example.com/token",
+		UserInfoEndpoint:         "https://idp.example.com/userinfo",
+		ClientId:                 "my-client",
+		ClientSecret:             "secret",
+		Scope:                    "openid email",
+		SessionTimeout:           3600,
+		OnUnauthenticatedRequest: elbv2.OnUnauthenticatedAuthenticate,
+		AuthenticationRequestExtraParams: elbv2.AuthParams{
+			"prompt":  "login",
+			"display": "page",
+		},
+	}
+	resp, err := s.elb.CreateRule(&elbv2.CreateRule{
+		ListenerArn: listenerArn,
+		Priority:    5,
+		Conditions:  []elbv2.RuleCondition{{Field: "path-pattern", Values: []string{"/admin/*"}}},
+		Actions: []elbv2.Action{
+			{Type: elbv2.ActionAuthenticateOidc, Order: 1, AuthenticateOidcConfig: oidc},
+			{Type: elbv2.ActionForward, Order: 2, TargetGroupArn: targetGroupArn},
+		},
+	})
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Actions.member.1.Type"), check.Equals, "authenticate-oidc")
+	c.Assert(values.Get("Actions.member.1.Order"), check.Equals, "1")
+	prefix := "Actions.member.1.AuthenticateOidcConfig."
+	c.Assert(values.Get(prefix+"Issuer"), check.Equals, "https://idp.example.com")
+	c.Assert(values.Get(prefix+"AuthorizationEndpoint"), check.Equals, "https://idp.example.com/authorize")
+	c.Assert(values.Get(prefix+"TokenEndpoint"), check.Equals, "https://idp.example.com/token")
+	c.Assert(values.Get(prefix+"UserInfoEndpoint"), check.Equals, "https://idp.example.com/userinfo")
+	c.Assert(values.Get(prefix+"ClientId"), check.Equals, "my-client")
+	c.Assert(values.Get(prefix+"ClientSecret"), check.Equals, "secret")
+	c.Assert(values.Get(prefix+"Scope"), check.Equals, "openid email")
+	c.Assert(values.Get(prefix+"SessionTimeout"), check.Equals, "3600")
+	c.Assert(values.Get(prefix+"OnUnauthenticatedRequest"), check.Equals, "authenticate")
+	c.Assert(values.Get(prefix+"AuthenticationRequestExtraParams.entry.1.key"), check.Equals, "display")
+	c.Assert(values.Get(prefix+"AuthenticationRequestExtraParams.entry.1.value"), check.Equals, "page")
+	c.Assert(values.Get(prefix+"AuthenticationRequestExtraParams.entry.2.key"), check.Equals, "prompt")
+	c.Assert(values.Get(prefix+"AuthenticationRequestExtraParams.entry.2.value"), check.Equals, "login")
+	for _, key := range []string{"SessionCookieName", "UseExistingClientSecret"} {
+		_, ok := values[prefix+key]
+		c.Assert(ok, check.Equals, false, check.Commentf("%s should not be set", key))
+	}
+	c.Assert(values.Get("Actions.member.2.Type"), check.Equals, "forward")
+	c.Assert(values.Get("Actions.member.2.Order"), check.Equals, "2")
+
+	c.Assert(err, check.IsNil)
+	actions := resp.Rules[0].Actions
+	c.Assert(actions, check.HasLen, 2)
+	c.Assert(actions[0].Type, check.Equals, elbv2.ActionAuthenticateOidc)
+	c.Assert(actions[0].AuthenticateOidcConfig, check.DeepEquals, &elbv2.AuthenticateOidcConfig{
+		Issuer:                           "https://idp.example.com",
+		AuthorizationEndpoint:            "https://idp.example.com/authorize",
+		TokenEndpoint:                    "https://idp.example.com/token",
+		UserInfoEndpoint:                 "https://idp.example.com/userinfo",
+		ClientId:                         "my-client",
+		Scope:                            "openid email",
+		SessionCookieName:                "AWSELBAuthSessionCookie",
+		SessionTimeout:                   3600,
+		OnUnauthenticatedRequest:         "authenticate",
+		AuthenticationRequestExtraParams: elbv2.AuthParams{"prompt": "login"},
+	})
+	c.Assert(actions[1].TargetGroupArn, check.Equals, targetGroupArn)
+}
+
+func (s *S) TestModifyListenerAuthenticateCognito(c *check.C) {
+	testServer.Response(200, nil, ModifyListenerExample)
+	_, err := s.elb.ModifyListener(&elbv2.ModifyListener{
+		ListenerArn: listenerArn,
+		DefaultActions: []elbv2.Action{
+			{Type: elbv2.ActionAuthenticateCognito, Order: 1, AuthenticateCognitoConfig: &elbv2.AuthenticateCognitoConfig{
+				UserPoolArn:              "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abcdef",
+				UserPoolClientId:         "client",
+				UserPoolDomain:           "my-domain",
+				OnUnauthenticatedRequest: elbv2.OnUnauthenticatedDeny,
+			}},
+			{Type: elbv2.ActionForward, Order: 2, TargetGroupArn: targetGroupArn},
+		},
+	})
+	c.Assert(err, check.IsNil)
+	values := testServer.WaitRequest().URL.Query()
+	prefix := "DefaultActions.member.1.AuthenticateCognitoConfig."
+	c.Assert(values.Get("DefaultActions.member.1.Type"), check.Equals, "authenticate-cognito")
+	c.Assert(values.Get(prefix+"UserPoolArn"), check.Equals, "arn:aws:cognito-idp:us-west-2:123456789012:userpool/us-west-2_abcdef")
+	c.Assert(values.Get(prefix+"UserPoolClientId"), check.Equals, "client")
+	c.Assert(values.Get(prefix+"UserPoolDomain"), check.Equals, "my-domain")
+	c.Assert(values.Get(prefix+"OnUnauthenticatedRequest"), check.Equals, "deny")
+	_, ok := values[prefix+"SessionTimeout"]
+	c.Assert(ok, check.Equals, false)
+}
@@ -0,0 +1,314 @@
+package elbv2_test
+
+var AddListenerCertificatesExample = `
+<AddListenerCertificatesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <AddListenerCertificatesResult>
+    <Certificates>
+      <member>
+        <CertificateArn>arn:aws:acm:us-west-2:123456789012:certificate/5cc54884-f4a3-4072-80be-05b9ba72f705</CertificateArn>
+      </member>
+    </Certificates>
+  </AddListenerCertificatesResult>
+  <ResponseMetadata>
+    <RequestId>c2a6a5c0-1b39-11e8-9a8d-0b8f4c3e1a7d</RequestId>
+  </ResponseMetadata>
+</AddListenerCertificatesResponse>
+`
+
+var RemoveListenerCertificatesExample = `
+<RemoveListenerCertificatesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <RemoveListenerCertificatesResult/>
+  <ResponseMetadata>
+    <RequestId>8e7a7c3f-1b3a-11e8-8a4e-3b6d2e4a5c1f</RequestId>
+  </ResponseMetadata>
+</RemoveListenerCertificatesResponse>
+`
+
+var DescribeListenerCertificatesExample = `
+<DescribeListenerCertificatesResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DescribeListenerCertificatesResult>
+    <Certificates>
+      <member>
+        <CertificateArn>arn:aws:acm:us-west-2:123456789012:certificate/default</CertificateArn>
+        <IsDefault>true</IsDefault>
+      </member>
+      <member>
+        <CertificateArn>arn:aws:acm:us-west-2:123456789012:certificate/tenant-a</CertificateArn>
+        <IsDefault>false</IsDefault>
+      </member>
+    </Certificates>
+    <NextMarker>def</NextMarker>
+  </DescribeListenerCertificatesResult>
+  <ResponseMetadata>
+    <RequestId>d1f0c9a2-1b3a-11e8-b4b6-5f3e2a1c9d0e</RequestId>
+  </ResponseMetadata>
+</DescribeListenerCertificatesResponse>
+`
+
+var ListenerNotFoundExample = `
+<ErrorResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>ListenerNotFound</Code>
+    <Message>One or more listeners not found</Message>
+  </Error>
+  <RequestId>e3b2a1c0-1b3a-11e8-9c7d-2a1b3c4d5e6f</RequestId>
+</ErrorResponse>
+`
+
+var CreateLoadBalancerExample = `
+<CreateLoadBalancerResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <CreateLoadBalancerResult>
+    <LoadBalancers>
+      <member>
+        <LoadBalancerArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188</LoadBalancerArn>
+        <Scheme>internet-facing</Scheme>
+        <LoadBalancerName>my-load-balancer</LoadBalancerName>
+        <VpcId>vpc-3ac0fb5f</VpcId>
+        <CanonicalHostedZoneId>Z2P70J7EXAMPLE</CanonicalHostedZoneId>
+        <CreatedTime>2016-03-25T21:29:48.850Z</CreatedTime>
+        <AvailabilityZones>
+          <member>
+            <SubnetId>subnet-8360a9e7</SubnetId>
+            <ZoneName>us-west-2a</ZoneName>
+          </member>
+          <member>
+            <SubnetId>subnet-b7d581c0</SubnetId>
+            <ZoneName>us-west-2b</ZoneName>
+          </member>
+        </AvailabilityZones>
+        <SecurityGroups>
+          <member>sg-5943793c</member>
+        </SecurityGroups>
+        <DNSName>my-load-balancer-424835706.us-west-2.elb.amazonaws.com</DNSName>
+        <State>
+          <Code>provisioning</Code>
+        </State>
+        <Type>application</Type>
+        <IpAddressType>ipv4</IpAddressType>
+      </member>
+    </LoadBalancers>
+  </CreateLoadBalancerResult>
+  <ResponseMetadata>
+    <RequestId>32d531b2-f2d0-11e5-9192-3fff33344cfa</RequestId>
+  </ResponseMetadata>
+</CreateLoadBalancerResponse>
+`
+
+var CreateTargetGroupExample = `
+<CreateTargetGroupResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <CreateTargetGroupResult>
+    <TargetGroups>
+      <member>
+        <TargetGroupArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067</TargetGroupArn>
+        <HealthCheckTimeoutSeconds>5</HealthCheckTimeoutSeconds>
+        <HealthCheckPort>traffic-port</HealthCheckPort>
+        <Matcher>
+          <HttpCode>200</HttpCode>
+        </Matcher>
+        <TargetGroupName>my-targets</TargetGroupName>
+        <HealthCheckProtocol>HTTP</HealthCheckProtocol>
+        <HealthCheckPath>/health</HealthCheckPath>
+        <Protocol>HTTP</Protocol>
+        <Port>80</Port>
+        <VpcId>vpc-3ac0fb5f</VpcId>
+        <HealthyThresholdCount>5</HealthyThresholdCount>
+        <HealthCheckIntervalSeconds>30</HealthCheckIntervalSeconds>
+        <UnhealthyThresholdCount>2</UnhealthyThresholdCount>
+        <TargetType>instance</TargetType>
+      </member>
+    </TargetGroups>
+  </CreateTargetGroupResult>
+  <ResponseMetadata>
+    <RequestId>b83fe90e-f2d5-11e5-b95d-3b2c1831fc26</RequestId>
+  </ResponseMetadata>
+</CreateTargetGroupResponse>
+`
+
+var RegisterTargetsExample = `
+<RegisterTargetsResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <RegisterTargetsResult/>
+  <ResponseMetadata>
+    <RequestId>f9880f01-f852-11e5-a4da-8d4bc5b8b0e6</RequestId>
+  </ResponseMetadata>
+</RegisterTargetsResponse>
+`
+
+var DescribeTargetHealthExample = `
+<DescribeTargetHealthResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <DescribeTargetHealthResult>
+    <TargetHealthDescriptions>
+      <member>
+        <HealthCheckPort>80</HealthCheckPort>
+        <TargetHealth>
+          <State>healthy</State>
+        </TargetHealth>
+        <Target>
+          <Port>80</Port>
+          <Id>i-0f76fade</Id>
+        </Target>
+      </member>
+      <member>
+        <HealthCheckPort>80</HealthCheckPort>
+        <TargetHealth>
+          <State>unhealthy</State>
+          <Reason>Target.ResponseCodeMismatch</Reason>
+          <Description>Health checks failed with these codes: [404]</Description>
+        </TargetHealth>
+        <Target>
+          <Port>80</Port>
+          <Id>i-0f76fadf</Id>
+        </Target>
+      </member>
+    </TargetHealthDescriptions>
+  </DescribeTargetHealthResult>
+  <ResponseMetadata>
+    <RequestId>c534f810-f389-11e5-9192-3fff33344cfa</RequestId>
+  </ResponseMetadata>
+</DescribeTargetHealthResponse>
+`
+
+var CreateListenerExample = `
+<CreateListenerResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <CreateListenerResult>
+    <Listeners>
+      <member>
+        <LoadBalancerArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188</LoadBalancerArn>
+        <Protocol>HTTPS</Protocol>
+        <Certificates>
+          <member>
+            <CertificateArn>arn:aws:acm:us-west-2:123456789012:certificate/default</CertificateArn>
+          </member>
+        </Certificates>
+        <Port>443</Port>
+        <SslPolicy>ELBSecurityPolicy-2016-08</SslPolicy>
+        <ListenerArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-load-balancer/50dc6c495c0c9188/f2f7dc8efc522ab2</ListenerArn>
+        <DefaultActions>
+          <member>
+            <Type>forward</Type>
+            <TargetGroupArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067</TargetGroupArn>
+          </member>
+        </DefaultActions>
+      </member>
+    </Listeners>
+  </CreateListenerResult>
+  <ResponseMetadata>
+    <RequestId>883d3ad9-f2d3-11e5-b95d-3b2c1831fc26</RequestId>
+  </ResponseMetadata>
+</CreateListenerResponse>
+`
+
+var ModifyListenerExample = `
+<ModifyListenerResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <ModifyListenerResult>
+    <Listeners>
+      <member>
+        <LoadBalancerArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-load-balancer/50dc6c495c0c9188</LoadBalancerArn>
+        <Protocol>HTTP</Protocol>
+        <Port>80</Port>
+        <ListenerArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:listener/app/my-load-balancer/50dc6c495c0c9188/0467ef3c8400ae65</ListenerArn>
+        <DefaultActions>
+          <member>
+            <Type>redirect</Type>
+            <RedirectConfig>
+              <Protocol>HTTPS</Protocol>
+              <Port>443</Port>
+              <Host>#{host}</Host>
+              <Path>/#{path}</Path>
+              <Query>#{query}</Query>
+              <StatusCode>HTTP_301</StatusCode>
+            </RedirectConfig>
+          </member>
+        </DefaultActions>
+      </member>
+    </Listeners>
+  </ModifyListenerResult>
+  <ResponseMetadata>
+    <RequestId>2f4b8b3e-f2d4-11e5-b95d-3b2c1831fc26</RequestId>
+  </ResponseMetadata>
+</ModifyListenerResponse>
+`
+
+var CreateRuleExample = `
+<CreateRuleResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <CreateRuleResult>
+    <Rules>
+      <member>
+        <IsDefault>false</IsDefault>
+        <Conditions>
+          <member>
+            <Field>path-pattern</Field>
+            <Values>
+              <member>/img/*</member>
+            </Values>
+          </member>
+        </Conditions>
+        <Priority>10</Priority>
+        <Actions>
+          <member>
+            <Type>forward</Type>
+            <TargetGroupArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067</TargetGroupArn>
+          </member>
+        </Actions>
+        <RuleArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/my-load-balancer/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee</RuleArn>
+      </member>
+    </Rules>
+  </CreateRuleResult>
+  <ResponseMetadata>
+    <RequestId>c5478c83-f397-11e5-bb98-57195a6eb84a</RequestId>
+  </ResponseMetadata>
+</CreateRuleResponse>
+`
+
+var CreateAuthenticatedRuleExample = `
+<CreateRuleResponse xmlns="http://elasticloadbalancing.amazonaws.com/doc/2015-12-01/">
+  <CreateRuleResult>
+    <Rules>
+      <member>
+        <IsDefault>false</IsDefault>
+        <Conditions>
+          <member>
+            <Field>path-pattern</Field>
+            <Values>
+              <member>/admin/*</member>
+            </Values>
+          </member>
+        </Conditions>
+        <Priority>5</Priority>
+        <Actions>
+          <member>
+            <Type>authenticate-oidc</Type>
+            <Order>1</Order>
+            <AuthenticateOidcConfig>
+              <Issuer>https://idp.example.com</Issuer>
+              <AuthorizationEndpoint>https://idp.example.com/authorize</AuthorizationEndpoint>
+              <TokenEndpoint>https://idp.example.com/token</TokenEndpoint>
+              <UserInfoEndpoint>https://idp.example.com/userinfo</UserInfoEndpoint>
+              <ClientId>my-client</ClientId>
+              <Scope>openid email</Scope>
+              <SessionCookieName>AWSELBAuthSessionCookie</SessionCookieName>
+              <SessionTimeout>3600</SessionTimeout>
+              <OnUnauthenticatedRequest>authenticate</OnUnauthenticatedRequest>
+              <AuthenticationRequestExtraParams>
+                <entry>
+                  <key>prompt</key>
+                  <value>login</value>
+                </entry>
+              </AuthenticationRequestExtraParams>
+            </AuthenticateOidcConfig>
+          </member>
+          <member>
+            <Type>forward</Type>
+            <Order>2</Order>
+            <TargetGroupArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-targets/73e2d6bc24d8a067</TargetGroupArn>
+          </member>
+        </Actions>
+        <RuleArn>arn:aws:elasticloadbalancing:us-west-2:123456789012:listener-rule/app/my-load-balancer/50dc6c495c0c9188/f2f7dc8efc522ab2/9683b2d02a6cabee</RuleArn>
+      </member>
+    </Rules>
+  </CreateRuleResult>
+  <ResponseMetadata>
+    <RequestId>c5478c83-f397-11e5-bb98-57195a6eb84a</RequestId>
+  </ResponseMetadata>
+</CreateRuleResponse>
+`
@@ -60,6 +60,10 @@ func (err *Error) Error() string {
 	return err.Message
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
 // The request stanza included in several response types, for example
 // in a "CreateHITResponse".  http://goo.gl/qGeKf
 type xmlRequest struct {
@@ -300,6 +300,10 @@ func (err *Error) Error() string {
 	return err.Message
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
 // SimpleResp represents a response to an SDB request which on success
 // will return no other information besides ResponseMetadata.
 type SimpleResp struct {
@@ -0,0 +1,291 @@
+package ses
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Receipt rules control what SES does with the email it receives for the
+// domains verified in a region. The rules are grouped in rule sets, of
+// which only the active one is applied.
+//
+// See http://docs.aws.amazon.com/ses/latest/dg/receiving-email-receipt-rules.html
+// for details.
+
+// TLS policies of a receipt rule.
+const (
+	TlsPolicyOptional = "Optional"
+	TlsPolicyRequire  = "Require"
+)
+
+// Encodings of the email published by an SNSAction.
+const (
+	SNSActionEncodingUTF8   = "UTF-8"
+	SNSActionEncodingBase64 = "Base64"
+)
+
+// Invocation types of a LambdaAction. With RequestResponse the function
+// can stop the evaluation of the rule set by returning
+// {"disposition": "STOP_RULE_SET"}.
+const (
+	LambdaInvocationEvent           = "Event"
+	LambdaInvocationRequestResponse = "RequestResponse"
+)
+
+// S3Action saves the received email to a bucket, encrypted with
+// KmsKeyArn if set, and publishes a notification to TopicArn if set.
+type S3Action struct {
+	BucketName      string `xml:"BucketName"`
+	ObjectKeyPrefix string `xml:"ObjectKeyPrefix"`
+	KmsKeyArn       string `xml:"KmsKeyArn"`
+	TopicArn        string `xml:"TopicArn"`
+}
+
+// SNSAction publishes the received email, up to 150 KB, to a topic.
+type SNSAction struct {
+	TopicArn string `xml:"TopicArn"`
+	Encoding string `xml:"Encoding"`
+}
+
+// LambdaAction invokes a function with the received email, and publishes
+// a notification to TopicArn if set.
+type LambdaAction struct {
+	FunctionArn    string `xml:"FunctionArn"`
+	InvocationType string `xml:"InvocationType"`
+	TopicArn       string `xml:"TopicArn"`
+}
+
+// StopAction stops the evaluation of the rule set.
+type StopAction struct {
+	Scope    string `xml:"Scope"`
+	TopicArn string `xml:"TopicArn"`
+}
+
+// ReceiptAction is an action of a receipt rule. Exactly one of its fields
+// must be set.
+type ReceiptAction struct {
+	S3Action     *S3Action     `xml:"S3Action"`
+	SNSAction    *SNSAction    `xml:"SNSAction"`
+	LambdaAction *LambdaAction `xml:"LambdaAction"`
+	StopAction   *StopAction   `xml:"StopAction"`
+}
+
+// ReceiptRule applies its actions, in order, to the email received for
+// Recipients, which are addresses or domains. A rule without recipients
+// applies to every recipient of the verified domains.
+type ReceiptRule struct {
+	Name        string          `xml:"Name"`
+	Enabled     bool            `xml:"Enabled"`
+	TlsPolicy   string          `xml:"TlsPolicy"`
+	ScanEnabled bool            `xml:"ScanEnabled"`
+	Recipients  []string        `xml:"Recipients>member"`
+	Actions     []ReceiptAction `xml:"Actions>member"`
+}
+
+// ReceiptRuleSetMetadata names a receipt rule set.
+type ReceiptRuleSetMetadata struct {
+	Name             string    `xml:"Name"`
+	CreatedTimestamp time.Time `xml:"CreatedTimestamp"`
+}
+
+// Response of the receipt rule actions that return no data.
+type ReceiptRuleResponse struct {
+	ResponseMetadata ResponseMetadata `xml:"ResponseMetadata"`
+}
+
+// ReceiptRuleSet is a receipt rule set and its rules, in the order they
+// are applied.
+type ReceiptRuleSet struct {
+	Metadata ReceiptRuleSetMetadata `xml:"Metadata"`
+	Rules    []ReceiptRule          `xml:"Rules>member"`
+}
+
+// DescribeReceiptRuleSetResponse is the response of DescribeReceiptRuleSet.
+type DescribeReceiptRuleSetResponse struct {
+	RuleSet          ReceiptRuleSet   `xml:"DescribeReceiptRuleSetResult"`
+	ResponseMetadata ResponseMetadata `xml:"ResponseMetadata"`
+}
+
+// DescribeActiveReceiptRuleSetResponse is the response of
+// DescribeActiveReceiptRuleSet.
+type DescribeActiveReceiptRuleSetResponse struct {
+	RuleSet          ReceiptRuleSet   `xml:"DescribeActiveReceiptRuleSetResult"`
+	ResponseMetadata ResponseMetadata `xml:"ResponseMetadata"`
+}
+
+// ListReceiptRuleSetsResponse is the response of ListReceiptRuleSets.
+type ListReceiptRuleSetsResponse struct {
+	RuleSets         []ReceiptRuleSetMetadata `xml:"ListReceiptRuleSetsResult>RuleSets>member"`
+	NextToken        string                   `xml:"ListReceiptRuleSetsResult>NextToken"`
+	ResponseMetadata ResponseMetadata         `xml:"ResponseMetadata"`
+}
+
+func (s *SES) simpleQuery(params url.Values) (*ReceiptRuleResponse, error) {
+	resp := &ReceiptRuleResponse{}
+	if err := s.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateReceiptRuleSet creates an empty receipt rule set.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_CreateReceiptRuleSet.html
+func (s *SES) CreateReceiptRuleSet(ruleSetName string) (*ReceiptRuleResponse, error) {
+	params := s.makeParams("CreateReceiptRuleSet")
+	params.Add("RuleSetName", ruleSetName)
+	return s.simpleQuery(params)
+}
+
+// DeleteReceiptRuleSet deletes a receipt rule set and its rules. The active
+// rule set can't be deleted.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_DeleteReceiptRuleSet.html
+func (s *SES) DeleteReceiptRuleSet(ruleSetName string) (*ReceiptRuleResponse, error) {
+	params := s.makeParams("DeleteReceiptRuleSet")
+	params.Add("RuleSetName", ruleSetName)
+	return s.simpleQuery(params)
+}
+
+// DescribeReceiptRuleSet returns a receipt rule set and its rules.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_DescribeReceiptRuleSet.html
+func (s *SES) DescribeReceiptRuleSet(ruleSetName string) (*DescribeReceiptRuleSetResponse, error) {
+	params := s.makeParams("DescribeReceiptRuleSet")
+	params.Add("RuleSetName", ruleSetName)
+	resp := &DescribeReceiptRuleSetResponse{}
+	if err := s.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListReceiptRuleSets returns a page of the receipt rule sets. nextToken is
+// the NextToken of the previous page, if any.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_ListReceiptRuleSets.html
+func (s *SES) ListReceiptRuleSets(nextToken string) (*ListReceiptRuleSetsResponse, error) {
+	params := s.makeParams("ListReceiptRuleSets")
+	if nextToken != "" {
+		params.Add("NextToken", nextToken)
+	}
+	resp := &ListReceiptRuleSetsResponse{}
+	if err := s.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetActiveReceiptRuleSet makes a receipt rule set the one applied to the
+// received email. An empty ruleSetName deactivates the active rule set,
+// after which received email is rejected.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_SetActiveReceiptRuleSet.html
+func (s *SES) SetActiveReceiptRuleSet(ruleSetName string) (*ReceiptRuleResponse, error) {
+	params := s.makeParams("SetActiveReceiptRuleSet")
+	if ruleSetName != "" {
+		params.Add("RuleSetName", ruleSetName)
+	}
+	return s.simpleQuery(params)
+}
+
+// DescribeActiveReceiptRuleSet returns the active receipt rule set and its
+// rules. RuleSet.Metadata.Name is empty when no rule set is active.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_DescribeActiveReceiptRuleSet.html
+func (s *SES) DescribeActiveReceiptRuleSet() (*DescribeActiveReceiptRuleSetResponse, error) {
+	resp := &DescribeActiveReceiptRuleSetResponse{}
+	if err := s.query(s.makeParams("DescribeActiveReceiptRuleSet"), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateReceiptRule adds a rule to a receipt rule set, after the rule named
+// after, or first if after is empty. SES must be allowed to use the
+// buckets, topics and functions of its actions.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_CreateReceiptRule.html
+func (s *SES) CreateReceiptRule(ruleSetName string, rule *ReceiptRule, after string) (*ReceiptRuleResponse, error) {
+	params := s.makeParams("CreateReceiptRule")
+	params.Add("RuleSetName", ruleSetName)
+	if after != "" {
+		params.Add("After", after)
+	}
+	addReceiptRuleParams(params, rule)
+	return s.simpleQuery(params)
+}
+
+// UpdateReceiptRule replaces the rule of a receipt rule set with the same
+// name as rule.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_UpdateReceiptRule.html
+func (s *SES) UpdateReceiptRule(ruleSetName string, rule *ReceiptRule) (*ReceiptRuleResponse, error) {
+	params := s.makeParams("UpdateReceiptRule")
+	params.Add("RuleSetName", ruleSetName)
+	addReceiptRuleParams(params, rule)
+	return s.simpleQuery(params)
+}
+
+// DeleteReceiptRule removes a rule from a receipt rule set.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_DeleteReceiptRule.html
+func (s *SES) DeleteReceiptRule(ruleSetName, ruleName string) (*ReceiptRuleResponse, error) {
+	params := s.makeParams("DeleteReceiptRule")
+	params.Add("RuleSetName", ruleSetName)
+	params.Add("RuleName", ruleName)
+	return s.simpleQuery(params)
+}
+
+func addReceiptRuleParams(params url.Values, rule *ReceiptRule) {
+	params.Add("Rule.Name", rule.Name)
+	params.Add("Rule.Enabled", strconv.FormatBool(rule.Enabled))
+	params.Add("Rule.ScanEnabled", strconv.FormatBool(rule.ScanEnabled))
+	if rule.TlsPolicy != "" {
+		params.Add("Rule.TlsPolicy", rule.TlsPolicy)
+	}
+	for i, recipient := range rule.Recipients {
+		params.Add(fmt.Sprintf("Rule.Recipients.member.%d", i+1), recipient)
+	}
+	for i, action := range rule.Actions {
+		prefix := fmt.Sprintf("Rule.Actions.member.%d.", i+1)
+		if a := action.S3Action; a != nil {
+			addActionParams(params, prefix+"S3Action.", map[string]string{
+				"BucketName":      a.BucketName,
+				"ObjectKeyPrefix": a.ObjectKeyPrefix,
+				"KmsKeyArn":       a.KmsKeyArn,
+				"TopicArn":        a.TopicArn,
+			})
+		}
+		if a := action.SNSAction; a != nil {
+			addActionParams(params, prefix+"SNSAction.", map[string]string{
+				"TopicArn": a.TopicArn,
+				"Encoding": a.Encoding,
+			})
+		}
+		if a := action.LambdaAction; a != nil {
+			addActionParams(params, prefix+"LambdaAction.", map[string]string{
+				"FunctionArn":    a.FunctionArn,
+				"InvocationType": a.InvocationType,
+				"TopicArn":       a.TopicArn,
+			})
+		}
+		if a := action.StopAction; a != nil {
+			addActionParams(params, prefix+"StopAction.", map[string]string{
+				"Scope":    a.Scope,
+				"TopicArn": a.TopicArn,
+			})
+		}
+	}
+}
+
+// addActionParams adds the fields of an action that are set.
+func addActionParams(params url.Values, prefix string, fields map[string]string) {
+	for name, value := range fields {
+		if value != "" {
+			params.Add(prefix+name, value)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package ses_test
+
+import (
+	"net/url"
+	"time"
+
+	"gopkg.in/check.v1"
+
+	"github.com/zackbloom/goamz/exp/ses"
+)
+
+func (s *S) TestCreateReceiptRuleSet(c *check.C) {
+	testServer.Response(200, nil, TestCreateReceiptRuleSetOk)
+
+	resp, err := s.sesService.CreateReceiptRuleSet("support-next")
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "CreateReceiptRuleSet")
+	c.Assert(req.FormValue("RuleSetName"), check.Equals, "support-next")
+	c.Assert(resp.ResponseMetadata.MessageId, check.Equals, "5ba2d6a5-6d08-4c1e-b4a0-2c8d6e3b9a11")
+}
+
+func (s *S) TestCreateReceiptRule(c *check.C) {
+	testServer.Response(200, nil, TestCreateReceiptRuleOk)
+
+	_, err := s.sesService.CreateReceiptRule("support-next", &ses.ReceiptRule{
+		Name:        "support-inbox",
+		Enabled:     true,
+		TlsPolicy:   ses.TlsPolicyRequire,
+		ScanEnabled: true,
+		Recipients:  []string{"support@example.com", "help@example.com"},
+		Actions: []ses.ReceiptAction{
+			{S3Action: &ses.S3Action{BucketName: "support-mail", ObjectKeyPrefix: "inbox/"}},
+			{SNSAction: &ses.SNSAction{
+				TopicArn: "arn:aws:sns:us-east-1:123456789012:support",
+				Encoding: ses.SNSActionEncodingBase64,
+			}},
+			{LambdaAction: &ses.LambdaAction{
+				FunctionArn:    "arn:aws:lambda:us-east-1:123456789012:function:ticket",
+				InvocationType: ses.LambdaInvocationEvent,
+			}},
+		},
+	}, "spam-filter")
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form, check.DeepEquals, url.Values{
+		"AWSAccessKeyId":           {"abc"},
+		"Action":                   {"CreateReceiptRule"},
+		"RuleSetName":              {"support-next"},
+		"After":                    {"spam-filter"},
+		"Rule.Name":                {"support-inbox"},
+		"Rule.Enabled":             {"true"},
+		"Rule.ScanEnabled":         {"true"},
+		"Rule.TlsPolicy":           {"Require"},
+		"Rule.Recipients.member.1": {"support@example.com"},
+		"Rule.Recipients.member.2": {"help@example.com"},
+		"Rule.Actions.member.1.S3Action.BucketName":         {"support-mail"},
+		"Rule.Actions.member.1.S3Action.ObjectKeyPrefix":    {"inbox/"},
+		"Rule.Actions.member.2.SNSAction.TopicArn":          {"arn:aws:sns:us-east-1:123456789012:support"},
+		"Rule.Actions.member.2.SNSAction.Encoding":          {"Base64"},
+		"Rule.Actions.member.3.LambdaAction.FunctionArn":    {"arn:aws:lambda:us-east-1:123456789012:function:ticket"},
+		"Rule.Actions.member.3.LambdaAction.InvocationType": {"Event"},
+	})
+}
+
+func (s *S) TestSetActiveReceiptRuleSet(c *check.C) {
+	testServer.Response(200, nil, TestCreateReceiptRuleSetOk)
+	testServer.Response(200, nil, TestCreateReceiptRuleSetOk)
+
+	_, err := s.sesService.SetActiveReceiptRuleSet("support-next")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "SetActiveReceiptRuleSet")
+	c.Assert(req.FormValue("RuleSetName"), check.Equals, "support-next")
+
+	_, err = s.sesService.SetActiveReceiptRuleSet("")
+	req = testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	_, ok := req.Form["RuleSetName"]
+	c.Assert(ok, check.Equals, false)
+}
+
+func (s *S) TestDescribeActiveReceiptRuleSet(c *check.C) {
+	testServer.Response(200, nil, TestDescribeActiveReceiptRuleSetOk)
+
+	resp, err := s.sesService.DescribeActiveReceiptRuleSet()
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "DescribeActiveReceiptRuleSet")
+	c.Assert(resp.RuleSet.Metadata.Name, check.Equals, "support")
+	c.Assert(resp.RuleSet.Metadata.CreatedTimestamp.Equal(time.Date(2016, 7, 15, 16, 25, 59, 607e6, time.UTC)), check.Equals, true)
+	c.Assert(resp.RuleSet.Rules, check.DeepEquals, []ses.ReceiptRule{{
+		Name:        "support-inbox",
+		Enabled:     true,
+		TlsPolicy:   "Require",
+		ScanEnabled: true,
+		Recipients:  []string{"support@example.com"},
+		Actions: []ses.ReceiptAction{
+			{S3Action: &ses.S3Action{BucketName: "support-mail", ObjectKeyPrefix: "inbox/"}},
+			{LambdaAction: &ses.LambdaAction{
+				FunctionArn:    "arn:aws:lambda:us-east-1:123456789012:function:ticket",
+				InvocationType: "Event",
+			}},
+		},
+	}})
+}
+
+func (s *S) TestListReceiptRuleSets(c *check.C) {
+	testServer.Response(200, nil, TestListReceiptRuleSetsOk)
+
+	resp, err := s.sesService.ListReceiptRuleSets("")
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "ListReceiptRuleSets")
+	c.Assert(resp.RuleSets, check.HasLen, 2)
+	c.Assert(resp.RuleSets[1].Name, check.Equals, "support-next")
+	c.Assert(resp.NextToken, check.Equals, "")
+}
+
+func (s *S) TestDeleteReceiptRuleSetError(c *check.C) {
+	testServer.Response(400, nil, TestDeleteReceiptRuleSetError)
+
+	resp, err := s.sesService.DeleteReceiptRuleSet("support")
+	req := testServer.WaitRequest()
+
+	c.Assert(req.FormValue("Action"), check.Equals, "DeleteReceiptRuleSet")
+	c.Assert(resp, check.IsNil)
+	c.Assert(err, check.ErrorMatches, `Cannot delete active rule set: support \(CannotDelete\)`)
+}
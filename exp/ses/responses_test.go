@@ -23,3 +23,146 @@ var TestSendEmailOk = `
 	</ResponseMetadata>
 </SendEmailResponse>
 `
+
+var TestCreateReceiptRuleSetOk = `
+<?xml version="1.0"?>
+<CreateReceiptRuleSetResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<CreateReceiptRuleSetResult/>
+	<ResponseMetadata>
+		<RequestId>5ba2d6a5-6d08-4c1e-b4a0-2c8d6e3b9a11</RequestId>
+	</ResponseMetadata>
+</CreateReceiptRuleSetResponse>
+`
+
+var TestCreateReceiptRuleOk = `
+<?xml version="1.0"?>
+<CreateReceiptRuleResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<CreateReceiptRuleResult/>
+	<ResponseMetadata>
+		<RequestId>6cb3e7b6-7e19-4d2f-c5b1-3d9e7f4c0b22</RequestId>
+	</ResponseMetadata>
+</CreateReceiptRuleResponse>
+`
+
+// http://docs.aws.amazon.com/ses/latest/APIReference/API_DescribeActiveReceiptRuleSet.html
+var TestDescribeActiveReceiptRuleSetOk = `
+<?xml version="1.0"?>
+<DescribeActiveReceiptRuleSetResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<DescribeActiveReceiptRuleSetResult>
+		<Metadata>
+			<Name>support</Name>
+			<CreatedTimestamp>2016-07-15T16:25:59.607Z</CreatedTimestamp>
+		</Metadata>
+		<Rules>
+			<member>
+				<Name>support-inbox</Name>
+				<Enabled>true</Enabled>
+				<TlsPolicy>Require</TlsPolicy>
+				<ScanEnabled>true</ScanEnabled>
+				<Recipients>
+					<member>support@example.com</member>
+				</Recipients>
+				<Actions>
+					<member>
+						<S3Action>
+							<BucketName>support-mail</BucketName>
+							<ObjectKeyPrefix>inbox/</ObjectKeyPrefix>
+						</S3Action>
+					</member>
+					<member>
+						<LambdaAction>
+							<FunctionArn>arn:aws:lambda:us-east-1:123456789012:function:ticket</FunctionArn>
+							<InvocationType>Event</InvocationType>
+						</LambdaAction>
+					</member>
+				</Actions>
+			</member>
+		</Rules>
+	</DescribeActiveReceiptRuleSetResult>
+	<ResponseMetadata>
+		<RequestId>7dc4f8c7-8f2a-4e30-d6c2-4e0f805d1c33</RequestId>
+	</ResponseMetadata>
+</DescribeActiveReceiptRuleSetResponse>
+`
+
+var TestListReceiptRuleSetsOk = `
+<?xml version="1.0"?>
+<ListReceiptRuleSetsResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<ListReceiptRuleSetsResult>
+		<RuleSets>
+			<member>
+				<Name>support</Name>
+				<CreatedTimestamp>2016-07-15T16:25:59.607Z</CreatedTimestamp>
+			</member>
+			<member>
+				<Name>support-next</Name>
+				<CreatedTimestamp>2016-08-01T09:00:00.000Z</CreatedTimestamp>
+			</member>
+		</RuleSets>
+	</ListReceiptRuleSetsResult>
+	<ResponseMetadata>
+		<RequestId>8ed50ad8-903b-4f41-e7d3-5f20917f2e44</RequestId>
+	</ResponseMetadata>
+</ListReceiptRuleSetsResponse>
+`
+
+var TestDeleteReceiptRuleSetError = `
+<?xml version="1.0"?>
+<ErrorResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<Error>
+		<Type>Sender</Type>
+		<Code>CannotDelete</Code>
+		<Message>Cannot delete active rule set: support</Message>
+	</Error>
+	<RequestId>9fe61be9-a14c-4052-f8e4-60211a7f3e55</RequestId>
+</ErrorResponse>
+`
+
+var TestSendRawEmailOk = `
+<?xml version="1.0"?>
+<SendRawEmailResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<SendRawEmailResult>
+		<MessageId>00000131d51d6b36-1d4f9293-0aee-4503-b573-9ae4e70e9e38-000000</MessageId>
+	</SendRawEmailResult>
+	<ResponseMetadata>
+		<RequestId>e0abcdfa-c866-11e0-b6d0-273d09173b49</RequestId>
+	</ResponseMetadata>
+</SendRawEmailResponse>
+`
+
+// http://docs.aws.amazon.com/ses/latest/APIReference/API_SendBulkTemplatedEmail.html
+var TestSendBulkTemplatedEmailOk = `
+<?xml version="1.0"?>
+<SendBulkTemplatedEmailResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<SendBulkTemplatedEmailResult>
+		<Status>
+			<member>
+				<Status>Success</Status>
+				<MessageId>0100016b8e5d4c1d-5c1e2b5f-1a4a-4a09-9a43-9a4c3f0c1d2e-000000</MessageId>
+			</member>
+			<member>
+				<Status>MessageRejected</Status>
+				<Error>Email address is not verified.</Error>
+			</member>
+		</Status>
+	</SendBulkTemplatedEmailResult>
+	<ResponseMetadata>
+		<RequestId>a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d</RequestId>
+	</ResponseMetadata>
+</SendBulkTemplatedEmailResponse>
+`
+
+// http://docs.aws.amazon.com/ses/latest/APIReference/API_GetSendQuota.html
+var TestGetSendQuotaOk = `
+<?xml version="1.0"?>
+<GetSendQuotaResponse xmlns="http://ses.amazonaws.com/doc/2010-12-01/">
+	<GetSendQuotaResult>
+		<SentLast24Hours>127.0</SentLast24Hours>
+		<Max24HourSend>200.0</Max24HourSend>
+		<MaxSendRate>1.0</MaxSendRate>
+	</GetSendQuotaResult>
+	<ResponseMetadata>
+		<RequestId>273021c6-c866-11e0-b926-699e21c3af9e</RequestId>
+	</ResponseMetadata>
+</GetSendQuotaResponse>
+`
@@ -0,0 +1,316 @@
+package ses
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// A message tag, published with the events of the configuration set a
+// message is sent with.
+type MessageTag struct {
+	Name  string
+	Value string
+}
+
+// Options of a sent message. ConfigurationSetName is the configuration
+// set whose event destinations receive the sending events of the message.
+type SendOptions struct {
+	ConfigurationSetName string
+	Tags                 []MessageTag
+}
+
+func addSendOptions(params url.Values, options *SendOptions) {
+	if options == nil {
+		return
+	}
+	if options.ConfigurationSetName != "" {
+		params.Add("ConfigurationSetName", options.ConfigurationSetName)
+	}
+	addMessageTags(params, "Tags.member", options.Tags)
+}
+
+func addMessageTags(params url.Values, label string, tags []MessageTag) {
+	for i, tag := range tags {
+		params.Add(fmt.Sprintf("%s.%d.Name", label, i+1), tag.Name)
+		params.Add(fmt.Sprintf("%s.%d.Value", label, i+1), tag.Value)
+	}
+}
+
+// SendEmailWithOptions is like SendEmail, with the given options.
+func (s *SES) SendEmailWithOptions(fromAddress string, destination *Destination, message *Message, options *SendOptions) (*SendEmailResponse, error) {
+	if err := enforceMaxRecipients(destination); err != nil {
+		return nil, err
+	}
+	params := s.composeRequestParams(fromAddress, destination, message)
+	addSendOptions(params, options)
+
+	resp := &SendEmailResponse{}
+	if err := s.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Represents a unique message ID returned from a successful SendRawEmail request.
+type SendRawEmailResponse struct {
+	XMLName            xml.Name         `xml:"SendRawEmailResponse"`
+	SendRawEmailResult SendEmailResult  `xml:"SendRawEmailResult"`
+	ResponseMetadata   ResponseMetadata `xml:"ResponseMetadata"`
+}
+
+// SendRawEmail sends a MIME message, such as one built with RawMessage.
+// The sender and recipients are taken from the headers of the message,
+// unless fromAddress or destinations are given.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_SendRawEmail.html
+func (s *SES) SendRawEmail(fromAddress string, destinations []string, rawMessage []byte, options *SendOptions) (*SendRawEmailResponse, error) {
+	if len(destinations) > MAX_RECIPIENTS_PER_REQUEST {
+		return nil, fmt.Errorf("Too many recipients. Found: %d, max %d", len(destinations), MAX_RECIPIENTS_PER_REQUEST)
+	}
+	params := s.makeParams("SendRawEmail")
+	if fromAddress != "" {
+		params.Add("Source", fromAddress)
+	}
+	for i, addr := range destinations {
+		params.Add(fmt.Sprintf("Destinations.member.%d", i+1), addr)
+	}
+	params.Add("RawMessage.Data", base64.StdEncoding.EncodeToString(rawMessage))
+	addSendOptions(params, options)
+
+	resp := &SendRawEmailResponse{}
+	if err := s.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// An attachment of a RawMessage. ContentType is guessed from the extension
+// of Filename if empty.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// RawMessage builds a MIME message, with a text and/or HTML body and
+// attachments, to be sent with SendRawEmail.
+type RawMessage struct {
+	From        string
+	To          []string
+	Cc          []string
+	ReplyTo     []string
+	Subject     string
+	Text        string
+	Html        string
+	Attachments []Attachment
+}
+
+// Bytes returns the MIME encoding of the message. Bcc recipients are not
+// part of the message, and must be given to SendRawEmail as destinations.
+func (m *RawMessage) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(name string, values []string) {
+		if len(values) > 0 {
+			fmt.Fprintf(&buf, "%s: %s\r\n", name, strings.Join(values, ", "))
+		}
+	}
+	header("From", []string{m.From})
+	header("To", m.To)
+	header("Cc", m.Cc)
+	header("Reply-To", m.ReplyTo)
+	header("Subject", []string{mime.QEncoding.Encode("utf-8", m.Subject)})
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	w := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", w.Boundary())
+
+	body := &bytes.Buffer{}
+	alternative := multipart.NewWriter(body)
+	for _, part := range []struct{ contentType, content string }{
+		{"text/plain", m.Text},
+		{"text/html", m.Html},
+	} {
+		if part.content == "" {
+			continue
+		}
+		pw, err := alternative.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType + "; charset=utf-8"},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64(pw, []byte(part.content)); err != nil {
+			return nil, err
+		}
+	}
+	if err := alternative.Close(); err != nil {
+		return nil, err
+	}
+	pw, err := w.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {"multipart/alternative; boundary=" + alternative.Boundary()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pw.Write(body.Bytes()); err != nil {
+		return nil, err
+	}
+
+	for _, a := range m.Attachments {
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = mime.TypeByExtension(filepath.Ext(a.Filename))
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		pw, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {mime.FormatMediaType(contentType, map[string]string{"name": a.Filename})},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": a.Filename})},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeBase64(pw, a.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeBase64 writes data base64 encoded in lines of 76 characters, as
+// required by RFC 2045.
+func writeBase64(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 0 {
+		n := 76
+		if len(encoded) < n {
+			n = len(encoded)
+		}
+		if _, err := fmt.Fprintf(w, "%s\r\n", encoded[:n]); err != nil {
+			return err
+		}
+		encoded = encoded[n:]
+	}
+	return nil
+}
+
+// The maximum number of destinations of a SendBulkTemplatedEmail request.
+const MAX_DESTINATIONS_PER_BULK_REQUEST = 50
+
+// A destination of a bulk templated email. ReplacementTemplateData is the
+// JSON data replacing the default template data for the destination.
+type BulkEmailDestination struct {
+	Destination             *Destination
+	ReplacementTemplateData string
+	ReplacementTags         []MessageTag
+}
+
+// BulkTemplatedEmail holds the parameters of SendBulkTemplatedEmail.
+// DefaultTemplateData is the JSON data the template is rendered with for
+// the destinations without replacement data.
+type BulkTemplatedEmail struct {
+	Source              string
+	Template            string
+	DefaultTemplateData string
+	Destinations        []BulkEmailDestination
+	Options             *SendOptions
+}
+
+// The status of a destination of a bulk templated email. MessageId is only
+// set when Status is "Success".
+type BulkEmailDestinationStatus struct {
+	Status    string `xml:"Status"`
+	Error     string `xml:"Error"`
+	MessageId string `xml:"MessageId"`
+}
+
+type SendBulkTemplatedEmailResponse struct {
+	Status           []BulkEmailDestinationStatus `xml:"SendBulkTemplatedEmailResult>Status>member"`
+	ResponseMetadata ResponseMetadata             `xml:"ResponseMetadata"`
+}
+
+// SendBulkTemplatedEmail sends an email rendered from a template to each of
+// up to 50 destinations. The response has the status of each destination,
+// in order; the request only fails as a whole if it is invalid.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_SendBulkTemplatedEmail.html
+func (s *SES) SendBulkTemplatedEmail(email *BulkTemplatedEmail) (*SendBulkTemplatedEmailResponse, error) {
+	if len(email.Destinations) > MAX_DESTINATIONS_PER_BULK_REQUEST {
+		return nil, fmt.Errorf("Too many destinations. Found: %d, max %d", len(email.Destinations), MAX_DESTINATIONS_PER_BULK_REQUEST)
+	}
+	params := s.makeParams("SendBulkTemplatedEmail")
+	params.Add("Source", email.Source)
+	params.Add("Template", email.Template)
+	params.Add("DefaultTemplateData", email.DefaultTemplateData)
+	for i, d := range email.Destinations {
+		if err := enforceMaxRecipients(d.Destination); err != nil {
+			return nil, err
+		}
+		prefix := "Destinations.member." + strconv.Itoa(i+1) + "."
+		for j, addr := range d.Destination.ToAddresses {
+			params.Add(fmt.Sprintf("%sDestination.ToAddresses.member.%d", prefix, j+1), addr)
+		}
+		for j, addr := range d.Destination.CcAddresses {
+			params.Add(fmt.Sprintf("%sDestination.CcAddresses.member.%d", prefix, j+1), addr)
+		}
+		for j, addr := range d.Destination.BccAddresses {
+			params.Add(fmt.Sprintf("%sDestination.BccAddresses.member.%d", prefix, j+1), addr)
+		}
+		if d.ReplacementTemplateData != "" {
+			params.Add(prefix+"ReplacementTemplateData", d.ReplacementTemplateData)
+		}
+		addMessageTags(params, prefix+"ReplacementTags.member", d.ReplacementTags)
+	}
+	if email.Options != nil {
+		if email.Options.ConfigurationSetName != "" {
+			params.Add("ConfigurationSetName", email.Options.ConfigurationSetName)
+		}
+		addMessageTags(params, "DefaultTags.member", email.Options.Tags)
+	}
+
+	resp := &SendBulkTemplatedEmailResponse{}
+	if err := s.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// The sending limits of the account, in messages. They apply per region.
+type SendQuota struct {
+	Max24HourSend   float64 `xml:"GetSendQuotaResult>Max24HourSend"`
+	MaxSendRate     float64 `xml:"GetSendQuotaResult>MaxSendRate"`
+	SentLast24Hours float64 `xml:"GetSendQuotaResult>SentLast24Hours"`
+}
+
+type GetSendQuotaResponse struct {
+	SendQuota
+	ResponseMetadata ResponseMetadata `xml:"ResponseMetadata"`
+}
+
+// GetSendQuota returns the sending limits of the account, and how many
+// messages were sent in the last 24 hours.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference/API_GetSendQuota.html
+func (s *SES) GetSendQuota() (*GetSendQuotaResponse, error) {
+	resp := &GetSendQuotaResponse{}
+	if err := s.query(s.makeParams("GetSendQuota"), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
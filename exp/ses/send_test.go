@@ -0,0 +1,195 @@
+package ses_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+
+	"gopkg.in/check.v1"
+
+	"github.com/zackbloom/goamz/exp/ses"
+)
+
+func (s *S) TestSendEmailWithOptions(c *check.C) {
+	testServer.Response(200, nil, TestSendEmailOk)
+
+	_, err := s.sesService.SendEmailWithOptions("foo@example.com",
+		ses.NewDestination([]string{"to@example.com"}, nil, nil),
+		ses.NewMessage("subject", "textBody", "htmlBody"),
+		&ses.SendOptions{
+			ConfigurationSetName: "transactional",
+			Tags:                 []ses.MessageTag{{Name: "campaign", Value: "welcome"}},
+		})
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "SendEmail")
+	c.Assert(req.FormValue("ConfigurationSetName"), check.Equals, "transactional")
+	c.Assert(req.FormValue("Tags.member.1.Name"), check.Equals, "campaign")
+	c.Assert(req.FormValue("Tags.member.1.Value"), check.Equals, "welcome")
+}
+
+func (s *S) TestSendRawEmail(c *check.C) {
+	testServer.Response(200, nil, TestSendRawEmailOk)
+
+	msg := &ses.RawMessage{
+		From:    "foo@example.com",
+		To:      []string{"to@example.com"},
+		Subject: "Your invoice ✓",
+		Text:    "See the attached invoice.",
+		Html:    "<p>See the attached invoice.</p>",
+		Attachments: []ses.Attachment{
+			{Filename: "invoice.pdf", Data: []byte("%PDF-1.4 invoice")},
+		},
+	}
+	raw, err := msg.Bytes()
+	c.Assert(err, check.IsNil)
+
+	resp, err := s.sesService.SendRawEmail("", []string{"to@example.com", "bcc@example.com"}, raw, nil)
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "SendRawEmail")
+	_, ok := req.Form["Source"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(req.FormValue("Destinations.member.2"), check.Equals, "bcc@example.com")
+	data, err := base64.StdEncoding.DecodeString(req.FormValue("RawMessage.Data"))
+	c.Assert(err, check.IsNil)
+	c.Assert(data, check.DeepEquals, raw)
+	c.Assert(resp.SendRawEmailResult.MessageId, check.Equals, "00000131d51d6b36-1d4f9293-0aee-4503-b573-9ae4e70e9e38-000000")
+}
+
+func (s *S) TestRawMessageBytes(c *check.C) {
+	msg := &ses.RawMessage{
+		From:    "foo@example.com",
+		To:      []string{"to1@example.com", "to2@example.com"},
+		Subject: "Your invoice ✓",
+		Text:    "See the attached invoice.",
+		Html:    "<p>See the attached invoice.</p>",
+		Attachments: []ses.Attachment{
+			{Filename: "invoice.pdf", Data: []byte("%PDF-1.4 invoice")},
+		},
+	}
+	raw, err := msg.Bytes()
+	c.Assert(err, check.IsNil)
+
+	m, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	c.Assert(err, check.IsNil)
+	c.Assert(m.Header.Get("To"), check.Equals, "to1@example.com, to2@example.com")
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	c.Assert(err, check.IsNil)
+	c.Assert(subject, check.Equals, "Your invoice ✓")
+
+	mediaType, params, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	c.Assert(err, check.IsNil)
+	c.Assert(mediaType, check.Equals, "multipart/mixed")
+	mixed := multipart.NewReader(m.Body, params["boundary"])
+
+	part, err := mixed.NextPart()
+	c.Assert(err, check.IsNil)
+	mediaType, params, err = mime.ParseMediaType(part.Header.Get("Content-Type"))
+	c.Assert(err, check.IsNil)
+	c.Assert(mediaType, check.Equals, "multipart/alternative")
+	alternative := multipart.NewReader(part, params["boundary"])
+	var bodies []string
+	for {
+		body, err := alternative.NextPart()
+		if err != nil {
+			break
+		}
+		data, err := ioutil.ReadAll(base64.NewDecoder(base64.StdEncoding, body))
+		c.Assert(err, check.IsNil)
+		bodies = append(bodies, body.Header.Get("Content-Type")+": "+string(data))
+	}
+	c.Assert(bodies, check.DeepEquals, []string{
+		"text/plain; charset=utf-8: See the attached invoice.",
+		"text/html; charset=utf-8: <p>See the attached invoice.</p>",
+	})
+
+	part, err = mixed.NextPart()
+	c.Assert(err, check.IsNil)
+	c.Assert(part.FileName(), check.Equals, "invoice.pdf")
+	c.Assert(part.Header.Get("Content-Type"), check.Equals, "application/pdf; name=invoice.pdf")
+	data, err := ioutil.ReadAll(base64.NewDecoder(base64.StdEncoding, part))
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, "%PDF-1.4 invoice")
+
+	_, err = mixed.NextPart()
+	c.Assert(err, check.NotNil)
+}
+
+func (s *S) TestSendBulkTemplatedEmail(c *check.C) {
+	testServer.Response(200, nil, TestSendBulkTemplatedEmailOk)
+
+	resp, err := s.sesService.SendBulkTemplatedEmail(&ses.BulkTemplatedEmail{
+		Source:              "foo@example.com",
+		Template:            "welcome",
+		DefaultTemplateData: `{"name":"friend"}`,
+		Destinations: []ses.BulkEmailDestination{
+			{
+				Destination:             ses.NewDestination([]string{"anaya@example.com"}, nil, nil),
+				ReplacementTemplateData: `{"name":"Anaya"}`,
+			},
+			{
+				Destination:     ses.NewDestination([]string{"unverified@example.com"}, nil, nil),
+				ReplacementTags: []ses.MessageTag{{Name: "cohort", Value: "b"}},
+			},
+		},
+		Options: &ses.SendOptions{
+			ConfigurationSetName: "transactional",
+			Tags:                 []ses.MessageTag{{Name: "campaign", Value: "welcome"}},
+		},
+	})
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	expected := map[string]string{
+		"Action":              "SendBulkTemplatedEmail",
+		"Source":              "foo@example.com",
+		"Template":            "welcome",
+		"DefaultTemplateData": `{"name":"friend"}`,
+		"Destinations.member.1.Destination.ToAddresses.member.1": "anaya@example.com",
+		"Destinations.member.1.ReplacementTemplateData":          `{"name":"Anaya"}`,
+		"Destinations.member.2.Destination.ToAddresses.member.1": "unverified@example.com",
+		"Destinations.member.2.ReplacementTags.member.1.Name":    "cohort",
+		"Destinations.member.2.ReplacementTags.member.1.Value":   "b",
+		"ConfigurationSetName":                                   "transactional",
+		"DefaultTags.member.1.Name":                              "campaign",
+		"DefaultTags.member.1.Value":                             "welcome",
+	}
+	for k, v := range expected {
+		c.Check(req.FormValue(k), check.Equals, v, check.Commentf("%s", k))
+	}
+	_, ok := req.Form["Destinations.member.2.ReplacementTemplateData"]
+	c.Assert(ok, check.Equals, false)
+
+	c.Assert(resp.Status, check.DeepEquals, []ses.BulkEmailDestinationStatus{
+		{Status: "Success", MessageId: "0100016b8e5d4c1d-5c1e2b5f-1a4a-4a09-9a43-9a4c3f0c1d2e-000000"},
+		{Status: "MessageRejected", Error: "Email address is not verified."},
+	})
+}
+
+func (s *S) TestSendBulkTemplatedEmailTooManyDestinations(c *check.C) {
+	email := &ses.BulkTemplatedEmail{Source: "foo@example.com", Template: "welcome"}
+	for i := 0; i <= ses.MAX_DESTINATIONS_PER_BULK_REQUEST; i++ {
+		email.Destinations = append(email.Destinations, ses.BulkEmailDestination{
+			Destination: ses.NewDestination([]string{"to@example.com"}, nil, nil),
+		})
+	}
+	_, err := s.sesService.SendBulkTemplatedEmail(email)
+	c.Assert(err, check.ErrorMatches, "Too many destinations. Found: 51, max 50")
+}
+
+func (s *S) TestGetSendQuota(c *check.C) {
+	testServer.Response(200, nil, TestGetSendQuotaOk)
+
+	resp, err := s.sesService.GetSendQuota()
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.FormValue("Action"), check.Equals, "GetSendQuota")
+	c.Assert(resp.SendQuota, check.Equals, ses.SendQuota{Max24HourSend: 200, MaxSendRate: 1, SentLast24Hours: 127})
+}
@@ -80,6 +80,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, "")
+}
+
 func (err *Error) String() string {
 	return err.Message
 }
@@ -129,15 +133,23 @@ func New(auth aws.Auth, region aws.Region) *SES {
 }
 
 func (s *SES) SendEmail(fromAddress string, destination *Destination, message *Message) (*SendEmailResponse, error) {
-	if err := enforceMaxRecipients(destination); err != nil {
-		return nil, err
-	}
-	params := s.composeRequestParams(fromAddress, destination, message)
+	return s.SendEmailWithOptions(fromAddress, destination, message, nil)
+}
 
+func (s *SES) makeParams(action string) url.Values {
+	params := make(url.Values)
+	params.Add("AWSAccessKeyId", s.Auth.AccessKey)
+	params.Add("Action", action)
+	return params
+}
+
+// query posts params to the SES endpoint and decodes the XML response
+// into resp.
+func (s *SES) query(params url.Values, resp interface{}) error {
 	body := strings.NewReader(params.Encode())
 	req, err := http.NewRequest("POST", s.Region.SESEndpoint, body)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	req.Header = s.composeRequestHeader()
 
@@ -145,27 +157,20 @@ func (s *SES) SendEmail(fromAddress string, destination *Destination, message *M
 
 	r, err := client.Do(req)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	//close the body at the end of the method
 	defer r.Body.Close()
 
 	if r.StatusCode != 200 {
-		return nil, buildError(r)
+		return buildError(r)
 	}
-	resp := &SendEmailResponse{}
-	err = xml.NewDecoder(r.Body).Decode(&resp)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
+	return xml.NewDecoder(r.Body).Decode(resp)
 }
 
 func (s *SES) composeRequestParams(fromAddress string, destination *Destination, message *Message) url.Values {
-	params := make(url.Values)
-	params.Add("AWSAccessKeyId", s.Auth.AccessKey)
-	params.Add("Action", "SendEmail")
+	params := s.makeParams("SendEmail")
 	params.Add("Source", fromAddress)
 
 	for index, addrs := range destination.ToAddresses {
@@ -0,0 +1,105 @@
+package sesv2
+
+import (
+	"net/url"
+	"time"
+)
+
+const (
+	EnforcementStatusHealthy   = "HEALTHY"
+	EnforcementStatusProbation = "PROBATION"
+	EnforcementStatusShutdown  = "SHUTDOWN"
+)
+
+// The reputation metrics SES publishes to CloudWatch in the AWS/SES
+// namespace, for the account and for configuration sets with reputation
+// metrics enabled.
+const (
+	ReputationMetricBounceRate    = "Reputation.BounceRate"
+	ReputationMetricComplaintRate = "Reputation.ComplaintRate"
+)
+
+// SendQuota holds the sending limits of the account and its usage in the
+// last 24 hours.
+type SendQuota struct {
+	Max24HourSend   float64
+	MaxSendRate     float64
+	SentLast24Hours float64
+}
+
+// Account describes the sending status of the account in the current
+// region. EnforcementStatus reflects the reputation of the account: it is
+// EnforcementStatusProbation or EnforcementStatusShutdown when bounce or
+// complaint rates are too high.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_GetAccount.html
+type Account struct {
+	SendQuota                    SendQuota
+	SendingEnabled               bool
+	ProductionAccessEnabled      bool
+	DedicatedIpAutoWarmupEnabled bool
+	EnforcementStatus            string
+}
+
+// GetAccount returns the sending quota and status of the account.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_GetAccount.html
+func (s *SESV2) GetAccount() (*Account, error) {
+	resp := new(Account)
+	if err := s.query("GET", "/account", nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type putReputationOptionsRequest struct {
+	ReputationMetricsEnabled bool
+}
+
+// PutConfigurationSetReputationOptions enables or disables the publication of
+// reputation metrics for the email sent with a configuration set.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_PutConfigurationSetReputationOptions.html
+func (s *SESV2) PutConfigurationSetReputationOptions(configurationSetName string, enabled bool) error {
+	req := &putReputationOptionsRequest{ReputationMetricsEnabled: enabled}
+	return s.query("PUT", "/configuration-sets/"+url.PathEscape(configurationSetName)+"/reputation-options", nil, req, nil)
+}
+
+type VolumeStatistics struct {
+	InboxRawCount  int64
+	SpamRawCount   int64
+	ProjectedInbox int64
+	ProjectedSpam  int64
+}
+
+type OverallVolume struct {
+	VolumeStatistics VolumeStatistics
+	ReadRatePercent  float64
+}
+
+type DailyVolume struct {
+	StartDate        Timestamp
+	VolumeStatistics VolumeStatistics
+}
+
+type DomainStatisticsReport struct {
+	OverallVolume OverallVolume
+	DailyVolumes  []DailyVolume
+}
+
+// GetDomainStatisticsReport returns the inbox placement and engagement
+// statistics of the email sent from domain between startDate and endDate.
+// The Deliverability dashboard must be enabled on the account.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_GetDomainStatisticsReport.html
+func (s *SESV2) GetDomainStatisticsReport(domain string, startDate, endDate time.Time) (*DomainStatisticsReport, error) {
+	params := url.Values{
+		"StartDate": {startDate.UTC().Format(time.RFC3339)},
+		"EndDate":   {endDate.UTC().Format(time.RFC3339)},
+	}
+	resp := new(DomainStatisticsReport)
+	if err := s.query("GET", "/deliverability-dashboard/statistics-report/"+url.PathEscape(domain), params, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
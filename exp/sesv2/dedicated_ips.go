@@ -0,0 +1,138 @@
+package sesv2
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const (
+	ScalingModeStandard = "STANDARD"
+	ScalingModeManaged  = "MANAGED"
+
+	WarmupStatusInProgress    = "IN_PROGRESS"
+	WarmupStatusDone          = "DONE"
+	WarmupStatusNotApplicable = "NOT_APPLICABLE"
+)
+
+// DedicatedIp is a dedicated IP address associated with the account.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_DedicatedIp.html
+type DedicatedIp struct {
+	Ip               string
+	WarmupStatus     string
+	WarmupPercentage int
+	PoolName         string
+}
+
+type createDedicatedIpPoolRequest struct {
+	PoolName    string
+	ScalingMode string `json:",omitempty"`
+}
+
+// CreateDedicatedIpPool creates a pool of dedicated IP addresses. scalingMode
+// may be "", in which case a standard pool is created.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_CreateDedicatedIpPool.html
+func (s *SESV2) CreateDedicatedIpPool(poolName, scalingMode string) error {
+	req := &createDedicatedIpPoolRequest{PoolName: poolName, ScalingMode: scalingMode}
+	return s.query("POST", "/dedicated-ip-pools", nil, req, nil)
+}
+
+// DeleteDedicatedIpPool deletes a dedicated IP pool.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_DeleteDedicatedIpPool.html
+func (s *SESV2) DeleteDedicatedIpPool(poolName string) error {
+	return s.query("DELETE", "/dedicated-ip-pools/"+url.PathEscape(poolName), nil, nil, nil)
+}
+
+type ListDedicatedIpPoolsResp struct {
+	DedicatedIpPools []string
+	NextToken        string
+}
+
+// ListDedicatedIpPools lists one page of the dedicated IP pool names. Pass
+// the NextToken of a response as nextToken to get the next page; nextToken
+// and pageSize may be "" and 0.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_ListDedicatedIpPools.html
+func (s *SESV2) ListDedicatedIpPools(nextToken string, pageSize int) (resp *ListDedicatedIpPoolsResp, err error) {
+	resp = new(ListDedicatedIpPoolsResp)
+	if err := s.query("GET", "/dedicated-ip-pools", pageParams(nextToken, pageSize), nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type GetDedicatedIpsResp struct {
+	DedicatedIps []DedicatedIp
+	NextToken    string
+}
+
+// GetDedicatedIps lists one page of the dedicated IP addresses of the
+// account, or only those in poolName if it isn't "".
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_GetDedicatedIps.html
+func (s *SESV2) GetDedicatedIps(poolName, nextToken string, pageSize int) (resp *GetDedicatedIpsResp, err error) {
+	params := pageParams(nextToken, pageSize)
+	if poolName != "" {
+		params.Set("PoolName", poolName)
+	}
+	resp = new(GetDedicatedIpsResp)
+	if err := s.query("GET", "/dedicated-ips", params, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type getDedicatedIpResp struct {
+	DedicatedIp DedicatedIp
+}
+
+// GetDedicatedIp returns the dedicated IP address ip, including its pool
+// and warm-up status.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_GetDedicatedIp.html
+func (s *SESV2) GetDedicatedIp(ip string) (*DedicatedIp, error) {
+	resp := new(getDedicatedIpResp)
+	if err := s.query("GET", "/dedicated-ips/"+url.PathEscape(ip), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return &resp.DedicatedIp, nil
+}
+
+type putDedicatedIpInPoolRequest struct {
+	DestinationPoolName string
+}
+
+// PutDedicatedIpInPool moves the dedicated IP address ip to the pool
+// destinationPoolName.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_PutDedicatedIpInPool.html
+func (s *SESV2) PutDedicatedIpInPool(ip, destinationPoolName string) error {
+	req := &putDedicatedIpInPoolRequest{DestinationPoolName: destinationPoolName}
+	return s.query("PUT", "/dedicated-ips/"+url.PathEscape(ip)+"/pool", nil, req, nil)
+}
+
+type putDedicatedIpWarmupAttributesRequest struct {
+	WarmupPercentage int
+}
+
+// PutDedicatedIpWarmupAttributes sets the warm-up progress of the dedicated
+// IP address ip, as a percentage.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/API_PutDedicatedIpWarmupAttributes.html
+func (s *SESV2) PutDedicatedIpWarmupAttributes(ip string, warmupPercentage int) error {
+	req := &putDedicatedIpWarmupAttributesRequest{WarmupPercentage: warmupPercentage}
+	return s.query("PUT", "/dedicated-ips/"+url.PathEscape(ip)+"/warmup", nil, req, nil)
+}
+
+func pageParams(nextToken string, pageSize int) url.Values {
+	params := url.Values{}
+	if nextToken != "" {
+		params.Set("NextToken", nextToken)
+	}
+	if pageSize != 0 {
+		params.Set("PageSize", strconv.Itoa(pageSize))
+	}
+	return params
+}
@@ -0,0 +1,54 @@
+package sesv2_test
+
+var ListDedicatedIpPoolsJSON = `
+{
+  "DedicatedIpPools": ["marketing", "transactional"],
+  "NextToken": "token2"
+}
+`
+
+var GetDedicatedIpsJSON = `
+{
+  "DedicatedIps": [
+    {"Ip": "192.0.2.1", "PoolName": "marketing", "WarmupPercentage": 100, "WarmupStatus": "DONE"},
+    {"Ip": "192.0.2.2", "PoolName": "marketing", "WarmupPercentage": 45, "WarmupStatus": "IN_PROGRESS"}
+  ]
+}
+`
+
+var GetDedicatedIpJSON = `
+{
+  "DedicatedIp": {"Ip": "192.0.2.2", "PoolName": "marketing", "WarmupPercentage": 45, "WarmupStatus": "IN_PROGRESS"}
+}
+`
+
+var GetAccountJSON = `
+{
+  "DedicatedIpAutoWarmupEnabled": false,
+  "EnforcementStatus": "HEALTHY",
+  "ProductionAccessEnabled": true,
+  "SendQuota": {
+    "Max24HourSend": 50000.0,
+    "MaxSendRate": 14.0,
+    "SentLast24Hours": 1234.0
+  },
+  "SendingEnabled": true
+}
+`
+
+var GetDomainStatisticsReportJSON = `
+{
+  "DailyVolumes": [
+    {
+      "DomainIspPlacements": [],
+      "StartDate": 1551398400,
+      "VolumeStatistics": {"InboxRawCount": 950, "ProjectedInbox": 9500, "ProjectedSpam": 500, "SpamRawCount": 50}
+    }
+  ],
+  "OverallVolume": {
+    "DomainIspPlacements": [],
+    "ReadRatePercent": 12.5,
+    "VolumeStatistics": {"InboxRawCount": 950, "ProjectedInbox": 9500, "ProjectedSpam": 500, "SpamRawCount": 50}
+  }
+}
+`
@@ -0,0 +1,140 @@
+// Package sesv2 provides a client for the Amazon SES API v2.
+//
+// See http://docs.aws.amazon.com/ses/latest/APIReference-V2/Welcome.html
+package sesv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// SESV2 uses the same endpoint as the classic SES API, under the /v2/email
+// path.
+type SESV2 struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *SESV2 {
+	return &SESV2{Auth: auth, Region: region}
+}
+
+// Error is an error returned by the SES v2 API.
+type Error struct {
+	StatusCode int    // HTTP status code (200, 403, ...)
+	Code       string // SES error code ("NotFoundException", ...)
+	Message    string `json:"message"`
+	RequestId  string
+}
+
+func (err *Error) Error() string {
+	if err.Code == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
+}
+
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
+// Timestamp is a time sent by SES v2 as seconds since the epoch.
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalJSON(b []byte) error {
+	var secs float64
+	if err := json.Unmarshal(b, &secs); err != nil {
+		return err
+	}
+	whole, frac := math.Modf(secs)
+	t.Time = time.Unix(int64(whole), int64(frac*1e9)).UTC()
+	return nil
+}
+
+// query sends a request to path, relative to /v2/email. in is encoded as
+// the JSON body of the request if not nil, and the JSON response is decoded
+// into out if not nil.
+func (s *SESV2) query(method, path string, params url.Values, in, out interface{}) error {
+	u := s.Region.SESEndpoint + "/v2/email" + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	hreq, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		hreq.Header.Set("Content-Type", "application/json")
+	}
+	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(aws.ISO8601BasicFormat))
+	if s.Auth.Token() != "" {
+		hreq.Header.Set("X-Amz-Security-Token", s.Auth.Token())
+	}
+
+	signer := aws.NewV4Signer(s.Auth, "ses", s.Region)
+	signer.Sign(hreq)
+
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(hreq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return buildError(resp, respBody)
+	}
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+func buildError(r *http.Response, body []byte) error {
+	err := &Error{
+		StatusCode: r.StatusCode,
+		Code:       r.Header.Get("X-Amzn-ErrorType"),
+		RequestId:  r.Header.Get("X-Amzn-RequestId"),
+	}
+	json.Unmarshal(body, err)
+	// The error type header may be followed by ":" and a URL.
+	if i := strings.Index(err.Code, ":"); i >= 0 {
+		err.Code = err.Code[:i]
+	}
+	if err.Message == "" {
+		err.Message = r.Status
+	}
+	return err
+}
@@ -0,0 +1,206 @@
+package sesv2_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/exp/sesv2"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+var testServer = testutil.NewHTTPServer()
+
+type S struct {
+	ses *sesv2.SESV2
+}
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.ses = sesv2.New(auth, aws.Region{Name: "us-east-1", SESEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func readJSON(c *check.C, body []byte) map[string]interface{} {
+	var v map[string]interface{}
+	c.Assert(json.Unmarshal(body, &v), check.IsNil)
+	return v
+}
+
+func (s *S) TestCreateDedicatedIpPool(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.ses.CreateDedicatedIpPool("marketing", sesv2.ScalingModeManaged)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ip-pools")
+	c.Assert(req.Header.Get("Content-Type"), check.Equals, "application/json")
+	c.Assert(req.Header.Get("Authorization"), check.Matches, "AWS4-HMAC-SHA256 Credential=abc/[0-9]{8}/us-east-1/ses/aws4_request, .*")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"PoolName":    "marketing",
+		"ScalingMode": "MANAGED",
+	})
+}
+
+func (s *S) TestDeleteDedicatedIpPool(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.ses.DeleteDedicatedIpPool("marketing")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ip-pools/marketing")
+}
+
+func (s *S) TestListDedicatedIpPools(c *check.C) {
+	testServer.Response(200, nil, ListDedicatedIpPoolsJSON)
+
+	resp, err := s.ses.ListDedicatedIpPools("token1", 10)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ip-pools")
+	c.Assert(req.Form.Get("NextToken"), check.Equals, "token1")
+	c.Assert(req.Form.Get("PageSize"), check.Equals, "10")
+	c.Assert(resp.DedicatedIpPools, check.DeepEquals, []string{"marketing", "transactional"})
+	c.Assert(resp.NextToken, check.Equals, "token2")
+}
+
+func (s *S) TestGetDedicatedIps(c *check.C) {
+	testServer.Response(200, nil, GetDedicatedIpsJSON)
+
+	resp, err := s.ses.GetDedicatedIps("marketing", "", 0)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ips")
+	c.Assert(req.Form.Get("PoolName"), check.Equals, "marketing")
+	c.Assert(req.Form["NextToken"], check.IsNil)
+	c.Assert(resp.DedicatedIps, check.DeepEquals, []sesv2.DedicatedIp{
+		{Ip: "192.0.2.1", WarmupStatus: sesv2.WarmupStatusDone, WarmupPercentage: 100, PoolName: "marketing"},
+		{Ip: "192.0.2.2", WarmupStatus: sesv2.WarmupStatusInProgress, WarmupPercentage: 45, PoolName: "marketing"},
+	})
+}
+
+func (s *S) TestGetDedicatedIp(c *check.C) {
+	testServer.Response(200, nil, GetDedicatedIpJSON)
+
+	ip, err := s.ses.GetDedicatedIp("192.0.2.2")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ips/192.0.2.2")
+	c.Assert(*ip, check.DeepEquals, sesv2.DedicatedIp{
+		Ip: "192.0.2.2", WarmupStatus: sesv2.WarmupStatusInProgress, WarmupPercentage: 45, PoolName: "marketing",
+	})
+}
+
+func (s *S) TestPutDedicatedIpInPool(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.ses.PutDedicatedIpInPool("192.0.2.2", "transactional")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ips/192.0.2.2/pool")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{"DestinationPoolName": "transactional"})
+}
+
+func (s *S) TestPutDedicatedIpWarmupAttributes(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.ses.PutDedicatedIpWarmupAttributes("192.0.2.2", 50)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/dedicated-ips/192.0.2.2/warmup")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{"WarmupPercentage": float64(50)})
+}
+
+func (s *S) TestGetAccount(c *check.C) {
+	testServer.Response(200, nil, GetAccountJSON)
+
+	account, err := s.ses.GetAccount()
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/account")
+	c.Assert(account.SendQuota, check.Equals, sesv2.SendQuota{Max24HourSend: 50000, MaxSendRate: 14, SentLast24Hours: 1234})
+	c.Assert(account.SendingEnabled, check.Equals, true)
+	c.Assert(account.ProductionAccessEnabled, check.Equals, true)
+	c.Assert(account.DedicatedIpAutoWarmupEnabled, check.Equals, false)
+	c.Assert(account.EnforcementStatus, check.Equals, sesv2.EnforcementStatusHealthy)
+}
+
+func (s *S) TestPutConfigurationSetReputationOptions(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.ses.PutConfigurationSetReputationOptions("my-config-set", true)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/configuration-sets/my-config-set/reputation-options")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{"ReputationMetricsEnabled": true})
+}
+
+func (s *S) TestGetDomainStatisticsReport(c *check.C) {
+	testServer.Response(200, nil, GetDomainStatisticsReportJSON)
+
+	start := time.Date(2019, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2019, 3, 2, 0, 0, 0, 0, time.UTC)
+	report, err := s.ses.GetDomainStatisticsReport("example.com", start, end)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.URL.Path, check.Equals, "/v2/email/deliverability-dashboard/statistics-report/example.com")
+	c.Assert(req.Form.Get("StartDate"), check.Equals, "2019-03-01T00:00:00Z")
+	c.Assert(req.Form.Get("EndDate"), check.Equals, "2019-03-02T00:00:00Z")
+	c.Assert(report.OverallVolume.ReadRatePercent, check.Equals, 12.5)
+	c.Assert(report.OverallVolume.VolumeStatistics.InboxRawCount, check.Equals, int64(950))
+	c.Assert(report.DailyVolumes, check.HasLen, 1)
+	c.Assert(report.DailyVolumes[0].StartDate.Equal(start), check.Equals, true)
+	c.Assert(report.DailyVolumes[0].VolumeStatistics.SpamRawCount, check.Equals, int64(50))
+}
+
+func (s *S) TestError(c *check.C) {
+	headers := map[string]string{
+		"X-Amzn-ErrorType": "NotFoundException:http://internal.amazon.com/coral/com.amazonaws.sesv2/",
+		"X-Amzn-RequestId": "2cc2b7c6-e0a0-4c1c-9d1a-4c4e8a4b2f0e",
+	}
+	testServer.Response(404, headers, `{"message":"Pool marketing does not exist."}`)
+
+	_, err := s.ses.GetDedicatedIps("marketing", "", 0)
+	testServer.WaitRequest()
+
+	c.Assert(err, check.DeepEquals, &sesv2.Error{
+		StatusCode: 404,
+		Code:       "NotFoundException",
+		Message:    "Pool marketing does not exist.",
+		RequestId:  "2cc2b7c6-e0a0-4c1c-9d1a-4c4e8a4b2f0e",
+	})
+	c.Assert(err.Error(), check.Equals, "Pool marketing does not exist. (NotFoundException)")
+}
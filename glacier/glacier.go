@@ -0,0 +1,125 @@
+// Package glacier provides types and functions to interact with Amazon S3
+// Glacier vaults.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/amazon-glacier-api.html
+package glacier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// ApiVersion is sent with every request in the x-amz-glacier-version
+// header.
+const ApiVersion = "2012-06-01"
+
+type Glacier struct {
+	aws.Auth
+	aws.Region
+
+	// AccountId is the ID of the account owning the vaults, or "-" for the
+	// account of the credentials.
+	AccountId string
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *Glacier {
+	return &Glacier{Auth: auth, Region: region, AccountId: "-"}
+}
+
+// Error is an error returned by the Glacier API.
+type Error struct {
+	StatusCode int    // HTTP status code (200, 403, ...)
+	Code       string `json:"code"` // Glacier error code ("ResourceNotFoundException", ...)
+	Type       string `json:"type"` // "Client" or "Server"
+	Message    string `json:"message"`
+	RequestId  string
+}
+
+func (err *Error) Error() string {
+	return fmt.Sprintf("glacier: %s: %s", err.Code, err.Message)
+}
+
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
+// vaultPath returns the path of a vault, followed by the elements of sub.
+func (g *Glacier) vaultPath(vaultName string, sub ...string) string {
+	path := "/" + url.PathEscape(g.AccountId) + "/vaults/" + url.PathEscape(vaultName)
+	for _, s := range sub {
+		path += "/" + url.PathEscape(s)
+	}
+	return path
+}
+
+// query sends a request to path. in is encoded as the JSON body of the
+// request if not nil, and the JSON response is decoded into out if not nil.
+func (g *Glacier) query(method, path string, in, out interface{}) (*http.Response, error) {
+	var r io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(b)
+	}
+	hreq, err := http.NewRequest(method, g.Region.GlacierEndpoint+path, r)
+	if err != nil {
+		return nil, err
+	}
+	if in != nil {
+		hreq.Header.Set("Content-Type", "application/json")
+	}
+	hreq.Header.Set("X-Amz-Glacier-Version", ApiVersion)
+	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(aws.ISO8601BasicFormat))
+	if g.Auth.Token() != "" {
+		hreq.Header.Set("X-Amz-Security-Token", g.Auth.Token())
+	}
+
+	signer := aws.NewV4Signer(g.Auth, "glacier", g.Region)
+	signer.Sign(hreq)
+
+	client := g.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(hreq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// Glacier answers 200, 201 or 204 depending on the action.
+	if resp.StatusCode/100 != 2 {
+		glacierErr := &Error{
+			StatusCode: resp.StatusCode,
+			RequestId:  resp.Header.Get("X-Amzn-RequestId"),
+		}
+		if err := json.Unmarshal(body, glacierErr); err != nil || glacierErr.Message == "" {
+			glacierErr.Message = resp.Status
+		}
+		return nil, glacierErr
+	}
+	if out != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,167 @@
+package glacier_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/glacier"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+var testServer = testutil.NewHTTPServer()
+
+type S struct {
+	glacier *glacier.Glacier
+}
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.glacier = glacier.New(auth, aws.Region{Name: "us-east-1", GlacierEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func readJSON(c *check.C, body []byte) map[string]interface{} {
+	var v map[string]interface{}
+	c.Assert(json.Unmarshal(body, &v), check.IsNil)
+	return v
+}
+
+func (s *S) TestInitiateVaultLock(c *check.C) {
+	testServer.Response(201, map[string]string{"x-amz-lock-id": "AE863rKkWZU53SLW5be4DUcW"}, "")
+
+	policy := glacier.ArchiveRetentionPolicy("arn:aws:glacier:us-east-1:123456789012:vaults/compliance", 365)
+	lockId, err := s.glacier.InitiateVaultLock("compliance", policy)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/lock-policy")
+	c.Assert(req.Header.Get("X-Amz-Glacier-Version"), check.Equals, "2012-06-01")
+	c.Assert(req.Header.Get("Authorization"), check.Matches, "AWS4-HMAC-SHA256 Credential=abc/[0-9]{8}/us-east-1/glacier/aws4_request, .*")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{"Policy": policy})
+	c.Assert(lockId, check.Equals, "AE863rKkWZU53SLW5be4DUcW")
+
+	var doc map[string]interface{}
+	c.Assert(json.Unmarshal([]byte(policy), &doc), check.IsNil)
+	c.Assert(doc["Statement"], check.DeepEquals, []interface{}{map[string]interface{}{
+		"Sid":       "deny-based-on-archive-age",
+		"Principal": "*",
+		"Effect":    "Deny",
+		"Action":    "glacier:DeleteArchive",
+		"Resource":  "arn:aws:glacier:us-east-1:123456789012:vaults/compliance",
+		"Condition": map[string]interface{}{
+			"NumericLessThan": map[string]interface{}{"glacier:ArchiveAgeInDays": "365"},
+		},
+	}})
+}
+
+func (s *S) TestCompleteVaultLock(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.glacier.CompleteVaultLock("compliance", "AE863rKkWZU53SLW5be4DUcW")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/lock-policy/AE863rKkWZU53SLW5be4DUcW")
+}
+
+func (s *S) TestAbortVaultLock(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.glacier.AbortVaultLock("compliance")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/lock-policy")
+}
+
+func (s *S) TestGetVaultLock(c *check.C) {
+	testServer.Response(200, nil, GetVaultLockJSON)
+
+	lock, err := s.glacier.GetVaultLock("compliance")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/lock-policy")
+	c.Assert(lock.State, check.Equals, glacier.VaultLockStateInProgress)
+	c.Assert(lock.Policy, check.Matches, `\{"Version":"2012-10-17".*`)
+	c.Assert(lock.CreationDate.Equal(time.Date(2016, 2, 23, 20, 49, 18, 482000000, time.UTC)), check.Equals, true)
+	c.Assert(lock.ExpirationDate.Sub(lock.CreationDate), check.Equals, 24*time.Hour)
+}
+
+func (s *S) TestSetVaultNotifications(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.glacier.SetVaultNotifications("compliance", &glacier.VaultNotificationConfig{
+		SNSTopic: "arn:aws:sns:us-east-1:123456789012:retrievals",
+		Events:   []string{glacier.EventArchiveRetrievalCompleted, glacier.EventInventoryRetrievalCompleted},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/notification-configuration")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"SNSTopic": "arn:aws:sns:us-east-1:123456789012:retrievals",
+		"Events":   []interface{}{"ArchiveRetrievalCompleted", "InventoryRetrievalCompleted"},
+	})
+}
+
+func (s *S) TestGetVaultNotifications(c *check.C) {
+	testServer.Response(200, nil, GetVaultNotificationsJSON)
+
+	config, err := s.glacier.GetVaultNotifications("compliance")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/notification-configuration")
+	c.Assert(config, check.DeepEquals, &glacier.VaultNotificationConfig{
+		SNSTopic: "arn:aws:sns:us-east-1:123456789012:retrievals",
+		Events:   []string{"ArchiveRetrievalCompleted"},
+	})
+}
+
+func (s *S) TestDeleteVaultNotifications(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.glacier.DeleteVaultNotifications("compliance")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/-/vaults/compliance/notification-configuration")
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(404, map[string]string{"x-amzn-RequestId": "AAABZpJrTyioDC_HsOmHae8EZp_uBSJr6cnGOLKp_XJCl-Q"}, ErrorJSON)
+
+	_, err := s.glacier.GetVaultLock("compliance")
+	testServer.WaitRequest()
+
+	glacierErr, ok := err.(*glacier.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(glacierErr.StatusCode, check.Equals, 404)
+	c.Assert(glacierErr.Code, check.Equals, "ResourceNotFoundException")
+	c.Assert(glacierErr.Type, check.Equals, "Client")
+	c.Assert(glacierErr.RequestId, check.Equals, "AAABZpJrTyioDC_HsOmHae8EZp_uBSJr6cnGOLKp_XJCl-Q")
+	c.Assert(err, check.ErrorMatches, "glacier: ResourceNotFoundException: Vault lock policy not found for vault: compliance")
+}
@@ -0,0 +1,27 @@
+package glacier_test
+
+// http://docs.aws.amazon.com/amazonglacier/latest/dev/api-GetVaultLock.html
+var GetVaultLockJSON = `
+{
+  "Policy": "{\"Version\":\"2012-10-17\",\"Statement\":[{\"Sid\":\"deny-based-on-archive-age\",\"Principal\":\"*\",\"Effect\":\"Deny\",\"Action\":\"glacier:DeleteArchive\",\"Resource\":\"arn:aws:glacier:us-east-1:123456789012:vaults/compliance\",\"Condition\":{\"NumericLessThan\":{\"glacier:ArchiveAgeInDays\":\"365\"}}}]}",
+  "State": "InProgress",
+  "ExpirationDate": "2016-02-24T20:49:18.482Z",
+  "CreationDate": "2016-02-23T20:49:18.482Z"
+}
+`
+
+// http://docs.aws.amazon.com/amazonglacier/latest/dev/api-vault-notifications-get.html
+var GetVaultNotificationsJSON = `
+{
+  "SNSTopic": "arn:aws:sns:us-east-1:123456789012:retrievals",
+  "Events": ["ArchiveRetrievalCompleted"]
+}
+`
+
+var ErrorJSON = `
+{
+  "code": "ResourceNotFoundException",
+  "message": "Vault lock policy not found for vault: compliance",
+  "type": "Client"
+}
+`
@@ -0,0 +1,149 @@
+package glacier
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// States of a vault lock. A lock stays InProgress, and can be aborted,
+// for 24 hours after InitiateVaultLock; it expires unless completed in
+// that time.
+const (
+	VaultLockStateInProgress = "InProgress"
+	VaultLockStateLocked     = "Locked"
+)
+
+// Events of a vault that can be published to SNS.
+const (
+	EventArchiveRetrievalCompleted   = "ArchiveRetrievalCompleted"
+	EventInventoryRetrievalCompleted = "InventoryRetrievalCompleted"
+)
+
+type vaultLockPolicy struct {
+	Policy string
+}
+
+// InitiateVaultLock attaches a vault lock policy, a JSON IAM policy such
+// as ArchiveRetentionPolicy returns, to a vault and returns the ID of the
+// lock. The policy can be tested, and the lock aborted, until the lock is
+// completed with CompleteVaultLock, after which the policy can't be
+// changed or removed.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-InitiateVaultLock.html
+func (g *Glacier) InitiateVaultLock(vaultName, policy string) (lockId string, err error) {
+	resp, err := g.query("POST", g.vaultPath(vaultName, "lock-policy"), &vaultLockPolicy{policy}, nil)
+	if err != nil {
+		return "", err
+	}
+	return resp.Header.Get("X-Amz-Lock-Id"), nil
+}
+
+// CompleteVaultLock locks the vault lock policy of a vault for good.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-CompleteVaultLock.html
+func (g *Glacier) CompleteVaultLock(vaultName, lockId string) error {
+	_, err := g.query("POST", g.vaultPath(vaultName, "lock-policy", lockId), nil, nil)
+	return err
+}
+
+// AbortVaultLock removes the vault lock policy of a vault whose lock is
+// still in progress.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-AbortVaultLock.html
+func (g *Glacier) AbortVaultLock(vaultName string) error {
+	_, err := g.query("DELETE", g.vaultPath(vaultName, "lock-policy"), nil, nil)
+	return err
+}
+
+// VaultLock is the vault lock policy of a vault and the state of its lock.
+// ExpirationDate is only set while the lock is in progress.
+type VaultLock struct {
+	Policy         string
+	State          string
+	CreationDate   time.Time
+	ExpirationDate time.Time
+}
+
+// GetVaultLock returns the vault lock policy of a vault.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-GetVaultLock.html
+func (g *Glacier) GetVaultLock(vaultName string) (*VaultLock, error) {
+	lock := new(VaultLock)
+	if _, err := g.query("GET", g.vaultPath(vaultName, "lock-policy"), nil, lock); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+type policyStatement struct {
+	Sid       string
+	Principal string
+	Effect    string
+	Action    string
+	Resource  string
+	Condition map[string]map[string]string
+}
+
+type policyDocument struct {
+	Version   string
+	Statement []policyStatement
+}
+
+// ArchiveRetentionPolicy returns a vault lock policy denying everyone the
+// deletion of the archives of a vault, given by ARN, that are less than
+// days old.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/vault-lock-policy.html
+func ArchiveRetentionPolicy(vaultArn string, days int) string {
+	policy, _ := json.Marshal(&policyDocument{
+		Version: "2012-10-17",
+		Statement: []policyStatement{{
+			Sid:       "deny-based-on-archive-age",
+			Principal: "*",
+			Effect:    "Deny",
+			Action:    "glacier:DeleteArchive",
+			Resource:  vaultArn,
+			Condition: map[string]map[string]string{
+				"NumericLessThan": {"glacier:ArchiveAgeInDays": fmt.Sprint(days)},
+			},
+		}},
+	})
+	return string(policy)
+}
+
+// VaultNotificationConfig publishes the given events of a vault to an SNS
+// topic, such as the completion of the retrieval jobs.
+type VaultNotificationConfig struct {
+	SNSTopic string
+	Events   []string
+}
+
+// SetVaultNotifications sets where the events of a vault are published,
+// replacing the previous configuration if any. Glacier must be allowed to
+// publish to the topic.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-vault-notifications-put.html
+func (g *Glacier) SetVaultNotifications(vaultName string, config *VaultNotificationConfig) error {
+	_, err := g.query("PUT", g.vaultPath(vaultName, "notification-configuration"), config, nil)
+	return err
+}
+
+// GetVaultNotifications returns where the events of a vault are published.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-vault-notifications-get.html
+func (g *Glacier) GetVaultNotifications(vaultName string) (*VaultNotificationConfig, error) {
+	config := new(VaultNotificationConfig)
+	if _, err := g.query("GET", g.vaultPath(vaultName, "notification-configuration"), nil, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// DeleteVaultNotifications stops publishing the events of a vault.
+//
+// See http://docs.aws.amazon.com/amazonglacier/latest/dev/api-vault-notifications-delete.html
+func (g *Glacier) DeleteVaultNotifications(vaultName string) error {
+	_, err := g.query("DELETE", g.vaultPath(vaultName, "notification-configuration"), nil, nil)
+	return err
+}
@@ -0,0 +1,111 @@
+package iam
+
+import (
+	"encoding/xml"
+)
+
+// CreateAccountAlias sets the alias of the AWS account, which replaces the
+// account ID in its sign-in URL. An account has at most one alias.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateAccountAlias.html for more details.
+func (iam *IAM) CreateAccountAlias(alias string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":       "CreateAccountAlias",
+		"AccountAlias": alias,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteAccountAlias deletes the alias of the AWS account.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteAccountAlias.html for more details.
+func (iam *IAM) DeleteAccountAlias(alias string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":       "DeleteAccountAlias",
+		"AccountAlias": alias,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListAccountAliases request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListAccountAliases.html for more details.
+type ListAccountAliasesResp struct {
+	AccountAliases []string `xml:"ListAccountAliasesResult>AccountAliases>member"`
+	IsTruncated    bool     `xml:"ListAccountAliasesResult>IsTruncated"`
+	Marker         string   `xml:"ListAccountAliasesResult>Marker"`
+	RequestId      string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListAccountAliases lists the aliases of the AWS account.
+//
+// The marker parameter is optional. If the response is truncated, pass its
+// Marker to get the next page.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListAccountAliases.html for more details.
+func (iam *IAM) ListAccountAliases(marker string) (*ListAccountAliasesResp, error) {
+	params := map[string]string{
+		"Action": "ListAccountAliases",
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListAccountAliasesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AccountSummary maps the names of IAM usage counts and quotas of an
+// account, such as "Users" and "UsersQuota", to their values.
+type AccountSummary map[string]int
+
+// UnmarshalXML decodes the entries of a SummaryMap.
+func (m *AccountSummary) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var entries struct {
+		Entry []struct {
+			Key   string `xml:"key"`
+			Value int    `xml:"value"`
+		} `xml:"entry"`
+	}
+	if err := d.DecodeElement(&entries, &start); err != nil {
+		return err
+	}
+	*m = make(AccountSummary, len(entries.Entry))
+	for _, e := range entries.Entry {
+		(*m)[e.Key] = e.Value
+	}
+	return nil
+}
+
+// Response to a GetAccountSummary request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetAccountSummary.html for more details.
+type GetAccountSummaryResp struct {
+	SummaryMap AccountSummary `xml:"GetAccountSummaryResult>SummaryMap"`
+	RequestId  string         `xml:"ResponseMetadata>RequestId"`
+}
+
+// GetAccountSummary gets the usage and quotas of IAM entities in the AWS
+// account.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetAccountSummary.html for more details.
+func (iam *IAM) GetAccountSummary() (*GetAccountSummaryResp, error) {
+	params := map[string]string{
+		"Action": "GetAccountSummary",
+	}
+	resp := new(GetAccountSummaryResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
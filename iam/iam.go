@@ -107,21 +107,44 @@ type CreateUserResp struct {
 //
 // See http://goo.gl/BwIQ3 for more details.
 type User struct {
-	Arn  string
-	Path string
-	Id   string `xml:"UserId"`
-	Name string `xml:"UserName"`
+	Arn                 string
+	Path                string
+	Id                  string               `xml:"UserId"`
+	Name                string               `xml:"UserName"`
+	PermissionsBoundary *PermissionsBoundary `xml:"PermissionsBoundary"`
+}
+
+// PermissionsBoundary describes the managed policy that sets the maximum
+// permissions an IAM user or role can have.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_AttachedPermissionsBoundary.html for more details.
+type PermissionsBoundary struct {
+	Arn  string `xml:"PermissionsBoundaryArn"`
+	Type string `xml:"PermissionsBoundaryType"`
 }
 
 // CreateUser creates a new user in IAM.
 //
 // See http://goo.gl/JS9Gz for more details.
 func (iam *IAM) CreateUser(name, path string) (*CreateUserResp, error) {
+	return iam.CreateUserWithPermissionsBoundary(name, path, "")
+}
+
+// CreateUserWithPermissionsBoundary creates a new user in IAM whose
+// permissions are limited by the managed policy permissionsBoundary.
+//
+// If permissionsBoundary is "" no boundary is set.
+//
+// See http://goo.gl/JS9Gz for more details.
+func (iam *IAM) CreateUserWithPermissionsBoundary(name, path, permissionsBoundary string) (*CreateUserResp, error) {
 	params := map[string]string{
 		"Action":   "CreateUser",
 		"Path":     path,
 		"UserName": name,
 	}
+	if permissionsBoundary != "" {
+		params["PermissionsBoundary"] = permissionsBoundary
+	}
 	resp := new(CreateUserResp)
 	if err := iam.query(params, resp); err != nil {
 		return nil, err
@@ -169,6 +192,118 @@ func (iam *IAM) DeleteUser(name string) (*SimpleResp, error) {
 	return resp, nil
 }
 
+// PutUserPermissionsBoundary sets or replaces the permissions boundary of a
+// user with the managed policy identified by policyArn.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_PutUserPermissionsBoundary.html for more details.
+func (iam *IAM) PutUserPermissionsBoundary(userName, policyArn string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":              "PutUserPermissionsBoundary",
+		"UserName":            userName,
+		"PermissionsBoundary": policyArn,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteUserPermissionsBoundary removes the permissions boundary of a user.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteUserPermissionsBoundary.html for more details.
+func (iam *IAM) DeleteUserPermissionsBoundary(userName string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":   "DeleteUserPermissionsBoundary",
+		"UserName": userName,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a CreateRole request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateRole.html for more details.
+type CreateRoleResp struct {
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+	Role      Role   `xml:"CreateRoleResult>Role"`
+}
+
+// Role encapsulates a role managed by IAM.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_Role.html for more details.
+type Role struct {
+	Arn                      string
+	Path                     string
+	Id                       string               `xml:"RoleId"`
+	Name                     string               `xml:"RoleName"`
+	AssumeRolePolicyDocument string               `xml:"AssumeRolePolicyDocument"`
+	CreateDate               string               `xml:"CreateDate"`
+	PermissionsBoundary      *PermissionsBoundary `xml:"PermissionsBoundary"`
+}
+
+// CreateRole creates a new role in IAM that can be assumed by the principals
+// named in assumeRolePolicyDocument.
+//
+// The path and permissionsBoundary parameters are optional. If
+// permissionsBoundary is set, it must be the ARN of the managed policy used
+// to limit the role's permissions.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateRole.html for more details.
+func (iam *IAM) CreateRole(name, path, assumeRolePolicyDocument, permissionsBoundary string) (*CreateRoleResp, error) {
+	params := map[string]string{
+		"Action":                   "CreateRole",
+		"RoleName":                 name,
+		"AssumeRolePolicyDocument": assumeRolePolicyDocument,
+	}
+	if path != "" {
+		params["Path"] = path
+	}
+	if permissionsBoundary != "" {
+		params["PermissionsBoundary"] = permissionsBoundary
+	}
+	resp := new(CreateRoleResp)
+	if err := iam.postQuery(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PutRolePermissionsBoundary sets or replaces the permissions boundary of a
+// role with the managed policy identified by policyArn.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_PutRolePermissionsBoundary.html for more details.
+func (iam *IAM) PutRolePermissionsBoundary(roleName, policyArn string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":              "PutRolePermissionsBoundary",
+		"RoleName":            roleName,
+		"PermissionsBoundary": policyArn,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteRolePermissionsBoundary removes the permissions boundary of a role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteRolePermissionsBoundary.html for more details.
+func (iam *IAM) DeleteRolePermissionsBoundary(roleName string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":   "DeleteRolePermissionsBoundary",
+		"RoleName": roleName,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Response to a CreateGroup request.
 //
 // See http://goo.gl/n7NNQ for more details.
@@ -432,3 +567,7 @@ func (e *Error) Error() string {
 	}
 	return prefix + e.Message
 }
+
+func (e *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, e, e.StatusCode, e.Code, e.Message, "")
+}
@@ -88,6 +88,85 @@ func (s *S) TestDeleteUser(c *check.C) {
 	c.Assert(resp.RequestId, check.Equals, "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE")
 }
 
+func (s *S) TestCreateUserWithPermissionsBoundary(c *check.C) {
+	testServer.Response(200, nil, CreateUserWithPermissionsBoundaryExample)
+	boundary := "arn:aws:iam::123456789012:policy/DelegatedAdminBoundary"
+	resp, err := s.iam.CreateUserWithPermissionsBoundary("Bob", "/", boundary)
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "CreateUser")
+	c.Assert(values.Get("UserName"), check.Equals, "Bob")
+	c.Assert(values.Get("PermissionsBoundary"), check.Equals, boundary)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.User.PermissionsBoundary, check.DeepEquals, &iam.PermissionsBoundary{
+		Arn:  boundary,
+		Type: "Policy",
+	})
+}
+
+func (s *S) TestPutUserPermissionsBoundary(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	boundary := "arn:aws:iam::123456789012:policy/DelegatedAdminBoundary"
+	resp, err := s.iam.PutUserPermissionsBoundary("Bob", boundary)
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "PutUserPermissionsBoundary")
+	c.Assert(values.Get("UserName"), check.Equals, "Bob")
+	c.Assert(values.Get("PermissionsBoundary"), check.Equals, boundary)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE")
+}
+
+func (s *S) TestDeleteUserPermissionsBoundary(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.DeleteUserPermissionsBoundary("Bob")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DeleteUserPermissionsBoundary")
+	c.Assert(values.Get("UserName"), check.Equals, "Bob")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestCreateRole(c *check.C) {
+	testServer.Response(200, nil, CreateRoleExample)
+	boundary := "arn:aws:iam::123456789012:policy/DelegatedAdminBoundary"
+	policy := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]},"Action":["sts:AssumeRole"]}]}`
+	resp, err := s.iam.CreateRole("S3Access", "/application_abc/component_xyz/", policy, boundary)
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.FormValue("Action"), check.Equals, "CreateRole")
+	c.Assert(req.FormValue("RoleName"), check.Equals, "S3Access")
+	c.Assert(req.FormValue("Path"), check.Equals, "/application_abc/component_xyz/")
+	c.Assert(req.FormValue("AssumeRolePolicyDocument"), check.Equals, policy)
+	c.Assert(req.FormValue("PermissionsBoundary"), check.Equals, boundary)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "4a93ceee-9966-11e1-b624-b1aEXAMPLE7c")
+	c.Assert(resp.Role.Name, check.Equals, "S3Access")
+	c.Assert(resp.Role.Id, check.Equals, "AROADBQP57FF2AEXAMPLE")
+	c.Assert(resp.Role.AssumeRolePolicyDocument, check.Equals, policy)
+	c.Assert(resp.Role.PermissionsBoundary, check.DeepEquals, &iam.PermissionsBoundary{
+		Arn:  boundary,
+		Type: "Policy",
+	})
+}
+
+func (s *S) TestPutRolePermissionsBoundary(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	boundary := "arn:aws:iam::123456789012:policy/DelegatedAdminBoundary"
+	_, err := s.iam.PutRolePermissionsBoundary("S3Access", boundary)
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "PutRolePermissionsBoundary")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(values.Get("PermissionsBoundary"), check.Equals, boundary)
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestDeleteRolePermissionsBoundary(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.DeleteRolePermissionsBoundary("S3Access")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DeleteRolePermissionsBoundary")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(err, check.IsNil)
+}
+
 func (s *S) TestCreateGroup(c *check.C) {
 	testServer.Response(200, nil, CreateGroupExample)
 	resp, err := s.iam.CreateGroup("Admins", "/admins/")
@@ -276,3 +355,315 @@ func (s *S) TestDeleteUserPolicy(c *check.C) {
 	c.Assert(err, check.IsNil)
 	c.Assert(resp.RequestId, check.Equals, "7a62c49f-347e-4fc4-9331-6e8eEXAMPLE")
 }
+
+func (s *S) TestGetRole(c *check.C) {
+	testServer.Response(200, nil, strings.Replace(CreateRoleExample, "CreateRole", "GetRole", -1))
+	resp, err := s.iam.GetRole("S3Access")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "GetRole")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Role.Id, check.Equals, "AROADBQP57FF2AEXAMPLE")
+}
+
+func (s *S) TestDeleteRole(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.DeleteRole("S3Access")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DeleteRole")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestListRoles(c *check.C) {
+	testServer.Response(200, nil, ListRolesExample)
+	resp, err := s.iam.ListRoles("/application_abc/", "previous")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ListRoles")
+	c.Assert(values.Get("PathPrefix"), check.Equals, "/application_abc/")
+	c.Assert(values.Get("Marker"), check.Equals, "previous")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.IsTruncated, check.Equals, true)
+	c.Assert(resp.Marker, check.Equals, "AAF0aW5nIG1hcmtlcg==")
+	c.Assert(resp.Roles, check.HasLen, 2)
+	c.Assert(resp.Roles[1], check.DeepEquals, iam.Role{
+		Arn:                      "arn:aws:iam::123456789012:role/application_abc/component_xyz/SDBAccess",
+		Path:                     "/application_abc/component_xyz/",
+		Id:                       "AROAC2ICXG32EXAMPLEWK",
+		Name:                     "SDBAccess",
+		AssumeRolePolicyDocument: "%7B%22Version%22%3A%222012-10-17%22%7D",
+		CreateDate:               "2012-05-09T15:45:45Z",
+	})
+}
+
+func (s *S) TestListRolesWithoutParams(c *check.C) {
+	testServer.Response(200, nil, ListRolesExample)
+	s.iam.ListRoles("", "")
+	values := testServer.WaitRequest().URL.Query()
+	_, ok := values["PathPrefix"]
+	c.Assert(ok, check.Equals, false)
+	_, ok = values["Marker"]
+	c.Assert(ok, check.Equals, false)
+}
+
+func (s *S) TestAttachRolePolicy(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.AttachRolePolicy("S3Access", "arn:aws:iam::aws:policy/ReadOnlyAccess")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "AttachRolePolicy")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(values.Get("PolicyArn"), check.Equals, "arn:aws:iam::aws:policy/ReadOnlyAccess")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestListAttachedRolePolicies(c *check.C) {
+	testServer.Response(200, nil, ListAttachedRolePoliciesExample)
+	resp, err := s.iam.ListAttachedRolePolicies("S3Access", "")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ListAttachedRolePolicies")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.IsTruncated, check.Equals, false)
+	c.Assert(resp.Policies, check.DeepEquals, []iam.AttachedPolicy{
+		{Name: "ReadOnlyAccess", Arn: "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+	})
+}
+
+func (s *S) TestPutRolePolicy(c *check.C) {
+	document := `{"Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.PutRolePolicy("S3Access", "S3AccessPolicy", document)
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.FormValue("Action"), check.Equals, "PutRolePolicy")
+	c.Assert(req.FormValue("RoleName"), check.Equals, "S3Access")
+	c.Assert(req.FormValue("PolicyName"), check.Equals, "S3AccessPolicy")
+	c.Assert(req.FormValue("PolicyDocument"), check.Equals, document)
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestGetRolePolicy(c *check.C) {
+	testServer.Response(200, nil, GetRolePolicyExample)
+	resp, err := s.iam.GetRolePolicy("S3Access", "S3AccessPolicy")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "GetRolePolicy")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(values.Get("PolicyName"), check.Equals, "S3AccessPolicy")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Policy.RoleName, check.Equals, "S3Access")
+	c.Assert(resp.Policy.Name, check.Equals, "S3AccessPolicy")
+	c.Assert(resp.Policy.Document, check.Equals, "%7B%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Action%22%3A%22s3%3A*%22%2C%22Resource%22%3A%22*%22%7D%5D%7D")
+}
+
+func (s *S) TestCreatePolicy(c *check.C) {
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::example-bucket/*"}]}`
+	testServer.Response(200, nil, CreatePolicyExample)
+	resp, err := s.iam.CreatePolicy("S3-read-only-example-bucket", "", "Read example-bucket", document)
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.FormValue("Action"), check.Equals, "CreatePolicy")
+	c.Assert(req.FormValue("PolicyName"), check.Equals, "S3-read-only-example-bucket")
+	c.Assert(req.FormValue("Description"), check.Equals, "Read example-bucket")
+	c.Assert(req.FormValue("PolicyDocument"), check.Equals, document)
+	c.Assert(req.Form["Path"], check.IsNil)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "ca64c9e1-3cfe-11e4-bfad-8d1c6EXAMPLE")
+	c.Assert(resp.Policy, check.DeepEquals, iam.Policy{
+		Arn:              "arn:aws:iam::123456789012:policy/S3-read-only-example-bucket",
+		Path:             "/",
+		Id:               "AGPACKCEVSQ6C2EXAMPLE",
+		Name:             "S3-read-only-example-bucket",
+		DefaultVersionId: "v1",
+		IsAttachable:     true,
+		CreateDate:       "2014-09-15T17:36:14.673Z",
+		UpdateDate:       "2014-09-15T17:36:14.673Z",
+	})
+}
+
+func (s *S) TestCreatePolicyVersion(c *check.C) {
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+	testServer.Response(200, nil, CreatePolicyVersionExample)
+	resp, err := s.iam.CreatePolicyVersion("arn:aws:iam::123456789012:policy/S3-read-only-example-bucket", document, true)
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.FormValue("Action"), check.Equals, "CreatePolicyVersion")
+	c.Assert(req.FormValue("PolicyArn"), check.Equals, "arn:aws:iam::123456789012:policy/S3-read-only-example-bucket")
+	c.Assert(req.FormValue("PolicyDocument"), check.Equals, document)
+	c.Assert(req.FormValue("SetAsDefault"), check.Equals, "true")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.PolicyVersion, check.DeepEquals, iam.PolicyVersion{
+		VersionId:        "v2",
+		IsDefaultVersion: true,
+		CreateDate:       "2014-09-15T19:58:59.430Z",
+	})
+}
+
+func (s *S) TestListPolicyVersions(c *check.C) {
+	testServer.Response(200, nil, ListPolicyVersionsExample)
+	resp, err := s.iam.ListPolicyVersions("arn:aws:iam::123456789012:policy/S3-read-only-example-bucket")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ListPolicyVersions")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Versions, check.HasLen, 2)
+	c.Assert(resp.Versions[0].VersionId, check.Equals, "v1")
+	c.Assert(resp.Versions[0].IsDefaultVersion, check.Equals, false)
+	c.Assert(resp.Versions[1].IsDefaultVersion, check.Equals, true)
+}
+
+func (s *S) TestSetDefaultPolicyVersion(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.SetDefaultPolicyVersion("arn:aws:iam::123456789012:policy/S3-read-only-example-bucket", "v1")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "SetDefaultPolicyVersion")
+	c.Assert(values.Get("VersionId"), check.Equals, "v1")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestCreateInstanceProfile(c *check.C) {
+	testServer.Response(200, nil, strings.Replace(GetInstanceProfileExample, "GetInstanceProfile", "CreateInstanceProfile", -1))
+	resp, err := s.iam.CreateInstanceProfile("Webserver", "/application_abc/component_xyz/")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "CreateInstanceProfile")
+	c.Assert(values.Get("InstanceProfileName"), check.Equals, "Webserver")
+	c.Assert(values.Get("Path"), check.Equals, "/application_abc/component_xyz/")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.InstanceProfile.Id, check.Equals, "AIPAD5ARO2C5EXAMPLE3G")
+}
+
+func (s *S) TestAddRoleToInstanceProfile(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.AddRoleToInstanceProfile("Webserver", "S3Access")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "AddRoleToInstanceProfile")
+	c.Assert(values.Get("InstanceProfileName"), check.Equals, "Webserver")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestGetInstanceProfile(c *check.C) {
+	testServer.Response(200, nil, GetInstanceProfileExample)
+	resp, err := s.iam.GetInstanceProfile("Webserver")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "GetInstanceProfile")
+	c.Assert(values.Get("InstanceProfileName"), check.Equals, "Webserver")
+	c.Assert(err, check.IsNil)
+	profile := resp.InstanceProfile
+	c.Assert(profile.Name, check.Equals, "Webserver")
+	c.Assert(profile.Arn, check.Equals, "arn:aws:iam::123456789012:instance-profile/application_abc/component_xyz/Webserver")
+	c.Assert(profile.Roles, check.HasLen, 1)
+	c.Assert(profile.Roles[0].Name, check.Equals, "S3Access")
+}
+
+func (s *S) TestListInstanceProfilesForRole(c *check.C) {
+	testServer.Response(200, nil, ListInstanceProfilesForRoleExample)
+	resp, err := s.iam.ListInstanceProfilesForRole("S3Access", "")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ListInstanceProfilesForRole")
+	c.Assert(values.Get("RoleName"), check.Equals, "S3Access")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.InstanceProfiles, check.HasLen, 1)
+	c.Assert(resp.InstanceProfiles[0].Name, check.Equals, "Webserver")
+	c.Assert(resp.InstanceProfiles[0].Roles, check.HasLen, 0)
+}
+
+func (s *S) TestUploadSigningCertificate(c *check.C) {
+	body := "-----BEGIN CERTIFICATE-----\nMIICdzCCAeCgAwIBAgIGANc+Ha2wMA0GCSqGSIb3DQEBBQUAMFMxCzAJBgNVBAYT\n-----END CERTIFICATE-----"
+	testServer.Response(200, nil, UploadSigningCertificateExample)
+	resp, err := s.iam.UploadSigningCertificate("Bob", body)
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.FormValue("Action"), check.Equals, "UploadSigningCertificate")
+	c.Assert(req.FormValue("UserName"), check.Equals, "Bob")
+	c.Assert(req.FormValue("CertificateBody"), check.Equals, body)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Certificate, check.DeepEquals, iam.SigningCertificate{
+		UserName:   "Bob",
+		Id:         "TA7SMP42TDN5Z26OBPJE7EXAMPLE",
+		Body:       body,
+		Status:     "Active",
+		UploadDate: "2014-07-12T18:49:12Z",
+	})
+}
+
+func (s *S) TestListSigningCertificates(c *check.C) {
+	testServer.Response(200, nil, ListSigningCertificatesExample)
+	resp, err := s.iam.ListSigningCertificates("Bob", "")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ListSigningCertificates")
+	c.Assert(values.Get("UserName"), check.Equals, "Bob")
+	_, ok := values["Marker"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.IsTruncated, check.Equals, false)
+	c.Assert(resp.Certificates, check.HasLen, 2)
+	c.Assert(resp.Certificates[0].Id, check.Equals, "TA7SMP42TDN5Z26OBPJE7EXAMPLE")
+	c.Assert(resp.Certificates[0].Status, check.Equals, "Inactive")
+	c.Assert(resp.Certificates[1].Id, check.Equals, "AZ8MN8FSJ3NX6ZFH6DJ2EXAMPLE")
+	c.Assert(resp.Certificates[1].Status, check.Equals, "Active")
+}
+
+func (s *S) TestUpdateSigningCertificate(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.UpdateSigningCertificate("TA7SMP42TDN5Z26OBPJE7EXAMPLE", "", "Inactive")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "UpdateSigningCertificate")
+	c.Assert(values.Get("CertificateId"), check.Equals, "TA7SMP42TDN5Z26OBPJE7EXAMPLE")
+	c.Assert(values.Get("Status"), check.Equals, "Inactive")
+	_, ok := values["UserName"]
+	c.Assert(ok, check.Equals, false)
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestDeleteSigningCertificate(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.DeleteSigningCertificate("TA7SMP42TDN5Z26OBPJE7EXAMPLE", "Bob")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DeleteSigningCertificate")
+	c.Assert(values.Get("CertificateId"), check.Equals, "TA7SMP42TDN5Z26OBPJE7EXAMPLE")
+	c.Assert(values.Get("UserName"), check.Equals, "Bob")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestCreateAccountAlias(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.CreateAccountAlias("example-corporation")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "CreateAccountAlias")
+	c.Assert(values.Get("AccountAlias"), check.Equals, "example-corporation")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestDeleteAccountAlias(c *check.C) {
+	testServer.Response(200, nil, RequestIdExample)
+	_, err := s.iam.DeleteAccountAlias("example-corporation")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "DeleteAccountAlias")
+	c.Assert(values.Get("AccountAlias"), check.Equals, "example-corporation")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestListAccountAliases(c *check.C) {
+	testServer.Response(200, nil, ListAccountAliasesExample)
+	resp, err := s.iam.ListAccountAliases("")
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "ListAccountAliases")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.AccountAliases, check.DeepEquals, []string{"example-corporation"})
+	c.Assert(resp.IsTruncated, check.Equals, false)
+}
+
+func (s *S) TestGetAccountSummary(c *check.C) {
+	testServer.Response(200, nil, GetAccountSummaryExample)
+	resp, err := s.iam.GetAccountSummary()
+	values := testServer.WaitRequest().URL.Query()
+	c.Assert(values.Get("Action"), check.Equals, "GetAccountSummary")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "85cb9b90-ac28-11e4-a88d-97964EXAMPLE")
+	c.Assert(resp.SummaryMap, check.DeepEquals, iam.AccountSummary{
+		"Groups":            31,
+		"GroupsQuota":       50,
+		"Users":             35,
+		"UsersQuota":        150,
+		"AccountMFAEnabled": 0,
+	})
+}
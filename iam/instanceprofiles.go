@@ -0,0 +1,178 @@
+package iam
+
+// InstanceProfile encapsulates an instance profile, which passes a role to
+// the EC2 instances it is launched with.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_InstanceProfile.html for more details.
+type InstanceProfile struct {
+	Arn        string
+	Path       string
+	Id         string `xml:"InstanceProfileId"`
+	Name       string `xml:"InstanceProfileName"`
+	CreateDate string
+	Roles      []Role `xml:"Roles>member"`
+}
+
+// Response to a CreateInstanceProfile request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateInstanceProfile.html for more details.
+type CreateInstanceProfileResp struct {
+	InstanceProfile InstanceProfile `xml:"CreateInstanceProfileResult>InstanceProfile"`
+	RequestId       string          `xml:"ResponseMetadata>RequestId"`
+}
+
+// CreateInstanceProfile creates a new instance profile in IAM. Use
+// AddRoleToInstanceProfile to give it a role.
+//
+// The path parameter is optional.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateInstanceProfile.html for more details.
+func (iam *IAM) CreateInstanceProfile(name, path string) (*CreateInstanceProfileResp, error) {
+	params := map[string]string{
+		"Action":              "CreateInstanceProfile",
+		"InstanceProfileName": name,
+	}
+	if path != "" {
+		params["Path"] = path
+	}
+	resp := new(CreateInstanceProfileResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a GetInstanceProfile request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetInstanceProfile.html for more details.
+type GetInstanceProfileResp struct {
+	InstanceProfile InstanceProfile `xml:"GetInstanceProfileResult>InstanceProfile"`
+	RequestId       string          `xml:"ResponseMetadata>RequestId"`
+}
+
+// GetInstanceProfile gets an instance profile in IAM, including its role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetInstanceProfile.html for more details.
+func (iam *IAM) GetInstanceProfile(name string) (*GetInstanceProfileResp, error) {
+	params := map[string]string{
+		"Action":              "GetInstanceProfile",
+		"InstanceProfileName": name,
+	}
+	resp := new(GetInstanceProfileResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteInstanceProfile deletes an instance profile from IAM. Its role
+// must be removed first with RemoveRoleFromInstanceProfile.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteInstanceProfile.html for more details.
+func (iam *IAM) DeleteInstanceProfile(name string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":              "DeleteInstanceProfile",
+		"InstanceProfileName": name,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AddRoleToInstanceProfile adds a role to an instance profile. An instance
+// profile can hold a single role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_AddRoleToInstanceProfile.html for more details.
+func (iam *IAM) AddRoleToInstanceProfile(profileName, roleName string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":              "AddRoleToInstanceProfile",
+		"InstanceProfileName": profileName,
+		"RoleName":            roleName,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RemoveRoleFromInstanceProfile removes a role from an instance profile.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_RemoveRoleFromInstanceProfile.html for more details.
+func (iam *IAM) RemoveRoleFromInstanceProfile(profileName, roleName string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":              "RemoveRoleFromInstanceProfile",
+		"InstanceProfileName": profileName,
+		"RoleName":            roleName,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListInstanceProfiles request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListInstanceProfiles.html for more details.
+type ListInstanceProfilesResp struct {
+	InstanceProfiles []InstanceProfile `xml:"ListInstanceProfilesResult>InstanceProfiles>member"`
+	IsTruncated      bool              `xml:"ListInstanceProfilesResult>IsTruncated"`
+	Marker           string            `xml:"ListInstanceProfilesResult>Marker"`
+	RequestId        string            `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListInstanceProfiles lists the instance profiles that have the specified
+// path prefix.
+//
+// Both parameters are optional. If the response is truncated, pass its
+// Marker to get the next page.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListInstanceProfiles.html for more details.
+func (iam *IAM) ListInstanceProfiles(pathPrefix, marker string) (*ListInstanceProfilesResp, error) {
+	params := map[string]string{
+		"Action": "ListInstanceProfiles",
+	}
+	if pathPrefix != "" {
+		params["PathPrefix"] = pathPrefix
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListInstanceProfilesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListInstanceProfilesForRole request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListInstanceProfilesForRole.html for more details.
+type ListInstanceProfilesForRoleResp struct {
+	InstanceProfiles []InstanceProfile `xml:"ListInstanceProfilesForRoleResult>InstanceProfiles>member"`
+	IsTruncated      bool              `xml:"ListInstanceProfilesForRoleResult>IsTruncated"`
+	Marker           string            `xml:"ListInstanceProfilesForRoleResult>Marker"`
+	RequestId        string            `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListInstanceProfilesForRole lists the instance profiles that hold a role.
+// The marker parameter is optional, as in ListInstanceProfiles.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListInstanceProfilesForRole.html for more details.
+func (iam *IAM) ListInstanceProfilesForRole(roleName, marker string) (*ListInstanceProfilesForRoleResp, error) {
+	params := map[string]string{
+		"Action":   "ListInstanceProfilesForRole",
+		"RoleName": roleName,
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListInstanceProfilesForRoleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
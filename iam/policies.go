@@ -0,0 +1,257 @@
+package iam
+
+import (
+	"strconv"
+)
+
+// Policy encapsulates a managed policy.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_Policy.html for more details.
+type Policy struct {
+	Arn              string
+	Path             string
+	Id               string `xml:"PolicyId"`
+	Name             string `xml:"PolicyName"`
+	Description      string
+	DefaultVersionId string
+	AttachmentCount  int
+	IsAttachable     bool
+	CreateDate       string
+	UpdateDate       string
+}
+
+// PolicyVersion is a version of a managed policy. Document is URL-encoded,
+// as returned by IAM, and is only set by GetPolicyVersion.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_PolicyVersion.html for more details.
+type PolicyVersion struct {
+	VersionId        string
+	IsDefaultVersion bool
+	Document         string
+	CreateDate       string
+}
+
+// Response to a CreatePolicy request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicy.html for more details.
+type CreatePolicyResp struct {
+	Policy    Policy `xml:"CreatePolicyResult>Policy"`
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+}
+
+// CreatePolicy creates a managed policy in IAM, whose first version holds
+// policyDocument.
+//
+// The path and description parameters are optional.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicy.html for more details.
+func (iam *IAM) CreatePolicy(name, path, description, policyDocument string) (*CreatePolicyResp, error) {
+	params := map[string]string{
+		"Action":         "CreatePolicy",
+		"PolicyName":     name,
+		"PolicyDocument": policyDocument,
+	}
+	if path != "" {
+		params["Path"] = path
+	}
+	if description != "" {
+		params["Description"] = description
+	}
+	resp := new(CreatePolicyResp)
+	if err := iam.postQuery(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a GetPolicy request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetPolicy.html for more details.
+type GetPolicyResp struct {
+	Policy    Policy `xml:"GetPolicyResult>Policy"`
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+}
+
+// GetPolicy gets a managed policy in IAM.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetPolicy.html for more details.
+func (iam *IAM) GetPolicy(policyArn string) (*GetPolicyResp, error) {
+	params := map[string]string{
+		"Action":    "GetPolicy",
+		"PolicyArn": policyArn,
+	}
+	resp := new(GetPolicyResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeletePolicy deletes a managed policy from IAM. The policy must not be
+// attached to anything and must only have its default version left.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeletePolicy.html for more details.
+func (iam *IAM) DeletePolicy(policyArn string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":    "DeletePolicy",
+		"PolicyArn": policyArn,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListPolicies request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListPolicies.html for more details.
+type ListPoliciesResp struct {
+	Policies    []Policy `xml:"ListPoliciesResult>Policies>member"`
+	IsTruncated bool     `xml:"ListPoliciesResult>IsTruncated"`
+	Marker      string   `xml:"ListPoliciesResult>Marker"`
+	RequestId   string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListPolicies lists the managed policies that have the specified path
+// prefix. The scope is one of "All", "AWS" or "Local", and onlyAttached
+// restricts the list to policies that are attached to something.
+//
+// The pathPrefix, scope and marker parameters are optional. If the
+// response is truncated, pass its Marker to get the next page.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListPolicies.html for more details.
+func (iam *IAM) ListPolicies(pathPrefix, scope string, onlyAttached bool, marker string) (*ListPoliciesResp, error) {
+	params := map[string]string{
+		"Action": "ListPolicies",
+	}
+	if pathPrefix != "" {
+		params["PathPrefix"] = pathPrefix
+	}
+	if scope != "" {
+		params["Scope"] = scope
+	}
+	if onlyAttached {
+		params["OnlyAttached"] = "true"
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListPoliciesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a CreatePolicyVersion request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicyVersion.html for more details.
+type CreatePolicyVersionResp struct {
+	PolicyVersion PolicyVersion `xml:"CreatePolicyVersionResult>PolicyVersion"`
+	RequestId     string        `xml:"ResponseMetadata>RequestId"`
+}
+
+// CreatePolicyVersion adds a version holding policyDocument to a managed
+// policy, making it the default version if setAsDefault is true. A policy
+// can have at most five versions, so older ones may need to be deleted
+// with DeletePolicyVersion first.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicyVersion.html for more details.
+func (iam *IAM) CreatePolicyVersion(policyArn, policyDocument string, setAsDefault bool) (*CreatePolicyVersionResp, error) {
+	params := map[string]string{
+		"Action":         "CreatePolicyVersion",
+		"PolicyArn":      policyArn,
+		"PolicyDocument": policyDocument,
+		"SetAsDefault":   strconv.FormatBool(setAsDefault),
+	}
+	resp := new(CreatePolicyVersionResp)
+	if err := iam.postQuery(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a GetPolicyVersion request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetPolicyVersion.html for more details.
+type GetPolicyVersionResp struct {
+	PolicyVersion PolicyVersion `xml:"GetPolicyVersionResult>PolicyVersion"`
+	RequestId     string        `xml:"ResponseMetadata>RequestId"`
+}
+
+// GetPolicyVersion gets a version of a managed policy, including its
+// document.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetPolicyVersion.html for more details.
+func (iam *IAM) GetPolicyVersion(policyArn, versionId string) (*GetPolicyVersionResp, error) {
+	params := map[string]string{
+		"Action":    "GetPolicyVersion",
+		"PolicyArn": policyArn,
+		"VersionId": versionId,
+	}
+	resp := new(GetPolicyVersionResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListPolicyVersions request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListPolicyVersions.html for more details.
+type ListPolicyVersionsResp struct {
+	Versions    []PolicyVersion `xml:"ListPolicyVersionsResult>Versions>member"`
+	IsTruncated bool            `xml:"ListPolicyVersionsResult>IsTruncated"`
+	Marker      string          `xml:"ListPolicyVersionsResult>Marker"`
+	RequestId   string          `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListPolicyVersions lists the versions of a managed policy.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListPolicyVersions.html for more details.
+func (iam *IAM) ListPolicyVersions(policyArn string) (*ListPolicyVersionsResp, error) {
+	params := map[string]string{
+		"Action":    "ListPolicyVersions",
+		"PolicyArn": policyArn,
+	}
+	resp := new(ListPolicyVersionsResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// SetDefaultPolicyVersion makes versionId the version of a managed policy
+// that is in effect.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_SetDefaultPolicyVersion.html for more details.
+func (iam *IAM) SetDefaultPolicyVersion(policyArn, versionId string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":    "SetDefaultPolicyVersion",
+		"PolicyArn": policyArn,
+		"VersionId": versionId,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeletePolicyVersion deletes a version of a managed policy, which must not
+// be its default version.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeletePolicyVersion.html for more details.
+func (iam *IAM) DeletePolicyVersion(policyArn, versionId string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":    "DeletePolicyVersion",
+		"PolicyArn": policyArn,
+		"VersionId": versionId,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
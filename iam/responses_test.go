@@ -153,3 +153,323 @@ var GetUserPolicyExample = `
    </ResponseMetadata>
 </GetUserPolicyResponse>
 `
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateUser.html
+var CreateUserWithPermissionsBoundaryExample = `
+<CreateUserResponse>
+   <CreateUserResult>
+      <User>
+         <Path>/</Path>
+         <UserName>Bob</UserName>
+         <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+         <Arn>arn:aws:iam::123456789012:user/Bob</Arn>
+         <PermissionsBoundary>
+            <PermissionsBoundaryType>Policy</PermissionsBoundaryType>
+            <PermissionsBoundaryArn>arn:aws:iam::123456789012:policy/DelegatedAdminBoundary</PermissionsBoundaryArn>
+         </PermissionsBoundary>
+      </User>
+   </CreateUserResult>
+   <ResponseMetadata>
+      <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
+   </ResponseMetadata>
+</CreateUserResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreateRole.html
+var CreateRoleExample = `
+<CreateRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <CreateRoleResult>
+    <Role>
+      <Path>/application_abc/component_xyz/</Path>
+      <Arn>arn:aws:iam::123456789012:role/application_abc/component_xyz/S3Access</Arn>
+      <RoleName>S3Access</RoleName>
+      <AssumeRolePolicyDocument>{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":["ec2.amazonaws.com"]},"Action":["sts:AssumeRole"]}]}</AssumeRolePolicyDocument>
+      <CreateDate>2012-05-08T23:34:01.495Z</CreateDate>
+      <RoleId>AROADBQP57FF2AEXAMPLE</RoleId>
+      <PermissionsBoundary>
+        <PermissionsBoundaryType>Policy</PermissionsBoundaryType>
+        <PermissionsBoundaryArn>arn:aws:iam::123456789012:policy/DelegatedAdminBoundary</PermissionsBoundaryArn>
+      </PermissionsBoundary>
+    </Role>
+  </CreateRoleResult>
+  <ResponseMetadata>
+    <RequestId>4a93ceee-9966-11e1-b624-b1aEXAMPLE7c</RequestId>
+  </ResponseMetadata>
+</CreateRoleResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListRoles.html
+var ListRolesExample = `
+<ListRolesResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListRolesResult>
+    <IsTruncated>true</IsTruncated>
+    <Marker>AAF0aW5nIG1hcmtlcg==</Marker>
+    <Roles>
+      <member>
+        <Path>/application_abc/component_xyz/</Path>
+        <Arn>arn:aws:iam::123456789012:role/application_abc/component_xyz/S3Access</Arn>
+        <RoleName>S3Access</RoleName>
+        <AssumeRolePolicyDocument>%7B%22Version%22%3A%222012-10-17%22%7D</AssumeRolePolicyDocument>
+        <CreateDate>2012-05-09T15:45:35Z</CreateDate>
+        <RoleId>AROACVSVTSZYEXAMPLEYK</RoleId>
+      </member>
+      <member>
+        <Path>/application_abc/component_xyz/</Path>
+        <Arn>arn:aws:iam::123456789012:role/application_abc/component_xyz/SDBAccess</Arn>
+        <RoleName>SDBAccess</RoleName>
+        <AssumeRolePolicyDocument>%7B%22Version%22%3A%222012-10-17%22%7D</AssumeRolePolicyDocument>
+        <CreateDate>2012-05-09T15:45:45Z</CreateDate>
+        <RoleId>AROAC2ICXG32EXAMPLEWK</RoleId>
+      </member>
+    </Roles>
+  </ListRolesResult>
+  <ResponseMetadata>
+    <RequestId>20f7279f-99ee-11e1-a4c3-27EXAMPLE804</RequestId>
+  </ResponseMetadata>
+</ListRolesResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListAttachedRolePolicies.html
+var ListAttachedRolePoliciesExample = `
+<ListAttachedRolePoliciesResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListAttachedRolePoliciesResult>
+    <AttachedPolicies>
+      <member>
+        <PolicyName>ReadOnlyAccess</PolicyName>
+        <PolicyArn>arn:aws:iam::aws:policy/ReadOnlyAccess</PolicyArn>
+      </member>
+    </AttachedPolicies>
+    <IsTruncated>false</IsTruncated>
+  </ListAttachedRolePoliciesResult>
+  <ResponseMetadata>
+    <RequestId>9a3b490d-f4b9-11e3-9f16-EXAMPLE</RequestId>
+  </ResponseMetadata>
+</ListAttachedRolePoliciesResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetRolePolicy.html
+var GetRolePolicyExample = `
+<GetRolePolicyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetRolePolicyResult>
+    <PolicyName>S3AccessPolicy</PolicyName>
+    <RoleName>S3Access</RoleName>
+    <PolicyDocument>%7B%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Action%22%3A%22s3%3A*%22%2C%22Resource%22%3A%22*%22%7D%5D%7D</PolicyDocument>
+  </GetRolePolicyResult>
+  <ResponseMetadata>
+    <RequestId>7e7cd8bc-99ef-11e1-a4c3-27EXAMPLE804</RequestId>
+  </ResponseMetadata>
+</GetRolePolicyResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicy.html
+var CreatePolicyExample = `
+<CreatePolicyResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <CreatePolicyResult>
+    <Policy>
+      <PolicyName>S3-read-only-example-bucket</PolicyName>
+      <DefaultVersionId>v1</DefaultVersionId>
+      <PolicyId>AGPACKCEVSQ6C2EXAMPLE</PolicyId>
+      <Path>/</Path>
+      <Arn>arn:aws:iam::123456789012:policy/S3-read-only-example-bucket</Arn>
+      <AttachmentCount>0</AttachmentCount>
+      <IsAttachable>true</IsAttachable>
+      <CreateDate>2014-09-15T17:36:14.673Z</CreateDate>
+      <UpdateDate>2014-09-15T17:36:14.673Z</UpdateDate>
+    </Policy>
+  </CreatePolicyResult>
+  <ResponseMetadata>
+    <RequestId>ca64c9e1-3cfe-11e4-bfad-8d1c6EXAMPLE</RequestId>
+  </ResponseMetadata>
+</CreatePolicyResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_CreatePolicyVersion.html
+var CreatePolicyVersionExample = `
+<CreatePolicyVersionResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <CreatePolicyVersionResult>
+    <PolicyVersion>
+      <IsDefaultVersion>true</IsDefaultVersion>
+      <VersionId>v2</VersionId>
+      <CreateDate>2014-09-15T19:58:59.430Z</CreateDate>
+    </PolicyVersion>
+  </CreatePolicyVersionResult>
+  <ResponseMetadata>
+    <RequestId>bb551b92-3d12-11e4-bfad-8d1c6EXAMPLE</RequestId>
+  </ResponseMetadata>
+</CreatePolicyVersionResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListPolicyVersions.html
+var ListPolicyVersionsExample = `
+<ListPolicyVersionsResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListPolicyVersionsResult>
+    <Versions>
+      <member>
+        <IsDefaultVersion>false</IsDefaultVersion>
+        <VersionId>v1</VersionId>
+        <CreateDate>2014-09-15T17:36:14Z</CreateDate>
+      </member>
+      <member>
+        <IsDefaultVersion>true</IsDefaultVersion>
+        <VersionId>v2</VersionId>
+        <CreateDate>2014-09-15T19:58:59Z</CreateDate>
+      </member>
+    </Versions>
+    <IsTruncated>false</IsTruncated>
+  </ListPolicyVersionsResult>
+  <ResponseMetadata>
+    <RequestId>a31d1a86-3eba-11e4-9d0d-6f969EXAMPLE</RequestId>
+  </ResponseMetadata>
+</ListPolicyVersionsResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetInstanceProfile.html
+var GetInstanceProfileExample = `
+<GetInstanceProfileResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetInstanceProfileResult>
+    <InstanceProfile>
+      <InstanceProfileId>AIPAD5ARO2C5EXAMPLE3G</InstanceProfileId>
+      <Roles>
+        <member>
+          <Path>/application_abc/component_xyz/</Path>
+          <Arn>arn:aws:iam::123456789012:role/application_abc/component_xyz/S3Access</Arn>
+          <RoleName>S3Access</RoleName>
+          <AssumeRolePolicyDocument>%7B%22Version%22%3A%222012-10-17%22%7D</AssumeRolePolicyDocument>
+          <CreateDate>2012-05-09T15:45:35Z</CreateDate>
+          <RoleId>AROACVYKSVTSZFEXAMPLE</RoleId>
+        </member>
+      </Roles>
+      <InstanceProfileName>Webserver</InstanceProfileName>
+      <Path>/application_abc/component_xyz/</Path>
+      <Arn>arn:aws:iam::123456789012:instance-profile/application_abc/component_xyz/Webserver</Arn>
+      <CreateDate>2012-05-09T16:11:10Z</CreateDate>
+    </InstanceProfile>
+  </GetInstanceProfileResult>
+  <ResponseMetadata>
+    <RequestId>37289fda-99f2-11e1-a4c3-27EXAMPLE804</RequestId>
+  </ResponseMetadata>
+</GetInstanceProfileResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListInstanceProfilesForRole.html
+var ListInstanceProfilesForRoleExample = `
+<ListInstanceProfilesForRoleResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListInstanceProfilesForRoleResult>
+    <IsTruncated>false</IsTruncated>
+    <InstanceProfiles>
+      <member>
+        <InstanceProfileId>AIPACZLS2EYYXMEXAMPLE</InstanceProfileId>
+        <Roles/>
+        <InstanceProfileName>Webserver</InstanceProfileName>
+        <Path>/application_abc/component_xyz/</Path>
+        <Arn>arn:aws:iam::123456789012:instance-profile/application_abc/component_xyz/Webserver</Arn>
+        <CreateDate>2012-05-09T16:27:11Z</CreateDate>
+      </member>
+    </InstanceProfiles>
+  </ListInstanceProfilesForRoleResult>
+  <ResponseMetadata>
+    <RequestId>6a8c3992-99f4-11e1-a4c3-27EXAMPLE804</RequestId>
+  </ResponseMetadata>
+</ListInstanceProfilesForRoleResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_UploadSigningCertificate.html
+var UploadSigningCertificateExample = `
+<UploadSigningCertificateResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <UploadSigningCertificateResult>
+    <Certificate>
+      <UserName>Bob</UserName>
+      <CertificateId>TA7SMP42TDN5Z26OBPJE7EXAMPLE</CertificateId>
+      <CertificateBody>-----BEGIN CERTIFICATE-----
+MIICdzCCAeCgAwIBAgIGANc+Ha2wMA0GCSqGSIb3DQEBBQUAMFMxCzAJBgNVBAYT
+-----END CERTIFICATE-----</CertificateBody>
+      <Status>Active</Status>
+      <UploadDate>2014-07-12T18:49:12Z</UploadDate>
+    </Certificate>
+  </UploadSigningCertificateResult>
+  <ResponseMetadata>
+    <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
+  </ResponseMetadata>
+</UploadSigningCertificateResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListSigningCertificates.html
+var ListSigningCertificatesExample = `
+<ListSigningCertificatesResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListSigningCertificatesResult>
+    <UserName>Bob</UserName>
+    <Certificates>
+      <member>
+        <UserName>Bob</UserName>
+        <CertificateId>TA7SMP42TDN5Z26OBPJE7EXAMPLE</CertificateId>
+        <CertificateBody>-----BEGIN CERTIFICATE-----
+MIICdzCCAeCgAwIBAgIGANc+Ha2wMA0GCSqGSIb3DQEBBQUAMFMxCzAJBgNVBAYT
+-----END CERTIFICATE-----</CertificateBody>
+        <Status>Inactive</Status>
+        <UploadDate>2013-06-06T21:40:08Z</UploadDate>
+      </member>
+      <member>
+        <UserName>Bob</UserName>
+        <CertificateId>AZ8MN8FSJ3NX6ZFH6DJ2EXAMPLE</CertificateId>
+        <CertificateBody>-----BEGIN CERTIFICATE-----
+MIICdzCCAeCgAwIBAgIGANc+Ha2wMA0GCSqGSIb3DQEBBQUAMFMxCzAJBgNVBAYD
+-----END CERTIFICATE-----</CertificateBody>
+        <Status>Active</Status>
+        <UploadDate>2014-07-12T18:49:12Z</UploadDate>
+      </member>
+    </Certificates>
+    <IsTruncated>false</IsTruncated>
+  </ListSigningCertificatesResult>
+  <ResponseMetadata>
+    <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
+  </ResponseMetadata>
+</ListSigningCertificatesResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListAccountAliases.html
+var ListAccountAliasesExample = `
+<ListAccountAliasesResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <ListAccountAliasesResult>
+    <IsTruncated>false</IsTruncated>
+    <AccountAliases>
+      <member>example-corporation</member>
+    </AccountAliases>
+  </ListAccountAliasesResult>
+  <ResponseMetadata>
+    <RequestId>7a62c49f-347e-4fc4-9331-6e8eEXAMPLE</RequestId>
+  </ResponseMetadata>
+</ListAccountAliasesResponse>
+`
+
+// http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetAccountSummary.html
+var GetAccountSummaryExample = `
+<GetAccountSummaryResponse xmlns="https://iam.amazonaws.com/doc/2010-05-08/">
+  <GetAccountSummaryResult>
+    <SummaryMap>
+      <entry>
+        <key>Groups</key>
+        <value>31</value>
+      </entry>
+      <entry>
+        <key>GroupsQuota</key>
+        <value>50</value>
+      </entry>
+      <entry>
+        <key>Users</key>
+        <value>35</value>
+      </entry>
+      <entry>
+        <key>UsersQuota</key>
+        <value>150</value>
+      </entry>
+      <entry>
+        <key>AccountMFAEnabled</key>
+        <value>0</value>
+      </entry>
+    </SummaryMap>
+  </GetAccountSummaryResult>
+  <ResponseMetadata>
+    <RequestId>85cb9b90-ac28-11e4-a88d-97964EXAMPLE</RequestId>
+  </ResponseMetadata>
+</GetAccountSummaryResponse>
+`
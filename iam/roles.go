@@ -0,0 +1,238 @@
+package iam
+
+// Response to a GetRole request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetRole.html for more details.
+type GetRoleResp struct {
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+	Role      Role   `xml:"GetRoleResult>Role"`
+}
+
+// GetRole gets a role in IAM.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetRole.html for more details.
+func (iam *IAM) GetRole(name string) (*GetRoleResp, error) {
+	params := map[string]string{
+		"Action":   "GetRole",
+		"RoleName": name,
+	}
+	resp := new(GetRoleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteRole deletes a role from IAM. The role must not have any policies
+// attached and must not be in any instance profile.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteRole.html for more details.
+func (iam *IAM) DeleteRole(name string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":   "DeleteRole",
+		"RoleName": name,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListRoles request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListRoles.html for more details.
+type ListRolesResp struct {
+	Roles       []Role `xml:"ListRolesResult>Roles>member"`
+	IsTruncated bool   `xml:"ListRolesResult>IsTruncated"`
+	Marker      string `xml:"ListRolesResult>Marker"`
+	RequestId   string `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListRoles lists the roles that have the specified path prefix.
+//
+// Both parameters are optional. If the response is truncated, pass its
+// Marker to get the next page.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListRoles.html for more details.
+func (iam *IAM) ListRoles(pathPrefix, marker string) (*ListRolesResp, error) {
+	params := map[string]string{
+		"Action": "ListRoles",
+	}
+	if pathPrefix != "" {
+		params["PathPrefix"] = pathPrefix
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListRolesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AttachRolePolicy attaches the managed policy identified by policyArn to a
+// role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_AttachRolePolicy.html for more details.
+func (iam *IAM) AttachRolePolicy(roleName, policyArn string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":    "AttachRolePolicy",
+		"RoleName":  roleName,
+		"PolicyArn": policyArn,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DetachRolePolicy detaches the managed policy identified by policyArn from
+// a role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DetachRolePolicy.html for more details.
+func (iam *IAM) DetachRolePolicy(roleName, policyArn string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":    "DetachRolePolicy",
+		"RoleName":  roleName,
+		"PolicyArn": policyArn,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AttachedPolicy is a managed policy attached to a role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_AttachedPolicy.html for more details.
+type AttachedPolicy struct {
+	Name string `xml:"PolicyName"`
+	Arn  string `xml:"PolicyArn"`
+}
+
+// Response to a ListAttachedRolePolicies request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListAttachedRolePolicies.html for more details.
+type ListAttachedRolePoliciesResp struct {
+	Policies    []AttachedPolicy `xml:"ListAttachedRolePoliciesResult>AttachedPolicies>member"`
+	IsTruncated bool             `xml:"ListAttachedRolePoliciesResult>IsTruncated"`
+	Marker      string           `xml:"ListAttachedRolePoliciesResult>Marker"`
+	RequestId   string           `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListAttachedRolePolicies lists the managed policies attached to a role.
+// The marker parameter is optional, as in ListRoles.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListAttachedRolePolicies.html for more details.
+func (iam *IAM) ListAttachedRolePolicies(roleName, marker string) (*ListAttachedRolePoliciesResp, error) {
+	params := map[string]string{
+		"Action":   "ListAttachedRolePolicies",
+		"RoleName": roleName,
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListAttachedRolePoliciesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PutRolePolicy creates or replaces an inline policy of a role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_PutRolePolicy.html for more details.
+func (iam *IAM) PutRolePolicy(roleName, policyName, policyDocument string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":         "PutRolePolicy",
+		"RoleName":       roleName,
+		"PolicyName":     policyName,
+		"PolicyDocument": policyDocument,
+	}
+	resp := new(SimpleResp)
+	if err := iam.postQuery(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a GetRolePolicy request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetRolePolicy.html for more details.
+type GetRolePolicyResp struct {
+	Policy    RolePolicy `xml:"GetRolePolicyResult"`
+	RequestId string     `xml:"ResponseMetadata>RequestId"`
+}
+
+// RolePolicy encapsulates an inline policy of a role. Document is
+// URL-encoded, as returned by IAM.
+type RolePolicy struct {
+	Name     string `xml:"PolicyName"`
+	RoleName string `xml:"RoleName"`
+	Document string `xml:"PolicyDocument"`
+}
+
+// GetRolePolicy gets an inline policy of a role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_GetRolePolicy.html for more details.
+func (iam *IAM) GetRolePolicy(roleName, policyName string) (*GetRolePolicyResp, error) {
+	params := map[string]string{
+		"Action":     "GetRolePolicy",
+		"RoleName":   roleName,
+		"PolicyName": policyName,
+	}
+	resp := new(GetRolePolicyResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListRolePolicies request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListRolePolicies.html for more details.
+type ListRolePoliciesResp struct {
+	PolicyNames []string `xml:"ListRolePoliciesResult>PolicyNames>member"`
+	IsTruncated bool     `xml:"ListRolePoliciesResult>IsTruncated"`
+	Marker      string   `xml:"ListRolePoliciesResult>Marker"`
+	RequestId   string   `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListRolePolicies lists the names of the inline policies of a role. The
+// marker parameter is optional, as in ListRoles.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListRolePolicies.html for more details.
+func (iam *IAM) ListRolePolicies(roleName, marker string) (*ListRolePoliciesResp, error) {
+	params := map[string]string{
+		"Action":   "ListRolePolicies",
+		"RoleName": roleName,
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListRolePoliciesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteRolePolicy deletes an inline policy of a role.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteRolePolicy.html for more details.
+func (iam *IAM) DeleteRolePolicy(roleName, policyName string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":     "DeleteRolePolicy",
+		"RoleName":   roleName,
+		"PolicyName": policyName,
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
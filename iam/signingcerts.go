@@ -0,0 +1,120 @@
+package iam
+
+// SigningCertificate encapsulates an X.509 signing certificate of a user.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_SigningCertificate.html for more details.
+type SigningCertificate struct {
+	UserName   string
+	Id         string `xml:"CertificateId"`
+	Body       string `xml:"CertificateBody"`
+	Status     string
+	UploadDate string
+}
+
+// Response to an UploadSigningCertificate request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_UploadSigningCertificate.html for more details.
+type UploadSigningCertificateResp struct {
+	Certificate SigningCertificate `xml:"UploadSigningCertificateResult>Certificate"`
+	RequestId   string             `xml:"ResponseMetadata>RequestId"`
+}
+
+// UploadSigningCertificate uploads the PEM-encoded X.509 certificate in
+// certificateBody and associates it with a user. New certificates are
+// Active.
+//
+// The userName parameter is optional. If set to "", the userName is determined
+// implicitly based on the AWS Access Key ID used to sign the request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_UploadSigningCertificate.html for more details.
+func (iam *IAM) UploadSigningCertificate(userName, certificateBody string) (*UploadSigningCertificateResp, error) {
+	params := map[string]string{
+		"Action":          "UploadSigningCertificate",
+		"CertificateBody": certificateBody,
+	}
+	if userName != "" {
+		params["UserName"] = userName
+	}
+	resp := new(UploadSigningCertificateResp)
+	if err := iam.postQuery(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Response to a ListSigningCertificates request.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListSigningCertificates.html for more details.
+type ListSigningCertificatesResp struct {
+	Certificates []SigningCertificate `xml:"ListSigningCertificatesResult>Certificates>member"`
+	IsTruncated  bool                 `xml:"ListSigningCertificatesResult>IsTruncated"`
+	Marker       string               `xml:"ListSigningCertificatesResult>Marker"`
+	RequestId    string               `xml:"ResponseMetadata>RequestId"`
+}
+
+// ListSigningCertificates lists the signing certificates of a user.
+//
+// Both parameters are optional. If userName is "", it is determined as in
+// UploadSigningCertificate. If the response is truncated, pass its Marker
+// to get the next page.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_ListSigningCertificates.html for more details.
+func (iam *IAM) ListSigningCertificates(userName, marker string) (*ListSigningCertificatesResp, error) {
+	params := map[string]string{
+		"Action": "ListSigningCertificates",
+	}
+	if userName != "" {
+		params["UserName"] = userName
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+	resp := new(ListSigningCertificatesResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateSigningCertificate sets the status of a signing certificate to
+// "Active" or "Inactive". Deactivating the old certificate before deleting
+// it allows rolling back a rotation.
+//
+// The userName parameter is optional, as in UploadSigningCertificate.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_UpdateSigningCertificate.html for more details.
+func (iam *IAM) UpdateSigningCertificate(id, userName, status string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":        "UpdateSigningCertificate",
+		"CertificateId": id,
+		"Status":        status,
+	}
+	if userName != "" {
+		params["UserName"] = userName
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteSigningCertificate deletes a signing certificate of a user.
+//
+// The userName parameter is optional, as in UploadSigningCertificate.
+//
+// See http://docs.aws.amazon.com/IAM/latest/APIReference/API_DeleteSigningCertificate.html for more details.
+func (iam *IAM) DeleteSigningCertificate(id, userName string) (*SimpleResp, error) {
+	params := map[string]string{
+		"Action":        "DeleteSigningCertificate",
+		"CertificateId": id,
+	}
+	if userName != "" {
+		params["UserName"] = userName
+	}
+	resp := new(SimpleResp)
+	if err := iam.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -14,7 +14,7 @@ const debug = false
 
 // New creates a new Kinesis object.
 func New(auth aws.Auth, region aws.Region) *Kinesis {
-	return &Kinesis{auth, region}
+	return &Kinesis{Auth: auth, Region: region}
 }
 
 // This operation adds a new Amazon Kinesis stream to your AWS account.
@@ -51,6 +51,33 @@ func (k *Kinesis) DescribeStream(name string) (resp *StreamDescription, err erro
 	return &dsr.StreamDescription, err
 }
 
+// This operation enables the publication of shard-level metrics to
+// CloudWatch. Enhanced monitoring is billed per shard and metric.
+func (k *Kinesis) EnableEnhancedMonitoring(streamName string, metrics ...ShardLevelMetric) (resp *EnhancedMonitoringResponse, err error) {
+	return k.enhancedMonitoring("EnableEnhancedMonitoring", streamName, metrics)
+}
+
+// This operation disables the publication of shard-level metrics to
+// CloudWatch.
+func (k *Kinesis) DisableEnhancedMonitoring(streamName string, metrics ...ShardLevelMetric) (resp *EnhancedMonitoringResponse, err error) {
+	return k.enhancedMonitoring("DisableEnhancedMonitoring", streamName, metrics)
+}
+
+func (k *Kinesis) enhancedMonitoring(action, streamName string, metrics []ShardLevelMetric) (resp *EnhancedMonitoringResponse, err error) {
+	target := target(action)
+	query := NewQueryWithStream(streamName)
+	query.AddShardLevelMetrics(metrics)
+
+	body, err := k.query(target, query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &EnhancedMonitoringResponse{}
+	err = json.Unmarshal(body, resp)
+	return resp, err
+}
+
 // This operation returns one or more data records from a shard.
 func (k *Kinesis) GetRecords(shardIterator string, limit int) (resp *GetRecordsResponse, err error) {
 	target := target("GetRecords")
@@ -109,6 +136,59 @@ func (k *Kinesis) ListStreams() (resp *ListStreamResponse, err error) {
 	return lsr, err
 }
 
+// This operation lists the shards of a stream, optionally restricted by filter.
+// Up to maxResults shards are returned (the service default if 0); when more
+// are available, NextToken is set in the response. Pass it as nextToken to
+// fetch the next page, in which case streamName and filter are ignored.
+//
+// Unlike DescribeStream, ListShards is not subject to the low per-account
+// DescribeStream rate limit, so it should be preferred for enumerating the
+// shards of large streams.
+func (k *Kinesis) ListShards(streamName string, filter *ShardFilter, maxResults int, nextToken string) (resp *ListShardsResponse, err error) {
+	target := target("ListShards")
+	query := NewEmptyQuery()
+
+	if nextToken != "" {
+		query.AddNextToken(nextToken)
+	} else {
+		query.AddStreamName(streamName)
+		if filter != nil {
+			query.AddShardFilter(filter)
+		}
+	}
+	if maxResults > 0 {
+		query.AddMaxResults(maxResults)
+	}
+
+	body, err := k.query(target, query)
+	if err != nil {
+		return nil, err
+	}
+
+	lsr := &ListShardsResponse{}
+	err = json.Unmarshal(body, lsr)
+	return lsr, err
+}
+
+// This operation returns every shard of a stream matching filter, following
+// ListShards pagination. It is the preferred way for consumers to discover
+// the shards to read from.
+func (k *Kinesis) ListAllShards(streamName string, filter *ShardFilter) ([]Shard, error) {
+	var shards []Shard
+	nextToken := ""
+	for {
+		resp, err := k.ListShards(streamName, filter, 0, nextToken)
+		if err != nil {
+			return nil, err
+		}
+		shards = append(shards, resp.Shards...)
+		if resp.NextToken == "" {
+			return shards, nil
+		}
+		nextToken = resp.NextToken
+	}
+}
+
 // This operation merges two adjacent shards in a stream and
 // combines them into a single shard to reduce the stream's capacity to ingest and transport data.
 func (k *Kinesis) MergeShards(streamName, shardToMerge, adjacentShard string) error {
@@ -148,6 +228,71 @@ func (k *Kinesis) PutRecord(streamName, partitionKey string, data []byte, hashKe
 	return prr, err
 }
 
+// The maximum number of records of a PutRecords request.
+const MaxPutRecords = 500
+
+// This operation puts up to MaxPutRecords data records into an Amazon Kinesis stream
+// in a single call. Each record succeeds or fails on its own: check FailedRecordCount
+// and the ErrorCode of each result, or use PutRecordsWithRetry.
+func (k *Kinesis) PutRecords(streamName string, records []PutRecordsRequestEntry) (resp *PutRecordsResponse, err error) {
+	target := target("PutRecords")
+	query := NewQueryWithStream(streamName)
+	query.AddRecords(records)
+
+	body, err := k.query(target, query)
+	if err != nil {
+		return nil, err
+	}
+
+	prr := &PutRecordsResponse{}
+	err = json.Unmarshal(body, prr)
+
+	return prr, err
+}
+
+// The delay before the first retry of PutRecordsWithRetry, doubled on each retry.
+var putRecordsRetryDelay = 100 * time.Millisecond
+
+// PutRecordsWithRetry is like PutRecords, but puts the records that failed again,
+// up to attempts times in total, backing off between attempts. The results of the
+// response are in the order of records; FailedRecordCount is the number of records
+// that still failed after the last attempt.
+func (k *Kinesis) PutRecordsWithRetry(streamName string, records []PutRecordsRequestEntry, attempts int) (resp *PutRecordsResponse, err error) {
+	results := make([]PutRecordsResultEntry, len(records))
+	pending := make([]int, len(records))
+	for i := range pending {
+		pending[i] = i
+	}
+
+	delay := putRecordsRetryDelay
+	for attempt := 1; len(pending) > 0; attempt++ {
+		batch := make([]PutRecordsRequestEntry, len(pending))
+		for i, j := range pending {
+			batch[i] = records[j]
+		}
+		resp, err := k.PutRecords(streamName, batch)
+		if err != nil {
+			return nil, err
+		}
+		var failed []int
+		for i, r := range resp.Records {
+			results[pending[i]] = r
+			if r.ErrorCode != "" {
+				failed = append(failed, pending[i])
+			}
+		}
+		pending = failed
+		if attempt >= attempts {
+			break
+		}
+		if len(pending) > 0 {
+			time.Sleep(delay)
+			delay *= 2
+		}
+	}
+	return &PutRecordsResponse{FailedRecordCount: len(pending), Records: results}, nil
+}
+
 // This operation splits a shard into two new shards in the stream,
 // to increase the stream's capacity to ingest and transport data.
 func (k *Kinesis) SplitShard(streamName, shard, startingHashKey string) error {
@@ -176,7 +321,11 @@ func (k *Kinesis) query(target string, query *Query) ([]byte, error) {
 	signer := aws.NewV4Signer(k.Auth, "kinesis", k.Region)
 	signer.Sign(hreq)
 
-	resp, err := http.DefaultClient.Do(hreq)
+	client := k.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(hreq)
 
 	if err != nil {
 		log.Printf("kinesis: Error calling Amazon\n: %v", err)
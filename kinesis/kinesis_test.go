@@ -10,11 +10,19 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"github.com/zackbloom/goamz/aws"
 	"github.com/zackbloom/goamz/kinesis"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // assert fails the test if the condition is false.
@@ -93,3 +101,290 @@ func TestPutRecords(t *testing.T) {
 	equals(t, "21269319989653637946712965403778482177", resp.SequenceNumber)
 	equals(t, "shardId-000000000001", resp.ShardId)
 }
+
+func TestListShardsResponse(t *testing.T) {
+	resp := &kinesis.ListShardsResponse{}
+	err := json.Unmarshal([]byte(listShards), resp)
+
+	ok(t, err)
+	equals(t, 2, len(resp.Shards))
+	equals(t, "shardId-000000000001", resp.Shards[1].ShardId)
+	equals(t, "AAAAAAAAAAGK9EEG", resp.NextToken[:16])
+}
+
+func TestListAllShards(t *testing.T) {
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		req := map[string]interface{}{"X-Amz-Target": r.Header.Get("X-Amz-Target")}
+		json.Unmarshal(body, &req)
+		requests = append(requests, req)
+		if len(requests) == 1 {
+			fmt.Fprint(w, listShards)
+		} else {
+			fmt.Fprint(w, listShardsLastPage)
+		}
+	}))
+	defer server.Close()
+
+	k := kinesis.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{KinesisEndpoint: server.URL})
+	filter := &kinesis.ShardFilter{Type: kinesis.ShardFilterAtTimestamp, Timestamp: time.Unix(1500000000, 500000000)}
+	shards, err := k.ListAllShards("exampleStreamName", filter)
+
+	ok(t, err)
+	equals(t, 3, len(shards))
+	equals(t, "shardId-000000000002", shards[2].ShardId)
+
+	equals(t, 2, len(requests))
+	equals(t, "Kinesis_20131202.ListShards", requests[0]["X-Amz-Target"])
+	equals(t, "exampleStreamName", requests[0]["StreamName"])
+	equals(t, map[string]interface{}{"Type": "AT_TIMESTAMP", "Timestamp": 1500000000.5}, requests[0]["ShardFilter"])
+
+	// Follow-up pages only carry the token.
+	var resp kinesis.ListShardsResponse
+	json.Unmarshal([]byte(listShards), &resp)
+	equals(t, resp.NextToken, requests[1]["NextToken"])
+	equals(t, nil, requests[1]["StreamName"])
+	equals(t, nil, requests[1]["ShardFilter"])
+}
+
+func TestPutRecordsWithRetry(t *testing.T) {
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		req := map[string]interface{}{"X-Amz-Target": r.Header.Get("X-Amz-Target")}
+		json.Unmarshal(body, &req)
+		requests = append(requests, req)
+		if len(requests) == 1 {
+			fmt.Fprint(w, putRecordsPartialFailure)
+		} else {
+			fmt.Fprint(w, putRecordsRetried)
+		}
+	}))
+	defer server.Close()
+
+	k := kinesis.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{KinesisEndpoint: server.URL})
+	resp, err := k.PutRecordsWithRetry("exampleStreamName", []kinesis.PutRecordsRequestEntry{
+		{Data: []byte("one"), PartitionKey: "a"},
+		{Data: []byte("two"), PartitionKey: "b", ExplicitHashKey: "1"},
+	}, 3)
+
+	ok(t, err)
+	equals(t, 0, resp.FailedRecordCount)
+	equals(t, 2, len(resp.Records))
+	equals(t, "shardId-000000000000", resp.Records[0].ShardId)
+	equals(t, "shardId-000000000001", resp.Records[1].ShardId)
+	equals(t, "", resp.Records[1].ErrorCode)
+
+	equals(t, 2, len(requests))
+	equals(t, "Kinesis_20131202.PutRecords", requests[0]["X-Amz-Target"])
+	equals(t, "exampleStreamName", requests[0]["StreamName"])
+	equals(t, []interface{}{
+		map[string]interface{}{"Data": "b25l", "PartitionKey": "a"},
+		map[string]interface{}{"Data": "dHdv", "PartitionKey": "b", "ExplicitHashKey": "1"},
+	}, requests[0]["Records"])
+	// Only the failed record is put again.
+	equals(t, []interface{}{
+		map[string]interface{}{"Data": "dHdv", "PartitionKey": "b", "ExplicitHashKey": "1"},
+	}, requests[1]["Records"])
+}
+
+func TestPutRecordsWithRetryGivesUp(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, putRecordsPartialFailure)
+	}))
+	defer server.Close()
+
+	k := kinesis.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{KinesisEndpoint: server.URL})
+	resp, err := k.PutRecordsWithRetry("exampleStreamName", []kinesis.PutRecordsRequestEntry{
+		{Data: []byte("one"), PartitionKey: "a"},
+		{Data: []byte("two"), PartitionKey: "b"},
+	}, 1)
+
+	ok(t, err)
+	equals(t, 1, requests)
+	equals(t, 1, resp.FailedRecordCount)
+	equals(t, "ProvisionedThroughputExceededException", resp.Records[1].ErrorCode)
+}
+
+func TestTailStreamFollowsSplit(t *testing.T) {
+	// shardId-000000000000 is split into shardId-000000000001 and
+	// shardId-000000000002, which are closed by a merge whose child is
+	// only found with ListShards.
+	shard := func(id, parent, adjacent string) string {
+		return fmt.Sprintf(`{"ShardId": %q, "ParentShardId": %q, "AdjacentParentShardId": %q}`, id, parent, adjacent)
+	}
+	pages := map[string]string{
+		"it-0-a": `{"NextShardIterator": "it-0-b", "MillisBehindLatest": 10, "Records": [{"Data": "MA==", "PartitionKey": "k", "SequenceNumber": "1"}]}`,
+		"it-0-b": `{"Records": [{"Data": "MQ==", "PartitionKey": "k", "SequenceNumber": "2"}], "ChildShards": [` +
+			`{"ShardId": "shardId-000000000001", "ParentShards": ["shardId-000000000000"]},` +
+			`{"ShardId": "shardId-000000000002", "ParentShards": ["shardId-000000000000"]}]}`,
+		"it-1-a": `{"Records": [{"Data": "Mg==", "PartitionKey": "k", "SequenceNumber": "3"}]}`,
+		"it-2-a": `{"Records": [{"Data": "Mw==", "PartitionKey": "k", "SequenceNumber": "4"}]}`,
+		"it-3-a": `{"Records": [{"Data": "NA==", "PartitionKey": "k", "SequenceNumber": "5"}], "ChildShards": []}`,
+	}
+	var mu sync.Mutex
+	var iterators []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req map[string]interface{}
+		json.Unmarshal(body, &req)
+		switch r.Header.Get("X-Amz-Target") {
+		case "Kinesis_20131202.ListShards":
+			if req["ShardFilter"] != nil {
+				fmt.Fprintf(w, `{"Shards": [%s]}`, shard("shardId-000000000000", "", ""))
+				return
+			}
+			fmt.Fprintf(w, `{"Shards": [%s, %s, %s, %s]}`,
+				shard("shardId-000000000000", "", ""),
+				shard("shardId-000000000001", "shardId-000000000000", ""),
+				shard("shardId-000000000002", "shardId-000000000000", ""),
+				shard("shardId-000000000003", "shardId-000000000001", "shardId-000000000002"))
+		case "Kinesis_20131202.GetShardIterator":
+			mu.Lock()
+			iterators = append(iterators, fmt.Sprintf("%s %s", req["ShardId"], req["ShardIteratorType"]))
+			mu.Unlock()
+			id := req["ShardId"].(string)
+			fmt.Fprintf(w, `{"ShardIterator": "it-%s-a"}`, id[len(id)-1:])
+		case "Kinesis_20131202.GetRecords":
+			fmt.Fprint(w, pages[req["ShardIterator"].(string)])
+		}
+	}))
+	defer server.Close()
+
+	k := kinesis.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{KinesisEndpoint: server.URL})
+	tail, err := k.TailStream("exampleStreamName", &kinesis.TailOptions{PollInterval: time.Millisecond})
+	ok(t, err)
+	defer tail.Close()
+
+	var got []string
+	for r := range tail.Records {
+		got = append(got, r.ShardId+" "+string(r.Data))
+	}
+	ok(t, tail.Err())
+
+	// The children of a shard are read after it, in any order.
+	equals(t, []string{"shardId-000000000000 0", "shardId-000000000000 1"}, got[:2])
+	children := append([]string(nil), got[2:4]...)
+	sort.Strings(children)
+	equals(t, []string{"shardId-000000000001 2", "shardId-000000000002 3"}, children)
+	equals(t, []string{"shardId-000000000003 4"}, got[4:])
+
+	sort.Strings(iterators)
+	equals(t, "shardId-000000000000 LATEST", iterators[0])
+	for _, it := range iterators[1:] {
+		assert(t, strings.HasSuffix(it, " TRIM_HORIZON"), "child shard not read from the start: %s", it)
+	}
+	equals(t, 4, len(iterators))
+}
+
+func TestEnableEnhancedMonitoring(t *testing.T) {
+	var target string
+	var req map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		target = r.Header.Get("X-Amz-Target")
+		json.Unmarshal(body, &req)
+		fmt.Fprint(w, enableEnhancedMonitoring)
+	}))
+	defer server.Close()
+
+	k := kinesis.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{KinesisEndpoint: server.URL})
+	resp, err := k.EnableEnhancedMonitoring("exampleStreamName", kinesis.ShardLevelIncomingBytes, kinesis.ShardLevelIteratorAgeMilliseconds)
+	ok(t, err)
+
+	equals(t, "Kinesis_20131202.EnableEnhancedMonitoring", target)
+	equals(t, map[string]interface{}{
+		"StreamName":        "exampleStreamName",
+		"ShardLevelMetrics": []interface{}{"IncomingBytes", "IteratorAgeMilliseconds"},
+	}, req)
+	equals(t, "exampleStreamName", resp.StreamName)
+	equals(t, []kinesis.ShardLevelMetric{}, resp.CurrentShardLevelMetrics)
+	equals(t, []kinesis.ShardLevelMetric{kinesis.ShardLevelIncomingBytes, kinesis.ShardLevelIteratorAgeMilliseconds}, resp.DesiredShardLevelMetrics)
+}
+
+type metricsRecorder struct {
+	mu     sync.Mutex
+	points []string
+}
+
+func (r *metricsRecorder) PutMetric(name string, value float64, unit string, dimensions map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.points = append(r.points, fmt.Sprintf("%s %s/%s %v %s", name, dimensions["StreamName"], dimensions["ShardId"], value, unit))
+}
+
+func TestLagMonitor(t *testing.T) {
+	sink := &metricsRecorder{}
+	m := kinesis.NewLagMonitor("exampleStreamName", sink)
+
+	m.Observe("shardId-000000000000", &kinesis.GetRecordsResponse{MillisBehindLatest: 1500})
+	m.Observe("shardId-000000000001", &kinesis.GetRecordsResponse{MillisBehindLatest: 250})
+	m.Observe("shardId-000000000000", &kinesis.GetRecordsResponse{MillisBehindLatest: 500})
+
+	equals(t, []string{
+		"IteratorAgeMilliseconds exampleStreamName/shardId-000000000000 1500 Milliseconds",
+		"IteratorAgeMilliseconds exampleStreamName/shardId-000000000001 250 Milliseconds",
+		"IteratorAgeMilliseconds exampleStreamName/shardId-000000000000 500 Milliseconds",
+	}, sink.points)
+	equals(t, map[string]time.Duration{
+		"shardId-000000000000": 500 * time.Millisecond,
+		"shardId-000000000001": 250 * time.Millisecond,
+	}, m.Lags())
+	equals(t, 500*time.Millisecond, m.MaxLag())
+
+	m.Forget("shardId-000000000000")
+	_, tracked := m.Lag("shardId-000000000000")
+	equals(t, false, tracked)
+	equals(t, 250*time.Millisecond, m.MaxLag())
+}
+
+func TestTailStreamLagMonitor(t *testing.T) {
+	pages := map[string]string{
+		"it-a": `{"NextShardIterator": "it-b", "MillisBehindLatest": 3000, "Records": [{"Data": "MA==", "PartitionKey": "k", "SequenceNumber": "1"}]}`,
+		"it-b": `{"NextShardIterator": "it-c", "MillisBehindLatest": 0, "Records": []}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var req map[string]interface{}
+		json.Unmarshal(body, &req)
+		switch r.Header.Get("X-Amz-Target") {
+		case "Kinesis_20131202.ListShards":
+			fmt.Fprint(w, `{"Shards": [{"ShardId": "shardId-000000000000"}]}`)
+		case "Kinesis_20131202.GetShardIterator":
+			fmt.Fprint(w, `{"ShardIterator": "it-a"}`)
+		case "Kinesis_20131202.GetRecords":
+			if page, found := pages[req["ShardIterator"].(string)]; found {
+				fmt.Fprint(w, page)
+				return
+			}
+			fmt.Fprint(w, `{"NextShardIterator": "it-c", "MillisBehindLatest": 0, "Records": []}`)
+		}
+	}))
+	defer server.Close()
+
+	sink := &metricsRecorder{}
+	monitor := kinesis.NewLagMonitor("exampleStreamName", sink)
+	k := kinesis.New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{KinesisEndpoint: server.URL})
+	tail, err := k.TailStream("exampleStreamName", &kinesis.TailOptions{PollInterval: time.Millisecond, LagMonitor: monitor})
+	ok(t, err)
+
+	<-tail.Records
+	lag, tracked := monitor.Lag("shardId-000000000000")
+	assert(t, tracked, "shard not tracked")
+	equals(t, 3*time.Second, lag)
+
+	for {
+		sink.mu.Lock()
+		n := len(sink.points)
+		sink.mu.Unlock()
+		if n >= 2 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	ok(t, tail.Close())
+	equals(t, time.Duration(0), monitor.MaxLag())
+}
@@ -0,0 +1,94 @@
+package kinesis
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// LagMetricName is the name of the metric LagMonitor exports, the same as
+// the iterator age published by enhanced monitoring, in milliseconds.
+const LagMetricName = "IteratorAgeMilliseconds"
+
+// LagMonitor tracks how far the readers of the shards of a stream are
+// behind its tip, from the MillisBehindLatest of their GetRecords
+// responses. This is the iterator age of the shards as seen by a consumer,
+// without the cost and the one-minute granularity of enhanced monitoring.
+//
+// Each observation is exported to Sink, if set, as LagMetricName with the
+// StreamName and ShardId dimensions. A LagMonitor is safe for concurrent
+// use, so the readers of all the shards can share one.
+type LagMonitor struct {
+	StreamName string
+	Sink       aws.MetricsSink
+
+	mu  sync.Mutex
+	lag map[string]time.Duration
+}
+
+// NewLagMonitor returns a LagMonitor of a stream, exporting to sink if it
+// is not nil.
+func NewLagMonitor(streamName string, sink aws.MetricsSink) *LagMonitor {
+	return &LagMonitor{StreamName: streamName, Sink: sink}
+}
+
+// Observe records the lag of a shard given by a GetRecords response.
+func (m *LagMonitor) Observe(shardId string, resp *GetRecordsResponse) {
+	lag := time.Duration(resp.MillisBehindLatest) * time.Millisecond
+	m.mu.Lock()
+	if m.lag == nil {
+		m.lag = make(map[string]time.Duration)
+	}
+	m.lag[shardId] = lag
+	m.mu.Unlock()
+
+	if m.Sink != nil {
+		m.Sink.PutMetric(LagMetricName, float64(resp.MillisBehindLatest), "Milliseconds", map[string]string{
+			"StreamName": m.StreamName,
+			"ShardId":    shardId,
+		})
+	}
+}
+
+// Forget stops tracking a shard, which should be done once it is read to
+// its end so that it doesn't count towards MaxLag.
+func (m *LagMonitor) Forget(shardId string) {
+	m.mu.Lock()
+	delete(m.lag, shardId)
+	m.mu.Unlock()
+}
+
+// Lag returns the last observed lag of a shard, and whether the shard is
+// tracked.
+func (m *LagMonitor) Lag(shardId string) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lag, ok := m.lag[shardId]
+	return lag, ok
+}
+
+// Lags returns the last observed lag of every tracked shard.
+func (m *LagMonitor) Lags() map[string]time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	lags := make(map[string]time.Duration, len(m.lag))
+	for shardId, lag := range m.lag {
+		lags[shardId] = lag
+	}
+	return lags
+}
+
+// MaxLag returns the largest lag of the tracked shards, which is what
+// alerting on a consumer falling behind usually needs.
+func (m *LagMonitor) MaxLag() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var max time.Duration
+	for _, lag := range m.lag {
+		if lag > max {
+			max = lag
+		}
+	}
+	return max
+}
@@ -2,6 +2,7 @@ package kinesis
 
 import (
 	"encoding/json"
+	"time"
 )
 
 type msi map[string]interface{}
@@ -63,6 +64,10 @@ func (q *Query) AddPartitionKey(partitionKey string) {
 	q.buffer["PartitionKey"] = partitionKey
 }
 
+func (q *Query) AddRecords(records []PutRecordsRequestEntry) {
+	q.buffer["Records"] = records
+}
+
 func (q *Query) AddSequenceNumberForOrdering(sequenceNumber string) {
 	q.buffer["SequenceNumberForOrdering"] = sequenceNumber
 }
@@ -83,6 +88,30 @@ func (q *Query) AddNewStartingHashKey(hashKey string) {
 	q.buffer["NewStartingHashKey"] = hashKey
 }
 
+func (q *Query) AddMaxResults(maxResults int) {
+	q.buffer["MaxResults"] = maxResults
+}
+
+func (q *Query) AddNextToken(token string) {
+	q.buffer["NextToken"] = token
+}
+
+func (q *Query) AddShardLevelMetrics(metrics []ShardLevelMetric) {
+	q.buffer["ShardLevelMetrics"] = metrics
+}
+
+func (q *Query) AddShardFilter(filter *ShardFilter) {
+	f := msi{"Type": filter.Type}
+	if filter.ShardId != "" {
+		f["ShardId"] = filter.ShardId
+	}
+	if !filter.Timestamp.IsZero() {
+		// Timestamps are sent as fractional seconds since the epoch.
+		f["Timestamp"] = float64(filter.Timestamp.UnixNano()) / float64(time.Second)
+	}
+	q.buffer["ShardFilter"] = f
+}
+
 func (q *Query) String() string {
 	bytes, err := json.Marshal(q.buffer)
 	if err != nil {
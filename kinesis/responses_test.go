@@ -69,3 +69,78 @@ var putRecords string = `{
   "SequenceNumber": "21269319989653637946712965403778482177",
   "ShardId": "shardId-000000000001"
 }`
+
+var listShards string = `
+{
+  "NextToken": "AAAAAAAAAAGK9EEG0sJqVhCUS2JsgigQ5dcpB4q9PYswrH2oK44Skbjtm+WR0xA7/hrAFFsohevH1/OyPnbzKBS1byPyCZuVcokYtQe/b1m4c0SCI7jctPT0oUTLRdwSRirKm9dp9YC/EL+kZHOvYAUnztVGsOAPEFVJbe6dhjWC5WLwECDtxtTYK5m47NJl/A3kmh1vaq2Y+6k7wGzpDyjQhanvB9/4LdWjUc2PMFlR7iW9dJbC6BDi2BvrcqfJnFFTIxRwyfXOIYLi1o0SFdBP5drBgdFHlh4NOO9rmE5TPvDXNCjBJhyOSqcT1FTJMHwOdxjEbaqz6PGlbY0+Ww6ffwzUJkhe1xRV",
+  "Shards": [
+    {
+      "HashKeyRange": {
+        "EndingHashKey": "113427455640312821154458202477256070484",
+        "StartingHashKey": "0"
+      },
+      "SequenceNumberRange": {
+        "StartingSequenceNumber": "49579844037727333356165064238440708846556371693205002242"
+      },
+      "ShardId": "shardId-000000000000"
+    },
+    {
+      "HashKeyRange": {
+        "EndingHashKey": "340282366920938463463374607431768211455",
+        "StartingHashKey": "113427455640312821154458202477256070485"
+      },
+      "SequenceNumberRange": {
+        "StartingSequenceNumber": "49579844037749634101363594861582244564829020124710982658"
+      },
+      "ShardId": "shardId-000000000001"
+    }
+  ]
+}
+`
+
+var listShardsLastPage string = `
+{
+  "Shards": [
+    {
+      "HashKeyRange": {
+        "EndingHashKey": "340282366920938463463374607431768211455",
+        "StartingHashKey": "226854911280625642308916404954512140970"
+      },
+      "SequenceNumberRange": {
+        "StartingSequenceNumber": "49579844037771934846562125484723780283101668556216963074"
+      },
+      "ShardId": "shardId-000000000002"
+    }
+  ]
+}
+`
+
+var putRecordsPartialFailure string = `{
+  "FailedRecordCount": 1,
+  "Records": [
+    {
+      "SequenceNumber": "49543463076548007577105092703039560359975228518395012686",
+      "ShardId": "shardId-000000000000"
+    },
+    {
+      "ErrorCode": "ProvisionedThroughputExceededException",
+      "ErrorMessage": "Rate exceeded for shard shardId-000000000001 in stream exampleStreamName under account 111111111111."
+    }
+  ]
+}`
+
+var putRecordsRetried string = `{
+  "FailedRecordCount": 0,
+  "Records": [
+    {
+      "SequenceNumber": "49543463076570308322303623326179887152428262250726293522",
+      "ShardId": "shardId-000000000001"
+    }
+  ]
+}`
+
+var enableEnhancedMonitoring string = `{
+  "StreamName": "exampleStreamName",
+  "CurrentShardLevelMetrics": [],
+  "DesiredShardLevelMetrics": ["IncomingBytes", "IteratorAgeMilliseconds"]
+}`
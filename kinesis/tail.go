@@ -0,0 +1,233 @@
+package kinesis
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Options of TailStream. IteratorType is where reading starts in the shards
+// open at that position: ShardIteratorLatest (the default) or
+// ShardIteratorTrimHorizon. Limit is the maximum number of records of each
+// GetRecords call (the service default if 0). PollInterval is how long to
+// wait before reading again a shard that is caught up, and defaults to one
+// second, as a shard supports only five reads per second. LagMonitor, if
+// set, observes every GetRecords response.
+type TailOptions struct {
+	IteratorType ShardIteratorType
+	Limit        int
+	PollInterval time.Duration
+	LagMonitor   *LagMonitor
+}
+
+// A record read by TailStream, along with the shard it was read from.
+type TailRecord struct {
+	ShardId string
+	Record
+}
+
+// StreamTail delivers the records of the shards of a stream as they are
+// added. The records of each shard are in order, and the records of a shard
+// closed by a split or merge come before those of its child shards.
+// Records is closed when Close is called, when every shard is closed, or
+// when reading fails; Err must then be checked to tell why.
+type StreamTail struct {
+	Records <-chan *TailRecord
+
+	kinesis    *Kinesis
+	streamName string
+	options    TailOptions
+	records    chan *TailRecord
+
+	mu       sync.Mutex
+	started  map[string]bool
+	finished map[string]bool
+	wg       sync.WaitGroup
+	err      error
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// TailStream starts reading every shard of a stream, following the shards
+// created by splits and merges as the shards being read are closed.
+//
+// The caller must call Close on the returned tail when done with it.
+func (k *Kinesis) TailStream(streamName string, options *TailOptions) (*StreamTail, error) {
+	t := &StreamTail{
+		kinesis:    k,
+		streamName: streamName,
+		records:    make(chan *TailRecord),
+		started:    make(map[string]bool),
+		finished:   make(map[string]bool),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	if options != nil {
+		t.options = *options
+	}
+	if t.options.IteratorType == "" {
+		t.options.IteratorType = ShardIteratorLatest
+	}
+	if t.options.PollInterval == 0 {
+		t.options.PollInterval = time.Second
+	}
+	t.Records = t.records
+
+	filter := &ShardFilter{Type: ShardFilterAtLatest}
+	if t.options.IteratorType == ShardIteratorTrimHorizon {
+		filter.Type = ShardFilterAtTrimHorizon
+	}
+	shards, err := k.ListAllShards(streamName, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	for _, shard := range shards {
+		t.startShard(shard.ShardId, t.options.IteratorType)
+	}
+	t.mu.Unlock()
+
+	go func() {
+		t.wg.Wait()
+		close(t.records)
+		close(t.done)
+	}()
+	return t, nil
+}
+
+// Err returns the error that stopped the tail, if any. It blocks until
+// Records is closed.
+func (t *StreamTail) Err() error {
+	<-t.done
+	return t.err
+}
+
+// Close stops reading the stream. It is safe to call Close before Records
+// is drained.
+func (t *StreamTail) Close() error {
+	t.stopOnce.Do(func() { close(t.stop) })
+	<-t.done
+	return nil
+}
+
+// fail stops the tail with err, unless it is already stopped.
+func (t *StreamTail) fail(err error) {
+	t.stopOnce.Do(func() {
+		t.err = err
+		close(t.stop)
+	})
+}
+
+// startShard starts reading a shard unless it is already being read. It
+// must be called with t.mu held.
+func (t *StreamTail) startShard(shardId string, iteratorType ShardIteratorType) {
+	if t.started[shardId] {
+		return
+	}
+	t.started[shardId] = true
+	t.wg.Add(1)
+	go t.readShard(shardId, iteratorType)
+}
+
+func (t *StreamTail) readShard(shardId string, iteratorType ShardIteratorType) {
+	defer t.wg.Done()
+
+	resp, err := t.kinesis.GetShardIterator(shardId, t.streamName, iteratorType, "")
+	if err != nil {
+		t.fail(err)
+		return
+	}
+	iterator := resp.ShardIterator
+	for {
+		records, err := t.kinesis.GetRecords(iterator, t.options.Limit)
+		var apiErr aws.APIError
+		if errors.As(err, &apiErr) && apiErr.Code() == "ProvisionedThroughputExceededException" {
+			if !t.sleep() {
+				return
+			}
+			continue
+		}
+		if err != nil {
+			t.fail(err)
+			return
+		}
+		if t.options.LagMonitor != nil {
+			t.options.LagMonitor.Observe(shardId, records)
+		}
+		for i := range records.Records {
+			select {
+			case t.records <- &TailRecord{ShardId: shardId, Record: records.Records[i]}:
+			case <-t.stop:
+				return
+			}
+		}
+		if records.NextShardIterator == "" {
+			if t.options.LagMonitor != nil {
+				t.options.LagMonitor.Forget(shardId)
+			}
+			t.closeShard(shardId, records.ChildShards)
+			return
+		}
+		iterator = records.NextShardIterator
+		if len(records.Records) == 0 || records.MillisBehindLatest == 0 {
+			if !t.sleep() {
+				return
+			}
+		}
+	}
+}
+
+// closeShard records that a shard has been read to its end and starts
+// reading its children whose parents are all finished, or were never read.
+// The children are looked up with ListShards if GetRecords did not return
+// them.
+func (t *StreamTail) closeShard(shardId string, children []ChildShard) {
+	if len(children) == 0 {
+		shards, err := t.kinesis.ListAllShards(t.streamName, nil)
+		if err != nil {
+			t.fail(err)
+			return
+		}
+		for _, s := range shards {
+			if s.ParentShardId != shardId && s.AdjacentParentShardId != shardId {
+				continue
+			}
+			child := ChildShard{ShardId: s.ShardId, ParentShards: []string{s.ParentShardId}}
+			if s.AdjacentParentShardId != "" {
+				child.ParentShards = append(child.ParentShards, s.AdjacentParentShardId)
+			}
+			children = append(children, child)
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.finished[shardId] = true
+	for _, child := range children {
+		ready := true
+		for _, parent := range child.ParentShards {
+			if t.started[parent] && !t.finished[parent] {
+				ready = false
+			}
+		}
+		if ready {
+			t.startShard(child.ShardId, ShardIteratorTrimHorizon)
+		}
+	}
+}
+
+// sleep waits for the poll interval and reports whether the tail is still
+// running.
+func (t *StreamTail) sleep() bool {
+	select {
+	case <-time.After(t.options.PollInterval):
+		return true
+	case <-t.stop:
+		return false
+	}
+}
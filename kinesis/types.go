@@ -3,10 +3,14 @@ package kinesis
 import (
 	"fmt"
 	"github.com/zackbloom/goamz/aws"
+	"net/http"
+	"time"
 )
 
 type ShardIteratorType string
 type StreamStatus string
+type ShardFilterType string
+type ShardLevelMetric string
 
 const (
 
@@ -39,12 +43,48 @@ const (
 	// Shards in the stream are being merged or split.
 	// Read and write operations continue to work while the stream is in the UPDATING state.
 	StreamStatusUpdating StreamStatus = "UPDATING"
+
+	// List the shards after the one given in ShardFilter.ShardId.
+	ShardFilterAfterShardId ShardFilterType = "AFTER_SHARD_ID"
+
+	// List the shards open at the trim horizon.
+	ShardFilterAtTrimHorizon ShardFilterType = "AT_TRIM_HORIZON"
+
+	// List all shards from the trim horizon to the tip of the stream.
+	ShardFilterFromTrimHorizon ShardFilterType = "FROM_TRIM_HORIZON"
+
+	// List the shards that are currently open.
+	ShardFilterAtLatest ShardFilterType = "AT_LATEST"
+
+	// List the shards open at ShardFilter.Timestamp.
+	ShardFilterAtTimestamp ShardFilterType = "AT_TIMESTAMP"
+
+	// List all shards from ShardFilter.Timestamp to the tip of the stream.
+	ShardFilterFromTimestamp ShardFilterType = "FROM_TIMESTAMP"
+)
+
+// Shard-level metrics published to CloudWatch by enhanced monitoring.
+const (
+	ShardLevelIncomingBytes                      ShardLevelMetric = "IncomingBytes"
+	ShardLevelIncomingRecords                    ShardLevelMetric = "IncomingRecords"
+	ShardLevelOutgoingBytes                      ShardLevelMetric = "OutgoingBytes"
+	ShardLevelOutgoingRecords                    ShardLevelMetric = "OutgoingRecords"
+	ShardLevelWriteProvisionedThroughputExceeded ShardLevelMetric = "WriteProvisionedThroughputExceeded"
+	ShardLevelReadProvisionedThroughputExceeded  ShardLevelMetric = "ReadProvisionedThroughputExceeded"
+	ShardLevelIteratorAgeMilliseconds            ShardLevelMetric = "IteratorAgeMilliseconds"
+
+	// All of the shard-level metrics.
+	ShardLevelAll ShardLevelMetric = "ALL"
 )
 
 // Main Kinesis object
 type Kinesis struct {
 	aws.Auth
 	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
 }
 
 // The range of possible hash key values for the shard, which is a set of ordered contiguous positive integers.
@@ -80,11 +120,17 @@ type Shard struct {
 
 // Description of a Stream
 type StreamDescription struct {
-	HasMoreShards bool
-	Shards        []Shard
-	StreamARN     string
-	StreamName    string
-	StreamStatus  StreamStatus
+	EnhancedMonitoring []EnhancedMetrics
+	HasMoreShards      bool
+	Shards             []Shard
+	StreamARN          string
+	StreamName         string
+	StreamStatus       StreamStatus
+}
+
+// The shard-level metrics enabled for a stream.
+type EnhancedMetrics struct {
+	ShardLevelMetrics []ShardLevelMetric
 }
 
 // The unit of data of the Amazon Kinesis stream, which is composed of a sequence number,
@@ -100,10 +146,31 @@ type DescribeStreamResponse struct {
 	StreamDescription StreamDescription
 }
 
-// Represents the output of a GetRecords operation.
+// A shard created by splitting or merging the shard being read, as returned
+// by GetRecords once it reaches the end of a closed shard.
+type ChildShard struct {
+	ShardId      string
+	ParentShards []string
+	HashKeyRange HashKeyRange
+}
+
+// Represents the output of a GetRecords operation. NextShardIterator is
+// empty once the end of a closed shard is reached, in which case
+// ChildShards lists the shards to read next.
 type GetRecordsResponse struct {
-	NextShardIterator string
-	Records           []Record
+	NextShardIterator  string
+	Records            []Record
+	MillisBehindLatest int64
+	ChildShards        []ChildShard
+}
+
+// Represents the output of an EnableEnhancedMonitoring or
+// DisableEnhancedMonitoring operation. The desired metrics are those of
+// the stream once the operation completes.
+type EnhancedMonitoringResponse struct {
+	StreamName               string
+	CurrentShardLevelMetrics []ShardLevelMetric
+	DesiredShardLevelMetrics []ShardLevelMetric
 }
 
 // Represents the output of a GetShardIterator operation.
@@ -117,12 +184,52 @@ type ListStreamResponse struct {
 	StreamNames    []string
 }
 
+// Restricts the shards returned by ListShards. ShardId is only used with
+// ShardFilterAfterShardId and Timestamp with ShardFilterAtTimestamp and
+// ShardFilterFromTimestamp.
+type ShardFilter struct {
+	Type      ShardFilterType
+	ShardId   string
+	Timestamp time.Time
+}
+
+// Represents the output of a ListShards operation.
+type ListShardsResponse struct {
+	NextToken string
+	Shards    []Shard
+}
+
 // Represents the output of a PutRecord operation.
 type PutRecordResponse struct {
 	SequenceNumber string
 	ShardId        string
 }
 
+// A record to put with PutRecords. ExplicitHashKey is optional and
+// overrides the hash of PartitionKey.
+type PutRecordsRequestEntry struct {
+	Data            []byte
+	PartitionKey    string
+	ExplicitHashKey string `json:",omitempty"`
+}
+
+// The result of putting a record with PutRecords. ErrorCode is set, to
+// "ProvisionedThroughputExceededException" or "InternalFailure", if the
+// record was not put.
+type PutRecordsResultEntry struct {
+	SequenceNumber string
+	ShardId        string
+	ErrorCode      string
+	ErrorMessage   string
+}
+
+// Represents the output of a PutRecords operation. Records are in the
+// order of the request.
+type PutRecordsResponse struct {
+	FailedRecordCount int
+	Records           []PutRecordsResultEntry
+}
+
 // Error represents an error in an operation with Kinesis(following goamz/Dynamodb)
 type Error struct {
 	StatusCode int // HTTP status code (200, 403, ...)
@@ -134,3 +241,7 @@ type Error struct {
 func (e Error) Error() string {
 	return fmt.Sprintf("[HTTP %d] %s : %s\n", e.StatusCode, e.Code, e.Message)
 }
+
+func (e Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, e, e.StatusCode, e.Code, e.Message, "")
+}
@@ -0,0 +1,89 @@
+package kms
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"io"
+)
+
+// Envelope holds data encrypted locally with AES-256-GCM under a data key,
+// along with the data key encrypted under a KMS key. It can be stored as
+// JSON, as byte slices are base64 encoded.
+type Envelope struct {
+	EncryptedKey []byte
+	Nonce        []byte
+	Ciphertext   []byte
+}
+
+var errEnvelopeKey = errors.New("kms: invalid envelope data key")
+
+// Seal encrypts plaintext of any size using envelope encryption: a new data
+// key is generated under keyId with GenerateDataKey, used to encrypt the
+// plaintext locally, and discarded. context is the encryption context of
+// the data key and may be nil.
+func (k *KMS) Seal(keyId string, plaintext []byte, context map[string]string) (*Envelope, error) {
+	key, err := k.GenerateDataKey(&GenerateDataKeyRequest{
+		KeyId:             keyId,
+		KeySpec:           DataKeySpecAES256,
+		EncryptionContext: context,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key.Plaintext)
+
+	aead, err := newGCM(key.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		EncryptedKey: key.CiphertextBlob,
+		Nonce:        nonce,
+		Ciphertext:   aead.Seal(nil, nonce, plaintext, nil),
+	}, nil
+}
+
+// Open decrypts an envelope sealed by Seal, decrypting its data key with
+// Decrypt. context must be the encryption context given to Seal.
+func (k *KMS) Open(env *Envelope, context map[string]string) ([]byte, error) {
+	key, err := k.Decrypt(&DecryptRequest{
+		CiphertextBlob:    env.EncryptedKey,
+		EncryptionContext: context,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer zero(key.Plaintext)
+
+	aead, err := newGCM(key.Plaintext)
+	if err != nil {
+		return nil, err
+	}
+	if len(env.Nonce) != aead.NonceSize() {
+		return nil, errors.New("kms: invalid envelope nonce")
+	}
+	return aead.Open(nil, env.Nonce, env.Ciphertext, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, errEnvelopeKey
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
@@ -0,0 +1,59 @@
+package kms_test
+
+import (
+	"encoding/base64"
+
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestSealOpen(c *check.C) {
+	context := map[string]string{"bucket": "backups"}
+	testServer.Response(200, nil, GenerateDataKeyResponse)
+
+	env, err := s.kms.Seal("alias/app", []byte("attack at dawn"), context)
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.GenerateDataKey")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"KeyId":             "alias/app",
+		"KeySpec":           "AES_256",
+		"EncryptionContext": map[string]interface{}{"bucket": "backups"},
+	})
+	c.Assert(string(env.EncryptedKey), check.Equals, "encrypted data key")
+	c.Assert(env.Nonce, check.HasLen, 12)
+	c.Assert(string(env.Ciphertext), check.Not(check.Matches), ".*attack.*")
+
+	// The data key of GenerateDataKeyResponse, as decrypted by KMS.
+	testServer.Response(200, nil, `{"Plaintext": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="}`)
+
+	plaintext, err := s.kms.Open(env, context)
+	target, body = testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.Decrypt")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"CiphertextBlob":    base64.StdEncoding.EncodeToString([]byte("encrypted data key")),
+		"EncryptionContext": map[string]interface{}{"bucket": "backups"},
+	})
+	c.Assert(string(plaintext), check.Equals, "attack at dawn")
+}
+
+func (s *S) TestOpenTampered(c *check.C) {
+	testServer.Response(200, nil, GenerateDataKeyResponse)
+	env, err := s.kms.Seal("alias/app", []byte("attack at dawn"), nil)
+	testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	env.Ciphertext[0] ^= 1
+	testServer.Response(200, nil, `{"Plaintext": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="}`)
+	_, err = s.kms.Open(env, nil)
+	testServer.WaitRequest()
+	c.Assert(err, check.ErrorMatches, ".*authentication failed")
+
+	env.Ciphertext[0] ^= 1
+	testServer.Response(200, nil, `{"Plaintext": "c2hvcnQ="}`)
+	_, err = s.kms.Open(env, nil)
+	testServer.WaitRequest()
+	c.Assert(err, check.ErrorMatches, "kms: invalid envelope data key")
+}
@@ -0,0 +1,177 @@
+package kms
+
+const (
+	KeyUsageEncryptDecrypt = "ENCRYPT_DECRYPT"
+	KeyUsageSignVerify     = "SIGN_VERIFY"
+	KeyUsageGenerateVerify = "GENERATE_VERIFY_MAC"
+	KeyUsageKeyAgreement   = "KEY_AGREEMENT"
+)
+
+// States of a KMS key.
+const (
+	KeyStateEnabled         = "Enabled"
+	KeyStateDisabled        = "Disabled"
+	KeyStatePendingDeletion = "PendingDeletion"
+	KeyStatePendingImport   = "PendingImport"
+	KeyStateUnavailable     = "Unavailable"
+)
+
+// Origins of the key material of a KMS key. Keys of origin
+// OriginAWSCloudHSM and OriginExternalKeyStore are created in a custom key
+// store.
+const (
+	OriginAWSKMS           = "AWS_KMS"
+	OriginExternal         = "EXTERNAL"
+	OriginAWSCloudHSM      = "AWS_CLOUDHSM"
+	OriginExternalKeyStore = "EXTERNAL_KEY_STORE"
+)
+
+type Tag struct {
+	TagKey   string
+	TagValue string
+}
+
+// KeyMetadata describes a KMS key. Dates are in seconds since the epoch;
+// DeletionDate is 0 unless the key is pending deletion.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_KeyMetadata.html
+type KeyMetadata struct {
+	AWSAccountId         string
+	KeyId                string
+	Arn                  string
+	CreationDate         float64
+	DeletionDate         float64
+	Description          string
+	Enabled              bool
+	KeyManager           string
+	KeySpec              string
+	KeyState             string
+	KeyUsage             string
+	MultiRegion          bool
+	Origin               string
+	EncryptionAlgorithms []string
+	CustomKeyStoreId     string
+	CloudHsmClusterId    string
+	XksKeyConfiguration  *XksKeyConfiguration
+}
+
+// XksKeyConfiguration identifies the key of the external key manager that
+// backs a KMS key of an external key store.
+type XksKeyConfiguration struct {
+	Id string
+}
+
+// CreateKeyRequest holds the parameters of CreateKey. All fields are
+// optional; the default is a symmetric encryption key with the default key
+// policy. Keys of a custom key store need its CustomKeyStoreId and the
+// matching Origin, and keys of an external key store the XksKeyId of their
+// external key.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateKey.html
+type CreateKeyRequest struct {
+	Description                    string `json:",omitempty"`
+	KeyUsage                       string `json:",omitempty"`
+	KeySpec                        string `json:",omitempty"`
+	Origin                         string `json:",omitempty"`
+	Policy                         string `json:",omitempty"`
+	MultiRegion                    bool   `json:",omitempty"`
+	Tags                           []Tag  `json:",omitempty"`
+	BypassPolicyLockoutSafetyCheck bool   `json:",omitempty"`
+	CustomKeyStoreId               string `json:",omitempty"`
+	XksKeyId                       string `json:",omitempty"`
+}
+
+type CreateKeyResponse struct {
+	KeyMetadata KeyMetadata
+}
+
+// CreateKey creates a KMS key in the account and region.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateKey.html
+func (k *KMS) CreateKey(req *CreateKeyRequest) (resp *CreateKeyResponse, err error) {
+	resp = new(CreateKeyResponse)
+	if err := k.query("CreateKey", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type DescribeKeyResponse struct {
+	KeyMetadata KeyMetadata
+}
+
+// DescribeKey describes a KMS key, given by key ID, ARN or alias.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeKey.html
+func (k *KMS) DescribeKey(keyId string) (resp *DescribeKeyResponse, err error) {
+	req := map[string]string{"KeyId": keyId}
+	resp = new(DescribeKeyResponse)
+	if err := k.query("DescribeKey", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateAlias creates an alias, such as "alias/my-key", for a KMS key.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateAlias.html
+func (k *KMS) CreateAlias(aliasName, targetKeyId string) error {
+	req := map[string]string{
+		"AliasName":   aliasName,
+		"TargetKeyId": targetKeyId,
+	}
+	return k.query("CreateAlias", req, nil)
+}
+
+// UpdateAlias points an existing alias to another KMS key.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_UpdateAlias.html
+func (k *KMS) UpdateAlias(aliasName, targetKeyId string) error {
+	req := map[string]string{
+		"AliasName":   aliasName,
+		"TargetKeyId": targetKeyId,
+	}
+	return k.query("UpdateAlias", req, nil)
+}
+
+// DeleteAlias deletes an alias. The KMS key it points to is not affected.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DeleteAlias.html
+func (k *KMS) DeleteAlias(aliasName string) error {
+	req := map[string]string{"AliasName": aliasName}
+	return k.query("DeleteAlias", req, nil)
+}
+
+type ScheduleKeyDeletionResponse struct {
+	KeyId               string
+	DeletionDate        float64
+	KeyState            string
+	PendingWindowInDays int
+}
+
+// ScheduleKeyDeletion schedules the deletion of a KMS key after a waiting
+// period of 7 to 30 days, or 30 days if pendingWindowInDays is 0. The key
+// can't be used in the meantime, and the deletion can be cancelled with
+// CancelKeyDeletion.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_ScheduleKeyDeletion.html
+func (k *KMS) ScheduleKeyDeletion(keyId string, pendingWindowInDays int) (resp *ScheduleKeyDeletionResponse, err error) {
+	req := map[string]interface{}{"KeyId": keyId}
+	if pendingWindowInDays != 0 {
+		req["PendingWindowInDays"] = pendingWindowInDays
+	}
+	resp = new(ScheduleKeyDeletionResponse)
+	if err := k.query("ScheduleKeyDeletion", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CancelKeyDeletion cancels the scheduled deletion of a KMS key, which is
+// left disabled.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CancelKeyDeletion.html
+func (k *KMS) CancelKeyDeletion(keyId string) error {
+	req := map[string]string{"KeyId": keyId}
+	return k.query("CancelKeyDeletion", req, nil)
+}
@@ -0,0 +1,158 @@
+package kms
+
+// Types of custom key store. An AWS CloudHSM key store keeps its keys in a
+// CloudHSM cluster of the account, and an external key store (XKS) in a
+// key manager outside of AWS, reached through an XKS proxy.
+const (
+	CustomKeyStoreTypeCloudHSM = "AWS_CLOUDHSM"
+	CustomKeyStoreTypeExternal = "EXTERNAL_KEY_STORE"
+)
+
+// Connection states of a custom key store. Its keys can only be used while
+// it is connected.
+const (
+	ConnectionStateConnected     = "CONNECTED"
+	ConnectionStateConnecting    = "CONNECTING"
+	ConnectionStateDisconnected  = "DISCONNECTED"
+	ConnectionStateDisconnecting = "DISCONNECTING"
+	ConnectionStateFailed        = "FAILED"
+)
+
+// How KMS reaches the XKS proxy of an external key store.
+const (
+	XksProxyConnectivityPublicEndpoint     = "PUBLIC_ENDPOINT"
+	XksProxyConnectivityVpcEndpointService = "VPC_ENDPOINT_SERVICE"
+)
+
+// XksProxyAuthenticationCredential is the credential KMS signs its requests
+// to an XKS proxy with.
+type XksProxyAuthenticationCredential struct {
+	AccessKeyId        string
+	RawSecretAccessKey string
+}
+
+// CreateCustomKeyStoreRequest holds the parameters of CreateCustomKeyStore.
+// A CloudHSM key store needs CloudHsmClusterId, the TrustAnchorCertificate
+// of the cluster and the KeyStorePassword of its kmsuser crypto user. An
+// external key store needs CustomKeyStoreType CustomKeyStoreTypeExternal
+// and the Xks fields describing its proxy.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateCustomKeyStore.html
+type CreateCustomKeyStoreRequest struct {
+	CustomKeyStoreName               string
+	CustomKeyStoreType               string                            `json:",omitempty"`
+	CloudHsmClusterId                string                            `json:",omitempty"`
+	TrustAnchorCertificate           string                            `json:",omitempty"`
+	KeyStorePassword                 string                            `json:",omitempty"`
+	XksProxyConnectivity             string                            `json:",omitempty"`
+	XksProxyUriEndpoint              string                            `json:",omitempty"`
+	XksProxyUriPath                  string                            `json:",omitempty"`
+	XksProxyVpcEndpointServiceName   string                            `json:",omitempty"`
+	XksProxyAuthenticationCredential *XksProxyAuthenticationCredential `json:",omitempty"`
+}
+
+type createCustomKeyStoreResponse struct {
+	CustomKeyStoreId string
+}
+
+// CreateCustomKeyStore creates a custom key store and returns its ID. The
+// key store is created disconnected; connect it with ConnectCustomKeyStore
+// before creating keys in it.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateCustomKeyStore.html
+func (k *KMS) CreateCustomKeyStore(req *CreateCustomKeyStoreRequest) (customKeyStoreId string, err error) {
+	var resp createCustomKeyStoreResponse
+	if err := k.query("CreateCustomKeyStore", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.CustomKeyStoreId, nil
+}
+
+// ConnectCustomKeyStore starts connecting a custom key store to its
+// CloudHSM cluster or XKS proxy. Connecting takes up to 20 minutes; poll
+// DescribeCustomKeyStores until its ConnectionState is
+// ConnectionStateConnected or ConnectionStateFailed.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_ConnectCustomKeyStore.html
+func (k *KMS) ConnectCustomKeyStore(customKeyStoreId string) error {
+	req := map[string]string{"CustomKeyStoreId": customKeyStoreId}
+	return k.query("ConnectCustomKeyStore", req, nil)
+}
+
+// DisconnectCustomKeyStore disconnects a custom key store. Its keys can't
+// be used until it is connected again.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DisconnectCustomKeyStore.html
+func (k *KMS) DisconnectCustomKeyStore(customKeyStoreId string) error {
+	req := map[string]string{"CustomKeyStoreId": customKeyStoreId}
+	return k.query("DisconnectCustomKeyStore", req, nil)
+}
+
+// DeleteCustomKeyStore deletes a disconnected custom key store that has no
+// keys left.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DeleteCustomKeyStore.html
+func (k *KMS) DeleteCustomKeyStore(customKeyStoreId string) error {
+	req := map[string]string{"CustomKeyStoreId": customKeyStoreId}
+	return k.query("DeleteCustomKeyStore", req, nil)
+}
+
+// XksProxyConfiguration describes the XKS proxy of an external key store.
+type XksProxyConfiguration struct {
+	AccessKeyId            string
+	Connectivity           string
+	UriEndpoint            string
+	UriPath                string
+	VpcEndpointServiceName string
+}
+
+// CustomKeyStore describes a custom key store. ConnectionErrorCode tells
+// why the last connection attempt failed, if it did. CreationDate is in
+// seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_CustomKeyStoresListEntry.html
+type CustomKeyStore struct {
+	CustomKeyStoreId       string
+	CustomKeyStoreName     string
+	CustomKeyStoreType     string
+	CloudHsmClusterId      string
+	TrustAnchorCertificate string
+	ConnectionState        string
+	ConnectionErrorCode    string
+	CreationDate           float64
+	XksProxyConfiguration  *XksProxyConfiguration
+}
+
+// DescribeCustomKeyStoresRequest holds the parameters of
+// DescribeCustomKeyStores. At most one of CustomKeyStoreId and
+// CustomKeyStoreName may be set; without either, every custom key store of
+// the account and region is described. Marker is the NextMarker of the
+// previous page.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeCustomKeyStores.html
+type DescribeCustomKeyStoresRequest struct {
+	CustomKeyStoreId   string `json:",omitempty"`
+	CustomKeyStoreName string `json:",omitempty"`
+	Limit              int    `json:",omitempty"`
+	Marker             string `json:",omitempty"`
+}
+
+type DescribeCustomKeyStoresResponse struct {
+	CustomKeyStores []CustomKeyStore
+	NextMarker      string
+	Truncated       bool
+}
+
+// DescribeCustomKeyStores describes custom key stores.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeCustomKeyStores.html
+func (k *KMS) DescribeCustomKeyStores(req *DescribeCustomKeyStoresRequest) (resp *DescribeCustomKeyStoresResponse, err error) {
+	if req == nil {
+		req = &DescribeCustomKeyStoresRequest{}
+	}
+	resp = new(DescribeCustomKeyStoresResponse)
+	if err := k.query("DescribeCustomKeyStores", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
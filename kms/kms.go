@@ -0,0 +1,239 @@
+// Package kms provides types and functions to interact with the AWS Key
+// Management Service.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/Welcome.html
+package kms
+
+import (
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type KMS struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *KMS {
+	return &KMS{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with KMS.
+type Error = aws.JSONError
+
+// query calls the KMS action with req encoded as JSON and decodes the
+// response into resp.
+func (k *KMS) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         k.Auth,
+		Region:       k.Region,
+		HTTPClient:   k.HTTPClient,
+		Endpoint:     k.Region.KMSEndpoint,
+		TargetPrefix: "TrentService",
+		SigningName:  "kms",
+	}
+	return client.Call(action, req, resp)
+}
+
+const (
+	EncryptionAlgorithmSymmetricDefault = "SYMMETRIC_DEFAULT"
+	EncryptionAlgorithmRSAESOAEPSHA1    = "RSAES_OAEP_SHA_1"
+	EncryptionAlgorithmRSAESOAEPSHA256  = "RSAES_OAEP_SHA_256"
+	EncryptionAlgorithmSM2PKE           = "SM2PKE"
+)
+
+// EncryptRequest holds the parameters of Encrypt. Byte slices are sent
+// base64 encoded. The same EncryptionContext must be given to decrypt the
+// ciphertext.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_Encrypt.html
+type EncryptRequest struct {
+	KeyId               string
+	Plaintext           []byte
+	EncryptionContext   map[string]string `json:",omitempty"`
+	EncryptionAlgorithm string            `json:",omitempty"`
+	GrantTokens         []string          `json:",omitempty"`
+	DryRun              bool              `json:",omitempty"`
+}
+
+type EncryptResponse struct {
+	CiphertextBlob      []byte
+	KeyId               string
+	EncryptionAlgorithm string
+}
+
+// Encrypt encrypts up to 4096 bytes of plaintext under a KMS key. Larger
+// data should be encrypted with a data key; see Seal.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_Encrypt.html
+func (k *KMS) Encrypt(req *EncryptRequest) (resp *EncryptResponse, err error) {
+	resp = new(EncryptResponse)
+	if err := k.query("Encrypt", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DecryptRequest holds the parameters of Decrypt. KeyId is only required
+// for ciphertext produced by asymmetric keys, but restricts decryption to
+// the given key otherwise.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_Decrypt.html
+type DecryptRequest struct {
+	CiphertextBlob      []byte
+	KeyId               string            `json:",omitempty"`
+	EncryptionContext   map[string]string `json:",omitempty"`
+	EncryptionAlgorithm string            `json:",omitempty"`
+	GrantTokens         []string          `json:",omitempty"`
+	DryRun              bool              `json:",omitempty"`
+}
+
+type DecryptResponse struct {
+	Plaintext           []byte
+	KeyId               string
+	EncryptionAlgorithm string
+}
+
+// Decrypt decrypts ciphertext produced by Encrypt, GenerateDataKey or
+// ReEncrypt.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_Decrypt.html
+func (k *KMS) Decrypt(req *DecryptRequest) (resp *DecryptResponse, err error) {
+	resp = new(DecryptResponse)
+	if err := k.query("Decrypt", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+const (
+	DataKeySpecAES256 = "AES_256"
+	DataKeySpecAES128 = "AES_128"
+)
+
+// GenerateDataKeyRequest holds the parameters of GenerateDataKey. Exactly
+// one of KeySpec and NumberOfBytes must be set.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_GenerateDataKey.html
+type GenerateDataKeyRequest struct {
+	KeyId             string
+	KeySpec           string            `json:",omitempty"`
+	NumberOfBytes     int               `json:",omitempty"`
+	EncryptionContext map[string]string `json:",omitempty"`
+	GrantTokens       []string          `json:",omitempty"`
+	DryRun            bool              `json:",omitempty"`
+}
+
+// GenerateDataKeyResponse holds a data key in plaintext and encrypted
+// under the KMS key. The plaintext key should be used and discarded; the
+// encrypted key is stored with the data and decrypted with Decrypt.
+type GenerateDataKeyResponse struct {
+	CiphertextBlob []byte
+	Plaintext      []byte
+	KeyId          string
+}
+
+// GenerateDataKey generates a unique symmetric data key for encrypting
+// data outside of KMS.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_GenerateDataKey.html
+func (k *KMS) GenerateDataKey(req *GenerateDataKeyRequest) (resp *GenerateDataKeyResponse, err error) {
+	resp = new(GenerateDataKeyResponse)
+	if err := k.query("GenerateDataKey", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ReEncryptRequest holds the parameters of ReEncrypt. Byte slices are sent
+// base64 encoded. SourceKeyId is only required for ciphertext produced by
+// asymmetric keys.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_ReEncrypt.html
+type ReEncryptRequest struct {
+	CiphertextBlob                 []byte
+	DestinationKeyId               string
+	SourceKeyId                    string            `json:",omitempty"`
+	SourceEncryptionContext        map[string]string `json:",omitempty"`
+	DestinationEncryptionContext   map[string]string `json:",omitempty"`
+	SourceEncryptionAlgorithm      string            `json:",omitempty"`
+	DestinationEncryptionAlgorithm string            `json:",omitempty"`
+	GrantTokens                    []string          `json:",omitempty"`
+	DryRun                         bool              `json:",omitempty"`
+}
+
+type ReEncryptResponse struct {
+	CiphertextBlob                 []byte
+	KeyId                          string
+	SourceKeyId                    string
+	SourceEncryptionAlgorithm      string
+	DestinationEncryptionAlgorithm string
+}
+
+// ReEncrypt decrypts ciphertext and encrypts it again under the
+// destination key, entirely within KMS. It is used to rotate the key that
+// wraps a data key without exposing the plaintext to the caller.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_ReEncrypt.html
+func (k *KMS) ReEncrypt(req *ReEncryptRequest) (resp *ReEncryptResponse, err error) {
+	resp = new(ReEncryptResponse)
+	if err := k.query("ReEncrypt", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+const KeyAgreementAlgorithmECDH = "ECDH"
+
+// RecipientInfo asks KMS to encrypt the result for a Nitro enclave rather
+// than return it in plaintext.
+type RecipientInfo struct {
+	KeyEncryptionAlgorithm string `json:",omitempty"`
+	AttestationDocument    []byte `json:",omitempty"`
+}
+
+// DeriveSharedSecretRequest holds the parameters of DeriveSharedSecret.
+// KeyId must be an asymmetric KEY_AGREEMENT key and PublicKey the DER
+// encoded X.509 public key of the other party, on the same curve.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DeriveSharedSecret.html
+type DeriveSharedSecretRequest struct {
+	KeyId                 string
+	KeyAgreementAlgorithm string
+	PublicKey             []byte
+	Recipient             *RecipientInfo `json:",omitempty"`
+	GrantTokens           []string       `json:",omitempty"`
+	DryRun                bool           `json:",omitempty"`
+}
+
+type DeriveSharedSecretResponse struct {
+	KeyId                  string
+	SharedSecret           []byte
+	CiphertextForRecipient []byte
+	KeyAgreementAlgorithm  string
+	KeyOrigin              string
+}
+
+// DeriveSharedSecret derives a shared secret from the private key of an
+// asymmetric KMS key and another party's public key using ECDH. If
+// KeyAgreementAlgorithm is empty, ECDH is used.
+//
+// The raw shared secret should be passed through a key derivation function
+// before being used as an encryption key.
+//
+// See http://docs.aws.amazon.com/kms/latest/APIReference/API_DeriveSharedSecret.html
+func (k *KMS) DeriveSharedSecret(req *DeriveSharedSecretRequest) (resp *DeriveSharedSecretResponse, err error) {
+	if req.KeyAgreementAlgorithm == "" {
+		req.KeyAgreementAlgorithm = KeyAgreementAlgorithmECDH
+	}
+	resp = new(DeriveSharedSecretResponse)
+	if err := k.query("DeriveSharedSecret", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,316 @@
+package kms_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/kms"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	kms *kms.KMS
+}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.kms = kms.New(auth, aws.Region{Name: "us-east-1", KMSEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) TestReEncrypt(c *check.C) {
+	testServer.Response(200, nil, ReEncryptResponse)
+
+	resp, err := s.kms.ReEncrypt(&kms.ReEncryptRequest{
+		CiphertextBlob:               []byte("old ciphertext"),
+		DestinationKeyId:             "alias/new-key",
+		SourceEncryptionContext:      map[string]string{"purpose": "test"},
+		DestinationEncryptionContext: map[string]string{"purpose": "test"},
+	})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.ReEncrypt")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"CiphertextBlob":               "b2xkIGNpcGhlcnRleHQ=",
+		"DestinationKeyId":             "alias/new-key",
+		"SourceEncryptionContext":      map[string]interface{}{"purpose": "test"},
+		"DestinationEncryptionContext": map[string]interface{}{"purpose": "test"},
+	})
+
+	c.Assert(string(resp.CiphertextBlob), check.Equals, "new ciphertext")
+	c.Assert(resp.KeyId, check.Equals, "arn:aws:kms:us-east-1:111122223333:key/0987dcba-09fe-87dc-65ba-ab0987654321")
+	c.Assert(resp.SourceKeyId, check.Equals, "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab")
+	c.Assert(resp.SourceEncryptionAlgorithm, check.Equals, kms.EncryptionAlgorithmSymmetricDefault)
+	c.Assert(resp.DestinationEncryptionAlgorithm, check.Equals, kms.EncryptionAlgorithmSymmetricDefault)
+}
+
+func (s *S) TestDeriveSharedSecret(c *check.C) {
+	testServer.Response(200, nil, DeriveSharedSecretResponse)
+
+	resp, err := s.kms.DeriveSharedSecret(&kms.DeriveSharedSecretRequest{
+		KeyId:     "1234abcd-12ab-34cd-56ef-1234567890ab",
+		PublicKey: []byte("public key"),
+	})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.DeriveSharedSecret")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"KeyId":                 "1234abcd-12ab-34cd-56ef-1234567890ab",
+		"KeyAgreementAlgorithm": "ECDH",
+		"PublicKey":             "cHVibGljIGtleQ==",
+	})
+
+	c.Assert(string(resp.SharedSecret), check.Equals, "shared secret")
+	c.Assert(resp.KeyAgreementAlgorithm, check.Equals, kms.KeyAgreementAlgorithmECDH)
+	c.Assert(resp.KeyOrigin, check.Equals, "AWS_KMS")
+	c.Assert(resp.CiphertextForRecipient, check.IsNil)
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(400, nil, ErrorResponse)
+
+	_, err := s.kms.ReEncrypt(&kms.ReEncryptRequest{CiphertextBlob: []byte("x"), DestinationKeyId: "alias/missing"})
+	testServer.WaitRequest()
+
+	c.Assert(err, check.DeepEquals, &kms.Error{
+		Service:    "kms",
+		StatusCode: 400,
+		Code:       "NotFoundException",
+		Message:    "Alias arn:aws:kms:us-east-1:111122223333:alias/missing is not found.",
+	})
+	c.Assert(err, check.ErrorMatches, "kms: NotFoundException: Alias .* is not found.")
+
+	var apiErr aws.APIError
+	c.Assert(errors.As(err, &apiErr), check.Equals, true)
+	c.Assert(apiErr.Code(), check.Equals, "NotFoundException")
+	c.Assert(apiErr.HTTPStatus(), check.Equals, 400)
+	c.Assert(apiErr.Unwrap(), check.Equals, err)
+}
+
+func (s *S) TestEncrypt(c *check.C) {
+	testServer.Response(200, nil, EncryptResponse)
+
+	resp, err := s.kms.Encrypt(&kms.EncryptRequest{
+		KeyId:             "alias/app",
+		Plaintext:         []byte("plaintext"),
+		EncryptionContext: map[string]string{"purpose": "test"},
+	})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.Encrypt")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"KeyId":             "alias/app",
+		"Plaintext":         "cGxhaW50ZXh0",
+		"EncryptionContext": map[string]interface{}{"purpose": "test"},
+	})
+	c.Assert(string(resp.CiphertextBlob), check.Equals, "ciphertext")
+	c.Assert(resp.EncryptionAlgorithm, check.Equals, kms.EncryptionAlgorithmSymmetricDefault)
+}
+
+func (s *S) TestDecrypt(c *check.C) {
+	testServer.Response(200, nil, DecryptResponse)
+
+	resp, err := s.kms.Decrypt(&kms.DecryptRequest{CiphertextBlob: []byte("ciphertext")})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.Decrypt")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"CiphertextBlob": "Y2lwaGVydGV4dA=="})
+	c.Assert(string(resp.Plaintext), check.Equals, "plaintext")
+	c.Assert(resp.KeyId, check.Equals, "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab")
+}
+
+func (s *S) TestGenerateDataKey(c *check.C) {
+	testServer.Response(200, nil, GenerateDataKeyResponse)
+
+	resp, err := s.kms.GenerateDataKey(&kms.GenerateDataKeyRequest{KeyId: "alias/app", KeySpec: kms.DataKeySpecAES256})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.GenerateDataKey")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"KeyId": "alias/app", "KeySpec": "AES_256"})
+	c.Assert(resp.Plaintext, check.HasLen, 32)
+	c.Assert(string(resp.CiphertextBlob), check.Equals, "encrypted data key")
+}
+
+func (s *S) TestCreateKey(c *check.C) {
+	testServer.Response(200, nil, CreateKeyResponse)
+
+	resp, err := s.kms.CreateKey(&kms.CreateKeyRequest{
+		Description: "app secrets",
+		Tags:        []kms.Tag{{TagKey: "team", TagValue: "web"}},
+	})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.CreateKey")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Description": "app secrets",
+		"Tags":        []interface{}{map[string]interface{}{"TagKey": "team", "TagValue": "web"}},
+	})
+	c.Assert(resp.KeyMetadata.KeyId, check.Equals, "1234abcd-12ab-34cd-56ef-1234567890ab")
+	c.Assert(resp.KeyMetadata.KeyState, check.Equals, kms.KeyStateEnabled)
+	c.Assert(resp.KeyMetadata.KeyUsage, check.Equals, kms.KeyUsageEncryptDecrypt)
+	c.Assert(resp.KeyMetadata.CreationDate, check.Equals, 1499988169.234)
+	c.Assert(resp.KeyMetadata.EncryptionAlgorithms, check.DeepEquals, []string{"SYMMETRIC_DEFAULT"})
+}
+
+func (s *S) TestCreateAlias(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	err := s.kms.CreateAlias("alias/app", "1234abcd-12ab-34cd-56ef-1234567890ab")
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.CreateAlias")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"AliasName":   "alias/app",
+		"TargetKeyId": "1234abcd-12ab-34cd-56ef-1234567890ab",
+	})
+}
+
+func (s *S) TestScheduleKeyDeletion(c *check.C) {
+	testServer.Response(200, nil, ScheduleKeyDeletionResponse)
+
+	resp, err := s.kms.ScheduleKeyDeletion("1234abcd-12ab-34cd-56ef-1234567890ab", 7)
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.ScheduleKeyDeletion")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"KeyId":               "1234abcd-12ab-34cd-56ef-1234567890ab",
+		"PendingWindowInDays": 7.0,
+	})
+	c.Assert(resp.KeyState, check.Equals, kms.KeyStatePendingDeletion)
+	c.Assert(resp.DeletionDate, check.Equals, 1501142400.0)
+	c.Assert(resp.PendingWindowInDays, check.Equals, 7)
+}
+
+func (s *S) TestCreateCustomKeyStoreCloudHSM(c *check.C) {
+	testServer.Response(200, nil, CreateCustomKeyStoreResponse)
+
+	id, err := s.kms.CreateCustomKeyStore(&kms.CreateCustomKeyStoreRequest{
+		CustomKeyStoreName:     "ExampleKeyStore",
+		CloudHsmClusterId:      "cluster-1a23b4cdefg",
+		TrustAnchorCertificate: "<certificate goes here>",
+		KeyStorePassword:       "kmsPswd",
+	})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.CreateCustomKeyStore")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"CustomKeyStoreName":     "ExampleKeyStore",
+		"CloudHsmClusterId":      "cluster-1a23b4cdefg",
+		"TrustAnchorCertificate": "<certificate goes here>",
+		"KeyStorePassword":       "kmsPswd",
+	})
+	c.Assert(id, check.Equals, "cks-1234567890abcdef0")
+}
+
+func (s *S) TestCreateCustomKeyStoreExternal(c *check.C) {
+	testServer.Response(200, nil, CreateCustomKeyStoreResponse)
+
+	_, err := s.kms.CreateCustomKeyStore(&kms.CreateCustomKeyStoreRequest{
+		CustomKeyStoreName:   "ExampleExternalKeyStore",
+		CustomKeyStoreType:   kms.CustomKeyStoreTypeExternal,
+		XksProxyConnectivity: kms.XksProxyConnectivityPublicEndpoint,
+		XksProxyUriEndpoint:  "https://myproxy.xks.example.com",
+		XksProxyUriPath:      "/kms/xks/v1",
+		XksProxyAuthenticationCredential: &kms.XksProxyAuthenticationCredential{
+			AccessKeyId:        "ABCDE12345670EXAMPLE",
+			RawSecretAccessKey: "DXjSUawnel2fr6SKC7G25CNxTyWKE5PF9XX6H/u9pSo=",
+		},
+	})
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.CreateCustomKeyStore")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"CustomKeyStoreName":   "ExampleExternalKeyStore",
+		"CustomKeyStoreType":   "EXTERNAL_KEY_STORE",
+		"XksProxyConnectivity": "PUBLIC_ENDPOINT",
+		"XksProxyUriEndpoint":  "https://myproxy.xks.example.com",
+		"XksProxyUriPath":      "/kms/xks/v1",
+		"XksProxyAuthenticationCredential": map[string]interface{}{
+			"AccessKeyId":        "ABCDE12345670EXAMPLE",
+			"RawSecretAccessKey": "DXjSUawnel2fr6SKC7G25CNxTyWKE5PF9XX6H/u9pSo=",
+		},
+	})
+}
+
+func (s *S) TestConnectCustomKeyStore(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.kms.ConnectCustomKeyStore("cks-1234567890abcdef0")
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.ConnectCustomKeyStore")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"CustomKeyStoreId": "cks-1234567890abcdef0"})
+}
+
+func (s *S) TestDescribeCustomKeyStores(c *check.C) {
+	testServer.Response(200, nil, DescribeCustomKeyStoresResponse)
+
+	resp, err := s.kms.DescribeCustomKeyStores(nil)
+	target, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "TrentService.DescribeCustomKeyStores")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{})
+	c.Assert(resp.Truncated, check.Equals, true)
+	c.Assert(resp.NextMarker, check.Equals, "eyJtYXJrZXIiOiIyIn0=")
+	c.Assert(resp.CustomKeyStores, check.HasLen, 2)
+
+	hsm := resp.CustomKeyStores[0]
+	c.Assert(hsm.CustomKeyStoreType, check.Equals, kms.CustomKeyStoreTypeCloudHSM)
+	c.Assert(hsm.ConnectionState, check.Equals, kms.ConnectionStateConnected)
+	c.Assert(hsm.CloudHsmClusterId, check.Equals, "cluster-1a23b4cdefg")
+	c.Assert(hsm.XksProxyConfiguration, check.IsNil)
+
+	xks := resp.CustomKeyStores[1]
+	c.Assert(xks.ConnectionState, check.Equals, kms.ConnectionStateFailed)
+	c.Assert(xks.ConnectionErrorCode, check.Equals, "XKS_PROXY_TIMED_OUT")
+	c.Assert(xks.XksProxyConfiguration, check.DeepEquals, &kms.XksProxyConfiguration{
+		AccessKeyId:  "ABCDE12345670EXAMPLE",
+		Connectivity: "PUBLIC_ENDPOINT",
+		UriEndpoint:  "https://myproxy.xks.example.com",
+		UriPath:      "/kms/xks/v1",
+	})
+}
+
+func (s *S) TestCreateKeyInExternalKeyStore(c *check.C) {
+	testServer.Response(200, nil, CreateKeyResponse)
+
+	_, err := s.kms.CreateKey(&kms.CreateKeyRequest{
+		Origin:           kms.OriginExternalKeyStore,
+		CustomKeyStoreId: "cks-9876543210fedcba9",
+		XksKeyId:         "bb8562717f809024",
+	})
+	_, body := testServer.WaitJSONRequest(c, "kms")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Origin":           "EXTERNAL_KEY_STORE",
+		"CustomKeyStoreId": "cks-9876543210fedcba9",
+		"XksKeyId":         "bb8562717f809024",
+	})
+}
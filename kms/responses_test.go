@@ -0,0 +1,127 @@
+package kms_test
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_ReEncrypt.html
+var ReEncryptResponse = `
+{
+  "CiphertextBlob": "bmV3IGNpcGhlcnRleHQ=",
+  "DestinationEncryptionAlgorithm": "SYMMETRIC_DEFAULT",
+  "KeyId": "arn:aws:kms:us-east-1:111122223333:key/0987dcba-09fe-87dc-65ba-ab0987654321",
+  "SourceEncryptionAlgorithm": "SYMMETRIC_DEFAULT",
+  "SourceKeyId": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_DeriveSharedSecret.html
+var DeriveSharedSecretResponse = `
+{
+  "KeyAgreementAlgorithm": "ECDH",
+  "KeyId": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+  "KeyOrigin": "AWS_KMS",
+  "SharedSecret": "c2hhcmVkIHNlY3JldA=="
+}
+`
+
+var ErrorResponse = `
+{
+  "__type": "NotFoundException",
+  "message": "Alias arn:aws:kms:us-east-1:111122223333:alias/missing is not found."
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_Encrypt.html
+var EncryptResponse = `
+{
+  "CiphertextBlob": "Y2lwaGVydGV4dA==",
+  "EncryptionAlgorithm": "SYMMETRIC_DEFAULT",
+  "KeyId": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_Decrypt.html
+var DecryptResponse = `
+{
+  "EncryptionAlgorithm": "SYMMETRIC_DEFAULT",
+  "KeyId": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+  "Plaintext": "cGxhaW50ZXh0"
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_GenerateDataKey.html
+var GenerateDataKeyResponse = `
+{
+  "CiphertextBlob": "ZW5jcnlwdGVkIGRhdGEga2V5",
+  "KeyId": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+  "Plaintext": "AAECAwQFBgcICQoLDA0ODxAREhMUFRYXGBkaGxwdHh8="
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateKey.html
+var CreateKeyResponse = `
+{
+  "KeyMetadata": {
+    "AWSAccountId": "111122223333",
+    "Arn": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+    "CreationDate": 1499988169.234,
+    "Description": "app secrets",
+    "Enabled": true,
+    "EncryptionAlgorithms": ["SYMMETRIC_DEFAULT"],
+    "KeyId": "1234abcd-12ab-34cd-56ef-1234567890ab",
+    "KeyManager": "CUSTOMER",
+    "KeySpec": "SYMMETRIC_DEFAULT",
+    "KeyState": "Enabled",
+    "KeyUsage": "ENCRYPT_DECRYPT",
+    "MultiRegion": false,
+    "Origin": "AWS_KMS"
+  }
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_ScheduleKeyDeletion.html
+var ScheduleKeyDeletionResponse = `
+{
+  "DeletionDate": 1.5011424E9,
+  "KeyId": "arn:aws:kms:us-east-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+  "KeyState": "PendingDeletion",
+  "PendingWindowInDays": 7
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_CreateCustomKeyStore.html
+var CreateCustomKeyStoreResponse = `
+{
+  "CustomKeyStoreId": "cks-1234567890abcdef0"
+}
+`
+
+// http://docs.aws.amazon.com/kms/latest/APIReference/API_DescribeCustomKeyStores.html
+var DescribeCustomKeyStoresResponse = `
+{
+  "CustomKeyStores": [
+    {
+      "CloudHsmClusterId": "cluster-1a23b4cdefg",
+      "ConnectionState": "CONNECTED",
+      "CreationDate": 1.499288695918E9,
+      "CustomKeyStoreId": "cks-1234567890abcdef0",
+      "CustomKeyStoreName": "ExampleKeyStore",
+      "CustomKeyStoreType": "AWS_CLOUDHSM",
+      "TrustAnchorCertificate": "<certificate appears here>"
+    },
+    {
+      "ConnectionErrorCode": "XKS_PROXY_TIMED_OUT",
+      "ConnectionState": "FAILED",
+      "CreationDate": 1.6693262E9,
+      "CustomKeyStoreId": "cks-9876543210fedcba9",
+      "CustomKeyStoreName": "ExampleExternalKeyStore",
+      "CustomKeyStoreType": "EXTERNAL_KEY_STORE",
+      "XksProxyConfiguration": {
+        "AccessKeyId": "ABCDE12345670EXAMPLE",
+        "Connectivity": "PUBLIC_ENDPOINT",
+        "UriEndpoint": "https://myproxy.xks.example.com",
+        "UriPath": "/kms/xks/v1"
+      }
+    }
+  ],
+  "NextMarker": "eyJtYXJrZXIiOiIyIn0=",
+  "Truncated": true
+}
+`
@@ -0,0 +1,105 @@
+package lambda
+
+import (
+	"net/url"
+)
+
+// AliasRoutingConfig sends a share of the invocations of an alias, from 0
+// to 1, to a second version.
+type AliasRoutingConfig struct {
+	AdditionalVersionWeights map[string]float64
+}
+
+// AliasConfiguration describes an alias of a function.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_AliasConfiguration.html
+type AliasConfiguration struct {
+	AliasArn        string
+	Name            string
+	FunctionVersion string
+	Description     string
+	RevisionId      string
+	RoutingConfig   *AliasRoutingConfig
+}
+
+// AliasRequest holds the parameters of CreateAlias and UpdateAlias. Name is
+// ignored by UpdateAlias, and RevisionId by CreateAlias.
+type AliasRequest struct {
+	Name            string              `json:",omitempty"`
+	FunctionVersion string              `json:",omitempty"`
+	Description     string              `json:",omitempty"`
+	RoutingConfig   *AliasRoutingConfig `json:",omitempty"`
+	RevisionId      string              `json:",omitempty"`
+}
+
+func aliasPath(functionName, name string) string {
+	return functionPath(functionName) + "/aliases/" + url.PathEscape(name)
+}
+
+// CreateAlias creates an alias pointing to a version of a function.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CreateAlias.html
+func (l *Lambda) CreateAlias(functionName string, req *AliasRequest) (resp *AliasConfiguration, err error) {
+	in := *req
+	in.RevisionId = ""
+	resp = new(AliasConfiguration)
+	if err := l.query("POST", functionPath(functionName)+"/aliases", nil, &in, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAlias returns an alias of a function.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetAlias.html
+func (l *Lambda) GetAlias(functionName, name string) (resp *AliasConfiguration, err error) {
+	resp = new(AliasConfiguration)
+	if err := l.query("GET", aliasPath(functionName, name), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateAlias points an alias to another version, or changes its
+// description or routing.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_UpdateAlias.html
+func (l *Lambda) UpdateAlias(functionName, name string, req *AliasRequest) (resp *AliasConfiguration, err error) {
+	in := *req
+	in.Name = ""
+	resp = new(AliasConfiguration)
+	if err := l.query("PUT", aliasPath(functionName, name), nil, &in, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteAlias deletes an alias of a function.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_DeleteAlias.html
+func (l *Lambda) DeleteAlias(functionName, name string) error {
+	return l.query("DELETE", aliasPath(functionName, name), nil, nil, nil)
+}
+
+type ListAliasesResp struct {
+	Aliases    []AliasConfiguration
+	NextMarker string
+}
+
+// ListAliases lists one page of the aliases of a function, only those
+// pointing to functionVersion if it is not "". Pass the NextMarker of a
+// response as marker to get the next page; marker and maxItems may be ""
+// and 0.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_ListAliases.html
+func (l *Lambda) ListAliases(functionName, functionVersion, marker string, maxItems int) (resp *ListAliasesResp, err error) {
+	params := pageParams(marker, maxItems)
+	if functionVersion != "" {
+		params.Set("FunctionVersion", functionVersion)
+	}
+	resp = new(ListAliasesResp)
+	if err := l.query("GET", functionPath(functionName)+"/aliases", params, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,151 @@
+package lambda
+
+import (
+	"net/url"
+)
+
+const (
+	UntrustedArtifactWarn    = "Warn"
+	UntrustedArtifactEnforce = "Enforce"
+)
+
+// AllowedPublishers lists the signing profiles allowed to sign code
+// deployed to functions using a code signing config.
+type AllowedPublishers struct {
+	SigningProfileVersionArns []string
+}
+
+// CodeSigningPolicies tells Lambda what to do when signature validation
+// of deployed code fails.
+type CodeSigningPolicies struct {
+	UntrustedArtifactOnDeployment string `json:",omitempty"`
+}
+
+// CodeSigningConfig is a set of publishers trusted to sign function code.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CodeSigningConfig.html
+type CodeSigningConfig struct {
+	CodeSigningConfigArn string
+	CodeSigningConfigId  string
+	Description          string
+	AllowedPublishers    AllowedPublishers
+	CodeSigningPolicies  CodeSigningPolicies
+	LastModified         string
+}
+
+// CodeSigningConfigRequest holds the parameters of CreateCodeSigningConfig
+// and UpdateCodeSigningConfig. When updating, nil fields are left unchanged.
+type CodeSigningConfigRequest struct {
+	Description         string               `json:",omitempty"`
+	AllowedPublishers   *AllowedPublishers   `json:",omitempty"`
+	CodeSigningPolicies *CodeSigningPolicies `json:",omitempty"`
+}
+
+type codeSigningConfigResp struct {
+	CodeSigningConfig CodeSigningConfig
+}
+
+func codeSigningConfigPath(arn string) string {
+	return "/2020-04-22/code-signing-configs/" + url.PathEscape(arn)
+}
+
+// CreateCodeSigningConfig creates a code signing config.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CreateCodeSigningConfig.html
+func (l *Lambda) CreateCodeSigningConfig(req *CodeSigningConfigRequest) (*CodeSigningConfig, error) {
+	resp := new(codeSigningConfigResp)
+	if err := l.query("POST", "/2020-04-22/code-signing-configs/", nil, req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.CodeSigningConfig, nil
+}
+
+// GetCodeSigningConfig returns a code signing config.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetCodeSigningConfig.html
+func (l *Lambda) GetCodeSigningConfig(arn string) (*CodeSigningConfig, error) {
+	resp := new(codeSigningConfigResp)
+	if err := l.query("GET", codeSigningConfigPath(arn), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return &resp.CodeSigningConfig, nil
+}
+
+// UpdateCodeSigningConfig changes a code signing config. The changes apply
+// the next time code is deployed to the functions using it.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_UpdateCodeSigningConfig.html
+func (l *Lambda) UpdateCodeSigningConfig(arn string, req *CodeSigningConfigRequest) (*CodeSigningConfig, error) {
+	resp := new(codeSigningConfigResp)
+	if err := l.query("PUT", codeSigningConfigPath(arn), nil, req, resp); err != nil {
+		return nil, err
+	}
+	return &resp.CodeSigningConfig, nil
+}
+
+// DeleteCodeSigningConfig deletes a code signing config. It fails if any
+// function still uses it.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_DeleteCodeSigningConfig.html
+func (l *Lambda) DeleteCodeSigningConfig(arn string) error {
+	return l.query("DELETE", codeSigningConfigPath(arn), nil, nil, nil)
+}
+
+type ListCodeSigningConfigsResp struct {
+	CodeSigningConfigs []CodeSigningConfig
+	NextMarker         string
+}
+
+// ListCodeSigningConfigs lists one page of the code signing configs. Pass
+// the NextMarker of a response as marker to get the next page; marker and
+// maxItems may be "" and 0.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_ListCodeSigningConfigs.html
+func (l *Lambda) ListCodeSigningConfigs(marker string, maxItems int) (resp *ListCodeSigningConfigsResp, err error) {
+	resp = new(ListCodeSigningConfigsResp)
+	if err := l.query("GET", "/2020-04-22/code-signing-configs/", pageParams(marker, maxItems), nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type FunctionCodeSigningConfig struct {
+	CodeSigningConfigArn string
+	FunctionName         string `json:",omitempty"`
+}
+
+func functionCodeSigningConfigPath(functionName string) string {
+	return "/2020-06-30/functions/" + url.PathEscape(functionName) + "/code-signing-config"
+}
+
+// PutFunctionCodeSigningConfig makes a function use a code signing config.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_PutFunctionCodeSigningConfig.html
+func (l *Lambda) PutFunctionCodeSigningConfig(functionName, codeSigningConfigArn string) (resp *FunctionCodeSigningConfig, err error) {
+	req := &FunctionCodeSigningConfig{CodeSigningConfigArn: codeSigningConfigArn}
+	resp = new(FunctionCodeSigningConfig)
+	if err := l.query("PUT", functionCodeSigningConfigPath(functionName), nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetFunctionCodeSigningConfig returns the code signing config used by a
+// function. CodeSigningConfigArn is empty if it has none.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetFunctionCodeSigningConfig.html
+func (l *Lambda) GetFunctionCodeSigningConfig(functionName string) (resp *FunctionCodeSigningConfig, err error) {
+	resp = new(FunctionCodeSigningConfig)
+	if err := l.query("GET", functionCodeSigningConfigPath(functionName), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteFunctionCodeSigningConfig stops a function from using its code
+// signing config.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_DeleteFunctionCodeSigningConfig.html
+func (l *Lambda) DeleteFunctionCodeSigningConfig(functionName string) error {
+	return l.query("DELETE", functionCodeSigningConfigPath(functionName), nil, nil, nil)
+}
@@ -0,0 +1,167 @@
+package lambda
+
+import (
+	"net/url"
+)
+
+// Positions of a Kinesis or DynamoDB stream at which an event source
+// mapping starts reading. AT_TIMESTAMP needs StartingPositionTimestamp.
+const (
+	StartingPositionTrimHorizon = "TRIM_HORIZON"
+	StartingPositionLatest      = "LATEST"
+	StartingPositionAtTimestamp = "AT_TIMESTAMP"
+)
+
+// FunctionResponseTypeReportBatchItemFailures lets a function return the
+// records of a batch that failed, in which case only those and the records
+// after them are retried.
+const FunctionResponseTypeReportBatchItemFailures = "ReportBatchItemFailures"
+
+// OnFailure is the destination, the ARN of an SQS queue or SNS topic,
+// receiving the details of the batches discarded after the retries of an
+// event source mapping are exhausted.
+type OnFailure struct {
+	Destination string
+}
+
+type DestinationConfig struct {
+	OnFailure *OnFailure `json:",omitempty"`
+}
+
+// EventSourceMappingRequest holds the parameters of
+// CreateEventSourceMapping and UpdateEventSourceMapping. EventSourceArn,
+// StartingPosition and StartingPositionTimestamp are ignored by
+// UpdateEventSourceMapping.
+//
+// The pointer fields are left unchanged by UpdateEventSourceMapping when
+// nil, as their zero values are meaningful: a MaximumRetryAttempts of 0
+// discards a failed batch right away, while -1, the default, retries it
+// until its records expire. BisectBatchOnFunctionError splits a failed
+// batch in two before retrying it, which isolates the record making the
+// function fail. The retry and failure settings only apply to stream
+// sources; failed SQS messages are retried by the queue's redrive policy.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CreateEventSourceMapping.html
+type EventSourceMappingRequest struct {
+	FunctionName                   string             `json:",omitempty"`
+	EventSourceArn                 string             `json:",omitempty"`
+	Enabled                        *bool              `json:",omitempty"`
+	StartingPosition               string             `json:",omitempty"`
+	StartingPositionTimestamp      float64            `json:",omitempty"` // Seconds since the epoch
+	BatchSize                      int                `json:",omitempty"`
+	MaximumBatchingWindowInSeconds *int               `json:",omitempty"`
+	ParallelizationFactor          int                `json:",omitempty"`
+	BisectBatchOnFunctionError     *bool              `json:",omitempty"`
+	MaximumRetryAttempts           *int               `json:",omitempty"`
+	MaximumRecordAgeInSeconds      int                `json:",omitempty"` // -1 or 60 to 604800
+	DestinationConfig              *DestinationConfig `json:",omitempty"`
+	FunctionResponseTypes          []string           `json:",omitempty"`
+}
+
+// EventSourceMappingConfiguration describes an event source mapping, which
+// invokes a function with batches of records read from a stream or queue.
+// State is "Creating", "Enabling", "Enabled", "Disabling", "Disabled",
+// "Updating" or "Deleting". LastModified is in seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_EventSourceMappingConfiguration.html
+type EventSourceMappingConfiguration struct {
+	UUID                           string
+	EventSourceArn                 string
+	FunctionArn                    string
+	State                          string
+	StateTransitionReason          string
+	LastModified                   float64
+	LastProcessingResult           string
+	StartingPosition               string
+	StartingPositionTimestamp      float64
+	BatchSize                      int
+	MaximumBatchingWindowInSeconds int
+	ParallelizationFactor          int
+	BisectBatchOnFunctionError     bool
+	MaximumRetryAttempts           int
+	MaximumRecordAgeInSeconds      int
+	DestinationConfig              *DestinationConfig
+	FunctionResponseTypes          []string
+}
+
+func eventSourceMappingPath(uuid string) string {
+	return "/2015-03-31/event-source-mappings/" + url.PathEscape(uuid)
+}
+
+// CreateEventSourceMapping maps an event source to a function. The mapping
+// is in the Creating state until it starts polling the source.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CreateEventSourceMapping.html
+func (l *Lambda) CreateEventSourceMapping(req *EventSourceMappingRequest) (resp *EventSourceMappingConfiguration, err error) {
+	resp = new(EventSourceMappingConfiguration)
+	if err := l.query("POST", "/2015-03-31/event-source-mappings/", nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetEventSourceMapping returns an event source mapping.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetEventSourceMapping.html
+func (l *Lambda) GetEventSourceMapping(uuid string) (resp *EventSourceMappingConfiguration, err error) {
+	resp = new(EventSourceMappingConfiguration)
+	if err := l.query("GET", eventSourceMappingPath(uuid), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateEventSourceMapping changes the function, batching or failure
+// handling of an event source mapping, or enables or disables it. Only the
+// fields of req that are set are changed.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_UpdateEventSourceMapping.html
+func (l *Lambda) UpdateEventSourceMapping(uuid string, req *EventSourceMappingRequest) (resp *EventSourceMappingConfiguration, err error) {
+	in := *req
+	in.EventSourceArn = ""
+	in.StartingPosition = ""
+	in.StartingPositionTimestamp = 0
+	resp = new(EventSourceMappingConfiguration)
+	if err := l.query("PUT", eventSourceMappingPath(uuid), nil, &in, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteEventSourceMapping deletes an event source mapping. The returned
+// mapping is in the Deleting state.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_DeleteEventSourceMapping.html
+func (l *Lambda) DeleteEventSourceMapping(uuid string) (resp *EventSourceMappingConfiguration, err error) {
+	resp = new(EventSourceMappingConfiguration)
+	if err := l.query("DELETE", eventSourceMappingPath(uuid), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type ListEventSourceMappingsResp struct {
+	EventSourceMappings []EventSourceMappingConfiguration
+	NextMarker          string
+}
+
+// ListEventSourceMappings lists one page of the event source mappings of
+// a function or of an event source, or of both if both are not "". Pass
+// the NextMarker of a response as marker to get the next page; marker and
+// maxItems may be "" and 0.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_ListEventSourceMappings.html
+func (l *Lambda) ListEventSourceMappings(functionName, eventSourceArn, marker string, maxItems int) (resp *ListEventSourceMappingsResp, err error) {
+	params := pageParams(marker, maxItems)
+	if functionName != "" {
+		params.Set("FunctionName", functionName)
+	}
+	if eventSourceArn != "" {
+		params.Set("EventSourceArn", eventSourceArn)
+	}
+	resp = new(ListEventSourceMappingsResp)
+	if err := l.query("GET", "/2015-03-31/event-source-mappings/", params, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
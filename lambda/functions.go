@@ -0,0 +1,202 @@
+package lambda
+
+import (
+	"net/url"
+)
+
+const (
+	PackageTypeZip   = "Zip"
+	PackageTypeImage = "Image"
+)
+
+// States of a function. A function can only be invoked once Active, and its
+// code or configuration updated once its LastUpdateStatus is no longer
+// "InProgress".
+const (
+	StatePending  = "Pending"
+	StateActive   = "Active"
+	StateInactive = "Inactive"
+	StateFailed   = "Failed"
+)
+
+// FunctionCode is the code of a function, either uploaded as a zip file,
+// read from S3 or, for PackageTypeImage, a container image URI.
+type FunctionCode struct {
+	S3Bucket        string `json:",omitempty"`
+	S3Key           string `json:",omitempty"`
+	S3ObjectVersion string `json:",omitempty"`
+	ZipFile         []byte `json:",omitempty"`
+	ImageUri        string `json:",omitempty"`
+}
+
+type Environment struct {
+	Variables map[string]string
+}
+
+// CreateFunctionRequest holds the parameters of CreateFunction. Runtime and
+// Handler are required for zip packages. Publish also publishes the first
+// version of the function.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CreateFunction.html
+type CreateFunctionRequest struct {
+	FunctionName  string
+	Role          string
+	Code          FunctionCode
+	Runtime       string            `json:",omitempty"`
+	Handler       string            `json:",omitempty"`
+	PackageType   string            `json:",omitempty"`
+	Description   string            `json:",omitempty"`
+	Timeout       int               `json:",omitempty"`
+	MemorySize    int               `json:",omitempty"`
+	Publish       bool              `json:",omitempty"`
+	Environment   *Environment      `json:",omitempty"`
+	Architectures []string          `json:",omitempty"`
+	Layers        []string          `json:",omitempty"`
+	Tags          map[string]string `json:",omitempty"`
+}
+
+// FunctionConfiguration describes a version of a function. FunctionArn is
+// qualified with the version for published versions.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_FunctionConfiguration.html
+type FunctionConfiguration struct {
+	FunctionName     string
+	FunctionArn      string
+	Runtime          string
+	Role             string
+	Handler          string
+	PackageType      string
+	CodeSize         int64
+	CodeSha256       string
+	Description      string
+	Timeout          int
+	MemorySize       int
+	LastModified     string
+	Version          string
+	RevisionId       string
+	State            string
+	StateReason      string
+	LastUpdateStatus string
+	Environment      *Environment
+	Architectures    []string
+}
+
+func functionPath(functionName string) string {
+	return "/2015-03-31/functions/" + url.PathEscape(functionName)
+}
+
+// qualifierParams returns the query parameters selecting a version or alias
+// of a function, or the unpublished version if qualifier is "".
+func qualifierParams(qualifier string) url.Values {
+	params := url.Values{}
+	if qualifier != "" {
+		params.Set("Qualifier", qualifier)
+	}
+	return params
+}
+
+// CreateFunction creates a function. The function is in the Pending state
+// until its resources are ready; see GetFunctionConfiguration.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_CreateFunction.html
+func (l *Lambda) CreateFunction(req *CreateFunctionRequest) (resp *FunctionConfiguration, err error) {
+	resp = new(FunctionConfiguration)
+	if err := l.query("POST", "/2015-03-31/functions", nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetFunctionConfiguration returns the configuration of a version or alias
+// of a function, or of its unpublished version if qualifier is "".
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetFunctionConfiguration.html
+func (l *Lambda) GetFunctionConfiguration(functionName, qualifier string) (resp *FunctionConfiguration, err error) {
+	resp = new(FunctionConfiguration)
+	if err := l.query("GET", functionPath(functionName)+"/configuration", qualifierParams(qualifier), nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateFunctionCodeRequest holds the parameters of UpdateFunctionCode.
+// The code is given as in FunctionCode. RevisionId, if set, makes the
+// update fail if the function changed since it was read.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_UpdateFunctionCode.html
+type UpdateFunctionCodeRequest struct {
+	S3Bucket        string   `json:",omitempty"`
+	S3Key           string   `json:",omitempty"`
+	S3ObjectVersion string   `json:",omitempty"`
+	ZipFile         []byte   `json:",omitempty"`
+	ImageUri        string   `json:",omitempty"`
+	Architectures   []string `json:",omitempty"`
+	Publish         bool     `json:",omitempty"`
+	DryRun          bool     `json:",omitempty"`
+	RevisionId      string   `json:",omitempty"`
+}
+
+// UpdateFunctionCode replaces the code of the unpublished version of a
+// function, optionally publishing a new version.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_UpdateFunctionCode.html
+func (l *Lambda) UpdateFunctionCode(functionName string, req *UpdateFunctionCodeRequest) (resp *FunctionConfiguration, err error) {
+	resp = new(FunctionConfiguration)
+	if err := l.query("PUT", functionPath(functionName)+"/code", nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteFunction deletes a version of a function, or the function and all
+// of its versions and aliases if qualifier is "".
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_DeleteFunction.html
+func (l *Lambda) DeleteFunction(functionName, qualifier string) error {
+	return l.query("DELETE", functionPath(functionName), qualifierParams(qualifier), nil, nil)
+}
+
+// PublishVersionRequest holds the optional parameters of PublishVersion.
+// CodeSha256, if set, makes publishing fail unless it matches the code of
+// the unpublished version.
+type PublishVersionRequest struct {
+	CodeSha256  string `json:",omitempty"`
+	Description string `json:",omitempty"`
+	RevisionId  string `json:",omitempty"`
+}
+
+// PublishVersion publishes a version from the current code and
+// configuration of a function. The FunctionArn of the returned version is
+// qualified with its number, as required by the Lambda@Edge associations of
+// CloudFront cache behaviors, which can't use $LATEST or an alias. req may
+// be nil.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_PublishVersion.html
+func (l *Lambda) PublishVersion(functionName string, req *PublishVersionRequest) (resp *FunctionConfiguration, err error) {
+	if req == nil {
+		req = &PublishVersionRequest{}
+	}
+	resp = new(FunctionConfiguration)
+	if err := l.query("POST", functionPath(functionName)+"/versions", nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type ListVersionsByFunctionResp struct {
+	Versions   []FunctionConfiguration
+	NextMarker string
+}
+
+// ListVersionsByFunction lists one page of the versions of a function,
+// including $LATEST. Pass the NextMarker of a response as marker to get the
+// next page; marker and maxItems may be "" and 0.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_ListVersionsByFunction.html
+func (l *Lambda) ListVersionsByFunction(functionName, marker string, maxItems int) (resp *ListVersionsByFunctionResp, err error) {
+	resp = new(ListVersionsByFunctionResp)
+	if err := l.query("GET", functionPath(functionName)+"/versions", pageParams(marker, maxItems), nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+package lambda
+
+import (
+	"encoding/base64"
+	"net/http"
+)
+
+// Values of InvokeRequest.InvocationType.
+const (
+	InvocationTypeRequestResponse = "RequestResponse"
+	InvocationTypeEvent           = "Event"
+	InvocationTypeDryRun          = "DryRun"
+)
+
+// InvokeRequest holds the parameters of Invoke. InvocationType defaults to
+// InvocationTypeRequestResponse, which waits for the function to return.
+// With InvocationTypeEvent the function is queued and run asynchronously.
+// TailLog returns the last 4 KB of the logs of a synchronous invocation.
+// ClientContext is JSON passed to the function, for synchronous
+// invocations only.
+type InvokeRequest struct {
+	Qualifier      string
+	InvocationType string
+	TailLog        bool
+	ClientContext  string
+	Payload        []byte
+}
+
+// InvokeResponse holds the result of Invoke. FunctionError is "Unhandled"
+// if the function failed, in which case Payload describes the error.
+type InvokeResponse struct {
+	StatusCode      int
+	FunctionError   string
+	ExecutedVersion string
+	LogResult       string
+	Payload         []byte
+}
+
+// Invoke runs a function with a JSON payload.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html
+func (l *Lambda) Invoke(functionName string, req *InvokeRequest) (resp *InvokeResponse, err error) {
+	header := http.Header{}
+	if req.InvocationType != "" {
+		header.Set("X-Amz-Invocation-Type", req.InvocationType)
+	}
+	if req.TailLog {
+		header.Set("X-Amz-Log-Type", "Tail")
+	}
+	if req.ClientContext != "" {
+		header.Set("X-Amz-Client-Context", base64.StdEncoding.EncodeToString([]byte(req.ClientContext)))
+	}
+	payload := req.Payload
+	if payload == nil {
+		payload = []byte{}
+	}
+	path := functionPath(functionName) + "/invocations"
+	hresp, body, err := l.send("POST", path, qualifierParams(req.Qualifier), header, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &InvokeResponse{
+		StatusCode:      hresp.StatusCode,
+		FunctionError:   hresp.Header.Get("X-Amz-Function-Error"),
+		ExecutedVersion: hresp.Header.Get("X-Amz-Executed-Version"),
+		Payload:         body,
+	}
+	if log := hresp.Header.Get("X-Amz-Log-Result"); log != "" {
+		b, err := base64.StdEncoding.DecodeString(log)
+		if err != nil {
+			return nil, err
+		}
+		resp.LogResult = string(b)
+	}
+	return resp, nil
+}
@@ -0,0 +1,152 @@
+// Package lambda provides types and functions to interact with AWS Lambda.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_Reference.html
+package lambda
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type Lambda struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *Lambda {
+	return &Lambda{Auth: auth, Region: region}
+}
+
+// Error is an error returned by the Lambda API.
+type Error struct {
+	StatusCode int    // HTTP status code (200, 403, ...)
+	Code       string // Lambda error code ("ResourceNotFoundException", ...)
+	Type       string // "User" or "Service"
+	Message    string `json:"message"`
+	RequestId  string
+}
+
+func (err *Error) Error() string {
+	if err.Code == "" {
+		return err.Message
+	}
+	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
+}
+
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
+// query sends a request to path, which starts with the API version of the
+// action. in is encoded as the JSON body of the request if not nil, and the
+// JSON response is decoded into out if not nil.
+func (l *Lambda) query(method, path string, params url.Values, in, out interface{}) error {
+	var body []byte
+	header := http.Header{}
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = b
+		header.Set("Content-Type", "application/json")
+	}
+	_, respBody, err := l.send(method, path, params, header, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(respBody) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBody, out)
+}
+
+// send sends a request with the given headers and raw body, which may be
+// nil, and returns the response along with its body.
+func (l *Lambda) send(method, path string, params url.Values, header http.Header, body []byte) (*http.Response, []byte, error) {
+	u := l.Region.LambdaEndpoint + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	hreq, err := http.NewRequest(method, u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	for k, v := range header {
+		hreq.Header[k] = v
+	}
+	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(aws.ISO8601BasicFormat))
+	if l.Auth.Token() != "" {
+		hreq.Header.Set("X-Amz-Security-Token", l.Auth.Token())
+	}
+
+	signer := aws.NewV4Signer(l.Auth, "lambda", l.Region)
+	signer.Sign(hreq)
+
+	client := l.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(hreq)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Lambda answers 200, 201, 202 or 204 depending on the action.
+	if resp.StatusCode/100 != 2 {
+		return nil, nil, buildError(resp, respBody)
+	}
+	return resp, respBody, nil
+}
+
+func buildError(r *http.Response, body []byte) error {
+	err := &Error{
+		StatusCode: r.StatusCode,
+		Code:       r.Header.Get("X-Amzn-ErrorType"),
+		RequestId:  r.Header.Get("X-Amzn-RequestId"),
+	}
+	json.Unmarshal(body, err)
+	// The error type header may be followed by ":" and a URL.
+	if i := strings.Index(err.Code, ":"); i >= 0 {
+		err.Code = err.Code[:i]
+	}
+	if err.Message == "" {
+		err.Message = r.Status
+	}
+	return err
+}
+
+// pageParams returns the query parameters selecting a page of a list.
+func pageParams(marker string, maxItems int) url.Values {
+	params := url.Values{}
+	if marker != "" {
+		params.Set("Marker", marker)
+	}
+	if maxItems > 0 {
+		params.Set("MaxItems", fmt.Sprint(maxItems))
+	}
+	return params
+}
@@ -0,0 +1,495 @@
+package lambda_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/lambda"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+var testServer = testutil.NewHTTPServer()
+
+type S struct {
+	lambda *lambda.Lambda
+}
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.lambda = lambda.New(auth, aws.Region{Name: "us-east-1", LambdaEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func readJSON(c *check.C, body []byte) map[string]interface{} {
+	var v map[string]interface{}
+	c.Assert(json.Unmarshal(body, &v), check.IsNil)
+	return v
+}
+
+func (s *S) TestPublishLayerVersion(c *check.C) {
+	testServer.Response(201, nil, PublishLayerVersionJSON)
+
+	resp, err := s.lambda.PublishLayerVersion("deps", &lambda.PublishLayerVersionRequest{
+		Content:            lambda.LayerContent{ZipFile: []byte("zip")},
+		Description:        "Shared dependencies",
+		CompatibleRuntimes: []string{"go1.x"},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2018-10-31/layers/deps/versions")
+	c.Assert(req.Header.Get("Authorization"), check.Matches, "AWS4-HMAC-SHA256 Credential=abc/[0-9]{8}/us-east-1/lambda/aws4_request, .*")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"Content":            map[string]interface{}{"ZipFile": "emlw"},
+		"Description":        "Shared dependencies",
+		"CompatibleRuntimes": []interface{}{"go1.x"},
+	})
+
+	c.Assert(resp.Version, check.Equals, int64(3))
+	c.Assert(resp.LayerVersionArn, check.Equals, "arn:aws:lambda:us-east-1:123456789012:layer:deps:3")
+	c.Assert(resp.Content.CodeSize, check.Equals, int64(169))
+	c.Assert(resp.CompatibleArchitectures, check.DeepEquals, []string{lambda.ArchitectureX86_64})
+}
+
+func (s *S) TestListLayerVersions(c *check.C) {
+	testServer.Response(200, nil, ListLayerVersionsJSON)
+
+	resp, err := s.lambda.ListLayerVersions("deps", &lambda.ListLayerVersionsOptions{
+		CompatibleRuntime: "go1.x",
+		MaxItems:          2,
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/2018-10-31/layers/deps/versions")
+	c.Assert(req.Form.Get("CompatibleRuntime"), check.Equals, "go1.x")
+	c.Assert(req.Form.Get("MaxItems"), check.Equals, "2")
+	c.Assert(req.Form.Get("Marker"), check.Equals, "")
+
+	c.Assert(resp.LayerVersions, check.HasLen, 2)
+	c.Assert(resp.LayerVersions[1].Version, check.Equals, int64(2))
+	c.Assert(resp.NextMarker, check.Equals, "marker2")
+}
+
+func (s *S) TestDeleteLayerVersion(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.lambda.DeleteLayerVersion("deps", 2)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/2018-10-31/layers/deps/versions/2")
+}
+
+func (s *S) TestGetLayerVersionError(c *check.C) {
+	headers := map[string]string{"X-Amzn-ErrorType": "ResourceNotFoundException"}
+	testServer.Response(404, headers, ResourceNotFoundJSON)
+
+	_, err := s.lambda.GetLayerVersion("deps", 9)
+	testServer.WaitRequest()
+	c.Assert(err, check.NotNil)
+	lerr := err.(*lambda.Error)
+	c.Assert(lerr.StatusCode, check.Equals, 404)
+	c.Assert(lerr.Code, check.Equals, "ResourceNotFoundException")
+	c.Assert(lerr.Type, check.Equals, "User")
+	c.Assert(lerr.Message, check.Equals, "Layer version arn:aws:lambda:us-east-1:123456789012:layer:deps:9 does not exist.")
+}
+
+func (s *S) TestCreateCodeSigningConfig(c *check.C) {
+	testServer.Response(201, nil, CodeSigningConfigJSON)
+
+	csc, err := s.lambda.CreateCodeSigningConfig(&lambda.CodeSigningConfigRequest{
+		Description: "Production signing",
+		AllowedPublishers: &lambda.AllowedPublishers{
+			SigningProfileVersionArns: []string{"arn:aws:signer:us-east-1:123456789012:/signing-profiles/prod/abcdef1234"},
+		},
+		CodeSigningPolicies: &lambda.CodeSigningPolicies{UntrustedArtifactOnDeployment: lambda.UntrustedArtifactEnforce},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2020-04-22/code-signing-configs/")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"Description": "Production signing",
+		"AllowedPublishers": map[string]interface{}{
+			"SigningProfileVersionArns": []interface{}{"arn:aws:signer:us-east-1:123456789012:/signing-profiles/prod/abcdef1234"},
+		},
+		"CodeSigningPolicies": map[string]interface{}{"UntrustedArtifactOnDeployment": "Enforce"},
+	})
+
+	c.Assert(csc.CodeSigningConfigId, check.Equals, "csc-0f6a7c4c9b8d2e1f0")
+	c.Assert(csc.CodeSigningPolicies.UntrustedArtifactOnDeployment, check.Equals, "Enforce")
+}
+
+func (s *S) TestUpdateCodeSigningConfig(c *check.C) {
+	testServer.Response(200, nil, CodeSigningConfigJSON)
+
+	arn := "arn:aws:lambda:us-east-1:123456789012:code-signing-config:csc-0f6a7c4c9b8d2e1f0"
+	_, err := s.lambda.UpdateCodeSigningConfig(arn, &lambda.CodeSigningConfigRequest{
+		CodeSigningPolicies: &lambda.CodeSigningPolicies{UntrustedArtifactOnDeployment: lambda.UntrustedArtifactWarn},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/2020-04-22/code-signing-configs/"+arn)
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"CodeSigningPolicies": map[string]interface{}{"UntrustedArtifactOnDeployment": "Warn"},
+	})
+}
+
+func (s *S) TestPutFunctionCodeSigningConfig(c *check.C) {
+	testServer.Response(200, nil, FunctionCodeSigningConfigJSON)
+
+	arn := "arn:aws:lambda:us-east-1:123456789012:code-signing-config:csc-0f6a7c4c9b8d2e1f0"
+	resp, err := s.lambda.PutFunctionCodeSigningConfig("deployer", arn)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/2020-06-30/functions/deployer/code-signing-config")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body)["CodeSigningConfigArn"], check.Equals, arn)
+	c.Assert(resp.FunctionName, check.Equals, "deployer")
+}
+
+func (s *S) TestDeleteFunctionCodeSigningConfig(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.lambda.DeleteFunctionCodeSigningConfig("deployer")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/2020-06-30/functions/deployer/code-signing-config")
+}
+
+func (s *S) TestCreateFunction(c *check.C) {
+	testServer.Response(201, nil, FunctionConfigurationJSON)
+
+	resp, err := s.lambda.CreateFunction(&lambda.CreateFunctionRequest{
+		FunctionName: "edge-auth",
+		Role:         "arn:aws:iam::123456789012:role/edge-auth",
+		Code:         lambda.FunctionCode{S3Bucket: "deploys", S3Key: "edge-auth.zip"},
+		Runtime:      "nodejs18.x",
+		Handler:      "index.handler",
+		Timeout:      5,
+		Environment:  &lambda.Environment{Variables: map[string]string{"STAGE": "prod"}},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"FunctionName": "edge-auth",
+		"Role":         "arn:aws:iam::123456789012:role/edge-auth",
+		"Code":         map[string]interface{}{"S3Bucket": "deploys", "S3Key": "edge-auth.zip"},
+		"Runtime":      "nodejs18.x",
+		"Handler":      "index.handler",
+		"Timeout":      5.0,
+		"Environment":  map[string]interface{}{"Variables": map[string]interface{}{"STAGE": "prod"}},
+	})
+
+	c.Assert(resp.FunctionArn, check.Equals, "arn:aws:lambda:us-east-1:123456789012:function:edge-auth")
+	c.Assert(resp.State, check.Equals, lambda.StatePending)
+	c.Assert(resp.Version, check.Equals, "$LATEST")
+	c.Assert(resp.CodeSize, check.Equals, int64(5797206))
+}
+
+func (s *S) TestUpdateFunctionCode(c *check.C) {
+	testServer.Response(200, nil, FunctionConfigurationJSON)
+
+	_, err := s.lambda.UpdateFunctionCode("edge-auth", &lambda.UpdateFunctionCodeRequest{
+		ZipFile:    []byte("zip"),
+		RevisionId: "7f1d1b5e-7a9b-4c53-9b2f-3e8f9b1c2d4a",
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/code")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"ZipFile":    "emlw",
+		"RevisionId": "7f1d1b5e-7a9b-4c53-9b2f-3e8f9b1c2d4a",
+	})
+}
+
+func (s *S) TestGetFunctionConfiguration(c *check.C) {
+	testServer.Response(200, nil, PublishVersionJSON)
+
+	resp, err := s.lambda.GetFunctionConfiguration("edge-auth", "3")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/configuration")
+	c.Assert(req.URL.Query().Get("Qualifier"), check.Equals, "3")
+	c.Assert(resp.State, check.Equals, lambda.StateActive)
+}
+
+func (s *S) TestPublishVersion(c *check.C) {
+	testServer.Response(201, nil, PublishVersionJSON)
+
+	resp, err := s.lambda.PublishVersion("edge-auth", &lambda.PublishVersionRequest{
+		CodeSha256: "YFgDgEKG3ugvF1+pX64gV6tu9qNuIYNUdgJm8nCxsm4=",
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/versions")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"CodeSha256": "YFgDgEKG3ugvF1+pX64gV6tu9qNuIYNUdgJm8nCxsm4=",
+	})
+	c.Assert(resp.Version, check.Equals, "3")
+	c.Assert(resp.FunctionArn, check.Equals, "arn:aws:lambda:us-east-1:123456789012:function:edge-auth:3")
+}
+
+func (s *S) TestCreateAlias(c *check.C) {
+	testServer.Response(201, nil, AliasConfigurationJSON)
+
+	resp, err := s.lambda.CreateAlias("edge-auth", &lambda.AliasRequest{
+		Name:            "live",
+		FunctionVersion: "3",
+		RoutingConfig:   &lambda.AliasRoutingConfig{AdditionalVersionWeights: map[string]float64{"4": 0.1}},
+		RevisionId:      "ignored",
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/aliases")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"Name":            "live",
+		"FunctionVersion": "3",
+		"RoutingConfig":   map[string]interface{}{"AdditionalVersionWeights": map[string]interface{}{"4": 0.1}},
+	})
+	c.Assert(resp.AliasArn, check.Equals, "arn:aws:lambda:us-east-1:123456789012:function:edge-auth:live")
+	c.Assert(resp.RoutingConfig.AdditionalVersionWeights, check.DeepEquals, map[string]float64{"4": 0.1})
+}
+
+func (s *S) TestUpdateAlias(c *check.C) {
+	testServer.Response(200, nil, AliasConfigurationJSON)
+
+	_, err := s.lambda.UpdateAlias("edge-auth", "live", &lambda.AliasRequest{Name: "ignored", FunctionVersion: "4"})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/aliases/live")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{"FunctionVersion": "4"})
+}
+
+func (s *S) TestAddPermission(c *check.C) {
+	testServer.Response(201, nil, AddPermissionJSON)
+
+	resp, err := s.lambda.AddPermission("edge-auth", &lambda.AddPermissionRequest{
+		StatementId:   "s3-invoke",
+		Action:        "lambda:InvokeFunction",
+		Principal:     "s3.amazonaws.com",
+		SourceAccount: "123456789012",
+		Qualifier:     "live",
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/policy")
+	c.Assert(req.URL.Query().Get("Qualifier"), check.Equals, "live")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"StatementId":   "s3-invoke",
+		"Action":        "lambda:InvokeFunction",
+		"Principal":     "s3.amazonaws.com",
+		"SourceAccount": "123456789012",
+	})
+	c.Assert(readJSON(c, []byte(resp.Statement))["Sid"], check.Equals, "s3-invoke")
+}
+
+func (s *S) TestRemovePermission(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	err := s.lambda.RemovePermission("edge-auth", "s3-invoke", "")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/policy/s3-invoke")
+	c.Assert(req.URL.RawQuery, check.Equals, "")
+}
+
+func (s *S) TestInvoke(c *check.C) {
+	headers := map[string]string{
+		"X-Amz-Executed-Version": "3",
+		"X-Amz-Log-Result":       "U1RBUlQgUmVxdWVzdElkOiAxCkVORCBSZXF1ZXN0SWQ6IDEK",
+	}
+	testServer.Response(200, headers, `{"status":"ok"}`)
+
+	resp, err := s.lambda.Invoke("edge-auth", &lambda.InvokeRequest{
+		Qualifier:     "live",
+		TailLog:       true,
+		ClientContext: `{"custom":{"app":"cli"}}`,
+		Payload:       []byte(`{"path":"/"}`),
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/functions/edge-auth/invocations")
+	c.Assert(req.URL.Query().Get("Qualifier"), check.Equals, "live")
+	c.Assert(req.Header.Get("X-Amz-Log-Type"), check.Equals, "Tail")
+	c.Assert(req.Header.Get("X-Amz-Invocation-Type"), check.Equals, "")
+	c.Assert(req.Header.Get("X-Amz-Client-Context"), check.Equals, "eyJjdXN0b20iOnsiYXBwIjoiY2xpIn19")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(string(body), check.Equals, `{"path":"/"}`)
+
+	c.Assert(resp.StatusCode, check.Equals, 200)
+	c.Assert(resp.FunctionError, check.Equals, "")
+	c.Assert(resp.ExecutedVersion, check.Equals, "3")
+	c.Assert(resp.LogResult, check.Equals, "START RequestId: 1\nEND RequestId: 1\n")
+	c.Assert(string(resp.Payload), check.Equals, `{"status":"ok"}`)
+}
+
+func (s *S) TestInvokeEvent(c *check.C) {
+	testServer.Response(202, nil, "")
+
+	resp, err := s.lambda.Invoke("edge-auth", &lambda.InvokeRequest{
+		InvocationType: lambda.InvocationTypeEvent,
+		Payload:        []byte(`{}`),
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Header.Get("X-Amz-Invocation-Type"), check.Equals, "Event")
+	c.Assert(resp.StatusCode, check.Equals, 202)
+}
+
+func (s *S) TestInvokeFunctionError(c *check.C) {
+	headers := map[string]string{"X-Amz-Function-Error": "Unhandled"}
+	testServer.Response(200, headers, `{"errorMessage":"boom","errorType":"Error"}`)
+
+	resp, err := s.lambda.Invoke("edge-auth", &lambda.InvokeRequest{})
+	testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(resp.FunctionError, check.Equals, "Unhandled")
+	c.Assert(readJSON(c, resp.Payload)["errorMessage"], check.Equals, "boom")
+}
+
+func (s *S) TestCreateEventSourceMapping(c *check.C) {
+	testServer.Response(202, nil, EventSourceMappingJSON)
+
+	retries, window, bisect := 3, 5, true
+	resp, err := s.lambda.CreateEventSourceMapping(&lambda.EventSourceMappingRequest{
+		FunctionName:                   "click-processor",
+		EventSourceArn:                 "arn:aws:kinesis:us-east-1:123456789012:stream/clicks",
+		StartingPosition:               lambda.StartingPositionLatest,
+		BatchSize:                      500,
+		MaximumBatchingWindowInSeconds: &window,
+		BisectBatchOnFunctionError:     &bisect,
+		MaximumRetryAttempts:           &retries,
+		MaximumRecordAgeInSeconds:      3600,
+		DestinationConfig: &lambda.DestinationConfig{
+			OnFailure: &lambda.OnFailure{Destination: "arn:aws:sqs:us-east-1:123456789012:click-processor-dlq"},
+		},
+		FunctionResponseTypes: []string{lambda.FunctionResponseTypeReportBatchItemFailures},
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/event-source-mappings/")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"FunctionName":                   "click-processor",
+		"EventSourceArn":                 "arn:aws:kinesis:us-east-1:123456789012:stream/clicks",
+		"StartingPosition":               "LATEST",
+		"BatchSize":                      500.0,
+		"MaximumBatchingWindowInSeconds": 5.0,
+		"BisectBatchOnFunctionError":     true,
+		"MaximumRetryAttempts":           3.0,
+		"MaximumRecordAgeInSeconds":      3600.0,
+		"DestinationConfig": map[string]interface{}{
+			"OnFailure": map[string]interface{}{"Destination": "arn:aws:sqs:us-east-1:123456789012:click-processor-dlq"},
+		},
+		"FunctionResponseTypes": []interface{}{"ReportBatchItemFailures"},
+	})
+
+	c.Assert(resp.UUID, check.Equals, "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE")
+	c.Assert(resp.State, check.Equals, "Creating")
+	c.Assert(resp.BisectBatchOnFunctionError, check.Equals, true)
+	c.Assert(resp.MaximumRetryAttempts, check.Equals, 3)
+	c.Assert(resp.DestinationConfig.OnFailure.Destination, check.Equals, "arn:aws:sqs:us-east-1:123456789012:click-processor-dlq")
+}
+
+func (s *S) TestUpdateEventSourceMapping(c *check.C) {
+	testServer.Response(202, nil, EventSourceMappingJSON)
+
+	retries, bisect := 0, false
+	_, err := s.lambda.UpdateEventSourceMapping("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE", &lambda.EventSourceMappingRequest{
+		EventSourceArn:             "ignored",
+		StartingPosition:           lambda.StartingPositionTrimHorizon,
+		BisectBatchOnFunctionError: &bisect,
+		MaximumRetryAttempts:       &retries,
+	})
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/event-source-mappings/a1b2c3d4-5678-90ab-cdef-11111EXAMPLE")
+	body, _ := ioutil.ReadAll(req.Body)
+	c.Assert(readJSON(c, body), check.DeepEquals, map[string]interface{}{
+		"BisectBatchOnFunctionError": false,
+		"MaximumRetryAttempts":       0.0,
+	})
+}
+
+func (s *S) TestListEventSourceMappings(c *check.C) {
+	testServer.Response(200, nil, `{"EventSourceMappings": [`+EventSourceMappingJSON+`], "NextMarker": "page2"}`)
+
+	resp, err := s.lambda.ListEventSourceMappings("click-processor", "", "", 10)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/event-source-mappings/")
+	c.Assert(req.URL.Query(), check.DeepEquals, url.Values{"FunctionName": {"click-processor"}, "MaxItems": {"10"}})
+	c.Assert(resp.EventSourceMappings, check.HasLen, 1)
+	c.Assert(resp.EventSourceMappings[0].BatchSize, check.Equals, 500)
+	c.Assert(resp.NextMarker, check.Equals, "page2")
+}
+
+func (s *S) TestDeleteEventSourceMapping(c *check.C) {
+	testServer.Response(202, nil, EventSourceMappingJSON)
+
+	_, err := s.lambda.DeleteEventSourceMapping("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE")
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/2015-03-31/event-source-mappings/a1b2c3d4-5678-90ab-cdef-11111EXAMPLE")
+}
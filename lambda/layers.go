@@ -0,0 +1,143 @@
+package lambda
+
+import (
+	"net/url"
+	"strconv"
+)
+
+const (
+	ArchitectureX86_64 = "x86_64"
+	ArchitectureArm64  = "arm64"
+)
+
+// LayerContent is the code of a layer version, either uploaded as a zip
+// file or read from S3.
+type LayerContent struct {
+	S3Bucket        string `json:",omitempty"`
+	S3Key           string `json:",omitempty"`
+	S3ObjectVersion string `json:",omitempty"`
+	ZipFile         []byte `json:",omitempty"`
+}
+
+// PublishLayerVersionRequest holds the parameters of PublishLayerVersion.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_PublishLayerVersion.html
+type PublishLayerVersionRequest struct {
+	Content                 LayerContent
+	Description             string   `json:",omitempty"`
+	CompatibleRuntimes      []string `json:",omitempty"`
+	CompatibleArchitectures []string `json:",omitempty"`
+	LicenseInfo             string   `json:",omitempty"`
+}
+
+// LayerVersionContent describes the stored code of a layer version.
+// Location is a presigned URL valid for ten minutes.
+type LayerVersionContent struct {
+	Location                 string
+	CodeSha256               string
+	CodeSize                 int64
+	SigningProfileVersionArn string
+	SigningJobArn            string
+}
+
+// LayerVersion is returned by PublishLayerVersion and GetLayerVersion.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetLayerVersion.html
+type LayerVersion struct {
+	Content                 LayerVersionContent
+	LayerArn                string
+	LayerVersionArn         string
+	Version                 int64
+	Description             string
+	CreatedDate             string
+	CompatibleRuntimes      []string
+	CompatibleArchitectures []string
+	LicenseInfo             string
+}
+
+// LayerVersionsListItem is a layer version as listed by ListLayerVersions.
+type LayerVersionsListItem struct {
+	LayerVersionArn         string
+	Version                 int64
+	Description             string
+	CreatedDate             string
+	CompatibleRuntimes      []string
+	CompatibleArchitectures []string
+	LicenseInfo             string
+}
+
+func layerVersionsPath(layerName string) string {
+	return "/2018-10-31/layers/" + url.PathEscape(layerName) + "/versions"
+}
+
+func layerVersionPath(layerName string, version int64) string {
+	return layerVersionsPath(layerName) + "/" + strconv.FormatInt(version, 10)
+}
+
+// PublishLayerVersion creates a new version of the layer layerName, creating
+// the layer if it doesn't exist.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_PublishLayerVersion.html
+func (l *Lambda) PublishLayerVersion(layerName string, req *PublishLayerVersionRequest) (resp *LayerVersion, err error) {
+	resp = new(LayerVersion)
+	if err := l.query("POST", layerVersionsPath(layerName), nil, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetLayerVersion returns a version of a layer.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_GetLayerVersion.html
+func (l *Lambda) GetLayerVersion(layerName string, version int64) (resp *LayerVersion, err error) {
+	resp = new(LayerVersion)
+	if err := l.query("GET", layerVersionPath(layerName, version), nil, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListLayerVersionsOptions filters the versions returned by
+// ListLayerVersions. All fields are optional.
+type ListLayerVersionsOptions struct {
+	CompatibleRuntime      string
+	CompatibleArchitecture string
+	Marker                 string
+	MaxItems               int
+}
+
+type ListLayerVersionsResp struct {
+	LayerVersions []LayerVersionsListItem
+	NextMarker    string
+}
+
+// ListLayerVersions lists one page of the versions of a layer, newest
+// first. Pass the NextMarker of a response as the Marker option to get the
+// next page; opts may be nil.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_ListLayerVersions.html
+func (l *Lambda) ListLayerVersions(layerName string, opts *ListLayerVersionsOptions) (resp *ListLayerVersionsResp, err error) {
+	params := url.Values{}
+	if opts != nil {
+		params = pageParams(opts.Marker, opts.MaxItems)
+		if opts.CompatibleRuntime != "" {
+			params.Set("CompatibleRuntime", opts.CompatibleRuntime)
+		}
+		if opts.CompatibleArchitecture != "" {
+			params.Set("CompatibleArchitecture", opts.CompatibleArchitecture)
+		}
+	}
+	resp = new(ListLayerVersionsResp)
+	if err := l.query("GET", layerVersionsPath(layerName), params, nil, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteLayerVersion deletes a version of a layer. Functions already using
+// the version keep working.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_DeleteLayerVersion.html
+func (l *Lambda) DeleteLayerVersion(layerName string, version int64) error {
+	return l.query("DELETE", layerVersionPath(layerName, version), nil, nil, nil)
+}
@@ -0,0 +1,56 @@
+package lambda
+
+import (
+	"net/url"
+)
+
+// AddPermissionRequest holds the parameters of AddPermission. Principal is
+// an AWS service, such as "s3.amazonaws.com", or an account ID. SourceArn
+// and SourceAccount restrict the permission of a service to a resource or
+// account. Qualifier selects the version or alias the permission applies
+// to.
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
+type AddPermissionRequest struct {
+	StatementId      string
+	Action           string
+	Principal        string
+	SourceArn        string `json:",omitempty"`
+	SourceAccount    string `json:",omitempty"`
+	EventSourceToken string `json:",omitempty"`
+	PrincipalOrgID   string `json:",omitempty"`
+	RevisionId       string `json:",omitempty"`
+	Qualifier        string `json:"-"`
+}
+
+type AddPermissionResp struct {
+	// The statement added to the resource policy, as a JSON document.
+	Statement string
+}
+
+func policyPath(functionName string) string {
+	return functionPath(functionName) + "/policy"
+}
+
+// AddPermission grants a service or account permission to use a function,
+// by adding a statement to its resource policy. Action is usually
+// "lambda:InvokeFunction".
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html
+func (l *Lambda) AddPermission(functionName string, req *AddPermissionRequest) (resp *AddPermissionResp, err error) {
+	resp = new(AddPermissionResp)
+	if err := l.query("POST", policyPath(functionName), qualifierParams(req.Qualifier), req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RemovePermission removes a statement from the resource policy of a
+// version or alias of a function, or of its unpublished version if
+// qualifier is "".
+//
+// See http://docs.aws.amazon.com/lambda/latest/dg/API_RemovePermission.html
+func (l *Lambda) RemovePermission(functionName, statementId, qualifier string) error {
+	path := policyPath(functionName) + "/" + url.PathEscape(statementId)
+	return l.query("DELETE", path, qualifierParams(qualifier), nil, nil)
+}
@@ -0,0 +1,147 @@
+package lambda_test
+
+var PublishLayerVersionJSON = `
+{
+  "Content": {
+    "Location": "https://awslambda-us-east-1-layers.s3.amazonaws.com/snapshots/123456789012/deps-abcd",
+    "CodeSha256": "tv9jJO+rPbXUUXuRKi7CwHzKtLDkDRJLB3cC3Z/ouXo=",
+    "CodeSize": 169
+  },
+  "LayerArn": "arn:aws:lambda:us-east-1:123456789012:layer:deps",
+  "LayerVersionArn": "arn:aws:lambda:us-east-1:123456789012:layer:deps:3",
+  "Description": "Shared dependencies",
+  "CreatedDate": "2018-11-14T23:03:52.894+0000",
+  "Version": 3,
+  "CompatibleRuntimes": ["go1.x"],
+  "CompatibleArchitectures": ["x86_64"]
+}
+`
+
+var ListLayerVersionsJSON = `
+{
+  "LayerVersions": [
+    {
+      "LayerVersionArn": "arn:aws:lambda:us-east-1:123456789012:layer:deps:3",
+      "Version": 3,
+      "Description": "Shared dependencies",
+      "CreatedDate": "2018-11-15T00:37:46.592+0000",
+      "CompatibleRuntimes": ["go1.x"]
+    },
+    {
+      "LayerVersionArn": "arn:aws:lambda:us-east-1:123456789012:layer:deps:2",
+      "Version": 2,
+      "CreatedDate": "2018-11-14T23:03:52.894+0000",
+      "CompatibleRuntimes": ["go1.x"]
+    }
+  ],
+  "NextMarker": "marker2"
+}
+`
+
+var CodeSigningConfigJSON = `
+{
+  "CodeSigningConfig": {
+    "CodeSigningConfigArn": "arn:aws:lambda:us-east-1:123456789012:code-signing-config:csc-0f6a7c4c9b8d2e1f0",
+    "CodeSigningConfigId": "csc-0f6a7c4c9b8d2e1f0",
+    "Description": "Production signing",
+    "AllowedPublishers": {
+      "SigningProfileVersionArns": ["arn:aws:signer:us-east-1:123456789012:/signing-profiles/prod/abcdef1234"]
+    },
+    "CodeSigningPolicies": {"UntrustedArtifactOnDeployment": "Enforce"},
+    "LastModified": "2020-11-20T18:42:31.153+0000"
+  }
+}
+`
+
+var FunctionCodeSigningConfigJSON = `
+{
+  "CodeSigningConfigArn": "arn:aws:lambda:us-east-1:123456789012:code-signing-config:csc-0f6a7c4c9b8d2e1f0",
+  "FunctionName": "deployer"
+}
+`
+
+var ResourceNotFoundJSON = `
+{
+  "Type": "User",
+  "message": "Layer version arn:aws:lambda:us-east-1:123456789012:layer:deps:9 does not exist."
+}
+`
+
+var FunctionConfigurationJSON = `
+{
+  "FunctionName": "edge-auth",
+  "FunctionArn": "arn:aws:lambda:us-east-1:123456789012:function:edge-auth",
+  "Runtime": "nodejs18.x",
+  "Role": "arn:aws:iam::123456789012:role/edge-auth",
+  "Handler": "index.handler",
+  "PackageType": "Zip",
+  "CodeSize": 5797206,
+  "CodeSha256": "YFgDgEKG3ugvF1+pX64gV6tu9qNuIYNUdgJm8nCxsm4=",
+  "Description": "Viewer request authentication",
+  "Timeout": 5,
+  "MemorySize": 128,
+  "LastModified": "2023-10-14T22:26:11.234+0000",
+  "Version": "$LATEST",
+  "RevisionId": "7f1d1b5e-7a9b-4c53-9b2f-3e8f9b1c2d4a",
+  "State": "Pending",
+  "StateReason": "The function is being created.",
+  "Architectures": ["x86_64"]
+}
+`
+
+var PublishVersionJSON = `
+{
+  "FunctionName": "edge-auth",
+  "FunctionArn": "arn:aws:lambda:us-east-1:123456789012:function:edge-auth:3",
+  "Runtime": "nodejs18.x",
+  "Handler": "index.handler",
+  "CodeSha256": "YFgDgEKG3ugvF1+pX64gV6tu9qNuIYNUdgJm8nCxsm4=",
+  "Version": "3",
+  "State": "Active",
+  "LastUpdateStatus": "Successful"
+}
+`
+
+var AliasConfigurationJSON = `
+{
+  "AliasArn": "arn:aws:lambda:us-east-1:123456789012:function:edge-auth:live",
+  "Name": "live",
+  "FunctionVersion": "3",
+  "Description": "",
+  "RevisionId": "594f41fb-b85f-4c20-95c7-6ca5f2a92c93",
+  "RoutingConfig": {
+    "AdditionalVersionWeights": {"4": 0.1}
+  }
+}
+`
+
+var AddPermissionJSON = `
+{
+  "Statement": "{\"Sid\":\"s3-invoke\",\"Effect\":\"Allow\",\"Principal\":{\"Service\":\"s3.amazonaws.com\"},\"Action\":\"lambda:InvokeFunction\",\"Resource\":\"arn:aws:lambda:us-east-1:123456789012:function:edge-auth\"}"
+}
+`
+
+var EventSourceMappingJSON = `
+{
+  "UUID": "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE",
+  "EventSourceArn": "arn:aws:kinesis:us-east-1:123456789012:stream/clicks",
+  "FunctionArn": "arn:aws:lambda:us-east-1:123456789012:function:click-processor",
+  "State": "Creating",
+  "StateTransitionReason": "User action",
+  "LastModified": 1569284520.333,
+  "LastProcessingResult": "No records processed",
+  "StartingPosition": "LATEST",
+  "BatchSize": 500,
+  "MaximumBatchingWindowInSeconds": 5,
+  "ParallelizationFactor": 1,
+  "BisectBatchOnFunctionError": true,
+  "MaximumRetryAttempts": 3,
+  "MaximumRecordAgeInSeconds": 3600,
+  "DestinationConfig": {
+    "OnFailure": {
+      "Destination": "arn:aws:sqs:us-east-1:123456789012:click-processor-dlq"
+    }
+  },
+  "FunctionResponseTypes": ["ReportBatchItemFailures"]
+}
+`
@@ -0,0 +1,278 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
+)
+
+// The statuses of a blue/green deployment.
+const (
+	BlueGreenProvisioning         = "PROVISIONING"
+	BlueGreenAvailable            = "AVAILABLE"
+	BlueGreenSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+	BlueGreenSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeleting             = "DELETING"
+)
+
+// BlueGreenDeployment is a copy of a production (blue) environment kept in
+// sync with it, the staging (green) environment, that replaces it on
+// switchover.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_BlueGreenDeployment.html for more details.
+type BlueGreenDeployment struct {
+	BlueGreenDeploymentIdentifier string                      `xml:"BlueGreenDeploymentIdentifier"`
+	BlueGreenDeploymentName       string                      `xml:"BlueGreenDeploymentName"`
+	Source                        string                      `xml:"Source"` // The ARN of the blue database
+	Target                        string                      `xml:"Target"` // The ARN of the green database
+	SwitchoverDetails             []BlueGreenSwitchoverDetail `xml:"SwitchoverDetails>member"`
+	Tasks                         []BlueGreenDeploymentTask   `xml:"Tasks>member"`
+	Status                        string                      `xml:"Status"`
+	StatusDetails                 string                      `xml:"StatusDetails"`
+	CreateTime                    string                      `xml:"CreateTime"`
+	DeleteTime                    string                      `xml:"DeleteTime"`
+	TagList                       []Tag                       `xml:"TagList>Tag"`
+}
+
+// BlueGreenSwitchoverDetail pairs a resource of the blue environment with
+// the green resource it is switched over to.
+type BlueGreenSwitchoverDetail struct {
+	SourceMember string `xml:"SourceMember"`
+	TargetMember string `xml:"TargetMember"`
+	Status       string `xml:"Status"` // e.g. PROVISIONING, AVAILABLE, SWITCHOVER_IN_PROGRESS, SWITCHOVER_COMPLETED
+}
+
+// BlueGreenDeploymentTask is a step of the creation of a green environment,
+// such as CREATING_READ_REPLICA_OF_SOURCE or DB_ENGINE_VERSION_UPGRADE.
+type BlueGreenDeploymentTask struct {
+	Name   string `xml:"Name"`
+	Status string `xml:"Status"` // PENDING, IN_PROGRESS, COMPLETED or FAILED
+}
+
+// CreateBlueGreenDeploymentOptions holds the parameters of a
+// CreateBlueGreenDeployment request. Source is the ARN of the DB instance
+// or cluster to copy. The Target fields change the green environment; a
+// major version upgrade sets TargetEngineVersion, and usually a
+// TargetDBParameterGroupName of the new parameter group family.
+type CreateBlueGreenDeploymentOptions struct {
+	BlueGreenDeploymentName           string
+	Source                            string
+	TargetEngineVersion               string
+	TargetDBParameterGroupName        string
+	TargetDBClusterParameterGroupName string
+	TargetDBInstanceClass             string
+	UpgradeTargetStorageConfig        bool
+	Tags                              []Tag
+}
+
+// Response to a CreateBlueGreenDeployment request
+type CreateBlueGreenDeploymentResponse struct {
+	BlueGreenDeployment BlueGreenDeployment `xml:"CreateBlueGreenDeploymentResult>BlueGreenDeployment"`
+	RequestId           string              `xml:"ResponseMetadata>RequestId"`
+}
+
+// CreateBlueGreenDeployment - Creates a green environment from a DB
+// instance or cluster. Use WaitUntilBlueGreenDeploymentAvailable to wait
+// for it to be ready to switch over.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_CreateBlueGreenDeployment.html for more details.
+func (rds *RDS) CreateBlueGreenDeployment(options *CreateBlueGreenDeploymentOptions) (*CreateBlueGreenDeploymentResponse, error) {
+
+	params := aws.MakeParams("CreateBlueGreenDeployment")
+
+	params["BlueGreenDeploymentName"] = options.BlueGreenDeploymentName
+	params["Source"] = options.Source
+
+	if options.TargetEngineVersion != "" {
+		params["TargetEngineVersion"] = options.TargetEngineVersion
+	}
+	if options.TargetDBParameterGroupName != "" {
+		params["TargetDBParameterGroupName"] = options.TargetDBParameterGroupName
+	}
+	if options.TargetDBClusterParameterGroupName != "" {
+		params["TargetDBClusterParameterGroupName"] = options.TargetDBClusterParameterGroupName
+	}
+	if options.TargetDBInstanceClass != "" {
+		params["TargetDBInstanceClass"] = options.TargetDBInstanceClass
+	}
+	if options.UpgradeTargetStorageConfig {
+		params["UpgradeTargetStorageConfig"] = "true"
+	}
+	for i, tag := range options.Tags {
+		prefix := "Tags.member." + strconv.Itoa(i+1)
+		params[prefix+".Key"] = tag.Key
+		params[prefix+".Value"] = tag.Value
+	}
+
+	resp := &CreateBlueGreenDeploymentResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// Response to a DescribeBlueGreenDeployments request
+type DescribeBlueGreenDeploymentsResponse struct {
+	BlueGreenDeployments []BlueGreenDeployment `xml:"DescribeBlueGreenDeploymentsResult>BlueGreenDeployments>member"`
+	Marker               string                `xml:"DescribeBlueGreenDeploymentsResult>Marker"`
+	RequestId            string                `xml:"ResponseMetadata>RequestId"`
+}
+
+// DescribeBlueGreenDeployments - Returns a description of one blue/green
+// deployment, or of all of them if id is empty. Supports pagination with
+// maxRecords and marker as DescribeDBInstances does.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_DescribeBlueGreenDeployments.html for more details.
+func (rds *RDS) DescribeBlueGreenDeployments(id string, maxRecords int, marker string) (*DescribeBlueGreenDeploymentsResponse, error) {
+
+	params := aws.MakeParams("DescribeBlueGreenDeployments")
+
+	if id != "" {
+		params["BlueGreenDeploymentIdentifier"] = id
+	}
+	if maxRecords != 0 {
+		params["MaxRecords"] = strconv.Itoa(maxRecords)
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+
+	resp := &DescribeBlueGreenDeploymentsResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// Response to a SwitchoverBlueGreenDeployment request
+type SwitchoverBlueGreenDeploymentResponse struct {
+	BlueGreenDeployment BlueGreenDeployment `xml:"SwitchoverBlueGreenDeploymentResult>BlueGreenDeployment"`
+	RequestId           string              `xml:"ResponseMetadata>RequestId"`
+}
+
+// SwitchoverBlueGreenDeployment - Promotes the green environment to be the
+// production one. If the switchover takes longer than timeoutSeconds it is
+// rolled back; 0 uses the service default of 300 seconds. Use
+// WaitUntilBlueGreenDeploymentSwitchoverCompleted to wait for it to end.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_SwitchoverBlueGreenDeployment.html for more details.
+func (rds *RDS) SwitchoverBlueGreenDeployment(id string, timeoutSeconds int) (*SwitchoverBlueGreenDeploymentResponse, error) {
+
+	params := aws.MakeParams("SwitchoverBlueGreenDeployment")
+
+	params["BlueGreenDeploymentIdentifier"] = id
+
+	if timeoutSeconds != 0 {
+		params["SwitchoverTimeout"] = strconv.Itoa(timeoutSeconds)
+	}
+
+	resp := &SwitchoverBlueGreenDeploymentResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// Response to a DeleteBlueGreenDeployment request
+type DeleteBlueGreenDeploymentResponse struct {
+	BlueGreenDeployment BlueGreenDeployment `xml:"DeleteBlueGreenDeploymentResult>BlueGreenDeployment"`
+	RequestId           string              `xml:"ResponseMetadata>RequestId"`
+}
+
+// DeleteBlueGreenDeployment - Deletes a blue/green deployment. The green
+// databases are deleted too if deleteTarget is true, which is not allowed
+// once the switchover has completed. Either way the blue databases are
+// kept.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_DeleteBlueGreenDeployment.html for more details.
+func (rds *RDS) DeleteBlueGreenDeployment(id string, deleteTarget bool) (*DeleteBlueGreenDeploymentResponse, error) {
+
+	params := aws.MakeParams("DeleteBlueGreenDeployment")
+
+	params["BlueGreenDeploymentIdentifier"] = id
+
+	if deleteTarget {
+		params["DeleteTarget"] = "true"
+	}
+
+	resp := &DeleteBlueGreenDeploymentResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// BlueGreenStatusError is returned by the blue/green WaitUntil methods when
+// a deployment reaches a status from which it can't get to the awaited one.
+type BlueGreenStatusError struct {
+	BlueGreenDeploymentIdentifier string
+	Status                        string
+	StatusDetails                 string
+	Want                          string
+}
+
+func (err *BlueGreenStatusError) Error() string {
+	msg := fmt.Sprintf("rds: blue/green deployment %s is %s while waiting for it to be %s",
+		err.BlueGreenDeploymentIdentifier, err.Status, err.Want)
+	if err.StatusDetails != "" {
+		msg += ": " + err.StatusDetails
+	}
+	return msg
+}
+
+// WaitUntilBlueGreenDeploymentAvailable polls RDS until the green
+// environment of a deployment is ready to switch over.
+//
+// The wait ends early with ctx's error when ctx is done, with
+// context.DeadlineExceeded when the timeout of opts is reached, and with a
+// *BlueGreenStatusError when the deployment has an invalid configuration
+// or is being deleted.
+func (rds *RDS) WaitUntilBlueGreenDeploymentAvailable(ctx context.Context, opts *WaitOptions, id string) error {
+	return rds.waitForBlueGreenStatus(ctx, opts, id, BlueGreenAvailable, BlueGreenInvalidConfiguration, BlueGreenDeleting)
+}
+
+// WaitUntilBlueGreenDeploymentSwitchoverCompleted polls RDS until the
+// switchover of a deployment completes. It ends early as
+// WaitUntilBlueGreenDeploymentAvailable does, and with a
+// *BlueGreenStatusError when the switchover fails.
+func (rds *RDS) WaitUntilBlueGreenDeploymentSwitchoverCompleted(ctx context.Context, opts *WaitOptions, id string) error {
+	return rds.waitForBlueGreenStatus(ctx, opts, id, BlueGreenSwitchoverCompleted, BlueGreenSwitchoverFailed, BlueGreenInvalidConfiguration, BlueGreenDeleting)
+}
+
+// WaitUntilBlueGreenDeploymentDeleted polls RDS until a deployment is no
+// longer found. It ends early as WaitUntilBlueGreenDeploymentAvailable
+// does, except for the status of the deployment.
+func (rds *RDS) WaitUntilBlueGreenDeploymentDeleted(ctx context.Context, opts *WaitOptions, id string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := rds.DescribeBlueGreenDeployments(id, 0, "")
+		if isBlueGreenNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return len(resp.BlueGreenDeployments) == 0, nil
+	})
+}
+
+func (rds *RDS) waitForBlueGreenStatus(ctx context.Context, opts *WaitOptions, id, want string, failStatuses ...string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := rds.DescribeBlueGreenDeployments(id, 0, "")
+		if err != nil {
+			return false, err
+		}
+		if len(resp.BlueGreenDeployments) == 0 {
+			return false, nil
+		}
+		bg := resp.BlueGreenDeployments[0]
+		for _, fail := range failStatuses {
+			if bg.Status == fail {
+				return false, &BlueGreenStatusError{id, bg.Status, bg.StatusDetails, want}
+			}
+		}
+		return bg.Status == want, nil
+	})
+}
+
+func isBlueGreenNotFound(err error) bool {
+	var apiErr aws.APIError
+	return errors.As(err, &apiErr) && apiErr.Code() == "BlueGreenDeploymentNotFoundFault"
+}
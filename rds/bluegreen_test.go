@@ -0,0 +1,134 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/rds"
+	"gopkg.in/check.v1"
+)
+
+var fastWait = &rds.WaitOptions{Interval: time.Millisecond, Timeout: time.Second}
+
+func blueGreenStatus(status, details string) string {
+	return fmt.Sprintf(DescribeBlueGreenDeploymentsDump, status, details)
+}
+
+func (s *S) TestCreateBlueGreenDeployment(c *check.C) {
+	testServer.Response(200, nil, CreateBlueGreenDeploymentExample1)
+
+	resp, err := s.rds.CreateBlueGreenDeployment(&rds.CreateBlueGreenDeploymentOptions{
+		BlueGreenDeploymentName:    "pg16-upgrade",
+		Source:                     "arn:aws:rds:us-east-1:123456789012:db:mydbinstance",
+		TargetEngineVersion:        "16.1",
+		TargetDBParameterGroupName: "pg16-params",
+		Tags:                       []rds.Tag{{Key: "team", Value: "data"}},
+	})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateBlueGreenDeployment"})
+	c.Assert(req.Form["BlueGreenDeploymentName"], check.DeepEquals, []string{"pg16-upgrade"})
+	c.Assert(req.Form["Source"], check.DeepEquals, []string{"arn:aws:rds:us-east-1:123456789012:db:mydbinstance"})
+	c.Assert(req.Form["TargetEngineVersion"], check.DeepEquals, []string{"16.1"})
+	c.Assert(req.Form["TargetDBParameterGroupName"], check.DeepEquals, []string{"pg16-params"})
+	c.Assert(req.Form["TargetDBInstanceClass"], check.IsNil)
+	c.Assert(req.Form["UpgradeTargetStorageConfig"], check.IsNil)
+	c.Assert(req.Form["Tags.member.1.Key"], check.DeepEquals, []string{"team"})
+	c.Assert(req.Form["Tags.member.1.Value"], check.DeepEquals, []string{"data"})
+
+	c.Assert(err, check.IsNil)
+	bg := resp.BlueGreenDeployment
+	c.Assert(bg.BlueGreenDeploymentIdentifier, check.Equals, "bgd-wi89nwzglccsfake")
+	c.Assert(bg.Status, check.Equals, rds.BlueGreenProvisioning)
+	c.Assert(bg.SwitchoverDetails, check.HasLen, 1)
+	c.Assert(bg.SwitchoverDetails[0].Status, check.Equals, "PROVISIONING")
+	c.Assert(bg.Tasks, check.DeepEquals, []rds.BlueGreenDeploymentTask{
+		{Name: "CREATING_READ_REPLICA_OF_SOURCE", Status: "PENDING"},
+		{Name: "DB_ENGINE_VERSION_UPGRADE", Status: "PENDING"},
+	})
+	c.Assert(bg.TagList, check.DeepEquals, []rds.Tag{{Key: "team", Value: "data"}})
+}
+
+func (s *S) TestSwitchoverBlueGreenDeployment(c *check.C) {
+	testServer.Response(200, nil, SwitchoverBlueGreenDeploymentExample1)
+
+	resp, err := s.rds.SwitchoverBlueGreenDeployment("bgd-wi89nwzglccsfake", 600)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"SwitchoverBlueGreenDeployment"})
+	c.Assert(req.Form["BlueGreenDeploymentIdentifier"], check.DeepEquals, []string{"bgd-wi89nwzglccsfake"})
+	c.Assert(req.Form["SwitchoverTimeout"], check.DeepEquals, []string{"600"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.BlueGreenDeployment.Status, check.Equals, rds.BlueGreenSwitchoverInProgress)
+}
+
+func (s *S) TestDeleteBlueGreenDeployment(c *check.C) {
+	testServer.Response(200, nil, DeleteBlueGreenDeploymentExample1)
+
+	resp, err := s.rds.DeleteBlueGreenDeployment("bgd-wi89nwzglccsfake", true)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DeleteBlueGreenDeployment"})
+	c.Assert(req.Form["DeleteTarget"], check.DeepEquals, []string{"true"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.BlueGreenDeployment.Status, check.Equals, rds.BlueGreenDeleting)
+}
+
+func (s *S) TestWaitUntilBlueGreenDeploymentAvailable(c *check.C) {
+	testServer.Response(200, nil, blueGreenStatus("PROVISIONING", ""))
+	testServer.Response(200, nil, blueGreenStatus("AVAILABLE", ""))
+
+	err := s.rds.WaitUntilBlueGreenDeploymentAvailable(context.Background(), fastWait, "bgd-wi89nwzglccsfake")
+	c.Assert(err, check.IsNil)
+
+	for i := 0; i < 2; i++ {
+		req := testServer.WaitRequest()
+		c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeBlueGreenDeployments"})
+		c.Assert(req.Form["BlueGreenDeploymentIdentifier"], check.DeepEquals, []string{"bgd-wi89nwzglccsfake"})
+	}
+}
+
+func (s *S) TestWaitUntilBlueGreenDeploymentAvailableFails(c *check.C) {
+	testServer.Response(200, nil, blueGreenStatus("INVALID_CONFIGURATION", "Logical replication is not enabled."))
+
+	err := s.rds.WaitUntilBlueGreenDeploymentAvailable(context.Background(), fastWait, "bgd-wi89nwzglccsfake")
+	testServer.WaitRequest()
+	c.Assert(err, check.DeepEquals, &rds.BlueGreenStatusError{
+		BlueGreenDeploymentIdentifier: "bgd-wi89nwzglccsfake",
+		Status:                        "INVALID_CONFIGURATION",
+		StatusDetails:                 "Logical replication is not enabled.",
+		Want:                          "AVAILABLE",
+	})
+	c.Assert(err, check.ErrorMatches, "rds: blue/green deployment bgd-wi89nwzglccsfake is INVALID_CONFIGURATION while waiting for it to be AVAILABLE: Logical replication is not enabled.")
+}
+
+func (s *S) TestWaitUntilBlueGreenDeploymentSwitchoverCompleted(c *check.C) {
+	testServer.Response(200, nil, blueGreenStatus("SWITCHOVER_IN_PROGRESS", ""))
+	testServer.Response(200, nil, blueGreenStatus("SWITCHOVER_FAILED", ""))
+
+	err := s.rds.WaitUntilBlueGreenDeploymentSwitchoverCompleted(context.Background(), fastWait, "bgd-wi89nwzglccsfake")
+	testServer.WaitRequests(2)
+	c.Assert(err, check.FitsTypeOf, &rds.BlueGreenStatusError{})
+}
+
+func (s *S) TestWaitUntilBlueGreenDeploymentDeleted(c *check.C) {
+	testServer.Response(200, nil, blueGreenStatus("DELETING", ""))
+	testServer.Response(404, nil, BlueGreenDeploymentNotFound)
+
+	err := s.rds.WaitUntilBlueGreenDeploymentDeleted(context.Background(), fastWait, "bgd-wi89nwzglccsfake")
+	c.Assert(err, check.IsNil)
+	testServer.WaitRequests(2)
+}
+
+func (s *S) TestWaitUntilBlueGreenDeploymentTimeout(c *check.C) {
+	for i := 0; i < 100; i++ {
+		testServer.Response(200, nil, blueGreenStatus("PROVISIONING", ""))
+	}
+
+	opts := &rds.WaitOptions{Interval: time.Millisecond, Timeout: 20 * time.Millisecond}
+	err := s.rds.WaitUntilBlueGreenDeploymentAvailable(context.Background(), opts, "bgd-wi89nwzglccsfake")
+	c.Assert(err, check.Equals, context.DeadlineExceeded)
+}
@@ -17,9 +17,12 @@ import (
 
 const debug = false
 
+// ApiVersion is the version of the RDS API the requests are made with.
+// Performance Insights and Enhanced Monitoring need 2014-10-31; the
+// actions and responses used before, with 2013-09-09, are unchanged in it.
 const (
 	ServiceName = "rds"
-	ApiVersion  = "2013-09-09"
+	ApiVersion  = "2014-10-31"
 )
 
 // The RDS type encapsulates operations within a specific EC2 region.
@@ -27,6 +30,10 @@ type RDS struct {
 	Service aws.AWSService
 	Auth    aws.Auth
 	Region  aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
 }
 
 // New creates a new RDS Client.
@@ -106,6 +113,291 @@ func (rds *RDS) DescribeDBInstances(id string, maxRecords int, marker string) (*
 	return resp, err
 }
 
+// CreateDBInstanceOptions holds the parameters of a CreateDBInstance request.
+//
+// Performance Insights and Enhanced Monitoring are configured at creation
+// time through EnablePerformanceInsights and MonitoringInterval; a non-zero
+// MonitoringInterval requires MonitoringRoleArn.
+type CreateDBInstanceOptions struct {
+	DBInstanceIdentifier       string
+	DBInstanceClass            string
+	Engine                     string
+	EngineVersion              string
+	DBName                     string
+	MasterUsername             string
+	MasterUserPassword         string
+	AllocatedStorage           int
+	StorageType                string
+	Iops                       int
+	MultiAZ                    bool
+	AvailabilityZone           string
+	DBSubnetGroupName          string
+	DBParameterGroupName       string
+	VpcSecurityGroupIds        []string
+	BackupRetentionPeriod      int
+	PreferredBackupWindow      string
+	PreferredMaintenanceWindow string
+	StorageEncrypted           bool
+	KmsKeyId                   string
+
+	EnablePerformanceInsights          bool
+	PerformanceInsightsKMSKeyId        string
+	PerformanceInsightsRetentionPeriod int
+
+	MonitoringInterval int // Seconds between Enhanced Monitoring samples: 0, 1, 5, 10, 15, 30 or 60
+	MonitoringRoleArn  string
+}
+
+// Response to a CreateDBInstance request
+type CreateDBInstanceResponse struct {
+	DBInstance DBInstance `xml:"CreateDBInstanceResult>DBInstance"`
+	RequestId  string     `xml:"ResponseMetadata>RequestId"`
+}
+
+// CreateDBInstance - Creates a new DB instance
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_CreateDBInstance.html for more details.
+func (rds *RDS) CreateDBInstance(options *CreateDBInstanceOptions) (*CreateDBInstanceResponse, error) {
+	if options.MonitoringInterval != 0 && options.MonitoringRoleArn == "" {
+		return nil, errors.New("rds: MonitoringRoleArn is required when MonitoringInterval is set")
+	}
+
+	params := aws.MakeParams("CreateDBInstance")
+
+	params["DBInstanceIdentifier"] = options.DBInstanceIdentifier
+	params["DBInstanceClass"] = options.DBInstanceClass
+	params["Engine"] = options.Engine
+
+	if options.EngineVersion != "" {
+		params["EngineVersion"] = options.EngineVersion
+	}
+	if options.DBName != "" {
+		params["DBName"] = options.DBName
+	}
+	if options.MasterUsername != "" {
+		params["MasterUsername"] = options.MasterUsername
+	}
+	if options.MasterUserPassword != "" {
+		params["MasterUserPassword"] = options.MasterUserPassword
+	}
+	if options.AllocatedStorage != 0 {
+		params["AllocatedStorage"] = strconv.Itoa(options.AllocatedStorage)
+	}
+	if options.StorageType != "" {
+		params["StorageType"] = options.StorageType
+	}
+	if options.Iops != 0 {
+		params["Iops"] = strconv.Itoa(options.Iops)
+	}
+	if options.MultiAZ {
+		params["MultiAZ"] = "true"
+	}
+	if options.AvailabilityZone != "" {
+		params["AvailabilityZone"] = options.AvailabilityZone
+	}
+	if options.DBSubnetGroupName != "" {
+		params["DBSubnetGroupName"] = options.DBSubnetGroupName
+	}
+	if options.DBParameterGroupName != "" {
+		params["DBParameterGroupName"] = options.DBParameterGroupName
+	}
+	for i, id := range options.VpcSecurityGroupIds {
+		params["VpcSecurityGroupIds.member."+strconv.Itoa(i+1)] = id
+	}
+	if options.BackupRetentionPeriod != 0 {
+		params["BackupRetentionPeriod"] = strconv.Itoa(options.BackupRetentionPeriod)
+	}
+	if options.PreferredBackupWindow != "" {
+		params["PreferredBackupWindow"] = options.PreferredBackupWindow
+	}
+	if options.PreferredMaintenanceWindow != "" {
+		params["PreferredMaintenanceWindow"] = options.PreferredMaintenanceWindow
+	}
+	if options.StorageEncrypted {
+		params["StorageEncrypted"] = "true"
+	}
+	if options.KmsKeyId != "" {
+		params["KmsKeyId"] = options.KmsKeyId
+	}
+	if options.EnablePerformanceInsights {
+		params["EnablePerformanceInsights"] = "true"
+		if options.PerformanceInsightsKMSKeyId != "" {
+			params["PerformanceInsightsKMSKeyId"] = options.PerformanceInsightsKMSKeyId
+		}
+		if options.PerformanceInsightsRetentionPeriod != 0 {
+			params["PerformanceInsightsRetentionPeriod"] = strconv.Itoa(options.PerformanceInsightsRetentionPeriod)
+		}
+	}
+	if options.MonitoringInterval != 0 {
+		params["MonitoringInterval"] = strconv.Itoa(options.MonitoringInterval)
+		params["MonitoringRoleArn"] = options.MonitoringRoleArn
+	}
+
+	resp := &CreateDBInstanceResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// ModifyDBInstanceOptions holds the parameters of a ModifyDBInstance request.
+// Only the fields that are set are changed.
+//
+// Because false and 0 are meaningful values for Performance Insights and
+// Enhanced Monitoring, they are only sent when SetEnablePerformanceInsights
+// or SetMonitoringInterval is true. Setting MonitoringInterval to 0 turns
+// Enhanced Monitoring off.
+type ModifyDBInstanceOptions struct {
+	DBInstanceIdentifier       string
+	ApplyImmediately           bool
+	DBInstanceClass            string
+	EngineVersion              string
+	MasterUserPassword         string
+	AllocatedStorage           int
+	BackupRetentionPeriod      int
+	PreferredBackupWindow      string
+	PreferredMaintenanceWindow string
+	DBParameterGroupName       string
+	VpcSecurityGroupIds        []string
+
+	EnablePerformanceInsights          bool
+	SetEnablePerformanceInsights       bool
+	PerformanceInsightsKMSKeyId        string
+	PerformanceInsightsRetentionPeriod int
+
+	MonitoringInterval    int
+	SetMonitoringInterval bool
+	MonitoringRoleArn     string
+}
+
+// Response to a ModifyDBInstance request
+type ModifyDBInstanceResponse struct {
+	DBInstance DBInstance `xml:"ModifyDBInstanceResult>DBInstance"`
+	RequestId  string     `xml:"ResponseMetadata>RequestId"`
+}
+
+// ModifyDBInstance - Modifies settings of a DB instance
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_ModifyDBInstance.html for more details.
+func (rds *RDS) ModifyDBInstance(options *ModifyDBInstanceOptions) (*ModifyDBInstanceResponse, error) {
+
+	params := aws.MakeParams("ModifyDBInstance")
+
+	params["DBInstanceIdentifier"] = options.DBInstanceIdentifier
+
+	if options.ApplyImmediately {
+		params["ApplyImmediately"] = "true"
+	}
+	if options.DBInstanceClass != "" {
+		params["DBInstanceClass"] = options.DBInstanceClass
+	}
+	if options.EngineVersion != "" {
+		params["EngineVersion"] = options.EngineVersion
+	}
+	if options.MasterUserPassword != "" {
+		params["MasterUserPassword"] = options.MasterUserPassword
+	}
+	if options.AllocatedStorage != 0 {
+		params["AllocatedStorage"] = strconv.Itoa(options.AllocatedStorage)
+	}
+	if options.BackupRetentionPeriod != 0 {
+		params["BackupRetentionPeriod"] = strconv.Itoa(options.BackupRetentionPeriod)
+	}
+	if options.PreferredBackupWindow != "" {
+		params["PreferredBackupWindow"] = options.PreferredBackupWindow
+	}
+	if options.PreferredMaintenanceWindow != "" {
+		params["PreferredMaintenanceWindow"] = options.PreferredMaintenanceWindow
+	}
+	if options.DBParameterGroupName != "" {
+		params["DBParameterGroupName"] = options.DBParameterGroupName
+	}
+	for i, id := range options.VpcSecurityGroupIds {
+		params["VpcSecurityGroupIds.member."+strconv.Itoa(i+1)] = id
+	}
+	if options.SetEnablePerformanceInsights {
+		params["EnablePerformanceInsights"] = strconv.FormatBool(options.EnablePerformanceInsights)
+	}
+	if options.PerformanceInsightsKMSKeyId != "" {
+		params["PerformanceInsightsKMSKeyId"] = options.PerformanceInsightsKMSKeyId
+	}
+	if options.PerformanceInsightsRetentionPeriod != 0 {
+		params["PerformanceInsightsRetentionPeriod"] = strconv.Itoa(options.PerformanceInsightsRetentionPeriod)
+	}
+	if options.SetMonitoringInterval {
+		params["MonitoringInterval"] = strconv.Itoa(options.MonitoringInterval)
+	}
+	if options.MonitoringRoleArn != "" {
+		params["MonitoringRoleArn"] = options.MonitoringRoleArn
+	}
+
+	resp := &ModifyDBInstanceResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// Response to a CreateDBSnapshot request
+type CreateDBSnapshotResponse struct {
+	DBSnapshot DBSnapshot `xml:"CreateDBSnapshotResult>DBSnapshot"`
+	RequestId  string     `xml:"ResponseMetadata>RequestId"`
+}
+
+// CreateDBSnapshot - Starts a manual snapshot of a DB instance. The
+// snapshot is usable once its Status is "available"; see
+// WaitUntilDBSnapshotAvailable.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_CreateDBSnapshot.html for more details.
+func (rds *RDS) CreateDBSnapshot(instanceId, snapshotId string, tags []Tag) (*CreateDBSnapshotResponse, error) {
+
+	params := aws.MakeParams("CreateDBSnapshot")
+
+	params["DBInstanceIdentifier"] = instanceId
+	params["DBSnapshotIdentifier"] = snapshotId
+
+	for i, tag := range tags {
+		prefix := "Tags.member." + strconv.Itoa(i+1)
+		params[prefix+".Key"] = tag.Key
+		params[prefix+".Value"] = tag.Value
+	}
+
+	resp := &CreateDBSnapshotResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
+// Response to a DescribeDBSnapshots request
+type DescribeDBSnapshotsResponse struct {
+	DBSnapshots []DBSnapshot `xml:"DescribeDBSnapshotsResult>DBSnapshots>DBSnapshot"`
+	Marker      string       `xml:"DescribeDBSnapshotsResult>Marker"`
+	RequestId   string       `xml:"ResponseMetadata>RequestId"`
+}
+
+// DescribeDBSnapshots - Returns a description of the snapshots of the
+// instance instanceId, or of the snapshot snapshotId. Either may be empty;
+// with both empty, every snapshot of the account is described.
+// Supports pagination like DescribeDBInstances.
+//
+// See http://docs.aws.amazon.com/AmazonRDS/latest/APIReference/API_DescribeDBSnapshots.html for more details.
+func (rds *RDS) DescribeDBSnapshots(instanceId, snapshotId string, maxRecords int, marker string) (*DescribeDBSnapshotsResponse, error) {
+
+	params := aws.MakeParams("DescribeDBSnapshots")
+
+	if instanceId != "" {
+		params["DBInstanceIdentifier"] = instanceId
+	}
+	if snapshotId != "" {
+		params["DBSnapshotIdentifier"] = snapshotId
+	}
+	if maxRecords != 0 {
+		params["MaxRecords"] = strconv.Itoa(maxRecords)
+	}
+	if marker != "" {
+		params["Marker"] = marker
+	}
+
+	resp := &DescribeDBSnapshotsResponse{}
+	err := rds.query("POST", "/", params, resp)
+	return resp, err
+}
+
 type DownloadDBLogFilePortionResponse struct {
 	Marker                string `xml:"DownloadDBLogFilePortionResult>Marker"`
 	LogFileData           string `xml:"DownloadDBLogFilePortionResult>LogFileData"`
@@ -160,7 +452,11 @@ func (rds *RDS) DownloadCompleteDBLogFile(id, filename string) (io.ReadCloser, e
 	hreq.Header.Set("X-Amz-Date", time.Now().UTC().Format(aws.ISO8601BasicFormat))
 	signer := aws.NewV4Signer(rds.Auth, "rds", rds.Region)
 	signer.Sign(hreq)
-	resp, err := http.DefaultClient.Do(hreq)
+	client := rds.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(hreq)
 	if err != nil {
 		if debug {
 			log.Print("Error calling Amazon")
@@ -73,4 +73,98 @@ func (s *S) TestDescribeDBInstancesExample1(c *check.C) {
 	c.Assert(db0.PreferredBackupWindow, check.Equals, "00:00-00:30")
 	c.Assert(db0.PreferredMaintenanceWindow, check.Equals, "sat:07:30-sat:08:00")
 	c.Assert(db0.PubliclyAccessible, check.Equals, false)
+	c.Assert(db0.ReadReplicaDBInstanceIdentifiers, check.DeepEquals, []string{"simcoprod01-replica"})
+	c.Assert(db0.VpcSecurityGroups, check.DeepEquals, []rds.VpcSecurityGroupMembership{{Id: "sg-1a2b3c4d", Status: "active"}})
+}
+
+func (s *S) TestCreateDBInstanceExample1(c *check.C) {
+	testServer.Response(200, nil, CreateDBInstanceExample1)
+
+	options := &rds.CreateDBInstanceOptions{
+		DBInstanceIdentifier:               "mydbinstance",
+		DBInstanceClass:                    "db.m4.large",
+		Engine:                             "postgres",
+		MasterUsername:                     "master",
+		MasterUserPassword:                 "secret99",
+		AllocatedStorage:                   100,
+		VpcSecurityGroupIds:                []string{"sg-1", "sg-2"},
+		EnablePerformanceInsights:          true,
+		PerformanceInsightsKMSKeyId:        "arn:aws:kms:us-east-1:123456789012:key/0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d",
+		PerformanceInsightsRetentionPeriod: 7,
+		MonitoringInterval:                 15,
+		MonitoringRoleArn:                  "arn:aws:iam::123456789012:role/rds-monitoring-role",
+	}
+	resp, err := s.rds.CreateDBInstance(options)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateDBInstance"})
+	c.Assert(req.Form["Version"], check.DeepEquals, []string{"2014-10-31"})
+	c.Assert(req.Form["DBInstanceIdentifier"], check.DeepEquals, []string{"mydbinstance"})
+	c.Assert(req.Form["AllocatedStorage"], check.DeepEquals, []string{"100"})
+	c.Assert(req.Form["VpcSecurityGroupIds.member.1"], check.DeepEquals, []string{"sg-1"})
+	c.Assert(req.Form["VpcSecurityGroupIds.member.2"], check.DeepEquals, []string{"sg-2"})
+	c.Assert(req.Form["MultiAZ"], check.IsNil)
+	c.Assert(req.Form["EnablePerformanceInsights"], check.DeepEquals, []string{"true"})
+	c.Assert(req.Form["PerformanceInsightsKMSKeyId"], check.DeepEquals, []string{options.PerformanceInsightsKMSKeyId})
+	c.Assert(req.Form["PerformanceInsightsRetentionPeriod"], check.DeepEquals, []string{"7"})
+	c.Assert(req.Form["MonitoringInterval"], check.DeepEquals, []string{"15"})
+	c.Assert(req.Form["MonitoringRoleArn"], check.DeepEquals, []string{options.MonitoringRoleArn})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "523e3218-afc7-11c3-90f5-f90431260ab4")
+	c.Assert(resp.DBInstance.DBInstanceStatus, check.Equals, "creating")
+	c.Assert(resp.DBInstance.PerformanceInsightsEnabled, check.Equals, true)
+	c.Assert(resp.DBInstance.PerformanceInsightsRetentionPeriod, check.Equals, 7)
+	c.Assert(resp.DBInstance.MonitoringInterval, check.Equals, 15)
+	c.Assert(resp.DBInstance.MonitoringRoleArn, check.Equals, options.MonitoringRoleArn)
+}
+
+func (s *S) TestCreateDBInstanceWithoutMonitoring(c *check.C) {
+	testServer.Response(200, nil, CreateDBInstanceExample1)
+
+	_, err := s.rds.CreateDBInstance(&rds.CreateDBInstanceOptions{
+		DBInstanceIdentifier: "mydbinstance",
+		DBInstanceClass:      "db.m4.large",
+		Engine:               "postgres",
+	})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["EnablePerformanceInsights"], check.IsNil)
+	c.Assert(req.Form["MonitoringInterval"], check.IsNil)
+	c.Assert(req.Form["MonitoringRoleArn"], check.IsNil)
+}
+
+func (s *S) TestCreateDBInstanceMonitoringRequiresRole(c *check.C) {
+	_, err := s.rds.CreateDBInstance(&rds.CreateDBInstanceOptions{
+		DBInstanceIdentifier: "mydbinstance",
+		DBInstanceClass:      "db.m4.large",
+		Engine:               "postgres",
+		MonitoringInterval:   60,
+	})
+	c.Assert(err, check.ErrorMatches, "rds: MonitoringRoleArn is required when MonitoringInterval is set")
+}
+
+func (s *S) TestModifyDBInstanceExample1(c *check.C) {
+	testServer.Response(200, nil, ModifyDBInstanceExample1)
+
+	resp, err := s.rds.ModifyDBInstance(&rds.ModifyDBInstanceOptions{
+		DBInstanceIdentifier:         "mydbinstance",
+		ApplyImmediately:             true,
+		SetEnablePerformanceInsights: true,
+		SetMonitoringInterval:        true,
+	})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"ModifyDBInstance"})
+	c.Assert(req.Form["DBInstanceIdentifier"], check.DeepEquals, []string{"mydbinstance"})
+	c.Assert(req.Form["ApplyImmediately"], check.DeepEquals, []string{"true"})
+	c.Assert(req.Form["EnablePerformanceInsights"], check.DeepEquals, []string{"false"})
+	c.Assert(req.Form["MonitoringInterval"], check.DeepEquals, []string{"0"})
+	c.Assert(req.Form["DBInstanceClass"], check.IsNil)
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.RequestId, check.Equals, "f643f1ac-bbfe-11c3-b2c8-6ab2e1e4cd92")
+	c.Assert(resp.DBInstance.DBInstanceStatus, check.Equals, "modifying")
+	c.Assert(resp.DBInstance.PerformanceInsightsEnabled, check.Equals, false)
 }
@@ -1,11 +1,19 @@
 package rds_test
 
 var DescribeDBInstancesExample1 = `
-<DescribeDBInstancesResponse xmlns="http://rds.amazonaws.com/doc/2013-09-09/">
+<DescribeDBInstancesResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
   <DescribeDBInstancesResult>
     <DBInstances>
       <DBInstance>
-        <ReadReplicaDBInstanceIdentifiers/>
+        <ReadReplicaDBInstanceIdentifiers>
+          <ReadReplicaDBInstanceIdentifier>simcoprod01-replica</ReadReplicaDBInstanceIdentifier>
+        </ReadReplicaDBInstanceIdentifiers>
+        <VpcSecurityGroups>
+          <VpcSecurityGroupMembership>
+            <VpcSecurityGroupId>sg-1a2b3c4d</VpcSecurityGroupId>
+            <Status>active</Status>
+          </VpcSecurityGroupMembership>
+        </VpcSecurityGroups>
         <LatestRestorableTime>2011-05-23T06:50:00Z</LatestRestorableTime>
         <Engine>mysql</Engine>
         <PendingModifiedValues/>
@@ -55,3 +63,211 @@ var DescribeDBInstancesExample1 = `
   </ResponseMetadata>
 </DescribeDBInstancesResponse>
 `
+
+var CreateDBInstanceExample1 = `
+<CreateDBInstanceResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <CreateDBInstanceResult>
+    <DBInstance>
+      <DBInstanceIdentifier>mydbinstance</DBInstanceIdentifier>
+      <DBInstanceClass>db.m4.large</DBInstanceClass>
+      <Engine>postgres</Engine>
+      <DBInstanceStatus>creating</DBInstanceStatus>
+      <MasterUsername>master</MasterUsername>
+      <AllocatedStorage>100</AllocatedStorage>
+      <MultiAZ>false</MultiAZ>
+      <PendingModifiedValues>
+        <MasterUserPassword>****</MasterUserPassword>
+      </PendingModifiedValues>
+      <MonitoringInterval>15</MonitoringInterval>
+      <MonitoringRoleArn>arn:aws:iam::123456789012:role/rds-monitoring-role</MonitoringRoleArn>
+      <PerformanceInsightsEnabled>true</PerformanceInsightsEnabled>
+      <PerformanceInsightsKMSKeyId>arn:aws:kms:us-east-1:123456789012:key/0a1b2c3d-4e5f-6a7b-8c9d-0e1f2a3b4c5d</PerformanceInsightsKMSKeyId>
+      <PerformanceInsightsRetentionPeriod>7</PerformanceInsightsRetentionPeriod>
+    </DBInstance>
+  </CreateDBInstanceResult>
+  <ResponseMetadata>
+    <RequestId>523e3218-afc7-11c3-90f5-f90431260ab4</RequestId>
+  </ResponseMetadata>
+</CreateDBInstanceResponse>
+`
+
+var ModifyDBInstanceExample1 = `
+<ModifyDBInstanceResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <ModifyDBInstanceResult>
+    <DBInstance>
+      <DBInstanceIdentifier>mydbinstance</DBInstanceIdentifier>
+      <DBInstanceStatus>modifying</DBInstanceStatus>
+      <MonitoringInterval>0</MonitoringInterval>
+      <PerformanceInsightsEnabled>false</PerformanceInsightsEnabled>
+    </DBInstance>
+  </ModifyDBInstanceResult>
+  <ResponseMetadata>
+    <RequestId>f643f1ac-bbfe-11c3-b2c8-6ab2e1e4cd92</RequestId>
+  </ResponseMetadata>
+</ModifyDBInstanceResponse>
+`
+
+var CreateBlueGreenDeploymentExample1 = `
+<CreateBlueGreenDeploymentResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <CreateBlueGreenDeploymentResult>
+    <BlueGreenDeployment>
+      <BlueGreenDeploymentIdentifier>bgd-wi89nwzglccsfake</BlueGreenDeploymentIdentifier>
+      <BlueGreenDeploymentName>pg16-upgrade</BlueGreenDeploymentName>
+      <Source>arn:aws:rds:us-east-1:123456789012:db:mydbinstance</Source>
+      <SwitchoverDetails>
+        <member>
+          <SourceMember>arn:aws:rds:us-east-1:123456789012:db:mydbinstance</SourceMember>
+          <Status>PROVISIONING</Status>
+        </member>
+      </SwitchoverDetails>
+      <Tasks>
+        <member>
+          <Name>CREATING_READ_REPLICA_OF_SOURCE</Name>
+          <Status>PENDING</Status>
+        </member>
+        <member>
+          <Name>DB_ENGINE_VERSION_UPGRADE</Name>
+          <Status>PENDING</Status>
+        </member>
+      </Tasks>
+      <Status>PROVISIONING</Status>
+      <CreateTime>2023-10-14T22:26:11.234Z</CreateTime>
+      <TagList>
+        <Tag>
+          <Key>team</Key>
+          <Value>data</Value>
+        </Tag>
+      </TagList>
+    </BlueGreenDeployment>
+  </CreateBlueGreenDeploymentResult>
+  <ResponseMetadata>
+    <RequestId>5e0ea0ec-8f5c-4a2b-9f4e-2c1f4f1a7b3d</RequestId>
+  </ResponseMetadata>
+</CreateBlueGreenDeploymentResponse>
+`
+
+// DescribeBlueGreenDeploymentsDump is formatted with a status and its
+// details.
+var DescribeBlueGreenDeploymentsDump = `
+<DescribeBlueGreenDeploymentsResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DescribeBlueGreenDeploymentsResult>
+    <BlueGreenDeployments>
+      <member>
+        <BlueGreenDeploymentIdentifier>bgd-wi89nwzglccsfake</BlueGreenDeploymentIdentifier>
+        <BlueGreenDeploymentName>pg16-upgrade</BlueGreenDeploymentName>
+        <Source>arn:aws:rds:us-east-1:123456789012:db:mydbinstance</Source>
+        <Target>arn:aws:rds:us-east-1:123456789012:db:mydbinstance-green-abc123</Target>
+        <Status>%s</Status>
+        <StatusDetails>%s</StatusDetails>
+      </member>
+    </BlueGreenDeployments>
+  </DescribeBlueGreenDeploymentsResult>
+  <ResponseMetadata>
+    <RequestId>0ad1e0a6-3b5e-4b7c-a6e5-5d4b1d2f9c8e</RequestId>
+  </ResponseMetadata>
+</DescribeBlueGreenDeploymentsResponse>
+`
+
+var SwitchoverBlueGreenDeploymentExample1 = `
+<SwitchoverBlueGreenDeploymentResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <SwitchoverBlueGreenDeploymentResult>
+    <BlueGreenDeployment>
+      <BlueGreenDeploymentIdentifier>bgd-wi89nwzglccsfake</BlueGreenDeploymentIdentifier>
+      <Status>SWITCHOVER_IN_PROGRESS</Status>
+    </BlueGreenDeployment>
+  </SwitchoverBlueGreenDeploymentResult>
+  <ResponseMetadata>
+    <RequestId>6f4a8c5e-1d2b-4e3f-8a9b-7c6d5e4f3a2b</RequestId>
+  </ResponseMetadata>
+</SwitchoverBlueGreenDeploymentResponse>
+`
+
+var DeleteBlueGreenDeploymentExample1 = `
+<DeleteBlueGreenDeploymentResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DeleteBlueGreenDeploymentResult>
+    <BlueGreenDeployment>
+      <BlueGreenDeploymentIdentifier>bgd-wi89nwzglccsfake</BlueGreenDeploymentIdentifier>
+      <Status>DELETING</Status>
+    </BlueGreenDeployment>
+  </DeleteBlueGreenDeploymentResult>
+  <ResponseMetadata>
+    <RequestId>7a5b9d6f-2e3c-4f4a-9b0c-8d7e6f5a4b3c</RequestId>
+  </ResponseMetadata>
+</DeleteBlueGreenDeploymentResponse>
+`
+
+var BlueGreenDeploymentNotFound = `
+<ErrorResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>BlueGreenDeploymentNotFoundFault</Code>
+    <Message>BlueGreenDeploymentIdentifier bgd-wi89nwzglccsfake not found.</Message>
+  </Error>
+  <RequestId>8b6c0e7a-3f4d-4a5b-8c1d-9e8f7a6b5c4d</RequestId>
+</ErrorResponse>
+`
+
+var CreateDBSnapshotExample1 = `
+<CreateDBSnapshotResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <CreateDBSnapshotResult>
+    <DBSnapshot>
+      <Port>3306</Port>
+      <Engine>mysql</Engine>
+      <Status>creating</Status>
+      <AvailabilityZone>us-east-1a</AvailabilityZone>
+      <LicenseModel>general-public-license</LicenseModel>
+      <InstanceCreateTime>2011-05-23T06:06:43.110Z</InstanceCreateTime>
+      <AllocatedStorage>10</AllocatedStorage>
+      <DBInstanceIdentifier>simcoprod01</DBInstanceIdentifier>
+      <EngineVersion>5.1.50</EngineVersion>
+      <DBSnapshotIdentifier>mydbsnapshot</DBSnapshotIdentifier>
+      <SnapshotType>manual</SnapshotType>
+      <MasterUsername>master</MasterUsername>
+    </DBSnapshot>
+  </CreateDBSnapshotResult>
+  <ResponseMetadata>
+    <RequestId>c4181d1d-8505-11e0-90aa-eb648410240d</RequestId>
+  </ResponseMetadata>
+</CreateDBSnapshotResponse>
+`
+
+// DescribeDBSnapshotsDump is formatted with a status.
+var DescribeDBSnapshotsDump = `
+<DescribeDBSnapshotsResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DescribeDBSnapshotsResult>
+    <DBSnapshots>
+      <DBSnapshot>
+        <Port>3306</Port>
+        <SnapshotCreateTime>2011-05-23T06:29:03.483Z</SnapshotCreateTime>
+        <Engine>mysql</Engine>
+        <Status>%s</Status>
+        <PercentProgress>100</PercentProgress>
+        <AllocatedStorage>10</AllocatedStorage>
+        <DBInstanceIdentifier>simcoprod01</DBInstanceIdentifier>
+        <DBSnapshotIdentifier>mydbsnapshot</DBSnapshotIdentifier>
+        <SnapshotType>manual</SnapshotType>
+      </DBSnapshot>
+    </DBSnapshots>
+  </DescribeDBSnapshotsResult>
+  <ResponseMetadata>
+    <RequestId>b7769930-b98c-11d3-f272-7cd6cce12cc5</RequestId>
+  </ResponseMetadata>
+</DescribeDBSnapshotsResponse>
+`
+
+// DescribeDBInstancesDump is formatted with a status.
+var DescribeDBInstancesDump = `
+<DescribeDBInstancesResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DescribeDBInstancesResult>
+    <DBInstances>
+      <DBInstance>
+        <DBInstanceIdentifier>simcoprod01</DBInstanceIdentifier>
+        <DBInstanceStatus>%s</DBInstanceStatus>
+      </DBInstance>
+    </DBInstances>
+  </DescribeDBInstancesResult>
+  <ResponseMetadata>
+    <RequestId>9135fff3-8509-11e0-bd9b-a7b1ece36d51</RequestId>
+  </ResponseMetadata>
+</DescribeDBInstancesResponse>
+`
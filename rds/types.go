@@ -29,37 +29,43 @@ type DBEngineVersion struct {
 // DBInstance encapsulates an instance of a Database
 // See http://goo.gl/rQFpAe for more details.
 type DBInstance struct {
-	AllocatedStorage                      int                          `xml:"AllocatedStorage"`                             // Specifies the allocated storage size specified in gigabytes.
-	AutoMinorVersionUpgrade               bool                         `xml:"AutoMinorVersionUpgrade"`                      // Indicates that minor version patches are applied automatically.
-	AvailabilityZone                      string                       `xml:"AvailabilityZone"`                             // Specifies the name of the Availability Zone the DB instance is located in.
-	BackupRetentionPeriod                 int                          `xml:"BackupRetentionPeriod"`                        // Specifies the number of days for which automatic DB snapshots are retained.
-	CharacterSetName                      string                       `xml:"CharacterSetName"`                             // If present, specifies the name of the character set that this instance is associated with.
-	DBInstanceClass                       string                       `xml:"DBInstanceClass"`                              // Contains the name of the compute and memory capacity class of the DB instance.
-	DBInstanceIdentifier                  string                       `xml:"DBInstanceIdentifier"`                         // Contains a user-supplied database identifier. This is the unique key that identifies a DB instance.
-	DBInstanceStatus                      string                       `xml:"DBInstanceStatus"`                             // Specifies the current state of this database.
-	DBName                                string                       `xml:"DBName"`                                       // The meaning of this parameter differs according to the database engine you use.
-	DBParameterGroups                     []DBParameterGroupStatus     `xml:"DBParameterGroups>DBParameterGroup"`           // Provides the list of DB parameter groups applied to this DB instance.
-	DBSecurityGroups                      []DBSecurityGroupMembership  `xml:"DBSecurityGroups>DBSecurityGroup"`             // Provides List of DB security group elements containing only DBSecurityGroup.Name and DBSecurityGroup.Status subelements.
-	DBSubnetGroup                         DBSubnetGroup                `xml:"DBSubnetGroup"`                                // Specifies information on the subnet group associated with the DB instance, including the name, description, and subnets in the subnet group.
-	Endpoint                              Endpoint                     `xml:"Endpoint"`                                     // Specifies the connection endpoint.
-	Engine                                string                       `xml:"Engine"`                                       // Provides the name of the database engine to be used for this DB instance.
-	EngineVersion                         string                       `xml:"EngineVersion"`                                // Indicates the database engine version.
-	InstanceCreateTime                    string                       `xml:"InstanceCreateTime"`                           // Provides the date and time the DB instance was created.
-	Iops                                  int                          `xml:"Iops"`                                         // Specifies the Provisioned IOPS (I/O operations per second) value.
-	LatestRestorableTime                  string                       `xml:"LatestRestorableTime"`                         // Specifies the latest time to which a database can be restored with point-in-time restore.
-	LicenseModel                          string                       `xml:"LicenseModel"`                                 // License model information for this DB instance.
-	MasterUsername                        string                       `xml:"MasterUsername"`                               // Contains the master username for the DB instance.
-	MultiAZ                               bool                         `xml:"MultiAZ"`                                      // Specifies if the DB instance is a Multi-AZ deployment.
-	OptionGroupMemberships                []OptionGroupMembership      `xml:"OptionGroupMemberships>OptionGroupMembership"` // Provides the list of option group memberships for this DB instance.
-	PendingModifiedValues                 PendingModifiedValues        `xml:"PendingModifiedValues"`                        // Specifies that changes to the DB instance are pending. This element is only included when changes are pending. Specific changes are identified by subelements.
-	PreferredBackupWindow                 string                       `xml:"PreferredBackupWindow"`                        // Specifies the daily time range during which automated backups are created if automated backups are enabled, as determined by the BackupRetentionPeriod.
-	PreferredMaintenanceWindow            string                       `xml:"PreferredMaintenanceWindow"`                   // Specifies the weekly time range (in UTC) during which system maintenance can occur.
-	PubliclyAccessible                    bool                         `xml:"PubliclyAccessible"`                           // Specifies the accessibility options for the DB instance. A value of true specifies an Internet-facing instance with a publicly resolvable DNS name, which resolves to a public IP address. A value of false specifies an internal instance with a DNS name that resolves to a private IP address.
-	ReadReplicaDBInstanceIdentifiers      []string                     `xml:"ReadReplicaDBInstanceIdentifiers"`             // Contains one or more identifiers of the read replicas associated with this DB instance.
-	ReadReplicaSourceDBInstanceIdentifier string                       `xml:"ReadReplicaSourceDBInstanceIdentifier"`        // Contains the identifier of the source DB instance if this DB instance is a read replica.
-	SecondaryAvailabilityZone             string                       `xml:"SecondaryAvailabilityZone"`                    // If present, specifies the name of the secondary Availability Zone for a DB instance with multi-AZ support.
-	StatusInfos                           []DBInstanceStatusInfo       `xml:"StatusInfos"`                                  // The status of a read replica. If the instance is not a read replica, this will be blank.
-	VpcSecurityGroups                     []VpcSecurityGroupMembership `xml:"VpcSecurityGroups"`                            // Provides List of VPC security group elements that the DB instance belongs to.
+	AllocatedStorage                      int                          `xml:"AllocatedStorage"`                                                 // Specifies the allocated storage size specified in gigabytes.
+	AutoMinorVersionUpgrade               bool                         `xml:"AutoMinorVersionUpgrade"`                                          // Indicates that minor version patches are applied automatically.
+	AvailabilityZone                      string                       `xml:"AvailabilityZone"`                                                 // Specifies the name of the Availability Zone the DB instance is located in.
+	BackupRetentionPeriod                 int                          `xml:"BackupRetentionPeriod"`                                            // Specifies the number of days for which automatic DB snapshots are retained.
+	CharacterSetName                      string                       `xml:"CharacterSetName"`                                                 // If present, specifies the name of the character set that this instance is associated with.
+	DBInstanceClass                       string                       `xml:"DBInstanceClass"`                                                  // Contains the name of the compute and memory capacity class of the DB instance.
+	DBInstanceIdentifier                  string                       `xml:"DBInstanceIdentifier"`                                             // Contains a user-supplied database identifier. This is the unique key that identifies a DB instance.
+	DBInstanceStatus                      string                       `xml:"DBInstanceStatus"`                                                 // Specifies the current state of this database.
+	DBName                                string                       `xml:"DBName"`                                                           // The meaning of this parameter differs according to the database engine you use.
+	DBParameterGroups                     []DBParameterGroupStatus     `xml:"DBParameterGroups>DBParameterGroup"`                               // Provides the list of DB parameter groups applied to this DB instance.
+	DBSecurityGroups                      []DBSecurityGroupMembership  `xml:"DBSecurityGroups>DBSecurityGroup"`                                 // Provides List of DB security group elements containing only DBSecurityGroup.Name and DBSecurityGroup.Status subelements.
+	DBSubnetGroup                         DBSubnetGroup                `xml:"DBSubnetGroup"`                                                    // Specifies information on the subnet group associated with the DB instance, including the name, description, and subnets in the subnet group.
+	Endpoint                              Endpoint                     `xml:"Endpoint"`                                                         // Specifies the connection endpoint.
+	Engine                                string                       `xml:"Engine"`                                                           // Provides the name of the database engine to be used for this DB instance.
+	EngineVersion                         string                       `xml:"EngineVersion"`                                                    // Indicates the database engine version.
+	InstanceCreateTime                    string                       `xml:"InstanceCreateTime"`                                               // Provides the date and time the DB instance was created.
+	Iops                                  int                          `xml:"Iops"`                                                             // Specifies the Provisioned IOPS (I/O operations per second) value.
+	LatestRestorableTime                  string                       `xml:"LatestRestorableTime"`                                             // Specifies the latest time to which a database can be restored with point-in-time restore.
+	LicenseModel                          string                       `xml:"LicenseModel"`                                                     // License model information for this DB instance.
+	MasterUsername                        string                       `xml:"MasterUsername"`                                                   // Contains the master username for the DB instance.
+	MonitoringInterval                    int                          `xml:"MonitoringInterval"`                                               // The interval, in seconds, between points when Enhanced Monitoring metrics are collected. 0 means Enhanced Monitoring is disabled.
+	MonitoringRoleArn                     string                       `xml:"MonitoringRoleArn"`                                                // The ARN of the IAM role that permits RDS to send Enhanced Monitoring metrics to CloudWatch Logs.
+	EnhancedMonitoringResourceArn         string                       `xml:"EnhancedMonitoringResourceArn"`                                    // The ARN of the CloudWatch Logs log stream that receives the Enhanced Monitoring metrics.
+	MultiAZ                               bool                         `xml:"MultiAZ"`                                                          // Specifies if the DB instance is a Multi-AZ deployment.
+	OptionGroupMemberships                []OptionGroupMembership      `xml:"OptionGroupMemberships>OptionGroupMembership"`                     // Provides the list of option group memberships for this DB instance.
+	PendingModifiedValues                 PendingModifiedValues        `xml:"PendingModifiedValues"`                                            // Specifies that changes to the DB instance are pending. This element is only included when changes are pending. Specific changes are identified by subelements.
+	PerformanceInsightsEnabled            bool                         `xml:"PerformanceInsightsEnabled"`                                       // Indicates whether Performance Insights is enabled for the DB instance.
+	PerformanceInsightsKMSKeyId           string                       `xml:"PerformanceInsightsKMSKeyId"`                                      // The KMS key used to encrypt the Performance Insights data.
+	PerformanceInsightsRetentionPeriod    int                          `xml:"PerformanceInsightsRetentionPeriod"`                               // The number of days to retain Performance Insights data.
+	PreferredBackupWindow                 string                       `xml:"PreferredBackupWindow"`                                            // Specifies the daily time range during which automated backups are created if automated backups are enabled, as determined by the BackupRetentionPeriod.
+	PreferredMaintenanceWindow            string                       `xml:"PreferredMaintenanceWindow"`                                       // Specifies the weekly time range (in UTC) during which system maintenance can occur.
+	PubliclyAccessible                    bool                         `xml:"PubliclyAccessible"`                                               // Specifies the accessibility options for the DB instance. A value of true specifies an Internet-facing instance with a publicly resolvable DNS name, which resolves to a public IP address. A value of false specifies an internal instance with a DNS name that resolves to a private IP address.
+	ReadReplicaDBInstanceIdentifiers      []string                     `xml:"ReadReplicaDBInstanceIdentifiers>ReadReplicaDBInstanceIdentifier"` // Contains one or more identifiers of the read replicas associated with this DB instance.
+	ReadReplicaSourceDBInstanceIdentifier string                       `xml:"ReadReplicaSourceDBInstanceIdentifier"`                            // Contains the identifier of the source DB instance if this DB instance is a read replica.
+	SecondaryAvailabilityZone             string                       `xml:"SecondaryAvailabilityZone"`                                        // If present, specifies the name of the secondary Availability Zone for a DB instance with multi-AZ support.
+	StatusInfos                           []DBInstanceStatusInfo       `xml:"StatusInfos>DBInstanceStatusInfo"`                                 // The status of a read replica. If the instance is not a read replica, this will be blank.
+	VpcSecurityGroups                     []VpcSecurityGroupMembership `xml:"VpcSecurityGroups>VpcSecurityGroupMembership"`                     // Provides List of VPC security group elements that the DB instance belongs to.
 }
 
 // DBInstanceStatusInfo provides a list of status information for a DB instance
@@ -0,0 +1,83 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/awsutil"
+)
+
+// The defaults of WaitOptions. Blue/green deployments take much longer to
+// provision than the 15 minutes of the AWS SDK instance waiters, so the
+// timeout is generous.
+const (
+	DefaultWaitInterval = 30 * time.Second
+	DefaultWaitTimeout  = 2 * time.Hour
+)
+
+// WaitOptions configures how the WaitUntil methods poll RDS. Zero values
+// use DefaultWaitInterval and DefaultWaitTimeout.
+type WaitOptions = awsutil.WaitOptions
+
+var defaultWait = WaitOptions{Interval: DefaultWaitInterval, Timeout: DefaultWaitTimeout}
+
+// The statuses from which a DB instance or snapshot can't become available,
+// as in the AWS SDK waiters.
+var unavailableStatuses = []string{"deleted", "deleting", "failed", "incompatible-restore", "incompatible-parameters"}
+
+// StatusError is returned by WaitUntilDBInstanceAvailable and
+// WaitUntilDBSnapshotAvailable when the instance or snapshot reaches a
+// status from which it can't become available.
+type StatusError struct {
+	Identifier string
+	Status     string
+	Want       string
+}
+
+func (err *StatusError) Error() string {
+	return fmt.Sprintf("rds: %s is %s while waiting for it to be %s", err.Identifier, err.Status, err.Want)
+}
+
+// WaitUntilDBInstanceAvailable polls RDS until a DB instance is available,
+// such as after CreateDBInstance or ModifyDBInstance.
+//
+// The wait ends early with ctx's error when ctx is done, with
+// context.DeadlineExceeded when the timeout of opts is reached, and with a
+// *StatusError when the instance is deleted or failed.
+func (rds *RDS) WaitUntilDBInstanceAvailable(ctx context.Context, opts *WaitOptions, id string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := rds.DescribeDBInstances(id, 0, "")
+		if err != nil {
+			return false, err
+		}
+		if len(resp.DBInstances) == 0 {
+			return false, nil
+		}
+		return checkAvailable(id, resp.DBInstances[0].DBInstanceStatus)
+	})
+}
+
+// WaitUntilDBSnapshotAvailable polls RDS until a DB snapshot is available.
+// It ends early as WaitUntilDBInstanceAvailable does.
+func (rds *RDS) WaitUntilDBSnapshotAvailable(ctx context.Context, opts *WaitOptions, snapshotId string) error {
+	return awsutil.Poll(ctx, opts, defaultWait, func() (bool, error) {
+		resp, err := rds.DescribeDBSnapshots("", snapshotId, 0, "")
+		if err != nil {
+			return false, err
+		}
+		if len(resp.DBSnapshots) == 0 {
+			return false, nil
+		}
+		return checkAvailable(snapshotId, resp.DBSnapshots[0].Status)
+	})
+}
+
+func checkAvailable(id, status string) (bool, error) {
+	for _, fail := range unavailableStatuses {
+		if status == fail {
+			return false, &StatusError{id, status, "available"}
+		}
+	}
+	return status == "available", nil
+}
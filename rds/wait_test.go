@@ -0,0 +1,83 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zackbloom/goamz/rds"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestCreateDBSnapshot(c *check.C) {
+	testServer.Response(200, nil, CreateDBSnapshotExample1)
+
+	resp, err := s.rds.CreateDBSnapshot("simcoprod01", "mydbsnapshot", []rds.Tag{{Key: "env", Value: "prod"}})
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateDBSnapshot"})
+	c.Assert(req.Form["DBInstanceIdentifier"], check.DeepEquals, []string{"simcoprod01"})
+	c.Assert(req.Form["DBSnapshotIdentifier"], check.DeepEquals, []string{"mydbsnapshot"})
+	c.Assert(req.Form["Tags.member.1.Key"], check.DeepEquals, []string{"env"})
+	c.Assert(req.Form["Tags.member.1.Value"], check.DeepEquals, []string{"prod"})
+
+	c.Assert(resp.DBSnapshot.DBSnapshotIdentifier, check.Equals, "mydbsnapshot")
+	c.Assert(resp.DBSnapshot.Status, check.Equals, "creating")
+	c.Assert(resp.DBSnapshot.SnapshotType, check.Equals, "manual")
+	c.Assert(resp.RequestId, check.Equals, "c4181d1d-8505-11e0-90aa-eb648410240d")
+}
+
+func (s *S) TestDescribeDBSnapshots(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeDBSnapshotsDump, "available"))
+
+	resp, err := s.rds.DescribeDBSnapshots("simcoprod01", "", 20, "")
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeDBSnapshots"})
+	c.Assert(req.Form["DBInstanceIdentifier"], check.DeepEquals, []string{"simcoprod01"})
+	c.Assert(req.Form["MaxRecords"], check.DeepEquals, []string{"20"})
+	_, ok := req.Form["DBSnapshotIdentifier"]
+	c.Assert(ok, check.Equals, false)
+
+	c.Assert(resp.DBSnapshots, check.HasLen, 1)
+	c.Assert(resp.DBSnapshots[0].DBSnapshotIdentifier, check.Equals, "mydbsnapshot")
+	c.Assert(resp.DBSnapshots[0].PercentProgress, check.Equals, 100)
+}
+
+func (s *S) TestWaitUntilDBInstanceAvailable(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeDBInstancesDump, "modifying"))
+	testServer.Response(200, nil, fmt.Sprintf(DescribeDBInstancesDump, "available"))
+
+	err := s.rds.WaitUntilDBInstanceAvailable(context.Background(), fastWait, "simcoprod01")
+	c.Assert(err, check.IsNil)
+
+	for i := 0; i < 2; i++ {
+		req := testServer.WaitRequest()
+		c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeDBInstances"})
+		c.Assert(req.Form["DBInstanceIdentifier"], check.DeepEquals, []string{"simcoprod01"})
+	}
+}
+
+func (s *S) TestWaitUntilDBInstanceAvailableFails(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeDBInstancesDump, "incompatible-parameters"))
+
+	err := s.rds.WaitUntilDBInstanceAvailable(context.Background(), fastWait, "simcoprod01")
+	testServer.WaitRequest()
+	c.Assert(err, check.DeepEquals, &rds.StatusError{Identifier: "simcoprod01", Status: "incompatible-parameters", Want: "available"})
+	c.Assert(err, check.ErrorMatches, "rds: simcoprod01 is incompatible-parameters while waiting for it to be available")
+}
+
+func (s *S) TestWaitUntilDBSnapshotAvailable(c *check.C) {
+	testServer.Response(200, nil, fmt.Sprintf(DescribeDBSnapshotsDump, "creating"))
+	testServer.Response(200, nil, fmt.Sprintf(DescribeDBSnapshotsDump, "available"))
+
+	err := s.rds.WaitUntilDBSnapshotAvailable(context.Background(), fastWait, "mydbsnapshot")
+	c.Assert(err, check.IsNil)
+
+	for i := 0; i < 2; i++ {
+		req := testServer.WaitRequest()
+		c.Assert(req.Form["Action"], check.DeepEquals, []string{"DescribeDBSnapshots"})
+		c.Assert(req.Form["DBSnapshotIdentifier"], check.DeepEquals, []string{"mydbsnapshot"})
+	}
+}
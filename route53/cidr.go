@@ -0,0 +1,193 @@
+package route53
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Actions of a CidrCollectionChange.
+const (
+	CidrActionPut            = "PUT"
+	CidrActionDeleteIfExists = "DELETE_IF_EXISTS"
+)
+
+// CidrDefaultLocation is the location name of the record set that answers
+// queries from IP addresses in none of the locations of a CIDR collection.
+const CidrDefaultLocation = "*"
+
+// CidrRoutingConfig routes queries to a record set when they come from an
+// IP address in the CIDR blocks of the location LocationName of a CIDR
+// collection.
+//
+// See http://docs.aws.amazon.com/Route53/latest/APIReference/API_CidrRoutingConfig.html
+type CidrRoutingConfig struct {
+	CollectionId string
+	LocationName string
+}
+
+// CidrCollection is a named group of CIDR blocks, each in a location, for
+// IP-based routing. Version is incremented by every change.
+type CidrCollection struct {
+	Arn     string
+	Id      string
+	Name    string
+	Version int64
+}
+
+// CidrCollectionChange adds (PUT) or removes (DELETE_IF_EXISTS) CIDR
+// blocks of a location. A location is removed with its last block.
+//
+// See http://docs.aws.amazon.com/Route53/latest/APIReference/API_CidrCollectionChange.html
+type CidrCollectionChange struct {
+	Action       string
+	LocationName string
+	CidrList     []string `xml:"CidrList>Cidr"`
+}
+
+// CidrBlock is a CIDR block of a location of a CIDR collection.
+type CidrBlock struct {
+	CidrBlock    string
+	LocationName string
+}
+
+type CreateCidrCollectionRequest struct {
+	XMLName         xml.Name `xml:"CreateCidrCollectionRequest"`
+	Xmlns           string   `xml:"xmlns,attr"`
+	Name            string
+	CallerReference string
+}
+
+type CreateCidrCollectionResponse struct {
+	XMLName    xml.Name `xml:"CreateCidrCollectionResponse"`
+	Collection CidrCollection
+}
+
+// ChangeCidrCollectionRequest is a batch of changes to a CIDR collection,
+// which Route53 applies all together or not at all. When CollectionVersion
+// is set, the changes fail if the collection was changed since that
+// version.
+type ChangeCidrCollectionRequest struct {
+	XMLName           xml.Name               `xml:"ChangeCidrCollectionRequest"`
+	Xmlns             string                 `xml:"xmlns,attr"`
+	CollectionVersion int64                  `xml:",omitempty"`
+	Changes           []CidrCollectionChange `xml:"Changes>member"`
+}
+
+type ChangeCidrCollectionResponse struct {
+	XMLName xml.Name `xml:"ChangeCidrCollectionResponse"`
+	Id      string
+}
+
+type ListCidrCollectionsResponse struct {
+	XMLName         xml.Name         `xml:"ListCidrCollectionsResponse"`
+	CidrCollections []CidrCollection `xml:"CidrCollections>member"`
+	NextToken       string
+}
+
+type ListCidrLocationsResponse struct {
+	XMLName       xml.Name `xml:"ListCidrLocationsResponse"`
+	LocationNames []string `xml:"CidrLocations>member>LocationName"`
+	NextToken     string
+}
+
+type ListCidrBlocksResponse struct {
+	XMLName    xml.Name    `xml:"ListCidrBlocksResponse"`
+	CidrBlocks []CidrBlock `xml:"CidrBlocks>member"`
+	NextToken  string
+}
+
+// CreateCidrCollection creates an empty CIDR collection. CallerReference
+// must be unique to the request, so that it can be retried safely.
+func (r *Route53) CreateCidrCollection(req *CreateCidrCollectionRequest) (*CreateCidrCollectionResponse, error) {
+	req.Xmlns = "https://route53.amazonaws.com/doc/" + route53_ver + "/"
+
+	xmlBytes, err := xml.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	xmlBytes = []byte(xml.Header + string(xmlBytes))
+
+	result := new(CreateCidrCollectionResponse)
+	err = r.query("POST", r.apiRoot()+"/cidrcollection", bytes.NewBuffer(xmlBytes), result)
+
+	return result, err
+}
+
+// ChangeCidrCollection adds and removes CIDR blocks of the locations of the
+// CIDR collection with the given id.
+func (r *Route53) ChangeCidrCollection(id string, req *ChangeCidrCollectionRequest) (*ChangeCidrCollectionResponse, error) {
+	req.Xmlns = "https://route53.amazonaws.com/doc/" + route53_ver + "/"
+
+	xmlBytes, err := xml.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	xmlBytes = []byte(xml.Header + string(xmlBytes))
+
+	result := new(ChangeCidrCollectionResponse)
+	path := fmt.Sprintf("%s/cidrcollection/%s", r.apiRoot(), id)
+	err = r.query("POST", path, bytes.NewBuffer(xmlBytes), result)
+
+	return result, err
+}
+
+// DeleteCidrCollection deletes the CIDR collection with the given id, which
+// must be empty and not used by any record set.
+func (r *Route53) DeleteCidrCollection(id string) error {
+	var result struct{}
+	path := fmt.Sprintf("%s/cidrcollection/%s", r.apiRoot(), id)
+	return r.query("DELETE", path, nil, &result)
+}
+
+// ListCidrCollections fetches a page of the CIDR collections of the
+// account. nextToken is "" for the first page, then the NextToken of the
+// previous one.
+func (r *Route53) ListCidrCollections(nextToken string, maxResults int) (result *ListCidrCollectionsResponse, err error) {
+	path := fmt.Sprintf("%s/cidrcollection?%s", r.apiRoot(), pageParams(nextToken, maxResults).Encode())
+
+	result = new(ListCidrCollectionsResponse)
+	err = r.query("GET", path, nil, result)
+
+	return
+}
+
+// ListCidrLocations fetches a page of the location names of the CIDR
+// collection with the given id, paginated as in ListCidrCollections.
+func (r *Route53) ListCidrLocations(id string, nextToken string, maxResults int) (result *ListCidrLocationsResponse, err error) {
+	path := fmt.Sprintf("%s/cidrcollection/%s?%s", r.apiRoot(), id, pageParams(nextToken, maxResults).Encode())
+
+	result = new(ListCidrLocationsResponse)
+	err = r.query("GET", path, nil, result)
+
+	return
+}
+
+// ListCidrBlocks fetches a page of the CIDR blocks of the CIDR collection
+// with the given id, paginated as in ListCidrCollections. If locationName
+// is not "", only the blocks of that location are listed.
+func (r *Route53) ListCidrBlocks(id string, locationName string, nextToken string, maxResults int) (result *ListCidrBlocksResponse, err error) {
+	params := pageParams(nextToken, maxResults)
+	if locationName != "" {
+		params.Set("location", locationName)
+	}
+	path := fmt.Sprintf("%s/cidrcollection/%s/cidrblocks?%s", r.apiRoot(), id, params.Encode())
+
+	result = new(ListCidrBlocksResponse)
+	err = r.query("GET", path, nil, result)
+
+	return
+}
+
+func pageParams(nextToken string, maxResults int) url.Values {
+	params := url.Values{}
+	if nextToken != "" {
+		params.Set("nexttoken", nextToken)
+	}
+	if maxResults > 0 {
+		params.Set("maxresults", strconv.Itoa(maxResults))
+	}
+	return params
+}
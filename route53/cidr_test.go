@@ -0,0 +1,92 @@
+package route53_test
+
+import (
+	"encoding/xml"
+
+	"github.com/zackbloom/goamz/route53"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestMarshalCidrRoutedChange(c *check.C) {
+	change := route53.Change{
+		Action:        route53.ActionUpsert,
+		Name:          "api.example.com.",
+		Type:          "A",
+		SetIdentifier: "isp-a",
+		TTL:           60,
+		Values:        []route53.ResourceRecordValue{{"198.51.100.10"}},
+		CidrRoutingConfig: &route53.CidrRoutingConfig{
+			CollectionId: "c8c02a84-aaaa-bbbb-e0d2-d833a2f80106",
+			LocationName: "isp-a",
+		},
+	}
+	out, err := xml.Marshal(change)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(out), check.Equals, `<Change><Action>UPSERT</Action><ResourceRecordSet>`+
+		`<Name>api.example.com.</Name><Type>A</Type><SetIdentifier>isp-a</SetIdentifier><TTL>60</TTL>`+
+		`<ResourceRecords><ResourceRecord><Value>198.51.100.10</Value></ResourceRecord></ResourceRecords>`+
+		`<CidrRoutingConfig><CollectionId>c8c02a84-aaaa-bbbb-e0d2-d833a2f80106</CollectionId><LocationName>isp-a</LocationName></CidrRoutingConfig>`+
+		`</ResourceRecordSet></Change>`)
+}
+
+func (s *S) TestMarshalChangeCidrCollection(c *check.C) {
+	req := route53.ChangeCidrCollectionRequest{
+		CollectionVersion: 3,
+		Changes: []route53.CidrCollectionChange{
+			{Action: route53.CidrActionPut, LocationName: "isp-a", CidrList: []string{"192.0.2.0/24", "2001:db8::/32"}},
+			{Action: route53.CidrActionDeleteIfExists, LocationName: "isp-b", CidrList: []string{"203.0.113.0/24"}},
+		},
+	}
+	out, err := xml.Marshal(req)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(out), check.Equals, `<ChangeCidrCollectionRequest xmlns="">`+
+		`<CollectionVersion>3</CollectionVersion><Changes>`+
+		`<member><Action>PUT</Action><LocationName>isp-a</LocationName>`+
+		`<CidrList><Cidr>192.0.2.0/24</Cidr><Cidr>2001:db8::/32</Cidr></CidrList></member>`+
+		`<member><Action>DELETE_IF_EXISTS</Action><LocationName>isp-b</LocationName>`+
+		`<CidrList><Cidr>203.0.113.0/24</Cidr></CidrList></member>`+
+		`</Changes></ChangeCidrCollectionRequest>`)
+}
+
+func (s *S) TestUnmarshalListCidrCollections(c *check.C) {
+	var resp route53.ListCidrCollectionsResponse
+	err := xml.Unmarshal([]byte(ListCidrCollectionsExample), &resp)
+	c.Assert(err, check.IsNil)
+
+	c.Assert(resp.NextToken, check.Equals, "AAAAAQ")
+	c.Assert(resp.CidrCollections, check.DeepEquals, []route53.CidrCollection{{
+		Arn:     "arn:aws:route53:::cidrcollection/c8c02a84-aaaa-bbbb-e0d2-d833a2f80106",
+		Id:      "c8c02a84-aaaa-bbbb-e0d2-d833a2f80106",
+		Name:    "isp-prefixes",
+		Version: 3,
+	}})
+}
+
+func (s *S) TestUnmarshalListCidrLocationsAndBlocks(c *check.C) {
+	var locations route53.ListCidrLocationsResponse
+	err := xml.Unmarshal([]byte(ListCidrLocationsExample), &locations)
+	c.Assert(err, check.IsNil)
+	c.Assert(locations.LocationNames, check.DeepEquals, []string{"isp-a", "isp-b"})
+
+	var blocks route53.ListCidrBlocksResponse
+	err = xml.Unmarshal([]byte(ListCidrBlocksExample), &blocks)
+	c.Assert(err, check.IsNil)
+	c.Assert(blocks.NextToken, check.Equals, "")
+	c.Assert(blocks.CidrBlocks, check.DeepEquals, []route53.CidrBlock{
+		{CidrBlock: "192.0.2.0/24", LocationName: "isp-a"},
+		{CidrBlock: "2001:db8::/32", LocationName: "isp-a"},
+	})
+}
+
+func (s *S) TestUnmarshalCidrRoutedRecordSet(c *check.C) {
+	var resp route53.ListResourceRecordSetsResponse
+	err := xml.Unmarshal([]byte(ListCidrRoutedRecordSetsExample), &resp)
+	c.Assert(err, check.IsNil)
+
+	sets := resp.GetResourceRecordSets()
+	c.Assert(sets, check.HasLen, 1)
+	c.Assert(sets[0].CidrRoutingConfig, check.DeepEquals, &route53.CidrRoutingConfig{
+		CollectionId: "c8c02a84-aaaa-bbbb-e0d2-d833a2f80106",
+		LocationName: "isp-a",
+	})
+}
@@ -0,0 +1,245 @@
+package route53
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Values of HealthCheckConfig.Type.
+const (
+	HealthCheckHTTP          = "HTTP"
+	HealthCheckHTTPS         = "HTTPS"
+	HealthCheckHTTPStrMatch  = "HTTP_STR_MATCH"
+	HealthCheckHTTPSStrMatch = "HTTPS_STR_MATCH"
+	HealthCheckTCP           = "TCP"
+	HealthCheckCalculated    = "CALCULATED"
+)
+
+// HealthCheckIds lists the child health checks of a calculated health
+// check.
+type HealthCheckIds []string
+
+func (ids HealthCheckIds) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalList(e, start, "ChildHealthCheck", ids)
+}
+
+func (ids *HealthCheckIds) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalList(d, start, "ChildHealthCheck", (*[]string)(ids))
+}
+
+// HealthCheckRegions lists the regions a health check is made from, such as
+// "us-east-1". At least three are needed; all of them are used if none are
+// given.
+type HealthCheckRegions []string
+
+func (regions HealthCheckRegions) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalList(e, start, "Region", regions)
+}
+
+func (regions *HealthCheckRegions) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalList(d, start, "Region", (*[]string)(regions))
+}
+
+// HealthCheckConfig describes what a health check checks. Endpoint checks
+// need Type and either IPAddress or FullyQualifiedDomainName; calculated
+// checks need ChildHealthChecks and HealthThreshold.
+//
+// See http://docs.aws.amazon.com/Route53/latest/APIReference/API_HealthCheckConfig.html
+type HealthCheckConfig struct {
+	IPAddress                string             `xml:",omitempty"`
+	Port                     int                `xml:",omitempty"`
+	Type                     string             `xml:"Type"`
+	ResourcePath             string             `xml:",omitempty"`
+	FullyQualifiedDomainName string             `xml:",omitempty"`
+	SearchString             string             `xml:",omitempty"` // for the *_STR_MATCH types
+	RequestInterval          int                `xml:",omitempty"` // 10 or 30 seconds
+	FailureThreshold         int                `xml:",omitempty"`
+	MeasureLatency           bool               `xml:",omitempty"`
+	Inverted                 bool               `xml:",omitempty"`
+	Disabled                 bool               `xml:",omitempty"`
+	HealthThreshold          int                `xml:",omitempty"`
+	ChildHealthChecks        HealthCheckIds     `xml:",omitempty"`
+	EnableSNI                bool               `xml:",omitempty"`
+	Regions                  HealthCheckRegions `xml:",omitempty"`
+}
+
+type HealthCheck struct {
+	Id                 string
+	CallerReference    string
+	HealthCheckConfig  HealthCheckConfig
+	HealthCheckVersion int64
+}
+
+type CreateHealthCheckRequest struct {
+	XMLName           xml.Name `xml:"CreateHealthCheckRequest"`
+	Xmlns             string   `xml:"xmlns,attr"`
+	CallerReference   string
+	HealthCheckConfig HealthCheckConfig
+}
+
+type CreateHealthCheckResponse struct {
+	XMLName     xml.Name `xml:"CreateHealthCheckResponse"`
+	HealthCheck HealthCheck
+}
+
+// UpdateHealthCheckRequest holds the settings of a health check to change;
+// the others are left as they are. Its type can't be changed. When
+// HealthCheckVersion is set, the update fails if the health check was
+// changed since that version. ResetElements lists optional settings to
+// reset to their defaults, such as "ResourcePath" or "Regions".
+//
+// See http://docs.aws.amazon.com/Route53/latest/APIReference/API_UpdateHealthCheck.html
+type UpdateHealthCheckRequest struct {
+	XMLName                  xml.Name           `xml:"UpdateHealthCheckRequest"`
+	Xmlns                    string             `xml:"xmlns,attr"`
+	HealthCheckVersion       int64              `xml:",omitempty"`
+	IPAddress                string             `xml:",omitempty"`
+	Port                     int                `xml:",omitempty"`
+	ResourcePath             string             `xml:",omitempty"`
+	FullyQualifiedDomainName string             `xml:",omitempty"`
+	SearchString             string             `xml:",omitempty"`
+	FailureThreshold         int                `xml:",omitempty"`
+	Inverted                 *bool              `xml:",omitempty"`
+	Disabled                 *bool              `xml:",omitempty"`
+	HealthThreshold          *int               `xml:",omitempty"`
+	ChildHealthChecks        HealthCheckIds     `xml:",omitempty"`
+	EnableSNI                *bool              `xml:",omitempty"`
+	Regions                  HealthCheckRegions `xml:",omitempty"`
+	ResetElements            []string           `xml:"ResetElements>ResettableElementName,omitempty"`
+}
+
+type UpdateHealthCheckResponse struct {
+	XMLName     xml.Name `xml:"UpdateHealthCheckResponse"`
+	HealthCheck HealthCheck
+}
+
+type ListHealthChecksResponse struct {
+	XMLName      xml.Name      `xml:"ListHealthChecksResponse"`
+	HealthChecks []HealthCheck `xml:"HealthChecks>HealthCheck"`
+	Marker       string
+	IsTruncated  bool
+	NextMarker   string
+	MaxItems     int
+}
+
+// HealthCheckObservation is the last result of a health check from one of
+// its regions. Status starts with "Success" or "Failure", followed by the
+// reason.
+type HealthCheckObservation struct {
+	Region      string
+	IPAddress   string
+	Status      string `xml:"StatusReport>Status"`
+	CheckedTime string `xml:"StatusReport>CheckedTime"`
+}
+
+type GetHealthCheckStatusResponse struct {
+	XMLName                 xml.Name                 `xml:"GetHealthCheckStatusResponse"`
+	HealthCheckObservations []HealthCheckObservation `xml:"HealthCheckObservations>HealthCheckObservation"`
+}
+
+// CreateHealthCheck creates a health check. CallerReference must be unique
+// to the request, so that it can be retried safely.
+func (r *Route53) CreateHealthCheck(req *CreateHealthCheckRequest) (*CreateHealthCheckResponse, error) {
+	req.Xmlns = "https://route53.amazonaws.com/doc/" + route53_ver + "/"
+
+	xmlBytes, err := xml.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	xmlBytes = []byte(xml.Header + string(xmlBytes))
+
+	result := new(CreateHealthCheckResponse)
+	err = r.query("POST", r.apiRoot()+"/healthcheck", bytes.NewBuffer(xmlBytes), result)
+
+	return result, err
+}
+
+// UpdateHealthCheck changes the settings of the health check with the given id.
+func (r *Route53) UpdateHealthCheck(id string, req *UpdateHealthCheckRequest) (*UpdateHealthCheckResponse, error) {
+	req.Xmlns = "https://route53.amazonaws.com/doc/" + route53_ver + "/"
+
+	xmlBytes, err := xml.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	xmlBytes = []byte(xml.Header + string(xmlBytes))
+
+	result := new(UpdateHealthCheckResponse)
+	path := fmt.Sprintf("%s/healthcheck/%s", r.apiRoot(), id)
+	err = r.query("POST", path, bytes.NewBuffer(xmlBytes), result)
+
+	return result, err
+}
+
+// DeleteHealthCheck deletes the health check with the given id. Record sets
+// using it stop being health checked.
+func (r *Route53) DeleteHealthCheck(id string) error {
+	var result struct{}
+	path := fmt.Sprintf("%s/healthcheck/%s", r.apiRoot(), id)
+	return r.query("DELETE", path, nil, &result)
+}
+
+// GetHealthCheckStatus fetches the latest observations of the health check
+// with the given id.
+func (r *Route53) GetHealthCheckStatus(id string) (result *GetHealthCheckStatusResponse, err error) {
+	path := fmt.Sprintf("%s/healthcheck/%s/status", r.apiRoot(), id)
+
+	result = new(GetHealthCheckStatusResponse)
+	err = r.query("GET", path, nil, result)
+
+	return
+}
+
+// ListHealthChecks fetches a page of the health checks of the account. marker
+// is "" for the first page, then the NextMarker of the previous one.
+func (r *Route53) ListHealthChecks(marker string, maxItems int) (result *ListHealthChecksResponse, err error) {
+	params := url.Values{}
+	if marker != "" {
+		params.Set("marker", marker)
+	}
+	if maxItems > 0 {
+		params.Set("maxitems", strconv.Itoa(maxItems))
+	}
+	path := fmt.Sprintf("%s/healthcheck?%s", r.apiRoot(), params.Encode())
+
+	result = new(ListHealthChecksResponse)
+	err = r.query("GET", path, nil, result)
+
+	return
+}
+
+func marshalList(e *xml.Encoder, start xml.StartElement, itemName string, items []string) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := e.EncodeElement(item, xml.StartElement{Name: xml.Name{Local: itemName}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+func unmarshalList(d *xml.Decoder, start xml.StartElement, itemName string, items *[]string) error {
+	var list struct {
+		Items []xmlItem `xml:",any"`
+	}
+	if err := d.DecodeElement(&list, &start); err != nil {
+		return err
+	}
+	*items = nil
+	for _, item := range list.Items {
+		if item.XMLName.Local == itemName {
+			*items = append(*items, item.Value)
+		}
+	}
+	return nil
+}
+
+type xmlItem struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
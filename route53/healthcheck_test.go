@@ -0,0 +1,83 @@
+package route53_test
+
+import (
+	"encoding/xml"
+
+	"github.com/zackbloom/goamz/route53"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestMarshalCreateHealthCheck(c *check.C) {
+	req := route53.CreateHealthCheckRequest{
+		CallerReference: "api-primary-2017-03-01",
+		HealthCheckConfig: route53.HealthCheckConfig{
+			Port:                     443,
+			Type:                     route53.HealthCheckHTTPS,
+			ResourcePath:             "/health",
+			FullyQualifiedDomainName: "api-1.example.com",
+			FailureThreshold:         3,
+			EnableSNI:                true,
+			Regions:                  route53.HealthCheckRegions{"us-east-1", "us-west-2", "eu-west-1"},
+		},
+	}
+	out, err := xml.Marshal(req)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(out), check.Equals, `<CreateHealthCheckRequest xmlns="">`+
+		`<CallerReference>api-primary-2017-03-01</CallerReference><HealthCheckConfig>`+
+		`<Port>443</Port><Type>HTTPS</Type><ResourcePath>/health</ResourcePath>`+
+		`<FullyQualifiedDomainName>api-1.example.com</FullyQualifiedDomainName><FailureThreshold>3</FailureThreshold>`+
+		`<EnableSNI>true</EnableSNI><Regions><Region>us-east-1</Region><Region>us-west-2</Region><Region>eu-west-1</Region></Regions>`+
+		`</HealthCheckConfig></CreateHealthCheckRequest>`)
+}
+
+func (s *S) TestMarshalUpdateHealthCheck(c *check.C) {
+	inverted, threshold := false, 1
+	req := route53.UpdateHealthCheckRequest{
+		HealthCheckVersion: 2,
+		Inverted:           &inverted,
+		HealthThreshold:    &threshold,
+		ChildHealthChecks:  route53.HealthCheckIds{"hc-1", "hc-2"},
+		ResetElements:      []string{"Regions"},
+	}
+	out, err := xml.Marshal(req)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(out), check.Equals, `<UpdateHealthCheckRequest xmlns="">`+
+		`<HealthCheckVersion>2</HealthCheckVersion><Inverted>false</Inverted><HealthThreshold>1</HealthThreshold>`+
+		`<ChildHealthChecks><ChildHealthCheck>hc-1</ChildHealthCheck><ChildHealthCheck>hc-2</ChildHealthCheck></ChildHealthChecks>`+
+		`<ResetElements><ResettableElementName>Regions</ResettableElementName></ResetElements>`+
+		`</UpdateHealthCheckRequest>`)
+}
+
+func (s *S) TestUnmarshalListHealthChecks(c *check.C) {
+	var resp route53.ListHealthChecksResponse
+	err := xml.Unmarshal([]byte(ListHealthChecksExample), &resp)
+	c.Assert(err, check.IsNil)
+
+	c.Assert(resp.IsTruncated, check.Equals, true)
+	c.Assert(resp.NextMarker, check.Equals, "aaaaaaaa-2222-3333-4444-555555fedcba")
+	c.Assert(resp.HealthChecks, check.HasLen, 2)
+
+	hc := resp.HealthChecks[0]
+	c.Assert(hc.Id, check.Equals, "abcdef11-2222-3333-4444-555555fedcba")
+	c.Assert(hc.HealthCheckVersion, check.Equals, int64(1))
+	c.Assert(hc.HealthCheckConfig.Type, check.Equals, route53.HealthCheckHTTPSStrMatch)
+	c.Assert(hc.HealthCheckConfig.SearchString, check.Equals, "OK")
+	c.Assert(hc.HealthCheckConfig.Regions, check.DeepEquals, route53.HealthCheckRegions{"us-east-1", "us-west-1", "eu-west-1"})
+
+	calculated := resp.HealthChecks[1].HealthCheckConfig
+	c.Assert(calculated.Type, check.Equals, route53.HealthCheckCalculated)
+	c.Assert(calculated.HealthThreshold, check.Equals, 1)
+	c.Assert(calculated.ChildHealthChecks, check.DeepEquals, route53.HealthCheckIds{"abcdef11-2222-3333-4444-555555fedcba"})
+}
+
+func (s *S) TestUnmarshalGetHealthCheckStatus(c *check.C) {
+	var resp route53.GetHealthCheckStatusResponse
+	err := xml.Unmarshal([]byte(GetHealthCheckStatusExample), &resp)
+	c.Assert(err, check.IsNil)
+
+	c.Assert(resp.HealthCheckObservations, check.HasLen, 2)
+	c.Assert(resp.HealthCheckObservations[0].Region, check.Equals, "us-east-1")
+	c.Assert(resp.HealthCheckObservations[0].Status, check.Equals, "Success: HTTP Status Code: 200, OK")
+	c.Assert(resp.HealthCheckObservations[1].Status, check.Equals, "Failure: Connection timed out.")
+	c.Assert(resp.HealthCheckObservations[1].CheckedTime, check.Equals, "2017-03-01T17:48:25.000Z")
+}
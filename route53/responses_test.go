@@ -0,0 +1,186 @@
+package route53_test
+
+var ListResourceRecordSetsExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<ListResourceRecordSetsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <ResourceRecordSets>
+      <ResourceRecordSet>
+         <Name>api.example.com.</Name>
+         <Type>A</Type>
+         <SetIdentifier>old-fleet</SetIdentifier>
+         <Weight>0</Weight>
+         <TTL>60</TTL>
+         <ResourceRecords>
+            <ResourceRecord>
+               <Value>192.0.2.1</Value>
+            </ResourceRecord>
+            <ResourceRecord>
+               <Value>192.0.2.2</Value>
+            </ResourceRecord>
+         </ResourceRecords>
+      </ResourceRecordSet>
+      <ResourceRecordSet>
+         <Name>www.example.com.</Name>
+         <Type>A</Type>
+         <AliasTarget>
+            <HostedZoneId>Z2FDTNDATAQYW2</HostedZoneId>
+            <DNSName>d111111abcdef8.cloudfront.net.</DNSName>
+            <EvaluateTargetHealth>false</EvaluateTargetHealth>
+         </AliasTarget>
+      </ResourceRecordSet>
+   </ResourceRecordSets>
+   <IsTruncated>true</IsTruncated>
+   <MaxItems>2</MaxItems>
+   <NextRecordName>api.example.com.</NextRecordName>
+   <NextRecordType>A</NextRecordType>
+   <NextRecordIdentifier>new-fleet</NextRecordIdentifier>
+</ListResourceRecordSetsResponse>
+`
+
+var GetChangeExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<GetChangeResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <ChangeInfo>
+      <Id>/change/C2682N5HXP0BZ4</Id>
+      <Status>INSYNC</Status>
+      <SubmittedAt>2011-09-10T01:36:41.958Z</SubmittedAt>
+   </ChangeInfo>
+</GetChangeResponse>
+`
+
+var ListHealthChecksExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<ListHealthChecksResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <HealthChecks>
+      <HealthCheck>
+         <Id>abcdef11-2222-3333-4444-555555fedcba</Id>
+         <CallerReference>api-primary-2017-03-01</CallerReference>
+         <HealthCheckConfig>
+            <IPAddress>192.0.2.17</IPAddress>
+            <Port>443</Port>
+            <Type>HTTPS_STR_MATCH</Type>
+            <ResourcePath>/health</ResourcePath>
+            <FullyQualifiedDomainName>api-1.example.com</FullyQualifiedDomainName>
+            <SearchString>OK</SearchString>
+            <RequestInterval>30</RequestInterval>
+            <FailureThreshold>3</FailureThreshold>
+            <MeasureLatency>false</MeasureLatency>
+            <Inverted>false</Inverted>
+            <EnableSNI>true</EnableSNI>
+            <Regions>
+               <Region>us-east-1</Region>
+               <Region>us-west-1</Region>
+               <Region>eu-west-1</Region>
+            </Regions>
+         </HealthCheckConfig>
+         <HealthCheckVersion>1</HealthCheckVersion>
+      </HealthCheck>
+      <HealthCheck>
+         <Id>aaaaaaaa-2222-3333-4444-555555fedcba</Id>
+         <CallerReference>api-any-2017-03-01</CallerReference>
+         <HealthCheckConfig>
+            <Type>CALCULATED</Type>
+            <Inverted>false</Inverted>
+            <HealthThreshold>1</HealthThreshold>
+            <ChildHealthChecks>
+               <ChildHealthCheck>abcdef11-2222-3333-4444-555555fedcba</ChildHealthCheck>
+            </ChildHealthChecks>
+         </HealthCheckConfig>
+         <HealthCheckVersion>4</HealthCheckVersion>
+      </HealthCheck>
+   </HealthChecks>
+   <IsTruncated>true</IsTruncated>
+   <NextMarker>aaaaaaaa-2222-3333-4444-555555fedcba</NextMarker>
+   <MaxItems>2</MaxItems>
+</ListHealthChecksResponse>
+`
+
+var GetHealthCheckStatusExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<GetHealthCheckStatusResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <HealthCheckObservations>
+      <HealthCheckObservation>
+         <Region>us-east-1</Region>
+         <IPAddress>192.0.2.17</IPAddress>
+         <StatusReport>
+            <Status>Success: HTTP Status Code: 200, OK</Status>
+            <CheckedTime>2017-03-01T17:48:16.000Z</CheckedTime>
+         </StatusReport>
+      </HealthCheckObservation>
+      <HealthCheckObservation>
+         <Region>eu-west-1</Region>
+         <IPAddress>192.0.2.17</IPAddress>
+         <StatusReport>
+            <Status>Failure: Connection timed out.</Status>
+            <CheckedTime>2017-03-01T17:48:25.000Z</CheckedTime>
+         </StatusReport>
+      </HealthCheckObservation>
+   </HealthCheckObservations>
+</GetHealthCheckStatusResponse>
+`
+
+var ListCidrCollectionsExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<ListCidrCollectionsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <CidrCollections>
+      <member>
+         <Arn>arn:aws:route53:::cidrcollection/c8c02a84-aaaa-bbbb-e0d2-d833a2f80106</Arn>
+         <Id>c8c02a84-aaaa-bbbb-e0d2-d833a2f80106</Id>
+         <Name>isp-prefixes</Name>
+         <Version>3</Version>
+      </member>
+   </CidrCollections>
+   <NextToken>AAAAAQ</NextToken>
+</ListCidrCollectionsResponse>`
+
+var ListCidrBlocksExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<ListCidrBlocksResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <CidrBlocks>
+      <member>
+         <CidrBlock>192.0.2.0/24</CidrBlock>
+         <LocationName>isp-a</LocationName>
+      </member>
+      <member>
+         <CidrBlock>2001:db8::/32</CidrBlock>
+         <LocationName>isp-a</LocationName>
+      </member>
+   </CidrBlocks>
+</ListCidrBlocksResponse>`
+
+var ListCidrLocationsExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<ListCidrLocationsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <CidrLocations>
+      <member>
+         <LocationName>isp-a</LocationName>
+      </member>
+      <member>
+         <LocationName>isp-b</LocationName>
+      </member>
+   </CidrLocations>
+</ListCidrLocationsResponse>`
+
+var ListCidrRoutedRecordSetsExample = `
+<?xml version="1.0" encoding="UTF-8"?>
+<ListResourceRecordSetsResponse xmlns="https://route53.amazonaws.com/doc/2013-04-01/">
+   <ResourceRecordSets>
+      <ResourceRecordSet>
+         <Name>api.example.com.</Name>
+         <Type>A</Type>
+         <SetIdentifier>isp-a</SetIdentifier>
+         <TTL>60</TTL>
+         <ResourceRecords>
+            <ResourceRecord>
+               <Value>198.51.100.10</Value>
+            </ResourceRecord>
+         </ResourceRecords>
+         <CidrRoutingConfig>
+            <CollectionId>c8c02a84-aaaa-bbbb-e0d2-d833a2f80106</CollectionId>
+            <LocationName>isp-a</LocationName>
+         </CidrRoutingConfig>
+      </ResourceRecordSet>
+   </ResourceRecordSets>
+   <IsTruncated>false</IsTruncated>
+   <MaxItems>100</MaxItems>
+</ListResourceRecordSetsResponse>`
@@ -8,8 +8,12 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
 )
 
 type Route53 struct {
@@ -22,6 +26,36 @@ type Route53 struct {
 const route53_host = "https://route53.amazonaws.com"
 const route53_ver = "2013-04-01"
 
+// Actions of a Change. UPSERT creates the record set or replaces the one
+// with the same name, type and set identifier.
+const (
+	ActionCreate = "CREATE"
+	ActionDelete = "DELETE"
+	ActionUpsert = "UPSERT"
+)
+
+// Values of Change.Failover for failover routing.
+const (
+	FailoverPrimary   = "PRIMARY"
+	FailoverSecondary = "SECONDARY"
+)
+
+// Values of ChangeInfo.Status.
+const (
+	ChangeStatusPending = "PENDING"
+	ChangeStatusInsync  = "INSYNC"
+)
+
+// CloudFrontHostedZoneId is the hosted zone of every CloudFront
+// distribution, for use in alias targets.
+const CloudFrontHostedZoneId = "Z2FDTNDATAQYW2"
+
+// How long WaitForChange polls GetChange for.
+var changeAttempts = aws.AttemptStrategy{
+	Total: 10 * time.Minute,
+	Delay: 5 * time.Second,
+}
+
 // Factory for the route53 type
 func NewRoute53(auth aws.Auth) (*Route53, error) {
 	signer := aws.NewRoute53Signer(auth)
@@ -82,21 +116,51 @@ type CreateHostedZoneRequest struct {
 }
 
 type ResourceRecordValue struct {
-	Value string `xml:"ResourceRecord>Value"`
+	Value string `xml:"Value"`
 }
 
-type Change struct {
-	Action      string                `xml:"Action"`
-	Name        string                `xml:"ResourceRecordSet>Name"`
-	Type        string                `xml:"ResourceRecordSet>Type"`
-	TTL         int                   `xml:"ResourceRecordSet>TTL,omitempty"`
-	AliasTarget AliasTarget           `xml:"ResourceRecordSet>AliasTarget,omitempty"`
-	Values      []ResourceRecordValue `xml:"ResourceRecordSet>ResourceRecords,omitempty"`
+// ResourceRecordValues are the values of a Change.
+type ResourceRecordValues []ResourceRecordValue
+
+// MarshalXML wraps each value in a ResourceRecord element.
+func (v ResourceRecordValues) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	records := struct {
+		ResourceRecord []ResourceRecordValue
+	}{v}
+	return e.EncodeElement(records, start)
 }
 
+// Change is a change to a resource record set. A record set either has a
+// TTL and Values or an AliasTarget.
+//
+// Record sets with the same name and type are told apart by SetIdentifier
+// and routed between using one of Weight, Region, Failover or
+// CidrRoutingConfig. Weight is a pointer as a weight of 0 is valid and sends
+// no traffic to the record set.
+//
+// See http://docs.aws.amazon.com/Route53/latest/APIReference/API_ResourceRecordSet.html
+type Change struct {
+	Action        string               `xml:"Action"`
+	Name          string               `xml:"ResourceRecordSet>Name"`
+	Type          string               `xml:"ResourceRecordSet>Type"`
+	SetIdentifier string               `xml:"ResourceRecordSet>SetIdentifier,omitempty"`
+	Weight        *int                 `xml:"ResourceRecordSet>Weight,omitempty"`
+	Region        string               `xml:"ResourceRecordSet>Region,omitempty"`
+	Failover      string               `xml:"ResourceRecordSet>Failover,omitempty"`
+	TTL           int                  `xml:"ResourceRecordSet>TTL,omitempty"`
+	Values        ResourceRecordValues `xml:"ResourceRecordSet>ResourceRecords,omitempty"`
+	AliasTarget   AliasTarget          `xml:"ResourceRecordSet>AliasTarget,omitempty"`
+	HealthCheckId string               `xml:"ResourceRecordSet>HealthCheckId,omitempty"`
+
+	CidrRoutingConfig *CidrRoutingConfig `xml:"ResourceRecordSet>CidrRoutingConfig,omitempty"`
+}
+
+// ChangeResourceRecordSetsRequest is a batch of changes, which Route53
+// applies all together or not at all.
 type ChangeResourceRecordSetsRequest struct {
 	XMLName xml.Name `xml:"ChangeResourceRecordSetsRequest"`
 	Xmlns   string   `xml:"xmlns,attr"`
+	Comment string   `xml:"ChangeBatch>Comment,omitempty"`
 	Changes []Change `xml:"ChangeBatch>Changes>Change"`
 }
 
@@ -138,6 +202,23 @@ type AliasTarget struct {
 	EvaluateTargetHealth bool
 }
 
+// MarshalXML leaves out the alias target of record sets that are not
+// aliases.
+func (a AliasTarget) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a == (AliasTarget{}) {
+		return nil
+	}
+	type aliasTarget AliasTarget
+	return e.EncodeElement(aliasTarget(a), start)
+}
+
+// CloudFrontAliasTarget returns the alias target of a record set pointing at
+// the CloudFront distribution with the given domain name, such as
+// "d111111abcdef8.cloudfront.net".
+func CloudFrontAliasTarget(domainName string) AliasTarget {
+	return AliasTarget{HostedZoneId: CloudFrontHostedZoneId, DNSName: domainName}
+}
+
 type ResourceRecord struct {
 	XMLName xml.Name `xml:"ResourceRecord"`
 	Value   string
@@ -152,12 +233,16 @@ type ResourceRecordSet struct {
 	XMLName         xml.Name `xml:"ResourceRecordSet"`
 	Name            string
 	Type            string
+	SetIdentifier   string
+	Weight          *int
 	TTL             int
 	ResourceRecords []ResourceRecords
 	HealthCheckId   string
 	Region          string
 	Failover        string
 	AliasTarget     AliasTarget
+
+	CidrRoutingConfig *CidrRoutingConfig
 }
 
 type ResourceRecordSets struct {
@@ -189,6 +274,11 @@ type ChangeInfo struct {
 	SubmittedAt string
 }
 
+type GetChangeResponse struct {
+	XMLName    xml.Name `xml:"GetChangeResponse"`
+	ChangeInfo ChangeInfo
+}
+
 type DelegationSet struct {
 	XMLName     xml.Name `xml:"DelegationSet`
 	NameServers NameServers
@@ -241,6 +331,7 @@ func (r *Route53) query(method string, path string, body io.Reader, result inter
 	if err != nil {
 		return err
 	}
+	defer res.Body.Close()
 
 	if method == "POST" {
 		defer req.Body.Close()
@@ -256,6 +347,11 @@ func (r *Route53) query(method string, path string, body io.Reader, result inter
 	return err
 }
 
+// apiRoot returns the URL the resources other than hosted zones are under.
+func (r *Route53) apiRoot() string {
+	return strings.TrimSuffix(r.Endpoint, "/hostedzone")
+}
+
 // CreateHostedZone send a creation request to the AWS Route53 API
 func (r *Route53) CreateHostedZone(hostedZoneReq *CreateHostedZoneRequest) (*CreateHostedZoneResponse, error) {
 	xmlBytes, err := xml.Marshal(hostedZoneReq)
@@ -270,24 +366,54 @@ func (r *Route53) CreateHostedZone(hostedZoneReq *CreateHostedZoneRequest) (*Cre
 }
 
 // ListResourceRecordSets fetches a collection of ResourceRecordSets through the AWS Route53 API
+// The record sets are listed in order of name and type, starting at the
+// given name, type and set identifier, any of which may be "". When the
+// response IsTruncated, its NextRecordName, NextRecordType and
+// NextRecordIdentifier give where the next page starts.
 func (r *Route53) ListResourceRecordSets(hostedZone string, name string, _type string, identifier string, maxitems int) (result *ListResourceRecordSetsResponse, err error) {
-	var buffer bytes.Buffer
-	addParam(&buffer, "name", name)
-	addParam(&buffer, "type", _type)
-	addParam(&buffer, "identifier", identifier)
+	params := url.Values{}
+	if name != "" {
+		params.Set("name", name)
+	}
+	if _type != "" {
+		params.Set("type", _type)
+	}
+	if identifier != "" {
+		params.Set("identifier", identifier)
+	}
 	if maxitems > 0 {
-		addParam(&buffer, "maxitems", strconv.Itoa(maxitems))
+		params.Set("maxitems", strconv.Itoa(maxitems))
 	}
-	path := fmt.Sprintf("%s/%s/rrset?%s", r.Endpoint, hostedZone, buffer.String())
+	path := fmt.Sprintf("%s/%s/rrset?%s", r.Endpoint, hostedZone, params.Encode())
 
-	fmt.Println(path)
 	result = new(ListResourceRecordSetsResponse)
 	err = r.query("GET", path, nil, result)
 
 	return
 }
 
+// ListAllResourceRecordSets fetches every ResourceRecordSet of a hosted zone,
+// following ListResourceRecordSets pagination.
+func (r *Route53) ListAllResourceRecordSets(hostedZone string) ([]ResourceRecordSet, error) {
+	var sets []ResourceRecordSet
+	name, _type, identifier := "", "", ""
+	for {
+		resp, err := r.ListResourceRecordSets(hostedZone, name, _type, identifier, 0)
+		if err != nil {
+			return nil, err
+		}
+		sets = append(sets, resp.GetResourceRecordSets()...)
+		if !resp.IsTruncated {
+			return sets, nil
+		}
+		name, _type, identifier = resp.NextRecordName, resp.NextRecordType, resp.NextRecordIdentifier
+	}
+}
+
 func (response *ListResourceRecordSetsResponse) GetResourceRecordSets() []ResourceRecordSet {
+	if len(response.ResourceRecordSets) == 0 {
+		return nil
+	}
 	return response.ResourceRecordSets[0].ResourceRecordSet
 }
 
@@ -319,6 +445,66 @@ func (r *Route53) ChangeResourceRecordSet(req *ChangeResourceRecordSetsRequest,
 	return result, err
 }
 
+// The most changes a ChangeResourceRecordSets request may hold.
+const maxChangesPerRequest = 1000
+
+// UpsertResourceRecordSets upserts the record sets of changes, however many
+// there are, in ChangeResourceRecordSets requests of up to 1000 changes,
+// running at most concurrency requests at once. The Action of the changes
+// is ignored.
+//
+// The requests that fail are retried, and a *awsutil.BatchError is
+// returned for the changes of those that still fail, indexed in changes.
+// The ChangeInfo of the requests that succeeded are returned in no
+// particular order, to be waited for with WaitForChange.
+func (r *Route53) UpsertResourceRecordSets(zoneId string, changes []Change, concurrency int) ([]ChangeInfo, error) {
+	var mu sync.Mutex
+	var infos []ChangeInfo
+	err := awsutil.ForEachBatchWithRetry(changes, maxChangesPerRequest, concurrency, func(batch []Change) error {
+		req := &ChangeResourceRecordSetsRequest{Changes: make([]Change, len(batch))}
+		for i, change := range batch {
+			change.Action = ActionUpsert
+			req.Changes[i] = change
+		}
+		resp, err := r.ChangeResourceRecordSet(req, zoneId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		infos = append(infos, ChangeInfo{Id: resp.Id, Status: resp.Status, SubmittedAt: resp.SubmittedAt})
+		mu.Unlock()
+		return nil
+	})
+	return infos, err
+}
+
+// GetChange fetches the status of the change with the given id, as returned
+// in the ChangeInfo of the request that made it.
+func (r *Route53) GetChange(id string) (result *GetChangeResponse, err error) {
+	path := fmt.Sprintf("%s/change/%s", r.apiRoot(), strings.TrimPrefix(id, "/change/"))
+
+	result = new(GetChangeResponse)
+	err = r.query("GET", path, nil, result)
+
+	return
+}
+
+// WaitForChange polls GetChange until the change with the given id has
+// propagated to all Route53 DNS servers, which usually takes under a
+// minute. It gives up after ten minutes.
+func (r *Route53) WaitForChange(id string) (*ChangeInfo, error) {
+	for attempt := changeAttempts.Start(); attempt.Next(); {
+		resp, err := r.GetChange(id)
+		if err != nil {
+			return nil, err
+		}
+		if resp.ChangeInfo.Status == ChangeStatusInsync {
+			return &resp.ChangeInfo, nil
+		}
+	}
+	return nil, fmt.Errorf("route53: timed out waiting for change %s", id)
+}
+
 // ListedHostedZones fetches a collection of HostedZones through the AWS Route53 API
 func (r *Route53) ListHostedZones(marker string, maxItems int) (result *ListHostedZonesResponse, err error) {
 	path := ""
@@ -402,12 +588,3 @@ func (r *Route53) DisassociateVPCWithHostedZone(zoneid string, req *Disassociate
 
 	return
 }
-
-func addParam(buffer *bytes.Buffer, name, value string) {
-	if value != "" {
-		if buffer.Len() > 0 {
-			buffer.WriteString("&")
-		}
-		buffer.WriteString(fmt.Sprintf("%s=%s", name, value))
-	}
-}
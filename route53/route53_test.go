@@ -0,0 +1,148 @@
+package route53_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
+	"github.com/zackbloom/goamz/route53"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct{}
+
+func (s *S) TestMarshalChangeBatch(c *check.C) {
+	weight := 0
+	req := route53.ChangeResourceRecordSetsRequest{
+		Comment: "Shift traffic to the new fleet",
+		Changes: []route53.Change{
+			{
+				Action:        route53.ActionUpsert,
+				Name:          "api.example.com.",
+				Type:          "A",
+				SetIdentifier: "old-fleet",
+				Weight:        &weight,
+				TTL:           60,
+				Values:        []route53.ResourceRecordValue{{"192.0.2.1"}, {"192.0.2.2"}},
+			},
+			{
+				Action:      route53.ActionCreate,
+				Name:        "www.example.com.",
+				Type:        "A",
+				AliasTarget: route53.CloudFrontAliasTarget("d111111abcdef8.cloudfront.net"),
+			},
+		},
+	}
+	out, err := xml.Marshal(req)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(out), check.Equals, `<ChangeResourceRecordSetsRequest xmlns="">`+
+		`<ChangeBatch><Comment>Shift traffic to the new fleet</Comment><Changes>`+
+		`<Change><Action>UPSERT</Action><ResourceRecordSet>`+
+		`<Name>api.example.com.</Name><Type>A</Type><SetIdentifier>old-fleet</SetIdentifier><Weight>0</Weight><TTL>60</TTL>`+
+		`<ResourceRecords><ResourceRecord><Value>192.0.2.1</Value></ResourceRecord><ResourceRecord><Value>192.0.2.2</Value></ResourceRecord></ResourceRecords>`+
+		`</ResourceRecordSet></Change>`+
+		`<Change><Action>CREATE</Action><ResourceRecordSet>`+
+		`<Name>www.example.com.</Name><Type>A</Type>`+
+		`<AliasTarget><HostedZoneId>Z2FDTNDATAQYW2</HostedZoneId><DNSName>d111111abcdef8.cloudfront.net</DNSName><EvaluateTargetHealth>false</EvaluateTargetHealth></AliasTarget>`+
+		`</ResourceRecordSet></Change>`+
+		`</Changes></ChangeBatch></ChangeResourceRecordSetsRequest>`)
+}
+
+func (s *S) TestMarshalFailoverChange(c *check.C) {
+	change := route53.Change{
+		Action:        route53.ActionUpsert,
+		Name:          "db.example.com.",
+		Type:          "CNAME",
+		SetIdentifier: "primary",
+		Failover:      route53.FailoverPrimary,
+		TTL:           30,
+		Values:        []route53.ResourceRecordValue{{"db-1.example.com"}},
+		HealthCheckId: "abcdef11-2222-3333-4444-555555fedcba",
+	}
+	out, err := xml.Marshal(change)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(out), check.Equals, `<Change><Action>UPSERT</Action><ResourceRecordSet>`+
+		`<Name>db.example.com.</Name><Type>CNAME</Type><SetIdentifier>primary</SetIdentifier><Failover>PRIMARY</Failover><TTL>30</TTL>`+
+		`<ResourceRecords><ResourceRecord><Value>db-1.example.com</Value></ResourceRecord></ResourceRecords>`+
+		`<HealthCheckId>abcdef11-2222-3333-4444-555555fedcba</HealthCheckId>`+
+		`</ResourceRecordSet></Change>`)
+}
+
+func (s *S) TestUnmarshalListResourceRecordSets(c *check.C) {
+	var resp route53.ListResourceRecordSetsResponse
+	err := xml.Unmarshal([]byte(ListResourceRecordSetsExample), &resp)
+	c.Assert(err, check.IsNil)
+
+	c.Assert(resp.IsTruncated, check.Equals, true)
+	c.Assert(resp.NextRecordName, check.Equals, "api.example.com.")
+	c.Assert(resp.NextRecordType, check.Equals, "A")
+	c.Assert(resp.NextRecordIdentifier, check.Equals, "new-fleet")
+
+	sets := resp.GetResourceRecordSets()
+	c.Assert(sets, check.HasLen, 2)
+	c.Assert(sets[0].SetIdentifier, check.Equals, "old-fleet")
+	c.Assert(*sets[0].Weight, check.Equals, 0)
+	c.Assert(sets[0].GetValues(), check.DeepEquals, []string{"192.0.2.1", "192.0.2.2"})
+	c.Assert(sets[1].Weight, check.IsNil)
+	c.Assert(sets[1].AliasTarget.HostedZoneId, check.Equals, route53.CloudFrontHostedZoneId)
+	c.Assert(sets[1].GetValues(), check.HasLen, 0)
+
+	c.Assert(new(route53.ListResourceRecordSetsResponse).GetResourceRecordSets(), check.IsNil)
+}
+
+func (s *S) TestUnmarshalGetChange(c *check.C) {
+	var resp route53.GetChangeResponse
+	err := xml.Unmarshal([]byte(GetChangeExample), &resp)
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.ChangeInfo.Id, check.Equals, "/change/C2682N5HXP0BZ4")
+	c.Assert(resp.ChangeInfo.Status, check.Equals, route53.ChangeStatusInsync)
+}
+
+func (s *S) TestUpsertResourceRecordSets(c *check.C) {
+	var requests []route53.ChangeResourceRecordSetsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req route53.ChangeResourceRecordSetsRequest
+		c.Assert(xml.NewDecoder(r.Body).Decode(&req), check.IsNil)
+		c.Check(r.URL.Path, check.Equals, "/hostedzone/Z1/rrset")
+		requests = append(requests, req)
+		if len(requests) == 2 {
+			w.WriteHeader(400)
+			io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>InvalidChangeBatch</Code><Message>Invalid</Message></Error></ErrorResponse>`)
+			return
+		}
+		io.WriteString(w, `<ChangeResourceRecordSetsResponse><ChangeInfo><Id>/change/C1</Id><Status>PENDING</Status></ChangeInfo></ChangeResourceRecordSetsResponse>`)
+	}))
+	defer server.Close()
+
+	r, err := route53.NewRoute53(aws.Auth{AccessKey: "key", SecretKey: "secret"})
+	c.Assert(err, check.IsNil)
+	r.Endpoint = server.URL + "/hostedzone"
+
+	changes := make([]route53.Change, 1001)
+	for i := range changes {
+		changes[i] = route53.Change{Action: route53.ActionCreate, Name: fmt.Sprintf("host%d.example.com.", i), Type: "A"}
+	}
+	infos, err := r.UpsertResourceRecordSets("Z1", changes, 1)
+	c.Assert(infos, check.DeepEquals, []route53.ChangeInfo{{Id: "/change/C1", Status: "PENDING"}})
+
+	batchErr, ok := err.(*awsutil.BatchError)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(batchErr.Errors, check.HasLen, 1)
+	c.Check(batchErr.Errors[0].Index, check.Equals, 1000)
+
+	c.Assert(requests, check.HasLen, 2)
+	c.Check(requests[0].Changes, check.HasLen, 1000)
+	c.Check(requests[0].Changes[0].Action, check.Equals, route53.ActionUpsert)
+	c.Check(requests[1].Changes[0].Name, check.Equals, "host1000.example.com.")
+}
@@ -0,0 +1,112 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Implements S3 Transfer Acceleration and dual-stack endpoints.
+// See http://docs.aws.amazon.com/AmazonS3/latest/dev/transfer-acceleration.html
+// and http://docs.aws.amazon.com/AmazonS3/latest/dev/dual-stack-endpoints.html
+// for details.
+
+const (
+	AccelerateStatusEnabled   = "Enabled"
+	AccelerateStatusSuspended = "Suspended"
+)
+
+var (
+	dnsBucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	ipBucketName  = regexp.MustCompile(`^\d+\.\d+\.\d+\.\d+$`)
+)
+
+// ValidAccelerateBucketName reports whether name can be used with the
+// Transfer Acceleration endpoint: it must be a DNS-compatible bucket name
+// that does not contain periods.
+func ValidAccelerateBucketName(name string) bool {
+	return validDNSBucketName(name) && !strings.Contains(name, ".")
+}
+
+func validDNSBucketName(name string) bool {
+	if !dnsBucketName.MatchString(name) || strings.Contains(name, "..") {
+		return false
+	}
+	// Bucket names must not be formatted as IP addresses.
+	return !ipBucketName.MatchString(name)
+}
+
+// setTransferBaseURL sets baseurl on req when either Accelerate or DualStack
+// is enabled on s3.
+func (s3 *S3) setTransferBaseURL(req *request) error {
+	var host string
+	switch {
+	case s3.Accelerate && s3.DualStack:
+		host = "s3-accelerate.dualstack.amazonaws.com"
+	case s3.Accelerate:
+		host = "s3-accelerate.amazonaws.com"
+	default:
+		host = fmt.Sprintf("s3.dualstack.%s.amazonaws.com", s3.Region.Name)
+	}
+
+	if s3.Accelerate && !ValidAccelerateBucketName(req.bucket) {
+		return fmt.Errorf("bad S3 bucket for transfer acceleration: %q", req.bucket)
+	}
+	if !validDNSBucketName(req.bucket) || strings.Contains(req.bucket, ".") {
+		// Names that can't be used as a host label over HTTPS fall back
+		// to path-style addressing on the dual-stack endpoint.
+		req.baseurl = "https://" + host
+		req.path = "/" + req.bucket + req.path
+		return nil
+	}
+	req.baseurl = "https://" + req.bucket + "." + host
+	req.virtualHosted = true
+	return nil
+}
+
+type AccelerateConfiguration struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ AccelerateConfiguration"`
+	Status  string   `xml:"Status"`
+}
+
+// PutBucketAccelerate enables or suspends Transfer Acceleration on b.
+// The status parameter must be AccelerateStatusEnabled or
+// AccelerateStatusSuspended.
+//
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUTaccelerate.html for details.
+func (b *Bucket) PutBucketAccelerate(status string) error {
+	doc, err := xml.Marshal(AccelerateConfiguration{Status: status})
+	if err != nil {
+		return err
+	}
+
+	buf := makeXmlBuffer(doc)
+
+	return b.PutBucketSubresource("accelerate", buf, int64(buf.Len()))
+}
+
+// GetBucketAccelerate returns the Transfer Acceleration status of b. An
+// empty status means acceleration has never been configured.
+//
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketGETaccelerate.html for details.
+func (b *Bucket) GetBucketAccelerate() (string, error) {
+	req := &request{
+		bucket: b.Name,
+		path:   "/",
+		params: url.Values{"accelerate": {""}},
+	}
+	var conf AccelerateConfiguration
+	var err error
+	for attempt := attempts.Start(); attempt.Next(); {
+		err = b.S3.query(req, &conf)
+		if !shouldRetry(err) {
+			break
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return conf.Status, nil
+}
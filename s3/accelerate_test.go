@@ -0,0 +1,68 @@
+package s3_test
+
+import (
+	"io/ioutil"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/s3"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestAccelerateURL(c *check.C) {
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	client := s3.New(auth, aws.USWest2)
+
+	client.Accelerate = true
+	c.Assert(client.Bucket("bucket").URL("key"), check.Equals, "https://bucket.s3-accelerate.amazonaws.com/key")
+
+	client.DualStack = true
+	c.Assert(client.Bucket("bucket").URL("key"), check.Equals, "https://bucket.s3-accelerate.dualstack.amazonaws.com/key")
+
+	client.Accelerate = false
+	c.Assert(client.Bucket("bucket").URL("key"), check.Equals, "https://bucket.s3.dualstack.us-west-2.amazonaws.com/key")
+	c.Assert(client.Bucket("my.bucket").URL("key"), check.Equals, "https://s3.dualstack.us-west-2.amazonaws.com/my.bucket/key")
+}
+
+func (s *S) TestAccelerateInvalidBucketName(c *check.C) {
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	client := s3.New(auth, aws.USWest2)
+	client.Accelerate = true
+
+	err := client.Bucket("my.bucket").PutBucket(s3.Private)
+	c.Assert(err, check.ErrorMatches, `bad S3 bucket for transfer acceleration: "my.bucket"`)
+
+	c.Assert(s3.ValidAccelerateBucketName("my-bucket"), check.Equals, true)
+	c.Assert(s3.ValidAccelerateBucketName("my.bucket"), check.Equals, false)
+	c.Assert(s3.ValidAccelerateBucketName("My-Bucket"), check.Equals, false)
+	c.Assert(s3.ValidAccelerateBucketName("ab"), check.Equals, false)
+}
+
+func (s *S) TestPutBucketAccelerate(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	b := s.s3.Bucket("bucket")
+	err := b.PutBucketAccelerate(s3.AccelerateStatusEnabled)
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	body, err := ioutil.ReadAll(req.Body)
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/bucket/")
+	c.Assert(req.URL.RawQuery, check.Equals, "accelerate=")
+	c.Assert(string(body), check.Equals, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<AccelerateConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Enabled</Status></AccelerateConfiguration>`)
+}
+
+func (s *S) TestGetBucketAccelerate(c *check.C) {
+	testServer.Response(200, nil, GetBucketAccelerateDump)
+
+	b := s.s3.Bucket("bucket")
+	status, err := b.GetBucketAccelerate()
+	c.Assert(err, check.IsNil)
+	c.Assert(status, check.Equals, s3.AccelerateStatusSuspended)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.RawQuery, check.Equals, "accelerate=")
+}
@@ -0,0 +1,122 @@
+package s3_test
+
+import (
+	"io/ioutil"
+
+	"github.com/zackbloom/goamz/s3"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestPutBucketCors(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	conf := &s3.CORSConfiguration{
+		Rules: []s3.CORSRule{
+			{
+				AllowedMethods: []string{"GET", "PUT"},
+				AllowedOrigins: []string{"https://example.com"},
+				AllowedHeaders: []string{"*"},
+				MaxAgeSeconds:  3000,
+			},
+		},
+	}
+	b := s.s3.Bucket("bucket")
+	err := b.PutBucketCors(conf)
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.Path, check.Equals, "/bucket/")
+	c.Assert(req.URL.RawQuery, check.Equals, "cors=")
+	c.Assert(req.Header["Content-Md5"], check.HasLen, 1)
+
+	data, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<CORSConfiguration><CORSRule><AllowedMethod>GET</AllowedMethod><AllowedMethod>PUT</AllowedMethod>`+
+		`<AllowedOrigin>https://example.com</AllowedOrigin><AllowedHeader>*</AllowedHeader>`+
+		`<MaxAgeSeconds>3000</MaxAgeSeconds></CORSRule></CORSConfiguration>`)
+}
+
+func (s *S) TestGetBucketCors(c *check.C) {
+	testServer.Response(200, nil, GetBucketCorsDump)
+
+	b := s.s3.Bucket("bucket")
+	conf, err := b.GetBucketCors()
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.RawQuery, check.Equals, "cors=")
+
+	c.Assert(conf.Rules, check.DeepEquals, []s3.CORSRule{
+		{
+			AllowedMethods: []string{"PUT", "POST", "DELETE"},
+			AllowedOrigins: []string{"http://www.example.com"},
+			AllowedHeaders: []string{"*"},
+			ExposeHeaders:  []string{"x-amz-server-side-encryption"},
+			MaxAgeSeconds:  3000,
+		},
+		{
+			AllowedMethods: []string{"GET"},
+			AllowedOrigins: []string{"*"},
+		},
+	})
+}
+
+func (s *S) TestDeleteBucketCors(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	b := s.s3.Bucket("bucket")
+	err := b.DeleteBucketCors()
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.RawQuery, check.Equals, "cors=")
+}
+
+func (s *S) TestPutBucketPolicy(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	b := s.s3.Bucket("bucket")
+	err := b.PutBucketPolicy([]byte(policy))
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.URL.RawQuery, check.Equals, "policy=")
+	c.Assert(req.ContentLength, check.Equals, int64(len(policy)))
+	data, err := ioutil.ReadAll(req.Body)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, policy)
+}
+
+func (s *S) TestGetBucketPolicy(c *check.C) {
+	policy := `{"Version":"2012-10-17","Statement":[]}`
+	testServer.Response(200, nil, policy)
+
+	b := s.s3.Bucket("bucket")
+	data, err := b.GetBucketPolicy()
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, policy)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.RawQuery, check.Equals, "policy=")
+}
+
+func (s *S) TestDeleteBucketPolicy(c *check.C) {
+	testServer.Response(204, nil, "")
+
+	b := s.s3.Bucket("bucket")
+	err := b.DeleteBucketPolicy()
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "DELETE")
+	c.Assert(req.URL.Path, check.Equals, "/bucket/")
+	c.Assert(req.URL.RawQuery, check.Equals, "policy=")
+}
@@ -0,0 +1,122 @@
+package s3
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Implements parsing the event notifications S3 sends to SQS queues, SNS
+// topics and Lambda functions when objects change.
+// See http://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html for details.
+
+// Event is an S3 event notification. When a notification configuration is
+// set up, S3 first sends a test event, with Event set to "s3:TestEvent"
+// and no records; see IsTest.
+type Event struct {
+	Records []EventRecord `json:"Records"`
+
+	// Set for test events only.
+	Service   string    `json:"Service"`
+	Event     string    `json:"Event"`
+	Time      time.Time `json:"Time"`
+	Bucket    string    `json:"Bucket"`
+	RequestId string    `json:"RequestId"`
+	HostId    string    `json:"HostId"`
+}
+
+// IsTest reports whether e is the test event sent when a notification
+// configuration is set up.
+func (e *Event) IsTest() bool {
+	return e.Event == "s3:TestEvent"
+}
+
+// EventRecord is a change of an object. EventName is the type of the
+// event without its "s3:" prefix, such as "ObjectCreated:Put" or
+// "ObjectRemoved:DeleteMarkerCreated".
+type EventRecord struct {
+	EventVersion      string            `json:"eventVersion"`
+	EventSource       string            `json:"eventSource"`
+	AWSRegion         string            `json:"awsRegion"`
+	EventTime         time.Time         `json:"eventTime"`
+	EventName         string            `json:"eventName"`
+	UserIdentity      EventIdentity     `json:"userIdentity"`
+	RequestParameters map[string]string `json:"requestParameters"`
+	ResponseElements  map[string]string `json:"responseElements"`
+	S3                EventS3           `json:"s3"`
+}
+
+// IsCreated reports whether the record is of an ObjectCreated event.
+func (r *EventRecord) IsCreated() bool {
+	return strings.HasPrefix(r.EventName, "ObjectCreated:")
+}
+
+// IsRemoved reports whether the record is of an ObjectRemoved event.
+func (r *EventRecord) IsRemoved() bool {
+	return strings.HasPrefix(r.EventName, "ObjectRemoved:")
+}
+
+type EventIdentity struct {
+	PrincipalId string `json:"principalId"`
+}
+
+type EventS3 struct {
+	SchemaVersion   string      `json:"s3SchemaVersion"`
+	ConfigurationId string      `json:"configurationId"`
+	Bucket          EventBucket `json:"bucket"`
+	Object          EventObject `json:"object"`
+}
+
+type EventBucket struct {
+	Name          string        `json:"name"`
+	OwnerIdentity EventIdentity `json:"ownerIdentity"`
+	Arn           string        `json:"arn"`
+}
+
+// EventObject is the object of an EventRecord. Key is decoded by
+// ParseEvent; S3 sends it URL-encoded, with spaces as "+". Size and ETag
+// are not set for ObjectRemoved events, and VersionId only for versioned
+// buckets. Sequencer orders the events of a key: compare sequencers of the
+// same length as strings.
+type EventObject struct {
+	Key       string `json:"key"`
+	Size      int64  `json:"size"`
+	ETag      string `json:"eTag"`
+	VersionId string `json:"versionId"`
+	Sequencer string `json:"sequencer"`
+}
+
+// snsEnvelope is the notification of an SNS topic, which S3 events
+// published to a topic are wrapped in, including when delivered to an SQS
+// queue without raw message delivery.
+type snsEnvelope struct {
+	Type     string
+	TopicArn string
+	Message  string
+}
+
+// ParseEvent parses an S3 event notification, as received by a Lambda
+// function, read from an SQS queue, or published to an SNS topic: an event
+// wrapped in an SNS notification is unwrapped. The object keys are
+// URL-decoded.
+func ParseEvent(data []byte) (*Event, error) {
+	var envelope snsEnvelope
+	if err := json.Unmarshal(data, &envelope); err == nil && envelope.Type == "Notification" && envelope.TopicArn != "" {
+		data = []byte(envelope.Message)
+	}
+
+	event := new(Event)
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	for i := range event.Records {
+		object := &event.Records[i].S3.Object
+		key, err := url.QueryUnescape(object.Key)
+		if err != nil {
+			return nil, err
+		}
+		object.Key = key
+	}
+	return event, nil
+}
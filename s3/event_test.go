@@ -0,0 +1,69 @@
+package s3_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/zackbloom/goamz/s3"
+	"github.com/zackbloom/goamz/s3/s3test"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestParseEvent(c *check.C) {
+	event, err := s3.ParseEvent([]byte(ObjectCreatedEvent))
+	c.Assert(err, check.IsNil)
+	c.Assert(event.IsTest(), check.Equals, false)
+	c.Assert(event.Records, check.HasLen, 1)
+
+	record := event.Records[0]
+	c.Assert(record.EventName, check.Equals, "ObjectCreated:Put")
+	c.Assert(record.IsCreated(), check.Equals, true)
+	c.Assert(record.IsRemoved(), check.Equals, false)
+	c.Assert(record.AWSRegion, check.Equals, "us-west-2")
+	c.Assert(record.EventTime.Equal(time.Unix(0, 0)), check.Equals, true)
+	c.Assert(record.S3.Bucket.Name, check.Equals, "mybucket")
+	c.Assert(record.S3.Object, check.DeepEquals, s3.EventObject{
+		Key:       "photos/my summer photo(1).jpg",
+		Size:      1024,
+		ETag:      "d41d8cd98f00b204e9800998ecf8427e",
+		VersionId: "096fKKXTRTtl3on89fVO.nfljtsv6qko",
+		Sequencer: "0055AED6DCD90281E5",
+	})
+}
+
+func (s *S) TestParseEventFromSNS(c *check.C) {
+	notification, err := json.Marshal(map[string]string{
+		"Type":      "Notification",
+		"MessageId": "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324",
+		"TopicArn":  "arn:aws:sns:us-west-2:123456789012:uploads",
+		"Subject":   "Amazon S3 Notification",
+		"Message":   ObjectCreatedEvent,
+	})
+	c.Assert(err, check.IsNil)
+
+	event, err := s3.ParseEvent(notification)
+	c.Assert(err, check.IsNil)
+	c.Assert(event.Records, check.HasLen, 1)
+	c.Assert(event.Records[0].S3.Object.Key, check.Equals, "photos/my summer photo(1).jpg")
+}
+
+func (s *S) TestParseTestEvent(c *check.C) {
+	event, err := s3.ParseEvent([]byte(TestEvent))
+	c.Assert(err, check.IsNil)
+	c.Assert(event.IsTest(), check.Equals, true)
+	c.Assert(event.Bucket, check.Equals, "mybucket")
+	c.Assert(event.Records, check.HasLen, 0)
+}
+
+func (s *S) TestNewEvent(c *check.C) {
+	data := s3test.NewEvent("ObjectRemoved:Delete", "mybucket", "reports/2019 Q1+Q2.csv", 10)
+	c.Assert(string(data), check.Matches, `.*"key":"reports/2019\+Q1%2BQ2.csv".*`)
+
+	event, err := s3.ParseEvent(data)
+	c.Assert(err, check.IsNil)
+	c.Assert(event.Records, check.HasLen, 1)
+	c.Assert(event.Records[0].IsRemoved(), check.Equals, true)
+	c.Assert(event.Records[0].S3.Bucket.Name, check.Equals, "mybucket")
+	c.Assert(event.Records[0].S3.Object.Key, check.Equals, "reports/2019 Q1+Q2.csv")
+	c.Assert(event.Records[0].S3.Object.Size, check.Equals, int64(0))
+}
@@ -1,6 +1,8 @@
 package s3
 
 import (
+	"time"
+
 	"github.com/zackbloom/goamz/aws"
 )
 
@@ -25,3 +27,7 @@ func SetListPartsMax(n int) {
 func SetListMultiMax(n int) {
 	listMultiMax = n
 }
+
+func PostPolicyFormAt(b *Bucket, policy PostPolicy, t time.Time) (string, map[string]string, error) {
+	return b.postPolicyForm(policy, t)
+}
@@ -0,0 +1,262 @@
+package s3
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Implements reprocessing the objects listed by S3 Inventory reports, which
+// is much faster than listing large buckets.
+// See http://docs.aws.amazon.com/AmazonS3/latest/dev/storage-inventory.html for details.
+
+// InventoryManifest is the manifest.json of an inventory report. Files
+// lists the data files of the report, stored in DestinationBucket.
+type InventoryManifest struct {
+	SourceBucket      string          `json:"sourceBucket"`
+	DestinationBucket string          `json:"destinationBucket"`
+	Version           string          `json:"version"`
+	CreationTimestamp string          `json:"creationTimestamp"`
+	FileFormat        string          `json:"fileFormat"`
+	FileSchema        string          `json:"fileSchema"`
+	Files             []InventoryFile `json:"files"`
+}
+
+type InventoryFile struct {
+	Key         string `json:"key"`
+	Size        int64  `json:"size"`
+	MD5Checksum string `json:"MD5checksum"`
+}
+
+// Fields returns the names of the columns of the data files, such as
+// "Bucket", "Key" and "Size".
+func (m *InventoryManifest) Fields() []string {
+	fields := strings.Split(m.FileSchema, ",")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	return fields
+}
+
+// InventoryObject is an object listed in an inventory report. Fields holds
+// all of the columns of the report by name, as found in the data file.
+type InventoryObject struct {
+	Bucket    string
+	Key       string
+	VersionId string
+	Size      int64
+	Fields    map[string]string
+}
+
+// GetInventoryManifest reads the manifest.json of an inventory report at
+// path in b, the destination bucket of the report.
+func (b *Bucket) GetInventoryManifest(path string) (*InventoryManifest, error) {
+	data, err := b.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	manifest := new(InventoryManifest)
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// InventoryCheckpoint records the data files of an inventory report whose
+// objects were all processed, so that an interrupted ProcessInventory can
+// resume where it stopped.
+type InventoryCheckpoint interface {
+	Done(file string) (bool, error)
+	MarkDone(file string) error
+}
+
+// FileCheckpoint is an InventoryCheckpoint that appends the processed data
+// files to a local file, one per line.
+type FileCheckpoint struct {
+	path string
+	mu   sync.Mutex
+	done map[string]bool
+}
+
+// NewFileCheckpoint returns a FileCheckpoint loaded from the file at path,
+// which doesn't need to exist.
+func NewFileCheckpoint(path string) (*FileCheckpoint, error) {
+	cp := &FileCheckpoint{path: path, done: make(map[string]bool)}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			cp.done[line] = true
+		}
+	}
+	return cp, scanner.Err()
+}
+
+func (cp *FileCheckpoint) Done(file string) (bool, error) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	return cp.done[file], nil
+}
+
+func (cp *FileCheckpoint) MarkDone(file string) error {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	f, err := os.OpenFile(cp.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(f, file); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	cp.done[file] = true
+	return nil
+}
+
+// InventoryOptions configures ProcessInventory. Workers defaults to 1, and
+// a nil Checkpoint processes every data file.
+type InventoryOptions struct {
+	Workers    int
+	Checkpoint InventoryCheckpoint
+}
+
+// ProcessInventory calls fn with every object listed by the inventory
+// report of manifest, from Workers goroutines at a time. b is the
+// destination bucket of the report. Only reports in the CSV format are
+// supported.
+//
+// The data files are processed one after the other, and marked done in the
+// checkpoint once fn returned for all of their objects. ProcessInventory
+// stops at the first error returned by fn, leaving the file being processed
+// to be processed again from its start.
+func (b *Bucket) ProcessInventory(manifest *InventoryManifest, options InventoryOptions, fn func(InventoryObject) error) error {
+	if manifest.FileFormat != "CSV" {
+		return fmt.Errorf("s3: unsupported inventory format %q", manifest.FileFormat)
+	}
+	workers := options.Workers
+	if workers < 1 {
+		workers = 1
+	}
+	fields := manifest.Fields()
+	for _, file := range manifest.Files {
+		if options.Checkpoint != nil {
+			done, err := options.Checkpoint.Done(file.Key)
+			if err != nil {
+				return err
+			}
+			if done {
+				continue
+			}
+		}
+		if err := b.processInventoryFile(file.Key, fields, workers, fn); err != nil {
+			return err
+		}
+		if options.Checkpoint != nil {
+			if err := options.Checkpoint.MarkDone(file.Key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Bucket) processInventoryFile(key string, fields []string, workers int, fn func(InventoryObject) error) error {
+	rc, err := b.GetReader(key)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	zr, err := gzip.NewReader(rc)
+	if err != nil {
+		return err
+	}
+	r := csv.NewReader(zr)
+	r.FieldsPerRecord = len(fields)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	objects := make(chan InventoryObject, workers)
+	failed := make(chan struct{})
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			close(failed)
+		})
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for obj := range objects {
+				if err := fn(obj); err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+read:
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			var obj InventoryObject
+			obj, err = inventoryObject(fields, record)
+			if err == nil {
+				select {
+				case objects <- obj:
+					continue
+				case <-failed:
+					break read
+				}
+			}
+		}
+		fail(err)
+		break
+	}
+	close(objects)
+	wg.Wait()
+	return firstErr
+}
+
+func inventoryObject(fields, record []string) (InventoryObject, error) {
+	obj := InventoryObject{Fields: make(map[string]string, len(fields))}
+	for i, name := range fields {
+		obj.Fields[name] = record[i]
+	}
+	obj.Bucket = obj.Fields["Bucket"]
+	obj.VersionId = obj.Fields["VersionId"]
+	// Keys are URL-encoded in the data files.
+	key, err := url.QueryUnescape(obj.Fields["Key"])
+	if err != nil {
+		return obj, err
+	}
+	obj.Key = key
+	if size := obj.Fields["Size"]; size != "" {
+		obj.Size, err = strconv.ParseInt(size, 10, 64)
+	}
+	return obj, err
+}
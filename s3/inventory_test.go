@@ -0,0 +1,155 @@
+package s3_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/zackbloom/goamz/s3"
+	"gopkg.in/check.v1"
+)
+
+var InventoryManifestDump = `{
+  "sourceBucket": "example-source-bucket",
+  "destinationBucket": "arn:aws:s3:::example-inventory-destination-bucket",
+  "version": "2016-11-30",
+  "creationTimestamp": "1514944800000",
+  "fileFormat": "CSV",
+  "fileSchema": "Bucket, Key, VersionId, Size, StorageClass",
+  "files": [
+    {
+      "key": "inventory/example-source-bucket/data/d794c570-95bb-4271-9128-26023c8b4900.csv.gz",
+      "size": 56291,
+      "MD5checksum": "5825f2e18e1695c2d030b9f6eexample"
+    },
+    {
+      "key": "inventory/example-source-bucket/data/8f1c2e2a-8e3f-4a2b-9c6e-0b1d2c3e4f50.csv.gz",
+      "size": 30142,
+      "MD5checksum": "1a2b3c4d5e6f708192a3b4c5d6example"
+    }
+  ]
+}`
+
+func gzipped(s string) string {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(s))
+	zw.Close()
+	return buf.String()
+}
+
+var inventoryData1 = gzipped(`"example-source-bucket","photos/2017/a.jpg","","1024","STANDARD"
+"example-source-bucket","photos/2017/b%20c.jpg","","2048","GLACIER"
+`)
+
+var inventoryData2 = gzipped(`"example-source-bucket","logs/x.log","3HL4kqtJlcpXroDTDmJ%2BrmSpXd3dIbrHY","10","STANDARD_IA"
+`)
+
+func (s *S) TestGetInventoryManifest(c *check.C) {
+	testServer.Response(200, nil, InventoryManifestDump)
+
+	b := s.s3.Bucket("example-inventory-destination-bucket")
+	manifest, err := b.GetInventoryManifest("inventory/example-source-bucket/2018-01-03T00-00Z/manifest.json")
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.URL.Path, check.Equals, "/example-inventory-destination-bucket/inventory/example-source-bucket/2018-01-03T00-00Z/manifest.json")
+	c.Assert(manifest.SourceBucket, check.Equals, "example-source-bucket")
+	c.Assert(manifest.FileFormat, check.Equals, "CSV")
+	c.Assert(manifest.Fields(), check.DeepEquals, []string{"Bucket", "Key", "VersionId", "Size", "StorageClass"})
+	c.Assert(manifest.Files, check.HasLen, 2)
+	c.Assert(manifest.Files[0].Size, check.Equals, int64(56291))
+	c.Assert(manifest.Files[0].MD5Checksum, check.Equals, "5825f2e18e1695c2d030b9f6eexample")
+}
+
+func (s *S) inventoryManifest(c *check.C) *s3.InventoryManifest {
+	testServer.Response(200, nil, InventoryManifestDump)
+	manifest, err := s.s3.Bucket("dest").GetInventoryManifest("manifest.json")
+	c.Assert(err, check.IsNil)
+	testServer.WaitRequest()
+	return manifest
+}
+
+func (s *S) TestProcessInventory(c *check.C) {
+	manifest := s.inventoryManifest(c)
+	testServer.Response(200, nil, inventoryData1)
+	testServer.Response(200, nil, inventoryData2)
+
+	var (
+		mu      sync.Mutex
+		objects []s3.InventoryObject
+	)
+	b := s.s3.Bucket("dest")
+	err := b.ProcessInventory(manifest, s3.InventoryOptions{Workers: 4}, func(obj s3.InventoryObject) error {
+		mu.Lock()
+		objects = append(objects, obj)
+		mu.Unlock()
+		return nil
+	})
+	c.Assert(err, check.IsNil)
+
+	reqs := testServer.WaitRequests(2)
+	c.Assert(reqs[0].URL.Path, check.Equals, "/dest/"+manifest.Files[0].Key)
+	c.Assert(reqs[1].URL.Path, check.Equals, "/dest/"+manifest.Files[1].Key)
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	c.Assert(objects, check.HasLen, 3)
+	c.Assert(objects[0].Key, check.Equals, "logs/x.log")
+	c.Assert(objects[0].VersionId, check.Equals, "3HL4kqtJlcpXroDTDmJ%2BrmSpXd3dIbrHY")
+	c.Assert(objects[1].Bucket, check.Equals, "example-source-bucket")
+	c.Assert(objects[1].Key, check.Equals, "photos/2017/a.jpg")
+	c.Assert(objects[1].Size, check.Equals, int64(1024))
+	c.Assert(objects[2].Key, check.Equals, "photos/2017/b c.jpg")
+	c.Assert(objects[2].Fields["StorageClass"], check.Equals, "GLACIER")
+}
+
+func (s *S) TestProcessInventoryCheckpoint(c *check.C) {
+	manifest := s.inventoryManifest(c)
+	path := filepath.Join(c.MkDir(), "checkpoint")
+	b := s.s3.Bucket("dest")
+
+	// The first run fails in the second file.
+	testServer.Response(200, nil, inventoryData1)
+	testServer.Response(200, nil, inventoryData2)
+	cp, err := s3.NewFileCheckpoint(path)
+	c.Assert(err, check.IsNil)
+	failure := errors.New("failure")
+	err = b.ProcessInventory(manifest, s3.InventoryOptions{Checkpoint: cp}, func(obj s3.InventoryObject) error {
+		if obj.Key == "logs/x.log" {
+			return failure
+		}
+		return nil
+	})
+	c.Assert(err, check.Equals, failure)
+	testServer.WaitRequests(2)
+
+	// The second run resumes with the second file.
+	testServer.Response(200, nil, inventoryData2)
+	cp, err = s3.NewFileCheckpoint(path)
+	c.Assert(err, check.IsNil)
+	done, err := cp.Done(manifest.Files[0].Key)
+	c.Assert(err, check.IsNil)
+	c.Assert(done, check.Equals, true)
+	var keys []string
+	err = b.ProcessInventory(manifest, s3.InventoryOptions{Checkpoint: cp}, func(obj s3.InventoryObject) error {
+		keys = append(keys, obj.Key)
+		return nil
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(keys, check.DeepEquals, []string{"logs/x.log"})
+	req := testServer.WaitRequest()
+	c.Assert(req.URL.Path, check.Equals, "/dest/"+manifest.Files[1].Key)
+
+	done, err = cp.Done(manifest.Files[1].Key)
+	c.Assert(err, check.IsNil)
+	c.Assert(done, check.Equals, true)
+}
+
+func (s *S) TestProcessInventoryUnsupportedFormat(c *check.C) {
+	manifest := &s3.InventoryManifest{FileFormat: "Parquet"}
+	err := s.s3.Bucket("dest").ProcessInventory(manifest, s3.InventoryOptions{}, nil)
+	c.Assert(err, check.ErrorMatches, `s3: unsupported inventory format "Parquet"`)
+}
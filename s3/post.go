@@ -0,0 +1,151 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Implements signed POST policies for browser-based uploads.
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/sigv4-HTTPPOSTConstructPolicy.html for details.
+
+// PostPolicy holds the constraints an HTML form upload must satisfy.
+//
+// Exactly one of Key and KeyPrefix must be set. When KeyPrefix is used the
+// returned "key" field is KeyPrefix followed by ${filename}, so the browser
+// fills in the name of the uploaded file.
+type PostPolicy struct {
+	Expiration time.Time // when the form stops being accepted; required
+
+	Key       string // exact key the upload is stored under
+	KeyPrefix string // any key starting with this prefix is accepted
+
+	ContentType       string // exact Content-Type required
+	ContentTypePrefix string // Content-Type must start with this value
+
+	// ContentLengthMin and ContentLengthMax bound the upload size in bytes.
+	// The content-length-range condition is only added when
+	// ContentLengthMax is non-zero.
+	ContentLengthMin int64
+	ContentLengthMax int64
+
+	ACL                   ACL
+	SuccessActionRedirect string
+	SuccessActionStatus   string
+
+	// Conditions are appended verbatim to the policy document, for example
+	// []interface{}{"starts-with", "$x-amz-meta-tag", ""}.
+	Conditions []interface{}
+}
+
+type postPolicyDocument struct {
+	Expiration string        `json:"expiration"`
+	Conditions []interface{} `json:"conditions"`
+}
+
+// PostPolicyForm builds and signs, with AWS Signature Version 4, a POST
+// policy for b. It returns the URL the form should be submitted to and the
+// input fields that must be embedded in the form ahead of the file field.
+func (b *Bucket) PostPolicyForm(policy PostPolicy) (action string, fields map[string]string, err error) {
+	return b.postPolicyForm(policy, time.Now().UTC())
+}
+
+func (b *Bucket) postPolicyForm(policy PostPolicy, t time.Time) (action string, fields map[string]string, err error) {
+	if policy.Expiration.IsZero() {
+		return "", nil, errors.New("s3: PostPolicy.Expiration must be set")
+	}
+	if (policy.Key == "") == (policy.KeyPrefix == "") {
+		return "", nil, errors.New("s3: exactly one of PostPolicy.Key and PostPolicy.KeyPrefix must be set")
+	}
+	if policy.ContentType != "" && policy.ContentTypePrefix != "" {
+		return "", nil, errors.New("s3: PostPolicy.ContentType and PostPolicy.ContentTypePrefix are mutually exclusive")
+	}
+	if policy.ContentLengthMax < policy.ContentLengthMin {
+		return "", nil, fmt.Errorf("s3: invalid content-length-range %d-%d", policy.ContentLengthMin, policy.ContentLengthMax)
+	}
+
+	date := t.Format(aws.ISO8601BasicFormat)
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", t.Format(aws.ISO8601BasicFormatShort), b.S3.Region.Name)
+	credential := b.S3.Auth.AccessKey + "/" + scope
+
+	fields = map[string]string{
+		"x-amz-algorithm":  "AWS4-HMAC-SHA256",
+		"x-amz-credential": credential,
+		"x-amz-date":       date,
+	}
+	conditions := []interface{}{
+		map[string]string{"bucket": b.Name},
+	}
+
+	if policy.Key != "" {
+		fields["key"] = policy.Key
+		conditions = append(conditions, map[string]string{"key": policy.Key})
+	} else {
+		fields["key"] = policy.KeyPrefix + "${filename}"
+		conditions = append(conditions, []interface{}{"starts-with", "$key", policy.KeyPrefix})
+	}
+	if policy.ContentType != "" {
+		fields["Content-Type"] = policy.ContentType
+		conditions = append(conditions, map[string]string{"Content-Type": policy.ContentType})
+	} else if policy.ContentTypePrefix != "" {
+		conditions = append(conditions, []interface{}{"starts-with", "$Content-Type", policy.ContentTypePrefix})
+	}
+	if policy.ContentLengthMax != 0 {
+		conditions = append(conditions, []interface{}{"content-length-range", policy.ContentLengthMin, policy.ContentLengthMax})
+	}
+	if policy.ACL != "" {
+		fields["acl"] = string(policy.ACL)
+		conditions = append(conditions, map[string]string{"acl": string(policy.ACL)})
+	}
+	if policy.SuccessActionRedirect != "" {
+		fields["success_action_redirect"] = policy.SuccessActionRedirect
+		conditions = append(conditions, map[string]string{"success_action_redirect": policy.SuccessActionRedirect})
+	}
+	if policy.SuccessActionStatus != "" {
+		fields["success_action_status"] = policy.SuccessActionStatus
+		conditions = append(conditions, map[string]string{"success_action_status": policy.SuccessActionStatus})
+	}
+	if token := b.S3.Auth.Token(); token != "" {
+		fields["x-amz-security-token"] = token
+		conditions = append(conditions, map[string]string{"x-amz-security-token": token})
+	}
+	conditions = append(conditions,
+		map[string]string{"x-amz-algorithm": "AWS4-HMAC-SHA256"},
+		map[string]string{"x-amz-credential": credential},
+		map[string]string{"x-amz-date": date},
+	)
+	conditions = append(conditions, policy.Conditions...)
+
+	doc, err := json.Marshal(postPolicyDocument{
+		Expiration: policy.Expiration.UTC().Format("2006-01-02T15:04:05.000Z"),
+		Conditions: conditions,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	policy64 := base64.StdEncoding.EncodeToString(doc)
+	fields["policy"] = policy64
+	fields["x-amz-signature"] = postPolicySignature(b.S3.Auth.SecretKey, b.S3.Region.Name, t, policy64)
+
+	action = fmt.Sprintf("%s/%s/", b.S3.Region.S3Endpoint, b.Name)
+	return action, fields, nil
+}
+
+// postPolicySignature signs the base64-encoded policy with the SigV4 key
+// derived for the s3 service in region on the day of t.
+func postPolicySignature(secretKey, region string, t time.Time, policy64 string) string {
+	key := []byte("AWS4" + secretKey)
+	for _, data := range []string{t.Format(aws.ISO8601BasicFormatShort), region, "s3", "aws4_request", policy64} {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(data))
+		key = h.Sum(nil)
+	}
+	return hex.EncodeToString(key)
+}
@@ -237,3 +237,68 @@ var GetLocationUsWest1 = `
 
 var BucketWebsiteConfigurationDump = `<?xml version="1.0" encoding="UTF-8"?>
 <WebsiteConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><RedirectAllRequestsTo><HostName>example.com</HostName></RedirectAllRequestsTo></WebsiteConfiguration>`
+
+var GetBucketAccelerateDump = `<?xml version="1.0" encoding="UTF-8"?>
+<AccelerateConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Status>Suspended</Status></AccelerateConfiguration>`
+
+var GetBucketCorsDump = `<?xml version="1.0" encoding="UTF-8"?>
+<CORSConfiguration>
+  <CORSRule>
+    <AllowedOrigin>http://www.example.com</AllowedOrigin>
+    <AllowedMethod>PUT</AllowedMethod>
+    <AllowedMethod>POST</AllowedMethod>
+    <AllowedMethod>DELETE</AllowedMethod>
+    <AllowedHeader>*</AllowedHeader>
+    <MaxAgeSeconds>3000</MaxAgeSeconds>
+    <ExposeHeader>x-amz-server-side-encryption</ExposeHeader>
+  </CORSRule>
+  <CORSRule>
+    <AllowedOrigin>*</AllowedOrigin>
+    <AllowedMethod>GET</AllowedMethod>
+  </CORSRule>
+</CORSConfiguration>`
+
+// http://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+var ObjectCreatedEvent = `{
+  "Records": [
+    {
+      "eventVersion": "2.1",
+      "eventSource": "aws:s3",
+      "awsRegion": "us-west-2",
+      "eventTime": "1970-01-01T00:00:00.000Z",
+      "eventName": "ObjectCreated:Put",
+      "userIdentity": {"principalId": "AIDAJDPLRKLG7UEXAMPLE"},
+      "requestParameters": {"sourceIPAddress": "127.0.0.1"},
+      "responseElements": {
+        "x-amz-request-id": "C3D13FE58DE4C810",
+        "x-amz-id-2": "FMyUVURIY8/IgAtTv8xRjskZQpcIZ9KG4V5Wp6S7S/JRWeUWerMUE5JgHvANOjpD"
+      },
+      "s3": {
+        "s3SchemaVersion": "1.0",
+        "configurationId": "testConfigRule",
+        "bucket": {
+          "name": "mybucket",
+          "ownerIdentity": {"principalId": "A3NL1KOZZKExample"},
+          "arn": "arn:aws:s3:::mybucket"
+        },
+        "object": {
+          "key": "photos/my+summer+photo%281%29.jpg",
+          "size": 1024,
+          "eTag": "d41d8cd98f00b204e9800998ecf8427e",
+          "versionId": "096fKKXTRTtl3on89fVO.nfljtsv6qko",
+          "sequencer": "0055AED6DCD90281E5"
+        }
+      }
+    }
+  ]
+}`
+
+// http://docs.aws.amazon.com/AmazonS3/latest/userguide/notification-content-structure.html
+var TestEvent = `{
+  "Service": "Amazon S3",
+  "Event": "s3:TestEvent",
+  "Time": "2014-10-13T15:57:02.089Z",
+  "Bucket": "mybucket",
+  "RequestId": "5582815E1AEA5ADF",
+  "HostId": "8cLeGAmw098X5cv4Zkwcmo8vvZa3eH3eKxsPzbB9wrR+YstdA6Knx4Ip8EXAMPLE"
+}`
@@ -0,0 +1,58 @@
+package s3
+
+import (
+	"encoding/xml"
+	"net/url"
+	"strconv"
+)
+
+// Implements restoring archived objects from Glacier.
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTObjectPOSTrestore.html for details.
+
+const (
+	RestoreTierStandard  = "Standard"
+	RestoreTierBulk      = "Bulk"
+	RestoreTierExpedited = "Expedited"
+)
+
+type GlacierJobParameters struct {
+	Tier string `xml:"Tier"`
+}
+
+type RestoreRequest struct {
+	XMLName              xml.Name              `xml:"http://s3.amazonaws.com/doc/2006-03-01/ RestoreRequest"`
+	Days                 int                   `xml:"Days"`
+	GlacierJobParameters *GlacierJobParameters `xml:"GlacierJobParameters,omitempty"`
+}
+
+// RestoreObject starts restoring a temporary copy of the archived object at
+// path for the given number of days. An empty tier uses the S3 default
+// (RestoreTierStandard). Of options, only RequestPayer is used.
+//
+// The restore completes asynchronously; its progress is reported in the
+// x-amz-restore header returned by HeadWithOptions.
+func (b *Bucket) RestoreObject(path string, days int, tier string, options Options) error {
+	restore := RestoreRequest{Days: days}
+	if tier != "" {
+		restore.GlacierJobParameters = &GlacierJobParameters{Tier: tier}
+	}
+	doc, err := xml.Marshal(restore)
+	if err != nil {
+		return err
+	}
+	buf := makeXmlBuffer(doc)
+
+	headers := map[string][]string{
+		"Content-Length": {strconv.Itoa(buf.Len())},
+	}
+	options.addRequestPayer(headers)
+	req := &request{
+		method:  "POST",
+		bucket:  b.Name,
+		path:    path,
+		params:  url.Values{"restore": {""}},
+		headers: headers,
+		payload: buf,
+	}
+	return b.S3.query(req, nil)
+}
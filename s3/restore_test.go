@@ -0,0 +1,52 @@
+package s3_test
+
+import (
+	"io/ioutil"
+
+	"github.com/zackbloom/goamz/s3"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestRestoreObject(c *check.C) {
+	testServer.Response(202, nil, "")
+
+	b := s.s3.Bucket("bucket")
+	err := b.RestoreObject("name", 7, s3.RestoreTierBulk, s3.Options{RequestPayer: true})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/bucket/name")
+	c.Assert(req.URL.RawQuery, check.Equals, "restore=")
+	c.Assert(req.Header["X-Amz-Request-Payer"], check.DeepEquals, []string{"requester"})
+
+	body, err := ioutil.ReadAll(req.Body)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(body), check.Matches, `(?s).*<RestoreRequest xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Days>7</Days><GlacierJobParameters><Tier>Bulk</Tier></GlacierJobParameters></RestoreRequest>`)
+}
+
+func (s *S) TestRestoreObjectDefaultTier(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	b := s.s3.Bucket("bucket")
+	err := b.RestoreObject("name", 1, "", s3.Options{})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Request-Payer"], check.IsNil)
+	body, err := ioutil.ReadAll(req.Body)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(body), check.Not(check.Matches), `(?s).*GlacierJobParameters.*`)
+}
+
+func (s *S) TestPutCopyStorageClass(c *check.C) {
+	testServer.Response(200, nil, PutCopyResultDump)
+
+	b := s.s3.Bucket("bucket")
+	options := s3.CopyOptions{Options: s3.Options{StorageClass: s3.StorageClassIntelligentTiering}}
+	_, err := b.PutCopy("name", s3.Private, options, "bucket/name")
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Storage-Class"], check.DeepEquals, []string{"INTELLIGENT_TIERING"})
+}
@@ -0,0 +1,62 @@
+package s3_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/s3"
+	"gopkg.in/check.v1"
+)
+
+var slowDownResponse = `
+<Error>
+  <Code>SlowDown</Code>
+  <Message>Please reduce your request rate.</Message>
+</Error>
+`
+
+func (s *S) TestRetryPolicy(c *check.C) {
+	s.DisableRetries()
+	client := *s.s3
+	client.RetryPolicy = aws.DynamoDBRetryPolicy{}
+
+	testServer.Response(503, nil, slowDownResponse)
+	testServer.Response(200, nil, "")
+	err := client.Bucket("bucket").Put("name", []byte("content"), "text/plain", s3.Private, s3.Options{})
+	c.Assert(err, check.IsNil)
+
+	for _, req := range testServer.WaitRequests(2) {
+		c.Assert(req.Method, check.Equals, "PUT")
+		c.Assert(req.ContentLength, check.Equals, int64(7))
+		body, err := ioutil.ReadAll(req.Body)
+		c.Assert(err, check.IsNil)
+		c.Assert(string(body), check.Equals, "content")
+	}
+}
+
+func (s *S) TestRetryPolicyGivesUp(c *check.C) {
+	s.DisableRetries()
+	client := *s.s3
+	client.RetryPolicy = aws.DefaultRetryPolicy{}
+
+	testServer.Response(403, nil, "<Error><Code>AccessDenied</Code></Error>")
+	_, err := client.Bucket("bucket").Get("name")
+	c.Assert(err, check.FitsTypeOf, &s3.Error{})
+	c.Assert(err.(*s3.Error).Code, check.Equals, "AccessDenied")
+	testServer.WaitRequest()
+}
+
+func (s *S) TestRetryPolicyUnseekableBody(c *check.C) {
+	s.DisableRetries()
+	client := *s.s3
+	client.RetryPolicy = aws.DynamoDBRetryPolicy{}
+
+	// Without a way to rewind the body, it can't be sent again.
+	testServer.Response(503, nil, slowDownResponse)
+	body := ioutil.NopCloser(strings.NewReader("content"))
+	err := client.Bucket("bucket").PutReader("name", body, 7, "text/plain", s3.Private, s3.Options{})
+	c.Assert(err, check.FitsTypeOf, &s3.Error{})
+	c.Assert(err.(*s3.Error).Code, check.Equals, "SlowDown")
+	testServer.WaitRequest()
+}
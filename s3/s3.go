@@ -17,6 +17,7 @@ import (
 	"crypto/sha1"
 	"encoding/base64"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -30,6 +31,7 @@ import (
 	"time"
 
 	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
 )
 
 const debug = false
@@ -41,7 +43,23 @@ type S3 struct {
 	ConnectTimeout time.Duration
 	ReadTimeout    time.Duration
 	Signature      int
-	private        byte // Reserve the right of using private data.
+	// Accelerate sends bucket requests through the S3 Transfer
+	// Acceleration endpoint. The bucket must have acceleration enabled
+	// and a DNS-compatible name without periods.
+	Accelerate bool
+	// DualStack sends bucket requests through the IPv4/IPv6 dual-stack
+	// endpoint of the region.
+	DualStack bool
+	// ExpectedBucketOwner is the account ID that must own the buckets
+	// accessed. When set, requests to a bucket owned by another account
+	// fail with a 403 AccessDenied error instead of reading or writing
+	// to it.
+	ExpectedBucketOwner string
+	// RetryPolicy, if set, retries the requests that fail with a network
+	// error, a 5xx response or throttling. Requests with a body are only
+	// retried if it is an io.Seeker, so that it can be sent again.
+	RetryPolicy aws.RetryPolicy
+	private     byte // Reserve the right of using private data.
 }
 
 // The Bucket type encapsulates operations with an S3 bucket.
@@ -70,16 +88,28 @@ type Options struct {
 	ContentMD5           string
 	ContentDisposition   string
 	Range                string
-	// What else?
-	//// The following become headers so they are []strings rather than strings... I think
-	// x-amz-storage-class []string
+	StorageClass         string // one of the StorageClass constants; writes only
+	RequestPayer         bool   // acknowledge charges on requester-pays buckets
 }
 
+// Storage classes accepted by Options.StorageClass. StorageClassGlacier is
+// declared alongside the lifecycle types.
+const (
+	StorageClassStandard           = "STANDARD"
+	StorageClassReducedRedundancy  = "REDUCED_REDUNDANCY"
+	StorageClassStandardIA         = "STANDARD_IA"
+	StorageClassOneZoneIA          = "ONEZONE_IA"
+	StorageClassIntelligentTiering = "INTELLIGENT_TIERING"
+)
+
 type CopyOptions struct {
 	Options
 	CopySourceOptions string
 	MetadataDirective string
 	ContentType       string
+	// ExpectedSourceBucketOwner is the account ID that must own the bucket
+	// copied from.
+	ExpectedSourceBucketOwner string
 }
 
 // CopyObjectResult is the output from a Copy request
@@ -96,7 +126,7 @@ var attempts = aws.AttemptStrategy{
 
 // New creates a new S3.
 func New(auth aws.Auth, region aws.Region) *S3 {
-	return &S3{auth, region, 0, 0, 0, aws.V2Signature}
+	return &S3{Auth: auth, Region: region, Signature: aws.V2Signature}
 }
 
 // Bucket returns a Bucket with the given name.
@@ -261,6 +291,17 @@ func (b *Bucket) GetResponseWithHeaders(path string, headers map[string][]string
 	panic("unreachable")
 }
 
+// GetResponseWithOptions retrieves an object from an S3 bucket, returning
+// the HTTP response. Only the SSE customer key, Range and RequestPayer
+// fields of options are used.
+// It is the caller's responsibility to call Close on the response body
+// when finished reading.
+func (b *Bucket) GetResponseWithOptions(path string, options Options) (resp *http.Response, err error) {
+	headers := make(http.Header)
+	options.addReadHeaders(headers)
+	return b.GetResponseWithHeaders(path, headers)
+}
+
 // Exists checks whether or not an object exists on an S3 bucket using a HEAD request.
 func (b *Bucket) Exists(path string) (exists bool, err error) {
 	req := &request{
@@ -281,7 +322,8 @@ func (b *Bucket) Exists(path string) (exists bool, err error) {
 
 		if err != nil {
 			// We can treat a 403 or 404 as non existance
-			if e, ok := err.(*Error); ok && (e.StatusCode == 403 || e.StatusCode == 404) {
+			var apiErr aws.APIError
+			if errors.As(err, &apiErr) && (apiErr.HTTPStatus() == 403 || apiErr.HTTPStatus() == 404) {
 				return false, nil
 			}
 			return false, err
@@ -325,11 +367,21 @@ func (b *Bucket) Head(path string, headers map[string][]string) (*http.Response,
 	return nil, fmt.Errorf("S3 Currently Unreachable")
 }
 
+// HeadWithOptions HEADs an object in the S3 bucket, sending the fields of
+// options that apply to reads. The storage class of the object, and the
+// status of any Glacier restore, are returned in the x-amz-storage-class
+// and x-amz-restore response headers.
+func (b *Bucket) HeadWithOptions(path string, options Options) (*http.Response, error) {
+	headers := make(http.Header)
+	options.addReadHeaders(headers)
+	return b.Head(path, headers)
+}
+
 // Put inserts an object into the S3 bucket.
 //
 // See http://goo.gl/FEBPD for details.
 func (b *Bucket) Put(path string, data []byte, contType string, perm ACL, options Options) error {
-	body := bytes.NewBuffer(data)
+	body := bytes.NewReader(data)
 	return b.PutReader(path, body, int64(len(data)), contType, perm, options)
 }
 
@@ -401,11 +453,35 @@ func (o Options) addHeaders(headers map[string][]string) {
 	if len(o.ContentDisposition) != 0 {
 		headers["Content-Disposition"] = []string{o.ContentDisposition}
 	}
+	if len(o.StorageClass) != 0 {
+		headers["x-amz-storage-class"] = []string{o.StorageClass}
+	}
+	o.addRequestPayer(headers)
 	for k, v := range o.Meta {
 		headers["x-amz-meta-"+k] = v
 	}
 }
 
+// addReadHeaders adds the fields of o that apply to GET and HEAD requests
+// to headers.
+func (o Options) addReadHeaders(headers map[string][]string) {
+	if len(o.SSECustomerAlgorithm) != 0 && len(o.SSECustomerKey) != 0 && len(o.SSECustomerKeyMD5) != 0 {
+		headers["x-amz-server-side-encryption-customer-algorithm"] = []string{o.SSECustomerAlgorithm}
+		headers["x-amz-server-side-encryption-customer-key"] = []string{o.SSECustomerKey}
+		headers["x-amz-server-side-encryption-customer-key-MD5"] = []string{o.SSECustomerKeyMD5}
+	}
+	if len(o.Range) != 0 {
+		headers["Range"] = []string{o.Range}
+	}
+	o.addRequestPayer(headers)
+}
+
+func (o Options) addRequestPayer(headers map[string][]string) {
+	if o.RequestPayer {
+		headers["x-amz-request-payer"] = []string{"requester"}
+	}
+}
+
 // addHeaders adds o's specified fields to headers
 func (o CopyOptions) addHeaders(headers map[string][]string) {
 	o.Options.addHeaders(headers)
@@ -418,6 +494,9 @@ func (o CopyOptions) addHeaders(headers map[string][]string) {
 	if len(o.ContentType) != 0 {
 		headers["Content-Type"] = []string{o.ContentType}
 	}
+	if len(o.ExpectedSourceBucketOwner) != 0 {
+		headers["x-amz-source-expected-bucket-owner"] = []string{o.ExpectedSourceBucketOwner}
+	}
 }
 
 func makeXmlBuffer(doc []byte) *bytes.Buffer {
@@ -506,10 +585,33 @@ type Object struct {
 	VersionId string `xml:"VersionId,omitempty"`
 }
 
+// DeleteResult holds the result of a multi-object delete. Deleted is
+// empty for a quiet delete.
+type DeleteResult struct {
+	Deleted []Object      `xml:"Deleted"`
+	Errors  []DeleteError `xml:"Error"`
+}
+
+// DeleteError reports an object a multi-object delete failed to remove.
+type DeleteError struct {
+	Key       string `xml:"Key"`
+	VersionId string `xml:"VersionId"`
+	Code      string `xml:"Code"`
+	Message   string `xml:"Message"`
+}
+
+func (e *DeleteError) Error() string {
+	return fmt.Sprintf("%s: %s: %s", e.Key, e.Code, e.Message)
+}
+
 // DelMulti removes up to 1000 objects from the S3 bucket.
 //
 // See http://goo.gl/jx6cWK for details.
 func (b *Bucket) DelMulti(objects Delete) error {
+	return b.delMulti(objects, nil)
+}
+
+func (b *Bucket) delMulti(objects Delete, resp interface{}) error {
 	doc, err := xml.Marshal(objects)
 	if err != nil {
 		return err
@@ -536,7 +638,42 @@ func (b *Bucket) DelMulti(objects Delete) error {
 		payload: buf,
 	}
 
-	return b.S3.query(req, nil)
+	return b.S3.query(req, resp)
+}
+
+// The most objects DelMulti removes at once.
+const maxDelMultiObjects = 1000
+
+// DelMany removes any number of objects from the S3 bucket, running at
+// most concurrency DelMulti requests of up to 1000 objects at once. The
+// requests that fail are retried. A *awsutil.BatchError is returned for
+// the objects that could not be removed, indexed in objects, with a
+// *DeleteError for each object S3 refused to remove.
+func (b *Bucket) DelMany(objects []Object, concurrency int) error {
+	return awsutil.ForEachBatchWithRetry(objects, maxDelMultiObjects, concurrency, func(batch []Object) error {
+		var result DeleteResult
+		if err := b.delMulti(Delete{Quiet: true, Objects: batch}, &result); err != nil {
+			return err
+		}
+		if len(result.Errors) == 0 {
+			return nil
+		}
+
+		index := make(map[Object]int, len(batch))
+		for i, obj := range batch {
+			index[obj] = i
+		}
+		var failures []awsutil.ItemError
+		for i := range result.Errors {
+			e := &result.Errors[i]
+			i, ok := index[Object{Key: e.Key, VersionId: e.VersionId}]
+			if !ok {
+				return fmt.Errorf("s3: unexpected key %q in delete result", e.Key)
+			}
+			failures = append(failures, awsutil.ItemError{Index: i, Err: e})
+		}
+		return &awsutil.BatchError{Errors: failures}
+	})
 }
 
 // The ListResp type holds the results of a List bucket operation.
@@ -744,8 +881,21 @@ func (b *Bucket) Location() (string, error) {
 	}
 }
 
-// Get bucket policy
+// GetPolicy is an alias for GetBucketPolicy kept for compatibility.
 func (b *Bucket) GetPolicy() ([]byte, error) {
+	return b.GetBucketPolicy()
+}
+
+// PutPolicy is an alias for PutBucketPolicy kept for compatibility.
+func (b *Bucket) PutPolicy(data []byte) error {
+	return b.PutBucketPolicy(data)
+}
+
+// GetBucketPolicy returns the JSON policy document attached to the bucket.
+// AWS returns an error with code NoSuchBucketPolicy if none is set.
+//
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketGETpolicy.html for details.
+func (b *Bucket) GetBucketPolicy() ([]byte, error) {
 	req := &request{
 		bucket: b.Name,
 		path:   "/",
@@ -764,24 +914,44 @@ func (b *Bucket) GetPolicy() ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		str, err := ioutil.ReadAll(resp.Body)
-		return str, nil
+		defer resp.Body.Close()
+		return ioutil.ReadAll(resp.Body)
 	}
 	panic("unreachable")
 }
 
-// Put bucket policy
-func (b *Bucket) PutPolicy(data []byte) error {
+// PutBucketPolicy attaches the JSON policy document data to the bucket,
+// replacing any existing policy.
+//
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUTpolicy.html for details.
+func (b *Bucket) PutBucketPolicy(data []byte) error {
+	headers := map[string][]string{
+		"Content-Length": {strconv.Itoa(len(data))},
+	}
 	req := &request{
 		bucket:  b.Name,
 		path:    "/",
 		method:  "PUT",
 		params:  url.Values{"policy": {""}},
+		headers: headers,
 		payload: bytes.NewReader(data),
 	}
 	return b.S3.query(req, nil)
 }
 
+// DeleteBucketPolicy removes the policy attached to the bucket.
+//
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketDELETEpolicy.html for details.
+func (b *Bucket) DeleteBucketPolicy() error {
+	req := &request{
+		bucket: b.Name,
+		path:   "/",
+		method: "DELETE",
+		params: url.Values{"policy": {""}},
+	}
+	return b.S3.query(req, nil)
+}
+
 // URL returns a non-signed URL that allows retriving the
 // object at path. It only works if the object is publicly
 // readable (see SignedURL).
@@ -945,6 +1115,9 @@ type request struct {
 	baseurl  string
 	payload  io.Reader
 	prepared bool
+	// virtualHosted is set when the bucket name is part of the host
+	// rather than the path.
+	virtualHosted bool
 }
 
 func (req *request) url() (*url.URL, error) {
@@ -979,6 +1152,7 @@ func (s3 *S3) queryV4Sign(req *request, resp interface{}) error {
 	if req.headers == nil {
 		req.headers = map[string][]string{}
 	}
+	s3.addExpectedBucketOwner(req)
 
 	err := s3.setBaseURL(req)
 	if err != nil {
@@ -1001,10 +1175,20 @@ func (s3 *S3) queryV4Sign(req *request, resp interface{}) error {
 	return err
 }
 
+// addExpectedBucketOwner adds the x-amz-expected-bucket-owner header to
+// the requests made to a bucket when s3.ExpectedBucketOwner is set.
+func (s3 *S3) addExpectedBucketOwner(req *request) {
+	if s3.ExpectedBucketOwner != "" && req.bucket != "" {
+		req.headers["x-amz-expected-bucket-owner"] = []string{s3.ExpectedBucketOwner}
+	}
+}
+
 // Sets baseurl on req from bucket name and the region endpoint
 func (s3 *S3) setBaseURL(req *request) error {
 	if req.bucket == "" {
 		req.baseurl = s3.Region.S3Endpoint
+	} else if s3.Accelerate || s3.DualStack {
+		return s3.setTransferBaseURL(req)
 	} else {
 		req.baseurl = s3.Region.S3BucketEndpoint
 		if req.baseurl == "" {
@@ -1062,6 +1246,7 @@ func (s3 *S3) prepare(req *request) error {
 	}
 	req.params = params
 	req.headers = headers
+	s3.addExpectedBucketOwner(req)
 
 	if !req.prepared {
 		req.prepared = true
@@ -1093,7 +1278,11 @@ func (s3 *S3) prepare(req *request) error {
 			return err
 		}
 
-		signpathPatiallyEscaped := partiallyEscapedPath(req.path)
+		signpath := req.path
+		if req.virtualHosted {
+			signpath = "/" + req.bucket + signpath
+		}
+		signpathPatiallyEscaped := partiallyEscapedPath(signpath)
 		req.headers["Host"] = []string{u.Host}
 		req.headers["Date"] = []string{time.Now().In(time.UTC).Format(time.RFC1123)}
 
@@ -1186,7 +1375,7 @@ func (s3 *S3) doHttpRequest(hreq *http.Request, resp interface{}) (*http.Respons
 		dump, _ := httputil.DumpResponse(hresp, true)
 		log.Printf("} -> %s\n", dump)
 	}
-	if hresp.StatusCode != 200 && hresp.StatusCode != 204 && hresp.StatusCode != 206 {
+	if hresp.StatusCode != 200 && hresp.StatusCode != 202 && hresp.StatusCode != 204 && hresp.StatusCode != 206 {
 		return nil, buildError(hresp)
 	}
 	if resp != nil {
@@ -1209,12 +1398,47 @@ func (s3 *S3) run(req *request, resp interface{}) (*http.Response, error) {
 		log.Printf("Running S3 request: %#v", req)
 	}
 
-	hreq, err := s3.setupHttpRequest(req)
-	if err != nil {
-		return nil, err
+	policy := s3.RetryPolicy
+	if policy == nil {
+		hreq, err := s3.setupHttpRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		return s3.doHttpRequest(hreq, resp)
 	}
 
-	return s3.doHttpRequest(hreq, resp)
+	seeker, replayable := req.payload.(io.Seeker)
+	replayable = replayable || req.payload == nil
+	var start int64
+	if seeker != nil {
+		var err error
+		if start, err = seeker.Seek(0, io.SeekCurrent); err != nil {
+			replayable = false
+		}
+	}
+	// setupHttpRequest takes Content-Length out of the headers.
+	headers := req.headers
+	for numRetries := 0; ; numRetries++ {
+		req.headers = headers
+		hreq, err := s3.setupHttpRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		hresp, err := s3.doHttpRequest(hreq, resp)
+		if err == nil {
+			aws.RecordSuccess(policy, req.method, numRetries)
+			return hresp, nil
+		}
+		if !replayable || !policy.ShouldRetry(req.method, hresp, err, numRetries) {
+			return hresp, err
+		}
+		time.Sleep(policy.Delay(req.method, hresp, err, numRetries))
+		if seeker != nil {
+			if _, serr := seeker.Seek(start, io.SeekStart); serr != nil {
+				return hresp, err
+			}
+		}
+	}
 }
 
 // Error represents an error in an operation with S3.
@@ -1231,6 +1455,10 @@ func (e *Error) Error() string {
 	return e.Message
 }
 
+func (e *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, e, e.StatusCode, e.Code, e.Message, e.RequestId)
+}
+
 func buildError(r *http.Response) error {
 	if debug {
 		log.Printf("got error (status code %v)", r.StatusCode)
@@ -1296,6 +1524,6 @@ func shouldRetry(err error) bool {
 }
 
 func hasCode(err error, code string) bool {
-	s3err, ok := err.(*Error)
-	return ok && s3err.Code == code
+	var apiErr aws.APIError
+	return errors.As(err, &apiErr) && apiErr.Code() == code
 }
@@ -2,12 +2,16 @@ package s3_test
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/awsutil"
 	"github.com/zackbloom/goamz/s3"
 	"github.com/zackbloom/goamz/testutil"
 	"gopkg.in/check.v1"
@@ -207,6 +211,12 @@ func (s *S) TestGetNotFound(c *check.C) {
 	c.Assert(s3err.Message, check.Equals, "The specified bucket does not exist")
 	c.Assert(s3err.Error(), check.Equals, "The specified bucket does not exist")
 	c.Assert(data, check.IsNil)
+
+	var apiErr aws.APIError
+	c.Assert(errors.As(err, &apiErr), check.Equals, true)
+	c.Assert(apiErr.Code(), check.Equals, "NoSuchBucket")
+	c.Assert(apiErr.RequestID(), check.Equals, "3F1B667FAD71C3D8")
+	c.Assert(apiErr.HTTPStatus(), check.Equals, 404)
 }
 
 // PutObject docs: http://goo.gl/FEBPD
@@ -230,6 +240,50 @@ func (s *S) TestPutObject(c *check.C) {
 	c.Assert(req.Header["X-Amz-Acl"], check.DeepEquals, []string{"private"})
 }
 
+func (s *S) TestPutObjectStorageClassRequesterPays(c *check.C) {
+	testServer.Response(200, nil, "")
+
+	b := s.s3.Bucket("bucket")
+	options := s3.Options{StorageClass: s3.StorageClassOneZoneIA, RequestPayer: true}
+	err := b.Put("name", []byte("content"), "content-type", s3.Private, options)
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "PUT")
+	c.Assert(req.Header["X-Amz-Storage-Class"], check.DeepEquals, []string{"ONEZONE_IA"})
+	c.Assert(req.Header["X-Amz-Request-Payer"], check.DeepEquals, []string{"requester"})
+}
+
+func (s *S) TestGetResponseWithOptions(c *check.C) {
+	testServer.Response(200, nil, "content")
+
+	b := s.s3.Bucket("bucket")
+	options := s3.Options{Range: "bytes=0-3", RequestPayer: true, StorageClass: s3.StorageClassStandardIA}
+	resp, err := b.GetResponseWithOptions("name", options)
+	c.Assert(err, check.IsNil)
+	resp.Body.Close()
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "GET")
+	c.Assert(req.URL.Path, check.Equals, "/bucket/name")
+	c.Assert(req.Header["Range"], check.DeepEquals, []string{"bytes=0-3"})
+	c.Assert(req.Header["X-Amz-Request-Payer"], check.DeepEquals, []string{"requester"})
+	c.Assert(req.Header["X-Amz-Storage-Class"], check.IsNil)
+}
+
+func (s *S) TestHeadWithOptions(c *check.C) {
+	testServer.Response(200, map[string]string{"x-amz-storage-class": "GLACIER"}, "")
+
+	b := s.s3.Bucket("bucket")
+	resp, err := b.HeadWithOptions("name", s3.Options{RequestPayer: true})
+	c.Assert(err, check.IsNil)
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "HEAD")
+	c.Assert(req.Header["X-Amz-Request-Payer"], check.DeepEquals, []string{"requester"})
+	c.Assert(resp.Header.Get("x-amz-storage-class"), check.Equals, "GLACIER")
+}
+
 // PutCopy docs: http://goo.gl/mhEHtA
 func (s *S) TestPutCopy(c *check.C) {
 	testServer.Response(200, nil, PutCopyResultDump)
@@ -330,6 +384,56 @@ func (s *S) TestDelMultiObjects(c *check.C) {
 	c.Assert(req.ContentLength, check.Not(check.Equals), "")
 }
 
+func (s *S) TestDelManyObjects(c *check.C) {
+	testServer.Response(200, nil, "<DeleteResult/>")
+	testServer.Response(200, nil, "<DeleteResult/>")
+
+	b := s.s3.Bucket("bucket")
+	objects := make([]s3.Object, 1001)
+	for i := range objects {
+		objects[i].Key = fmt.Sprintf("key%d", i)
+	}
+	err := b.DelMany(objects, 1)
+	c.Assert(err, check.IsNil)
+
+	for _, n := range []int{1000, 1} {
+		req := testServer.WaitRequest()
+		c.Assert(req.Method, check.Equals, "POST")
+		c.Assert(req.URL.RawQuery, check.Equals, "delete=")
+		body, err := ioutil.ReadAll(req.Body)
+		c.Assert(err, check.IsNil)
+		c.Assert(strings.Count(string(body), "<Object>"), check.Equals, n)
+		c.Assert(strings.Contains(string(body), "<Quiet>true</Quiet>"), check.Equals, true)
+	}
+}
+
+func (s *S) TestDelManyObjectsErrors(c *check.C) {
+	testServer.Response(200, nil, `<DeleteResult>
+  <Error>
+    <Key>key1</Key>
+    <VersionId>v1</VersionId>
+    <Code>AccessDenied</Code>
+    <Message>Access Denied</Message>
+  </Error>
+</DeleteResult>`)
+
+	b := s.s3.Bucket("bucket")
+	objects := []s3.Object{{Key: "key0"}, {Key: "key1", VersionId: "v1"}, {Key: "key2"}}
+	err := b.DelMany(objects, 1)
+
+	batchErr, ok := err.(*awsutil.BatchError)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(batchErr.Errors, check.HasLen, 1)
+	c.Assert(batchErr.Errors[0].Index, check.Equals, 1)
+	c.Assert(batchErr.Errors[0].Err, check.DeepEquals, &s3.DeleteError{
+		Key:       "key1",
+		VersionId: "v1",
+		Code:      "AccessDenied",
+		Message:   "Access Denied",
+	})
+	testServer.WaitRequest()
+}
+
 // Bucket List Objects docs: http://goo.gl/YjQTc
 
 func (s *S) TestList(c *check.C) {
@@ -484,3 +588,44 @@ func (s *S) TestLocation(c *check.C) {
 	c.Assert(err, check.IsNil)
 	c.Assert(resultUsWest1, check.Equals, expectedUsWest1)
 }
+
+func (s *S) TestExpectedBucketOwner(c *check.C) {
+	client := *s.s3
+	client.ExpectedBucketOwner = "111122223333"
+	b := client.Bucket("bucket")
+
+	testServer.Response(200, nil, "content")
+	_, err := b.Get("name")
+	c.Assert(err, check.IsNil)
+	req := testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Expected-Bucket-Owner"], check.DeepEquals, []string{"111122223333"})
+
+	// Through the V4-signed lifecycle requests too.
+	testServer.Response(200, nil, "")
+	err = b.DeleteLifecycleConfiguration()
+	c.Assert(err, check.IsNil)
+	req = testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Expected-Bucket-Owner"], check.DeepEquals, []string{"111122223333"})
+
+	testServer.Response(200, nil, PutCopyResultDump)
+	options := s3.CopyOptions{ExpectedSourceBucketOwner: "444455556666"}
+	_, err = b.PutCopy("name", s3.Private, options, "source-bucket/name")
+	c.Assert(err, check.IsNil)
+	req = testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Expected-Bucket-Owner"], check.DeepEquals, []string{"111122223333"})
+	c.Assert(req.Header["X-Amz-Source-Expected-Bucket-Owner"], check.DeepEquals, []string{"444455556666"})
+
+	// Requests that aren't made to a bucket are left alone.
+	testServer.Response(200, nil, GetServiceDump)
+	_, err = client.GetService()
+	c.Assert(err, check.IsNil)
+	req = testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Expected-Bucket-Owner"], check.IsNil)
+
+	// Not set by default.
+	testServer.Response(200, nil, "content")
+	_, err = s.s3.Bucket("bucket").Get("name")
+	c.Assert(err, check.IsNil)
+	req = testServer.WaitRequest()
+	c.Assert(req.Header["X-Amz-Expected-Bucket-Owner"], check.IsNil)
+}
@@ -0,0 +1,55 @@
+package s3test
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zackbloom/goamz/s3"
+)
+
+// NewEvent returns the JSON of an S3 event notification for a single
+// object, as S3 sends it, to test the consumers of notifications. The
+// event name has no "s3:" prefix, such as "ObjectCreated:Put"; the key is
+// URL-encoded as S3 does.
+func NewEvent(eventName, bucket, key string, size int64) []byte {
+	record := s3.EventRecord{
+		EventVersion:      "2.1",
+		EventSource:       "aws:s3",
+		AWSRegion:         "us-east-1",
+		EventTime:         time.Now().UTC(),
+		EventName:         eventName,
+		UserIdentity:      s3.EventIdentity{PrincipalId: "AWS:AIDAJDPLRKLG7UEXAMPLE"},
+		RequestParameters: map[string]string{"sourceIPAddress": "127.0.0.1"},
+		ResponseElements: map[string]string{
+			"x-amz-request-id": "C3D13FE58DE4C810",
+			"x-amz-id-2":       "FMyUVURIY8/IgAtTv8xRjskZQpcIZ9KG4V5Wp6S7S/JRWeUWerMUE5JgHvANOjpD",
+		},
+		S3: s3.EventS3{
+			SchemaVersion:   "1.0",
+			ConfigurationId: "testConfigRule",
+			Bucket: s3.EventBucket{
+				Name:          bucket,
+				OwnerIdentity: s3.EventIdentity{PrincipalId: "A3NL1KOZZKExample"},
+				Arn:           "arn:aws:s3:::" + bucket,
+			},
+			Object: s3.EventObject{
+				Key:       strings.Replace(url.QueryEscape(key), "%2F", "/", -1),
+				Sequencer: "0055AED6DCD90281E5",
+			},
+		},
+	}
+	if strings.HasPrefix(eventName, "ObjectCreated:") {
+		record.S3.Object.Size = size
+		record.S3.Object.ETag = "d41d8cd98f00b204e9800998ecf8427e"
+	}
+	event := struct {
+		Records []s3.EventRecord
+	}{[]s3.EventRecord{record}}
+	data, err := json.Marshal(event)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
@@ -0,0 +1,243 @@
+package s3
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Implements Select Object Content (S3 Select).
+// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTObjectSELECTContent.html for details.
+
+const (
+	SelectExpressionTypeSQL = "SQL"
+
+	SelectCompressionNone  = "NONE"
+	SelectCompressionGZIP  = "GZIP"
+	SelectCompressionBZIP2 = "BZIP2"
+
+	SelectFileHeaderUse    = "USE"
+	SelectFileHeaderIgnore = "IGNORE"
+	SelectFileHeaderNone   = "NONE"
+
+	SelectJSONDocument = "DOCUMENT"
+	SelectJSONLines    = "LINES"
+)
+
+type CSVInput struct {
+	FileHeaderInfo             string `xml:"FileHeaderInfo,omitempty"`
+	Comments                   string `xml:"Comments,omitempty"`
+	QuoteEscapeCharacter       string `xml:"QuoteEscapeCharacter,omitempty"`
+	RecordDelimiter            string `xml:"RecordDelimiter,omitempty"`
+	FieldDelimiter             string `xml:"FieldDelimiter,omitempty"`
+	QuoteCharacter             string `xml:"QuoteCharacter,omitempty"`
+	AllowQuotedRecordDelimiter bool   `xml:"AllowQuotedRecordDelimiter,omitempty"`
+}
+
+type JSONInput struct {
+	Type string `xml:"Type"`
+}
+
+type ParquetInput struct{}
+
+// InputSerialization describes the format of the object being queried.
+// Exactly one of CSV, JSON and Parquet must be set.
+type InputSerialization struct {
+	CompressionType string        `xml:"CompressionType,omitempty"`
+	CSV             *CSVInput     `xml:"CSV,omitempty"`
+	JSON            *JSONInput    `xml:"JSON,omitempty"`
+	Parquet         *ParquetInput `xml:"Parquet,omitempty"`
+}
+
+type CSVOutput struct {
+	QuoteFields          string `xml:"QuoteFields,omitempty"`
+	QuoteEscapeCharacter string `xml:"QuoteEscapeCharacter,omitempty"`
+	RecordDelimiter      string `xml:"RecordDelimiter,omitempty"`
+	FieldDelimiter       string `xml:"FieldDelimiter,omitempty"`
+	QuoteCharacter       string `xml:"QuoteCharacter,omitempty"`
+}
+
+type JSONOutput struct {
+	RecordDelimiter string `xml:"RecordDelimiter,omitempty"`
+}
+
+// OutputSerialization describes the format of the returned records.
+// Exactly one of CSV and JSON must be set.
+type OutputSerialization struct {
+	CSV  *CSVOutput  `xml:"CSV,omitempty"`
+	JSON *JSONOutput `xml:"JSON,omitempty"`
+}
+
+// RequestProgress asks S3 to send periodic Progress events.
+type RequestProgress struct {
+	Enabled bool `xml:"Enabled"`
+}
+
+type SelectObjectContentRequest struct {
+	XMLName             xml.Name            `xml:"http://s3.amazonaws.com/doc/2006-03-01/ SelectObjectContentRequest"`
+	Expression          string              `xml:"Expression"`
+	ExpressionType      string              `xml:"ExpressionType"`
+	RequestProgress     *RequestProgress    `xml:"RequestProgress,omitempty"`
+	InputSerialization  InputSerialization  `xml:"InputSerialization"`
+	OutputSerialization OutputSerialization `xml:"OutputSerialization"`
+}
+
+// SelectStats holds the byte counts reported by Stats and Progress events.
+type SelectStats struct {
+	BytesScanned   int64
+	BytesProcessed int64
+	BytesReturned  int64
+}
+
+// SelectObjectContentStream delivers the results of a SelectObjectContent
+// call. Records yields the payload of every Records event in order and is
+// closed when the stream ends; Err must then be checked to tell a complete
+// result from a failed or truncated one.
+type SelectObjectContentStream struct {
+	Records <-chan []byte
+
+	body     io.ReadCloser
+	done     chan struct{}
+	finished chan struct{}
+	once     sync.Once
+	err      error
+	stats    *SelectStats
+}
+
+// Err returns the error that terminated the stream, if any. It blocks until
+// Records is closed.
+func (s *SelectObjectContentStream) Err() error {
+	<-s.finished
+	return s.err
+}
+
+// Stats returns the statistics sent at the end of the stream, or nil if
+// none were received. It blocks until Records is closed.
+func (s *SelectObjectContentStream) Stats() *SelectStats {
+	<-s.finished
+	return s.stats
+}
+
+// Close stops reading the stream and releases the underlying connection.
+// It is safe to call Close before Records is drained.
+func (s *SelectObjectContentStream) Close() error {
+	var err error
+	s.once.Do(func() {
+		close(s.done)
+		err = s.body.Close()
+	})
+	<-s.finished
+	return err
+}
+
+// SelectError is an error event sent in the body of a SelectObjectContent
+// response.
+type SelectError struct {
+	Code    string
+	Message string
+}
+
+func (e *SelectError) Error() string {
+	return fmt.Sprintf("s3 select: %s: %s", e.Code, e.Message)
+}
+
+// SelectObjectContent filters the contents of the object at path with the
+// SQL expression in req and streams back the matching records.
+//
+// The caller must call Close on the returned stream when done with it.
+func (b *Bucket) SelectObjectContent(path string, req *SelectObjectContentRequest) (*SelectObjectContentStream, error) {
+	if req.ExpressionType == "" {
+		req.ExpressionType = SelectExpressionTypeSQL
+	}
+	doc, err := xml.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	buf := makeXmlBuffer(doc)
+
+	headers := map[string][]string{
+		"Content-Length": {strconv.Itoa(buf.Len())},
+	}
+	r := &request{
+		method:  "POST",
+		bucket:  b.Name,
+		path:    path,
+		params:  url.Values{"select": {""}, "select-type": {"2"}},
+		headers: headers,
+		payload: buf,
+	}
+	err = b.S3.prepare(r)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.S3.run(r, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make(chan []byte)
+	s := &SelectObjectContentStream{
+		Records:  records,
+		body:     resp.Body,
+		done:     make(chan struct{}),
+		finished: make(chan struct{}),
+	}
+	go s.read(records)
+	return s, nil
+}
+
+func (s *SelectObjectContentStream) read(records chan<- []byte) {
+	defer close(s.finished)
+	defer close(records)
+
+	r := bufio.NewReader(s.body)
+	for {
+		msg, err := aws.ReadEventStreamMessage(r)
+		if err != nil {
+			select {
+			case <-s.done:
+				// Closed by the caller; the read error is expected.
+			default:
+				if err == io.EOF {
+					err = io.ErrUnexpectedEOF
+				}
+				s.err = err
+			}
+			return
+		}
+
+		switch msg.Headers[":message-type"] {
+		case "error":
+			s.err = &SelectError{Code: msg.Headers[":error-code"], Message: msg.Headers[":error-message"]}
+			return
+		case "event":
+		default:
+			s.err = fmt.Errorf("s3 select: unexpected message type %q", msg.Headers[":message-type"])
+			return
+		}
+
+		switch msg.Headers[":event-type"] {
+		case "Records":
+			select {
+			case records <- msg.Payload:
+			case <-s.done:
+				return
+			}
+		case "Stats", "Progress":
+			stats := new(SelectStats)
+			if err := xml.Unmarshal(msg.Payload, stats); err != nil {
+				s.err = err
+				return
+			}
+			s.stats = stats
+		case "End":
+			return
+		}
+	}
+}
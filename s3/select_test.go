@@ -0,0 +1,125 @@
+package s3_test
+
+import (
+	"bytes"
+	"io/ioutil"
+
+	"github.com/zackbloom/goamz/s3"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func selectEvent(eventType, payload string) []byte {
+	return testutil.EventStreamMessage([][2]string{
+		{":message-type", "event"},
+		{":event-type", eventType},
+		{":content-type", "application/octet-stream"},
+	}, payload)
+}
+
+func (s *S) TestSelectObjectContent(c *check.C) {
+	var body bytes.Buffer
+	body.Write(selectEvent("Records", "a,1\n"))
+	body.Write(selectEvent("Cont", ""))
+	body.Write(selectEvent("Records", "b,2\n"))
+	body.Write(selectEvent("Stats", "<Stats><BytesScanned>100</BytesScanned><BytesProcessed>90</BytesProcessed><BytesReturned>8</BytesReturned></Stats>"))
+	body.Write(selectEvent("End", ""))
+	testServer.Response(200, nil, body.String())
+
+	b := s.s3.Bucket("bucket")
+	stream, err := b.SelectObjectContent("data.csv", &s3.SelectObjectContentRequest{
+		Expression: "select * from s3object s where s._2 > 0",
+		InputSerialization: s3.InputSerialization{
+			CSV: &s3.CSVInput{FileHeaderInfo: s3.SelectFileHeaderNone},
+		},
+		OutputSerialization: s3.OutputSerialization{CSV: &s3.CSVOutput{}},
+	})
+	c.Assert(err, check.IsNil)
+	defer stream.Close()
+
+	var records []string
+	for r := range stream.Records {
+		records = append(records, string(r))
+	}
+	c.Assert(stream.Err(), check.IsNil)
+	c.Assert(records, check.DeepEquals, []string{"a,1\n", "b,2\n"})
+	c.Assert(stream.Stats(), check.DeepEquals, &s3.SelectStats{BytesScanned: 100, BytesProcessed: 90, BytesReturned: 8})
+
+	req := testServer.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/bucket/data.csv")
+	c.Assert(req.Form["select"], check.DeepEquals, []string{""})
+	c.Assert(req.Form["select-type"], check.DeepEquals, []string{"2"})
+	data, err := ioutil.ReadAll(req.Body)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(data), check.Equals, `<?xml version="1.0" encoding="UTF-8"?>`+"\n"+
+		`<SelectObjectContentRequest xmlns="http://s3.amazonaws.com/doc/2006-03-01/">`+
+		`<Expression>select * from s3object s where s._2 &gt; 0</Expression><ExpressionType>SQL</ExpressionType>`+
+		`<InputSerialization><CSV><FileHeaderInfo>NONE</FileHeaderInfo></CSV></InputSerialization>`+
+		`<OutputSerialization><CSV></CSV></OutputSerialization></SelectObjectContentRequest>`)
+}
+
+func (s *S) TestSelectObjectContentErrorEvent(c *check.C) {
+	var body bytes.Buffer
+	body.Write(selectEvent("Records", "a,1\n"))
+	body.Write(testutil.EventStreamMessage([][2]string{
+		{":message-type", "error"},
+		{":error-code", "CSVParsingError"},
+		{":error-message", "Encountered an error parsing the CSV file."},
+	}, ""))
+	testServer.Response(200, nil, body.String())
+
+	b := s.s3.Bucket("bucket")
+	stream, err := b.SelectObjectContent("data.csv", &s3.SelectObjectContentRequest{
+		Expression:          "select * from s3object",
+		InputSerialization:  s3.InputSerialization{CSV: &s3.CSVInput{}},
+		OutputSerialization: s3.OutputSerialization{JSON: &s3.JSONOutput{}},
+	})
+	c.Assert(err, check.IsNil)
+	defer stream.Close()
+
+	n := 0
+	for range stream.Records {
+		n++
+	}
+	c.Assert(n, check.Equals, 1)
+	c.Assert(stream.Err(), check.ErrorMatches, "s3 select: CSVParsingError: Encountered an error parsing the CSV file.")
+	e, ok := stream.Err().(*s3.SelectError)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(e.Code, check.Equals, "CSVParsingError")
+}
+
+func (s *S) TestSelectObjectContentTruncated(c *check.C) {
+	testServer.Response(200, nil, string(selectEvent("Records", "a,1\n")))
+
+	b := s.s3.Bucket("bucket")
+	stream, err := b.SelectObjectContent("data.json", &s3.SelectObjectContentRequest{
+		Expression:          "select * from s3object",
+		InputSerialization:  s3.InputSerialization{JSON: &s3.JSONInput{Type: s3.SelectJSONLines}},
+		OutputSerialization: s3.OutputSerialization{JSON: &s3.JSONOutput{}},
+	})
+	c.Assert(err, check.IsNil)
+	for range stream.Records {
+	}
+	c.Assert(stream.Err(), check.ErrorMatches, "unexpected EOF")
+	c.Assert(stream.Close(), check.IsNil)
+}
+
+func (s *S) TestSelectObjectContentCloseEarly(c *check.C) {
+	var body bytes.Buffer
+	body.Write(selectEvent("Records", "a,1\n"))
+	body.Write(selectEvent("Records", "b,2\n"))
+	body.Write(selectEvent("End", ""))
+	testServer.Response(200, nil, body.String())
+
+	b := s.s3.Bucket("bucket")
+	stream, err := b.SelectObjectContent("data.csv", &s3.SelectObjectContentRequest{
+		Expression:          "select * from s3object",
+		InputSerialization:  s3.InputSerialization{Parquet: &s3.ParquetInput{}},
+		OutputSerialization: s3.OutputSerialization{CSV: &s3.CSVOutput{}},
+	})
+	c.Assert(err, check.IsNil)
+	<-stream.Records
+	c.Assert(stream.Close(), check.IsNil)
+	c.Assert(stream.Err(), check.IsNil)
+}
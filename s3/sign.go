@@ -16,13 +16,18 @@ var b64 = base64.StdEncoding
 // S3 signing (http://goo.gl/G1LrK)
 
 var s3ParamsToSign = map[string]bool{
+	"accelerate":                   true,
 	"acl":                          true,
+	"cors":                         true,
 	"location":                     true,
 	"logging":                      true,
 	"notification":                 true,
 	"partNumber":                   true,
 	"policy":                       true,
 	"requestPayment":               true,
+	"restore":                      true,
+	"select":                       true,
+	"select-type":                  true,
 	"torrent":                      true,
 	"uploadId":                     true,
 	"uploads":                      true,
@@ -0,0 +1,34 @@
+package secretsmanager
+
+// GetRandomPasswordRequest holds the parameters of GetRandomPassword. The
+// password is PasswordLength characters long, or 32 if 0, drawn from the
+// upper and lower case letters, numbers and punctuation unless excluded,
+// less the characters of ExcludeCharacters. With RequireEachIncludedType,
+// it has at least one character of each class not excluded.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_GetRandomPassword.html
+type GetRandomPasswordRequest struct {
+	PasswordLength          int    `json:",omitempty"`
+	ExcludeCharacters       string `json:",omitempty"`
+	ExcludeNumbers          bool   `json:",omitempty"`
+	ExcludePunctuation      bool   `json:",omitempty"`
+	ExcludeUppercase        bool   `json:",omitempty"`
+	ExcludeLowercase        bool   `json:",omitempty"`
+	IncludeSpace            bool   `json:",omitempty"`
+	RequireEachIncludedType bool   `json:",omitempty"`
+}
+
+// GetRandomPassword generates a random password. req may be nil for the
+// defaults.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_GetRandomPassword.html
+func (s *SecretsManager) GetRandomPassword(req *GetRandomPasswordRequest) (string, error) {
+	if req == nil {
+		req = &GetRandomPasswordRequest{}
+	}
+	var resp struct{ RandomPassword string }
+	if err := s.query("GetRandomPassword", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.RandomPassword, nil
+}
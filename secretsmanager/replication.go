@@ -0,0 +1,91 @@
+package secretsmanager
+
+// Statuses of the replica of a secret in a region.
+const (
+	ReplicationStatusInSync     = "InSync"
+	ReplicationStatusFailed     = "Failed"
+	ReplicationStatusInProgress = "InProgress"
+)
+
+// ReplicaRegion is a region a secret is replicated to. The replica is
+// encrypted with KmsKeyId, a key of that region, or with the
+// aws/secretsmanager key of the region if empty.
+type ReplicaRegion struct {
+	Region   string
+	KmsKeyId string `json:",omitempty"`
+}
+
+// ReplicationStatus describes the replica of a secret in a region.
+// StatusMessage tells why the replication failed, if it did.
+// LastAccessedDate is in seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_ReplicationStatusType.html
+type ReplicationStatus struct {
+	Region           string
+	KmsKeyId         string
+	Status           string
+	StatusMessage    string
+	LastAccessedDate float64
+}
+
+// ReplicationResponse is the response of the replication actions: the ARN
+// of the primary secret and the status of its replicas.
+type ReplicationResponse struct {
+	ARN               string
+	ReplicationStatus []ReplicationStatus
+}
+
+type replicateSecretToRegionsRequest struct {
+	SecretId                    string
+	AddReplicaRegions           []ReplicaRegion
+	ForceOverwriteReplicaSecret bool `json:",omitempty"`
+}
+
+// ReplicateSecretToRegions replicates a secret, given by name or ARN, to
+// other regions. Replicas are read-only copies kept in sync with the
+// primary secret, including its rotations. A secret of the same name that
+// already exists in a region makes its replication fail, unless
+// forceOverwrite is true.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_ReplicateSecretToRegions.html
+func (s *SecretsManager) ReplicateSecretToRegions(secretId string, regions []ReplicaRegion, forceOverwrite bool) (*ReplicationResponse, error) {
+	req := &replicateSecretToRegionsRequest{secretId, regions, forceOverwrite}
+	resp := new(ReplicationResponse)
+	if err := s.query("ReplicateSecretToRegions", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+type removeRegionsFromReplicationRequest struct {
+	SecretId             string
+	RemoveReplicaRegions []string
+}
+
+// RemoveRegionsFromReplication deletes the replicas of a secret in the
+// given regions.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_RemoveRegionsFromReplication.html
+func (s *SecretsManager) RemoveRegionsFromReplication(secretId string, regions []string) (*ReplicationResponse, error) {
+	req := &removeRegionsFromReplicationRequest{secretId, regions}
+	resp := new(ReplicationResponse)
+	if err := s.query("RemoveRegionsFromReplication", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StopReplicationToReplica turns a replica secret, given by the ARN of the
+// replica, into a standalone secret of its region, such as to fail over
+// when the region of the primary secret is unavailable. It must be called
+// in the region of the replica, and returns the ARN of the promoted
+// secret.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_StopReplicationToReplica.html
+func (s *SecretsManager) StopReplicationToReplica(secretId string) (arn string, err error) {
+	var resp struct{ ARN string }
+	if err := s.query("StopReplicationToReplica", map[string]string{"SecretId": secretId}, &resp); err != nil {
+		return "", err
+	}
+	return resp.ARN, nil
+}
@@ -0,0 +1,50 @@
+package secretsmanager_test
+
+// http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_ReplicateSecretToRegions.html
+var ReplicateSecretToRegionsResponse = `
+{
+  "ARN": "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-a1b2c3",
+  "ReplicationStatus": [
+    {
+      "KmsKeyId": "alias/aws/secretsmanager",
+      "Region": "eu-west-1",
+      "Status": "InProgress"
+    },
+    {
+      "KmsKeyId": "alias/replicas",
+      "Region": "ap-southeast-2",
+      "Status": "Failed",
+      "StatusMessage": "Replication failed: Secret name already exists in region."
+    }
+  ]
+}
+`
+
+// http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_RemoveRegionsFromReplication.html
+var RemoveRegionsFromReplicationResponse = `
+{
+  "ARN": "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-a1b2c3",
+  "ReplicationStatus": [
+    {
+      "KmsKeyId": "alias/aws/secretsmanager",
+      "LastAccessedDate": 1696118400,
+      "Region": "eu-west-1",
+      "Status": "InSync"
+    }
+  ]
+}
+`
+
+// http://docs.aws.amazon.com/secretsmanager/latest/apireference/API_GetRandomPassword.html
+var GetRandomPasswordResponse = `
+{
+  "RandomPassword": "EXAMPLE-PASSWORD-1a2"
+}
+`
+
+var ErrorResponse = `
+{
+  "__type": "InvalidParameterException",
+  "message": "The secret is not replicated to the region sa-east-1."
+}
+`
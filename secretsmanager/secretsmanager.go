@@ -0,0 +1,41 @@
+// Package secretsmanager provides types and functions to interact with AWS
+// Secrets Manager.
+//
+// See http://docs.aws.amazon.com/secretsmanager/latest/apireference/Welcome.html
+package secretsmanager
+
+import (
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type SecretsManager struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *SecretsManager {
+	return &SecretsManager{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with Secrets Manager.
+type Error = aws.JSONError
+
+// query calls the Secrets Manager action with req encoded as JSON and
+// decodes the response into resp.
+func (s *SecretsManager) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         s.Auth,
+		Region:       s.Region,
+		HTTPClient:   s.HTTPClient,
+		Endpoint:     s.Region.SecretsManagerEndpoint,
+		TargetPrefix: "secretsmanager",
+		SigningName:  "secretsmanager",
+	}
+	return client.Call(action, req, resp)
+}
@@ -0,0 +1,132 @@
+package secretsmanager_test
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/secretsmanager"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	sm *secretsmanager.SecretsManager
+}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.sm = secretsmanager.New(auth, aws.Region{Name: "us-east-1", SecretsManagerEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) TestReplicateSecretToRegions(c *check.C) {
+	testServer.Response(200, nil, ReplicateSecretToRegionsResponse)
+
+	resp, err := s.sm.ReplicateSecretToRegions("prod/db", []secretsmanager.ReplicaRegion{
+		{Region: "eu-west-1"},
+		{Region: "ap-southeast-2", KmsKeyId: "alias/replicas"},
+	}, true)
+	target, body := testServer.WaitJSONRequest(c, "secretsmanager")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "secretsmanager.ReplicateSecretToRegions")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"SecretId": "prod/db",
+		"AddReplicaRegions": []interface{}{
+			map[string]interface{}{"Region": "eu-west-1"},
+			map[string]interface{}{"Region": "ap-southeast-2", "KmsKeyId": "alias/replicas"},
+		},
+		"ForceOverwriteReplicaSecret": true,
+	})
+
+	c.Assert(resp.ARN, check.Equals, "arn:aws:secretsmanager:us-east-1:123456789012:secret:prod/db-a1b2c3")
+	c.Assert(resp.ReplicationStatus, check.DeepEquals, []secretsmanager.ReplicationStatus{
+		{Region: "eu-west-1", KmsKeyId: "alias/aws/secretsmanager", Status: secretsmanager.ReplicationStatusInProgress},
+		{Region: "ap-southeast-2", KmsKeyId: "alias/replicas", Status: secretsmanager.ReplicationStatusFailed, StatusMessage: "Replication failed: Secret name already exists in region."},
+	})
+}
+
+func (s *S) TestRemoveRegionsFromReplication(c *check.C) {
+	testServer.Response(200, nil, RemoveRegionsFromReplicationResponse)
+
+	resp, err := s.sm.RemoveRegionsFromReplication("prod/db", []string{"ap-southeast-2"})
+	target, body := testServer.WaitJSONRequest(c, "secretsmanager")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "secretsmanager.RemoveRegionsFromReplication")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"SecretId":             "prod/db",
+		"RemoveReplicaRegions": []interface{}{"ap-southeast-2"},
+	})
+	c.Assert(resp.ReplicationStatus, check.HasLen, 1)
+	c.Assert(resp.ReplicationStatus[0].Status, check.Equals, secretsmanager.ReplicationStatusInSync)
+	c.Assert(resp.ReplicationStatus[0].LastAccessedDate, check.Equals, 1.6961184e+09)
+}
+
+func (s *S) TestStopReplicationToReplica(c *check.C) {
+	testServer.Response(200, nil, `{"ARN": "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-a1b2c3"}`)
+
+	arn, err := s.sm.StopReplicationToReplica("arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-a1b2c3")
+	target, body := testServer.WaitJSONRequest(c, "secretsmanager")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "secretsmanager.StopReplicationToReplica")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"SecretId": "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-a1b2c3"})
+	c.Assert(arn, check.Equals, "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/db-a1b2c3")
+}
+
+func (s *S) TestGetRandomPassword(c *check.C) {
+	testServer.Response(200, nil, GetRandomPasswordResponse)
+
+	password, err := s.sm.GetRandomPassword(&secretsmanager.GetRandomPasswordRequest{
+		PasswordLength:          20,
+		ExcludeCharacters:       `"'\`,
+		ExcludePunctuation:      true,
+		RequireEachIncludedType: true,
+	})
+	target, body := testServer.WaitJSONRequest(c, "secretsmanager")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "secretsmanager.GetRandomPassword")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"PasswordLength":          float64(20),
+		"ExcludeCharacters":       `"'\`,
+		"ExcludePunctuation":      true,
+		"RequireEachIncludedType": true,
+	})
+	c.Assert(password, check.Equals, "EXAMPLE-PASSWORD-1a2")
+}
+
+func (s *S) TestGetRandomPasswordDefaults(c *check.C) {
+	testServer.Response(200, nil, GetRandomPasswordResponse)
+
+	_, err := s.sm.GetRandomPassword(nil)
+	_, body := testServer.WaitJSONRequest(c, "secretsmanager")
+	c.Assert(err, check.IsNil)
+	c.Assert(body, check.DeepEquals, map[string]interface{}{})
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(400, nil, ErrorResponse)
+
+	_, err := s.sm.RemoveRegionsFromReplication("prod/db", []string{"sa-east-1"})
+	testServer.WaitJSONRequest(c, "secretsmanager")
+
+	smErr, ok := err.(*secretsmanager.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(smErr.StatusCode, check.Equals, 400)
+	c.Assert(smErr.Code, check.Equals, "InvalidParameterException")
+	c.Assert(err, check.ErrorMatches, "secretsmanager: InvalidParameterException: .*")
+}
@@ -0,0 +1,188 @@
+package sns
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Names of the subscription attributes that apply to HTTP and HTTPS
+// endpoints.
+const (
+	DeliveryPolicyAttribute          = "DeliveryPolicy"
+	EffectiveDeliveryPolicyAttribute = "EffectiveDeliveryPolicy"
+	RawMessageDeliveryAttribute      = "RawMessageDelivery"
+	FilterPolicyAttribute            = "FilterPolicy"
+	RedrivePolicyAttribute           = "RedrivePolicy"
+)
+
+// Backoff functions of a RetryPolicy.
+const (
+	BackoffLinear      = "linear"
+	BackoffArithmetic  = "arithmetic"
+	BackoffGeometric   = "geometric"
+	BackoffExponential = "exponential"
+)
+
+// RetryPolicy controls how SNS retries deliveries to an HTTP or HTTPS
+// endpoint that fails. Delays are in seconds. After NumNoDelayRetries
+// immediate retries and NumMinDelayRetries retries MinDelayTarget apart,
+// the delay grows to MaxDelayTarget following BackoffFunction, and the last
+// NumMaxDelayRetries retries are MaxDelayTarget apart. NumRetries is the
+// total, at most 100.
+//
+// See https://docs.aws.amazon.com/sns/latest/dg/sns-message-delivery-retries.html for more details.
+type RetryPolicy struct {
+	MinDelayTarget     int    `json:"minDelayTarget"`
+	MaxDelayTarget     int    `json:"maxDelayTarget"`
+	NumRetries         int    `json:"numRetries"`
+	NumNoDelayRetries  int    `json:"numNoDelayRetries"`
+	NumMinDelayRetries int    `json:"numMinDelayRetries"`
+	NumMaxDelayRetries int    `json:"numMaxDelayRetries"`
+	BackoffFunction    string `json:"backoffFunction,omitempty"`
+}
+
+// ThrottlePolicy limits the number of deliveries per second SNS makes to
+// an endpoint.
+type ThrottlePolicy struct {
+	MaxReceivesPerSecond int `json:"maxReceivesPerSecond"`
+}
+
+// RequestPolicy sets the Content-Type header of the requests SNS makes to
+// an endpoint, such as "application/json".
+type RequestPolicy struct {
+	HeaderContentType string `json:"headerContentType"`
+}
+
+// SubscriptionDeliveryPolicy is the delivery policy of an HTTP or HTTPS
+// subscription. Unset policies fall back to the topic's defaults.
+type SubscriptionDeliveryPolicy struct {
+	HealthyRetryPolicy *RetryPolicy    `json:"healthyRetryPolicy,omitempty"`
+	ThrottlePolicy     *ThrottlePolicy `json:"throttlePolicy,omitempty"`
+	RequestPolicy      *RequestPolicy  `json:"requestPolicy,omitempty"`
+}
+
+// TopicDeliveryPolicy is the delivery policy of a topic, holding the
+// defaults of its HTTP and HTTPS subscriptions.
+type TopicDeliveryPolicy struct {
+	HTTP *HTTPDeliveryPolicy `json:"http,omitempty"`
+}
+
+// HTTPDeliveryPolicy holds the default policies of the HTTP and HTTPS
+// subscriptions of a topic. If DisableSubscriptionOverrides is true, the
+// delivery policies of the subscriptions are ignored.
+type HTTPDeliveryPolicy struct {
+	DefaultHealthyRetryPolicy    *RetryPolicy    `json:"defaultHealthyRetryPolicy,omitempty"`
+	DefaultThrottlePolicy        *ThrottlePolicy `json:"defaultThrottlePolicy,omitempty"`
+	DefaultRequestPolicy         *RequestPolicy  `json:"defaultRequestPolicy,omitempty"`
+	DisableSubscriptionOverrides bool            `json:"disableSubscriptionOverrides"`
+}
+
+// HTTPSubscriptionOptions holds the attributes of a new HTTP or HTTPS
+// subscription. FilterPolicy is a JSON document and is optional.
+type HTTPSubscriptionOptions struct {
+	DeliveryPolicy     *SubscriptionDeliveryPolicy
+	RawMessageDelivery bool
+	FilterPolicy       string
+}
+
+// SubscribeHTTP subscribes an HTTP or HTTPS endpoint, depending on the
+// scheme of its URL, to a topic. The endpoint must confirm the
+// subscription before it receives notifications.
+func (sns *SNS) SubscribeHTTP(topicArn, endpoint string, options *HTTPSubscriptionOptions) (*SubscribeResponse, error) {
+	protocol := "http"
+	if strings.HasPrefix(endpoint, "https://") {
+		protocol = "https"
+	}
+
+	var attributes []Attribute
+	if options != nil {
+		if options.DeliveryPolicy != nil {
+			policy, err := json.Marshal(options.DeliveryPolicy)
+			if err != nil {
+				return nil, err
+			}
+			attributes = append(attributes, Attribute{DeliveryPolicyAttribute, string(policy)})
+		}
+		if options.RawMessageDelivery {
+			attributes = append(attributes, Attribute{RawMessageDeliveryAttribute, "true"})
+		}
+		if options.FilterPolicy != "" {
+			attributes = append(attributes, Attribute{FilterPolicyAttribute, options.FilterPolicy})
+		}
+	}
+
+	return sns.SubscribeWithAttributes(topicArn, protocol, endpoint, attributes, false)
+}
+
+// SetTopicDeliveryPolicy sets the delivery policy of a topic.
+//
+// See https://docs.aws.amazon.com/sns/latest/dg/sns-message-delivery-retries.html for more details.
+func (sns *SNS) SetTopicDeliveryPolicy(topicArn string, policy *TopicDeliveryPolicy) (*SetTopicAttributesResponse, error) {
+	value, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	return sns.SetTopicAttributes(topicArn, DeliveryPolicyAttribute, string(value))
+}
+
+// GetTopicDeliveryPolicy returns the effective delivery policy of a topic,
+// which includes the SNS defaults for anything the topic does not set.
+func (sns *SNS) GetTopicDeliveryPolicy(topicArn string) (*TopicDeliveryPolicy, error) {
+	response, err := sns.GetTopicAttributes(topicArn)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &TopicDeliveryPolicy{}
+	if err := unmarshalAttribute(response.Attributes, EffectiveDeliveryPolicyAttribute, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetSubscriptionDeliveryPolicy sets the delivery policy of an HTTP or
+// HTTPS subscription.
+//
+// See https://docs.aws.amazon.com/sns/latest/dg/sns-message-delivery-retries.html for more details.
+func (sns *SNS) SetSubscriptionDeliveryPolicy(subscriptionArn string, policy *SubscriptionDeliveryPolicy) (*SetSubscriptionAttributesResponse, error) {
+	value, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	return sns.SetSubscriptionAttributes(subscriptionArn, DeliveryPolicyAttribute, string(value))
+}
+
+// GetSubscriptionDeliveryPolicy returns the effective delivery policy of an
+// HTTP or HTTPS subscription, which combines its own delivery policy with
+// the defaults of its topic.
+func (sns *SNS) GetSubscriptionDeliveryPolicy(subscriptionArn string) (*SubscriptionDeliveryPolicy, error) {
+	response, err := sns.GetSubscriptionAttributes(subscriptionArn)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &SubscriptionDeliveryPolicy{}
+	if err := unmarshalAttribute(response.Attributes, EffectiveDeliveryPolicyAttribute, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetRawMessageDelivery turns raw message delivery of a subscription on or
+// off. When on, HTTP endpoints receive the message body as is, instead of
+// wrapped in a JSON notification.
+func (sns *SNS) SetRawMessageDelivery(subscriptionArn string, raw bool) (*SetSubscriptionAttributesResponse, error) {
+	return sns.SetSubscriptionAttributes(subscriptionArn, RawMessageDeliveryAttribute, strconv.FormatBool(raw))
+}
+
+// unmarshalAttribute decodes the JSON value of the named attribute into v.
+// v is left untouched if the attribute is missing.
+func unmarshalAttribute(attributes []Attribute, name string, v interface{}) error {
+	for _, attr := range attributes {
+		if attr.Key == name && attr.Value != "" {
+			return json.Unmarshal([]byte(attr.Value), v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package sns_test
+
+import (
+	"github.com/zackbloom/goamz/sns"
+	"gopkg.in/check.v1"
+)
+
+func (s *S) TestSubscribeHTTP(c *check.C) {
+	testServer.Response(200, nil, TestSubscribeXmlOK)
+
+	options := &sns.HTTPSubscriptionOptions{
+		DeliveryPolicy: &sns.SubscriptionDeliveryPolicy{
+			ThrottlePolicy: &sns.ThrottlePolicy{MaxReceivesPerSecond: 5},
+		},
+		RawMessageDelivery: true,
+	}
+	resp, err := s.sns.SubscribeHTTP("arn:aws:sns:us-east-1:123456789012:My-Topic", "https://example.com/hook", options)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.Form.Get("Action"), check.Equals, "Subscribe")
+	c.Assert(req.Form.Get("Protocol"), check.Equals, "https")
+	c.Assert(req.Form.Get("Endpoint"), check.Equals, "https://example.com/hook")
+	c.Assert(req.Form.Get("Attributes.entry.1.key"), check.Equals, "DeliveryPolicy")
+	c.Assert(req.Form.Get("Attributes.entry.1.value"), check.Equals, `{"throttlePolicy":{"maxReceivesPerSecond":5}}`)
+	c.Assert(req.Form.Get("Attributes.entry.2.key"), check.Equals, "RawMessageDelivery")
+	c.Assert(req.Form.Get("Attributes.entry.2.value"), check.Equals, "true")
+	c.Assert(req.Form.Get("Attributes.entry.3.key"), check.Equals, "")
+
+	c.Assert(resp.SubscriptionArn, check.Equals, "pending confirmation")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestSetTopicDeliveryPolicy(c *check.C) {
+	testServer.Response(200, nil, TestSetTopicAttributesXmlOK)
+
+	policy := &sns.TopicDeliveryPolicy{
+		HTTP: &sns.HTTPDeliveryPolicy{
+			DefaultHealthyRetryPolicy: &sns.RetryPolicy{
+				MinDelayTarget:  5,
+				MaxDelayTarget:  300,
+				NumRetries:      10,
+				BackoffFunction: sns.BackoffExponential,
+			},
+			DisableSubscriptionOverrides: true,
+		},
+	}
+	_, err := s.sns.SetTopicDeliveryPolicy("arn:aws:sns:us-east-1:123456789012:My-Topic", policy)
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form.Get("Action"), check.Equals, "SetTopicAttributes")
+	c.Assert(req.Form.Get("AttributeName"), check.Equals, "DeliveryPolicy")
+	c.Assert(req.Form.Get("AttributeValue"), check.Equals,
+		`{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":5,"maxDelayTarget":300,"numRetries":10,`+
+			`"numNoDelayRetries":0,"numMinDelayRetries":0,"numMaxDelayRetries":0,"backoffFunction":"exponential"},`+
+			`"disableSubscriptionOverrides":true}}`)
+}
+
+func (s *S) TestGetTopicDeliveryPolicy(c *check.C) {
+	testServer.Response(200, nil, TestGetTopicDeliveryPolicyXmlOK)
+
+	policy, err := s.sns.GetTopicDeliveryPolicy("arn:aws:sns:us-east-1:123456789012:My-Topic")
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form.Get("Action"), check.Equals, "GetTopicAttributes")
+	c.Assert(policy.HTTP, check.NotNil)
+	c.Assert(policy.HTTP.DefaultHealthyRetryPolicy, check.DeepEquals, &sns.RetryPolicy{
+		MinDelayTarget:  20,
+		MaxDelayTarget:  20,
+		NumRetries:      3,
+		BackoffFunction: sns.BackoffLinear,
+	})
+	c.Assert(policy.HTTP.DisableSubscriptionOverrides, check.Equals, false)
+}
+
+func (s *S) TestSetSubscriptionDeliveryPolicy(c *check.C) {
+	testServer.Response(200, nil, TestSetSubscriptionAttributesXmlOK)
+
+	policy := &sns.SubscriptionDeliveryPolicy{
+		RequestPolicy: &sns.RequestPolicy{HeaderContentType: "application/json"},
+	}
+	_, err := s.sns.SetSubscriptionDeliveryPolicy("arn:aws:sns:us-east-1:123456789012:My-Topic:sub", policy)
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form.Get("Action"), check.Equals, "SetSubscriptionAttributes")
+	c.Assert(req.Form.Get("SubscriptionArn"), check.Equals, "arn:aws:sns:us-east-1:123456789012:My-Topic:sub")
+	c.Assert(req.Form.Get("AttributeName"), check.Equals, "DeliveryPolicy")
+	c.Assert(req.Form.Get("AttributeValue"), check.Equals, `{"requestPolicy":{"headerContentType":"application/json"}}`)
+}
+
+func (s *S) TestGetSubscriptionDeliveryPolicy(c *check.C) {
+	testServer.Response(200, nil, TestGetSubscriptionDeliveryPolicyXmlOK)
+
+	policy, err := s.sns.GetSubscriptionDeliveryPolicy("arn:aws:sns:us-east-1:123456789012:My-Topic:sub")
+	testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(policy.HealthyRetryPolicy.NumRetries, check.Equals, 50)
+	c.Assert(policy.HealthyRetryPolicy.NumNoDelayRetries, check.Equals, 3)
+	c.Assert(policy.HealthyRetryPolicy.BackoffFunction, check.Equals, sns.BackoffExponential)
+	c.Assert(policy.ThrottlePolicy, check.DeepEquals, &sns.ThrottlePolicy{MaxReceivesPerSecond: 10})
+	c.Assert(policy.RequestPolicy, check.IsNil)
+}
+
+func (s *S) TestSetRawMessageDelivery(c *check.C) {
+	testServer.Response(200, nil, TestSetSubscriptionAttributesXmlOK)
+
+	_, err := s.sns.SetRawMessageDelivery("arn:aws:sns:us-east-1:123456789012:My-Topic:sub", false)
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form.Get("AttributeName"), check.Equals, "RawMessageDelivery")
+	c.Assert(req.Form.Get("AttributeValue"), check.Equals, "false")
+}
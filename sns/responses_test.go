@@ -90,6 +90,42 @@ var TestPublishXmlOK = `
 </PublishResponse>
 `
 
+var TestPublishFIFOXmlOK = `
+<PublishResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
+  <PublishResult>
+    <MessageId>94f20ce6-13c5-43a0-9a9e-ca52d816e90b</MessageId>
+    <SequenceNumber>10000000000000003000</SequenceNumber>
+  </PublishResult>
+  <ResponseMetadata>
+    <RequestId>f187a3c1-376f-11df-8963-01868b7c937a</RequestId>
+  </ResponseMetadata>
+</PublishResponse>
+`
+
+var TestPublishBatchXmlOK = `
+<PublishBatchResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
+  <PublishBatchResult>
+    <Successful>
+      <member>
+        <Id>1</Id>
+        <MessageId>c7d7e6a5-4a2b-5f39-a1de-2c0b3e7b7f11</MessageId>
+      </member>
+    </Successful>
+    <Failed>
+      <member>
+        <Id>2</Id>
+        <Code>InvalidParameter</Code>
+        <Message>Invalid parameter: Message too long</Message>
+        <SenderFault>true</SenderFault>
+      </member>
+    </Failed>
+  </PublishBatchResult>
+  <ResponseMetadata>
+    <RequestId>3a3c4b2f-7d0e-5c5e-9a7b-5a8f1c2d3e4f</RequestId>
+  </ResponseMetadata>
+</PublishBatchResponse>
+`
+
 var TestSetTopicAttributesXmlOK = `
 <SetTopicAttributesResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
   <ResponseMetadata>
@@ -338,3 +374,43 @@ var TestSetPlatformApplicationAttributesXmlOK = `
   </ResponseMetadata>
 </SetPlatformApplicationAttributesResponse>
 `
+
+var TestGetTopicDeliveryPolicyXmlOK = `
+<GetTopicAttributesResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
+  <GetTopicAttributesResult>
+    <Attributes>
+      <entry>
+        <key>TopicArn</key>
+        <value>arn:aws:sns:us-east-1:123456789012:My-Topic</value>
+      </entry>
+      <entry>
+        <key>EffectiveDeliveryPolicy</key>
+        <value>{"http":{"defaultHealthyRetryPolicy":{"minDelayTarget":20,"maxDelayTarget":20,"numRetries":3,"numMaxDelayRetries":0,"numNoDelayRetries":0,"numMinDelayRetries":0,"backoffFunction":"linear"},"disableSubscriptionOverrides":false}}</value>
+      </entry>
+    </Attributes>
+  </GetTopicAttributesResult>
+  <ResponseMetadata>
+    <RequestId>057f074c-33a7-11df-9540-99d0768312d3</RequestId>
+  </ResponseMetadata>
+</GetTopicAttributesResponse>
+`
+
+var TestGetSubscriptionDeliveryPolicyXmlOK = `
+<GetSubscriptionAttributesResponse xmlns="http://sns.amazonaws.com/doc/2010-03-31/">
+  <GetSubscriptionAttributesResult>
+    <Attributes>
+      <entry>
+        <key>Protocol</key>
+        <value>https</value>
+      </entry>
+      <entry>
+        <key>EffectiveDeliveryPolicy</key>
+        <value>{"healthyRetryPolicy":{"minDelayTarget":1,"maxDelayTarget":60,"numRetries":50,"numMaxDelayRetries":35,"numNoDelayRetries":3,"numMinDelayRetries":2,"backoffFunction":"exponential"},"sicklyRetryPolicy":null,"throttlePolicy":{"maxReceivesPerSecond":10},"guaranteed":false}</value>
+      </entry>
+    </Attributes>
+  </GetSubscriptionAttributesResult>
+  <ResponseMetadata>
+    <RequestId>95bfab85-1300-403f-a86e-2e78f095a05d</RequestId>
+  </ResponseMetadata>
+</GetSubscriptionAttributesResponse>
+`
@@ -1,10 +1,12 @@
 package sns
 
 import (
+	"encoding/base64"
 	"encoding/xml"
 	"fmt"
 	"github.com/zackbloom/goamz/aws"
 	"net/http"
+	"sort"
 )
 
 type SNS struct {
@@ -70,9 +72,20 @@ func (sns *SNS) ListAllTopics() ([]Topic, error) {
 // Creates a topic to which notifications can be published. Users can create at most 3000 topics.
 // This action is idempotent, so if the requester already owns a topic with the specified name, that topic's ARN is returned without creating a new topic.
 func (sns *SNS) CreateTopic(name string) (*CreateTopicResponse, error) {
+	return sns.CreateTopicWithAttributes(name, nil)
+}
+
+// Creates a topic with the given attributes, such as DisplayName, Policy or DeliveryPolicy.
+// FIFO topics are created by giving a name ending in ".fifo" and setting the FifoTopic attribute to "true".
+func (sns *SNS) CreateTopicWithAttributes(name string, attributes []Attribute) (*CreateTopicResponse, error) {
 	params := aws.MakeParams("CreateTopic")
 	params["Name"] = name
 
+	for i, attr := range attributes {
+		params[fmt.Sprintf("Attributes.entry.%d.key", i+1)] = attr.Key
+		params[fmt.Sprintf("Attributes.entry.%d.value", i+1)] = attr.Value
+	}
+
 	response := &CreateTopicResponse{}
 	err := sns.query("POST", params, response)
 
@@ -175,12 +188,75 @@ func (sns *SNS) Publish(options *PublishOptions) (*PublishResponse, error) {
 		params["TargetArn"] = options.TargetArn
 	}
 
+	if options.MessageGroupId != "" {
+		params["MessageGroupId"] = options.MessageGroupId
+	}
+
+	if options.MessageDeduplicationId != "" {
+		params["MessageDeduplicationId"] = options.MessageDeduplicationId
+	}
+
+	addMessageAttributes(params, "", options.MessageAttributes)
+
 	response := &PublishResponse{}
 	err := sns.query("POST", params, response)
 
 	return response, err
 }
 
+// Publishes up to 10 messages to a topic in a single request.
+// The response holds the result of each entry; a batch can partially succeed, so Failed must be checked even when no error is returned.
+func (sns *SNS) PublishBatch(topicArn string, entries []PublishBatchEntry) (*PublishBatchResponse, error) {
+	params := aws.MakeParams("PublishBatch")
+	params["TopicArn"] = topicArn
+
+	for i, entry := range entries {
+		prefix := fmt.Sprintf("PublishBatchRequestEntries.member.%d.", i+1)
+		params[prefix+"Id"] = entry.Id
+		params[prefix+"Message"] = entry.Message
+
+		if entry.MessageStructure != "" {
+			params[prefix+"MessageStructure"] = entry.MessageStructure
+		}
+		if entry.Subject != "" {
+			params[prefix+"Subject"] = entry.Subject
+		}
+		if entry.MessageGroupId != "" {
+			params[prefix+"MessageGroupId"] = entry.MessageGroupId
+		}
+		if entry.MessageDeduplicationId != "" {
+			params[prefix+"MessageDeduplicationId"] = entry.MessageDeduplicationId
+		}
+
+		addMessageAttributes(params, prefix, entry.MessageAttributes)
+	}
+
+	response := &PublishBatchResponse{}
+	err := sns.query("POST", params, response)
+
+	return response, err
+}
+
+func addMessageAttributes(params map[string]string, prefix string, attributes map[string]MessageAttributeValue) {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for i, name := range names {
+		attr := attributes[name]
+		entry := fmt.Sprintf("%sMessageAttributes.entry.%d.", prefix, i+1)
+		params[entry+"Name"] = name
+		params[entry+"Value.DataType"] = attr.DataType
+		if attr.BinaryValue != nil {
+			params[entry+"Value.BinaryValue"] = base64.StdEncoding.EncodeToString(attr.BinaryValue)
+		} else {
+			params[entry+"Value.StringValue"] = attr.StringValue
+		}
+	}
+}
+
 // Prepares to subscribe an endpoint by sending the endpoint a confirmation message.
 // To actually create a subscription, the endpoint owner must call the ConfirmSubscription action with the token from the confirmation message.
 // Confirmation tokens are valid for three days.
@@ -198,6 +274,30 @@ func (sns *SNS) Subscribe(topicArn, protocol, endpoint string) (*SubscribeRespon
 	return response, err
 }
 
+// Subscribes an endpoint to a topic, setting subscription attributes such as RawMessageDelivery or FilterPolicy.
+// If returnSubscriptionArn is true the subscription ARN is returned even if the subscription still needs to be confirmed.
+func (sns *SNS) SubscribeWithAttributes(topicArn, protocol, endpoint string, attributes []Attribute, returnSubscriptionArn bool) (*SubscribeResponse, error) {
+	params := aws.MakeParams("Subscribe")
+	params["TopicArn"] = topicArn
+	params["Protocol"] = protocol
+	if endpoint != "" {
+		params["Endpoint"] = endpoint
+	}
+	if returnSubscriptionArn {
+		params["ReturnSubscriptionArn"] = "true"
+	}
+
+	for i, attr := range attributes {
+		params[fmt.Sprintf("Attributes.entry.%d.key", i+1)] = attr.Key
+		params[fmt.Sprintf("Attributes.entry.%d.value", i+1)] = attr.Value
+	}
+
+	response := &SubscribeResponse{}
+	err := sns.query("POST", params, response)
+
+	return response, err
+}
+
 func (sns *SNS) UnsubscribeFromHttp(notification *HttpNotification,
 	authenticateOnUnsubscribe string) (*UnsubscribeResponse, error) {
 	if notification.Type != MESSAGE_TYPE_NOTIFICATION {
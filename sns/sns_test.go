@@ -116,7 +116,11 @@ func (s *S) TestGetTopicAttributes(c *check.C) {
 func (s *S) TestPublish(c *check.C) {
 	testServer.Response(200, nil, TestPublishXmlOK)
 
-	pubOpt := &sns.PublishOptions{"foobar", "", "subject", "", "arn:aws:sns:us-east-1:123456789012:My-Topic"}
+	pubOpt := &sns.PublishOptions{
+		Message:   "foobar",
+		Subject:   "subject",
+		TargetArn: "arn:aws:sns:us-east-1:123456789012:My-Topic",
+	}
 	resp, err := s.sns.Publish(pubOpt)
 	req := testServer.WaitRequest()
 
@@ -129,6 +133,102 @@ func (s *S) TestPublish(c *check.C) {
 	c.Assert(err, check.IsNil)
 }
 
+func (s *S) TestCreateTopicWithAttributes(c *check.C) {
+	testServer.Response(200, nil, TestCreateTopicXmlOK)
+
+	attrs := []sns.Attribute{{"FifoTopic", "true"}, {"ContentBasedDeduplication", "true"}}
+	resp, err := s.sns.CreateTopicWithAttributes("My-Topic.fifo", attrs)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.Form.Get("Action"), check.Equals, "CreateTopic")
+	c.Assert(req.Form.Get("Name"), check.Equals, "My-Topic.fifo")
+	c.Assert(req.Form.Get("Attributes.entry.1.key"), check.Equals, "FifoTopic")
+	c.Assert(req.Form.Get("Attributes.entry.1.value"), check.Equals, "true")
+	c.Assert(req.Form.Get("Attributes.entry.2.key"), check.Equals, "ContentBasedDeduplication")
+	c.Assert(req.Form.Get("Attributes.entry.2.value"), check.Equals, "true")
+
+	c.Assert(resp.Topic.TopicArn, check.Equals, "arn:aws:sns:us-east-1:123456789012:My-Topic")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestPublishWithMessageAttributes(c *check.C) {
+	testServer.Response(200, nil, TestPublishFIFOXmlOK)
+
+	pubOpt := &sns.PublishOptions{
+		Message:  "foobar",
+		TopicArn: "arn:aws:sns:us-east-1:123456789012:My-Topic.fifo",
+		MessageAttributes: map[string]sns.MessageAttributeValue{
+			"store":   {DataType: "String", StringValue: "example_corp"},
+			"price":   {DataType: "Number", StringValue: "10"},
+			"payload": {DataType: "Binary", BinaryValue: []byte("hello")},
+		},
+		MessageGroupId:         "group1",
+		MessageDeduplicationId: "dedup1",
+	}
+	resp, err := s.sns.Publish(pubOpt)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.Form.Get("Action"), check.Equals, "Publish")
+	c.Assert(req.Form.Get("MessageGroupId"), check.Equals, "group1")
+	c.Assert(req.Form.Get("MessageDeduplicationId"), check.Equals, "dedup1")
+	c.Assert(req.Form.Get("MessageAttributes.entry.1.Name"), check.Equals, "payload")
+	c.Assert(req.Form.Get("MessageAttributes.entry.1.Value.DataType"), check.Equals, "Binary")
+	c.Assert(req.Form.Get("MessageAttributes.entry.1.Value.BinaryValue"), check.Equals, "aGVsbG8=")
+	c.Assert(req.Form.Get("MessageAttributes.entry.2.Name"), check.Equals, "price")
+	c.Assert(req.Form.Get("MessageAttributes.entry.2.Value.DataType"), check.Equals, "Number")
+	c.Assert(req.Form.Get("MessageAttributes.entry.2.Value.StringValue"), check.Equals, "10")
+	c.Assert(req.Form.Get("MessageAttributes.entry.3.Name"), check.Equals, "store")
+	c.Assert(req.Form.Get("MessageAttributes.entry.3.Value.StringValue"), check.Equals, "example_corp")
+
+	c.Assert(resp.MessageId, check.Equals, "94f20ce6-13c5-43a0-9a9e-ca52d816e90b")
+	c.Assert(resp.SequenceNumber, check.Equals, "10000000000000003000")
+	c.Assert(err, check.IsNil)
+}
+
+func (s *S) TestPublishBatch(c *check.C) {
+	testServer.Response(200, nil, TestPublishBatchXmlOK)
+
+	entries := []sns.PublishBatchEntry{
+		{Id: "1", Message: "first", Subject: "subject"},
+		{
+			Id:      "2",
+			Message: "second",
+			MessageAttributes: map[string]sns.MessageAttributeValue{
+				"store": {DataType: "String", StringValue: "example_corp"},
+			},
+		},
+	}
+	resp, err := s.sns.PublishBatch("arn:aws:sns:us-east-1:123456789012:My-Topic", entries)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.Form.Get("Action"), check.Equals, "PublishBatch")
+	c.Assert(req.Form.Get("TopicArn"), check.Equals, "arn:aws:sns:us-east-1:123456789012:My-Topic")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.1.Id"), check.Equals, "1")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.1.Message"), check.Equals, "first")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.1.Subject"), check.Equals, "subject")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.2.Id"), check.Equals, "2")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.2.Message"), check.Equals, "second")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.2.MessageAttributes.entry.1.Name"), check.Equals, "store")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.2.MessageAttributes.entry.1.Value.DataType"), check.Equals, "String")
+	c.Assert(req.Form.Get("PublishBatchRequestEntries.member.2.MessageAttributes.entry.1.Value.StringValue"), check.Equals, "example_corp")
+
+	c.Assert(resp.Successful, check.HasLen, 1)
+	c.Assert(resp.Successful[0].Id, check.Equals, "1")
+	c.Assert(resp.Successful[0].MessageId, check.Equals, "c7d7e6a5-4a2b-5f39-a1de-2c0b3e7b7f11")
+	c.Assert(resp.Failed, check.HasLen, 1)
+	c.Assert(resp.Failed[0], check.DeepEquals, sns.PublishBatchResultErrorEntry{
+		Id:          "2",
+		Code:        "InvalidParameter",
+		Message:     "Invalid parameter: Message too long",
+		SenderFault: true,
+	})
+	c.Assert(resp.ResponseMetadata.RequestId, check.Equals, "3a3c4b2f-7d0e-5c5e-9a7b-5a8f1c2d3e4f")
+	c.Assert(err, check.IsNil)
+}
+
 func (s *S) TestSetTopicAttributes(c *check.C) {
 	testServer.Response(200, nil, TestSetTopicAttributesXmlOK)
 
@@ -158,6 +258,26 @@ func (s *S) TestSubscribe(c *check.C) {
 	c.Assert(err, check.IsNil)
 }
 
+func (s *S) TestSubscribeWithAttributes(c *check.C) {
+	testServer.Response(200, nil, TestSubscribeXmlOK)
+
+	attrs := []sns.Attribute{{"RawMessageDelivery", "true"}}
+	resp, err := s.sns.SubscribeWithAttributes("arn:aws:sns:us-east-1:123456789012:My-Topic", "sqs",
+		"arn:aws:sqs:us-east-1:123456789012:My-Queue", attrs, true)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.Form.Get("Action"), check.Equals, "Subscribe")
+	c.Assert(req.Form.Get("Protocol"), check.Equals, "sqs")
+	c.Assert(req.Form.Get("Endpoint"), check.Equals, "arn:aws:sqs:us-east-1:123456789012:My-Queue")
+	c.Assert(req.Form.Get("ReturnSubscriptionArn"), check.Equals, "true")
+	c.Assert(req.Form.Get("Attributes.entry.1.key"), check.Equals, "RawMessageDelivery")
+	c.Assert(req.Form.Get("Attributes.entry.1.value"), check.Equals, "true")
+
+	c.Assert(resp.SubscriptionArn, check.Equals, "pending confirmation")
+	c.Assert(err, check.IsNil)
+}
+
 func (s *S) TestUnsubscribe(c *check.C) {
 	testServer.Response(200, nil, TestUnsubscribeXmlOK)
 
@@ -36,6 +36,16 @@ type Endpoint struct {
 	Attributes  []Attribute `xml:"Attributes>entry"`
 }
 
+// MessageAttributeValue is the value of a message attribute. DataType is
+// String, String.Array, Number or Binary, optionally followed by a custom
+// type suffix such as "Number.float". BinaryValue is only used with Binary
+// attributes.
+type MessageAttributeValue struct {
+	DataType    string
+	StringValue string
+	BinaryValue []byte
+}
+
 // ============ Request ============
 
 type PublishOptions struct {
@@ -44,6 +54,26 @@ type PublishOptions struct {
 	Subject          string
 	TopicArn         string
 	TargetArn        string
+
+	MessageAttributes map[string]MessageAttributeValue
+
+	// Required when publishing to FIFO topics. MessageDeduplicationId may
+	// be empty if the topic has content-based deduplication enabled.
+	MessageGroupId         string
+	MessageDeduplicationId string
+}
+
+// PublishBatchEntry is a single message of a PublishBatch request. Id must
+// be unique within the batch and is used to match the entry with its
+// result.
+type PublishBatchEntry struct {
+	Id                     string
+	Message                string
+	MessageStructure       string
+	Subject                string
+	MessageAttributes      map[string]MessageAttributeValue
+	MessageGroupId         string
+	MessageDeduplicationId string
 }
 
 type PlatformEndpointOptions struct {
@@ -88,6 +118,26 @@ type SetTopicAttributesResponse struct {
 
 type PublishResponse struct {
 	MessageId        string `xml:"PublishResult>MessageId"`
+	SequenceNumber   string `xml:"PublishResult>SequenceNumber"`
+	ResponseMetadata aws.ResponseMetadata
+}
+
+type PublishBatchResultEntry struct {
+	Id             string
+	MessageId      string
+	SequenceNumber string
+}
+
+type PublishBatchResultErrorEntry struct {
+	Id          string
+	Code        string
+	Message     string
+	SenderFault bool
+}
+
+type PublishBatchResponse struct {
+	Successful       []PublishBatchResultEntry      `xml:"PublishBatchResult>Successful>member"`
+	Failed           []PublishBatchResultErrorEntry `xml:"PublishBatchResult>Failed>member"`
 	ResponseMetadata aws.ResponseMetadata
 }
 
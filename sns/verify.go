@@ -0,0 +1,184 @@
+package sns
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// Verifies the signatures of the messages SNS posts to HTTP endpoints.
+// See http://docs.aws.amazon.com/sns/latest/dg/sns-verify-signature-of-message.html for details.
+
+var snsCertHost = regexp.MustCompile(`^sns\.[a-z0-9-]+\.amazonaws\.com(\.cn)?$`)
+
+// The fields covered by the signature of each message type, in order.
+var (
+	notificationSignedFields = []string{"Message", "MessageId", "Subject", "Timestamp", "TopicArn", "Type"}
+	confirmationSignedFields = []string{"Message", "MessageId", "SubscribeURL", "Timestamp", "Token", "TopicArn", "Type"}
+)
+
+// NotificationVerifier checks the signatures of HTTP notifications. The
+// signing certificates it downloads are cached, so a single verifier should
+// be shared by all the requests of an endpoint.
+type NotificationVerifier struct {
+	// Client is used to download signing certificates. If nil,
+	// http.DefaultClient is used.
+	Client *http.Client
+
+	// CertHost matches the hosts signing certificates may be downloaded
+	// from. If nil, only SNS endpoints are accepted.
+	CertHost *regexp.Regexp
+
+	mu    sync.Mutex
+	certs map[string]*x509.Certificate
+}
+
+var defaultVerifier = &NotificationVerifier{}
+
+// VerifyNotification decodes an HTTP notification posted by SNS and checks
+// its signature. It uses a shared NotificationVerifier.
+func VerifyNotification(body []byte) (*HttpNotification, error) {
+	return defaultVerifier.Verify(body)
+}
+
+// Verify decodes an HTTP notification posted by SNS and checks its
+// signature against the certificate at its SigningCertURL. The
+// notification is only returned if the signature is valid.
+func (v *NotificationVerifier) Verify(body []byte) (*HttpNotification, error) {
+	// The signature covers the fields exactly as sent, so they are also
+	// kept as strings; Timestamp wouldn't survive a round trip otherwise.
+	var fields map[string]interface{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, err
+	}
+	notification := &HttpNotification{}
+	if err := json.Unmarshal(body, notification); err != nil {
+		return nil, err
+	}
+
+	var signed []string
+	switch notification.Type {
+	case MESSAGE_TYPE_NOTIFICATION:
+		signed = notificationSignedFields
+	case MESSAGE_TYPE_SUBSCRIPTION_CONFIRMATION, MESSAGE_TYPE_UNSUBSCRIBE_CONFIRMATION:
+		signed = confirmationSignedFields
+	default:
+		return nil, fmt.Errorf("sns: unknown message type %q", notification.Type)
+	}
+	var msg bytes.Buffer
+	for _, name := range signed {
+		value, ok := fields[name].(string)
+		if !ok {
+			// Optional fields such as Subject are skipped when absent.
+			continue
+		}
+		msg.WriteString(name + "\n" + value + "\n")
+	}
+
+	var hash crypto.Hash
+	var digest []byte
+	switch notification.SignatureVersion {
+	case "1":
+		sum := sha1.Sum([]byte(msg.String()))
+		hash, digest = crypto.SHA1, sum[:]
+	case "2":
+		sum := sha256.Sum256([]byte(msg.String()))
+		hash, digest = crypto.SHA256, sum[:]
+	default:
+		return nil, fmt.Errorf("sns: unsupported signature version %q", notification.SignatureVersion)
+	}
+	signature, err := base64.StdEncoding.DecodeString(notification.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("sns: invalid signature encoding: %v", err)
+	}
+
+	cert, err := v.certificate(notification.SigningCertURL)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("sns: signing certificate does not hold an RSA key")
+	}
+	if err := rsa.VerifyPKCS1v15(key, hash, digest, signature); err != nil {
+		return nil, errors.New("sns: invalid message signature")
+	}
+	return notification, nil
+}
+
+// certificate returns the signing certificate at certURL, downloading it
+// the first time it is used.
+func (v *NotificationVerifier) certificate(certURL string) (*x509.Certificate, error) {
+	u, err := url.Parse(certURL)
+	if err != nil {
+		return nil, err
+	}
+	certHost := v.CertHost
+	if certHost == nil {
+		certHost = snsCertHost
+	}
+	if u.Scheme != "https" || !certHost.MatchString(u.Host) || !strings.HasSuffix(u.Path, ".pem") {
+		return nil, fmt.Errorf("sns: untrusted signing certificate URL %q", certURL)
+	}
+
+	v.mu.Lock()
+	cert := v.certs[certURL]
+	v.mu.Unlock()
+	if cert != nil {
+		return cert, nil
+	}
+
+	client := v.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Get(certURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sns: downloading signing certificate: %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("sns: signing certificate is not a PEM certificate")
+	}
+	cert, err = x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	v.mu.Lock()
+	if v.certs == nil {
+		v.certs = make(map[string]*x509.Certificate)
+	}
+	v.certs[certURL] = cert
+	v.mu.Unlock()
+	return cert, nil
+}
+
+// DecodeMessage decodes the Message of n, which must be JSON, into v. It
+// is useful for the notifications of services such as SES and
+// CloudFormation that publish structured messages.
+func (n *HttpNotification) DecodeMessage(v interface{}) error {
+	return json.Unmarshal([]byte(n.Message), v)
+}
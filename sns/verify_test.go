@@ -0,0 +1,192 @@
+package sns_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"time"
+
+	"github.com/zackbloom/goamz/sns"
+	"gopkg.in/check.v1"
+)
+
+var _ = check.Suite(&VerifyS{})
+
+type VerifyS struct {
+	key       *rsa.PrivateKey
+	server    *httptest.Server
+	certURL   string
+	downloads int
+}
+
+func (s *VerifyS) SetUpSuite(c *check.C) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	c.Assert(err, check.IsNil)
+	s.key = key
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sns.amazonaws.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	c.Assert(err, check.IsNil)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+
+	s.server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.downloads++
+		w.Write(certPEM)
+	}))
+	s.certURL = s.server.URL + "/SimpleNotificationService-test.pem"
+}
+
+func (s *VerifyS) TearDownSuite(c *check.C) {
+	s.server.Close()
+}
+
+func (s *VerifyS) verifier() *sns.NotificationVerifier {
+	return &sns.NotificationVerifier{
+		Client:   s.server.Client(),
+		CertHost: regexp.MustCompile(`^127\.0\.0\.1:[0-9]+$`),
+	}
+}
+
+// sign signs fields the way SNS does and returns the JSON notification.
+func (s *VerifyS) sign(c *check.C, fields map[string]string, signed []string) []byte {
+	fields["SigningCertURL"] = s.certURL
+	msg := ""
+	for _, name := range signed {
+		if value, ok := fields[name]; ok {
+			msg += name + "\n" + value + "\n"
+		}
+	}
+	var sig []byte
+	var err error
+	if fields["SignatureVersion"] == "2" {
+		sum := sha256.Sum256([]byte(msg))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, sum[:])
+	} else {
+		sum := sha1.Sum([]byte(msg))
+		sig, err = rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA1, sum[:])
+	}
+	c.Assert(err, check.IsNil)
+	fields["Signature"] = base64.StdEncoding.EncodeToString(sig)
+	body, err := json.Marshal(fields)
+	c.Assert(err, check.IsNil)
+	return body
+}
+
+func notificationFields() map[string]string {
+	return map[string]string{
+		"Type":             "Notification",
+		"MessageId":        "22b80b92-fdea-4c2c-8f9d-bdfb0c7bf324",
+		"TopicArn":         "arn:aws:sns:us-east-1:123456789012:MyTopic",
+		"Subject":          "My First Message",
+		"Message":          `{"notificationType":"Bounce"}`,
+		"Timestamp":        "2012-05-02T00:54:06.655Z",
+		"SignatureVersion": "1",
+		"UnsubscribeURL":   "https://sns.us-east-1.amazonaws.com/?Action=Unsubscribe",
+	}
+}
+
+var notificationSigned = []string{"Message", "MessageId", "Subject", "Timestamp", "TopicArn", "Type"}
+
+func (s *VerifyS) TestVerifyNotification(c *check.C) {
+	body := s.sign(c, notificationFields(), notificationSigned)
+
+	n, err := s.verifier().Verify(body)
+	c.Assert(err, check.IsNil)
+	c.Assert(n.Type, check.Equals, sns.MESSAGE_TYPE_NOTIFICATION)
+	c.Assert(n.Subject, check.Equals, "My First Message")
+	c.Assert(n.Timestamp.Equal(time.Date(2012, 5, 2, 0, 54, 6, 655e6, time.UTC)), check.Equals, true)
+
+	var msg struct{ NotificationType string }
+	c.Assert(n.DecodeMessage(&msg), check.IsNil)
+	c.Assert(msg.NotificationType, check.Equals, "Bounce")
+}
+
+func (s *VerifyS) TestVerifyNotificationWithoutSubject(c *check.C) {
+	fields := notificationFields()
+	delete(fields, "Subject")
+	fields["SignatureVersion"] = "2"
+	body := s.sign(c, fields, notificationSigned)
+
+	_, err := s.verifier().Verify(body)
+	c.Assert(err, check.IsNil)
+}
+
+func (s *VerifyS) TestVerifySubscriptionConfirmation(c *check.C) {
+	fields := map[string]string{
+		"Type":             "SubscriptionConfirmation",
+		"MessageId":        "165545c9-2a5c-472c-8df2-7ff2be2b3b1b",
+		"Token":            "2336412f37fb687f5d51e6e241d09c805a5a57b30d712f794cc5f6a988666d92768dd60a747ba6f3beb7",
+		"TopicArn":         "arn:aws:sns:us-east-1:123456789012:MyTopic",
+		"Message":          "You have chosen to subscribe to the topic arn:aws:sns:us-east-1:123456789012:MyTopic.",
+		"SubscribeURL":     "https://sns.us-east-1.amazonaws.com/?Action=ConfirmSubscription",
+		"Timestamp":        "2012-04-26T20:45:04.751Z",
+		"SignatureVersion": "1",
+	}
+	body := s.sign(c, fields, []string{"Message", "MessageId", "SubscribeURL", "Timestamp", "Token", "TopicArn", "Type"})
+
+	n, err := s.verifier().Verify(body)
+	c.Assert(err, check.IsNil)
+	c.Assert(n.Token, check.Equals, fields["Token"])
+}
+
+func (s *VerifyS) TestVerifyTamperedMessage(c *check.C) {
+	body := s.sign(c, notificationFields(), notificationSigned)
+	var fields map[string]string
+	c.Assert(json.Unmarshal(body, &fields), check.IsNil)
+	fields["Message"] = "tampered"
+	body, _ = json.Marshal(fields)
+
+	_, err := s.verifier().Verify(body)
+	c.Assert(err, check.ErrorMatches, "sns: invalid message signature")
+}
+
+func (s *VerifyS) TestVerifyCachesCertificate(c *check.C) {
+	v := s.verifier()
+	downloads := s.downloads
+	for i := 0; i < 3; i++ {
+		_, err := v.Verify(s.sign(c, notificationFields(), notificationSigned))
+		c.Assert(err, check.IsNil)
+	}
+	c.Assert(s.downloads, check.Equals, downloads+1)
+}
+
+func (s *VerifyS) TestVerifyUntrustedCertURL(c *check.C) {
+	for _, certURL := range []string{
+		"http://sns.us-east-1.amazonaws.com/SimpleNotificationService-f3ecfb7224c7233fe7bb5f59f96de52f.pem",
+		"https://sns.us-east-1.amazonaws.com.example.com/cert.pem",
+		"https://example.com/SimpleNotificationService-f3ecfb7224c7233fe7bb5f59f96de52f.pem",
+		"https://sns.us-east-1.amazonaws.com/?cert=x.pem",
+	} {
+		fields := notificationFields()
+		fields["SigningCertURL"] = certURL
+		fields["Signature"] = base64.StdEncoding.EncodeToString([]byte("EXAMPLE"))
+		body, _ := json.Marshal(fields)
+
+		_, err := sns.VerifyNotification(body)
+		c.Assert(err, check.ErrorMatches, "sns: untrusted signing certificate URL .*")
+	}
+}
+
+func (s *VerifyS) TestVerifyUnsupportedSignatureVersion(c *check.C) {
+	fields := notificationFields()
+	fields["SignatureVersion"] = "3"
+	body := s.sign(c, fields, notificationSigned)
+
+	_, err := s.verifier().Verify(body)
+	c.Assert(err, check.ErrorMatches, `sns: unsupported signature version "3"`)
+}
@@ -0,0 +1,199 @@
+package sqs
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Consumer polls a queue with long polling and dispatches the messages it
+// receives to Handler from a pool of Concurrency goroutines.
+//
+// While a message is being handled its visibility timeout is extended every
+// VisibilityTimeout/2 seconds, so slow handlers don't have their messages
+// redelivered to another consumer. A message is deleted once Handler returns
+// nil; if Handler returns an error the message is left on the queue and
+// becomes visible again when its visibility timeout expires.
+//
+// If the queue has a TracePropagator, the trace context of the sender is
+// extracted from the attributes of each message into its Context.
+//
+// Consumers must be created with NewConsumer. Set the fields before calling
+// Run and don't change them afterwards.
+type Consumer struct {
+	Queue   *Queue
+	Handler func(*Message) error
+
+	Concurrency       int // Number of messages handled at once (default 1)
+	MaxMessages       int // Messages requested per ReceiveMessage, at most 10 (default 10)
+	WaitTimeSeconds   int // Long polling wait, at most 20 (default 20)
+	VisibilityTimeout int // Visibility timeout of received messages in seconds (default 30)
+
+	// ErrorHandler, if set, is called with the errors of the ReceiveMessage,
+	// ChangeMessageVisibility and DeleteMessage requests made by the
+	// consumer. Handler errors are not reported.
+	ErrorHandler func(error)
+
+	// Time to wait before polling again after a failed ReceiveMessage
+	// (default 1 second).
+	RetryDelay time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewConsumer returns a Consumer for q with the default settings.
+func NewConsumer(q *Queue, handler func(*Message) error) *Consumer {
+	return &Consumer{
+		Queue:   q,
+		Handler: handler,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+}
+
+// Run polls the queue until Stop is called. It returns once every message
+// already received has been handled.
+func (c *Consumer) Run() {
+	defer close(c.done)
+
+	concurrency := c.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	work := make(chan *inflightMessage)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for m := range work {
+				c.handle(m)
+			}
+		}()
+	}
+
+	for !c.stopping() {
+		resp, err := c.Queue.ReceiveMessageWithParameters(map[string]string{
+			"MaxNumberOfMessages": strconv.Itoa(c.maxMessages()),
+			"WaitTimeSeconds":     strconv.Itoa(c.waitTimeSeconds()),
+			"VisibilityTimeout":   strconv.Itoa(c.visibilityTimeout()),
+		})
+		if err != nil {
+			c.reportError(err)
+			select {
+			case <-time.After(c.retryDelay()):
+			case <-c.stop:
+			}
+			continue
+		}
+		// Messages already received are always dispatched, even after
+		// Stop, since they are invisible to other consumers until their
+		// visibility timeout expires.
+		for i := range resp.Messages {
+			work <- c.track(&resp.Messages[i])
+		}
+	}
+
+	close(work)
+	wg.Wait()
+}
+
+// Stop makes Run return after the current ReceiveMessage request completes
+// and the messages in flight are handled. It blocks until then, so it must
+// only be called once Run has been started.
+func (c *Consumer) Stop() {
+	c.stopOnce.Do(func() { close(c.stop) })
+	<-c.done
+}
+
+func (c *Consumer) stopping() bool {
+	select {
+	case <-c.stop:
+		return true
+	default:
+		return false
+	}
+}
+
+type inflightMessage struct {
+	msg  *Message
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// track starts extending the visibility timeout of msg until the returned
+// message is finished.
+func (c *Consumer) track(msg *Message) *inflightMessage {
+	m := &inflightMessage{msg: msg, done: make(chan struct{})}
+	timeout := c.visibilityTimeout()
+	interval := time.Duration(timeout) * time.Second / 2
+	m.wg.Add(1)
+	go func() {
+		defer m.wg.Done()
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := c.Queue.ChangeMessageVisibility(msg, timeout); err != nil {
+					c.reportError(err)
+				}
+			case <-m.done:
+				return
+			}
+		}
+	}()
+	return m
+}
+
+func (c *Consumer) handle(m *inflightMessage) {
+	if p := c.Queue.TracePropagator; p != nil {
+		m.msg.ctx = p.Extract(context.Background(), m.msg.TraceCarrier())
+	}
+	err := c.Handler(m.msg)
+	close(m.done)
+	m.wg.Wait()
+	if err != nil {
+		return
+	}
+	if _, err := c.Queue.DeleteMessage(m.msg); err != nil {
+		c.reportError(err)
+	}
+}
+
+func (c *Consumer) reportError(err error) {
+	if c.ErrorHandler != nil {
+		c.ErrorHandler(err)
+	}
+}
+
+func (c *Consumer) maxMessages() int {
+	if c.MaxMessages <= 0 || c.MaxMessages > 10 {
+		return 10
+	}
+	return c.MaxMessages
+}
+
+func (c *Consumer) waitTimeSeconds() int {
+	if c.WaitTimeSeconds <= 0 || c.WaitTimeSeconds > 20 {
+		return 20
+	}
+	return c.WaitTimeSeconds
+}
+
+func (c *Consumer) visibilityTimeout() int {
+	if c.VisibilityTimeout <= 0 {
+		return 30
+	}
+	return c.VisibilityTimeout
+}
+
+func (c *Consumer) retryDelay() time.Duration {
+	if c.RetryDelay <= 0 {
+		return time.Second
+	}
+	return c.RetryDelay
+}
@@ -0,0 +1,163 @@
+package sqs
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+var _ = check.Suite(&ConsumerS{})
+
+type ConsumerS struct{}
+
+// fakeQueue serves ReceiveMessage, ChangeMessageVisibility and DeleteMessage
+// requests for a queue holding a fixed set of messages.
+type fakeQueue struct {
+	sync.Mutex
+	pending     []string
+	params      []map[string]string
+	visibility  map[string]int
+	deleted     []string
+	failReceive int
+	attributes  map[string]string // String attributes of every message
+}
+
+func (f *fakeQueue) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	req.ParseForm()
+	f.Lock()
+	defer f.Unlock()
+	switch req.Form.Get("Action") {
+	case "ReceiveMessage":
+		f.params = append(f.params, map[string]string{
+			"MaxNumberOfMessages": req.Form.Get("MaxNumberOfMessages"),
+			"WaitTimeSeconds":     req.Form.Get("WaitTimeSeconds"),
+			"VisibilityTimeout":   req.Form.Get("VisibilityTimeout"),
+		})
+		if f.failReceive > 0 {
+			f.failReceive--
+			w.WriteHeader(500)
+			return
+		}
+		fmt.Fprint(w, "<ReceiveMessageResponse><ReceiveMessageResult>")
+		for _, id := range f.pending {
+			fmt.Fprintf(w, "<Message><MessageId>%s</MessageId><ReceiptHandle>handle-%s</ReceiptHandle><Body>body-%s</Body>", id, id, id)
+			for k, v := range f.attributes {
+				fmt.Fprintf(w, "<MessageAttribute><Name>%s</Name><Value><DataType>String</DataType><StringValue>%s</StringValue></Value></MessageAttribute>", k, v)
+			}
+			fmt.Fprint(w, "</Message>")
+		}
+		f.pending = nil
+		fmt.Fprint(w, "</ReceiveMessageResult></ReceiveMessageResponse>")
+		if len(f.params) > 1 {
+			// Simulate a short long poll on an empty queue.
+			time.Sleep(10 * time.Millisecond)
+		}
+	case "ChangeMessageVisibility":
+		f.visibility[req.Form.Get("ReceiptHandle")]++
+		fmt.Fprint(w, TestChangeMessageVisibilityXmlOK)
+	case "DeleteMessage":
+		f.deleted = append(f.deleted, req.Form.Get("ReceiptHandle"))
+		fmt.Fprint(w, TestDeleteMessageXmlOK)
+	default:
+		w.WriteHeader(400)
+	}
+}
+
+func (s *ConsumerS) newQueue(f *fakeQueue) (*Queue, func()) {
+	server := httptest.NewServer(f)
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	sqs := New(auth, aws.Region{SQSEndpoint: server.URL})
+	return &Queue{sqs, server.URL + "/123456789012/testQueue/"}, server.Close
+}
+
+func (s *ConsumerS) TestConsumerDeletesHandledMessages(c *check.C) {
+	f := &fakeQueue{pending: []string{"m1", "m2", "m3"}, visibility: map[string]int{}}
+	q, closeServer := s.newQueue(f)
+	defer closeServer()
+
+	var mu sync.Mutex
+	var bodies []string
+	handled := make(chan bool, 3)
+	consumer := NewConsumer(q, func(m *Message) error {
+		mu.Lock()
+		bodies = append(bodies, m.Body)
+		mu.Unlock()
+		handled <- true
+		if m.MessageId == "m2" {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	consumer.Concurrency = 2
+	go consumer.Run()
+	for i := 0; i < 3; i++ {
+		<-handled
+	}
+	consumer.Stop()
+
+	c.Assert(bodies, check.HasLen, 3)
+	f.Lock()
+	defer f.Unlock()
+	c.Assert(f.params[0], check.DeepEquals, map[string]string{
+		"MaxNumberOfMessages": "10",
+		"WaitTimeSeconds":     "20",
+		"VisibilityTimeout":   "30",
+	})
+	deleted := map[string]bool{}
+	for _, h := range f.deleted {
+		deleted[h] = true
+	}
+	c.Assert(deleted, check.DeepEquals, map[string]bool{"handle-m1": true, "handle-m3": true})
+	c.Assert(f.visibility, check.HasLen, 0)
+}
+
+func (s *ConsumerS) TestConsumerExtendsVisibility(c *check.C) {
+	f := &fakeQueue{pending: []string{"m1"}, visibility: map[string]int{}}
+	q, closeServer := s.newQueue(f)
+	defer closeServer()
+
+	done := make(chan bool)
+	consumer := NewConsumer(q, func(m *Message) error {
+		// Outlive two extension intervals.
+		time.Sleep(1200 * time.Millisecond)
+		done <- true
+		return nil
+	})
+	consumer.VisibilityTimeout = 1
+	go consumer.Run()
+	<-done
+	consumer.Stop()
+
+	f.Lock()
+	defer f.Unlock()
+	c.Assert(f.params[0]["VisibilityTimeout"], check.Equals, "1")
+	c.Assert(f.visibility["handle-m1"] >= 2, check.Equals, true)
+	c.Assert(f.deleted, check.DeepEquals, []string{"handle-m1"})
+}
+
+func (s *ConsumerS) TestConsumerReportsReceiveErrors(c *check.C) {
+	f := &fakeQueue{pending: []string{"m1"}, visibility: map[string]int{}, failReceive: 1}
+	q, closeServer := s.newQueue(f)
+	defer closeServer()
+
+	var errs []error
+	handled := make(chan bool, 1)
+	consumer := NewConsumer(q, func(m *Message) error {
+		handled <- true
+		return nil
+	})
+	consumer.RetryDelay = time.Millisecond
+	consumer.ErrorHandler = func(err error) { errs = append(errs, err) }
+	go consumer.Run()
+	<-handled
+	consumer.Stop()
+
+	c.Assert(errs, check.HasLen, 1)
+	c.Assert(errs[0].(*Error).StatusCode, check.Equals, 500)
+}
@@ -243,3 +243,94 @@ var TestPurgeQueueXmlOK = `
     </ResponseMetadata>
 </PurgeQueueResponse>
 `
+
+var TestSendMessageBatchXmlPartialFailure = `
+<SendMessageBatchResponse>
+<SendMessageBatchResult>
+    <SendMessageBatchResultEntry>
+        <Id>msg-1</Id>
+        <MessageId>0a5231c7-8bff-4955-be2e-8dc7c50a25fa</MessageId>
+        <MD5OfMessageBody>0e024d309850c78cba5eabbeff7cae71</MD5OfMessageBody>
+    </SendMessageBatchResultEntry>
+    <BatchResultErrorEntry>
+        <Id>msg-2</Id>
+        <SenderFault>true</SenderFault>
+        <Code>InvalidParameterValue</Code>
+        <Message>Message must be shorter than 262144 bytes.</Message>
+    </BatchResultErrorEntry>
+</SendMessageBatchResult>
+<ResponseMetadata>
+    <RequestId>ca1ad5d0-8271-408b-8d0f-1351bf547e74</RequestId>
+</ResponseMetadata>
+</SendMessageBatchResponse>
+`
+
+var TestDeleteMessageBatchXmlPartialFailure = `
+<DeleteMessageBatchResponse>
+    <DeleteMessageBatchResult>
+        <DeleteMessageBatchResultEntry>
+            <Id>msg1</Id>
+        </DeleteMessageBatchResultEntry>
+        <BatchResultErrorEntry>
+            <Id>msg2</Id>
+            <SenderFault>true</SenderFault>
+            <Code>ReceiptHandleIsInvalid</Code>
+            <Message>The input receipt handle is invalid.</Message>
+        </BatchResultErrorEntry>
+    </DeleteMessageBatchResult>
+    <ResponseMetadata>
+        <RequestId>d6f86b7a-74d1-4439-b43f-196a1e29cd85</RequestId>
+    </ResponseMetadata>
+</DeleteMessageBatchResponse>
+`
+
+var TestChangeMessageVisibilityBatchXmlOK = `
+<ChangeMessageVisibilityBatchResponse>
+    <ChangeMessageVisibilityBatchResult>
+        <ChangeMessageVisibilityBatchResultEntry>
+            <Id>msg1</Id>
+        </ChangeMessageVisibilityBatchResultEntry>
+        <BatchResultErrorEntry>
+            <Id>msg2</Id>
+            <SenderFault>true</SenderFault>
+            <Code>ReceiptHandleIsInvalid</Code>
+            <Message>The input receipt handle is invalid.</Message>
+        </BatchResultErrorEntry>
+    </ChangeMessageVisibilityBatchResult>
+    <ResponseMetadata>
+        <RequestId>ca9668f7-ab1b-4f7a-8859-f15747ab17a7</RequestId>
+    </ResponseMetadata>
+</ChangeMessageVisibilityBatchResponse>
+`
+
+var TestCreateFIFOQueueXmlOK = `
+<CreateQueueResponse>
+  <CreateQueueResult>
+    <QueueUrl>http://sqs.us-east-1.amazonaws.com/123456789012/testQueue.fifo</QueueUrl>
+  </CreateQueueResult>
+  <ResponseMetadata>
+    <RequestId>7a62c49f-347e-4fc4-9331-6e8e7a96aa73</RequestId>
+  </ResponseMetadata>
+</CreateQueueResponse>
+`
+
+var TestSendFIFOMessageXmlOK = `
+<SendMessageResponse>
+  <SendMessageResult>
+    <MD5OfMessageBody>fafb00f5732ab283681e124bf8747ed1</MD5OfMessageBody>
+    <MessageId>5fea7756-0ea4-451a-a703-a558b933e274</MessageId>
+    <SequenceNumber>18849496460467696128</SequenceNumber>
+  </SendMessageResult>
+  <ResponseMetadata>
+    <RequestId>27daac76-34dd-47df-bd01-1f6e873584a0</RequestId>
+  </ResponseMetadata>
+</SendMessageResponse>
+`
+
+var TestDeleteMessageXmlOK = `
+<DeleteMessageResponse>
+    <ResponseMetadata>
+        <RequestId>b5293cb5-d306-4a17-9048-b263635abe42</RequestId>
+    </ResponseMetadata>
+</DeleteMessageResponse>
+`
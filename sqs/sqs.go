@@ -10,6 +10,7 @@
 package sqs
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -31,6 +32,20 @@ const debug = false
 type SQS struct {
 	aws.Auth
 	aws.Region
+
+	// TracePropagator, if set, carries trace context across queues in
+	// message attributes. See SendMessageWithContext and Consumer.
+	TracePropagator TracePropagator
+
+	// RetryPolicy, if set, retries the requests that fail with a network
+	// error, a 5xx response or throttling. By default requests are not
+	// retried.
+	RetryPolicy aws.RetryPolicy
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+
 	private byte // Reserve the right of using private data.
 }
 
@@ -50,7 +65,7 @@ func NewFrom(accessKey, secretKey, region string) (*SQS, error) {
 
 // NewFrom Create A new SQS Client from an exisisting aws.Auth
 func New(auth aws.Auth, region aws.Region) *SQS {
-	return &SQS{auth, region, 0}
+	return &SQS{Auth: auth, Region: region}
 }
 
 // Queue Reference to a Queue
@@ -90,6 +105,7 @@ type SendMessageResponse struct {
 	AttributeMD5     string `xml:"SendMessageResult>MD5OfMessageAttributes"`
 	MD5              string `xml:"SendMessageResult>MD5OfMessageBody"`
 	Id               string `xml:"SendMessageResult>MessageId"`
+	SequenceNumber   string `xml:"SendMessageResult>SequenceNumber"`
 	ResponseMetadata ResponseMetadata
 }
 
@@ -106,6 +122,14 @@ type Message struct {
 	Attribute        []Attribute        `xml:"Attribute"`
 	MessageAttribute []MessageAttribute `xml:"MessageAttribute"`
 	DelaySeconds     int
+
+	// MessageGroupId and MessageDeduplicationId are sent with messages
+	// published to FIFO queues by SendMessageBatch. On received messages
+	// they are reported in Attribute instead.
+	MessageGroupId         string
+	MessageDeduplicationId string
+
+	ctx context.Context
 }
 
 type Attribute struct {
@@ -155,6 +179,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
 func (err *Error) String() string {
 	return err.Message
 }
@@ -187,6 +215,23 @@ func (s *SQS) CreateQueueWithAttributes(queueName string, attrs map[string]strin
 	return
 }
 
+// CreateFIFOQueue creates a FIFO queue. The name of a FIFO queue must end
+// with ".fifo". When contentBasedDeduplication is true, SQS uses a SHA-256
+// hash of the message body as the deduplication ID of messages sent
+// without one.
+func (s *SQS) CreateFIFOQueue(queueName string, contentBasedDeduplication bool) (*Queue, error) {
+	if !strings.HasSuffix(queueName, ".fifo") {
+		return nil, fmt.Errorf("FIFO queue name %q must end with .fifo", queueName)
+	}
+	attrs := map[string]string{
+		"FifoQueue": "true",
+	}
+	if contentBasedDeduplication {
+		attrs["ContentBasedDeduplication"] = "true"
+	}
+	return s.CreateQueueWithAttributes(queueName, attrs)
+}
+
 // GetQueue get a reference to the given quename
 func (s *SQS) GetQueue(queueName string) (*Queue, error) {
 	var q *Queue
@@ -261,10 +306,33 @@ func (q *Queue) SendMessageWithDelay(MessageBody string, DelaySeconds int64) (re
 }
 
 func (q *Queue) SendMessageWithAttributes(MessageBody string, MessageAttributes map[string]string) (resp *SendMessageResponse, err error) {
-	resp = &SendMessageResponse{}
 	params := makeParams("SendMessage")
+	params["MessageBody"] = MessageBody
+
+	return q.sendMessage(params, MessageAttributes)
+}
 
+// SendFIFOMessageWithAttributes sends a message to a FIFO queue. Messages
+// with the same MessageGroupId are delivered in order.
+// MessageDeduplicationId may be empty if the queue has content-based
+// deduplication enabled.
+func (q *Queue) SendFIFOMessageWithAttributes(MessageBody, MessageGroupId, MessageDeduplicationId string, MessageAttributes map[string]string) (resp *SendMessageResponse, err error) {
+	params := makeParams("SendMessage")
 	params["MessageBody"] = MessageBody
+	params["MessageGroupId"] = MessageGroupId
+	if MessageDeduplicationId != "" {
+		params["MessageDeduplicationId"] = MessageDeduplicationId
+	}
+
+	return q.sendMessage(params, MessageAttributes)
+}
+
+func (q *Queue) SendFIFOMessage(MessageBody, MessageGroupId, MessageDeduplicationId string) (resp *SendMessageResponse, err error) {
+	return q.SendFIFOMessageWithAttributes(MessageBody, MessageGroupId, MessageDeduplicationId, map[string]string{})
+}
+
+func (q *Queue) sendMessage(params map[string]string, MessageAttributes map[string]string) (resp *SendMessageResponse, err error) {
+	resp = &SendMessageResponse{}
 
 	// Add attributes (currently only supports string values)
 	i := 1
@@ -312,6 +380,17 @@ func (q *Queue) ReceiveMessage(MaxNumberOfMessages int) (*ReceiveMessageResponse
 	return q.ReceiveMessageWithParameters(params)
 }
 
+// ReceiveMessageWithWaitTime long polls the queue, waiting up to
+// WaitTimeSeconds (at most 20) for a message to arrive before returning an
+// empty response.
+func (q *Queue) ReceiveMessageWithWaitTime(MaxNumberOfMessages, WaitTimeSeconds int) (*ReceiveMessageResponse, error) {
+	params := map[string]string{
+		"MaxNumberOfMessages": strconv.Itoa(MaxNumberOfMessages),
+		"WaitTimeSeconds":     strconv.Itoa(WaitTimeSeconds),
+	}
+	return q.ReceiveMessageWithParameters(params)
+}
+
 func (q *Queue) ReceiveMessageWithParameters(p map[string]string) (resp *ReceiveMessageResponse, err error) {
 	resp = &ReceiveMessageResponse{}
 	params := makeParams("ReceiveMessage")
@@ -375,15 +454,27 @@ type SendMessageBatchResultEntry struct {
 	Id               string `xml:"Id"`
 	MessageId        string `xml:"MessageId"`
 	MD5OfMessageBody string `xml:"MD5OfMessageBody"`
+	SequenceNumber   string `xml:"SequenceNumber"`
+}
+
+// BatchResultErrorEntry describes an entry of a batch request that failed.
+// Id matches the Id of the request entry.
+type BatchResultErrorEntry struct {
+	Id          string `xml:"Id"`
+	SenderFault bool   `xml:"SenderFault"`
+	Code        string `xml:"Code"`
+	Message     string `xml:"Message"`
 }
 
 type SendMessageBatchResponse struct {
 	SendMessageBatchResult []SendMessageBatchResultEntry `xml:"SendMessageBatchResult>SendMessageBatchResultEntry"`
+	Failed                 []BatchResultErrorEntry       `xml:"SendMessageBatchResult>BatchResultErrorEntry"`
 	ResponseMetadata       ResponseMetadata
 }
 
-/* SendMessageBatch
- */
+// SendMessageBatch sends up to ten messages in one request. Entries are
+// given the Ids "msg-1", "msg-2", ... in the order of msgList; messages
+// that could not be sent are reported in resp.Failed.
 func (q *Queue) SendMessageBatch(msgList []Message) (resp *SendMessageBatchResponse, err error) {
 	resp = &SendMessageBatchResponse{}
 	params := makeParams("SendMessageBatch")
@@ -396,6 +487,12 @@ func (q *Queue) SendMessageBatch(msgList []Message) (resp *SendMessageBatchRespo
 		if msg.DelaySeconds > 0 {
 			params[fmt.Sprintf("SendMessageBatchRequestEntry.%d.DelaySeconds", count)] = strconv.Itoa(msg.DelaySeconds)
 		}
+		if msg.MessageGroupId != "" {
+			params[fmt.Sprintf("SendMessageBatchRequestEntry.%d.MessageGroupId", count)] = msg.MessageGroupId
+		}
+		if msg.MessageDeduplicationId != "" {
+			params[fmt.Sprintf("SendMessageBatchRequestEntry.%d.MessageDeduplicationId", count)] = msg.MessageDeduplicationId
+		}
 	}
 
 	err = q.SQS.query(q.Url, params, resp)
@@ -425,40 +522,51 @@ type DeleteMessageBatchResponse struct {
 		Code        string
 		Message     string
 	} `xml:"DeleteMessageBatchResult>DeleteMessageBatchResultEntry"`
+	Failed           []BatchResultErrorEntry `xml:"DeleteMessageBatchResult>BatchResultErrorEntry"`
 	ResponseMetadata ResponseMetadata
 }
 
-/* DeleteMessageBatch */
+// DeleteMessageBatch deletes up to ten messages in one request. Entries
+// are identified by MessageId; messages that could not be deleted are
+// reported in resp.Failed.
 func (q *Queue) DeleteMessageBatch(msgList []Message) (resp *DeleteMessageBatchResponse, err error) {
 	resp = &DeleteMessageBatchResponse{}
 	params := makeParams("DeleteMessageBatch")
 
-	lutMsg := make(map[string]Message)
-
 	for idx := range msgList {
 		params[fmt.Sprintf("DeleteMessageBatchRequestEntry.%d.Id", idx+1)] = msgList[idx].MessageId
 		params[fmt.Sprintf("DeleteMessageBatchRequestEntry.%d.ReceiptHandle", idx+1)] = msgList[idx].ReceiptHandle
-
-		lutMsg[string(msgList[idx].MessageId)] = msgList[idx]
 	}
 
 	err = q.SQS.query(q.Url, params, resp)
+	return
+}
 
-	messageWithErrors := make([]Message, 0, len(msgList))
+type ChangeMessageVisibilityBatchResultEntry struct {
+	Id string `xml:"Id"`
+}
 
-	for idx := range resp.DeleteMessageBatchResult {
-		if resp.DeleteMessageBatchResult[idx].SenderFault {
-			msg, ok := lutMsg[resp.DeleteMessageBatchResult[idx].Id]
-			if ok {
-				messageWithErrors = append(messageWithErrors, msg)
-			}
-		}
-	}
+type ChangeMessageVisibilityBatchResponse struct {
+	ChangeMessageVisibilityBatchResult []ChangeMessageVisibilityBatchResultEntry `xml:"ChangeMessageVisibilityBatchResult>ChangeMessageVisibilityBatchResultEntry"`
+	Failed                             []BatchResultErrorEntry                   `xml:"ChangeMessageVisibilityBatchResult>BatchResultErrorEntry"`
+	ResponseMetadata                   ResponseMetadata
+}
 
-	if len(messageWithErrors) > 0 {
-		log.Printf("%d Message have not been sent", len(messageWithErrors))
+// ChangeMessageVisibilityBatch sets the visibility timeout of up to ten
+// messages in one request. Entries are identified by MessageId; messages
+// whose timeout could not be changed are reported in resp.Failed.
+func (q *Queue) ChangeMessageVisibilityBatch(msgList []Message, VisibilityTimeout int) (resp *ChangeMessageVisibilityBatchResponse, err error) {
+	resp = &ChangeMessageVisibilityBatchResponse{}
+	params := makeParams("ChangeMessageVisibilityBatch")
+
+	for idx := range msgList {
+		count := idx + 1
+		params[fmt.Sprintf("ChangeMessageVisibilityBatchRequestEntry.%d.Id", count)] = msgList[idx].MessageId
+		params[fmt.Sprintf("ChangeMessageVisibilityBatchRequestEntry.%d.ReceiptHandle", count)] = msgList[idx].ReceiptHandle
+		params[fmt.Sprintf("ChangeMessageVisibilityBatchRequestEntry.%d.VisibilityTimeout", count)] = strconv.Itoa(VisibilityTimeout)
 	}
 
+	err = q.SQS.query(q.Url, params, resp)
 	return
 }
 
@@ -485,6 +593,25 @@ func (s *SQS) query(queueUrl string, params map[string]string, resp interface{})
 	}
 
 	params["Version"] = "2012-11-05"
+	if s.RetryPolicy == nil {
+		return s.attempt(url_, params, resp)
+	}
+	target := params["Action"]
+	for numRetries := 0; ; numRetries++ {
+		err = s.attempt(url_, params, resp)
+		if err == nil {
+			aws.RecordSuccess(s.RetryPolicy, target, numRetries)
+			return nil
+		}
+		if !s.RetryPolicy.ShouldRetry(target, nil, err, numRetries) {
+			return err
+		}
+		time.Sleep(s.RetryPolicy.Delay(target, nil, err, numRetries))
+	}
+}
+
+// attempt signs and sends a single request for query.
+func (s *SQS) attempt(url_ *url.URL, params map[string]string, resp interface{}) error {
 	hreq, err := http.NewRequest("POST", url_.String(), strings.NewReader(multimap(params).Encode()))
 	if err != nil {
 		return err
@@ -500,7 +627,11 @@ func (s *SQS) query(queueUrl string, params map[string]string, resp interface{})
 	signer := aws.NewV4Signer(s.Auth, "sqs", s.Region)
 	signer.Sign(hreq)
 
-	r, err := http.DefaultClient.Do(hreq)
+	client := s.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	r, err := client.Do(hreq)
 
 	if err != nil {
 		return err
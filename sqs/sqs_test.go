@@ -201,6 +201,156 @@ func (s *S) TestDeleteMessageBatch(c *check.C) {
 	}
 }
 
+func (s *S) TestSendMessageBatchPartialFailure(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestSendMessageBatchXmlPartialFailure)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue/"}
+
+	msgList := []Message{{Body: "test message body 1"}, {Body: "test message body 2", DelaySeconds: 30}}
+	resp, err := q.SendMessageBatch(msgList)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"SendMessageBatch"})
+	c.Assert(req.Form["SendMessageBatchRequestEntry.1.Id"], check.DeepEquals, []string{"msg-1"})
+	c.Assert(req.Form["SendMessageBatchRequestEntry.2.DelaySeconds"], check.DeepEquals, []string{"30"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.SendMessageBatchResult, check.HasLen, 1)
+	c.Assert(resp.SendMessageBatchResult[0].Id, check.Equals, "msg-1")
+	c.Assert(resp.Failed, check.DeepEquals, []BatchResultErrorEntry{{
+		Id:          "msg-2",
+		SenderFault: true,
+		Code:        "InvalidParameterValue",
+		Message:     "Message must be shorter than 262144 bytes.",
+	}})
+}
+
+func (s *S) TestDeleteMessageBatchPartialFailure(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestDeleteMessageBatchXmlPartialFailure)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue/"}
+
+	msgList := []Message{{MessageId: "msg1", ReceiptHandle: "handle1"}, {MessageId: "msg2", ReceiptHandle: "handle2"}}
+	resp, err := q.DeleteMessageBatch(msgList)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Form["DeleteMessageBatchRequestEntry.2.Id"], check.DeepEquals, []string{"msg2"})
+	c.Assert(req.Form["DeleteMessageBatchRequestEntry.2.ReceiptHandle"], check.DeepEquals, []string{"handle2"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.DeleteMessageBatchResult, check.HasLen, 1)
+	c.Assert(resp.Failed, check.HasLen, 1)
+	c.Assert(resp.Failed[0].Id, check.Equals, "msg2")
+	c.Assert(resp.Failed[0].Code, check.Equals, "ReceiptHandleIsInvalid")
+}
+
+func (s *S) TestChangeMessageVisibilityBatch(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestChangeMessageVisibilityBatchXmlOK)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue/"}
+
+	msgList := []Message{{MessageId: "msg1", ReceiptHandle: "handle1"}, {MessageId: "msg2", ReceiptHandle: "handle2"}}
+	resp, err := q.ChangeMessageVisibilityBatch(msgList, 45)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/123456789012/testQueue/")
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"ChangeMessageVisibilityBatch"})
+	c.Assert(req.Form["ChangeMessageVisibilityBatchRequestEntry.1.Id"], check.DeepEquals, []string{"msg1"})
+	c.Assert(req.Form["ChangeMessageVisibilityBatchRequestEntry.1.ReceiptHandle"], check.DeepEquals, []string{"handle1"})
+	c.Assert(req.Form["ChangeMessageVisibilityBatchRequestEntry.2.VisibilityTimeout"], check.DeepEquals, []string{"45"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.ChangeMessageVisibilityBatchResult, check.DeepEquals, []ChangeMessageVisibilityBatchResultEntry{{Id: "msg1"}})
+	c.Assert(resp.Failed, check.HasLen, 1)
+	c.Assert(resp.Failed[0].Id, check.Equals, "msg2")
+	c.Assert(resp.ResponseMetadata.RequestId, check.Equals, "ca9668f7-ab1b-4f7a-8859-f15747ab17a7")
+}
+
+func (s *S) TestReceiveMessageWithWaitTime(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestReceiveMessageXmlOK)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue/"}
+	resp, err := q.ReceiveMessageWithWaitTime(10, 20)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"ReceiveMessage"})
+	c.Assert(req.Form["MaxNumberOfMessages"], check.DeepEquals, []string{"10"})
+	c.Assert(req.Form["WaitTimeSeconds"], check.DeepEquals, []string{"20"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Messages, check.HasLen, 1)
+}
+
+func (s *S) TestCreateFIFOQueue(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestCreateFIFOQueueXmlOK)
+
+	q, err := s.sqs.CreateFIFOQueue("testQueue.fifo", true)
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateQueue"})
+	c.Assert(req.Form["QueueName"], check.DeepEquals, []string{"testQueue.fifo"})
+	attrs := map[string]string{}
+	for i := 1; i <= 2; i++ {
+		attrs[req.Form.Get(fmt.Sprintf("Attribute.%d.Name", i))] = req.Form.Get(fmt.Sprintf("Attribute.%d.Value", i))
+	}
+	c.Assert(attrs, check.DeepEquals, map[string]string{"FifoQueue": "true", "ContentBasedDeduplication": "true"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(q.Url, check.Equals, "http://sqs.us-east-1.amazonaws.com/123456789012/testQueue.fifo")
+}
+
+func (s *S) TestCreateFIFOQueueBadName(c *check.C) {
+	_, err := s.sqs.CreateFIFOQueue("testQueue", false)
+	c.Assert(err, check.ErrorMatches, `FIFO queue name "testQueue" must end with .fifo`)
+}
+
+func (s *S) TestSendFIFOMessage(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestSendFIFOMessageXmlOK)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue.fifo"}
+	resp, err := q.SendFIFOMessage("This is a test message", "group-1", "dedup-1")
+	req := testServer.WaitRequest()
+
+	c.Assert(req.Form["Action"], check.DeepEquals, []string{"SendMessage"})
+	c.Assert(req.Form["MessageGroupId"], check.DeepEquals, []string{"group-1"})
+	c.Assert(req.Form["MessageDeduplicationId"], check.DeepEquals, []string{"dedup-1"})
+
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Id, check.Equals, "5fea7756-0ea4-451a-a703-a558b933e274")
+	c.Assert(resp.SequenceNumber, check.Equals, "18849496460467696128")
+}
+
+func (s *S) TestSendFIFOMessageContentBasedDeduplication(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestSendFIFOMessageXmlOK)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue.fifo"}
+	_, err := q.SendFIFOMessage("This is a test message", "group-1", "")
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form["MessageGroupId"], check.DeepEquals, []string{"group-1"})
+	c.Assert(req.Form["MessageDeduplicationId"], check.IsNil)
+}
+
+func (s *S) TestSendMessageBatchFIFO(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestSendMessageBatchXmlOk)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue.fifo"}
+	msgList := []Message{
+		{Body: "test message body 1", MessageGroupId: "group-1", MessageDeduplicationId: "dedup-1"},
+		{Body: "test message body 2", MessageGroupId: "group-2"},
+	}
+	_, err := q.SendMessageBatch(msgList)
+	req := testServer.WaitRequest()
+
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form["SendMessageBatchRequestEntry.1.MessageGroupId"], check.DeepEquals, []string{"group-1"})
+	c.Assert(req.Form["SendMessageBatchRequestEntry.1.MessageDeduplicationId"], check.DeepEquals, []string{"dedup-1"})
+	c.Assert(req.Form["SendMessageBatchRequestEntry.2.MessageGroupId"], check.DeepEquals, []string{"group-2"})
+	c.Assert(req.Form["SendMessageBatchRequestEntry.2.MessageDeduplicationId"], check.IsNil)
+}
+
 func (s *S) TestPurgeQueue(c *check.C) {
 	testServer.PrepareResponse(200, nil, TestPurgeQueueXmlOK)
 
@@ -364,3 +514,30 @@ func (s *S) TestGetQueueAttributes(c *check.C) {
 
 	c.Assert(err, check.IsNil)
 }
+
+func (s *S) TestRetryPolicy(c *check.C) {
+	client := *s.sqs
+	client.RetryPolicy = aws.DynamoDBRetryPolicy{}
+
+	testServer.PrepareResponse(400, nil, `<ErrorResponse><Error><Code>RequestThrottled</Code><Message>slow down</Message></Error></ErrorResponse>`)
+	testServer.PrepareResponse(200, nil, TestCreateQueueXmlOK)
+	resp, err := client.CreateQueue("testQueue")
+	c.Assert(err, check.IsNil)
+	c.Assert(resp.Url, check.Equals, "http://sqs.us-east-1.amazonaws.com/123456789012/testQueue")
+
+	for i := 0; i < 2; i++ {
+		req := testServer.WaitRequest()
+		c.Assert(req.Form["Action"], check.DeepEquals, []string{"CreateQueue"})
+	}
+}
+
+func (s *S) TestRetryPolicyGivesUp(c *check.C) {
+	client := *s.sqs
+	client.RetryPolicy = aws.DynamoDBRetryPolicy{}
+
+	testServer.PrepareResponse(400, nil, `<ErrorResponse><Error><Code>AWS.SimpleQueueService.NonExistentQueue</Code></Error></ErrorResponse>`)
+	_, err := client.CreateQueue("testQueue")
+	c.Assert(err, check.FitsTypeOf, &Error{})
+	c.Assert(err.(*Error).Code, check.Equals, "AWS.SimpleQueueService.NonExistentQueue")
+	testServer.WaitRequest()
+}
@@ -0,0 +1,94 @@
+package sqs
+
+import (
+	"context"
+)
+
+// The message attributes holding the W3C trace context, as used by
+// OpenTelemetry.
+const (
+	TraceParentAttribute = "traceparent"
+	TraceStateAttribute  = "tracestate"
+)
+
+// MessageAttributeCarrier holds the string message attributes of a
+// message. It implements the Get, Set and Keys methods of the
+// OpenTelemetry TextMapCarrier interface, so that an OpenTelemetry
+// propagator can inject trace context into it and extract trace context
+// from it.
+type MessageAttributeCarrier map[string]string
+
+// Get returns the value of the attribute key, or "" if there is none.
+func (c MessageAttributeCarrier) Get(key string) string {
+	return c[key]
+}
+
+// Set sets the attribute key to value.
+func (c MessageAttributeCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+// Keys returns the names of the attributes.
+func (c MessageAttributeCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// TracePropagator moves trace context between contexts and message
+// attributes, usually as the traceparent and tracestate attributes.
+//
+// An OpenTelemetry TextMapPropagator can be adapted with:
+//
+//	type otelPropagator struct{ propagation.TextMapPropagator }
+//
+//	func (p otelPropagator) Inject(ctx context.Context, c sqs.MessageAttributeCarrier) {
+//		p.TextMapPropagator.Inject(ctx, c)
+//	}
+//
+//	func (p otelPropagator) Extract(ctx context.Context, c sqs.MessageAttributeCarrier) context.Context {
+//		return p.TextMapPropagator.Extract(ctx, c)
+//	}
+type TracePropagator interface {
+	Inject(ctx context.Context, carrier MessageAttributeCarrier)
+	Extract(ctx context.Context, carrier MessageAttributeCarrier) context.Context
+}
+
+// SendMessageWithContext is like SendMessageWithAttributes, but also adds
+// the trace context of ctx to the message attributes if the TracePropagator
+// of q is set. MessageAttributes is not modified. A message can have at
+// most 10 attributes, including the injected ones.
+func (q *Queue) SendMessageWithContext(ctx context.Context, MessageBody string, MessageAttributes map[string]string) (resp *SendMessageResponse, err error) {
+	attrs := make(MessageAttributeCarrier, len(MessageAttributes)+2)
+	for k, v := range MessageAttributes {
+		attrs[k] = v
+	}
+	if q.TracePropagator != nil {
+		q.TracePropagator.Inject(ctx, attrs)
+	}
+	return q.SendMessageWithAttributes(MessageBody, attrs)
+}
+
+// TraceCarrier returns the string message attributes of m, from which a
+// propagator can extract the trace context of the sender.
+func (m *Message) TraceCarrier() MessageAttributeCarrier {
+	attrs := make(MessageAttributeCarrier, len(m.MessageAttribute))
+	for _, a := range m.MessageAttribute {
+		if a.Value.DataType == "String" {
+			attrs[a.Name] = a.Value.StringValue
+		}
+	}
+	return attrs
+}
+
+// Context returns the context of a message handled by a Consumer, which
+// holds the trace context of the sender if the queue has a
+// TracePropagator. It is context.Background() otherwise.
+func (m *Message) Context() context.Context {
+	if m.ctx != nil {
+		return m.ctx
+	}
+	return context.Background()
+}
@@ -0,0 +1,102 @@
+package sqs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zackbloom/goamz/aws"
+	"gopkg.in/check.v1"
+)
+
+type traceKey struct{}
+
+// fakePropagator stores the traceparent as a context value.
+type fakePropagator struct{}
+
+func (fakePropagator) Inject(ctx context.Context, carrier MessageAttributeCarrier) {
+	if tp, ok := ctx.Value(traceKey{}).(string); ok {
+		carrier.Set(TraceParentAttribute, tp)
+	}
+}
+
+func (fakePropagator) Extract(ctx context.Context, carrier MessageAttributeCarrier) context.Context {
+	if tp := carrier.Get(TraceParentAttribute); tp != "" {
+		return context.WithValue(ctx, traceKey{}, tp)
+	}
+	return ctx
+}
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func (s *S) TestSendMessageWithContext(c *check.C) {
+	expected := map[string]string{"red": "fish", TraceParentAttribute: testTraceParent}
+	testServer.PrepareResponse(200, nil, fmt.Sprintf(`
+<SendMessageResponse>
+  <SendMessageResult>
+    <MD5OfMessageAttributes>%x</MD5OfMessageAttributes>
+    <MessageId>5fea7756-0ea4-451a-a703-a558b933e274</MessageId>
+  </SendMessageResult>
+</SendMessageResponse>`, calculateAttributeMD5(expected)))
+
+	sqs := New(aws.Auth{AccessKey: "abc", SecretKey: "123"}, aws.Region{SQSEndpoint: testServer.URL})
+	sqs.TracePropagator = fakePropagator{}
+	q := &Queue{sqs, testServer.URL + "/123456789012/testQueue/"}
+
+	attributes := map[string]string{"red": "fish"}
+	ctx := context.WithValue(context.Background(), traceKey{}, testTraceParent)
+	_, err := q.SendMessageWithContext(ctx, "This is a test message", attributes)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+
+	sent := map[string]string{}
+	for i := 1; i <= 2; i++ {
+		prefix := fmt.Sprintf("MessageAttribute.%d.", i)
+		sent[req.Form.Get(prefix+"Name")] = req.Form.Get(prefix + "Value.StringValue")
+	}
+	c.Assert(sent, check.DeepEquals, expected)
+	c.Assert(attributes, check.DeepEquals, map[string]string{"red": "fish"})
+}
+
+func (s *S) TestSendMessageWithContextWithoutPropagator(c *check.C) {
+	testServer.PrepareResponse(200, nil, TestSendMessageXmlOK)
+
+	q := &Queue{s.sqs, testServer.URL + "/123456789012/testQueue/"}
+	ctx := context.WithValue(context.Background(), traceKey{}, testTraceParent)
+	_, err := q.SendMessageWithContext(ctx, "This is a test message", nil)
+	req := testServer.WaitRequest()
+	c.Assert(err, check.IsNil)
+	c.Assert(req.Form["MessageAttribute.1.Name"], check.IsNil)
+}
+
+func (s *S) TestMessageTraceCarrier(c *check.C) {
+	m := &Message{MessageAttribute: []MessageAttribute{
+		{Name: TraceParentAttribute, Value: MessageAttributeValue{DataType: "String", StringValue: testTraceParent}},
+		{Name: "count", Value: MessageAttributeValue{DataType: "Number", StringValue: "3"}},
+	}}
+	carrier := m.TraceCarrier()
+	c.Assert(carrier, check.DeepEquals, MessageAttributeCarrier{TraceParentAttribute: testTraceParent})
+	c.Assert(carrier.Keys(), check.DeepEquals, []string{TraceParentAttribute})
+	c.Assert(m.Context(), check.Equals, context.Background())
+}
+
+func (s *ConsumerS) TestConsumerExtractsTraceContext(c *check.C) {
+	f := &fakeQueue{
+		pending:    []string{"m1"},
+		visibility: map[string]int{},
+		attributes: map[string]string{TraceParentAttribute: testTraceParent},
+	}
+	q, closeServer := s.newQueue(f)
+	defer closeServer()
+	q.TracePropagator = fakePropagator{}
+
+	traceParents := make(chan interface{}, 1)
+	consumer := NewConsumer(q, func(m *Message) error {
+		traceParents <- m.Context().Value(traceKey{})
+		return nil
+	})
+	go consumer.Run()
+	tp := <-traceParents
+	consumer.Stop()
+
+	c.Assert(tp, check.Equals, testTraceParent)
+}
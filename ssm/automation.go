@@ -0,0 +1,135 @@
+package ssm
+
+// Statuses of an automation execution and of its steps.
+const (
+	AutomationStatusPending    = "Pending"
+	AutomationStatusInProgress = "InProgress"
+	AutomationStatusWaiting    = "Waiting"
+	AutomationStatusSuccess    = "Success"
+	AutomationStatusTimedOut   = "TimedOut"
+	AutomationStatusCancelling = "Cancelling"
+	AutomationStatusCancelled  = "Cancelled"
+	AutomationStatusFailed     = "Failed"
+)
+
+// Execution modes of an automation. Interactive executions run one step
+// at a time, as sent by SendAutomationSignal.
+const (
+	ExecutionModeAuto        = "Auto"
+	ExecutionModeInteractive = "Interactive"
+)
+
+// StartAutomationExecutionRequest holds the parameters of
+// StartAutomationExecution. Only DocumentName, the name or ARN of an
+// Automation runbook such as "AWS-RestartEC2Instance", is required.
+//
+// A rate-controlled execution runs the runbook for each resource of
+// Targets, passing it as the TargetParameterName parameter, MaxConcurrency
+// at a time and stopping after MaxErrors failures. Both are a number or a
+// percentage, such as "10" or "25%".
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_StartAutomationExecution.html
+type StartAutomationExecutionRequest struct {
+	DocumentName        string
+	DocumentVersion     string              `json:",omitempty"`
+	Parameters          map[string][]string `json:",omitempty"`
+	Mode                string              `json:",omitempty"`
+	ClientToken         string              `json:",omitempty"`
+	TargetParameterName string              `json:",omitempty"`
+	Targets             []Target            `json:",omitempty"`
+	MaxConcurrency      string              `json:",omitempty"`
+	MaxErrors           string              `json:",omitempty"`
+	Tags                []Tag               `json:",omitempty"`
+}
+
+type startAutomationExecutionResponse struct {
+	AutomationExecutionId string
+}
+
+// StartAutomationExecution starts running an Automation runbook and
+// returns the ID of the execution.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_StartAutomationExecution.html
+func (s *SSM) StartAutomationExecution(req *StartAutomationExecutionRequest) (automationExecutionId string, err error) {
+	var resp startAutomationExecutionResponse
+	if err := s.query("StartAutomationExecution", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.AutomationExecutionId, nil
+}
+
+// StepExecution describes a step of an automation execution. Dates are in
+// seconds since the epoch.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_StepExecution.html
+type StepExecution struct {
+	StepExecutionId    string
+	StepName           string
+	Action             string
+	StepStatus         string
+	ExecutionStartTime float64
+	ExecutionEndTime   float64
+	Inputs             map[string]string
+	Outputs            map[string][]string
+	ResponseCode       string
+	Response           string
+	FailureMessage     string
+}
+
+// AutomationExecution describes an automation execution. Dates are in
+// seconds since the epoch; ExecutionEndTime is 0 until it ends.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_AutomationExecution.html
+type AutomationExecution struct {
+	AutomationExecutionId     string
+	AutomationExecutionStatus string
+	DocumentName              string
+	DocumentVersion           string
+	Mode                      string
+	ExecutedBy                string
+	ExecutionStartTime        float64
+	ExecutionEndTime          float64
+	CurrentStepName           string
+	CurrentAction             string
+	Parameters                map[string][]string
+	Outputs                   map[string][]string
+	StepExecutions            []StepExecution
+	FailureMessage            string
+	Target                    string
+	MaxConcurrency            string
+	MaxErrors                 string
+}
+
+// Done reports whether the execution has ended, whether it succeeded or
+// not.
+func (e *AutomationExecution) Done() bool {
+	switch e.AutomationExecutionStatus {
+	case AutomationStatusSuccess, AutomationStatusTimedOut, AutomationStatusCancelled, AutomationStatusFailed:
+		return true
+	}
+	return false
+}
+
+type getAutomationExecutionResponse struct {
+	AutomationExecution AutomationExecution
+}
+
+// GetAutomationExecution describes an automation execution and its steps.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetAutomationExecution.html
+func (s *SSM) GetAutomationExecution(automationExecutionId string) (*AutomationExecution, error) {
+	req := map[string]string{"AutomationExecutionId": automationExecutionId}
+	var resp getAutomationExecutionResponse
+	if err := s.query("GetAutomationExecution", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.AutomationExecution, nil
+}
+
+// StopAutomationExecution cancels an automation execution.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_StopAutomationExecution.html
+func (s *SSM) StopAutomationExecution(automationExecutionId string) error {
+	req := map[string]string{"AutomationExecutionId": automationExecutionId, "Type": "Cancel"}
+	return s.query("StopAutomationExecution", req, nil)
+}
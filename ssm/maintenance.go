@@ -0,0 +1,147 @@
+package ssm
+
+// CreateMaintenanceWindowRequest holds the parameters of
+// CreateMaintenanceWindow. Schedule is a cron or rate expression, such as
+// "cron(0 2 ? * SUN *)", in ScheduleTimezone, an IANA time zone, or UTC.
+// The window lasts Duration hours, and no task is started in its last
+// Cutoff hours.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_CreateMaintenanceWindow.html
+type CreateMaintenanceWindowRequest struct {
+	Name                     string
+	Description              string `json:",omitempty"`
+	Schedule                 string
+	ScheduleTimezone         string `json:",omitempty"`
+	ScheduleOffset           int    `json:",omitempty"`
+	Duration                 int
+	Cutoff                   int
+	AllowUnassociatedTargets bool
+	StartDate                string `json:",omitempty"`
+	EndDate                  string `json:",omitempty"`
+	ClientToken              string `json:",omitempty"`
+	Tags                     []Tag  `json:",omitempty"`
+}
+
+type windowIdResponse struct {
+	WindowId string
+}
+
+// CreateMaintenanceWindow creates a maintenance window and returns its ID.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_CreateMaintenanceWindow.html
+func (s *SSM) CreateMaintenanceWindow(req *CreateMaintenanceWindowRequest) (windowId string, err error) {
+	var resp windowIdResponse
+	if err := s.query("CreateMaintenanceWindow", req, &resp); err != nil {
+		return "", err
+	}
+	return resp.WindowId, nil
+}
+
+// MaintenanceWindow describes a maintenance window. NextExecutionTime is
+// an ISO 8601 date, and CreatedDate and ModifiedDate are in seconds since
+// the epoch; they are only set by GetMaintenanceWindow.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetMaintenanceWindow.html
+type MaintenanceWindow struct {
+	WindowId                 string
+	Name                     string
+	Description              string
+	Enabled                  bool
+	Schedule                 string
+	ScheduleTimezone         string
+	ScheduleOffset           int
+	Duration                 int
+	Cutoff                   int
+	AllowUnassociatedTargets bool
+	StartDate                string
+	EndDate                  string
+	NextExecutionTime        string
+	CreatedDate              float64
+	ModifiedDate             float64
+}
+
+// GetMaintenanceWindow describes a maintenance window.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetMaintenanceWindow.html
+func (s *SSM) GetMaintenanceWindow(windowId string) (*MaintenanceWindow, error) {
+	resp := new(MaintenanceWindow)
+	if err := s.query("GetMaintenanceWindow", map[string]string{"WindowId": windowId}, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateMaintenanceWindowRequest holds the parameters of
+// UpdateMaintenanceWindow. Fields left empty are not changed, unless
+// Replace is true, in which case the optional fields left empty are
+// cleared.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_UpdateMaintenanceWindow.html
+type UpdateMaintenanceWindowRequest struct {
+	WindowId                 string
+	Name                     string `json:",omitempty"`
+	Description              string `json:",omitempty"`
+	Schedule                 string `json:",omitempty"`
+	ScheduleTimezone         string `json:",omitempty"`
+	ScheduleOffset           *int   `json:",omitempty"`
+	Duration                 *int   `json:",omitempty"`
+	Cutoff                   *int   `json:",omitempty"`
+	AllowUnassociatedTargets *bool  `json:",omitempty"`
+	Enabled                  *bool  `json:",omitempty"`
+	StartDate                string `json:",omitempty"`
+	EndDate                  string `json:",omitempty"`
+	Replace                  bool   `json:",omitempty"`
+}
+
+// UpdateMaintenanceWindow changes the settings of a maintenance window,
+// and returns them.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_UpdateMaintenanceWindow.html
+func (s *SSM) UpdateMaintenanceWindow(req *UpdateMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	resp := new(MaintenanceWindow)
+	if err := s.query("UpdateMaintenanceWindow", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window, along with its
+// targets and tasks.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_DeleteMaintenanceWindow.html
+func (s *SSM) DeleteMaintenanceWindow(windowId string) error {
+	return s.query("DeleteMaintenanceWindow", map[string]string{"WindowId": windowId}, nil)
+}
+
+// MaintenanceWindowFilter selects maintenance windows by Key, "Name" or
+// "Enabled", and Values.
+type MaintenanceWindowFilter struct {
+	Key    string
+	Values []string
+}
+
+type describeMaintenanceWindowsRequest struct {
+	Filters    []MaintenanceWindowFilter `json:",omitempty"`
+	NextToken  string                    `json:",omitempty"`
+	MaxResults int                       `json:",omitempty"`
+}
+
+type DescribeMaintenanceWindowsResponse struct {
+	WindowIdentities []MaintenanceWindow
+	NextToken        string
+}
+
+// DescribeMaintenanceWindows returns a page of the maintenance windows of
+// the account matching all of filters. nextToken is the NextToken of the
+// previous page, if any, and maxResults, from 10 to 100, may be 0 for the
+// default.
+//
+// See http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_DescribeMaintenanceWindows.html
+func (s *SSM) DescribeMaintenanceWindows(filters []MaintenanceWindowFilter, nextToken string, maxResults int) (*DescribeMaintenanceWindowsResponse, error) {
+	req := &describeMaintenanceWindowsRequest{filters, nextToken, maxResults}
+	resp := new(DescribeMaintenanceWindowsResponse)
+	if err := s.query("DescribeMaintenanceWindows", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
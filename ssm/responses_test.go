@@ -0,0 +1,71 @@
+package ssm_test
+
+// http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetAutomationExecution.html
+var GetAutomationExecutionResponse = `
+{
+  "AutomationExecution": {
+    "AutomationExecutionId": "4105a4fc-f944-11e6-9d32-0123456789ab",
+    "AutomationExecutionStatus": "Failed",
+    "DocumentName": "AWS-RestartEC2Instance",
+    "DocumentVersion": "1",
+    "Mode": "Auto",
+    "ExecutionStartTime": 1696118400.5,
+    "ExecutionEndTime": 1696118460.5,
+    "Parameters": {"InstanceId": ["i-1234567890abcdef0"]},
+    "FailureMessage": "Step fails when it is verifying the command has completed.",
+    "StepExecutions": [
+      {
+        "StepName": "stopInstances",
+        "Action": "aws:changeInstanceState",
+        "StepStatus": "Success",
+        "ExecutionStartTime": 1696118400.5,
+        "ExecutionEndTime": 1696118430.5,
+        "Outputs": {"CurrentState": ["stopped"]}
+      },
+      {
+        "StepName": "startInstances",
+        "Action": "aws:changeInstanceState",
+        "StepStatus": "Failed",
+        "ExecutionStartTime": 1696118430.5,
+        "ExecutionEndTime": 1696118460.5,
+        "FailureMessage": "Step fails when it is verifying the command has completed."
+      }
+    ]
+  }
+}
+`
+
+// http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetMaintenanceWindow.html
+var GetMaintenanceWindowResponse = `
+{
+  "WindowId": "mw-0c50858d01EXAMPLE",
+  "Name": "patching",
+  "Enabled": true,
+  "Schedule": "cron(0 2 ? * SUN *)",
+  "ScheduleTimezone": "Europe/Paris",
+  "Duration": 3,
+  "Cutoff": 0,
+  "AllowUnassociatedTargets": false,
+  "NextExecutionTime": "2023-10-08T02:00+02:00",
+  "CreatedDate": 1696118400.5,
+  "ModifiedDate": 1696118400.5
+}
+`
+
+// http://docs.aws.amazon.com/systems-manager/latest/APIReference/API_DescribeMaintenanceWindows.html
+var DescribeMaintenanceWindowsResponse = `
+{
+  "WindowIdentities": [
+    {
+      "WindowId": "mw-0c50858d01EXAMPLE",
+      "Name": "patching",
+      "Enabled": true,
+      "Duration": 3,
+      "Cutoff": 0,
+      "Schedule": "cron(0 2 ? * SUN *)",
+      "NextExecutionTime": "2023-10-08T02:00+02:00"
+    }
+  ],
+  "NextToken": "AAEAAX"
+}
+`
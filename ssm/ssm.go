@@ -0,0 +1,53 @@
+// Package ssm provides types and functions to interact with AWS Systems
+// Manager.
+//
+// See http://docs.aws.amazon.com/ssm/latest/apireference/Welcome.html
+package ssm
+
+import (
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+type SSM struct {
+	aws.Auth
+	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+func New(auth aws.Auth, region aws.Region) *SSM {
+	return &SSM{Auth: auth, Region: region}
+}
+
+// Error represents an error in an operation with Systems Manager.
+type Error = aws.JSONError
+
+// query calls the Systems Manager action with req encoded as JSON and
+// decodes the response into resp.
+func (s *SSM) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         s.Auth,
+		Region:       s.Region,
+		HTTPClient:   s.HTTPClient,
+		Endpoint:     s.Region.SSMEndpoint,
+		TargetPrefix: "AmazonSSM",
+		SigningName:  "ssm",
+	}
+	return client.Call(action, req, resp)
+}
+
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Target selects resources by Key, such as "InstanceIds" or
+// "tag:Environment", and Values.
+type Target struct {
+	Key    string
+	Values []string
+}
@@ -0,0 +1,186 @@
+package ssm_test
+
+import (
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/ssm"
+	"github.com/zackbloom/goamz/testutil"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	ssm *ssm.SSM
+}
+
+var testServer = testutil.NewHTTPServer()
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.ssm = ssm.New(auth, aws.Region{Name: "us-east-1", SSMEndpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+func (s *S) TestStartAutomationExecution(c *check.C) {
+	testServer.Response(200, nil, `{"AutomationExecutionId": "4105a4fc-f944-11e6-9d32-0123456789ab"}`)
+
+	id, err := s.ssm.StartAutomationExecution(&ssm.StartAutomationExecutionRequest{
+		DocumentName:        "AWS-RestartEC2Instance",
+		TargetParameterName: "InstanceId",
+		Targets:             []ssm.Target{{Key: "tag:Patch Group", Values: []string{"web"}}},
+		MaxConcurrency:      "25%",
+		MaxErrors:           "1",
+	})
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.StartAutomationExecution")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"DocumentName":        "AWS-RestartEC2Instance",
+		"TargetParameterName": "InstanceId",
+		"Targets":             []interface{}{map[string]interface{}{"Key": "tag:Patch Group", "Values": []interface{}{"web"}}},
+		"MaxConcurrency":      "25%",
+		"MaxErrors":           "1",
+	})
+	c.Assert(id, check.Equals, "4105a4fc-f944-11e6-9d32-0123456789ab")
+}
+
+func (s *S) TestGetAutomationExecution(c *check.C) {
+	testServer.Response(200, nil, GetAutomationExecutionResponse)
+
+	exec, err := s.ssm.GetAutomationExecution("4105a4fc-f944-11e6-9d32-0123456789ab")
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.GetAutomationExecution")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"AutomationExecutionId": "4105a4fc-f944-11e6-9d32-0123456789ab"})
+	c.Assert(exec.AutomationExecutionStatus, check.Equals, ssm.AutomationStatusFailed)
+	c.Assert(exec.Done(), check.Equals, true)
+	c.Assert(exec.Parameters, check.DeepEquals, map[string][]string{"InstanceId": {"i-1234567890abcdef0"}})
+	c.Assert(exec.StepExecutions, check.HasLen, 2)
+	c.Assert(exec.StepExecutions[0].StepStatus, check.Equals, ssm.AutomationStatusSuccess)
+	c.Assert(exec.StepExecutions[1].StepName, check.Equals, "startInstances")
+	c.Assert(exec.StepExecutions[1].FailureMessage, check.Equals, "Step fails when it is verifying the command has completed.")
+	c.Assert(exec.ExecutionEndTime, check.Equals, 1696118460.5)
+}
+
+func (s *S) TestStopAutomationExecution(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.ssm.StopAutomationExecution("4105a4fc-f944-11e6-9d32-0123456789ab")
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.StopAutomationExecution")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"AutomationExecutionId": "4105a4fc-f944-11e6-9d32-0123456789ab", "Type": "Cancel"})
+}
+
+func (s *S) TestCreateMaintenanceWindow(c *check.C) {
+	testServer.Response(200, nil, `{"WindowId": "mw-0c50858d01EXAMPLE"}`)
+
+	id, err := s.ssm.CreateMaintenanceWindow(&ssm.CreateMaintenanceWindowRequest{
+		Name:             "patching",
+		Schedule:         "cron(0 2 ? * SUN *)",
+		ScheduleTimezone: "Europe/Paris",
+		Duration:         3,
+		Cutoff:           0,
+	})
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.CreateMaintenanceWindow")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Name":                     "patching",
+		"Schedule":                 "cron(0 2 ? * SUN *)",
+		"ScheduleTimezone":         "Europe/Paris",
+		"Duration":                 float64(3),
+		"Cutoff":                   float64(0),
+		"AllowUnassociatedTargets": false,
+	})
+	c.Assert(id, check.Equals, "mw-0c50858d01EXAMPLE")
+}
+
+func (s *S) TestGetMaintenanceWindow(c *check.C) {
+	testServer.Response(200, nil, GetMaintenanceWindowResponse)
+
+	window, err := s.ssm.GetMaintenanceWindow("mw-0c50858d01EXAMPLE")
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.GetMaintenanceWindow")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"WindowId": "mw-0c50858d01EXAMPLE"})
+	c.Assert(window.Name, check.Equals, "patching")
+	c.Assert(window.Enabled, check.Equals, true)
+	c.Assert(window.Duration, check.Equals, 3)
+	c.Assert(window.NextExecutionTime, check.Equals, "2023-10-08T02:00+02:00")
+}
+
+func (s *S) TestUpdateMaintenanceWindow(c *check.C) {
+	testServer.Response(200, nil, GetMaintenanceWindowResponse)
+
+	enabled, cutoff := false, 1
+	_, err := s.ssm.UpdateMaintenanceWindow(&ssm.UpdateMaintenanceWindowRequest{
+		WindowId: "mw-0c50858d01EXAMPLE",
+		Enabled:  &enabled,
+		Cutoff:   &cutoff,
+	})
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.UpdateMaintenanceWindow")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"WindowId": "mw-0c50858d01EXAMPLE",
+		"Enabled":  false,
+		"Cutoff":   float64(1),
+	})
+}
+
+func (s *S) TestDeleteMaintenanceWindow(c *check.C) {
+	testServer.Response(200, nil, `{"WindowId": "mw-0c50858d01EXAMPLE"}`)
+
+	err := s.ssm.DeleteMaintenanceWindow("mw-0c50858d01EXAMPLE")
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.DeleteMaintenanceWindow")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"WindowId": "mw-0c50858d01EXAMPLE"})
+}
+
+func (s *S) TestDescribeMaintenanceWindows(c *check.C) {
+	testServer.Response(200, nil, DescribeMaintenanceWindowsResponse)
+
+	resp, err := s.ssm.DescribeMaintenanceWindows([]ssm.MaintenanceWindowFilter{{Key: "Enabled", Values: []string{"true"}}}, "", 10)
+	target, body := testServer.WaitJSONRequest(c, "ssm")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AmazonSSM.DescribeMaintenanceWindows")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Filters":    []interface{}{map[string]interface{}{"Key": "Enabled", "Values": []interface{}{"true"}}},
+		"MaxResults": float64(10),
+	})
+	c.Assert(resp.WindowIdentities, check.HasLen, 1)
+	c.Assert(resp.WindowIdentities[0].WindowId, check.Equals, "mw-0c50858d01EXAMPLE")
+	c.Assert(resp.NextToken, check.Equals, "AAEAAX")
+}
+
+func (s *S) TestError(c *check.C) {
+	testServer.Response(400, nil, `{"__type": "DoesNotExistException", "message": "Maintenance window mw-0c50858d01EXAMPLE does not exist"}`)
+
+	_, err := s.ssm.GetMaintenanceWindow("mw-0c50858d01EXAMPLE")
+	testServer.WaitJSONRequest(c, "ssm")
+
+	ssmErr, ok := err.(*ssm.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(ssmErr.StatusCode, check.Equals, 400)
+	c.Assert(err, check.ErrorMatches, "ssm: DoesNotExistException: Maintenance window .* does not exist")
+}
@@ -1,5 +1,7 @@
 package sts_test
 
+import "time"
+
 var AssumeRoleResponse = `
 <AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/
 2011-06-15/">
@@ -82,3 +84,50 @@ var GetSessionTokenResponse = `
   </ResponseMetadata>
 </GetSessionTokenResponse>
 `
+
+var DecodeAuthorizationMessageResponse = `
+<DecodeAuthorizationMessageResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <DecodeAuthorizationMessageResult>
+    <DecodedMessage>{"allowed":false,"explicitDeny":false,"context":{"action":"ec2:RunInstances"}}</DecodedMessage>
+  </DecodeAuthorizationMessageResult>
+  <ResponseMetadata>
+    <RequestId>6624a9ca-cd25-4f50-b2a5-7ba65bf07453</RequestId>
+  </ResponseMetadata>
+</DecodeAuthorizationMessageResponse>
+`
+
+var GetSigninTokenResponse = `{"SigninToken":"VCQgdGhpcyBpcyBhIHNpZ24taW4gdG9rZW4"}`
+
+// http://docs.aws.amazon.com/STS/latest/APIReference/API_GetCallerIdentity.html
+var GetCallerIdentityResponse = `
+<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:sts::123456789012:assumed-role/my-role-name/my-role-session-name</Arn>
+    <UserId>ARO123EXAMPLE123:my-role-session-name</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>
+`
+
+// SessionTokenResponse is a GetSessionTokenResponse for the given access
+// key ID and expiration.
+func SessionTokenResponse(accessKeyId string, expiration time.Time) string {
+	return `
+<GetSessionTokenResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetSessionTokenResult>
+    <Credentials>
+      <SessionToken>token-` + accessKeyId + `</SessionToken>
+      <SecretAccessKey>secret-` + accessKeyId + `</SecretAccessKey>
+      <Expiration>` + expiration.UTC().Format(time.RFC3339) + `</Expiration>
+      <AccessKeyId>` + accessKeyId + `</AccessKeyId>
+    </Credentials>
+  </GetSessionTokenResult>
+  <ResponseMetadata>
+    <RequestId>58c5dbae-abef-11e0-8cfe-09039844ac7d</RequestId>
+  </ResponseMetadata>
+</GetSessionTokenResponse>
+`
+}
@@ -0,0 +1,63 @@
+package sts
+
+import (
+	"sync"
+	"time"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// The default RefreshWindow of a SessionTokenCache.
+const DefaultRefreshWindow = 5 * time.Minute
+
+// SessionTokenCache hands out the temporary credentials of a session token
+// obtained with GetSessionToken, getting a new session token when the
+// current one expires within RefreshWindow. It is safe for concurrent use.
+type SessionTokenCache struct {
+	// RefreshWindow is how long before expiry the session token is
+	// replaced, DefaultRefreshWindow if zero. It must be longer than the
+	// requests made with the credentials take.
+	RefreshWindow time.Duration
+
+	sts             *STS
+	durationSeconds int
+
+	mu          sync.Mutex
+	credentials *Credentials
+}
+
+// NewSessionTokenCache returns a cache of session tokens of the user of
+// sts, lasting durationSeconds each, or the service default if 0. MFA
+// protected session tokens can't be refreshed and are not supported.
+func NewSessionTokenCache(sts *STS, durationSeconds int) *SessionTokenCache {
+	return &SessionTokenCache{sts: sts, durationSeconds: durationSeconds}
+}
+
+// Auth returns the credentials of the cached session token, getting a new
+// session token first if there is none or it is about to expire.
+func (c *SessionTokenCache) Auth() (*aws.Auth, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	window := c.RefreshWindow
+	if window == 0 {
+		window = DefaultRefreshWindow
+	}
+	if c.credentials == nil || time.Now().Add(window).After(c.credentials.Expiration) {
+		resp, err := c.sts.GetSessionToken(c.durationSeconds, "", "")
+		if err != nil {
+			return nil, err
+		}
+		c.credentials = &resp.Credentials
+	}
+	return aws.NewAuth(c.credentials.AccessKeyId, c.credentials.SecretAccessKey,
+		c.credentials.SessionToken, c.credentials.Expiration), nil
+}
+
+// Invalidate drops the cached session token, so that the next call to Auth
+// gets a new one, for example after a request failed with ExpiredToken.
+func (c *SessionTokenCache) Invalidate() {
+	c.mu.Lock()
+	c.credentials = nil
+	c.mu.Unlock()
+}
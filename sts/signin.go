@@ -0,0 +1,138 @@
+package sts
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// DefaultFederationEndpoint is the AWS federation endpoint of the
+	// commercial partition.
+	DefaultFederationEndpoint = "https://signin.aws.amazon.com/federation"
+
+	// DefaultConsoleDestination is the page of the console federated users
+	// land on.
+	DefaultConsoleDestination = "https://console.aws.amazon.com/"
+)
+
+// ConsoleLoginParams configures ConsoleLoginURL. All fields are optional.
+//
+// Issuer is the URL users are sent back to when their console session
+// expires, usually the page that generated the login URL. Destination is
+// the console page to open, DefaultConsoleDestination if empty.
+//
+// SessionDuration is the length in seconds of the console session, from
+// 900 to 43200. It may only be set for credentials from AssumeRole; the
+// session of GetFederationToken credentials lasts as long as they do.
+//
+// FederationEndpoint is DefaultFederationEndpoint if empty; other
+// partitions have their own, e.g.
+// https://signin.amazonaws-us-gov.com/federation for GovCloud.
+//
+// See http://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_providers_enable-console-custom-url.html
+type ConsoleLoginParams struct {
+	Issuer             string
+	Destination        string
+	SessionDuration    int
+	FederationEndpoint string
+}
+
+var errNoSigninToken = errors.New("sts: no sign-in token in federation response")
+
+// GetSigninToken exchanges temporary credentials for a sign-in token of
+// the federation endpoint. The token is valid for 15 minutes.
+func GetSigninToken(creds *Credentials, params *ConsoleLoginParams) (string, error) {
+	if params == nil {
+		params = &ConsoleLoginParams{}
+	}
+	session, err := json.Marshal(map[string]string{
+		"sessionId":    creds.AccessKeyId,
+		"sessionKey":   creds.SecretAccessKey,
+		"sessionToken": creds.SessionToken,
+	})
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{
+		"Action":  {"getSigninToken"},
+		"Session": {string(session)},
+	}
+	if params.SessionDuration != 0 {
+		query.Set("SessionDuration", strconv.Itoa(params.SessionDuration))
+	}
+
+	r, err := http.Get(federationEndpoint(params) + "?" + query.Encode())
+	if err != nil {
+		return "", err
+	}
+	defer r.Body.Close()
+
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return "", err
+	}
+	if r.StatusCode != 200 {
+		// The federation endpoint answers errors with an HTML page.
+		return "", &Error{StatusCode: r.StatusCode, Message: r.Status}
+	}
+	var resp struct {
+		SigninToken string
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return "", err
+	}
+	if resp.SigninToken == "" {
+		return "", errNoSigninToken
+	}
+	return resp.SigninToken, nil
+}
+
+// ConsoleLoginURL returns a URL that signs in to the AWS Management Console
+// with temporary credentials. Anyone with the URL can use it, until it
+// expires 15 minutes later.
+func ConsoleLoginURL(creds *Credentials, params *ConsoleLoginParams) (string, error) {
+	if params == nil {
+		params = &ConsoleLoginParams{}
+	}
+	token, err := GetSigninToken(creds, params)
+	if err != nil {
+		return "", err
+	}
+	destination := params.Destination
+	if destination == "" {
+		destination = DefaultConsoleDestination
+	}
+	query := url.Values{
+		"Action":      {"login"},
+		"Destination": {destination},
+		"SigninToken": {token},
+	}
+	if params.Issuer != "" {
+		query.Set("Issuer", params.Issuer)
+	}
+	return federationEndpoint(params) + "?" + query.Encode(), nil
+}
+
+// FederatedConsoleLoginURL calls GetFederationToken and returns a console
+// login URL for the federated user it creates. The permissions of the user
+// are those of the caller restricted by policy; GetFederationToken must be
+// called with the long-term credentials of an IAM user. The console
+// session lasts durationSeconds, from 900 to 129600 (36 hours).
+func (sts *STS) FederatedConsoleLoginURL(name, policy string, durationSeconds int, params *ConsoleLoginParams) (string, error) {
+	resp, err := sts.GetFederationToken(name, policy, durationSeconds)
+	if err != nil {
+		return "", err
+	}
+	return ConsoleLoginURL(&resp.Credentials, params)
+}
+
+func federationEndpoint(params *ConsoleLoginParams) string {
+	if params.FederationEndpoint != "" {
+		return params.FederationEndpoint
+	}
+	return DefaultFederationEndpoint
+}
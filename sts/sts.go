@@ -24,6 +24,11 @@ import (
 type STS struct {
 	aws.Auth
 	aws.Region
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+
 	private byte // Reserve the right of using private data.
 }
 
@@ -32,9 +37,9 @@ type STS struct {
 func New(auth aws.Auth, region aws.Region) *STS {
 	// Make sure we can run the package tests
 	if region.Name == "" {
-		return &STS{auth, region, 0}
+		return &STS{Auth: auth, Region: region}
 	}
-	return &STS{auth, aws.Regions["us-east-1"], 0}
+	return &STS{Auth: auth, Region: aws.Regions["us-east-1"]}
 }
 
 const debug = false
@@ -63,6 +68,10 @@ func (err *Error) Error() string {
 	return fmt.Sprintf("%s (%s)", err.Message, err.Code)
 }
 
+func (err *Error) As(target interface{}) bool {
+	return aws.AsAPIError(target, err, err.StatusCode, err.Code, err.Message, err.RequestId)
+}
+
 type xmlErrors struct {
 	RequestId string  `xml:"RequestId"`
 	Errors    []Error `xml:"Error"`
@@ -91,7 +100,11 @@ func (sts *STS) query(params map[string]string, resp interface{}) error {
 	if debug {
 		log.Printf("%v -> {\n", hreq)
 	}
-	r, err := http.DefaultClient.Do(hreq)
+	client := sts.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	r, err := client.Do(hreq)
 
 	if err != nil {
 		log.Printf("Error calling Amazon")
@@ -271,3 +284,54 @@ func (sts *STS) GetSessionToken(durationSeconds int, serialnNumber, tokenCode st
 	}
 	return resp, nil
 }
+
+// DecodeAuthorizationMessageResult wraps DecodeAuthorizationMessage response
+//
+// See http://docs.aws.amazon.com/STS/latest/APIReference/API_DecodeAuthorizationMessage.html for more details
+type DecodeAuthorizationMessageResult struct {
+	DecodedMessage string `xml:"DecodeAuthorizationMessageResult>DecodedMessage"`
+	RequestId      string `xml:"ResponseMetadata>RequestId"`
+}
+
+// DecodeAuthorizationMessage decodes the encoded message returned with an
+// authorization failure, such as an EC2 UnauthorizedOperation error. The
+// decoded message is a JSON document describing why the request was denied.
+// The caller needs the sts:DecodeAuthorizationMessage permission.
+//
+// See http://docs.aws.amazon.com/STS/latest/APIReference/API_DecodeAuthorizationMessage.html for more details
+func (sts *STS) DecodeAuthorizationMessage(encodedMessage string) (
+	resp *DecodeAuthorizationMessageResult, err error) {
+	params := makeParams("DecodeAuthorizationMessage")
+	params["EncodedMessage"] = encodedMessage
+
+	resp = new(DecodeAuthorizationMessageResult)
+	if err := sts.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetCallerIdentityResult wraps GetCallerIdentity response
+//
+// See http://docs.aws.amazon.com/STS/latest/APIReference/API_GetCallerIdentity.html for more details
+type GetCallerIdentityResult struct {
+	Account   string `xml:"GetCallerIdentityResult>Account"`
+	Arn       string `xml:"GetCallerIdentityResult>Arn"`
+	UserId    string `xml:"GetCallerIdentityResult>UserId"`
+	RequestId string `xml:"ResponseMetadata>RequestId"`
+}
+
+// GetCallerIdentity returns the account, ARN and user ID of the IAM user or
+// role whose credentials are used to call it, so that a tool can check
+// where it is about to operate. No permission is needed to call it.
+//
+// See http://docs.aws.amazon.com/STS/latest/APIReference/API_GetCallerIdentity.html for more details
+func (sts *STS) GetCallerIdentity() (resp *GetCallerIdentityResult, err error) {
+	params := makeParams("GetCallerIdentity")
+
+	resp = new(GetCallerIdentityResult)
+	if err := sts.query(params, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
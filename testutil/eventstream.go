@@ -0,0 +1,28 @@
+package testutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+)
+
+// EventStreamMessage encodes a message with string headers in the AWS
+// event stream encoding, as read by aws.ReadEventStreamMessage.
+func EventStreamMessage(headers [][2]string, payload string) []byte {
+	var h bytes.Buffer
+	for _, kv := range headers {
+		h.WriteByte(byte(len(kv[0])))
+		h.WriteString(kv[0])
+		h.WriteByte(7)
+		binary.Write(&h, binary.BigEndian, uint16(len(kv[1])))
+		h.WriteString(kv[1])
+	}
+	var m bytes.Buffer
+	binary.Write(&m, binary.BigEndian, uint32(12+h.Len()+len(payload)+4))
+	binary.Write(&m, binary.BigEndian, uint32(h.Len()))
+	binary.Write(&m, binary.BigEndian, crc32.ChecksumIEEE(m.Bytes()))
+	m.Write(h.Bytes())
+	m.WriteString(payload)
+	binary.Write(&m, binary.BigEndian, crc32.ChecksumIEEE(m.Bytes()))
+	return m.Bytes()
+}
@@ -2,6 +2,7 @@ package testutil
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
@@ -72,6 +73,34 @@ func (s *HTTPServer) Flush() {
 	}
 }
 
+// DialAll makes http.DefaultTransport connect to the server whatever the
+// host of a request, so that the requests sent to a subdomain of URL, such
+// as those of operations with a host prefix, reach it. The returned
+// function restores the transport.
+func (s *HTTPServer) DialAll() (restore func()) {
+	u, err := url.Parse(s.URL)
+	if err != nil {
+		panic(err)
+	}
+	return DialAll(u.Host)
+}
+
+// DialAll makes http.DefaultTransport connect to addr whatever the host of
+// a request. The returned function restores the transport.
+func DialAll(addr string) (restore func()) {
+	defaultTransport := http.DefaultTransport
+	transport := defaultTransport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, network, addr)
+	}
+	http.DefaultTransport = transport
+	return func() {
+		transport.CloseIdleConnections()
+		http.DefaultTransport = defaultTransport
+	}
+}
+
 func body(req *http.Request) string {
 	data, err := ioutil.ReadAll(req.Body)
 	if err != nil {
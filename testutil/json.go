@@ -0,0 +1,24 @@
+package testutil
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"gopkg.in/check.v1"
+)
+
+// WaitJSONRequest waits for the next request, checks that it calls an
+// action of an AWS JSON 1.1 service signed for signingName, and returns
+// its X-Amz-Target header and decoded body.
+func (s *HTTPServer) WaitJSONRequest(c *check.C, signingName string) (string, map[string]interface{}) {
+	req := s.WaitRequest()
+	c.Assert(req.Method, check.Equals, "POST")
+	c.Assert(req.URL.Path, check.Equals, "/")
+	c.Assert(req.Header.Get("Content-Type"), check.Equals, "application/x-amz-json-1.1")
+	c.Assert(req.Header.Get("Authorization"), check.Matches, "AWS4-HMAC-SHA256 Credential=[^/]+/[0-9]{8}/[^/]+/"+signingName+"/aws4_request, .*")
+	data, err := ioutil.ReadAll(req.Body)
+	c.Assert(err, check.IsNil)
+	var body map[string]interface{}
+	c.Assert(json.Unmarshal(data, &body), check.IsNil)
+	return req.Header.Get("X-Amz-Target"), body
+}
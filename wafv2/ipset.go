@@ -0,0 +1,109 @@
+package wafv2
+
+const (
+	IPAddressVersionIPv4 = "IPV4"
+	IPAddressVersionIPv6 = "IPV6"
+)
+
+// IPSet is a list of addresses in CIDR notation, such as "192.0.2.0/24",
+// that rules match with an IPSetReferenceStatement.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_IPSet.html
+type IPSet struct {
+	ARN              string
+	Id               string
+	Name             string
+	Description      string
+	IPAddressVersion string
+	Addresses        []string
+}
+
+// IPSetRequest holds the parameters of CreateIPSet. Addresses may be empty
+// but not nil, and must all be of IPAddressVersion.
+type IPSetRequest struct {
+	Name             string
+	Description      string `json:",omitempty"`
+	IPAddressVersion string
+	Addresses        []string
+	Tags             []Tag `json:",omitempty"`
+}
+
+// CreateIPSet creates an IP set.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_CreateIPSet.html
+func (w *WAFV2) CreateIPSet(req *IPSetRequest) (*Summary, error) {
+	params := struct {
+		*IPSetRequest
+		Scope string
+	}{req, w.Scope}
+	if params.Addresses == nil {
+		params.Addresses = []string{}
+	}
+	var resp struct {
+		Summary *Summary
+	}
+	if err := w.query("CreateIPSet", params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Summary, nil
+}
+
+// GetIPSet returns an IP set and the lock token to update or delete it
+// with.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_GetIPSet.html
+func (w *WAFV2) GetIPSet(name, id string) (ipSet *IPSet, lockToken string, err error) {
+	var resp struct {
+		IPSet     *IPSet
+		LockToken string
+	}
+	if err := w.query("GetIPSet", ref{Name: name, Id: id, Scope: w.Scope}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.IPSet, resp.LockToken, nil
+}
+
+// UpdateIPSet replaces the addresses and the description of an IP set and
+// returns the lock token of the next change. To add or remove addresses,
+// get the IP set and update it with its modified list.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_UpdateIPSet.html
+func (w *WAFV2) UpdateIPSet(name, id, lockToken string, addresses []string, description string) (nextLockToken string, err error) {
+	if addresses == nil {
+		addresses = []string{}
+	}
+	params := struct {
+		ref
+		Addresses   []string
+		Description string `json:",omitempty"`
+	}{ref{Name: name, Id: id, Scope: w.Scope, LockToken: lockToken}, addresses, description}
+	var resp updateResponse
+	if err := w.query("UpdateIPSet", params, &resp); err != nil {
+		return "", err
+	}
+	return resp.NextLockToken, nil
+}
+
+// DeleteIPSet deletes an IP set, which must not be referenced by any rule.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_DeleteIPSet.html
+func (w *WAFV2) DeleteIPSet(name, id, lockToken string) error {
+	return w.query("DeleteIPSet", ref{Name: name, Id: id, Scope: w.Scope, LockToken: lockToken}, nil)
+}
+
+type ListIPSetsResponse struct {
+	IPSets     []Summary
+	NextMarker string
+}
+
+// ListIPSets fetches a page of the IP sets of the scope. If the response
+// has a NextMarker, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_ListIPSets.html
+func (w *WAFV2) ListIPSets(req *ListRequest) (resp *ListIPSetsResponse, err error) {
+	resp = new(ListIPSetsResponse)
+	if err := w.query("ListIPSets", listParams(req, w.Scope), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package wafv2_test
+
+// http://docs.aws.amazon.com/waf/latest/APIReference/API_CreateWebACL.html
+var CreateWebACLResponse = `
+{
+  "Summary": {
+    "ARN": "arn:aws:wafv2:us-east-1:111122223333:global/webacl/edge/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+    "Description": "Edge protection",
+    "Id": "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+    "LockToken": "6b9e4b6e-4a2d-4c1e-9d0a-1f2e3d4c5b6a",
+    "Name": "edge"
+  }
+}
+`
+
+// http://docs.aws.amazon.com/waf/latest/APIReference/API_GetWebACL.html
+var GetWebACLResponse = `
+{
+  "WebACL": {
+    "ARN": "arn:aws:wafv2:us-east-1:111122223333:global/webacl/edge/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+    "Capacity": 702,
+    "DefaultAction": {"Allow": {}},
+    "Id": "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+    "Name": "edge",
+    "Rules": [
+      {
+        "Name": "aws-common",
+        "Priority": 0,
+        "Statement": {
+          "ManagedRuleGroupStatement": {"VendorName": "AWS", "Name": "AWSManagedRulesCommonRuleSet"}
+        },
+        "OverrideAction": {"None": {}},
+        "VisibilityConfig": {"SampledRequestsEnabled": true, "CloudWatchMetricsEnabled": true, "MetricName": "aws-common"}
+      },
+      {
+        "Name": "rate-limit",
+        "Priority": 1,
+        "Statement": {
+          "RateBasedStatement": {"Limit": 2000, "AggregateKeyType": "IP"}
+        },
+        "Action": {"Block": {}},
+        "VisibilityConfig": {"SampledRequestsEnabled": true, "CloudWatchMetricsEnabled": true, "MetricName": "rate-limit"}
+      }
+    ],
+    "VisibilityConfig": {"SampledRequestsEnabled": true, "CloudWatchMetricsEnabled": true, "MetricName": "edge"}
+  },
+  "LockToken": "6b9e4b6e-4a2d-4c1e-9d0a-1f2e3d4c5b6a"
+}
+`
+
+var UpdateResponse = `
+{
+  "NextLockToken": "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"
+}
+`
+
+// http://docs.aws.amazon.com/waf/latest/APIReference/API_GetIPSet.html
+var GetIPSetResponse = `
+{
+  "IPSet": {
+    "ARN": "arn:aws:wafv2:us-east-1:111122223333:global/ipset/blocked/b1c2d3e4-5678-90ab-cdef-EXAMPLE22222",
+    "Addresses": ["192.0.2.0/24", "198.51.100.7/32"],
+    "Id": "b1c2d3e4-5678-90ab-cdef-EXAMPLE22222",
+    "IPAddressVersion": "IPV4",
+    "Name": "blocked"
+  },
+  "LockToken": "7c0f5c7f-5b3e-4d2f-8e1b-2a3f4e5d6c7b"
+}
+`
+
+// http://docs.aws.amazon.com/waf/latest/APIReference/API_ListRuleGroups.html
+var ListRuleGroupsResponse = `
+{
+  "NextMarker": "Z2FtbWE=",
+  "RuleGroups": [
+    {
+      "ARN": "arn:aws:wafv2:us-east-1:111122223333:global/rulegroup/admin/c1d2e3f4-5678-90ab-cdef-EXAMPLE33333",
+      "Id": "c1d2e3f4-5678-90ab-cdef-EXAMPLE33333",
+      "LockToken": "8d1a6d8a-6c4f-4e3a-9f2c-3b4a5f6e7d8c",
+      "Name": "admin"
+    }
+  ]
+}
+`
+
+var OptimisticLockResponse = `
+{
+  "__type": "WAFOptimisticLockException",
+  "Message": "AWS WAF couldn't save your changes because someone changed the resource after you started to edit it."
+}
+`
@@ -0,0 +1,103 @@
+package wafv2
+
+// RuleGroup is a reusable set of rules, referenced by the rules of web
+// ACLs with a RuleGroupReferenceStatement.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_RuleGroup.html
+type RuleGroup struct {
+	ARN              string
+	Id               string
+	Name             string
+	Description      string
+	Capacity         int64
+	Rules            []Rule
+	VisibilityConfig VisibilityConfig
+}
+
+// RuleGroupRequest holds the parameters of CreateRuleGroup and
+// UpdateRuleGroup. Capacity is the web ACL capacity units the group may
+// use, which can't be changed once it is created, so it and Tags are
+// ignored by updates.
+type RuleGroupRequest struct {
+	Name             string
+	Description      string `json:",omitempty"`
+	Capacity         int64
+	Rules            []Rule
+	VisibilityConfig VisibilityConfig
+	Tags             []Tag `json:",omitempty"`
+}
+
+// CreateRuleGroup creates a rule group.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_CreateRuleGroup.html
+func (w *WAFV2) CreateRuleGroup(req *RuleGroupRequest) (*Summary, error) {
+	params := struct {
+		*RuleGroupRequest
+		Scope string
+	}{req, w.Scope}
+	var resp struct {
+		Summary *Summary
+	}
+	if err := w.query("CreateRuleGroup", params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Summary, nil
+}
+
+// GetRuleGroup returns a rule group and the lock token to update or delete
+// it with.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_GetRuleGroup.html
+func (w *WAFV2) GetRuleGroup(name, id string) (group *RuleGroup, lockToken string, err error) {
+	var resp struct {
+		RuleGroup *RuleGroup
+		LockToken string
+	}
+	if err := w.query("GetRuleGroup", ref{Name: name, Id: id, Scope: w.Scope}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.RuleGroup, resp.LockToken, nil
+}
+
+// UpdateRuleGroup replaces the rules, the description and the visibility
+// settings of a rule group and returns the lock token of the next change.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_UpdateRuleGroup.html
+func (w *WAFV2) UpdateRuleGroup(id, lockToken string, req *RuleGroupRequest) (nextLockToken string, err error) {
+	params := struct {
+		*RuleGroupRequest
+		Id, Scope, LockToken string
+		Capacity             int64 `json:",omitempty"` // Hides the capacity of req
+		Tags                 []Tag `json:",omitempty"` // Hides the tags of req
+	}{RuleGroupRequest: req, Id: id, Scope: w.Scope, LockToken: lockToken}
+	var resp updateResponse
+	if err := w.query("UpdateRuleGroup", params, &resp); err != nil {
+		return "", err
+	}
+	return resp.NextLockToken, nil
+}
+
+// DeleteRuleGroup deletes a rule group, which must not be referenced by
+// any web ACL.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_DeleteRuleGroup.html
+func (w *WAFV2) DeleteRuleGroup(name, id, lockToken string) error {
+	return w.query("DeleteRuleGroup", ref{Name: name, Id: id, Scope: w.Scope, LockToken: lockToken}, nil)
+}
+
+type ListRuleGroupsResponse struct {
+	RuleGroups []Summary
+	NextMarker string
+}
+
+// ListRuleGroups fetches a page of the rule groups of the scope. If the
+// response has a NextMarker, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_ListRuleGroups.html
+func (w *WAFV2) ListRuleGroups(req *ListRequest) (resp *ListRuleGroupsResponse, err error) {
+	resp = new(ListRuleGroupsResponse)
+	if err := w.query("ListRuleGroups", listParams(req, w.Scope), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,160 @@
+package wafv2
+
+// Empty is the value of the members of WAF unions that have no settings,
+// such as the Block action or the UriPath field to match.
+type Empty struct{}
+
+// VisibilityConfig sets the CloudWatch metric of a web ACL, rule group or
+// rule, and whether the requests it matches are sampled.
+type VisibilityConfig struct {
+	SampledRequestsEnabled   bool
+	CloudWatchMetricsEnabled bool
+	MetricName               string
+}
+
+// RuleAction is what a rule does with the requests it matches. Exactly one
+// field must be set, e.g. &RuleAction{Block: &Empty{}}.
+type RuleAction struct {
+	Allow     *Empty `json:",omitempty"`
+	Block     *Empty `json:",omitempty"`
+	Count     *Empty `json:",omitempty"`
+	Captcha   *Empty `json:",omitempty"`
+	Challenge *Empty `json:",omitempty"`
+}
+
+// DefaultAction is what a web ACL does with the requests no rule matches.
+// Exactly one field must be set.
+type DefaultAction struct {
+	Allow *Empty `json:",omitempty"`
+	Block *Empty `json:",omitempty"`
+}
+
+// OverrideAction replaces the actions of the rules of a rule group
+// referenced by a rule: Count makes them only count, None keeps them.
+type OverrideAction struct {
+	Count *Empty `json:",omitempty"`
+	None  *Empty `json:",omitempty"`
+}
+
+// Rule is a statement and what to do with the requests it matches. Rules
+// are evaluated in order of Priority. A rule referencing a rule group
+// sets OverrideAction; any other rule sets Action.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_Rule.html
+type Rule struct {
+	Name             string
+	Priority         int
+	Statement        Statement
+	Action           *RuleAction     `json:",omitempty"`
+	OverrideAction   *OverrideAction `json:",omitempty"`
+	VisibilityConfig VisibilityConfig
+}
+
+// Statement is the condition of a rule. Exactly one field must be set;
+// AndStatement, OrStatement and NotStatement combine other statements.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_Statement.html
+type Statement struct {
+	ByteMatchStatement          *ByteMatchStatement          `json:",omitempty"`
+	GeoMatchStatement           *GeoMatchStatement           `json:",omitempty"`
+	IPSetReferenceStatement     *IPSetReferenceStatement     `json:",omitempty"`
+	RuleGroupReferenceStatement *RuleGroupReferenceStatement `json:",omitempty"`
+	ManagedRuleGroupStatement   *ManagedRuleGroupStatement   `json:",omitempty"`
+	RateBasedStatement          *RateBasedStatement          `json:",omitempty"`
+	AndStatement                *StatementList               `json:",omitempty"`
+	OrStatement                 *StatementList               `json:",omitempty"`
+	NotStatement                *NotStatement                `json:",omitempty"`
+}
+
+type StatementList struct {
+	Statements []Statement
+}
+
+type NotStatement struct {
+	Statement Statement
+}
+
+// Positional constraints of a ByteMatchStatement.
+const (
+	PositionalConstraintExactly      = "EXACTLY"
+	PositionalConstraintStartsWith   = "STARTS_WITH"
+	PositionalConstraintEndsWith     = "ENDS_WITH"
+	PositionalConstraintContains     = "CONTAINS"
+	PositionalConstraintContainsWord = "CONTAINS_WORD"
+)
+
+// ByteMatchStatement matches requests whose FieldToMatch, once transformed
+// by TextTransformations, holds SearchString.
+type ByteMatchStatement struct {
+	SearchString         []byte
+	FieldToMatch         FieldToMatch
+	TextTransformations  []TextTransformation
+	PositionalConstraint string
+}
+
+// FieldToMatch is the part of the request a statement inspects. Exactly
+// one field must be set.
+type FieldToMatch struct {
+	UriPath           *Empty       `json:",omitempty"`
+	QueryString       *Empty       `json:",omitempty"`
+	Method            *Empty       `json:",omitempty"`
+	AllQueryArguments *Empty       `json:",omitempty"`
+	SingleHeader      *SingleField `json:",omitempty"`
+}
+
+// SingleField names a header to match, in lowercase.
+type SingleField struct {
+	Name string
+}
+
+// TextTransformation is applied to a field before it is matched. Type is
+// e.g. NONE, LOWERCASE or URL_DECODE; transformations are applied in
+// order of Priority.
+type TextTransformation struct {
+	Priority int
+	Type     string
+}
+
+// GeoMatchStatement matches requests from the given ISO 3166 country
+// codes.
+type GeoMatchStatement struct {
+	CountryCodes []string
+}
+
+// IPSetReferenceStatement matches requests from the addresses of an IP
+// set.
+type IPSetReferenceStatement struct {
+	ARN string
+}
+
+// ExcludedRule names a rule of a rule group whose action is set to count.
+type ExcludedRule struct {
+	Name string
+}
+
+// RuleGroupReferenceStatement evaluates the rules of one of the account's
+// rule groups.
+type RuleGroupReferenceStatement struct {
+	ARN           string
+	ExcludedRules []ExcludedRule `json:",omitempty"`
+}
+
+// ManagedRuleGroupStatement evaluates the rules of a rule group managed by
+// a vendor, such as VendorName "AWS" and Name "AWSManagedRulesCommonRuleSet".
+type ManagedRuleGroupStatement struct {
+	VendorName    string
+	Name          string
+	Version       string         `json:",omitempty"`
+	ExcludedRules []ExcludedRule `json:",omitempty"`
+}
+
+// RateBasedStatement matches the requests of the clients exceeding Limit
+// requests in EvaluationWindowSec (300 by default). AggregateKeyType is IP
+// or FORWARDED_IP. Only the requests matching ScopeDownStatement, if set,
+// are counted.
+type RateBasedStatement struct {
+	Limit               int64
+	AggregateKeyType    string
+	EvaluationWindowSec int        `json:",omitempty"`
+	ScopeDownStatement  *Statement `json:",omitempty"`
+}
@@ -0,0 +1,95 @@
+// Package wafv2 provides types and functions to interact with AWS WAF.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/Welcome.html
+package wafv2
+
+import (
+	"net/http"
+
+	"github.com/zackbloom/goamz/aws"
+)
+
+// Scopes of WAF resources. Resources protecting CloudFront distributions
+// are global and managed through us-east-1; those protecting regional
+// resources, such as load balancers, are managed in their region.
+const (
+	ScopeCloudFront = "CLOUDFRONT"
+	ScopeRegional   = "REGIONAL"
+)
+
+// WAFV2 manages the WAF resources of a scope, ScopeCloudFront unless
+// changed after New.
+type WAFV2 struct {
+	aws.Auth
+	aws.Region
+	Scope string
+
+	// HTTPClient sends the requests, or http.DefaultClient if nil. A client
+	// made by aws.NewClient retries them and can report metrics.
+	HTTPClient *http.Client
+}
+
+// New returns a client of resources protecting CloudFront distributions,
+// for which region must be us-east-1.
+func New(auth aws.Auth, region aws.Region) *WAFV2 {
+	return &WAFV2{Auth: auth, Region: region, Scope: ScopeCloudFront}
+}
+
+// Error represents an error in an operation with AWS WAF.
+// WAFOptimisticLockException is returned when the lock token of an update
+// or a deletion is out of date; the resource must be fetched again.
+type Error = aws.JSONError
+
+// query calls the WAF action with req encoded as JSON and decodes the
+// response into resp.
+func (w *WAFV2) query(action string, req, resp interface{}) error {
+	client := aws.JSONClient{
+		Auth:         w.Auth,
+		Region:       w.Region,
+		HTTPClient:   w.HTTPClient,
+		Endpoint:     w.Region.WAFV2Endpoint,
+		TargetPrefix: "AWSWAF_20190729",
+		SigningName:  "wafv2",
+	}
+	return client.Call(action, req, resp)
+}
+
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Summary identifies a WAF resource. LockToken is required to update or
+// delete it.
+type Summary struct {
+	ARN         string
+	Id          string
+	Name        string
+	Description string
+	LockToken   string
+}
+
+// ref holds the parameters identifying a resource in its scope.
+type ref struct {
+	Name      string
+	Id        string
+	Scope     string
+	LockToken string `json:",omitempty"`
+}
+
+// ListRequest holds the paging parameters of the List methods. Both fields
+// are optional; Limit is from 1 to 100.
+type ListRequest struct {
+	NextMarker string `json:",omitempty"`
+	Limit      int    `json:",omitempty"`
+}
+
+type listRequest struct {
+	ListRequest
+	Scope string
+}
+
+// updateResponse is the response of the Update actions.
+type updateResponse struct {
+	NextLockToken string
+}
@@ -0,0 +1,304 @@
+package wafv2_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zackbloom/goamz/aws"
+	"github.com/zackbloom/goamz/testutil"
+	"github.com/zackbloom/goamz/wafv2"
+	"gopkg.in/check.v1"
+)
+
+func Test(t *testing.T) {
+	check.TestingT(t)
+}
+
+var _ = check.Suite(&S{})
+
+type S struct {
+	waf *wafv2.WAFV2
+}
+
+var testServer = testutil.NewHTTPServer()
+
+const (
+	webACLId     = "a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	webACLArn    = "arn:aws:wafv2:us-east-1:111122223333:global/webacl/edge/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"
+	ipSetId      = "b1c2d3e4-5678-90ab-cdef-EXAMPLE22222"
+	ipSetArn     = "arn:aws:wafv2:us-east-1:111122223333:global/ipset/blocked/b1c2d3e4-5678-90ab-cdef-EXAMPLE22222"
+	lockToken    = "6b9e4b6e-4a2d-4c1e-9d0a-1f2e3d4c5b6a"
+	newLockToken = "0f1e2d3c-4b5a-6978-8796-a5b4c3d2e1f0"
+)
+
+func (s *S) SetUpSuite(c *check.C) {
+	testServer.Start()
+	auth := aws.Auth{AccessKey: "abc", SecretKey: "123"}
+	s.waf = wafv2.New(auth, aws.Region{Name: "us-east-1", WAFV2Endpoint: testServer.URL})
+}
+
+func (s *S) TearDownTest(c *check.C) {
+	testServer.Flush()
+}
+
+// toJSON returns v as decoded by requestBody.
+func toJSON(c *check.C, v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	c.Assert(err, check.IsNil)
+	var decoded interface{}
+	c.Assert(json.Unmarshal(data, &decoded), check.IsNil)
+	return decoded
+}
+
+func edgeACL() *wafv2.WebACLRequest {
+	return &wafv2.WebACLRequest{
+		Name:          "edge",
+		Description:   "Edge protection",
+		DefaultAction: wafv2.DefaultAction{Allow: &wafv2.Empty{}},
+		Rules: []wafv2.Rule{{
+			Name:     "blocked-ips",
+			Priority: 0,
+			Statement: wafv2.Statement{
+				IPSetReferenceStatement: &wafv2.IPSetReferenceStatement{ARN: ipSetArn},
+			},
+			Action:           &wafv2.RuleAction{Block: &wafv2.Empty{}},
+			VisibilityConfig: wafv2.VisibilityConfig{CloudWatchMetricsEnabled: true, MetricName: "blocked-ips"},
+		}},
+		VisibilityConfig: wafv2.VisibilityConfig{SampledRequestsEnabled: true, CloudWatchMetricsEnabled: true, MetricName: "edge"},
+		Tags:             []wafv2.Tag{{Key: "team", Value: "web"}},
+	}
+}
+
+func (s *S) TestCreateWebACL(c *check.C) {
+	testServer.Response(200, nil, CreateWebACLResponse)
+
+	summary, err := s.waf.CreateWebACL(edgeACL())
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.CreateWebACL")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Name":          "edge",
+		"Scope":         "CLOUDFRONT",
+		"Description":   "Edge protection",
+		"DefaultAction": map[string]interface{}{"Allow": map[string]interface{}{}},
+		"Rules": []interface{}{map[string]interface{}{
+			"Name":     "blocked-ips",
+			"Priority": 0.0,
+			"Statement": map[string]interface{}{
+				"IPSetReferenceStatement": map[string]interface{}{"ARN": ipSetArn},
+			},
+			"Action": map[string]interface{}{"Block": map[string]interface{}{}},
+			"VisibilityConfig": map[string]interface{}{
+				"SampledRequestsEnabled":   false,
+				"CloudWatchMetricsEnabled": true,
+				"MetricName":               "blocked-ips",
+			},
+		}},
+		"VisibilityConfig": map[string]interface{}{
+			"SampledRequestsEnabled":   true,
+			"CloudWatchMetricsEnabled": true,
+			"MetricName":               "edge",
+		},
+		"Tags": []interface{}{map[string]interface{}{"Key": "team", "Value": "web"}},
+	})
+
+	c.Assert(summary.ARN, check.Equals, webACLArn)
+	c.Assert(summary.Id, check.Equals, webACLId)
+	c.Assert(summary.LockToken, check.Equals, lockToken)
+}
+
+func (s *S) TestGetWebACL(c *check.C) {
+	testServer.Response(200, nil, GetWebACLResponse)
+
+	acl, token, err := s.waf.GetWebACL("edge", webACLId)
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.GetWebACL")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"Name": "edge", "Id": webACLId, "Scope": "CLOUDFRONT"})
+
+	c.Assert(token, check.Equals, lockToken)
+	c.Assert(acl.ARN, check.Equals, webACLArn)
+	c.Assert(acl.Capacity, check.Equals, int64(702))
+	c.Assert(acl.DefaultAction.Allow, check.NotNil)
+	c.Assert(acl.Rules, check.HasLen, 2)
+	c.Assert(acl.Rules[0].Statement.ManagedRuleGroupStatement, check.DeepEquals, &wafv2.ManagedRuleGroupStatement{
+		VendorName: "AWS",
+		Name:       "AWSManagedRulesCommonRuleSet",
+	})
+	c.Assert(acl.Rules[0].OverrideAction.None, check.NotNil)
+	c.Assert(acl.Rules[1].Statement.RateBasedStatement.Limit, check.Equals, int64(2000))
+	c.Assert(acl.Rules[1].Action.Block, check.NotNil)
+}
+
+func (s *S) TestUpdateWebACL(c *check.C) {
+	testServer.Response(200, nil, UpdateResponse)
+
+	req := edgeACL()
+	next, err := s.waf.UpdateWebACL(webACLId, lockToken, req)
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.UpdateWebACL")
+	c.Assert(body["Name"], check.Equals, "edge")
+	c.Assert(body["Id"], check.Equals, webACLId)
+	c.Assert(body["Scope"], check.Equals, "CLOUDFRONT")
+	c.Assert(body["LockToken"], check.Equals, lockToken)
+	c.Assert(body["Rules"], check.DeepEquals, toJSON(c, req.Rules))
+	c.Assert(body["Tags"], check.IsNil)
+	c.Assert(next, check.Equals, newLockToken)
+}
+
+func (s *S) TestDeleteWebACL(c *check.C) {
+	testServer.Response(200, nil, "{}")
+
+	err := s.waf.DeleteWebACL("edge", webACLId, lockToken)
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.DeleteWebACL")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Name":      "edge",
+		"Id":        webACLId,
+		"Scope":     "CLOUDFRONT",
+		"LockToken": lockToken,
+	})
+}
+
+func (s *S) TestDeleteWebACLOptimisticLock(c *check.C) {
+	testServer.Response(400, nil, OptimisticLockResponse)
+
+	err := s.waf.DeleteWebACL("edge", webACLId, "stale")
+	testServer.WaitJSONRequest(c, "wafv2")
+
+	wafErr, ok := err.(*wafv2.Error)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(wafErr.StatusCode, check.Equals, 400)
+	c.Assert(wafErr.Code, check.Equals, "WAFOptimisticLockException")
+	c.Assert(wafErr.Message, check.Matches, "AWS WAF couldn't save your changes .*")
+}
+
+func (s *S) TestCreateIPSetRegional(c *check.C) {
+	testServer.Response(200, nil, CreateWebACLResponse)
+
+	waf := *s.waf
+	waf.Scope = wafv2.ScopeRegional
+	_, err := waf.CreateIPSet(&wafv2.IPSetRequest{
+		Name:             "blocked",
+		IPAddressVersion: wafv2.IPAddressVersionIPv4,
+	})
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.CreateIPSet")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Name":             "blocked",
+		"Scope":            "REGIONAL",
+		"IPAddressVersion": "IPV4",
+		"Addresses":        []interface{}{},
+	})
+}
+
+func (s *S) TestGetAndUpdateIPSet(c *check.C) {
+	testServer.Response(200, nil, GetIPSetResponse)
+	testServer.Response(200, nil, UpdateResponse)
+
+	ipSet, token, err := s.waf.GetIPSet("blocked", ipSetId)
+	testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+	c.Assert(ipSet.Addresses, check.DeepEquals, []string{"192.0.2.0/24", "198.51.100.7/32"})
+	c.Assert(ipSet.IPAddressVersion, check.Equals, wafv2.IPAddressVersionIPv4)
+
+	next, err := s.waf.UpdateIPSet(ipSet.Name, ipSet.Id, token, append(ipSet.Addresses, "203.0.113.0/24"), "")
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.UpdateIPSet")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{
+		"Name":      "blocked",
+		"Id":        ipSetId,
+		"Scope":     "CLOUDFRONT",
+		"LockToken": "7c0f5c7f-5b3e-4d2f-8e1b-2a3f4e5d6c7b",
+		"Addresses": []interface{}{"192.0.2.0/24", "198.51.100.7/32", "203.0.113.0/24"},
+	})
+	c.Assert(next, check.Equals, newLockToken)
+}
+
+func (s *S) TestCreateRuleGroup(c *check.C) {
+	testServer.Response(200, nil, CreateWebACLResponse)
+
+	_, err := s.waf.CreateRuleGroup(&wafv2.RuleGroupRequest{
+		Name:     "admin",
+		Capacity: 50,
+		Rules: []wafv2.Rule{{
+			Name:     "admin-outside-office",
+			Priority: 0,
+			Statement: wafv2.Statement{AndStatement: &wafv2.StatementList{Statements: []wafv2.Statement{
+				{ByteMatchStatement: &wafv2.ByteMatchStatement{
+					SearchString:         []byte("/admin"),
+					FieldToMatch:         wafv2.FieldToMatch{UriPath: &wafv2.Empty{}},
+					TextTransformations:  []wafv2.TextTransformation{{Priority: 0, Type: "LOWERCASE"}},
+					PositionalConstraint: wafv2.PositionalConstraintStartsWith,
+				}},
+				{NotStatement: &wafv2.NotStatement{Statement: wafv2.Statement{
+					GeoMatchStatement: &wafv2.GeoMatchStatement{CountryCodes: []string{"US"}},
+				}}},
+			}}},
+			Action:           &wafv2.RuleAction{Block: &wafv2.Empty{}},
+			VisibilityConfig: wafv2.VisibilityConfig{MetricName: "admin-outside-office"},
+		}},
+		VisibilityConfig: wafv2.VisibilityConfig{MetricName: "admin"},
+	})
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.CreateRuleGroup")
+	c.Assert(body["Capacity"], check.Equals, 50.0)
+	statement := body["Rules"].([]interface{})[0].(map[string]interface{})["Statement"]
+	c.Assert(statement, check.DeepEquals, map[string]interface{}{
+		"AndStatement": map[string]interface{}{"Statements": []interface{}{
+			map[string]interface{}{"ByteMatchStatement": map[string]interface{}{
+				"SearchString":         "L2FkbWlu",
+				"FieldToMatch":         map[string]interface{}{"UriPath": map[string]interface{}{}},
+				"TextTransformations":  []interface{}{map[string]interface{}{"Priority": 0.0, "Type": "LOWERCASE"}},
+				"PositionalConstraint": "STARTS_WITH",
+			}},
+			map[string]interface{}{"NotStatement": map[string]interface{}{"Statement": map[string]interface{}{
+				"GeoMatchStatement": map[string]interface{}{"CountryCodes": []interface{}{"US"}},
+			}}},
+		}},
+	})
+}
+
+func (s *S) TestUpdateRuleGroup(c *check.C) {
+	testServer.Response(200, nil, UpdateResponse)
+
+	_, err := s.waf.UpdateRuleGroup("c1d2e3f4-5678-90ab-cdef-EXAMPLE33333", lockToken, &wafv2.RuleGroupRequest{
+		Name:             "admin",
+		Capacity:         50,
+		Rules:            []wafv2.Rule{},
+		VisibilityConfig: wafv2.VisibilityConfig{MetricName: "admin"},
+	})
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.UpdateRuleGroup")
+	c.Assert(body["Name"], check.Equals, "admin")
+	c.Assert(body["Capacity"], check.IsNil)
+	c.Assert(body["LockToken"], check.Equals, lockToken)
+}
+
+func (s *S) TestListRuleGroups(c *check.C) {
+	testServer.Response(200, nil, ListRuleGroupsResponse)
+
+	resp, err := s.waf.ListRuleGroups(&wafv2.ListRequest{Limit: 10})
+	target, body := testServer.WaitJSONRequest(c, "wafv2")
+	c.Assert(err, check.IsNil)
+
+	c.Assert(target, check.Equals, "AWSWAF_20190729.ListRuleGroups")
+	c.Assert(body, check.DeepEquals, map[string]interface{}{"Scope": "CLOUDFRONT", "Limit": 10.0})
+	c.Assert(resp.NextMarker, check.Equals, "Z2FtbWE=")
+	c.Assert(resp.RuleGroups, check.HasLen, 1)
+	c.Assert(resp.RuleGroups[0].Name, check.Equals, "admin")
+}
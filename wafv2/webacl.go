@@ -0,0 +1,112 @@
+package wafv2
+
+// WebACL is a set of rules protecting resources, such as CloudFront
+// distributions, which reference it by ARN.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_WebACL.html
+type WebACL struct {
+	ARN              string
+	Id               string
+	Name             string
+	Description      string
+	DefaultAction    DefaultAction
+	Rules            []Rule
+	VisibilityConfig VisibilityConfig
+	Capacity         int64 // The web ACL capacity units used by the rules
+}
+
+// WebACLRequest holds the parameters of CreateWebACL and UpdateWebACL. An
+// update replaces all the settings of the web ACL.
+type WebACLRequest struct {
+	Name             string
+	Description      string `json:",omitempty"`
+	DefaultAction    DefaultAction
+	Rules            []Rule
+	VisibilityConfig VisibilityConfig
+	Tags             []Tag `json:",omitempty"`
+}
+
+// CreateWebACL creates a web ACL. For ScopeCloudFront, attach it to a
+// distribution by setting the WebACLId of its configuration to the ARN of
+// the returned summary.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_CreateWebACL.html
+func (w *WAFV2) CreateWebACL(req *WebACLRequest) (*Summary, error) {
+	params := struct {
+		*WebACLRequest
+		Scope string
+	}{req, w.Scope}
+	var resp struct {
+		Summary *Summary
+	}
+	if err := w.query("CreateWebACL", params, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Summary, nil
+}
+
+// GetWebACL returns a web ACL and the lock token to update or delete it
+// with.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_GetWebACL.html
+func (w *WAFV2) GetWebACL(name, id string) (acl *WebACL, lockToken string, err error) {
+	var resp struct {
+		WebACL    *WebACL
+		LockToken string
+	}
+	if err := w.query("GetWebACL", ref{Name: name, Id: id, Scope: w.Scope}, &resp); err != nil {
+		return nil, "", err
+	}
+	return resp.WebACL, resp.LockToken, nil
+}
+
+// UpdateWebACL replaces the settings of a web ACL, except for its tags,
+// and returns the lock token of the next change. The update takes a
+// minute to propagate.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_UpdateWebACL.html
+func (w *WAFV2) UpdateWebACL(id, lockToken string, req *WebACLRequest) (nextLockToken string, err error) {
+	params := struct {
+		*WebACLRequest
+		Id, Scope, LockToken string
+		Tags                 []Tag `json:",omitempty"` // Hides the tags of req
+	}{req, id, w.Scope, lockToken, nil}
+	var resp updateResponse
+	if err := w.query("UpdateWebACL", params, &resp); err != nil {
+		return "", err
+	}
+	return resp.NextLockToken, nil
+}
+
+// DeleteWebACL deletes a web ACL, which must not be attached to any
+// resource.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_DeleteWebACL.html
+func (w *WAFV2) DeleteWebACL(name, id, lockToken string) error {
+	return w.query("DeleteWebACL", ref{Name: name, Id: id, Scope: w.Scope, LockToken: lockToken}, nil)
+}
+
+type ListWebACLsResponse struct {
+	WebACLs    []Summary
+	NextMarker string
+}
+
+// ListWebACLs fetches a page of the web ACLs of the scope. If the response
+// has a NextMarker, set it in req to get the next page.
+//
+// See http://docs.aws.amazon.com/waf/latest/APIReference/API_ListWebACLs.html
+func (w *WAFV2) ListWebACLs(req *ListRequest) (resp *ListWebACLsResponse, err error) {
+	resp = new(ListWebACLsResponse)
+	if err := w.query("ListWebACLs", listParams(req, w.Scope), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func listParams(req *ListRequest, scope string) listRequest {
+	params := listRequest{Scope: scope}
+	if req != nil {
+		params.ListRequest = *req
+	}
+	return params
+}